@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rightscale/rsc/cmd"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// Captures alias placeholders: "$1" (positional), "${name}" or "${name:default}" (named) and "$$"
+// (escaped dollar sign).
+var placeholderRegexp = regexp.MustCompile(`\$(\d+)|\$\{([A-Za-z_][A-Za-z0-9_-]*)(?::([^}]*))?\}|\$\$`)
+
+// Global flags that do not take a value, used to find the command name in commandIndex.
+var boolFlags = map[string]bool{"--help": true, "--version": true, "--rl10": true, "--noAuth": true,
+	"--fetch": true, "--verbose": true, "-v": true, "--pp": true}
+
+// commandIndex returns the index of the first command line argument that is not a global flag or
+// a global flag value, -1 if there isn't one.
+func commandIndex(args []string) int {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return -1
+		}
+		if strings.HasPrefix(arg, "-") {
+			if !strings.Contains(arg, "=") && !boolFlags[arg] && !strings.HasPrefix(arg, "--no-") {
+				i++ // Skip flag value
+			}
+			continue
+		}
+		return i
+	}
+	return -1
+}
+
+// configPathFromArgs returns the value of the --config flag, or its default value if not
+// specified. This is needed prior to parsing the command line to load the aliases.
+func configPathFromArgs(args []string) string {
+	if p, ok := flagFromArgs(args, "config", "c"); ok {
+		return p
+	}
+	return path.Join(os.Getenv("HOME"), ".rsc")
+}
+
+// flagFromArgs returns the value of the top level flag with the given long and short (optional)
+// names and whether the flag was found.
+func flagFromArgs(args []string, long, short string) (string, bool) {
+	for i, arg := range args {
+		if strings.HasPrefix(arg, "--"+long+"=") {
+			return strings.TrimPrefix(arg, "--"+long+"="), true
+		}
+		if (arg == "--"+long || short != "" && arg == "-"+short) && i < len(args)-1 {
+			return args[i+1], true
+		}
+	}
+	return "", false
+}
+
+// registerAliases registers a command for each alias so that aliases show up in the command line
+// help and completion. Aliases whose name is a built-in command are ignored.
+func registerAliases(app *kingpin.Application, aliases map[string][]string) {
+	names := make([]string, 0, len(aliases))
+	for n := range aliases {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		if app.GetCommand(n) != nil {
+			continue
+		}
+		aliasCmd := app.Command(n, fmt.Sprintf("alias for '%s'", strings.Join(aliases[n], " ")))
+		aliasCmd.Arg("args", "Alias arguments").Strings()
+	}
+}
+
+// expandAliasArgs replaces the alias named on the command line if any with its definition.
+func expandAliasArgs(app *kingpin.Application, aliases map[string][]string, args []string) ([]string, error) {
+	idx := commandIndex(args)
+	if idx < 0 {
+		return args, nil
+	}
+	name := args[idx]
+	def, ok := aliases[name]
+	if !ok || isBuiltinCommand(name) {
+		return args, nil
+	}
+	expanded, err := expandAlias(def, args[idx+1:])
+	if err != nil {
+		return nil, fmt.Errorf("alias %s: %s", name, err)
+	}
+	newArgs := append([]string{}, args[:idx]...)
+	return append(newArgs, expanded...), nil
+}
+
+// expandAlias substitutes the placeholders of the alias definition with the given arguments.
+// Positional placeholders ("$1", "$2" etc.) consume the first arguments, named placeholders
+// ("${name}") consume arguments of the form "name=value" and may specify a default value
+// ("${name:default}"). Arguments that are not consumed by placeholders are appended to the result.
+func expandAlias(def, args []string) ([]string, error) {
+	var positional int
+	named := make(map[string]bool)
+	for _, d := range def {
+		for _, m := range placeholderRegexp.FindAllStringSubmatch(d, -1) {
+			if m[1] != "" {
+				if n, _ := strconv.Atoi(m[1]); n > positional {
+					positional = n
+				}
+			} else if m[2] != "" {
+				named[m[2]] = true
+			}
+		}
+	}
+	var positionalValues, rest []string
+	namedValues := make(map[string]string)
+	for _, a := range args {
+		if elems := strings.SplitN(a, "=", 2); len(elems) == 2 && named[elems[0]] {
+			namedValues[elems[0]] = elems[1]
+		} else if len(positionalValues) < positional {
+			positionalValues = append(positionalValues, a)
+		} else {
+			rest = append(rest, a)
+		}
+	}
+	if len(positionalValues) < positional {
+		return nil, fmt.Errorf("missing argument, %d argument(s) required but got %d",
+			positional, len(positionalValues))
+	}
+	var missing []string
+	expanded := make([]string, len(def))
+	for i, d := range def {
+		expanded[i] = placeholderRegexp.ReplaceAllStringFunc(d, func(p string) string {
+			m := placeholderRegexp.FindStringSubmatch(p)
+			switch {
+			case m[1] != "":
+				n, _ := strconv.Atoi(m[1])
+				if n == 0 {
+					return p
+				}
+				return positionalValues[n-1]
+			case m[2] != "":
+				if v, ok := namedValues[m[2]]; ok {
+					return v
+				}
+				if strings.Contains(p, ":") {
+					return m[3]
+				}
+				missing = append(missing, m[2])
+				return p
+			default:
+				return "$"
+			}
+		})
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing value for %s, use NAME=VALUE to specify it",
+			strings.Join(missing, ", "))
+	}
+	return append(expanded, rest...), nil
+}
+
+// runAlias runs the "alias list", "alias add" and "alias remove" commands.
+func runAlias(cmdLine *cmd.CommandLine) error {
+	config, err := LoadConfig(cmdLine.ConfigPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("Failed to load config: %s", err)
+		}
+		config = &ClientConfig{}
+	}
+	switch cmdLine.Command {
+	case "alias list":
+		names := make([]string, 0, len(config.Aliases))
+		for n := range config.Aliases {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		for _, n := range names {
+			fmt.Fprintf(out, "%s: %s\n", n, strings.Join(config.Aliases[n], " "))
+		}
+		return nil
+	case "alias add":
+		if isBuiltinCommand(cmdLine.AliasName) {
+			return fmt.Errorf("Cannot create alias %s: %s is a built-in command",
+				cmdLine.AliasName, cmdLine.AliasName)
+		}
+		if config.Aliases == nil {
+			config.Aliases = make(map[string][]string)
+		}
+		config.Aliases[cmdLine.AliasName] = cmdLine.AliasArgs
+	case "alias remove":
+		if _, ok := config.Aliases[cmdLine.AliasName]; !ok {
+			return fmt.Errorf("No alias named %s", cmdLine.AliasName)
+		}
+		delete(config.Aliases, cmdLine.AliasName)
+	}
+	return config.Save(cmdLine.ConfigPath)
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rightscale/rsc/cmd"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+var _ = Describe("Aliases", func() {
+	Context("expanding an alias", func() {
+		var (
+			def, args []string
+			expanded  []string
+			err       error
+		)
+
+		JustBeforeEach(func() {
+			expanded, err = expandAlias(def, args)
+		})
+
+		Context("with positional placeholders", func() {
+			BeforeEach(func() {
+				def = []string{"cm15", "index", "/api/clouds/$1/instances", "filter[]=name==$2"}
+				args = []string{"6", "web", "--pp"}
+			})
+
+			It("substitutes the arguments and appends the others", func() {
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(expanded).Should(Equal([]string{"cm15", "index", "/api/clouds/6/instances",
+					"filter[]=name==web", "--pp"}))
+			})
+		})
+
+		Context("with missing positional arguments", func() {
+			BeforeEach(func() {
+				def = []string{"cm15", "show", "/api/clouds/$1"}
+				args = nil
+			})
+
+			It("fails", func() {
+				Ω(err).Should(HaveOccurred())
+			})
+		})
+
+		Context("with named placeholders", func() {
+			BeforeEach(func() {
+				def = []string{"cm15", "index", "/api/clouds/${cloud}/instances", "view=${view:default}", "$$x"}
+				args = []string{"cloud=6"}
+			})
+
+			It("substitutes values and defaults", func() {
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(expanded).Should(Equal([]string{"cm15", "index", "/api/clouds/6/instances",
+					"view=default", "$x"}))
+			})
+		})
+
+		Context("with a missing named value", func() {
+			BeforeEach(func() {
+				def = []string{"cm15", "index", "/api/clouds/${cloud}/instances"}
+				args = []string{"view=full"}
+			})
+
+			It("fails", func() {
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("cloud"))
+			})
+		})
+	})
+
+	Context("with a config file", func() {
+		var (
+			dir, configPath string
+			args            []string
+			cmdLine         *cmd.CommandLine
+			err             error
+		)
+
+		BeforeEach(func() {
+			dir, _ = ioutil.TempDir("", "rsc_alias")
+			configPath = filepath.Join(dir, "config")
+			config := ClientConfig{Aliases: map[string][]string{
+				"servers": {"cm15", "index", "/api/clouds/$1/instances"},
+				"setup":   {"cm15", "index", "/api/clouds"},
+			}}
+			Ω(config.Save(configPath)).Should(Succeed())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		JustBeforeEach(func() {
+			os.Args = append([]string{"rsc", "--config", configPath}, args...)
+			cmdLine, err = ParseCommandLine(kingpin.New("test", "test"))
+		})
+
+		Context("running an alias", func() {
+			BeforeEach(func() {
+				args = []string{"--noAuth", "--host", "h", "servers", "6", "--pp"}
+			})
+
+			It("parses the expanded command line", func() {
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(cmdLine.Command).Should(Equal("cm15 index"))
+				Ω(cmdLine.Pretty).Should(BeTrue())
+			})
+		})
+
+		Context("running an alias named after a built-in command", func() {
+			BeforeEach(func() {
+				args = []string{"setup"}
+			})
+
+			It("runs the built-in command", func() {
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(cmdLine.Command).Should(Equal("setup"))
+			})
+		})
+
+		Context("adding an alias", func() {
+			BeforeEach(func() {
+				args = []string{"alias", "add", "clouds", "--", "cm15", "index", "/api/clouds", "--pp"}
+			})
+
+			It("saves it in the config", func() {
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(runAlias(cmdLine)).Should(Succeed())
+				config, err := LoadConfig(configPath)
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(config.Aliases["clouds"]).Should(Equal([]string{"cm15", "index", "/api/clouds", "--pp"}))
+				Ω(config.Aliases).Should(HaveKey("servers"))
+			})
+		})
+
+		Context("adding an alias named after a built-in command", func() {
+			BeforeEach(func() {
+				args = []string{"alias", "add", "diff", "cm15", "index"}
+			})
+
+			It("fails", func() {
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(runAlias(cmdLine)).ShouldNot(Succeed())
+			})
+		})
+
+		Context("removing an alias", func() {
+			BeforeEach(func() {
+				args = []string{"alias", "remove", "servers"}
+			})
+
+			It("deletes it from the config", func() {
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(runAlias(cmdLine)).Should(Succeed())
+				config, err := LoadConfig(configPath)
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(config.Aliases).ShouldNot(HaveKey("servers"))
+			})
+		})
+	})
+})
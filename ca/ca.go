@@ -30,6 +30,18 @@ func FromCommandLine(cmdLine *cmd.CommandLine) (*API, error) {
 	return &API{api}, nil
 }
 
+// FromEnv builds a client from the environment variables set by rsc when running plugins.
+func FromEnv() (*API, error) {
+	api, err := rsapi.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+	setupMetadata()
+	api.Host = apiHostFromLogin(api.Host)
+	api.Metadata = GenMetadata
+	return &API{api}, nil
+}
+
 // New returns a CA API client.
 func New(h string, a rsapi.Authenticator) *API {
 	api := rsapi.New(h, a)
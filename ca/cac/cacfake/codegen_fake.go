@@ -0,0 +1,2657 @@
+//************************************************************************//
+//                     RightScale API client fakes
+//
+// Generated with:
+// $ praxisgen -metadata=ca/cac/docs/api -output=ca/cac -pkg=cac -target=1.0 -client=API
+//
+// The content of this file is auto-generated, DO NOT MODIFY
+//************************************************************************//
+
+// Package cacfake provides fake implementations of the cac locator interfaces.
+// Each fake records the calls made to its methods and returns the values returned by the
+// corresponding stub functions if set, zero values otherwise.
+package cacfake
+
+import (
+	"io"
+	"sync"
+
+	"github.com/rightscale/rsc/ca/cac"
+	"github.com/rightscale/rsc/rsapi"
+)
+
+/******  Account ******/
+
+// AccountLocator is a fake implementation of cac.AccountLocatorInterface.
+type AccountLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cac.AccountCreateOptions) (*cac.AccountLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*AccountCreateCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cac.AccountIndexOptions) (*cac.Account, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*AccountIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cac.AccountShowOptions) (*cac.Account, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*AccountShowCall
+}
+
+var _ cac.AccountLocatorInterface = (*AccountLocator)(nil)
+
+// AccountCreateCall records the arguments of a call to AccountLocator.Create.
+type AccountCreateCall struct {
+	Options *cac.AccountCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *AccountLocator) Create(options *cac.AccountCreateOptions) (*cac.AccountLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &AccountCreateCall{Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.AccountLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *AccountLocator) CreateReturns(res *cac.AccountLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cac.AccountCreateOptions) (*cac.AccountLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AccountIndexCall records the arguments of a call to AccountLocator.Index.
+type AccountIndexCall struct {
+	Options *cac.AccountIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *AccountLocator) Index(options *cac.AccountIndexOptions) (*cac.Account, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &AccountIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.Account
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *AccountLocator) IndexReturns(res *cac.Account, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cac.AccountIndexOptions) (*cac.Account, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AccountShowCall records the arguments of a call to AccountLocator.Show.
+type AccountShowCall struct {
+	Options *cac.AccountShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *AccountLocator) Show(options *cac.AccountShowOptions) (*cac.Account, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &AccountShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.Account
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *AccountLocator) ShowReturns(res *cac.Account, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cac.AccountShowOptions) (*cac.Account, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  AnalysisSnapshot ******/
+
+// AnalysisSnapshotLocator is a fake implementation of cac.AnalysisSnapshotLocatorInterface.
+type AnalysisSnapshotLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*rsapi.Time, string, *rsapi.Time, *cac.AnalysisSnapshotCreateOptions) (*cac.AnalysisSnapshotLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*AnalysisSnapshotCreateCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cac.AnalysisSnapshotShowOptions) (*cac.AnalysisSnapshot, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*AnalysisSnapshotShowCall
+}
+
+var _ cac.AnalysisSnapshotLocatorInterface = (*AnalysisSnapshotLocator)(nil)
+
+// AnalysisSnapshotCreateCall records the arguments of a call to AnalysisSnapshotLocator.Create.
+type AnalysisSnapshotCreateCall struct {
+	EndTime     *rsapi.Time
+	Granularity string
+	StartTime   *rsapi.Time
+	Options     *cac.AnalysisSnapshotCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *AnalysisSnapshotLocator) Create(endTime *rsapi.Time, granularity string, startTime *rsapi.Time, options *cac.AnalysisSnapshotCreateOptions) (*cac.AnalysisSnapshotLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &AnalysisSnapshotCreateCall{EndTime: endTime, Granularity: granularity, StartTime: startTime, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, granularity, startTime, options)
+	}
+	var res *cac.AnalysisSnapshotLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *AnalysisSnapshotLocator) CreateReturns(res *cac.AnalysisSnapshotLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*rsapi.Time, string, *rsapi.Time, *cac.AnalysisSnapshotCreateOptions) (*cac.AnalysisSnapshotLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AnalysisSnapshotShowCall records the arguments of a call to AnalysisSnapshotLocator.Show.
+type AnalysisSnapshotShowCall struct {
+	Options *cac.AnalysisSnapshotShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *AnalysisSnapshotLocator) Show(options *cac.AnalysisSnapshotShowOptions) (*cac.AnalysisSnapshot, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &AnalysisSnapshotShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.AnalysisSnapshot
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *AnalysisSnapshotLocator) ShowReturns(res *cac.AnalysisSnapshot, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cac.AnalysisSnapshotShowOptions) (*cac.AnalysisSnapshot, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  BudgetAlert ******/
+
+// BudgetAlertLocator is a fake implementation of cac.BudgetAlertLocatorInterface.
+type BudgetAlertLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cac.BudgetStruct, string, string, string, *cac.BudgetAlertCreateOptions) (*cac.BudgetAlertLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*BudgetAlertCreateCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cac.BudgetAlertIndexOptions) (*cac.BudgetAlert, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*BudgetAlertIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cac.BudgetAlertShowOptions) (*cac.BudgetAlert, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*BudgetAlertShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cac.BudgetAlertUpdateOptions) (*cac.BudgetAlert, error)
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*BudgetAlertUpdateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*BudgetAlertDestroyCall
+}
+
+var _ cac.BudgetAlertLocatorInterface = (*BudgetAlertLocator)(nil)
+
+// BudgetAlertCreateCall records the arguments of a call to BudgetAlertLocator.Create.
+type BudgetAlertCreateCall struct {
+	Budget    *cac.BudgetStruct
+	Frequency string
+	Name      string
+	Type_     string
+	Options   *cac.BudgetAlertCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *BudgetAlertLocator) Create(budget *cac.BudgetStruct, frequency string, name string, type_ string, options *cac.BudgetAlertCreateOptions) (*cac.BudgetAlertLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &BudgetAlertCreateCall{Budget: budget, Frequency: frequency, Name: name, Type_: type_, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(budget, frequency, name, type_, options)
+	}
+	var res *cac.BudgetAlertLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *BudgetAlertLocator) CreateReturns(res *cac.BudgetAlertLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cac.BudgetStruct, string, string, string, *cac.BudgetAlertCreateOptions) (*cac.BudgetAlertLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// BudgetAlertIndexCall records the arguments of a call to BudgetAlertLocator.Index.
+type BudgetAlertIndexCall struct {
+	Options *cac.BudgetAlertIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *BudgetAlertLocator) Index(options *cac.BudgetAlertIndexOptions) (*cac.BudgetAlert, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &BudgetAlertIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.BudgetAlert
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *BudgetAlertLocator) IndexReturns(res *cac.BudgetAlert, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cac.BudgetAlertIndexOptions) (*cac.BudgetAlert, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// BudgetAlertShowCall records the arguments of a call to BudgetAlertLocator.Show.
+type BudgetAlertShowCall struct {
+	Options *cac.BudgetAlertShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *BudgetAlertLocator) Show(options *cac.BudgetAlertShowOptions) (*cac.BudgetAlert, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &BudgetAlertShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.BudgetAlert
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *BudgetAlertLocator) ShowReturns(res *cac.BudgetAlert, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cac.BudgetAlertShowOptions) (*cac.BudgetAlert, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// BudgetAlertUpdateCall records the arguments of a call to BudgetAlertLocator.Update.
+type BudgetAlertUpdateCall struct {
+	Options *cac.BudgetAlertUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *BudgetAlertLocator) Update(options *cac.BudgetAlertUpdateOptions) (*cac.BudgetAlert, error) {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &BudgetAlertUpdateCall{Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.BudgetAlert
+	return res, nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *BudgetAlertLocator) UpdateReturns(res *cac.BudgetAlert, err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cac.BudgetAlertUpdateOptions) (*cac.BudgetAlert, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// BudgetAlertDestroyCall records the arguments of a call to BudgetAlertLocator.Destroy.
+type BudgetAlertDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *BudgetAlertLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &BudgetAlertDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *BudgetAlertLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  CloudBill ******/
+
+// CloudBillLocator is a fake implementation of cac.CloudBillLocatorInterface.
+type CloudBillLocator struct {
+	mu sync.Mutex
+
+	// FilterOptionsStub is called by FilterOptions if not nil.
+	FilterOptionsStub func(*rsapi.Time, []string, *rsapi.Time, *cac.CloudBillFilterOptionsOptions) (*cac.Filter, error)
+	// FilterOptionsCalls records the arguments of the calls made to FilterOptions.
+	FilterOptionsCalls []*CloudBillFilterOptionsCall
+}
+
+var _ cac.CloudBillLocatorInterface = (*CloudBillLocator)(nil)
+
+// CloudBillFilterOptionsCall records the arguments of a call to CloudBillLocator.FilterOptions.
+type CloudBillFilterOptionsCall struct {
+	EndTime     *rsapi.Time
+	FilterTypes []string
+	StartTime   *rsapi.Time
+	Options     *cac.CloudBillFilterOptionsOptions
+}
+
+// FilterOptions records the call and returns the results of FilterOptionsStub if set.
+func (fake *CloudBillLocator) FilterOptions(endTime *rsapi.Time, filterTypes []string, startTime *rsapi.Time, options *cac.CloudBillFilterOptionsOptions) (*cac.Filter, error) {
+	fake.mu.Lock()
+	fake.FilterOptionsCalls = append(fake.FilterOptionsCalls, &CloudBillFilterOptionsCall{EndTime: endTime, FilterTypes: filterTypes, StartTime: startTime, Options: options})
+	stub := fake.FilterOptionsStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, filterTypes, startTime, options)
+	}
+	var res *cac.Filter
+	return res, nil
+}
+
+// FilterOptionsReturns sets FilterOptionsStub to a function that returns the given values.
+func (fake *CloudBillLocator) FilterOptionsReturns(res *cac.Filter, err error) {
+	fake.mu.Lock()
+	fake.FilterOptionsStub = func(*rsapi.Time, []string, *rsapi.Time, *cac.CloudBillFilterOptionsOptions) (*cac.Filter, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  CloudBillMetric ******/
+
+// CloudBillMetricLocator is a fake implementation of cac.CloudBillMetricLocatorInterface.
+type CloudBillMetricLocator struct {
+	mu sync.Mutex
+
+	// GroupedTimeSeriesStub is called by GroupedTimeSeries if not nil.
+	GroupedTimeSeriesStub func(*rsapi.Time, [][]string, *rsapi.Time, *cac.CloudBillMetricGroupedTimeSeriesOptions) (*cac.TimeSeriesMetricsResult, error)
+	// GroupedTimeSeriesCalls records the arguments of the calls made to GroupedTimeSeries.
+	GroupedTimeSeriesCalls []*CloudBillMetricGroupedTimeSeriesCall
+}
+
+var _ cac.CloudBillMetricLocatorInterface = (*CloudBillMetricLocator)(nil)
+
+// CloudBillMetricGroupedTimeSeriesCall records the arguments of a call to CloudBillMetricLocator.GroupedTimeSeries.
+type CloudBillMetricGroupedTimeSeriesCall struct {
+	EndTime   *rsapi.Time
+	Group     [][]string
+	StartTime *rsapi.Time
+	Options   *cac.CloudBillMetricGroupedTimeSeriesOptions
+}
+
+// GroupedTimeSeries records the call and returns the results of GroupedTimeSeriesStub if set.
+func (fake *CloudBillMetricLocator) GroupedTimeSeries(endTime *rsapi.Time, group [][]string, startTime *rsapi.Time, options *cac.CloudBillMetricGroupedTimeSeriesOptions) (*cac.TimeSeriesMetricsResult, error) {
+	fake.mu.Lock()
+	fake.GroupedTimeSeriesCalls = append(fake.GroupedTimeSeriesCalls, &CloudBillMetricGroupedTimeSeriesCall{EndTime: endTime, Group: group, StartTime: startTime, Options: options})
+	stub := fake.GroupedTimeSeriesStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, group, startTime, options)
+	}
+	var res *cac.TimeSeriesMetricsResult
+	return res, nil
+}
+
+// GroupedTimeSeriesReturns sets GroupedTimeSeriesStub to a function that returns the given values.
+func (fake *CloudBillMetricLocator) GroupedTimeSeriesReturns(res *cac.TimeSeriesMetricsResult, err error) {
+	fake.mu.Lock()
+	fake.GroupedTimeSeriesStub = func(*rsapi.Time, [][]string, *rsapi.Time, *cac.CloudBillMetricGroupedTimeSeriesOptions) (*cac.TimeSeriesMetricsResult, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  CurrentUser ******/
+
+// CurrentUserLocator is a fake implementation of cac.CurrentUserLocatorInterface.
+type CurrentUserLocator struct {
+	mu sync.Mutex
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cac.CurrentUserShowOptions) (*cac.CurrentUser, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*CurrentUserShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(string, *cac.CurrentUserUpdateOptions) (*cac.CurrentUser, error)
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*CurrentUserUpdateCall
+
+	// CloudAccountsStub is called by CloudAccounts if not nil.
+	CloudAccountsStub func(string, string, string, string) error
+	// CloudAccountsCalls records the arguments of the calls made to CloudAccounts.
+	CloudAccountsCalls []*CurrentUserCloudAccountsCall
+
+	// OnboardingStatusStub is called by OnboardingStatus if not nil.
+	OnboardingStatusStub func(*cac.CurrentUserOnboardingStatusOptions) (*cac.UserOnboardingStatus, error)
+	// OnboardingStatusCalls records the arguments of the calls made to OnboardingStatus.
+	OnboardingStatusCalls []*CurrentUserOnboardingStatusCall
+
+	// EnvironmentStub is called by Environment if not nil.
+	EnvironmentStub func() (*cac.UserEnvironment, error)
+	// EnvironmentCalls records the arguments of the calls made to Environment.
+	EnvironmentCalls []*CurrentUserEnvironmentCall
+}
+
+var _ cac.CurrentUserLocatorInterface = (*CurrentUserLocator)(nil)
+
+// CurrentUserShowCall records the arguments of a call to CurrentUserLocator.Show.
+type CurrentUserShowCall struct {
+	Options *cac.CurrentUserShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *CurrentUserLocator) Show(options *cac.CurrentUserShowOptions) (*cac.CurrentUser, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &CurrentUserShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.CurrentUser
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *CurrentUserLocator) ShowReturns(res *cac.CurrentUser, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cac.CurrentUserShowOptions) (*cac.CurrentUser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CurrentUserUpdateCall records the arguments of a call to CurrentUserLocator.Update.
+type CurrentUserUpdateCall struct {
+	Password string
+	Options  *cac.CurrentUserUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *CurrentUserLocator) Update(password string, options *cac.CurrentUserUpdateOptions) (*cac.CurrentUser, error) {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &CurrentUserUpdateCall{Password: password, Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(password, options)
+	}
+	var res *cac.CurrentUser
+	return res, nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *CurrentUserLocator) UpdateReturns(res *cac.CurrentUser, err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(string, *cac.CurrentUserUpdateOptions) (*cac.CurrentUser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CurrentUserCloudAccountsCall records the arguments of a call to CurrentUserLocator.CloudAccounts.
+type CurrentUserCloudAccountsCall struct {
+	AwsAccessKeyId     string
+	AwsAccountNumber   string
+	AwsSecretAccessKey string
+	CloudVendorName    string
+}
+
+// CloudAccounts records the call and returns the results of CloudAccountsStub if set.
+func (fake *CurrentUserLocator) CloudAccounts(awsAccessKeyId string, awsAccountNumber string, awsSecretAccessKey string, cloudVendorName string) error {
+	fake.mu.Lock()
+	fake.CloudAccountsCalls = append(fake.CloudAccountsCalls, &CurrentUserCloudAccountsCall{AwsAccessKeyId: awsAccessKeyId, AwsAccountNumber: awsAccountNumber, AwsSecretAccessKey: awsSecretAccessKey, CloudVendorName: cloudVendorName})
+	stub := fake.CloudAccountsStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(awsAccessKeyId, awsAccountNumber, awsSecretAccessKey, cloudVendorName)
+	}
+	return nil
+}
+
+// CloudAccountsReturns sets CloudAccountsStub to a function that returns the given values.
+func (fake *CurrentUserLocator) CloudAccountsReturns(err error) {
+	fake.mu.Lock()
+	fake.CloudAccountsStub = func(string, string, string, string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CurrentUserOnboardingStatusCall records the arguments of a call to CurrentUserLocator.OnboardingStatus.
+type CurrentUserOnboardingStatusCall struct {
+	Options *cac.CurrentUserOnboardingStatusOptions
+}
+
+// OnboardingStatus records the call and returns the results of OnboardingStatusStub if set.
+func (fake *CurrentUserLocator) OnboardingStatus(options *cac.CurrentUserOnboardingStatusOptions) (*cac.UserOnboardingStatus, error) {
+	fake.mu.Lock()
+	fake.OnboardingStatusCalls = append(fake.OnboardingStatusCalls, &CurrentUserOnboardingStatusCall{Options: options})
+	stub := fake.OnboardingStatusStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.UserOnboardingStatus
+	return res, nil
+}
+
+// OnboardingStatusReturns sets OnboardingStatusStub to a function that returns the given values.
+func (fake *CurrentUserLocator) OnboardingStatusReturns(res *cac.UserOnboardingStatus, err error) {
+	fake.mu.Lock()
+	fake.OnboardingStatusStub = func(*cac.CurrentUserOnboardingStatusOptions) (*cac.UserOnboardingStatus, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CurrentUserEnvironmentCall records the arguments of a call to CurrentUserLocator.Environment.
+type CurrentUserEnvironmentCall struct{}
+
+// Environment records the call and returns the results of EnvironmentStub if set.
+func (fake *CurrentUserLocator) Environment() (*cac.UserEnvironment, error) {
+	fake.mu.Lock()
+	fake.EnvironmentCalls = append(fake.EnvironmentCalls, &CurrentUserEnvironmentCall{})
+	stub := fake.EnvironmentStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cac.UserEnvironment
+	return res, nil
+}
+
+// EnvironmentReturns sets EnvironmentStub to a function that returns the given values.
+func (fake *CurrentUserLocator) EnvironmentReturns(res *cac.UserEnvironment, err error) {
+	fake.mu.Lock()
+	fake.EnvironmentStub = func() (*cac.UserEnvironment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Instance ******/
+
+// InstanceLocator is a fake implementation of cac.InstanceLocatorInterface.
+type InstanceLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*rsapi.Time, *rsapi.Time, *cac.InstanceIndexOptions) (*cac.Instance, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*InstanceIndexCall
+
+	// CountStub is called by Count if not nil.
+	CountStub func(*rsapi.Time, *rsapi.Time, *cac.InstanceCountOptions) (string, error)
+	// CountCalls records the arguments of the calls made to Count.
+	CountCalls []*InstanceCountCall
+
+	// CountReaderStub is called by CountReader if not nil.
+	CountReaderStub func(*rsapi.Time, *rsapi.Time, *cac.InstanceCountOptions) (io.ReadCloser, error)
+	// CountReaderCalls records the arguments of the calls made to CountReader.
+	CountReaderCalls []*InstanceCountCall
+
+	// ExistStub is called by Exist if not nil.
+	ExistStub func(*cac.InstanceExistOptions) (string, error)
+	// ExistCalls records the arguments of the calls made to Exist.
+	ExistCalls []*InstanceExistCall
+
+	// ExistReaderStub is called by ExistReader if not nil.
+	ExistReaderStub func(*cac.InstanceExistOptions) (io.ReadCloser, error)
+	// ExistReaderCalls records the arguments of the calls made to ExistReader.
+	ExistReaderCalls []*InstanceExistCall
+
+	// ExportStub is called by Export if not nil.
+	ExportStub func(*rsapi.Time, *rsapi.Time, *cac.InstanceExportOptions) (string, error)
+	// ExportCalls records the arguments of the calls made to Export.
+	ExportCalls []*InstanceExportCall
+
+	// ExportReaderStub is called by ExportReader if not nil.
+	ExportReaderStub func(*rsapi.Time, *rsapi.Time, *cac.InstanceExportOptions) (io.ReadCloser, error)
+	// ExportReaderCalls records the arguments of the calls made to ExportReader.
+	ExportReaderCalls []*InstanceExportCall
+
+	// FilterOptionsStub is called by FilterOptions if not nil.
+	FilterOptionsStub func(*rsapi.Time, []string, *rsapi.Time, *cac.InstanceFilterOptionsOptions) (*cac.Filter, error)
+	// FilterOptionsCalls records the arguments of the calls made to FilterOptions.
+	FilterOptionsCalls []*InstanceFilterOptionsCall
+}
+
+var _ cac.InstanceLocatorInterface = (*InstanceLocator)(nil)
+
+// InstanceIndexCall records the arguments of a call to InstanceLocator.Index.
+type InstanceIndexCall struct {
+	EndTime   *rsapi.Time
+	StartTime *rsapi.Time
+	Options   *cac.InstanceIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *InstanceLocator) Index(endTime *rsapi.Time, startTime *rsapi.Time, options *cac.InstanceIndexOptions) (*cac.Instance, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &InstanceIndexCall{EndTime: endTime, StartTime: startTime, Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, startTime, options)
+	}
+	var res *cac.Instance
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *InstanceLocator) IndexReturns(res *cac.Instance, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*rsapi.Time, *rsapi.Time, *cac.InstanceIndexOptions) (*cac.Instance, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceCountCall records the arguments of a call to InstanceLocator.Count.
+type InstanceCountCall struct {
+	EndTime   *rsapi.Time
+	StartTime *rsapi.Time
+	Options   *cac.InstanceCountOptions
+}
+
+// Count records the call and returns the results of CountStub if set.
+func (fake *InstanceLocator) Count(endTime *rsapi.Time, startTime *rsapi.Time, options *cac.InstanceCountOptions) (string, error) {
+	fake.mu.Lock()
+	fake.CountCalls = append(fake.CountCalls, &InstanceCountCall{EndTime: endTime, StartTime: startTime, Options: options})
+	stub := fake.CountStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, startTime, options)
+	}
+	var res string
+	return res, nil
+}
+
+// CountReturns sets CountStub to a function that returns the given values.
+func (fake *InstanceLocator) CountReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.CountStub = func(*rsapi.Time, *rsapi.Time, *cac.InstanceCountOptions) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CountReader records the call and returns the results of CountReaderStub if set.
+func (fake *InstanceLocator) CountReader(endTime *rsapi.Time, startTime *rsapi.Time, options *cac.InstanceCountOptions) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.CountReaderCalls = append(fake.CountReaderCalls, &InstanceCountCall{EndTime: endTime, StartTime: startTime, Options: options})
+	stub := fake.CountReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, startTime, options)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// CountReaderReturns sets CountReaderStub to a function that returns the given values.
+func (fake *InstanceLocator) CountReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.CountReaderStub = func(*rsapi.Time, *rsapi.Time, *cac.InstanceCountOptions) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceExistCall records the arguments of a call to InstanceLocator.Exist.
+type InstanceExistCall struct {
+	Options *cac.InstanceExistOptions
+}
+
+// Exist records the call and returns the results of ExistStub if set.
+func (fake *InstanceLocator) Exist(options *cac.InstanceExistOptions) (string, error) {
+	fake.mu.Lock()
+	fake.ExistCalls = append(fake.ExistCalls, &InstanceExistCall{Options: options})
+	stub := fake.ExistStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res string
+	return res, nil
+}
+
+// ExistReturns sets ExistStub to a function that returns the given values.
+func (fake *InstanceLocator) ExistReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.ExistStub = func(*cac.InstanceExistOptions) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ExistReader records the call and returns the results of ExistReaderStub if set.
+func (fake *InstanceLocator) ExistReader(options *cac.InstanceExistOptions) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.ExistReaderCalls = append(fake.ExistReaderCalls, &InstanceExistCall{Options: options})
+	stub := fake.ExistReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// ExistReaderReturns sets ExistReaderStub to a function that returns the given values.
+func (fake *InstanceLocator) ExistReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.ExistReaderStub = func(*cac.InstanceExistOptions) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceExportCall records the arguments of a call to InstanceLocator.Export.
+type InstanceExportCall struct {
+	EndTime   *rsapi.Time
+	StartTime *rsapi.Time
+	Options   *cac.InstanceExportOptions
+}
+
+// Export records the call and returns the results of ExportStub if set.
+func (fake *InstanceLocator) Export(endTime *rsapi.Time, startTime *rsapi.Time, options *cac.InstanceExportOptions) (string, error) {
+	fake.mu.Lock()
+	fake.ExportCalls = append(fake.ExportCalls, &InstanceExportCall{EndTime: endTime, StartTime: startTime, Options: options})
+	stub := fake.ExportStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, startTime, options)
+	}
+	var res string
+	return res, nil
+}
+
+// ExportReturns sets ExportStub to a function that returns the given values.
+func (fake *InstanceLocator) ExportReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.ExportStub = func(*rsapi.Time, *rsapi.Time, *cac.InstanceExportOptions) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ExportReader records the call and returns the results of ExportReaderStub if set.
+func (fake *InstanceLocator) ExportReader(endTime *rsapi.Time, startTime *rsapi.Time, options *cac.InstanceExportOptions) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.ExportReaderCalls = append(fake.ExportReaderCalls, &InstanceExportCall{EndTime: endTime, StartTime: startTime, Options: options})
+	stub := fake.ExportReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, startTime, options)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// ExportReaderReturns sets ExportReaderStub to a function that returns the given values.
+func (fake *InstanceLocator) ExportReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.ExportReaderStub = func(*rsapi.Time, *rsapi.Time, *cac.InstanceExportOptions) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceFilterOptionsCall records the arguments of a call to InstanceLocator.FilterOptions.
+type InstanceFilterOptionsCall struct {
+	EndTime     *rsapi.Time
+	FilterTypes []string
+	StartTime   *rsapi.Time
+	Options     *cac.InstanceFilterOptionsOptions
+}
+
+// FilterOptions records the call and returns the results of FilterOptionsStub if set.
+func (fake *InstanceLocator) FilterOptions(endTime *rsapi.Time, filterTypes []string, startTime *rsapi.Time, options *cac.InstanceFilterOptionsOptions) (*cac.Filter, error) {
+	fake.mu.Lock()
+	fake.FilterOptionsCalls = append(fake.FilterOptionsCalls, &InstanceFilterOptionsCall{EndTime: endTime, FilterTypes: filterTypes, StartTime: startTime, Options: options})
+	stub := fake.FilterOptionsStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, filterTypes, startTime, options)
+	}
+	var res *cac.Filter
+	return res, nil
+}
+
+// FilterOptionsReturns sets FilterOptionsStub to a function that returns the given values.
+func (fake *InstanceLocator) FilterOptionsReturns(res *cac.Filter, err error) {
+	fake.mu.Lock()
+	fake.FilterOptionsStub = func(*rsapi.Time, []string, *rsapi.Time, *cac.InstanceFilterOptionsOptions) (*cac.Filter, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  InstanceCombination ******/
+
+// InstanceCombinationLocator is a fake implementation of cac.InstanceCombinationLocatorInterface.
+type InstanceCombinationLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(string, string, string, string, string, int, *cac.InstanceCombinationCreateOptions) (*cac.InstanceCombinationLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*InstanceCombinationCreateCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cac.InstanceCombinationShowOptions) (*cac.InstanceCombination, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*InstanceCombinationShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cac.InstanceCombinationUpdateOptions) (*cac.InstanceCombination, error)
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*InstanceCombinationUpdateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*InstanceCombinationDestroyCall
+
+	// ReservedInstancePricesStub is called by ReservedInstancePrices if not nil.
+	ReservedInstancePricesStub func(*cac.InstanceCombinationReservedInstancePricesOptions) (*cac.ReservedInstancePurchase, error)
+	// ReservedInstancePricesCalls records the arguments of the calls made to ReservedInstancePrices.
+	ReservedInstancePricesCalls []*InstanceCombinationReservedInstancePricesCall
+}
+
+var _ cac.InstanceCombinationLocatorInterface = (*InstanceCombinationLocator)(nil)
+
+// InstanceCombinationCreateCall records the arguments of a call to InstanceCombinationLocator.Create.
+type InstanceCombinationCreateCall struct {
+	CloudName          string
+	CloudVendorName    string
+	InstanceTypeName   string
+	MonthlyUsageOption string
+	Platform           string
+	Quantity           int
+	Options            *cac.InstanceCombinationCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *InstanceCombinationLocator) Create(cloudName string, cloudVendorName string, instanceTypeName string, monthlyUsageOption string, platform string, quantity int, options *cac.InstanceCombinationCreateOptions) (*cac.InstanceCombinationLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &InstanceCombinationCreateCall{CloudName: cloudName, CloudVendorName: cloudVendorName, InstanceTypeName: instanceTypeName, MonthlyUsageOption: monthlyUsageOption, Platform: platform, Quantity: quantity, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(cloudName, cloudVendorName, instanceTypeName, monthlyUsageOption, platform, quantity, options)
+	}
+	var res *cac.InstanceCombinationLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *InstanceCombinationLocator) CreateReturns(res *cac.InstanceCombinationLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(string, string, string, string, string, int, *cac.InstanceCombinationCreateOptions) (*cac.InstanceCombinationLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceCombinationShowCall records the arguments of a call to InstanceCombinationLocator.Show.
+type InstanceCombinationShowCall struct {
+	Options *cac.InstanceCombinationShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *InstanceCombinationLocator) Show(options *cac.InstanceCombinationShowOptions) (*cac.InstanceCombination, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &InstanceCombinationShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.InstanceCombination
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *InstanceCombinationLocator) ShowReturns(res *cac.InstanceCombination, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cac.InstanceCombinationShowOptions) (*cac.InstanceCombination, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceCombinationUpdateCall records the arguments of a call to InstanceCombinationLocator.Update.
+type InstanceCombinationUpdateCall struct {
+	Options *cac.InstanceCombinationUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *InstanceCombinationLocator) Update(options *cac.InstanceCombinationUpdateOptions) (*cac.InstanceCombination, error) {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &InstanceCombinationUpdateCall{Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.InstanceCombination
+	return res, nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *InstanceCombinationLocator) UpdateReturns(res *cac.InstanceCombination, err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cac.InstanceCombinationUpdateOptions) (*cac.InstanceCombination, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceCombinationDestroyCall records the arguments of a call to InstanceCombinationLocator.Destroy.
+type InstanceCombinationDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *InstanceCombinationLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &InstanceCombinationDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *InstanceCombinationLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceCombinationReservedInstancePricesCall records the arguments of a call to InstanceCombinationLocator.ReservedInstancePrices.
+type InstanceCombinationReservedInstancePricesCall struct {
+	Options *cac.InstanceCombinationReservedInstancePricesOptions
+}
+
+// ReservedInstancePrices records the call and returns the results of ReservedInstancePricesStub if set.
+func (fake *InstanceCombinationLocator) ReservedInstancePrices(options *cac.InstanceCombinationReservedInstancePricesOptions) (*cac.ReservedInstancePurchase, error) {
+	fake.mu.Lock()
+	fake.ReservedInstancePricesCalls = append(fake.ReservedInstancePricesCalls, &InstanceCombinationReservedInstancePricesCall{Options: options})
+	stub := fake.ReservedInstancePricesStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.ReservedInstancePurchase
+	return res, nil
+}
+
+// ReservedInstancePricesReturns sets ReservedInstancePricesStub to a function that returns the given values.
+func (fake *InstanceCombinationLocator) ReservedInstancePricesReturns(res *cac.ReservedInstancePurchase, err error) {
+	fake.mu.Lock()
+	fake.ReservedInstancePricesStub = func(*cac.InstanceCombinationReservedInstancePricesOptions) (*cac.ReservedInstancePurchase, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  InstanceMetric ******/
+
+// InstanceMetricLocator is a fake implementation of cac.InstanceMetricLocatorInterface.
+type InstanceMetricLocator struct {
+	mu sync.Mutex
+
+	// OverallStub is called by Overall if not nil.
+	OverallStub func(*rsapi.Time, []string, *rsapi.Time, *cac.InstanceMetricOverallOptions) (*cac.MetricsResult, error)
+	// OverallCalls records the arguments of the calls made to Overall.
+	OverallCalls []*InstanceMetricOverallCall
+
+	// GroupedOverallStub is called by GroupedOverall if not nil.
+	GroupedOverallStub func(*rsapi.Time, []string, []string, *rsapi.Time, *cac.InstanceMetricGroupedOverallOptions) (*cac.MetricsResult, error)
+	// GroupedOverallCalls records the arguments of the calls made to GroupedOverall.
+	GroupedOverallCalls []*InstanceMetricGroupedOverallCall
+
+	// TimeSeriesStub is called by TimeSeries if not nil.
+	TimeSeriesStub func(*rsapi.Time, string, []string, *rsapi.Time, *cac.InstanceMetricTimeSeriesOptions) (*cac.TimeSeriesMetricsResult, error)
+	// TimeSeriesCalls records the arguments of the calls made to TimeSeries.
+	TimeSeriesCalls []*InstanceMetricTimeSeriesCall
+
+	// GroupedTimeSeriesStub is called by GroupedTimeSeries if not nil.
+	GroupedTimeSeriesStub func(*rsapi.Time, string, []string, []string, *rsapi.Time, *cac.InstanceMetricGroupedTimeSeriesOptions) (*cac.TimeSeriesMetricsResult, error)
+	// GroupedTimeSeriesCalls records the arguments of the calls made to GroupedTimeSeries.
+	GroupedTimeSeriesCalls []*InstanceMetricGroupedTimeSeriesCall
+
+	// CurrentCountStub is called by CurrentCount if not nil.
+	CurrentCountStub func(*cac.InstanceMetricCurrentCountOptions) (string, error)
+	// CurrentCountCalls records the arguments of the calls made to CurrentCount.
+	CurrentCountCalls []*InstanceMetricCurrentCountCall
+
+	// CurrentCountReaderStub is called by CurrentCountReader if not nil.
+	CurrentCountReaderStub func(*cac.InstanceMetricCurrentCountOptions) (io.ReadCloser, error)
+	// CurrentCountReaderCalls records the arguments of the calls made to CurrentCountReader.
+	CurrentCountReaderCalls []*InstanceMetricCurrentCountCall
+}
+
+var _ cac.InstanceMetricLocatorInterface = (*InstanceMetricLocator)(nil)
+
+// InstanceMetricOverallCall records the arguments of a call to InstanceMetricLocator.Overall.
+type InstanceMetricOverallCall struct {
+	EndTime   *rsapi.Time
+	Metrics   []string
+	StartTime *rsapi.Time
+	Options   *cac.InstanceMetricOverallOptions
+}
+
+// Overall records the call and returns the results of OverallStub if set.
+func (fake *InstanceMetricLocator) Overall(endTime *rsapi.Time, metrics []string, startTime *rsapi.Time, options *cac.InstanceMetricOverallOptions) (*cac.MetricsResult, error) {
+	fake.mu.Lock()
+	fake.OverallCalls = append(fake.OverallCalls, &InstanceMetricOverallCall{EndTime: endTime, Metrics: metrics, StartTime: startTime, Options: options})
+	stub := fake.OverallStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, metrics, startTime, options)
+	}
+	var res *cac.MetricsResult
+	return res, nil
+}
+
+// OverallReturns sets OverallStub to a function that returns the given values.
+func (fake *InstanceMetricLocator) OverallReturns(res *cac.MetricsResult, err error) {
+	fake.mu.Lock()
+	fake.OverallStub = func(*rsapi.Time, []string, *rsapi.Time, *cac.InstanceMetricOverallOptions) (*cac.MetricsResult, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceMetricGroupedOverallCall records the arguments of a call to InstanceMetricLocator.GroupedOverall.
+type InstanceMetricGroupedOverallCall struct {
+	EndTime   *rsapi.Time
+	Group     []string
+	Metrics   []string
+	StartTime *rsapi.Time
+	Options   *cac.InstanceMetricGroupedOverallOptions
+}
+
+// GroupedOverall records the call and returns the results of GroupedOverallStub if set.
+func (fake *InstanceMetricLocator) GroupedOverall(endTime *rsapi.Time, group []string, metrics []string, startTime *rsapi.Time, options *cac.InstanceMetricGroupedOverallOptions) (*cac.MetricsResult, error) {
+	fake.mu.Lock()
+	fake.GroupedOverallCalls = append(fake.GroupedOverallCalls, &InstanceMetricGroupedOverallCall{EndTime: endTime, Group: group, Metrics: metrics, StartTime: startTime, Options: options})
+	stub := fake.GroupedOverallStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, group, metrics, startTime, options)
+	}
+	var res *cac.MetricsResult
+	return res, nil
+}
+
+// GroupedOverallReturns sets GroupedOverallStub to a function that returns the given values.
+func (fake *InstanceMetricLocator) GroupedOverallReturns(res *cac.MetricsResult, err error) {
+	fake.mu.Lock()
+	fake.GroupedOverallStub = func(*rsapi.Time, []string, []string, *rsapi.Time, *cac.InstanceMetricGroupedOverallOptions) (*cac.MetricsResult, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceMetricTimeSeriesCall records the arguments of a call to InstanceMetricLocator.TimeSeries.
+type InstanceMetricTimeSeriesCall struct {
+	EndTime     *rsapi.Time
+	Granularity string
+	Metrics     []string
+	StartTime   *rsapi.Time
+	Options     *cac.InstanceMetricTimeSeriesOptions
+}
+
+// TimeSeries records the call and returns the results of TimeSeriesStub if set.
+func (fake *InstanceMetricLocator) TimeSeries(endTime *rsapi.Time, granularity string, metrics []string, startTime *rsapi.Time, options *cac.InstanceMetricTimeSeriesOptions) (*cac.TimeSeriesMetricsResult, error) {
+	fake.mu.Lock()
+	fake.TimeSeriesCalls = append(fake.TimeSeriesCalls, &InstanceMetricTimeSeriesCall{EndTime: endTime, Granularity: granularity, Metrics: metrics, StartTime: startTime, Options: options})
+	stub := fake.TimeSeriesStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, granularity, metrics, startTime, options)
+	}
+	var res *cac.TimeSeriesMetricsResult
+	return res, nil
+}
+
+// TimeSeriesReturns sets TimeSeriesStub to a function that returns the given values.
+func (fake *InstanceMetricLocator) TimeSeriesReturns(res *cac.TimeSeriesMetricsResult, err error) {
+	fake.mu.Lock()
+	fake.TimeSeriesStub = func(*rsapi.Time, string, []string, *rsapi.Time, *cac.InstanceMetricTimeSeriesOptions) (*cac.TimeSeriesMetricsResult, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceMetricGroupedTimeSeriesCall records the arguments of a call to InstanceMetricLocator.GroupedTimeSeries.
+type InstanceMetricGroupedTimeSeriesCall struct {
+	EndTime     *rsapi.Time
+	Granularity string
+	Group       []string
+	Metrics     []string
+	StartTime   *rsapi.Time
+	Options     *cac.InstanceMetricGroupedTimeSeriesOptions
+}
+
+// GroupedTimeSeries records the call and returns the results of GroupedTimeSeriesStub if set.
+func (fake *InstanceMetricLocator) GroupedTimeSeries(endTime *rsapi.Time, granularity string, group []string, metrics []string, startTime *rsapi.Time, options *cac.InstanceMetricGroupedTimeSeriesOptions) (*cac.TimeSeriesMetricsResult, error) {
+	fake.mu.Lock()
+	fake.GroupedTimeSeriesCalls = append(fake.GroupedTimeSeriesCalls, &InstanceMetricGroupedTimeSeriesCall{EndTime: endTime, Granularity: granularity, Group: group, Metrics: metrics, StartTime: startTime, Options: options})
+	stub := fake.GroupedTimeSeriesStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, granularity, group, metrics, startTime, options)
+	}
+	var res *cac.TimeSeriesMetricsResult
+	return res, nil
+}
+
+// GroupedTimeSeriesReturns sets GroupedTimeSeriesStub to a function that returns the given values.
+func (fake *InstanceMetricLocator) GroupedTimeSeriesReturns(res *cac.TimeSeriesMetricsResult, err error) {
+	fake.mu.Lock()
+	fake.GroupedTimeSeriesStub = func(*rsapi.Time, string, []string, []string, *rsapi.Time, *cac.InstanceMetricGroupedTimeSeriesOptions) (*cac.TimeSeriesMetricsResult, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceMetricCurrentCountCall records the arguments of a call to InstanceMetricLocator.CurrentCount.
+type InstanceMetricCurrentCountCall struct {
+	Options *cac.InstanceMetricCurrentCountOptions
+}
+
+// CurrentCount records the call and returns the results of CurrentCountStub if set.
+func (fake *InstanceMetricLocator) CurrentCount(options *cac.InstanceMetricCurrentCountOptions) (string, error) {
+	fake.mu.Lock()
+	fake.CurrentCountCalls = append(fake.CurrentCountCalls, &InstanceMetricCurrentCountCall{Options: options})
+	stub := fake.CurrentCountStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res string
+	return res, nil
+}
+
+// CurrentCountReturns sets CurrentCountStub to a function that returns the given values.
+func (fake *InstanceMetricLocator) CurrentCountReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.CurrentCountStub = func(*cac.InstanceMetricCurrentCountOptions) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CurrentCountReader records the call and returns the results of CurrentCountReaderStub if set.
+func (fake *InstanceMetricLocator) CurrentCountReader(options *cac.InstanceMetricCurrentCountOptions) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.CurrentCountReaderCalls = append(fake.CurrentCountReaderCalls, &InstanceMetricCurrentCountCall{Options: options})
+	stub := fake.CurrentCountReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// CurrentCountReaderReturns sets CurrentCountReaderStub to a function that returns the given values.
+func (fake *InstanceMetricLocator) CurrentCountReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.CurrentCountReaderStub = func(*cac.InstanceMetricCurrentCountOptions) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  InstanceUsagePeriod ******/
+
+// InstanceUsagePeriodLocator is a fake implementation of cac.InstanceUsagePeriodLocatorInterface.
+type InstanceUsagePeriodLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func([]*cac.Filter, *cac.InstanceUsagePeriodIndexOptions) (*cac.InstanceUsagePeriod, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*InstanceUsagePeriodIndexCall
+}
+
+var _ cac.InstanceUsagePeriodLocatorInterface = (*InstanceUsagePeriodLocator)(nil)
+
+// InstanceUsagePeriodIndexCall records the arguments of a call to InstanceUsagePeriodLocator.Index.
+type InstanceUsagePeriodIndexCall struct {
+	InstanceUsagePeriodFilters []*cac.Filter
+	Options                    *cac.InstanceUsagePeriodIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *InstanceUsagePeriodLocator) Index(instanceUsagePeriodFilters []*cac.Filter, options *cac.InstanceUsagePeriodIndexOptions) (*cac.InstanceUsagePeriod, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &InstanceUsagePeriodIndexCall{InstanceUsagePeriodFilters: instanceUsagePeriodFilters, Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(instanceUsagePeriodFilters, options)
+	}
+	var res *cac.InstanceUsagePeriod
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *InstanceUsagePeriodLocator) IndexReturns(res *cac.InstanceUsagePeriod, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func([]*cac.Filter, *cac.InstanceUsagePeriodIndexOptions) (*cac.InstanceUsagePeriod, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Pattern ******/
+
+// PatternLocator is a fake implementation of cac.PatternLocatorInterface.
+type PatternLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(string, string, string, string, float64, string, *cac.PatternCreateOptions) (*cac.PatternLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*PatternCreateCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cac.PatternIndexOptions) (*cac.Pattern, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*PatternIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cac.PatternShowOptions) (*cac.Pattern, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*PatternShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cac.PatternUpdateOptions) (*cac.Pattern, error)
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*PatternUpdateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*PatternDestroyCall
+
+	// CreateDefaultsStub is called by CreateDefaults if not nil.
+	CreateDefaultsStub func(*cac.PatternCreateDefaultsOptions) (*cac.Pattern, error)
+	// CreateDefaultsCalls records the arguments of the calls made to CreateDefaults.
+	CreateDefaultsCalls []*PatternCreateDefaultsCall
+}
+
+var _ cac.PatternLocatorInterface = (*PatternLocator)(nil)
+
+// PatternCreateCall records the arguments of a call to PatternLocator.Create.
+type PatternCreateCall struct {
+	Months    string
+	Name      string
+	Operation string
+	Type_     string
+	Value     float64
+	Years     string
+	Options   *cac.PatternCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *PatternLocator) Create(months string, name string, operation string, type_ string, value float64, years string, options *cac.PatternCreateOptions) (*cac.PatternLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &PatternCreateCall{Months: months, Name: name, Operation: operation, Type_: type_, Value: value, Years: years, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(months, name, operation, type_, value, years, options)
+	}
+	var res *cac.PatternLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *PatternLocator) CreateReturns(res *cac.PatternLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(string, string, string, string, float64, string, *cac.PatternCreateOptions) (*cac.PatternLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PatternIndexCall records the arguments of a call to PatternLocator.Index.
+type PatternIndexCall struct {
+	Options *cac.PatternIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *PatternLocator) Index(options *cac.PatternIndexOptions) (*cac.Pattern, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &PatternIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.Pattern
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *PatternLocator) IndexReturns(res *cac.Pattern, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cac.PatternIndexOptions) (*cac.Pattern, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PatternShowCall records the arguments of a call to PatternLocator.Show.
+type PatternShowCall struct {
+	Options *cac.PatternShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *PatternLocator) Show(options *cac.PatternShowOptions) (*cac.Pattern, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &PatternShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.Pattern
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *PatternLocator) ShowReturns(res *cac.Pattern, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cac.PatternShowOptions) (*cac.Pattern, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PatternUpdateCall records the arguments of a call to PatternLocator.Update.
+type PatternUpdateCall struct {
+	Options *cac.PatternUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *PatternLocator) Update(options *cac.PatternUpdateOptions) (*cac.Pattern, error) {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &PatternUpdateCall{Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.Pattern
+	return res, nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *PatternLocator) UpdateReturns(res *cac.Pattern, err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cac.PatternUpdateOptions) (*cac.Pattern, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PatternDestroyCall records the arguments of a call to PatternLocator.Destroy.
+type PatternDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *PatternLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &PatternDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *PatternLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// PatternCreateDefaultsCall records the arguments of a call to PatternLocator.CreateDefaults.
+type PatternCreateDefaultsCall struct {
+	Options *cac.PatternCreateDefaultsOptions
+}
+
+// CreateDefaults records the call and returns the results of CreateDefaultsStub if set.
+func (fake *PatternLocator) CreateDefaults(options *cac.PatternCreateDefaultsOptions) (*cac.Pattern, error) {
+	fake.mu.Lock()
+	fake.CreateDefaultsCalls = append(fake.CreateDefaultsCalls, &PatternCreateDefaultsCall{Options: options})
+	stub := fake.CreateDefaultsStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.Pattern
+	return res, nil
+}
+
+// CreateDefaultsReturns sets CreateDefaultsStub to a function that returns the given values.
+func (fake *PatternLocator) CreateDefaultsReturns(res *cac.Pattern, err error) {
+	fake.mu.Lock()
+	fake.CreateDefaultsStub = func(*cac.PatternCreateDefaultsOptions) (*cac.Pattern, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  ReservedInstance ******/
+
+// ReservedInstanceLocator is a fake implementation of cac.ReservedInstanceLocatorInterface.
+type ReservedInstanceLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*rsapi.Time, *rsapi.Time, *cac.ReservedInstanceIndexOptions) (*cac.ReservedInstance, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ReservedInstanceIndexCall
+
+	// CountStub is called by Count if not nil.
+	CountStub func(*rsapi.Time, *rsapi.Time, *cac.ReservedInstanceCountOptions) (string, error)
+	// CountCalls records the arguments of the calls made to Count.
+	CountCalls []*ReservedInstanceCountCall
+
+	// CountReaderStub is called by CountReader if not nil.
+	CountReaderStub func(*rsapi.Time, *rsapi.Time, *cac.ReservedInstanceCountOptions) (io.ReadCloser, error)
+	// CountReaderCalls records the arguments of the calls made to CountReader.
+	CountReaderCalls []*ReservedInstanceCountCall
+
+	// ExistStub is called by Exist if not nil.
+	ExistStub func(*cac.ReservedInstanceExistOptions) (string, error)
+	// ExistCalls records the arguments of the calls made to Exist.
+	ExistCalls []*ReservedInstanceExistCall
+
+	// ExistReaderStub is called by ExistReader if not nil.
+	ExistReaderStub func(*cac.ReservedInstanceExistOptions) (io.ReadCloser, error)
+	// ExistReaderCalls records the arguments of the calls made to ExistReader.
+	ExistReaderCalls []*ReservedInstanceExistCall
+
+	// ExportStub is called by Export if not nil.
+	ExportStub func(*rsapi.Time, *rsapi.Time, *cac.ReservedInstanceExportOptions) (string, error)
+	// ExportCalls records the arguments of the calls made to Export.
+	ExportCalls []*ReservedInstanceExportCall
+
+	// ExportReaderStub is called by ExportReader if not nil.
+	ExportReaderStub func(*rsapi.Time, *rsapi.Time, *cac.ReservedInstanceExportOptions) (io.ReadCloser, error)
+	// ExportReaderCalls records the arguments of the calls made to ExportReader.
+	ExportReaderCalls []*ReservedInstanceExportCall
+
+	// FilterOptionsStub is called by FilterOptions if not nil.
+	FilterOptionsStub func(*rsapi.Time, *rsapi.Time, *cac.ReservedInstanceFilterOptionsOptions) (*cac.Filter, error)
+	// FilterOptionsCalls records the arguments of the calls made to FilterOptions.
+	FilterOptionsCalls []*ReservedInstanceFilterOptionsCall
+}
+
+var _ cac.ReservedInstanceLocatorInterface = (*ReservedInstanceLocator)(nil)
+
+// ReservedInstanceIndexCall records the arguments of a call to ReservedInstanceLocator.Index.
+type ReservedInstanceIndexCall struct {
+	EndTime   *rsapi.Time
+	StartTime *rsapi.Time
+	Options   *cac.ReservedInstanceIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ReservedInstanceLocator) Index(endTime *rsapi.Time, startTime *rsapi.Time, options *cac.ReservedInstanceIndexOptions) (*cac.ReservedInstance, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ReservedInstanceIndexCall{EndTime: endTime, StartTime: startTime, Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, startTime, options)
+	}
+	var res *cac.ReservedInstance
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ReservedInstanceLocator) IndexReturns(res *cac.ReservedInstance, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*rsapi.Time, *rsapi.Time, *cac.ReservedInstanceIndexOptions) (*cac.ReservedInstance, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ReservedInstanceCountCall records the arguments of a call to ReservedInstanceLocator.Count.
+type ReservedInstanceCountCall struct {
+	EndTime   *rsapi.Time
+	StartTime *rsapi.Time
+	Options   *cac.ReservedInstanceCountOptions
+}
+
+// Count records the call and returns the results of CountStub if set.
+func (fake *ReservedInstanceLocator) Count(endTime *rsapi.Time, startTime *rsapi.Time, options *cac.ReservedInstanceCountOptions) (string, error) {
+	fake.mu.Lock()
+	fake.CountCalls = append(fake.CountCalls, &ReservedInstanceCountCall{EndTime: endTime, StartTime: startTime, Options: options})
+	stub := fake.CountStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, startTime, options)
+	}
+	var res string
+	return res, nil
+}
+
+// CountReturns sets CountStub to a function that returns the given values.
+func (fake *ReservedInstanceLocator) CountReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.CountStub = func(*rsapi.Time, *rsapi.Time, *cac.ReservedInstanceCountOptions) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CountReader records the call and returns the results of CountReaderStub if set.
+func (fake *ReservedInstanceLocator) CountReader(endTime *rsapi.Time, startTime *rsapi.Time, options *cac.ReservedInstanceCountOptions) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.CountReaderCalls = append(fake.CountReaderCalls, &ReservedInstanceCountCall{EndTime: endTime, StartTime: startTime, Options: options})
+	stub := fake.CountReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, startTime, options)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// CountReaderReturns sets CountReaderStub to a function that returns the given values.
+func (fake *ReservedInstanceLocator) CountReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.CountReaderStub = func(*rsapi.Time, *rsapi.Time, *cac.ReservedInstanceCountOptions) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ReservedInstanceExistCall records the arguments of a call to ReservedInstanceLocator.Exist.
+type ReservedInstanceExistCall struct {
+	Options *cac.ReservedInstanceExistOptions
+}
+
+// Exist records the call and returns the results of ExistStub if set.
+func (fake *ReservedInstanceLocator) Exist(options *cac.ReservedInstanceExistOptions) (string, error) {
+	fake.mu.Lock()
+	fake.ExistCalls = append(fake.ExistCalls, &ReservedInstanceExistCall{Options: options})
+	stub := fake.ExistStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res string
+	return res, nil
+}
+
+// ExistReturns sets ExistStub to a function that returns the given values.
+func (fake *ReservedInstanceLocator) ExistReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.ExistStub = func(*cac.ReservedInstanceExistOptions) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ExistReader records the call and returns the results of ExistReaderStub if set.
+func (fake *ReservedInstanceLocator) ExistReader(options *cac.ReservedInstanceExistOptions) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.ExistReaderCalls = append(fake.ExistReaderCalls, &ReservedInstanceExistCall{Options: options})
+	stub := fake.ExistReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// ExistReaderReturns sets ExistReaderStub to a function that returns the given values.
+func (fake *ReservedInstanceLocator) ExistReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.ExistReaderStub = func(*cac.ReservedInstanceExistOptions) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ReservedInstanceExportCall records the arguments of a call to ReservedInstanceLocator.Export.
+type ReservedInstanceExportCall struct {
+	EndTime   *rsapi.Time
+	StartTime *rsapi.Time
+	Options   *cac.ReservedInstanceExportOptions
+}
+
+// Export records the call and returns the results of ExportStub if set.
+func (fake *ReservedInstanceLocator) Export(endTime *rsapi.Time, startTime *rsapi.Time, options *cac.ReservedInstanceExportOptions) (string, error) {
+	fake.mu.Lock()
+	fake.ExportCalls = append(fake.ExportCalls, &ReservedInstanceExportCall{EndTime: endTime, StartTime: startTime, Options: options})
+	stub := fake.ExportStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, startTime, options)
+	}
+	var res string
+	return res, nil
+}
+
+// ExportReturns sets ExportStub to a function that returns the given values.
+func (fake *ReservedInstanceLocator) ExportReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.ExportStub = func(*rsapi.Time, *rsapi.Time, *cac.ReservedInstanceExportOptions) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ExportReader records the call and returns the results of ExportReaderStub if set.
+func (fake *ReservedInstanceLocator) ExportReader(endTime *rsapi.Time, startTime *rsapi.Time, options *cac.ReservedInstanceExportOptions) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.ExportReaderCalls = append(fake.ExportReaderCalls, &ReservedInstanceExportCall{EndTime: endTime, StartTime: startTime, Options: options})
+	stub := fake.ExportReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, startTime, options)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// ExportReaderReturns sets ExportReaderStub to a function that returns the given values.
+func (fake *ReservedInstanceLocator) ExportReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.ExportReaderStub = func(*rsapi.Time, *rsapi.Time, *cac.ReservedInstanceExportOptions) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ReservedInstanceFilterOptionsCall records the arguments of a call to ReservedInstanceLocator.FilterOptions.
+type ReservedInstanceFilterOptionsCall struct {
+	EndTime   *rsapi.Time
+	StartTime *rsapi.Time
+	Options   *cac.ReservedInstanceFilterOptionsOptions
+}
+
+// FilterOptions records the call and returns the results of FilterOptionsStub if set.
+func (fake *ReservedInstanceLocator) FilterOptions(endTime *rsapi.Time, startTime *rsapi.Time, options *cac.ReservedInstanceFilterOptionsOptions) (*cac.Filter, error) {
+	fake.mu.Lock()
+	fake.FilterOptionsCalls = append(fake.FilterOptionsCalls, &ReservedInstanceFilterOptionsCall{EndTime: endTime, StartTime: startTime, Options: options})
+	stub := fake.FilterOptionsStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endTime, startTime, options)
+	}
+	var res *cac.Filter
+	return res, nil
+}
+
+// FilterOptionsReturns sets FilterOptionsStub to a function that returns the given values.
+func (fake *ReservedInstanceLocator) FilterOptionsReturns(res *cac.Filter, err error) {
+	fake.mu.Lock()
+	fake.FilterOptionsStub = func(*rsapi.Time, *rsapi.Time, *cac.ReservedInstanceFilterOptionsOptions) (*cac.Filter, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  ReservedInstancePurchase ******/
+
+// ReservedInstancePurchaseLocator is a fake implementation of cac.ReservedInstancePurchaseLocatorInterface.
+type ReservedInstancePurchaseLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(bool, int, string, int, *rsapi.Time, *cac.ReservedInstancePurchaseCreateOptions) (*cac.ReservedInstancePurchaseLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*ReservedInstancePurchaseCreateCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cac.ReservedInstancePurchaseIndexOptions) (*cac.ReservedInstancePurchase, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ReservedInstancePurchaseIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cac.ReservedInstancePurchaseShowOptions) (*cac.ReservedInstancePurchase, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ReservedInstancePurchaseShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cac.ReservedInstancePurchaseUpdateOptions) (*cac.ReservedInstancePurchase, error)
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*ReservedInstancePurchaseUpdateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*ReservedInstancePurchaseDestroyCall
+}
+
+var _ cac.ReservedInstancePurchaseLocatorInterface = (*ReservedInstancePurchaseLocator)(nil)
+
+// ReservedInstancePurchaseCreateCall records the arguments of a call to ReservedInstancePurchaseLocator.Create.
+type ReservedInstancePurchaseCreateCall struct {
+	AutoRenew    bool
+	Duration     int
+	OfferingType string
+	Quantity     int
+	StartDate    *rsapi.Time
+	Options      *cac.ReservedInstancePurchaseCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *ReservedInstancePurchaseLocator) Create(autoRenew bool, duration int, offeringType string, quantity int, startDate *rsapi.Time, options *cac.ReservedInstancePurchaseCreateOptions) (*cac.ReservedInstancePurchaseLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &ReservedInstancePurchaseCreateCall{AutoRenew: autoRenew, Duration: duration, OfferingType: offeringType, Quantity: quantity, StartDate: startDate, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(autoRenew, duration, offeringType, quantity, startDate, options)
+	}
+	var res *cac.ReservedInstancePurchaseLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *ReservedInstancePurchaseLocator) CreateReturns(res *cac.ReservedInstancePurchaseLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(bool, int, string, int, *rsapi.Time, *cac.ReservedInstancePurchaseCreateOptions) (*cac.ReservedInstancePurchaseLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ReservedInstancePurchaseIndexCall records the arguments of a call to ReservedInstancePurchaseLocator.Index.
+type ReservedInstancePurchaseIndexCall struct {
+	Options *cac.ReservedInstancePurchaseIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ReservedInstancePurchaseLocator) Index(options *cac.ReservedInstancePurchaseIndexOptions) (*cac.ReservedInstancePurchase, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ReservedInstancePurchaseIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.ReservedInstancePurchase
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ReservedInstancePurchaseLocator) IndexReturns(res *cac.ReservedInstancePurchase, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cac.ReservedInstancePurchaseIndexOptions) (*cac.ReservedInstancePurchase, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ReservedInstancePurchaseShowCall records the arguments of a call to ReservedInstancePurchaseLocator.Show.
+type ReservedInstancePurchaseShowCall struct {
+	Options *cac.ReservedInstancePurchaseShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ReservedInstancePurchaseLocator) Show(options *cac.ReservedInstancePurchaseShowOptions) (*cac.ReservedInstancePurchase, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ReservedInstancePurchaseShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.ReservedInstancePurchase
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ReservedInstancePurchaseLocator) ShowReturns(res *cac.ReservedInstancePurchase, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cac.ReservedInstancePurchaseShowOptions) (*cac.ReservedInstancePurchase, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ReservedInstancePurchaseUpdateCall records the arguments of a call to ReservedInstancePurchaseLocator.Update.
+type ReservedInstancePurchaseUpdateCall struct {
+	Options *cac.ReservedInstancePurchaseUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *ReservedInstancePurchaseLocator) Update(options *cac.ReservedInstancePurchaseUpdateOptions) (*cac.ReservedInstancePurchase, error) {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &ReservedInstancePurchaseUpdateCall{Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.ReservedInstancePurchase
+	return res, nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *ReservedInstancePurchaseLocator) UpdateReturns(res *cac.ReservedInstancePurchase, err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cac.ReservedInstancePurchaseUpdateOptions) (*cac.ReservedInstancePurchase, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ReservedInstancePurchaseDestroyCall records the arguments of a call to ReservedInstancePurchaseLocator.Destroy.
+type ReservedInstancePurchaseDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *ReservedInstancePurchaseLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &ReservedInstancePurchaseDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *ReservedInstancePurchaseLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Scenario ******/
+
+// ScenarioLocator is a fake implementation of cac.ScenarioLocatorInterface.
+type ScenarioLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*rsapi.Time, *cac.ScenarioCreateOptions) (*cac.ScenarioLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*ScenarioCreateCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cac.ScenarioIndexOptions) (*cac.Scenario, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ScenarioIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cac.ScenarioShowOptions) (*cac.Scenario, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ScenarioShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cac.ScenarioUpdateOptions) (*cac.Scenario, error)
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*ScenarioUpdateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*ScenarioDestroyCall
+
+	// ForecastStub is called by Forecast if not nil.
+	ForecastStub func(*cac.ScenarioForecastOptions) (*cac.TimeSeriesMetricsResult, error)
+	// ForecastCalls records the arguments of the calls made to Forecast.
+	ForecastCalls []*ScenarioForecastCall
+}
+
+var _ cac.ScenarioLocatorInterface = (*ScenarioLocator)(nil)
+
+// ScenarioCreateCall records the arguments of a call to ScenarioLocator.Create.
+type ScenarioCreateCall struct {
+	SnapshotTimestamp *rsapi.Time
+	Options           *cac.ScenarioCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *ScenarioLocator) Create(snapshotTimestamp *rsapi.Time, options *cac.ScenarioCreateOptions) (*cac.ScenarioLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &ScenarioCreateCall{SnapshotTimestamp: snapshotTimestamp, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(snapshotTimestamp, options)
+	}
+	var res *cac.ScenarioLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *ScenarioLocator) CreateReturns(res *cac.ScenarioLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*rsapi.Time, *cac.ScenarioCreateOptions) (*cac.ScenarioLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScenarioIndexCall records the arguments of a call to ScenarioLocator.Index.
+type ScenarioIndexCall struct {
+	Options *cac.ScenarioIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ScenarioLocator) Index(options *cac.ScenarioIndexOptions) (*cac.Scenario, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ScenarioIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.Scenario
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ScenarioLocator) IndexReturns(res *cac.Scenario, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cac.ScenarioIndexOptions) (*cac.Scenario, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScenarioShowCall records the arguments of a call to ScenarioLocator.Show.
+type ScenarioShowCall struct {
+	Options *cac.ScenarioShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ScenarioLocator) Show(options *cac.ScenarioShowOptions) (*cac.Scenario, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ScenarioShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.Scenario
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ScenarioLocator) ShowReturns(res *cac.Scenario, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cac.ScenarioShowOptions) (*cac.Scenario, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScenarioUpdateCall records the arguments of a call to ScenarioLocator.Update.
+type ScenarioUpdateCall struct {
+	Options *cac.ScenarioUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *ScenarioLocator) Update(options *cac.ScenarioUpdateOptions) (*cac.Scenario, error) {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &ScenarioUpdateCall{Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.Scenario
+	return res, nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *ScenarioLocator) UpdateReturns(res *cac.Scenario, err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cac.ScenarioUpdateOptions) (*cac.Scenario, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScenarioDestroyCall records the arguments of a call to ScenarioLocator.Destroy.
+type ScenarioDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *ScenarioLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &ScenarioDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *ScenarioLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ScenarioForecastCall records the arguments of a call to ScenarioLocator.Forecast.
+type ScenarioForecastCall struct {
+	Options *cac.ScenarioForecastOptions
+}
+
+// Forecast records the call and returns the results of ForecastStub if set.
+func (fake *ScenarioLocator) Forecast(options *cac.ScenarioForecastOptions) (*cac.TimeSeriesMetricsResult, error) {
+	fake.mu.Lock()
+	fake.ForecastCalls = append(fake.ForecastCalls, &ScenarioForecastCall{Options: options})
+	stub := fake.ForecastStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.TimeSeriesMetricsResult
+	return res, nil
+}
+
+// ForecastReturns sets ForecastStub to a function that returns the given values.
+func (fake *ScenarioLocator) ForecastReturns(res *cac.TimeSeriesMetricsResult, err error) {
+	fake.mu.Lock()
+	fake.ForecastStub = func(*cac.ScenarioForecastOptions) (*cac.TimeSeriesMetricsResult, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  ScheduledReport ******/
+
+// ScheduledReportLocator is a fake implementation of cac.ScheduledReportLocatorInterface.
+type ScheduledReportLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(string, string, *cac.ScheduledReportCreateOptions) (*cac.ScheduledReportLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*ScheduledReportCreateCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cac.ScheduledReportIndexOptions) (*cac.ScheduledReport, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ScheduledReportIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cac.ScheduledReportShowOptions) (*cac.ScheduledReport, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ScheduledReportShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cac.ScheduledReportUpdateOptions) (*cac.ScheduledReport, error)
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*ScheduledReportUpdateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*ScheduledReportDestroyCall
+
+	// CreateDefaultsStub is called by CreateDefaults if not nil.
+	CreateDefaultsStub func(*cac.ScheduledReportCreateDefaultsOptions) (*cac.ScheduledReport, error)
+	// CreateDefaultsCalls records the arguments of the calls made to CreateDefaults.
+	CreateDefaultsCalls []*ScheduledReportCreateDefaultsCall
+}
+
+var _ cac.ScheduledReportLocatorInterface = (*ScheduledReportLocator)(nil)
+
+// ScheduledReportCreateCall records the arguments of a call to ScheduledReportLocator.Create.
+type ScheduledReportCreateCall struct {
+	Frequency string
+	Name      string
+	Options   *cac.ScheduledReportCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *ScheduledReportLocator) Create(frequency string, name string, options *cac.ScheduledReportCreateOptions) (*cac.ScheduledReportLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &ScheduledReportCreateCall{Frequency: frequency, Name: name, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(frequency, name, options)
+	}
+	var res *cac.ScheduledReportLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *ScheduledReportLocator) CreateReturns(res *cac.ScheduledReportLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(string, string, *cac.ScheduledReportCreateOptions) (*cac.ScheduledReportLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduledReportIndexCall records the arguments of a call to ScheduledReportLocator.Index.
+type ScheduledReportIndexCall struct {
+	Options *cac.ScheduledReportIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ScheduledReportLocator) Index(options *cac.ScheduledReportIndexOptions) (*cac.ScheduledReport, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ScheduledReportIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.ScheduledReport
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ScheduledReportLocator) IndexReturns(res *cac.ScheduledReport, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cac.ScheduledReportIndexOptions) (*cac.ScheduledReport, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduledReportShowCall records the arguments of a call to ScheduledReportLocator.Show.
+type ScheduledReportShowCall struct {
+	Options *cac.ScheduledReportShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ScheduledReportLocator) Show(options *cac.ScheduledReportShowOptions) (*cac.ScheduledReport, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ScheduledReportShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.ScheduledReport
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ScheduledReportLocator) ShowReturns(res *cac.ScheduledReport, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cac.ScheduledReportShowOptions) (*cac.ScheduledReport, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduledReportUpdateCall records the arguments of a call to ScheduledReportLocator.Update.
+type ScheduledReportUpdateCall struct {
+	Options *cac.ScheduledReportUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *ScheduledReportLocator) Update(options *cac.ScheduledReportUpdateOptions) (*cac.ScheduledReport, error) {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &ScheduledReportUpdateCall{Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.ScheduledReport
+	return res, nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *ScheduledReportLocator) UpdateReturns(res *cac.ScheduledReport, err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cac.ScheduledReportUpdateOptions) (*cac.ScheduledReport, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduledReportDestroyCall records the arguments of a call to ScheduledReportLocator.Destroy.
+type ScheduledReportDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *ScheduledReportLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &ScheduledReportDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *ScheduledReportLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduledReportCreateDefaultsCall records the arguments of a call to ScheduledReportLocator.CreateDefaults.
+type ScheduledReportCreateDefaultsCall struct {
+	Options *cac.ScheduledReportCreateDefaultsOptions
+}
+
+// CreateDefaults records the call and returns the results of CreateDefaultsStub if set.
+func (fake *ScheduledReportLocator) CreateDefaults(options *cac.ScheduledReportCreateDefaultsOptions) (*cac.ScheduledReport, error) {
+	fake.mu.Lock()
+	fake.CreateDefaultsCalls = append(fake.CreateDefaultsCalls, &ScheduledReportCreateDefaultsCall{Options: options})
+	stub := fake.CreateDefaultsStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.ScheduledReport
+	return res, nil
+}
+
+// CreateDefaultsReturns sets CreateDefaultsStub to a function that returns the given values.
+func (fake *ScheduledReportLocator) CreateDefaultsReturns(res *cac.ScheduledReport, err error) {
+	fake.mu.Lock()
+	fake.CreateDefaultsStub = func(*cac.ScheduledReportCreateDefaultsOptions) (*cac.ScheduledReport, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  TempInstancePrice ******/
+
+// TempInstancePriceLocator is a fake implementation of cac.TempInstancePriceLocatorInterface.
+type TempInstancePriceLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func() (string, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*TempInstancePriceIndexCall
+
+	// IndexReaderStub is called by IndexReader if not nil.
+	IndexReaderStub func() (io.ReadCloser, error)
+	// IndexReaderCalls records the arguments of the calls made to IndexReader.
+	IndexReaderCalls []*TempInstancePriceIndexCall
+}
+
+var _ cac.TempInstancePriceLocatorInterface = (*TempInstancePriceLocator)(nil)
+
+// TempInstancePriceIndexCall records the arguments of a call to TempInstancePriceLocator.Index.
+type TempInstancePriceIndexCall struct{}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *TempInstancePriceLocator) Index() (string, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &TempInstancePriceIndexCall{})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res string
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *TempInstancePriceLocator) IndexReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func() (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// IndexReader records the call and returns the results of IndexReaderStub if set.
+func (fake *TempInstancePriceLocator) IndexReader() (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.IndexReaderCalls = append(fake.IndexReaderCalls, &TempInstancePriceIndexCall{})
+	stub := fake.IndexReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// IndexReaderReturns sets IndexReaderStub to a function that returns the given values.
+func (fake *TempInstancePriceLocator) IndexReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.IndexReaderStub = func() (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  User ******/
+
+// UserLocator is a fake implementation of cac.UserLocatorInterface.
+type UserLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func([]*cac.UserAccounts, string, *cac.UserCreateOptions) (*cac.UserLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*UserCreateCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cac.UserIndexOptions) (*cac.User, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*UserIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cac.UserShowOptions) (*cac.User, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*UserShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cac.UserUpdateOptions) (*cac.User, error)
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*UserUpdateCall
+
+	// InviteStub is called by Invite if not nil.
+	InviteStub func(*cac.UserInviteOptions) (*cac.User, error)
+	// InviteCalls records the arguments of the calls made to Invite.
+	InviteCalls []*UserInviteCall
+}
+
+var _ cac.UserLocatorInterface = (*UserLocator)(nil)
+
+// UserCreateCall records the arguments of a call to UserLocator.Create.
+type UserCreateCall struct {
+	Accounts []*cac.UserAccounts
+	Email    string
+	Options  *cac.UserCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *UserLocator) Create(accounts []*cac.UserAccounts, email string, options *cac.UserCreateOptions) (*cac.UserLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &UserCreateCall{Accounts: accounts, Email: email, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(accounts, email, options)
+	}
+	var res *cac.UserLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *UserLocator) CreateReturns(res *cac.UserLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func([]*cac.UserAccounts, string, *cac.UserCreateOptions) (*cac.UserLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UserIndexCall records the arguments of a call to UserLocator.Index.
+type UserIndexCall struct {
+	Options *cac.UserIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *UserLocator) Index(options *cac.UserIndexOptions) (*cac.User, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &UserIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.User
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *UserLocator) IndexReturns(res *cac.User, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cac.UserIndexOptions) (*cac.User, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UserShowCall records the arguments of a call to UserLocator.Show.
+type UserShowCall struct {
+	Options *cac.UserShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *UserLocator) Show(options *cac.UserShowOptions) (*cac.User, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &UserShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.User
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *UserLocator) ShowReturns(res *cac.User, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cac.UserShowOptions) (*cac.User, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UserUpdateCall records the arguments of a call to UserLocator.Update.
+type UserUpdateCall struct {
+	Options *cac.UserUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *UserLocator) Update(options *cac.UserUpdateOptions) (*cac.User, error) {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &UserUpdateCall{Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.User
+	return res, nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *UserLocator) UpdateReturns(res *cac.User, err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cac.UserUpdateOptions) (*cac.User, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UserInviteCall records the arguments of a call to UserLocator.Invite.
+type UserInviteCall struct {
+	Options *cac.UserInviteOptions
+}
+
+// Invite records the call and returns the results of InviteStub if set.
+func (fake *UserLocator) Invite(options *cac.UserInviteOptions) (*cac.User, error) {
+	fake.mu.Lock()
+	fake.InviteCalls = append(fake.InviteCalls, &UserInviteCall{Options: options})
+	stub := fake.InviteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.User
+	return res, nil
+}
+
+// InviteReturns sets InviteStub to a function that returns the given values.
+func (fake *UserLocator) InviteReturns(res *cac.User, err error) {
+	fake.mu.Lock()
+	fake.InviteStub = func(*cac.UserInviteOptions) (*cac.User, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  UserSetting ******/
+
+// UserSettingLocator is a fake implementation of cac.UserSettingLocatorInterface.
+type UserSettingLocator struct {
+	mu sync.Mutex
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cac.UserSettingShowOptions) (*cac.UserSetting, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*UserSettingShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cac.UserSettingUpdateOptions) (*cac.UserSetting, error)
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*UserSettingUpdateCall
+}
+
+var _ cac.UserSettingLocatorInterface = (*UserSettingLocator)(nil)
+
+// UserSettingShowCall records the arguments of a call to UserSettingLocator.Show.
+type UserSettingShowCall struct {
+	Options *cac.UserSettingShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *UserSettingLocator) Show(options *cac.UserSettingShowOptions) (*cac.UserSetting, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &UserSettingShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.UserSetting
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *UserSettingLocator) ShowReturns(res *cac.UserSetting, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cac.UserSettingShowOptions) (*cac.UserSetting, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UserSettingUpdateCall records the arguments of a call to UserSettingLocator.Update.
+type UserSettingUpdateCall struct {
+	Options *cac.UserSettingUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *UserSettingLocator) Update(options *cac.UserSettingUpdateOptions) (*cac.UserSetting, error) {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &UserSettingUpdateCall{Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cac.UserSetting
+	return res, nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *UserSettingLocator) UpdateReturns(res *cac.UserSetting, err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cac.UserSettingUpdateOptions) (*cac.UserSetting, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
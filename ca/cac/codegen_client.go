@@ -12,8 +12,8 @@ package cac
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
-	"time"
 
 	"github.com/rightscale/rsc/metadata"
 	"github.com/rightscale/rsc/rsapi"
@@ -76,20 +76,63 @@ func (api *API) AccountLocator(href string) *AccountLocator {
 	return &AccountLocator{Href(href), api}
 }
 
+// AccountLocatorInterface lists the AccountLocator methods, code that depends on the interface
+// rather than on AccountLocator can be unit tested using the generated fake locators.
+type AccountLocatorInterface interface {
+	Create(options *AccountCreateOptions) (*AccountLocator, error)
+	Index(options *AccountIndexOptions) (*Account, error)
+	Show(options *AccountShowOptions) (*Account, error)
+}
+
 //===== Actions
 
+// AccountCreateOptions contains the optional parameters of AccountLocator.Create.
+type AccountCreateOptions struct {
+	Dunno string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AccountCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "dunno":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AccountLocator.Create", n)
+		}
+	}
+	if o.Dunno != "" {
+		p["dunno"] = o.Dunno
+	}
+	return p, nil
+}
+
 // POST /api/accounts
 //
 // Create a new child account.
-func (loc *AccountLocator) Create(options rsapi.APIParams) (*AccountLocator, error) {
+func (loc *AccountLocator) Create(options *AccountCreateOptions) (*AccountLocator, error) {
 	var res *AccountLocator
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var dunnoOpt = options["dunno"]
+	var dunnoOpt = opts["dunno"]
 	if dunnoOpt != nil {
 		p["dunno"] = dunnoOpt
 	}
+	if err := loc.api.ValidateParams("Account", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Account", "create")
 	if err != nil {
 		return res, err
@@ -102,15 +145,10 @@ func (loc *AccountLocator) Create(options rsapi.APIParams) (*AccountLocator, err
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -119,18 +157,53 @@ func (loc *AccountLocator) Create(options rsapi.APIParams) (*AccountLocator, err
 	}
 }
 
+// AccountIndexOptions contains the optional parameters of AccountLocator.Index.
+type AccountIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AccountIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AccountLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/accounts
 //
 // List all accounts.
-func (loc *AccountLocator) Index(options rsapi.APIParams) (*Account, error) {
+func (loc *AccountLocator) Index(options *AccountIndexOptions) (*Account, error) {
 	var res *Account
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Account", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Account", "index")
 	if err != nil {
 		return res, err
@@ -143,36 +216,61 @@ func (loc *AccountLocator) Index(options rsapi.APIParams) (*Account, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// AccountShowOptions contains the optional parameters of AccountLocator.Show.
+type AccountShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AccountShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AccountLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/accounts/:id
 //
 // Show a specific account.
-func (loc *AccountLocator) Show(options rsapi.APIParams) (*Account, error) {
+func (loc *AccountLocator) Show(options *AccountShowOptions) (*Account, error) {
 	var res *Account
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Account", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Account", "show")
 	if err != nil {
 		return res, err
@@ -185,21 +283,11 @@ func (loc *AccountLocator) Show(options rsapi.APIParams) (*Account, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -222,19 +310,89 @@ func (api *API) AnalysisSnapshotLocator(href string) *AnalysisSnapshotLocator {
 	return &AnalysisSnapshotLocator{Href(href), api}
 }
 
+// AnalysisSnapshotLocatorInterface lists the AnalysisSnapshotLocator methods, code that depends on the interface
+// rather than on AnalysisSnapshotLocator can be unit tested using the generated fake locators.
+type AnalysisSnapshotLocatorInterface interface {
+	Create(endTime *rsapi.Time, granularity string, startTime *rsapi.Time, options *AnalysisSnapshotCreateOptions) (*AnalysisSnapshotLocator, error)
+	Show(options *AnalysisSnapshotShowOptions) (*AnalysisSnapshot, error)
+}
+
 //===== Actions
 
+// AnalysisSnapshotCreateOptions contains the optional parameters of AnalysisSnapshotLocator.Create.
+type AnalysisSnapshotCreateOptions struct {
+	View string
+	// Used by the Cloud Analytics UI to disable tag types in the tags module.
+	ExcludedTagTypes []string
+	// Filters used to create the snapshot
+	Filters []*Filter
+	// Whether the snapshot should return comparison data for the previous date range.
+	IsComparison bool
+	// Metrics that should be included in the snapshot.
+	Metrics []string
+	// Used by the Cloud Analytics UI to store the state of the snapshot modules based on the state of the Analyze page modules.
+	ModuleStates []*ModuleState
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AnalysisSnapshotCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "excluded_tag_types", "filters", "is_comparison", "metrics", "module_states":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AnalysisSnapshotLocator.Create", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.ExcludedTagTypes != nil {
+		p["excluded_tag_types"] = o.ExcludedTagTypes
+	}
+	if o.Filters != nil {
+		p["filters"] = o.Filters
+	}
+	if o.IsComparison {
+		p["is_comparison"] = o.IsComparison
+	}
+	if o.Metrics != nil {
+		p["metrics"] = o.Metrics
+	}
+	if o.ModuleStates != nil {
+		p["module_states"] = o.ModuleStates
+	}
+	return p, nil
+}
+
 // POST /api/analysis_snapshots
 //
 // Create a new AnalysisSnapshot.
-func (loc *AnalysisSnapshotLocator) Create(endTime *time.Time, granularity string, startTime *time.Time, options rsapi.APIParams) (*AnalysisSnapshotLocator, error) {
+func (loc *AnalysisSnapshotLocator) Create(endTime *rsapi.Time, granularity string, startTime *rsapi.Time, options *AnalysisSnapshotCreateOptions) (*AnalysisSnapshotLocator, error) {
 	var res *AnalysisSnapshotLocator
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
 	if granularity == "" {
 		return res, fmt.Errorf("granularity is required")
 	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
@@ -244,26 +402,29 @@ func (loc *AnalysisSnapshotLocator) Create(endTime *time.Time, granularity strin
 		"granularity": granularity,
 		"start_time":  startTime,
 	}
-	var excludedTagTypesOpt = options["excluded_tag_types"]
+	var excludedTagTypesOpt = opts["excluded_tag_types"]
 	if excludedTagTypesOpt != nil {
 		p["excluded_tag_types"] = excludedTagTypesOpt
 	}
-	var filtersOpt = options["filters"]
+	var filtersOpt = opts["filters"]
 	if filtersOpt != nil {
 		p["filters"] = filtersOpt
 	}
-	var isComparisonOpt = options["is_comparison"]
+	var isComparisonOpt = opts["is_comparison"]
 	if isComparisonOpt != nil {
 		p["is_comparison"] = isComparisonOpt
 	}
-	var metricsOpt = options["metrics"]
+	var metricsOpt = opts["metrics"]
 	if metricsOpt != nil {
 		p["metrics"] = metricsOpt
 	}
-	var moduleStatesOpt = options["module_states"]
+	var moduleStatesOpt = opts["module_states"]
 	if moduleStatesOpt != nil {
 		p["module_states"] = moduleStatesOpt
 	}
+	if err := loc.api.ValidateParams("AnalysisSnapshot", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AnalysisSnapshot", "create")
 	if err != nil {
 		return res, err
@@ -276,15 +437,10 @@ func (loc *AnalysisSnapshotLocator) Create(endTime *time.Time, granularity strin
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -293,18 +449,53 @@ func (loc *AnalysisSnapshotLocator) Create(endTime *time.Time, granularity strin
 	}
 }
 
+// AnalysisSnapshotShowOptions contains the optional parameters of AnalysisSnapshotLocator.Show.
+type AnalysisSnapshotShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AnalysisSnapshotShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AnalysisSnapshotLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/analysis_snapshots/:uuid
 //
 // Show a specific AnalysisSnapshot.
-func (loc *AnalysisSnapshotLocator) Show(options rsapi.APIParams) (*AnalysisSnapshot, error) {
+func (loc *AnalysisSnapshotLocator) Show(options *AnalysisSnapshotShowOptions) (*AnalysisSnapshot, error) {
 	var res *AnalysisSnapshot
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AnalysisSnapshot", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AnalysisSnapshot", "show")
 	if err != nil {
 		return res, err
@@ -317,21 +508,11 @@ func (loc *AnalysisSnapshotLocator) Show(options rsapi.APIParams) (*AnalysisSnap
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -356,12 +537,65 @@ func (api *API) BudgetAlertLocator(href string) *BudgetAlertLocator {
 	return &BudgetAlertLocator{Href(href), api}
 }
 
+// BudgetAlertLocatorInterface lists the BudgetAlertLocator methods, code that depends on the interface
+// rather than on BudgetAlertLocator can be unit tested using the generated fake locators.
+type BudgetAlertLocatorInterface interface {
+	Create(budget *BudgetStruct, frequency string, name string, type_ string, options *BudgetAlertCreateOptions) (*BudgetAlertLocator, error)
+	Index(options *BudgetAlertIndexOptions) (*BudgetAlert, error)
+	Show(options *BudgetAlertShowOptions) (*BudgetAlert, error)
+	Update(options *BudgetAlertUpdateOptions) (*BudgetAlert, error)
+	Destroy() error
+}
+
 //===== Actions
 
+// BudgetAlertCreateOptions contains the optional parameters of BudgetAlertLocator.Create.
+type BudgetAlertCreateOptions struct {
+	View string
+	// In addition to your email, the report will be sent to these additional email addresses.
+	AdditionalEmails []string
+	// Whether the emails should include a CSV attachement of the instance data.
+	AttachCsv bool
+	// Filters to use for the BudgetAlert.
+	Filters []*Filter
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *BudgetAlertCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "additional_emails", "attach_csv", "filters":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of BudgetAlertLocator.Create", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.AdditionalEmails != nil {
+		p["additional_emails"] = o.AdditionalEmails
+	}
+	if o.AttachCsv {
+		p["attach_csv"] = o.AttachCsv
+	}
+	if o.Filters != nil {
+		p["filters"] = o.Filters
+	}
+	return p, nil
+}
+
 // POST /api/budget_alerts
 //
 // Create a new BudgetAlert.
-func (loc *BudgetAlertLocator) Create(budget *BudgetStruct, frequency string, name string, type_ string, options rsapi.APIParams) (*BudgetAlertLocator, error) {
+func (loc *BudgetAlertLocator) Create(budget *BudgetStruct, frequency string, name string, type_ string, options *BudgetAlertCreateOptions) (*BudgetAlertLocator, error) {
 	var res *BudgetAlertLocator
 	if budget == nil {
 		return res, fmt.Errorf("budget is required")
@@ -375,9 +609,13 @@ func (loc *BudgetAlertLocator) Create(budget *BudgetStruct, frequency string, na
 	if type_ == "" {
 		return res, fmt.Errorf("type_ is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
@@ -388,18 +626,21 @@ func (loc *BudgetAlertLocator) Create(budget *BudgetStruct, frequency string, na
 		"name":      name,
 		"type":      type_,
 	}
-	var additionalEmailsOpt = options["additional_emails"]
+	var additionalEmailsOpt = opts["additional_emails"]
 	if additionalEmailsOpt != nil {
 		p["additional_emails"] = additionalEmailsOpt
 	}
-	var attachCsvOpt = options["attach_csv"]
+	var attachCsvOpt = opts["attach_csv"]
 	if attachCsvOpt != nil {
 		p["attach_csv"] = attachCsvOpt
 	}
-	var filtersOpt = options["filters"]
+	var filtersOpt = opts["filters"]
 	if filtersOpt != nil {
 		p["filters"] = filtersOpt
 	}
+	if err := loc.api.ValidateParams("BudgetAlert", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("BudgetAlert", "create")
 	if err != nil {
 		return res, err
@@ -412,15 +653,10 @@ func (loc *BudgetAlertLocator) Create(budget *BudgetStruct, frequency string, na
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -429,18 +665,53 @@ func (loc *BudgetAlertLocator) Create(budget *BudgetStruct, frequency string, na
 	}
 }
 
+// BudgetAlertIndexOptions contains the optional parameters of BudgetAlertLocator.Index.
+type BudgetAlertIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *BudgetAlertIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of BudgetAlertLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/budget_alerts
 //
 // List all BudgetAlerts.
-func (loc *BudgetAlertLocator) Index(options rsapi.APIParams) (*BudgetAlert, error) {
+func (loc *BudgetAlertLocator) Index(options *BudgetAlertIndexOptions) (*BudgetAlert, error) {
 	var res *BudgetAlert
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("BudgetAlert", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("BudgetAlert", "index")
 	if err != nil {
 		return res, err
@@ -453,36 +724,61 @@ func (loc *BudgetAlertLocator) Index(options rsapi.APIParams) (*BudgetAlert, err
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// BudgetAlertShowOptions contains the optional parameters of BudgetAlertLocator.Show.
+type BudgetAlertShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *BudgetAlertShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of BudgetAlertLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/budget_alerts/:id
 //
 // Show a specific BudgetAlert.
-func (loc *BudgetAlertLocator) Show(options rsapi.APIParams) (*BudgetAlert, error) {
+func (loc *BudgetAlertLocator) Show(options *BudgetAlertShowOptions) (*BudgetAlert, error) {
 	var res *BudgetAlert
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("BudgetAlert", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("BudgetAlert", "show")
 	if err != nil {
 		return res, err
@@ -495,61 +791,116 @@ func (loc *BudgetAlertLocator) Show(options rsapi.APIParams) (*BudgetAlert, erro
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// BudgetAlertUpdateOptions contains the optional parameters of BudgetAlertLocator.Update.
+type BudgetAlertUpdateOptions struct {
+	View string
+	// In addition to your email, the report will be sent to these additional email addresses.
+	AdditionalEmails []string
+	// Whether the emails should include a CSV attachement of the instance data.
+	AttachCsv bool
+	// Budget for the alert.
+	Budget *BudgetStruct
+	// The intervals at which alerts should be sent, emails will be sent at most once a day, week or month.
+	Frequency string
+	Name      string
+	// Whether alerts should be triggered when the actual cost exceeds the monthly budget, or when
+	// we forecast the costs going over the monthly budget based on the average daily-cost of the current month.
+	Type_ string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *BudgetAlertUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "additional_emails", "attach_csv", "budget", "frequency", "name", "type":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of BudgetAlertLocator.Update", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.AdditionalEmails != nil {
+		p["additional_emails"] = o.AdditionalEmails
+	}
+	if o.AttachCsv {
+		p["attach_csv"] = o.AttachCsv
+	}
+	if o.Budget != nil {
+		p["budget"] = o.Budget
+	}
+	if o.Frequency != "" {
+		p["frequency"] = o.Frequency
+	}
+	if o.Name != "" {
+		p["name"] = o.Name
+	}
+	if o.Type_ != "" {
+		p["type"] = o.Type_
+	}
+	return p, nil
+}
+
 // PATCH /api/budget_alerts/:id
 //
 // Update the provided attributes of a BudgetAlert.
-func (loc *BudgetAlertLocator) Update(options rsapi.APIParams) (*BudgetAlert, error) {
+func (loc *BudgetAlertLocator) Update(options *BudgetAlertUpdateOptions) (*BudgetAlert, error) {
 	var res *BudgetAlert
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var additionalEmailsOpt = options["additional_emails"]
+	var additionalEmailsOpt = opts["additional_emails"]
 	if additionalEmailsOpt != nil {
 		p["additional_emails"] = additionalEmailsOpt
 	}
-	var attachCsvOpt = options["attach_csv"]
+	var attachCsvOpt = opts["attach_csv"]
 	if attachCsvOpt != nil {
 		p["attach_csv"] = attachCsvOpt
 	}
-	var budgetOpt = options["budget"]
+	var budgetOpt = opts["budget"]
 	if budgetOpt != nil {
 		p["budget"] = budgetOpt
 	}
-	var frequencyOpt = options["frequency"]
+	var frequencyOpt = opts["frequency"]
 	if frequencyOpt != nil {
 		p["frequency"] = frequencyOpt
 	}
-	var nameOpt = options["name"]
+	var nameOpt = opts["name"]
 	if nameOpt != nil {
 		p["name"] = nameOpt
 	}
-	var type_Opt = options["type"]
+	var type_Opt = opts["type"]
 	if type_Opt != nil {
 		p["type"] = type_Opt
 	}
+	if err := loc.api.ValidateParams("BudgetAlert", "update", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("BudgetAlert", "update")
 	if err != nil {
 		return res, err
@@ -562,21 +913,11 @@ func (loc *BudgetAlertLocator) Update(options rsapi.APIParams) (*BudgetAlert, er
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -586,6 +927,9 @@ func (loc *BudgetAlertLocator) Update(options rsapi.APIParams) (*BudgetAlert, er
 func (loc *BudgetAlertLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("BudgetAlert", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("BudgetAlert", "destroy")
 	if err != nil {
 		return err
@@ -598,15 +942,10 @@ func (loc *BudgetAlertLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -629,31 +968,83 @@ func (api *API) CloudBillLocator(href string) *CloudBillLocator {
 	return &CloudBillLocator{Href(href), api}
 }
 
+// CloudBillLocatorInterface lists the CloudBillLocator methods, code that depends on the interface
+// rather than on CloudBillLocator can be unit tested using the generated fake locators.
+type CloudBillLocatorInterface interface {
+	FilterOptions(endTime *rsapi.Time, filterTypes []string, startTime *rsapi.Time, options *CloudBillFilterOptionsOptions) (*Filter, error)
+}
+
 //===== Actions
 
+// CloudBillFilterOptionsOptions contains the optional parameters of CloudBillLocator.FilterOptions.
+type CloudBillFilterOptionsOptions struct {
+	// The filters to apply
+	CloudBillFilters []*Filter
+	View             string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CloudBillFilterOptionsOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "cloud_bill_filters", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CloudBillLocator.FilterOptions", n)
+		}
+	}
+	if o.CloudBillFilters != nil {
+		p["cloud_bill_filters"] = o.CloudBillFilters
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/cloud_bills/actions/filter_options
 //
 // Gets the filter options which can be used for filtering the cloud bill breakdown calls.
-func (loc *CloudBillLocator) FilterOptions(endTime *time.Time, filterTypes []string, startTime *time.Time, options rsapi.APIParams) (*Filter, error) {
+func (loc *CloudBillLocator) FilterOptions(endTime *rsapi.Time, filterTypes []string, startTime *rsapi.Time, options *CloudBillFilterOptionsOptions) (*Filter, error) {
 	var res *Filter
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
 	if len(filterTypes) == 0 {
 		return res, fmt.Errorf("filterTypes is required")
 	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":       endTime,
 		"filter_types[]": filterTypes,
 		"start_time":     startTime,
 	}
-	var cloudBillFiltersOpt = options["cloud_bill_filters"]
+	var cloudBillFiltersOpt = opts["cloud_bill_filters"]
 	if cloudBillFiltersOpt != nil {
 		params["cloud_bill_filters[]"] = cloudBillFiltersOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CloudBill", "filter_options", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("CloudBill", "filter_options")
 	if err != nil {
 		return res, err
@@ -666,21 +1057,11 @@ func (loc *CloudBillLocator) FilterOptions(endTime *time.Time, filterTypes []str
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -703,33 +1084,85 @@ func (api *API) CloudBillMetricLocator(href string) *CloudBillMetricLocator {
 	return &CloudBillMetricLocator{Href(href), api}
 }
 
+// CloudBillMetricLocatorInterface lists the CloudBillMetricLocator methods, code that depends on the interface
+// rather than on CloudBillMetricLocator can be unit tested using the generated fake locators.
+type CloudBillMetricLocatorInterface interface {
+	GroupedTimeSeries(endTime *rsapi.Time, group [][]string, startTime *rsapi.Time, options *CloudBillMetricGroupedTimeSeriesOptions) (*TimeSeriesMetricsResult, error)
+}
+
 //===== Actions
 
+// CloudBillMetricGroupedTimeSeriesOptions contains the optional parameters of CloudBillMetricLocator.GroupedTimeSeries.
+type CloudBillMetricGroupedTimeSeriesOptions struct {
+	// The filters to apply
+	CloudBillFilters []*Filter
+	View             string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CloudBillMetricGroupedTimeSeriesOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "cloud_bill_filters", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CloudBillMetricLocator.GroupedTimeSeries", n)
+		}
+	}
+	if o.CloudBillFilters != nil {
+		p["cloud_bill_filters"] = o.CloudBillFilters
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/cloud_bill_metrics/actions/grouped_time_series
 //
 // Calculates the time series of costs for cloud bills in a time period grouped into monthly
 // time buckets and groups them into specified breakdown categories, e.g. show me cost of my
 // cloud bills per month during the last year grouped by product.
-func (loc *CloudBillMetricLocator) GroupedTimeSeries(endTime *time.Time, group [][]string, startTime *time.Time, options rsapi.APIParams) (*TimeSeriesMetricsResult, error) {
+func (loc *CloudBillMetricLocator) GroupedTimeSeries(endTime *rsapi.Time, group [][]string, startTime *rsapi.Time, options *CloudBillMetricGroupedTimeSeriesOptions) (*TimeSeriesMetricsResult, error) {
 	var res *TimeSeriesMetricsResult
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
 	if len(group) == 0 {
 		return res, fmt.Errorf("group is required")
 	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":   endTime,
 		"group[]":    group,
 		"start_time": startTime,
 	}
-	var cloudBillFiltersOpt = options["cloud_bill_filters"]
+	var cloudBillFiltersOpt = opts["cloud_bill_filters"]
 	if cloudBillFiltersOpt != nil {
 		params["cloud_bill_filters[]"] = cloudBillFiltersOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CloudBillMetric", "grouped_time_series", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("CloudBillMetric", "grouped_time_series")
 	if err != nil {
 		return res, err
@@ -742,21 +1175,11 @@ func (loc *CloudBillMetricLocator) GroupedTimeSeries(endTime *time.Time, group [
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -779,20 +1202,65 @@ func (api *API) CurrentUserLocator(href string) *CurrentUserLocator {
 	return &CurrentUserLocator{Href(href), api}
 }
 
+// CurrentUserLocatorInterface lists the CurrentUserLocator methods, code that depends on the interface
+// rather than on CurrentUserLocator can be unit tested using the generated fake locators.
+type CurrentUserLocatorInterface interface {
+	Show(options *CurrentUserShowOptions) (*CurrentUser, error)
+	Update(password string, options *CurrentUserUpdateOptions) (*CurrentUser, error)
+	CloudAccounts(awsAccessKeyId string, awsAccountNumber string, awsSecretAccessKey string, cloudVendorName string) error
+	OnboardingStatus(options *CurrentUserOnboardingStatusOptions) (*UserOnboardingStatus, error)
+	Environment() (*UserEnvironment, error)
+}
+
 //===== Actions
 
+// CurrentUserShowOptions contains the optional parameters of CurrentUserLocator.Show.
+type CurrentUserShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CurrentUserShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CurrentUserLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/current_user
 //
 // Show the user's details.
-func (loc *CurrentUserLocator) Show(options rsapi.APIParams) (*CurrentUser, error) {
+func (loc *CurrentUserLocator) Show(options *CurrentUserShowOptions) (*CurrentUser, error) {
 	var res *CurrentUser
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CurrentUser", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("CurrentUser", "show")
 	if err != nil {
 		return res, err
@@ -805,35 +1273,92 @@ func (loc *CurrentUserLocator) Show(options rsapi.APIParams) (*CurrentUser, erro
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// CurrentUserUpdateOptions contains the optional parameters of CurrentUserLocator.Update.
+type CurrentUserUpdateOptions struct {
+	View string
+	// The company of the user.
+	Company string
+	// Email address of the user.
+	Email string
+	// First name of the user.
+	FirstName string
+	// Last name of the user.
+	LastName string
+	// Use this to update change the password.
+	NewPassword string
+	// The phone number of the user.
+	Phone string
+	// The time zone of the user, can be any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CurrentUserUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "company", "email", "first_name", "last_name", "new_password", "phone", "timezone":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CurrentUserLocator.Update", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.Company != "" {
+		p["company"] = o.Company
+	}
+	if o.Email != "" {
+		p["email"] = o.Email
+	}
+	if o.FirstName != "" {
+		p["first_name"] = o.FirstName
+	}
+	if o.LastName != "" {
+		p["last_name"] = o.LastName
+	}
+	if o.NewPassword != "" {
+		p["new_password"] = o.NewPassword
+	}
+	if o.Phone != "" {
+		p["phone"] = o.Phone
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	return p, nil
+}
+
 // PATCH /api/current_user
 //
 // Update the user's details.
-func (loc *CurrentUserLocator) Update(password string, options rsapi.APIParams) (*CurrentUser, error) {
+func (loc *CurrentUserLocator) Update(password string, options *CurrentUserUpdateOptions) (*CurrentUser, error) {
 	var res *CurrentUser
 	if password == "" {
 		return res, fmt.Errorf("password is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
@@ -841,34 +1366,37 @@ func (loc *CurrentUserLocator) Update(password string, options rsapi.APIParams)
 	p = rsapi.APIParams{
 		"password": password,
 	}
-	var companyOpt = options["company"]
+	var companyOpt = opts["company"]
 	if companyOpt != nil {
 		p["company"] = companyOpt
 	}
-	var emailOpt = options["email"]
+	var emailOpt = opts["email"]
 	if emailOpt != nil {
 		p["email"] = emailOpt
 	}
-	var firstNameOpt = options["first_name"]
+	var firstNameOpt = opts["first_name"]
 	if firstNameOpt != nil {
 		p["first_name"] = firstNameOpt
 	}
-	var lastNameOpt = options["last_name"]
+	var lastNameOpt = opts["last_name"]
 	if lastNameOpt != nil {
 		p["last_name"] = lastNameOpt
 	}
-	var newPasswordOpt = options["new_password"]
+	var newPasswordOpt = opts["new_password"]
 	if newPasswordOpt != nil {
 		p["new_password"] = newPasswordOpt
 	}
-	var phoneOpt = options["phone"]
+	var phoneOpt = opts["phone"]
 	if phoneOpt != nil {
 		p["phone"] = phoneOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		p["timezone"] = timezoneOpt
 	}
+	if err := loc.api.ValidateParams("CurrentUser", "update", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("CurrentUser", "update")
 	if err != nil {
 		return res, err
@@ -881,21 +1409,11 @@ func (loc *CurrentUserLocator) Update(password string, options rsapi.APIParams)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -924,6 +1442,9 @@ func (loc *CurrentUserLocator) CloudAccounts(awsAccessKeyId string, awsAccountNu
 		"aws_secret_access_key": awsSecretAccessKey,
 		"cloud_vendor_name":     cloudVendorName,
 	}
+	if err := loc.api.ValidateParams("CurrentUser", "cloud_accounts", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("CurrentUser", "cloud_accounts")
 	if err != nil {
 		return err
@@ -936,30 +1457,60 @@ func (loc *CurrentUserLocator) CloudAccounts(awsAccessKeyId string, awsAccountNu
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// CurrentUserOnboardingStatusOptions contains the optional parameters of CurrentUserLocator.OnboardingStatus.
+type CurrentUserOnboardingStatusOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CurrentUserOnboardingStatusOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CurrentUserLocator.OnboardingStatus", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/current_user/actions/onboarding_status
 //
 // Gets the onboarding status of the user.
-func (loc *CurrentUserLocator) OnboardingStatus(options rsapi.APIParams) (*UserOnboardingStatus, error) {
+func (loc *CurrentUserLocator) OnboardingStatus(options *CurrentUserOnboardingStatusOptions) (*UserOnboardingStatus, error) {
 	var res *UserOnboardingStatus
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CurrentUser", "onboarding_status", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("CurrentUser", "onboarding_status")
 	if err != nil {
 		return res, err
@@ -972,21 +1523,11 @@ func (loc *CurrentUserLocator) OnboardingStatus(options rsapi.APIParams) (*UserO
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -997,6 +1538,9 @@ func (loc *CurrentUserLocator) Environment() (*UserEnvironment, error) {
 	var res *UserEnvironment
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CurrentUser", "environment", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("CurrentUser", "environment")
 	if err != nil {
 		return res, err
@@ -1009,21 +1553,11 @@ func (loc *CurrentUserLocator) Environment() (*UserEnvironment, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -1046,43 +1580,122 @@ func (api *API) InstanceLocator(href string) *InstanceLocator {
 	return &InstanceLocator{Href(href), api}
 }
 
+// InstanceLocatorInterface lists the InstanceLocator methods, code that depends on the interface
+// rather than on InstanceLocator can be unit tested using the generated fake locators.
+type InstanceLocatorInterface interface {
+	Index(endTime *rsapi.Time, startTime *rsapi.Time, options *InstanceIndexOptions) (*Instance, error)
+	Count(endTime *rsapi.Time, startTime *rsapi.Time, options *InstanceCountOptions) (string, error)
+	CountReader(endTime *rsapi.Time, startTime *rsapi.Time, options *InstanceCountOptions) (io.ReadCloser, error)
+	Exist(options *InstanceExistOptions) (string, error)
+	ExistReader(options *InstanceExistOptions) (io.ReadCloser, error)
+	Export(endTime *rsapi.Time, startTime *rsapi.Time, options *InstanceExportOptions) (string, error)
+	ExportReader(endTime *rsapi.Time, startTime *rsapi.Time, options *InstanceExportOptions) (io.ReadCloser, error)
+	FilterOptions(endTime *rsapi.Time, filterTypes []string, startTime *rsapi.Time, options *InstanceFilterOptionsOptions) (*Filter, error)
+}
+
 //===== Actions
 
+// InstanceIndexOptions contains the optional parameters of InstanceLocator.Index.
+type InstanceIndexOptions struct {
+	// The filters to apply
+	InstanceFilters []*Filter
+	// Limits the number of results.
+	Limit int
+	// Used with limit to paginate the results.
+	Offset int
+	// The fields to order the results by. If '-' is in front of the field name allows it to be sorted in descending order.
+	Order []string
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	View     string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "instance_filters", "limit", "offset", "order", "timezone", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.Index", n)
+		}
+	}
+	if o.InstanceFilters != nil {
+		p["instance_filters"] = o.InstanceFilters
+	}
+	if o.Limit != 0 {
+		p["limit"] = o.Limit
+	}
+	if o.Offset != 0 {
+		p["offset"] = o.Offset
+	}
+	if o.Order != nil {
+		p["order"] = o.Order
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/instances
 //
 // Gets instances that overlap with the requested time period.
-func (loc *InstanceLocator) Index(endTime *time.Time, startTime *time.Time, options rsapi.APIParams) (*Instance, error) {
+func (loc *InstanceLocator) Index(endTime *rsapi.Time, startTime *rsapi.Time, options *InstanceIndexOptions) (*Instance, error) {
 	var res *Instance
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":   endTime,
 		"start_time": startTime,
 	}
-	var instanceFiltersOpt = options["instance_filters"]
+	var instanceFiltersOpt = opts["instance_filters"]
 	if instanceFiltersOpt != nil {
 		params["instance_filters[]"] = instanceFiltersOpt
 	}
-	var limitOpt = options["limit"]
+	var limitOpt = opts["limit"]
 	if limitOpt != nil {
 		params["limit"] = limitOpt
 	}
-	var offsetOpt = options["offset"]
+	var offsetOpt = opts["offset"]
 	if offsetOpt != nil {
 		params["offset"] = offsetOpt
 	}
-	var orderOpt = options["order"]
+	var orderOpt = opts["order"]
 	if orderOpt != nil {
 		params["order[]"] = orderOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Instance", "index")
 	if err != nil {
 		return res, err
@@ -1095,43 +1708,80 @@ func (loc *InstanceLocator) Index(endTime *time.Time, startTime *time.Time, opti
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// InstanceCountOptions contains the optional parameters of InstanceLocator.Count.
+type InstanceCountOptions struct {
+	// The filters to apply
+	InstanceFilters []*Filter
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceCountOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "instance_filters", "timezone":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.Count", n)
+		}
+	}
+	if o.InstanceFilters != nil {
+		p["instance_filters"] = o.InstanceFilters
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	return p, nil
+}
+
 // GET /api/instances/actions/count
 //
 // Gets the count of instances that overlap with the requested time period.
-func (loc *InstanceLocator) Count(endTime *time.Time, startTime *time.Time, options rsapi.APIParams) (string, error) {
+func (loc *InstanceLocator) Count(endTime *rsapi.Time, startTime *rsapi.Time, options *InstanceCountOptions) (string, error) {
 	var res string
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":   endTime,
 		"start_time": startTime,
 	}
-	var instanceFiltersOpt = options["instance_filters"]
+	var instanceFiltersOpt = opts["instance_filters"]
 	if instanceFiltersOpt != nil {
 		params["instance_filters[]"] = instanceFiltersOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "count", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Instance", "count")
 	if err != nil {
 		return res, err
@@ -1144,14 +1794,8 @@ func (loc *InstanceLocator) Count(endTime *time.Time, startTime *time.Time, opti
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -1162,30 +1806,129 @@ func (loc *InstanceLocator) Count(endTime *time.Time, startTime *time.Time, opti
 	return res, err
 }
 
+// CountReader is identical to Count except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *InstanceLocator) CountReader(endTime *rsapi.Time, startTime *rsapi.Time, options *InstanceCountOptions) (io.ReadCloser, error) {
+	if endTime == nil {
+		return nil, fmt.Errorf("endTime is required")
+	}
+	if startTime == nil {
+		return nil, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return nil, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{
+		"end_time":   endTime,
+		"start_time": startTime,
+	}
+	var instanceFiltersOpt = opts["instance_filters"]
+	if instanceFiltersOpt != nil {
+		params["instance_filters[]"] = instanceFiltersOpt
+	}
+	var timezoneOpt = opts["timezone"]
+	if timezoneOpt != nil {
+		params["timezone"] = timezoneOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "count", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Instance", "count")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
+// InstanceExistOptions contains the optional parameters of InstanceLocator.Exist.
+type InstanceExistOptions struct {
+	// The end time of the period.
+	EndTime *rsapi.Time
+	// The filters to apply
+	InstanceFilters []*Filter
+	// The start time of the period.
+	StartTime *rsapi.Time
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceExistOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "end_time", "instance_filters", "start_time", "timezone":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.Exist", n)
+		}
+	}
+	if o.EndTime != nil {
+		p["end_time"] = o.EndTime
+	}
+	if o.InstanceFilters != nil {
+		p["instance_filters"] = o.InstanceFilters
+	}
+	if o.StartTime != nil {
+		p["start_time"] = o.StartTime
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	return p, nil
+}
+
 // GET /api/instances/actions/exist
 //
 // Checks if any instances overlap with the requested time period.
-func (loc *InstanceLocator) Exist(options rsapi.APIParams) (string, error) {
+func (loc *InstanceLocator) Exist(options *InstanceExistOptions) (string, error) {
 	var res string
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var endTimeOpt = options["end_time"]
+	var endTimeOpt = opts["end_time"]
 	if endTimeOpt != nil {
 		params["end_time"] = endTimeOpt
 	}
-	var instanceFiltersOpt = options["instance_filters"]
+	var instanceFiltersOpt = opts["instance_filters"]
 	if instanceFiltersOpt != nil {
 		params["instance_filters[]"] = instanceFiltersOpt
 	}
-	var startTimeOpt = options["start_time"]
+	var startTimeOpt = opts["start_time"]
 	if startTimeOpt != nil {
 		params["start_time"] = startTimeOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "exist", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Instance", "exist")
 	if err != nil {
 		return res, err
@@ -1198,14 +1941,8 @@ func (loc *InstanceLocator) Exist(options rsapi.APIParams) (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -1216,41 +1953,154 @@ func (loc *InstanceLocator) Exist(options rsapi.APIParams) (string, error) {
 	return res, err
 }
 
+// ExistReader is identical to Exist except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *InstanceLocator) ExistReader(options *InstanceExistOptions) (io.ReadCloser, error) {
+	opts, err := options.params()
+	if err != nil {
+		return nil, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var endTimeOpt = opts["end_time"]
+	if endTimeOpt != nil {
+		params["end_time"] = endTimeOpt
+	}
+	var instanceFiltersOpt = opts["instance_filters"]
+	if instanceFiltersOpt != nil {
+		params["instance_filters[]"] = instanceFiltersOpt
+	}
+	var startTimeOpt = opts["start_time"]
+	if startTimeOpt != nil {
+		params["start_time"] = startTimeOpt
+	}
+	var timezoneOpt = opts["timezone"]
+	if timezoneOpt != nil {
+		params["timezone"] = timezoneOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "exist", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Instance", "exist")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
+// InstanceExportOptions contains the optional parameters of InstanceLocator.Export.
+type InstanceExportOptions struct {
+	// The filters to apply
+	InstanceFilters []*Filter
+	// Limits the number of results.
+	Limit int
+	// Used with limit to paginate the results.
+	Offset int
+	// The fields to order the results by. If '-' is in front of the field name allows it to be sorted in descending order.
+	Order []string
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	View     string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceExportOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "instance_filters", "limit", "offset", "order", "timezone", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.Export", n)
+		}
+	}
+	if o.InstanceFilters != nil {
+		p["instance_filters"] = o.InstanceFilters
+	}
+	if o.Limit != 0 {
+		p["limit"] = o.Limit
+	}
+	if o.Offset != 0 {
+		p["offset"] = o.Offset
+	}
+	if o.Order != nil {
+		p["order"] = o.Order
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/instances/actions/export
 //
 // Exports the instances that overlap with the requested time period in CSV format.
-func (loc *InstanceLocator) Export(endTime *time.Time, startTime *time.Time, options rsapi.APIParams) (string, error) {
+func (loc *InstanceLocator) Export(endTime *rsapi.Time, startTime *rsapi.Time, options *InstanceExportOptions) (string, error) {
 	var res string
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":   endTime,
 		"start_time": startTime,
 	}
-	var instanceFiltersOpt = options["instance_filters"]
+	var instanceFiltersOpt = opts["instance_filters"]
 	if instanceFiltersOpt != nil {
 		params["instance_filters[]"] = instanceFiltersOpt
 	}
-	var limitOpt = options["limit"]
+	var limitOpt = opts["limit"]
 	if limitOpt != nil {
 		params["limit"] = limitOpt
 	}
-	var offsetOpt = options["offset"]
+	var offsetOpt = opts["offset"]
 	if offsetOpt != nil {
 		params["offset"] = offsetOpt
 	}
-	var orderOpt = options["order"]
+	var orderOpt = opts["order"]
 	if orderOpt != nil {
 		params["order[]"] = orderOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "export", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Instance", "export")
 	if err != nil {
 		return res, err
@@ -1263,14 +2113,8 @@ func (loc *InstanceLocator) Export(endTime *time.Time, startTime *time.Time, opt
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -1281,49 +2125,184 @@ func (loc *InstanceLocator) Export(endTime *time.Time, startTime *time.Time, opt
 	return res, err
 }
 
+// ExportReader is identical to Export except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *InstanceLocator) ExportReader(endTime *rsapi.Time, startTime *rsapi.Time, options *InstanceExportOptions) (io.ReadCloser, error) {
+	if endTime == nil {
+		return nil, fmt.Errorf("endTime is required")
+	}
+	if startTime == nil {
+		return nil, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return nil, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{
+		"end_time":   endTime,
+		"start_time": startTime,
+	}
+	var instanceFiltersOpt = opts["instance_filters"]
+	if instanceFiltersOpt != nil {
+		params["instance_filters[]"] = instanceFiltersOpt
+	}
+	var limitOpt = opts["limit"]
+	if limitOpt != nil {
+		params["limit"] = limitOpt
+	}
+	var offsetOpt = opts["offset"]
+	if offsetOpt != nil {
+		params["offset"] = offsetOpt
+	}
+	var orderOpt = opts["order"]
+	if orderOpt != nil {
+		params["order[]"] = orderOpt
+	}
+	var timezoneOpt = opts["timezone"]
+	if timezoneOpt != nil {
+		params["timezone"] = timezoneOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "export", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Instance", "export")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
+// InstanceFilterOptionsOptions contains the optional parameters of InstanceLocator.FilterOptions.
+type InstanceFilterOptionsOptions struct {
+	// The filters to apply
+	InstanceFilters []*Filter
+	// Limits the number of results.
+	Limit int
+	// Used with limit to paginate the results.
+	Offset int
+	// The fields to order the results by. If '-' is in front of the field name allows it to be sorted in descending order.
+	Order []string
+	// Only matches filter options which contain the search term.
+	SearchTerm string
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	View     string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceFilterOptionsOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "instance_filters", "limit", "offset", "order", "search_term", "timezone", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.FilterOptions", n)
+		}
+	}
+	if o.InstanceFilters != nil {
+		p["instance_filters"] = o.InstanceFilters
+	}
+	if o.Limit != 0 {
+		p["limit"] = o.Limit
+	}
+	if o.Offset != 0 {
+		p["offset"] = o.Offset
+	}
+	if o.Order != nil {
+		p["order"] = o.Order
+	}
+	if o.SearchTerm != "" {
+		p["search_term"] = o.SearchTerm
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/instances/actions/filter_options
 //
 // Gets the filter options for instances that overlap with the requested time period.
-func (loc *InstanceLocator) FilterOptions(endTime *time.Time, filterTypes []string, startTime *time.Time, options rsapi.APIParams) (*Filter, error) {
+func (loc *InstanceLocator) FilterOptions(endTime *rsapi.Time, filterTypes []string, startTime *rsapi.Time, options *InstanceFilterOptionsOptions) (*Filter, error) {
 	var res *Filter
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
 	if len(filterTypes) == 0 {
 		return res, fmt.Errorf("filterTypes is required")
 	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":       endTime,
 		"filter_types[]": filterTypes,
 		"start_time":     startTime,
 	}
-	var instanceFiltersOpt = options["instance_filters"]
+	var instanceFiltersOpt = opts["instance_filters"]
 	if instanceFiltersOpt != nil {
 		params["instance_filters[]"] = instanceFiltersOpt
 	}
-	var limitOpt = options["limit"]
+	var limitOpt = opts["limit"]
 	if limitOpt != nil {
 		params["limit"] = limitOpt
 	}
-	var offsetOpt = options["offset"]
+	var offsetOpt = opts["offset"]
 	if offsetOpt != nil {
 		params["offset"] = offsetOpt
 	}
-	var orderOpt = options["order"]
+	var orderOpt = opts["order"]
 	if orderOpt != nil {
 		params["order[]"] = orderOpt
 	}
-	var searchTermOpt = options["search_term"]
+	var searchTermOpt = opts["search_term"]
 	if searchTermOpt != nil {
 		params["search_term"] = searchTermOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "filter_options", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Instance", "filter_options")
 	if err != nil {
 		return res, err
@@ -1336,21 +2315,11 @@ func (loc *InstanceLocator) FilterOptions(endTime *time.Time, filterTypes []stri
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -1374,12 +2343,65 @@ func (api *API) InstanceCombinationLocator(href string) *InstanceCombinationLoca
 	return &InstanceCombinationLocator{Href(href), api}
 }
 
+// InstanceCombinationLocatorInterface lists the InstanceCombinationLocator methods, code that depends on the interface
+// rather than on InstanceCombinationLocator can be unit tested using the generated fake locators.
+type InstanceCombinationLocatorInterface interface {
+	Create(cloudName string, cloudVendorName string, instanceTypeName string, monthlyUsageOption string, platform string, quantity int, options *InstanceCombinationCreateOptions) (*InstanceCombinationLocator, error)
+	Show(options *InstanceCombinationShowOptions) (*InstanceCombination, error)
+	Update(options *InstanceCombinationUpdateOptions) (*InstanceCombination, error)
+	Destroy() error
+	ReservedInstancePrices(options *InstanceCombinationReservedInstancePricesOptions) (*ReservedInstancePurchase, error)
+}
+
 //===== Actions
 
+// InstanceCombinationCreateOptions contains the optional parameters of InstanceCombinationLocator.Create.
+type InstanceCombinationCreateOptions struct {
+	View string
+	// Where applicable, the datacenter name of the instances.
+	DatacenterName string
+	// If monthly_usage_option is set to 'Other', this specifies the hours in the month the instances are running.
+	MonthlyUsageHours int
+	// Patterns to apply to the InstanceCombination, in the order that they are to be applied. If no href is specified with the Pattern body then this will create a new Pattern and apply it to the InstanceCombination.
+	Patterns []*PatternParam
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceCombinationCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "datacenter_name", "monthly_usage_hours", "patterns":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceCombinationLocator.Create", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.DatacenterName != "" {
+		p["datacenter_name"] = o.DatacenterName
+	}
+	if o.MonthlyUsageHours != 0 {
+		p["monthly_usage_hours"] = o.MonthlyUsageHours
+	}
+	if o.Patterns != nil {
+		p["patterns"] = o.Patterns
+	}
+	return p, nil
+}
+
 // POST /api/scenarios/:scenario_id/instance_combinations
 //
 // Create a new InstanceCombination.
-func (loc *InstanceCombinationLocator) Create(cloudName string, cloudVendorName string, instanceTypeName string, monthlyUsageOption string, platform string, quantity int, options rsapi.APIParams) (*InstanceCombinationLocator, error) {
+func (loc *InstanceCombinationLocator) Create(cloudName string, cloudVendorName string, instanceTypeName string, monthlyUsageOption string, platform string, quantity int, options *InstanceCombinationCreateOptions) (*InstanceCombinationLocator, error) {
 	var res *InstanceCombinationLocator
 	if cloudName == "" {
 		return res, fmt.Errorf("cloudName is required")
@@ -1396,9 +2418,13 @@ func (loc *InstanceCombinationLocator) Create(cloudName string, cloudVendorName
 	if platform == "" {
 		return res, fmt.Errorf("platform is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
@@ -1411,18 +2437,21 @@ func (loc *InstanceCombinationLocator) Create(cloudName string, cloudVendorName
 		"platform":             platform,
 		"quantity":             quantity,
 	}
-	var datacenterNameOpt = options["datacenter_name"]
+	var datacenterNameOpt = opts["datacenter_name"]
 	if datacenterNameOpt != nil {
 		p["datacenter_name"] = datacenterNameOpt
 	}
-	var monthlyUsageHoursOpt = options["monthly_usage_hours"]
+	var monthlyUsageHoursOpt = opts["monthly_usage_hours"]
 	if monthlyUsageHoursOpt != nil {
 		p["monthly_usage_hours"] = monthlyUsageHoursOpt
 	}
-	var patternsOpt = options["patterns"]
+	var patternsOpt = opts["patterns"]
 	if patternsOpt != nil {
 		p["patterns"] = patternsOpt
 	}
+	if err := loc.api.ValidateParams("InstanceCombination", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceCombination", "create")
 	if err != nil {
 		return res, err
@@ -1435,15 +2464,10 @@ func (loc *InstanceCombinationLocator) Create(cloudName string, cloudVendorName
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -1452,18 +2476,53 @@ func (loc *InstanceCombinationLocator) Create(cloudName string, cloudVendorName
 	}
 }
 
+// InstanceCombinationShowOptions contains the optional parameters of InstanceCombinationLocator.Show.
+type InstanceCombinationShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceCombinationShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceCombinationLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/scenarios/:scenario_id/instance_combinations/:id
 //
 // Show a specific InstanceCombination.
-func (loc *InstanceCombinationLocator) Show(options rsapi.APIParams) (*InstanceCombination, error) {
+func (loc *InstanceCombinationLocator) Show(options *InstanceCombinationShowOptions) (*InstanceCombination, error) {
 	var res *InstanceCombination
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceCombination", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceCombination", "show")
 	if err != nil {
 		return res, err
@@ -1476,73 +2535,143 @@ func (loc *InstanceCombinationLocator) Show(options rsapi.APIParams) (*InstanceC
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// InstanceCombinationUpdateOptions contains the optional parameters of InstanceCombinationLocator.Update.
+type InstanceCombinationUpdateOptions struct {
+	View string
+	// The cloud name of the instances.
+	CloudName string
+	// The cloud vendor name of the instances.
+	CloudVendorName string
+	// Where applicable, the datacenter name of the instances.
+	DatacenterName string
+	// The instance type.
+	InstanceTypeName string
+	// If monthly_usage_option is set to 'Other', this specifies the hours in the month the instances are running.
+	MonthlyUsageHours int
+	// The number of hours that the instances run for every month.
+	MonthlyUsageOption string
+	// Patterns to apply to the InstanceCombination, in the order that they are to be applied. If no href is specified with the Pattern body then this will create a new Pattern and apply it to the InstanceCombination.
+	Patterns []*PatternParam
+	// The platform isn't the actual operating system of the instance as cloud vendors don't always have a way for us to access this.
+	Platform string
+	// The number of instances.
+	Quantity int
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceCombinationUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "cloud_name", "cloud_vendor_name", "datacenter_name", "instance_type_name", "monthly_usage_hours", "monthly_usage_option", "patterns", "platform", "quantity":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceCombinationLocator.Update", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.CloudName != "" {
+		p["cloud_name"] = o.CloudName
+	}
+	if o.CloudVendorName != "" {
+		p["cloud_vendor_name"] = o.CloudVendorName
+	}
+	if o.DatacenterName != "" {
+		p["datacenter_name"] = o.DatacenterName
+	}
+	if o.InstanceTypeName != "" {
+		p["instance_type_name"] = o.InstanceTypeName
+	}
+	if o.MonthlyUsageHours != 0 {
+		p["monthly_usage_hours"] = o.MonthlyUsageHours
+	}
+	if o.MonthlyUsageOption != "" {
+		p["monthly_usage_option"] = o.MonthlyUsageOption
+	}
+	if o.Patterns != nil {
+		p["patterns"] = o.Patterns
+	}
+	if o.Platform != "" {
+		p["platform"] = o.Platform
+	}
+	if o.Quantity != 0 {
+		p["quantity"] = o.Quantity
+	}
+	return p, nil
+}
+
 // PATCH /api/scenarios/:scenario_id/instance_combinations/:id
 //
 // Update the provided attributes of an InstanceCombination.
-func (loc *InstanceCombinationLocator) Update(options rsapi.APIParams) (*InstanceCombination, error) {
+func (loc *InstanceCombinationLocator) Update(options *InstanceCombinationUpdateOptions) (*InstanceCombination, error) {
 	var res *InstanceCombination
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var cloudNameOpt = options["cloud_name"]
+	var cloudNameOpt = opts["cloud_name"]
 	if cloudNameOpt != nil {
 		p["cloud_name"] = cloudNameOpt
 	}
-	var cloudVendorNameOpt = options["cloud_vendor_name"]
+	var cloudVendorNameOpt = opts["cloud_vendor_name"]
 	if cloudVendorNameOpt != nil {
 		p["cloud_vendor_name"] = cloudVendorNameOpt
 	}
-	var datacenterNameOpt = options["datacenter_name"]
+	var datacenterNameOpt = opts["datacenter_name"]
 	if datacenterNameOpt != nil {
 		p["datacenter_name"] = datacenterNameOpt
 	}
-	var instanceTypeNameOpt = options["instance_type_name"]
+	var instanceTypeNameOpt = opts["instance_type_name"]
 	if instanceTypeNameOpt != nil {
 		p["instance_type_name"] = instanceTypeNameOpt
 	}
-	var monthlyUsageHoursOpt = options["monthly_usage_hours"]
+	var monthlyUsageHoursOpt = opts["monthly_usage_hours"]
 	if monthlyUsageHoursOpt != nil {
 		p["monthly_usage_hours"] = monthlyUsageHoursOpt
 	}
-	var monthlyUsageOptionOpt = options["monthly_usage_option"]
+	var monthlyUsageOptionOpt = opts["monthly_usage_option"]
 	if monthlyUsageOptionOpt != nil {
 		p["monthly_usage_option"] = monthlyUsageOptionOpt
 	}
-	var patternsOpt = options["patterns"]
+	var patternsOpt = opts["patterns"]
 	if patternsOpt != nil {
 		p["patterns"] = patternsOpt
 	}
-	var platformOpt = options["platform"]
+	var platformOpt = opts["platform"]
 	if platformOpt != nil {
 		p["platform"] = platformOpt
 	}
-	var quantityOpt = options["quantity"]
+	var quantityOpt = opts["quantity"]
 	if quantityOpt != nil {
 		p["quantity"] = quantityOpt
 	}
+	if err := loc.api.ValidateParams("InstanceCombination", "update", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceCombination", "update")
 	if err != nil {
 		return res, err
@@ -1555,21 +2684,11 @@ func (loc *InstanceCombinationLocator) Update(options rsapi.APIParams) (*Instanc
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -1579,6 +2698,9 @@ func (loc *InstanceCombinationLocator) Update(options rsapi.APIParams) (*Instanc
 func (loc *InstanceCombinationLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceCombination", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("InstanceCombination", "destroy")
 	if err != nil {
 		return err
@@ -1591,30 +2713,60 @@ func (loc *InstanceCombinationLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// InstanceCombinationReservedInstancePricesOptions contains the optional parameters of InstanceCombinationLocator.ReservedInstancePrices.
+type InstanceCombinationReservedInstancePricesOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceCombinationReservedInstancePricesOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceCombinationLocator.ReservedInstancePrices", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/scenarios/:scenario_id/instance_combinations/:id/actions/reserved_instance_prices
 //
 // Returns pricing details for the various reserved instances that can be purchased for this InstanceCombination.
-func (loc *InstanceCombinationLocator) ReservedInstancePrices(options rsapi.APIParams) (*ReservedInstancePurchase, error) {
+func (loc *InstanceCombinationLocator) ReservedInstancePrices(options *InstanceCombinationReservedInstancePricesOptions) (*ReservedInstancePurchase, error) {
 	var res *ReservedInstancePurchase
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceCombination", "reserved_instance_prices", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceCombination", "reserved_instance_prices")
 	if err != nil {
 		return res, err
@@ -1627,21 +2779,11 @@ func (loc *InstanceCombinationLocator) ReservedInstancePrices(options rsapi.APIP
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -1664,36 +2806,98 @@ func (api *API) InstanceMetricLocator(href string) *InstanceMetricLocator {
 	return &InstanceMetricLocator{Href(href), api}
 }
 
+// InstanceMetricLocatorInterface lists the InstanceMetricLocator methods, code that depends on the interface
+// rather than on InstanceMetricLocator can be unit tested using the generated fake locators.
+type InstanceMetricLocatorInterface interface {
+	Overall(endTime *rsapi.Time, metrics []string, startTime *rsapi.Time, options *InstanceMetricOverallOptions) (*MetricsResult, error)
+	GroupedOverall(endTime *rsapi.Time, group []string, metrics []string, startTime *rsapi.Time, options *InstanceMetricGroupedOverallOptions) (*MetricsResult, error)
+	TimeSeries(endTime *rsapi.Time, granularity string, metrics []string, startTime *rsapi.Time, options *InstanceMetricTimeSeriesOptions) (*TimeSeriesMetricsResult, error)
+	GroupedTimeSeries(endTime *rsapi.Time, granularity string, group []string, metrics []string, startTime *rsapi.Time, options *InstanceMetricGroupedTimeSeriesOptions) (*TimeSeriesMetricsResult, error)
+	CurrentCount(options *InstanceMetricCurrentCountOptions) (string, error)
+	CurrentCountReader(options *InstanceMetricCurrentCountOptions) (io.ReadCloser, error)
+}
+
 //===== Actions
 
+// InstanceMetricOverallOptions contains the optional parameters of InstanceMetricLocator.Overall.
+type InstanceMetricOverallOptions struct {
+	// The filters to apply
+	InstanceFilters []*Filter
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	View     string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceMetricOverallOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "instance_filters", "timezone", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceMetricLocator.Overall", n)
+		}
+	}
+	if o.InstanceFilters != nil {
+		p["instance_filters"] = o.InstanceFilters
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/instance_metrics/actions/overall
 //
 // Calculates the overall metrics for instance usages in a time period, e.g. show me the
 // total cost of all my instances during the last month.
-func (loc *InstanceMetricLocator) Overall(endTime *time.Time, metrics []string, startTime *time.Time, options rsapi.APIParams) (*MetricsResult, error) {
+func (loc *InstanceMetricLocator) Overall(endTime *rsapi.Time, metrics []string, startTime *rsapi.Time, options *InstanceMetricOverallOptions) (*MetricsResult, error) {
 	var res *MetricsResult
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
 	if len(metrics) == 0 {
 		return res, fmt.Errorf("metrics is required")
 	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":   endTime,
 		"metrics[]":  metrics,
 		"start_time": startTime,
 	}
-	var instanceFiltersOpt = options["instance_filters"]
+	var instanceFiltersOpt = opts["instance_filters"]
 	if instanceFiltersOpt != nil {
 		params["instance_filters[]"] = instanceFiltersOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceMetric", "overall", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceMetric", "overall")
 	if err != nil {
 		return res, err
@@ -1706,37 +2910,90 @@ func (loc *InstanceMetricLocator) Overall(endTime *time.Time, metrics []string,
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// InstanceMetricGroupedOverallOptions contains the optional parameters of InstanceMetricLocator.GroupedOverall.
+type InstanceMetricGroupedOverallOptions struct {
+	// The filters to apply
+	InstanceFilters []*Filter
+	// Limits the number of results.
+	Limit int
+	// Used with limit to paginate the results.
+	Offset int
+	// The fields to order the results by. If '-' is in front of the field name allows it to be sorted in descending order.
+	Order []string
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	View     string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceMetricGroupedOverallOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "instance_filters", "limit", "offset", "order", "timezone", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceMetricLocator.GroupedOverall", n)
+		}
+	}
+	if o.InstanceFilters != nil {
+		p["instance_filters"] = o.InstanceFilters
+	}
+	if o.Limit != 0 {
+		p["limit"] = o.Limit
+	}
+	if o.Offset != 0 {
+		p["offset"] = o.Offset
+	}
+	if o.Order != nil {
+		p["order"] = o.Order
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/instance_metrics/actions/grouped_overall
 //
 // Calculates the overall metrics for instance usages in a time period and groups them into
 // specified breakdown categories, e.g. show me the total cost of all my instances during the
 // last month grouped by different accounts.
-func (loc *InstanceMetricLocator) GroupedOverall(endTime *time.Time, group []string, metrics []string, startTime *time.Time, options rsapi.APIParams) (*MetricsResult, error) {
+func (loc *InstanceMetricLocator) GroupedOverall(endTime *rsapi.Time, group []string, metrics []string, startTime *rsapi.Time, options *InstanceMetricGroupedOverallOptions) (*MetricsResult, error) {
 	var res *MetricsResult
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
 	if len(group) == 0 {
 		return res, fmt.Errorf("group is required")
 	}
 	if len(metrics) == 0 {
 		return res, fmt.Errorf("metrics is required")
 	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":   endTime,
@@ -1744,31 +3001,34 @@ func (loc *InstanceMetricLocator) GroupedOverall(endTime *time.Time, group []str
 		"metrics[]":  metrics,
 		"start_time": startTime,
 	}
-	var instanceFiltersOpt = options["instance_filters"]
+	var instanceFiltersOpt = opts["instance_filters"]
 	if instanceFiltersOpt != nil {
 		params["instance_filters[]"] = instanceFiltersOpt
 	}
-	var limitOpt = options["limit"]
+	var limitOpt = opts["limit"]
 	if limitOpt != nil {
 		params["limit"] = limitOpt
 	}
-	var offsetOpt = options["offset"]
+	var offsetOpt = opts["offset"]
 	if offsetOpt != nil {
 		params["offset"] = offsetOpt
 	}
-	var orderOpt = options["order"]
+	var orderOpt = opts["order"]
 	if orderOpt != nil {
 		params["order[]"] = orderOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceMetric", "grouped_overall", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceMetric", "grouped_overall")
 	if err != nil {
 		return res, err
@@ -1781,37 +3041,80 @@ func (loc *InstanceMetricLocator) GroupedOverall(endTime *time.Time, group []str
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// InstanceMetricTimeSeriesOptions contains the optional parameters of InstanceMetricLocator.TimeSeries.
+type InstanceMetricTimeSeriesOptions struct {
+	// The filters to apply
+	InstanceFilters []*Filter
+	// The interval for the time bucket to group the results by, e.g. if the granularity is day and the interval is 2 then the results will be grouped into buckets representing a period of 2 days.
+	Interval int
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	View     string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceMetricTimeSeriesOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "instance_filters", "interval", "timezone", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceMetricLocator.TimeSeries", n)
+		}
+	}
+	if o.InstanceFilters != nil {
+		p["instance_filters"] = o.InstanceFilters
+	}
+	if o.Interval != 0 {
+		p["interval"] = o.Interval
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/instance_metrics/actions/time_series
 //
 // Calculates the metrics time series for instance usages in a time period allowing different
 // time buckets (hour, 3 days, month, etc.), e.g. show me the lowest instance count of my
 // instances per day during the last month.
-func (loc *InstanceMetricLocator) TimeSeries(endTime *time.Time, granularity string, metrics []string, startTime *time.Time, options rsapi.APIParams) (*TimeSeriesMetricsResult, error) {
+func (loc *InstanceMetricLocator) TimeSeries(endTime *rsapi.Time, granularity string, metrics []string, startTime *rsapi.Time, options *InstanceMetricTimeSeriesOptions) (*TimeSeriesMetricsResult, error) {
 	var res *TimeSeriesMetricsResult
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
 	if granularity == "" {
 		return res, fmt.Errorf("granularity is required")
 	}
 	if len(metrics) == 0 {
 		return res, fmt.Errorf("metrics is required")
 	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":    endTime,
@@ -1819,23 +3122,26 @@ func (loc *InstanceMetricLocator) TimeSeries(endTime *time.Time, granularity str
 		"metrics[]":   metrics,
 		"start_time":  startTime,
 	}
-	var instanceFiltersOpt = options["instance_filters"]
+	var instanceFiltersOpt = opts["instance_filters"]
 	if instanceFiltersOpt != nil {
 		params["instance_filters[]"] = instanceFiltersOpt
 	}
-	var intervalOpt = options["interval"]
+	var intervalOpt = opts["interval"]
 	if intervalOpt != nil {
 		params["interval"] = intervalOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceMetric", "time_series", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceMetric", "time_series")
 	if err != nil {
 		return res, err
@@ -1848,32 +3154,83 @@ func (loc *InstanceMetricLocator) TimeSeries(endTime *time.Time, granularity str
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// InstanceMetricGroupedTimeSeriesOptions contains the optional parameters of InstanceMetricLocator.GroupedTimeSeries.
+type InstanceMetricGroupedTimeSeriesOptions struct {
+	// The filters to apply
+	InstanceFilters []*Filter
+	// The interval for the time bucket to group the results by, e.g. if the granularity is day and the interval is 2 then the results will be grouped into buckets representing a period of 2 days.
+	Interval int
+	// Limits the number of results.
+	Limit int
+	// Used with limit to paginate the results.
+	Offset int
+	// The fields to order the results by. If '-' is in front of the field name allows it to be sorted in descending order.
+	Order []string
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	View     string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceMetricGroupedTimeSeriesOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "instance_filters", "interval", "limit", "offset", "order", "timezone", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceMetricLocator.GroupedTimeSeries", n)
+		}
+	}
+	if o.InstanceFilters != nil {
+		p["instance_filters"] = o.InstanceFilters
+	}
+	if o.Interval != 0 {
+		p["interval"] = o.Interval
+	}
+	if o.Limit != 0 {
+		p["limit"] = o.Limit
+	}
+	if o.Offset != 0 {
+		p["offset"] = o.Offset
+	}
+	if o.Order != nil {
+		p["order"] = o.Order
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/instance_metrics/actions/grouped_time_series
 //
 // Calculates the metrics time series for instance usages in a time period allowing different
 // time buckets (hour, 3 days, month, etc.) and groups them into specified breakdown
 // categories, e.g. show me the lowest instance count of my instances per day during the last
 // month grouped by accounts.
-func (loc *InstanceMetricLocator) GroupedTimeSeries(endTime *time.Time, granularity string, group []string, metrics []string, startTime *time.Time, options rsapi.APIParams) (*TimeSeriesMetricsResult, error) {
+func (loc *InstanceMetricLocator) GroupedTimeSeries(endTime *rsapi.Time, granularity string, group []string, metrics []string, startTime *rsapi.Time, options *InstanceMetricGroupedTimeSeriesOptions) (*TimeSeriesMetricsResult, error) {
 	var res *TimeSeriesMetricsResult
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
 	if granularity == "" {
 		return res, fmt.Errorf("granularity is required")
 	}
@@ -1883,6 +3240,13 @@ func (loc *InstanceMetricLocator) GroupedTimeSeries(endTime *time.Time, granular
 	if len(metrics) == 0 {
 		return res, fmt.Errorf("metrics is required")
 	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":    endTime,
@@ -1891,35 +3255,38 @@ func (loc *InstanceMetricLocator) GroupedTimeSeries(endTime *time.Time, granular
 		"metrics[]":   metrics,
 		"start_time":  startTime,
 	}
-	var instanceFiltersOpt = options["instance_filters"]
+	var instanceFiltersOpt = opts["instance_filters"]
 	if instanceFiltersOpt != nil {
 		params["instance_filters[]"] = instanceFiltersOpt
 	}
-	var intervalOpt = options["interval"]
+	var intervalOpt = opts["interval"]
 	if intervalOpt != nil {
 		params["interval"] = intervalOpt
 	}
-	var limitOpt = options["limit"]
+	var limitOpt = opts["limit"]
 	if limitOpt != nil {
 		params["limit"] = limitOpt
 	}
-	var offsetOpt = options["offset"]
+	var offsetOpt = opts["offset"]
 	if offsetOpt != nil {
 		params["offset"] = offsetOpt
 	}
-	var orderOpt = options["order"]
+	var orderOpt = opts["order"]
 	if orderOpt != nil {
 		params["order[]"] = orderOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceMetric", "grouped_time_series", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceMetric", "grouped_time_series")
 	if err != nil {
 		return res, err
@@ -1932,36 +3299,62 @@ func (loc *InstanceMetricLocator) GroupedTimeSeries(endTime *time.Time, granular
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// InstanceMetricCurrentCountOptions contains the optional parameters of InstanceMetricLocator.CurrentCount.
+type InstanceMetricCurrentCountOptions struct {
+	// The filters to apply
+	InstanceFilters []*Filter
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceMetricCurrentCountOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "instance_filters":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceMetricLocator.CurrentCount", n)
+		}
+	}
+	if o.InstanceFilters != nil {
+		p["instance_filters"] = o.InstanceFilters
+	}
+	return p, nil
+}
+
 // GET /api/instance_metrics/actions/current_count
 //
 // Returns the count of currently running instances.
-func (loc *InstanceMetricLocator) CurrentCount(options rsapi.APIParams) (string, error) {
+func (loc *InstanceMetricLocator) CurrentCount(options *InstanceMetricCurrentCountOptions) (string, error) {
 	var res string
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var instanceFiltersOpt = options["instance_filters"]
+	var instanceFiltersOpt = opts["instance_filters"]
 	if instanceFiltersOpt != nil {
 		params["instance_filters[]"] = instanceFiltersOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceMetric", "current_count", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceMetric", "current_count")
 	if err != nil {
 		return res, err
@@ -1974,14 +3367,8 @@ func (loc *InstanceMetricLocator) CurrentCount(options rsapi.APIParams) (string,
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -1992,6 +3379,41 @@ func (loc *InstanceMetricLocator) CurrentCount(options rsapi.APIParams) (string,
 	return res, err
 }
 
+// CurrentCountReader is identical to CurrentCount except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *InstanceMetricLocator) CurrentCountReader(options *InstanceMetricCurrentCountOptions) (io.ReadCloser, error) {
+	opts, err := options.params()
+	if err != nil {
+		return nil, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var instanceFiltersOpt = opts["instance_filters"]
+	if instanceFiltersOpt != nil {
+		params["instance_filters[]"] = instanceFiltersOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceMetric", "current_count", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("InstanceMetric", "current_count")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 /******  InstanceUsagePeriod ******/
 
 // Enables you to get usage period details from instances. An instance can have many usage periods, which can
@@ -2013,25 +3435,66 @@ func (api *API) InstanceUsagePeriodLocator(href string) *InstanceUsagePeriodLoca
 	return &InstanceUsagePeriodLocator{Href(href), api}
 }
 
+// InstanceUsagePeriodLocatorInterface lists the InstanceUsagePeriodLocator methods, code that depends on the interface
+// rather than on InstanceUsagePeriodLocator can be unit tested using the generated fake locators.
+type InstanceUsagePeriodLocatorInterface interface {
+	Index(instanceUsagePeriodFilters []*Filter, options *InstanceUsagePeriodIndexOptions) (*InstanceUsagePeriod, error)
+}
+
 //===== Actions
 
+// InstanceUsagePeriodIndexOptions contains the optional parameters of InstanceUsagePeriodLocator.Index.
+type InstanceUsagePeriodIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceUsagePeriodIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceUsagePeriodLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/instance_usage_periods
 //
 // Gets the instance usage periods of instances.
-func (loc *InstanceUsagePeriodLocator) Index(instanceUsagePeriodFilters []*Filter, options rsapi.APIParams) (*InstanceUsagePeriod, error) {
+func (loc *InstanceUsagePeriodLocator) Index(instanceUsagePeriodFilters []*Filter, options *InstanceUsagePeriodIndexOptions) (*InstanceUsagePeriod, error) {
 	var res *InstanceUsagePeriod
 	if len(instanceUsagePeriodFilters) == 0 {
 		return res, fmt.Errorf("instanceUsagePeriodFilters is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"instance_usage_period_filters[]": instanceUsagePeriodFilters,
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceUsagePeriod", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceUsagePeriod", "index")
 	if err != nil {
 		return res, err
@@ -2044,21 +3507,11 @@ func (loc *InstanceUsagePeriodLocator) Index(instanceUsagePeriodFilters []*Filte
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -2082,12 +3535,56 @@ func (api *API) PatternLocator(href string) *PatternLocator {
 	return &PatternLocator{Href(href), api}
 }
 
+// PatternLocatorInterface lists the PatternLocator methods, code that depends on the interface
+// rather than on PatternLocator can be unit tested using the generated fake locators.
+type PatternLocatorInterface interface {
+	Create(months string, name string, operation string, type_ string, value float64, years string, options *PatternCreateOptions) (*PatternLocator, error)
+	Index(options *PatternIndexOptions) (*Pattern, error)
+	Show(options *PatternShowOptions) (*Pattern, error)
+	Update(options *PatternUpdateOptions) (*Pattern, error)
+	Destroy() error
+	CreateDefaults(options *PatternCreateDefaultsOptions) (*Pattern, error)
+}
+
 //===== Actions
 
+// PatternCreateOptions contains the optional parameters of PatternLocator.Create.
+type PatternCreateOptions struct {
+	View string
+	// Summary of the pattern.
+	Summary string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *PatternCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "summary":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of PatternLocator.Create", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.Summary != "" {
+		p["summary"] = o.Summary
+	}
+	return p, nil
+}
+
 // POST /api/patterns
 //
 // Create a new Pattern.
-func (loc *PatternLocator) Create(months string, name string, operation string, type_ string, value float64, years string, options rsapi.APIParams) (*PatternLocator, error) {
+func (loc *PatternLocator) Create(months string, name string, operation string, type_ string, value float64, years string, options *PatternCreateOptions) (*PatternLocator, error) {
 	var res *PatternLocator
 	if months == "" {
 		return res, fmt.Errorf("months is required")
@@ -2104,9 +3601,13 @@ func (loc *PatternLocator) Create(months string, name string, operation string,
 	if years == "" {
 		return res, fmt.Errorf("years is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
@@ -2119,10 +3620,13 @@ func (loc *PatternLocator) Create(months string, name string, operation string,
 		"value":     value,
 		"years":     years,
 	}
-	var summaryOpt = options["summary"]
+	var summaryOpt = opts["summary"]
 	if summaryOpt != nil {
 		p["summary"] = summaryOpt
 	}
+	if err := loc.api.ValidateParams("Pattern", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Pattern", "create")
 	if err != nil {
 		return res, err
@@ -2135,15 +3639,10 @@ func (loc *PatternLocator) Create(months string, name string, operation string,
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -2152,18 +3651,53 @@ func (loc *PatternLocator) Create(months string, name string, operation string,
 	}
 }
 
+// PatternIndexOptions contains the optional parameters of PatternLocator.Index.
+type PatternIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *PatternIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of PatternLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/patterns
 //
 // List all Patterns.
-func (loc *PatternLocator) Index(options rsapi.APIParams) (*Pattern, error) {
+func (loc *PatternLocator) Index(options *PatternIndexOptions) (*Pattern, error) {
 	var res *Pattern
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Pattern", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Pattern", "index")
 	if err != nil {
 		return res, err
@@ -2176,36 +3710,61 @@ func (loc *PatternLocator) Index(options rsapi.APIParams) (*Pattern, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// PatternShowOptions contains the optional parameters of PatternLocator.Show.
+type PatternShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *PatternShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of PatternLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/patterns/:id
 //
 // Show a specific Pattern.
-func (loc *PatternLocator) Show(options rsapi.APIParams) (*Pattern, error) {
+func (loc *PatternLocator) Show(options *PatternShowOptions) (*Pattern, error) {
 	var res *Pattern
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Pattern", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Pattern", "show")
 	if err != nil {
 		return res, err
@@ -2218,65 +3777,131 @@ func (loc *PatternLocator) Show(options rsapi.APIParams) (*Pattern, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// PatternUpdateOptions contains the optional parameters of PatternLocator.Update.
+type PatternUpdateOptions struct {
+	View string
+	// The months that the pattern apply to. This can be "all" to apply the pattern in all months;
+	// a range such as "1-3" meaning the pattern will be applied from the start of January to the end of March; or
+	// individual months in a comma-separated list such as "5, 7, 9" meaning the pattern will be applied in May, July and September.
+	Months string
+	// Name of the pattern.
+	Name string
+	// Type of change, the increase and decrease operations are based on percentages.
+	Operation string
+	// Summary of the pattern.
+	Summary string
+	// Pattern type reflects whether the change that the pattern applies continues after the pattern has ended.
+	// Changes made by permanent patterns persist past the chosen end date, e.g. general growth.
+	// Changes made by temporary patterns only apply during the selected dates, e.g. holiday increase.
+	Type_ string
+	// Amount of change that the pattern will apply. The increase and decrease operations are based on percentages,
+	// so for example, use the value 10 to increase/decrease by 10%.
+	Value float64
+	// The years that the pattern will apply to. This can be "all" to apply the pattern in all years;
+	// a range such as "1-3" to apply the pattern from years 1 to years 3; or individual years in a comma-separated list such as "1,3".
+	Years string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *PatternUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "months", "name", "operation", "summary", "type", "value", "years":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of PatternLocator.Update", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.Months != "" {
+		p["months"] = o.Months
+	}
+	if o.Name != "" {
+		p["name"] = o.Name
+	}
+	if o.Operation != "" {
+		p["operation"] = o.Operation
+	}
+	if o.Summary != "" {
+		p["summary"] = o.Summary
+	}
+	if o.Type_ != "" {
+		p["type"] = o.Type_
+	}
+	if o.Value != 0 {
+		p["value"] = o.Value
+	}
+	if o.Years != "" {
+		p["years"] = o.Years
+	}
+	return p, nil
+}
+
 // PATCH /api/patterns/:id
 //
 // Update the provided attributes of a Pattern.
-func (loc *PatternLocator) Update(options rsapi.APIParams) (*Pattern, error) {
+func (loc *PatternLocator) Update(options *PatternUpdateOptions) (*Pattern, error) {
 	var res *Pattern
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var monthsOpt = options["months"]
+	var monthsOpt = opts["months"]
 	if monthsOpt != nil {
 		p["months"] = monthsOpt
 	}
-	var nameOpt = options["name"]
+	var nameOpt = opts["name"]
 	if nameOpt != nil {
 		p["name"] = nameOpt
 	}
-	var operationOpt = options["operation"]
+	var operationOpt = opts["operation"]
 	if operationOpt != nil {
 		p["operation"] = operationOpt
 	}
-	var summaryOpt = options["summary"]
+	var summaryOpt = opts["summary"]
 	if summaryOpt != nil {
 		p["summary"] = summaryOpt
 	}
-	var type_Opt = options["type"]
+	var type_Opt = opts["type"]
 	if type_Opt != nil {
 		p["type"] = type_Opt
 	}
-	var valueOpt = options["value"]
+	var valueOpt = opts["value"]
 	if valueOpt != nil {
 		p["value"] = valueOpt
 	}
-	var yearsOpt = options["years"]
+	var yearsOpt = opts["years"]
 	if yearsOpt != nil {
 		p["years"] = yearsOpt
 	}
+	if err := loc.api.ValidateParams("Pattern", "update", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Pattern", "update")
 	if err != nil {
 		return res, err
@@ -2289,21 +3914,11 @@ func (loc *PatternLocator) Update(options rsapi.APIParams) (*Pattern, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -2313,6 +3928,9 @@ func (loc *PatternLocator) Update(options rsapi.APIParams) (*Pattern, error) {
 func (loc *PatternLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Pattern", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Pattern", "destroy")
 	if err != nil {
 		return err
@@ -2325,33 +3943,63 @@ func (loc *PatternLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// PatternCreateDefaultsOptions contains the optional parameters of PatternLocator.CreateDefaults.
+type PatternCreateDefaultsOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *PatternCreateDefaultsOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of PatternLocator.CreateDefaults", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // POST /api/patterns/actions/create_defaults
 //
 // Create the following commonly used default Patterns: Increase by 2% every month,
 // Increase by 5% every month, Increase by 10% every month, Increase by 15% every month,
 // Increase by 500% during Nov - Dec, Increase by 200% during Jan - Feb, Decrease by 2% every month,
 // Decrease by 5% every month, Decrease by 10% every month, Decrease by 15% every month, Add 1 every month.
-func (loc *PatternLocator) CreateDefaults(options rsapi.APIParams) (*Pattern, error) {
+func (loc *PatternLocator) CreateDefaults(options *PatternCreateDefaultsOptions) (*Pattern, error) {
 	var res *Pattern
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Pattern", "create_defaults", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Pattern", "create_defaults")
 	if err != nil {
 		return res, err
@@ -2364,21 +4012,11 @@ func (loc *PatternLocator) CreateDefaults(options rsapi.APIParams) (*Pattern, er
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -2401,43 +4039,122 @@ func (api *API) ReservedInstanceLocator(href string) *ReservedInstanceLocator {
 	return &ReservedInstanceLocator{Href(href), api}
 }
 
+// ReservedInstanceLocatorInterface lists the ReservedInstanceLocator methods, code that depends on the interface
+// rather than on ReservedInstanceLocator can be unit tested using the generated fake locators.
+type ReservedInstanceLocatorInterface interface {
+	Index(endTime *rsapi.Time, startTime *rsapi.Time, options *ReservedInstanceIndexOptions) (*ReservedInstance, error)
+	Count(endTime *rsapi.Time, startTime *rsapi.Time, options *ReservedInstanceCountOptions) (string, error)
+	CountReader(endTime *rsapi.Time, startTime *rsapi.Time, options *ReservedInstanceCountOptions) (io.ReadCloser, error)
+	Exist(options *ReservedInstanceExistOptions) (string, error)
+	ExistReader(options *ReservedInstanceExistOptions) (io.ReadCloser, error)
+	Export(endTime *rsapi.Time, startTime *rsapi.Time, options *ReservedInstanceExportOptions) (string, error)
+	ExportReader(endTime *rsapi.Time, startTime *rsapi.Time, options *ReservedInstanceExportOptions) (io.ReadCloser, error)
+	FilterOptions(endTime *rsapi.Time, startTime *rsapi.Time, options *ReservedInstanceFilterOptionsOptions) (*Filter, error)
+}
+
 //===== Actions
 
+// ReservedInstanceIndexOptions contains the optional parameters of ReservedInstanceLocator.Index.
+type ReservedInstanceIndexOptions struct {
+	// Limits the number of results.
+	Limit int
+	// Used with limit to paginate the results.
+	Offset int
+	// The fields to order the results by. If '-' is in front of the field name allows it to be sorted in descending order.
+	Order []string
+	// The filters to apply
+	ReservedInstanceFilters []*Filter
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	View     string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ReservedInstanceIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "limit", "offset", "order", "reserved_instance_filters", "timezone", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ReservedInstanceLocator.Index", n)
+		}
+	}
+	if o.Limit != 0 {
+		p["limit"] = o.Limit
+	}
+	if o.Offset != 0 {
+		p["offset"] = o.Offset
+	}
+	if o.Order != nil {
+		p["order"] = o.Order
+	}
+	if o.ReservedInstanceFilters != nil {
+		p["reserved_instance_filters"] = o.ReservedInstanceFilters
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/reserved_instances
 //
 // Gets Reserved Instances that overlap with the requested time period.
-func (loc *ReservedInstanceLocator) Index(endTime *time.Time, startTime *time.Time, options rsapi.APIParams) (*ReservedInstance, error) {
+func (loc *ReservedInstanceLocator) Index(endTime *rsapi.Time, startTime *rsapi.Time, options *ReservedInstanceIndexOptions) (*ReservedInstance, error) {
 	var res *ReservedInstance
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":   endTime,
 		"start_time": startTime,
 	}
-	var limitOpt = options["limit"]
+	var limitOpt = opts["limit"]
 	if limitOpt != nil {
 		params["limit"] = limitOpt
 	}
-	var offsetOpt = options["offset"]
+	var offsetOpt = opts["offset"]
 	if offsetOpt != nil {
 		params["offset"] = offsetOpt
 	}
-	var orderOpt = options["order"]
+	var orderOpt = opts["order"]
 	if orderOpt != nil {
 		params["order[]"] = orderOpt
 	}
-	var reservedInstanceFiltersOpt = options["reserved_instance_filters"]
+	var reservedInstanceFiltersOpt = opts["reserved_instance_filters"]
 	if reservedInstanceFiltersOpt != nil {
 		params["reserved_instance_filters[]"] = reservedInstanceFiltersOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ReservedInstance", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ReservedInstance", "index")
 	if err != nil {
 		return res, err
@@ -2450,43 +4167,80 @@ func (loc *ReservedInstanceLocator) Index(endTime *time.Time, startTime *time.Ti
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ReservedInstanceCountOptions contains the optional parameters of ReservedInstanceLocator.Count.
+type ReservedInstanceCountOptions struct {
+	// The filters to apply
+	ReservedInstanceFilters []*Filter
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ReservedInstanceCountOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "reserved_instance_filters", "timezone":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ReservedInstanceLocator.Count", n)
+		}
+	}
+	if o.ReservedInstanceFilters != nil {
+		p["reserved_instance_filters"] = o.ReservedInstanceFilters
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	return p, nil
+}
+
 // GET /api/reserved_instances/actions/count
 //
 // Gets the count of Reserved Instances that overlap with the requested time period.
-func (loc *ReservedInstanceLocator) Count(endTime *time.Time, startTime *time.Time, options rsapi.APIParams) (string, error) {
+func (loc *ReservedInstanceLocator) Count(endTime *rsapi.Time, startTime *rsapi.Time, options *ReservedInstanceCountOptions) (string, error) {
 	var res string
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":   endTime,
 		"start_time": startTime,
 	}
-	var reservedInstanceFiltersOpt = options["reserved_instance_filters"]
+	var reservedInstanceFiltersOpt = opts["reserved_instance_filters"]
 	if reservedInstanceFiltersOpt != nil {
 		params["reserved_instance_filters[]"] = reservedInstanceFiltersOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ReservedInstance", "count", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ReservedInstance", "count")
 	if err != nil {
 		return res, err
@@ -2499,14 +4253,8 @@ func (loc *ReservedInstanceLocator) Count(endTime *time.Time, startTime *time.Ti
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -2517,31 +4265,302 @@ func (loc *ReservedInstanceLocator) Count(endTime *time.Time, startTime *time.Ti
 	return res, err
 }
 
+// CountReader is identical to Count except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *ReservedInstanceLocator) CountReader(endTime *rsapi.Time, startTime *rsapi.Time, options *ReservedInstanceCountOptions) (io.ReadCloser, error) {
+	if endTime == nil {
+		return nil, fmt.Errorf("endTime is required")
+	}
+	if startTime == nil {
+		return nil, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return nil, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{
+		"end_time":   endTime,
+		"start_time": startTime,
+	}
+	var reservedInstanceFiltersOpt = opts["reserved_instance_filters"]
+	if reservedInstanceFiltersOpt != nil {
+		params["reserved_instance_filters[]"] = reservedInstanceFiltersOpt
+	}
+	var timezoneOpt = opts["timezone"]
+	if timezoneOpt != nil {
+		params["timezone"] = timezoneOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ReservedInstance", "count", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("ReservedInstance", "count")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
+// ReservedInstanceExistOptions contains the optional parameters of ReservedInstanceLocator.Exist.
+type ReservedInstanceExistOptions struct {
+	// The end time of the period.
+	EndTime *rsapi.Time
+	// The filters to apply
+	ReservedInstanceFilters []*Filter
+	// The start time of the period.
+	StartTime *rsapi.Time
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ReservedInstanceExistOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "end_time", "reserved_instance_filters", "start_time", "timezone":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ReservedInstanceLocator.Exist", n)
+		}
+	}
+	if o.EndTime != nil {
+		p["end_time"] = o.EndTime
+	}
+	if o.ReservedInstanceFilters != nil {
+		p["reserved_instance_filters"] = o.ReservedInstanceFilters
+	}
+	if o.StartTime != nil {
+		p["start_time"] = o.StartTime
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	return p, nil
+}
+
 // GET /api/reserved_instances/actions/exist
 //
 // Checks if any Reserved Instances overlap with the requested time period.
-func (loc *ReservedInstanceLocator) Exist(options rsapi.APIParams) (string, error) {
+func (loc *ReservedInstanceLocator) Exist(options *ReservedInstanceExistOptions) (string, error) {
 	var res string
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var endTimeOpt = options["end_time"]
+	var endTimeOpt = opts["end_time"]
 	if endTimeOpt != nil {
 		params["end_time"] = endTimeOpt
 	}
-	var reservedInstanceFiltersOpt = options["reserved_instance_filters"]
+	var reservedInstanceFiltersOpt = opts["reserved_instance_filters"]
+	if reservedInstanceFiltersOpt != nil {
+		params["reserved_instance_filters[]"] = reservedInstanceFiltersOpt
+	}
+	var startTimeOpt = opts["start_time"]
+	if startTimeOpt != nil {
+		params["start_time"] = startTimeOpt
+	}
+	var timezoneOpt = opts["timezone"]
+	if timezoneOpt != nil {
+		params["timezone"] = timezoneOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ReservedInstance", "exist", params, p); err != nil {
+		return res, err
+	}
+	uri, err := loc.ActionPath("ReservedInstance", "exist")
+	if err != nil {
+		return res, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return res, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return res, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return res, rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return res, err
+	}
+	res = string(respBody)
+	return res, err
+}
+
+// ExistReader is identical to Exist except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *ReservedInstanceLocator) ExistReader(options *ReservedInstanceExistOptions) (io.ReadCloser, error) {
+	opts, err := options.params()
+	if err != nil {
+		return nil, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var endTimeOpt = opts["end_time"]
+	if endTimeOpt != nil {
+		params["end_time"] = endTimeOpt
+	}
+	var reservedInstanceFiltersOpt = opts["reserved_instance_filters"]
+	if reservedInstanceFiltersOpt != nil {
+		params["reserved_instance_filters[]"] = reservedInstanceFiltersOpt
+	}
+	var startTimeOpt = opts["start_time"]
+	if startTimeOpt != nil {
+		params["start_time"] = startTimeOpt
+	}
+	var timezoneOpt = opts["timezone"]
+	if timezoneOpt != nil {
+		params["timezone"] = timezoneOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ReservedInstance", "exist", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("ReservedInstance", "exist")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
+// ReservedInstanceExportOptions contains the optional parameters of ReservedInstanceLocator.Export.
+type ReservedInstanceExportOptions struct {
+	// Limits the number of results.
+	Limit int
+	// Used with limit to paginate the results.
+	Offset int
+	// The fields to order the results by. If '-' is in front of the field name allows it to be sorted in descending order.
+	Order []string
+	// The filters to apply
+	ReservedInstanceFilters []*Filter
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	View     string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ReservedInstanceExportOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "limit", "offset", "order", "reserved_instance_filters", "timezone", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ReservedInstanceLocator.Export", n)
+		}
+	}
+	if o.Limit != 0 {
+		p["limit"] = o.Limit
+	}
+	if o.Offset != 0 {
+		p["offset"] = o.Offset
+	}
+	if o.Order != nil {
+		p["order"] = o.Order
+	}
+	if o.ReservedInstanceFilters != nil {
+		p["reserved_instance_filters"] = o.ReservedInstanceFilters
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
+// GET /api/reserved_instances/actions/export
+//
+// Exports the Reserved Instances that overlap with the requested time period in CSV format.
+func (loc *ReservedInstanceLocator) Export(endTime *rsapi.Time, startTime *rsapi.Time, options *ReservedInstanceExportOptions) (string, error) {
+	var res string
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{
+		"end_time":   endTime,
+		"start_time": startTime,
+	}
+	var limitOpt = opts["limit"]
+	if limitOpt != nil {
+		params["limit"] = limitOpt
+	}
+	var offsetOpt = opts["offset"]
+	if offsetOpt != nil {
+		params["offset"] = offsetOpt
+	}
+	var orderOpt = opts["order"]
+	if orderOpt != nil {
+		params["order[]"] = orderOpt
+	}
+	var reservedInstanceFiltersOpt = opts["reserved_instance_filters"]
 	if reservedInstanceFiltersOpt != nil {
 		params["reserved_instance_filters[]"] = reservedInstanceFiltersOpt
 	}
-	var startTimeOpt = options["start_time"]
-	if startTimeOpt != nil {
-		params["start_time"] = startTimeOpt
-	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
 	var p rsapi.APIParams
-	uri, err := loc.ActionPath("ReservedInstance", "exist")
+	if err := loc.api.ValidateParams("ReservedInstance", "export", params, p); err != nil {
+		return res, err
+	}
+	uri, err := loc.ActionPath("ReservedInstance", "export")
 	if err != nil {
 		return res, err
 	}
@@ -2553,14 +4572,8 @@ func (loc *ReservedInstanceLocator) Exist(options rsapi.APIParams) (string, erro
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -2571,114 +4584,189 @@ func (loc *ReservedInstanceLocator) Exist(options rsapi.APIParams) (string, erro
 	return res, err
 }
 
-// GET /api/reserved_instances/actions/export
-//
-// Exports the Reserved Instances that overlap with the requested time period in CSV format.
-func (loc *ReservedInstanceLocator) Export(endTime *time.Time, startTime *time.Time, options rsapi.APIParams) (string, error) {
-	var res string
+// ExportReader is identical to Export except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *ReservedInstanceLocator) ExportReader(endTime *rsapi.Time, startTime *rsapi.Time, options *ReservedInstanceExportOptions) (io.ReadCloser, error) {
+	if endTime == nil {
+		return nil, fmt.Errorf("endTime is required")
+	}
+	if startTime == nil {
+		return nil, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return nil, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":   endTime,
 		"start_time": startTime,
 	}
-	var limitOpt = options["limit"]
+	var limitOpt = opts["limit"]
 	if limitOpt != nil {
 		params["limit"] = limitOpt
 	}
-	var offsetOpt = options["offset"]
+	var offsetOpt = opts["offset"]
 	if offsetOpt != nil {
 		params["offset"] = offsetOpt
 	}
-	var orderOpt = options["order"]
+	var orderOpt = opts["order"]
 	if orderOpt != nil {
 		params["order[]"] = orderOpt
 	}
-	var reservedInstanceFiltersOpt = options["reserved_instance_filters"]
+	var reservedInstanceFiltersOpt = opts["reserved_instance_filters"]
 	if reservedInstanceFiltersOpt != nil {
 		params["reserved_instance_filters[]"] = reservedInstanceFiltersOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ReservedInstance", "export", params, p); err != nil {
+		return nil, err
+	}
 	uri, err := loc.ActionPath("ReservedInstance", "export")
 	if err != nil {
-		return res, err
+		return nil, err
 	}
 	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
 	if err != nil {
-		return res, err
+		return nil, err
 	}
 	resp, err := loc.api.PerformRequest(req)
 	if err != nil {
-		return res, err
+		return nil, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
+// ReservedInstanceFilterOptionsOptions contains the optional parameters of ReservedInstanceLocator.FilterOptions.
+type ReservedInstanceFilterOptionsOptions struct {
+	// The filter types to get results for.
+	FilterTypes []string
+	// Limits the number of results.
+	Limit int
+	// Used with limit to paginate the results.
+	Offset int
+	// The fields to order the results by. If '-' is in front of the field name allows it to be sorted in descending order.
+	Order []string
+	// The filters to apply
+	ReservedInstanceFilters []*Filter
+	// Only matches filter options which contain the search term.
+	SearchTerm string
+	// The timezone name. Accepts any valid tz timezone (`http://en.wikipedia.org/wiki/List_of_tz_database_time_zones`).
+	Timezone string
+	View     string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ReservedInstanceFilterOptionsOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter_types", "limit", "offset", "order", "reserved_instance_filters", "search_term", "timezone", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ReservedInstanceLocator.FilterOptions", n)
 		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
 	}
-	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
+	if o.FilterTypes != nil {
+		p["filter_types"] = o.FilterTypes
 	}
-	res = string(respBody)
-	return res, err
+	if o.Limit != 0 {
+		p["limit"] = o.Limit
+	}
+	if o.Offset != 0 {
+		p["offset"] = o.Offset
+	}
+	if o.Order != nil {
+		p["order"] = o.Order
+	}
+	if o.ReservedInstanceFilters != nil {
+		p["reserved_instance_filters"] = o.ReservedInstanceFilters
+	}
+	if o.SearchTerm != "" {
+		p["search_term"] = o.SearchTerm
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/reserved_instances/actions/filter_options
 //
 // Gets the filter options for Reserved Instances that overlap with the requested time period.
-func (loc *ReservedInstanceLocator) FilterOptions(endTime *time.Time, startTime *time.Time, options rsapi.APIParams) (*Filter, error) {
+func (loc *ReservedInstanceLocator) FilterOptions(endTime *rsapi.Time, startTime *rsapi.Time, options *ReservedInstanceFilterOptionsOptions) (*Filter, error) {
 	var res *Filter
+	if endTime == nil {
+		return res, fmt.Errorf("endTime is required")
+	}
+	if startTime == nil {
+		return res, fmt.Errorf("startTime is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"end_time":   endTime,
 		"start_time": startTime,
 	}
-	var filterTypesOpt = options["filter_types"]
+	var filterTypesOpt = opts["filter_types"]
 	if filterTypesOpt != nil {
 		params["filter_types[]"] = filterTypesOpt
 	}
-	var limitOpt = options["limit"]
+	var limitOpt = opts["limit"]
 	if limitOpt != nil {
 		params["limit"] = limitOpt
 	}
-	var offsetOpt = options["offset"]
+	var offsetOpt = opts["offset"]
 	if offsetOpt != nil {
 		params["offset"] = offsetOpt
 	}
-	var orderOpt = options["order"]
+	var orderOpt = opts["order"]
 	if orderOpt != nil {
 		params["order[]"] = orderOpt
 	}
-	var reservedInstanceFiltersOpt = options["reserved_instance_filters"]
+	var reservedInstanceFiltersOpt = opts["reserved_instance_filters"]
 	if reservedInstanceFiltersOpt != nil {
 		params["reserved_instance_filters[]"] = reservedInstanceFiltersOpt
 	}
-	var searchTermOpt = options["search_term"]
+	var searchTermOpt = opts["search_term"]
 	if searchTermOpt != nil {
 		params["search_term"] = searchTermOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		params["timezone"] = timezoneOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ReservedInstance", "filter_options", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ReservedInstance", "filter_options")
 	if err != nil {
 		return res, err
@@ -2691,21 +4779,11 @@ func (loc *ReservedInstanceLocator) FilterOptions(endTime *time.Time, startTime
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -2728,19 +4806,64 @@ func (api *API) ReservedInstancePurchaseLocator(href string) *ReservedInstancePu
 	return &ReservedInstancePurchaseLocator{Href(href), api}
 }
 
+// ReservedInstancePurchaseLocatorInterface lists the ReservedInstancePurchaseLocator methods, code that depends on the interface
+// rather than on ReservedInstancePurchaseLocator can be unit tested using the generated fake locators.
+type ReservedInstancePurchaseLocatorInterface interface {
+	Create(autoRenew bool, duration int, offeringType string, quantity int, startDate *rsapi.Time, options *ReservedInstancePurchaseCreateOptions) (*ReservedInstancePurchaseLocator, error)
+	Index(options *ReservedInstancePurchaseIndexOptions) (*ReservedInstancePurchase, error)
+	Show(options *ReservedInstancePurchaseShowOptions) (*ReservedInstancePurchase, error)
+	Update(options *ReservedInstancePurchaseUpdateOptions) (*ReservedInstancePurchase, error)
+	Destroy() error
+}
+
 //===== Actions
 
+// ReservedInstancePurchaseCreateOptions contains the optional parameters of ReservedInstancePurchaseLocator.Create.
+type ReservedInstancePurchaseCreateOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ReservedInstancePurchaseCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ReservedInstancePurchaseLocator.Create", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // POST /api/scenarios/:scenario_id/instance_combinations/:instance_combination_id/reserved_instance_purchases
 //
 // Create a new ReservedInstancePurchase. This is not actually purchased in the cloud and is only used for cost simulation purposes.
-func (loc *ReservedInstancePurchaseLocator) Create(autoRenew bool, duration int, offeringType string, quantity int, startDate *time.Time, options rsapi.APIParams) (*ReservedInstancePurchaseLocator, error) {
+func (loc *ReservedInstancePurchaseLocator) Create(autoRenew bool, duration int, offeringType string, quantity int, startDate *rsapi.Time, options *ReservedInstancePurchaseCreateOptions) (*ReservedInstancePurchaseLocator, error) {
 	var res *ReservedInstancePurchaseLocator
 	if offeringType == "" {
 		return res, fmt.Errorf("offeringType is required")
 	}
+	if startDate == nil {
+		return res, fmt.Errorf("startDate is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
@@ -2752,6 +4875,9 @@ func (loc *ReservedInstancePurchaseLocator) Create(autoRenew bool, duration int,
 		"quantity":      quantity,
 		"start_date":    startDate,
 	}
+	if err := loc.api.ValidateParams("ReservedInstancePurchase", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ReservedInstancePurchase", "create")
 	if err != nil {
 		return res, err
@@ -2764,15 +4890,10 @@ func (loc *ReservedInstancePurchaseLocator) Create(autoRenew bool, duration int,
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -2781,18 +4902,53 @@ func (loc *ReservedInstancePurchaseLocator) Create(autoRenew bool, duration int,
 	}
 }
 
+// ReservedInstancePurchaseIndexOptions contains the optional parameters of ReservedInstancePurchaseLocator.Index.
+type ReservedInstancePurchaseIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ReservedInstancePurchaseIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ReservedInstancePurchaseLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/scenarios/:scenario_id/instance_combinations/:instance_combination_id/reserved_instance_purchases
 //
 // List all ReservedInstancePurchases for the InstanceCombination.
-func (loc *ReservedInstancePurchaseLocator) Index(options rsapi.APIParams) (*ReservedInstancePurchase, error) {
+func (loc *ReservedInstancePurchaseLocator) Index(options *ReservedInstancePurchaseIndexOptions) (*ReservedInstancePurchase, error) {
 	var res *ReservedInstancePurchase
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ReservedInstancePurchase", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ReservedInstancePurchase", "index")
 	if err != nil {
 		return res, err
@@ -2805,36 +4961,61 @@ func (loc *ReservedInstancePurchaseLocator) Index(options rsapi.APIParams) (*Res
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ReservedInstancePurchaseShowOptions contains the optional parameters of ReservedInstancePurchaseLocator.Show.
+type ReservedInstancePurchaseShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ReservedInstancePurchaseShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ReservedInstancePurchaseLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/scenarios/:scenario_id/instance_combinations/:instance_combination_id/reserved_instance_purchases/:id
 //
 // Show a specific ReservedInstancePurchase.
-func (loc *ReservedInstancePurchaseLocator) Show(options rsapi.APIParams) (*ReservedInstancePurchase, error) {
+func (loc *ReservedInstancePurchaseLocator) Show(options *ReservedInstancePurchaseShowOptions) (*ReservedInstancePurchase, error) {
 	var res *ReservedInstancePurchase
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ReservedInstancePurchase", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ReservedInstancePurchase", "show")
 	if err != nil {
 		return res, err
@@ -2847,57 +5028,107 @@ func (loc *ReservedInstancePurchaseLocator) Show(options rsapi.APIParams) (*Rese
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ReservedInstancePurchaseUpdateOptions contains the optional parameters of ReservedInstancePurchaseLocator.Update.
+type ReservedInstancePurchaseUpdateOptions struct {
+	View string
+	// Whether the ReservedInstance should be renewed every year or not; only applicable for 1 year ReservedInstances.
+	AutoRenew bool
+	// The duration of the ReservedInstance in seconds, 94608000 is 3 years, 31536000 is 1 year.
+	Duration int
+	// The ReservedInstance offering type. Support for the newer types are coming soon!
+	OfferingType string
+	// Number of instances to include in the reservation.
+	Quantity int
+	// Date at which the ReservedInstance purchase should start from, this can be a future date.
+	StartDate *rsapi.Time
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ReservedInstancePurchaseUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "auto_renew", "duration", "offering_type", "quantity", "start_date":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ReservedInstancePurchaseLocator.Update", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.AutoRenew {
+		p["auto_renew"] = o.AutoRenew
+	}
+	if o.Duration != 0 {
+		p["duration"] = o.Duration
+	}
+	if o.OfferingType != "" {
+		p["offering_type"] = o.OfferingType
+	}
+	if o.Quantity != 0 {
+		p["quantity"] = o.Quantity
+	}
+	if o.StartDate != nil {
+		p["start_date"] = o.StartDate
+	}
+	return p, nil
+}
+
 // PATCH /api/scenarios/:scenario_id/instance_combinations/:instance_combination_id/reserved_instance_purchases/:id
 //
 // Update the provided attributes of a ReservedInstancePurchase.
-func (loc *ReservedInstancePurchaseLocator) Update(options rsapi.APIParams) (*ReservedInstancePurchase, error) {
+func (loc *ReservedInstancePurchaseLocator) Update(options *ReservedInstancePurchaseUpdateOptions) (*ReservedInstancePurchase, error) {
 	var res *ReservedInstancePurchase
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var autoRenewOpt = options["auto_renew"]
+	var autoRenewOpt = opts["auto_renew"]
 	if autoRenewOpt != nil {
 		p["auto_renew"] = autoRenewOpt
 	}
-	var durationOpt = options["duration"]
+	var durationOpt = opts["duration"]
 	if durationOpt != nil {
 		p["duration"] = durationOpt
 	}
-	var offeringTypeOpt = options["offering_type"]
+	var offeringTypeOpt = opts["offering_type"]
 	if offeringTypeOpt != nil {
 		p["offering_type"] = offeringTypeOpt
 	}
-	var quantityOpt = options["quantity"]
+	var quantityOpt = opts["quantity"]
 	if quantityOpt != nil {
 		p["quantity"] = quantityOpt
 	}
-	var startDateOpt = options["start_date"]
+	var startDateOpt = opts["start_date"]
 	if startDateOpt != nil {
 		p["start_date"] = startDateOpt
 	}
+	if err := loc.api.ValidateParams("ReservedInstancePurchase", "update", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ReservedInstancePurchase", "update")
 	if err != nil {
 		return res, err
@@ -2910,21 +5141,11 @@ func (loc *ReservedInstancePurchaseLocator) Update(options rsapi.APIParams) (*Re
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -2934,6 +5155,9 @@ func (loc *ReservedInstancePurchaseLocator) Update(options rsapi.APIParams) (*Re
 func (loc *ReservedInstancePurchaseLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ReservedInstancePurchase", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ReservedInstancePurchase", "destroy")
 	if err != nil {
 		return err
@@ -2946,15 +5170,10 @@ func (loc *ReservedInstancePurchaseLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -2979,16 +5198,86 @@ func (api *API) ScenarioLocator(href string) *ScenarioLocator {
 	return &ScenarioLocator{Href(href), api}
 }
 
+// ScenarioLocatorInterface lists the ScenarioLocator methods, code that depends on the interface
+// rather than on ScenarioLocator can be unit tested using the generated fake locators.
+type ScenarioLocatorInterface interface {
+	Create(snapshotTimestamp *rsapi.Time, options *ScenarioCreateOptions) (*ScenarioLocator, error)
+	Index(options *ScenarioIndexOptions) (*Scenario, error)
+	Show(options *ScenarioShowOptions) (*Scenario, error)
+	Update(options *ScenarioUpdateOptions) (*Scenario, error)
+	Destroy() error
+	Forecast(options *ScenarioForecastOptions) (*TimeSeriesMetricsResult, error)
+}
+
 //===== Actions
 
+// ScenarioCreateOptions contains the optional parameters of ScenarioLocator.Create.
+type ScenarioCreateOptions struct {
+	View string
+	// Filters to use for the Scenario.
+	Filters []*Filter
+	// Set to true to create a blank Scenario, which will result in historic data to be excluded from the Scenario.
+	IsBlank bool
+	// Used by the Cloud Analytics UI to define whether the Scenario should be persisted or if it's being used in experimentation mode by a user, which will result in the Scenario to be deleted automatically after a few days.
+	IsPersisted bool
+	Name        string
+	// Used by the Cloud Analytics UI to define the total number of instances allocated to private clouds, do not use.
+	PrivateCloudInstanceCount int
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScenarioCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "filters", "is_blank", "is_persisted", "name", "private_cloud_instance_count":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScenarioLocator.Create", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.Filters != nil {
+		p["filters"] = o.Filters
+	}
+	if o.IsBlank {
+		p["is_blank"] = o.IsBlank
+	}
+	if o.IsPersisted {
+		p["is_persisted"] = o.IsPersisted
+	}
+	if o.Name != "" {
+		p["name"] = o.Name
+	}
+	if o.PrivateCloudInstanceCount != 0 {
+		p["private_cloud_instance_count"] = o.PrivateCloudInstanceCount
+	}
+	return p, nil
+}
+
 // POST /api/scenarios
 //
 // Create a new Scenario.
-func (loc *ScenarioLocator) Create(snapshotTimestamp *time.Time, options rsapi.APIParams) (*ScenarioLocator, error) {
+func (loc *ScenarioLocator) Create(snapshotTimestamp *rsapi.Time, options *ScenarioCreateOptions) (*ScenarioLocator, error) {
 	var res *ScenarioLocator
+	if snapshotTimestamp == nil {
+		return res, fmt.Errorf("snapshotTimestamp is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
@@ -2996,26 +5285,29 @@ func (loc *ScenarioLocator) Create(snapshotTimestamp *time.Time, options rsapi.A
 	p = rsapi.APIParams{
 		"snapshot_timestamp": snapshotTimestamp,
 	}
-	var filtersOpt = options["filters"]
+	var filtersOpt = opts["filters"]
 	if filtersOpt != nil {
 		p["filters"] = filtersOpt
 	}
-	var isBlankOpt = options["is_blank"]
+	var isBlankOpt = opts["is_blank"]
 	if isBlankOpt != nil {
 		p["is_blank"] = isBlankOpt
 	}
-	var isPersistedOpt = options["is_persisted"]
+	var isPersistedOpt = opts["is_persisted"]
 	if isPersistedOpt != nil {
 		p["is_persisted"] = isPersistedOpt
 	}
-	var nameOpt = options["name"]
+	var nameOpt = opts["name"]
 	if nameOpt != nil {
 		p["name"] = nameOpt
 	}
-	var privateCloudInstanceCountOpt = options["private_cloud_instance_count"]
+	var privateCloudInstanceCountOpt = opts["private_cloud_instance_count"]
 	if privateCloudInstanceCountOpt != nil {
 		p["private_cloud_instance_count"] = privateCloudInstanceCountOpt
 	}
+	if err := loc.api.ValidateParams("Scenario", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Scenario", "create")
 	if err != nil {
 		return res, err
@@ -3028,15 +5320,10 @@ func (loc *ScenarioLocator) Create(snapshotTimestamp *time.Time, options rsapi.A
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -3045,22 +5332,61 @@ func (loc *ScenarioLocator) Create(snapshotTimestamp *time.Time, options rsapi.A
 	}
 }
 
+// ScenarioIndexOptions contains the optional parameters of ScenarioLocator.Index.
+type ScenarioIndexOptions struct {
+	IncludeNonPersisted bool
+	View                string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScenarioIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "include_non_persisted", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScenarioLocator.Index", n)
+		}
+	}
+	if o.IncludeNonPersisted {
+		p["include_non_persisted"] = o.IncludeNonPersisted
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/scenarios
 //
 // List all Scenarios.
-func (loc *ScenarioLocator) Index(options rsapi.APIParams) (*Scenario, error) {
+func (loc *ScenarioLocator) Index(options *ScenarioIndexOptions) (*Scenario, error) {
 	var res *Scenario
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var includeNonPersistedOpt = options["include_non_persisted"]
+	var includeNonPersistedOpt = opts["include_non_persisted"]
 	if includeNonPersistedOpt != nil {
 		params["include_non_persisted"] = includeNonPersistedOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Scenario", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Scenario", "index")
 	if err != nil {
 		return res, err
@@ -3073,36 +5399,61 @@ func (loc *ScenarioLocator) Index(options rsapi.APIParams) (*Scenario, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ScenarioShowOptions contains the optional parameters of ScenarioLocator.Show.
+type ScenarioShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScenarioShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScenarioLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/scenarios/:id
 //
 // Show a specific Scenario.
-func (loc *ScenarioLocator) Show(options rsapi.APIParams) (*Scenario, error) {
+func (loc *ScenarioLocator) Show(options *ScenarioShowOptions) (*Scenario, error) {
 	var res *Scenario
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Scenario", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Scenario", "show")
 	if err != nil {
 		return res, err
@@ -3115,53 +5466,97 @@ func (loc *ScenarioLocator) Show(options rsapi.APIParams) (*Scenario, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ScenarioUpdateOptions contains the optional parameters of ScenarioLocator.Update.
+type ScenarioUpdateOptions struct {
+	View string
+	// Used by the Cloud Analytics UI to define whether the Scenario should be persisted or if it's being used in experimentation mode by a user, which will result in the Scenario to be deleted automatically after a few days.
+	IsPersisted bool
+	Name        string
+	// Used by the Cloud Analytics UI to define the total number of instances allocated to private clouds, do not use.
+	PrivateCloudInstanceCount int
+	// The timestamp of when a snapshot of historic data was taken when creating the Scenario. When creating a new Scenario, you usually want to use the current time.
+	SnapshotTimestamp *rsapi.Time
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScenarioUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "is_persisted", "name", "private_cloud_instance_count", "snapshot_timestamp":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScenarioLocator.Update", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.IsPersisted {
+		p["is_persisted"] = o.IsPersisted
+	}
+	if o.Name != "" {
+		p["name"] = o.Name
+	}
+	if o.PrivateCloudInstanceCount != 0 {
+		p["private_cloud_instance_count"] = o.PrivateCloudInstanceCount
+	}
+	if o.SnapshotTimestamp != nil {
+		p["snapshot_timestamp"] = o.SnapshotTimestamp
+	}
+	return p, nil
+}
+
 // PATCH /api/scenarios/:id
 //
 // Update the provided attributes of a Scenario.
-func (loc *ScenarioLocator) Update(options rsapi.APIParams) (*Scenario, error) {
+func (loc *ScenarioLocator) Update(options *ScenarioUpdateOptions) (*Scenario, error) {
 	var res *Scenario
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var isPersistedOpt = options["is_persisted"]
+	var isPersistedOpt = opts["is_persisted"]
 	if isPersistedOpt != nil {
 		p["is_persisted"] = isPersistedOpt
 	}
-	var nameOpt = options["name"]
+	var nameOpt = opts["name"]
 	if nameOpt != nil {
 		p["name"] = nameOpt
 	}
-	var privateCloudInstanceCountOpt = options["private_cloud_instance_count"]
+	var privateCloudInstanceCountOpt = opts["private_cloud_instance_count"]
 	if privateCloudInstanceCountOpt != nil {
 		p["private_cloud_instance_count"] = privateCloudInstanceCountOpt
 	}
-	var snapshotTimestampOpt = options["snapshot_timestamp"]
+	var snapshotTimestampOpt = opts["snapshot_timestamp"]
 	if snapshotTimestampOpt != nil {
 		p["snapshot_timestamp"] = snapshotTimestampOpt
 	}
+	if err := loc.api.ValidateParams("Scenario", "update", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Scenario", "update")
 	if err != nil {
 		return res, err
@@ -3174,21 +5569,11 @@ func (loc *ScenarioLocator) Update(options rsapi.APIParams) (*Scenario, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -3198,6 +5583,9 @@ func (loc *ScenarioLocator) Update(options rsapi.APIParams) (*Scenario, error) {
 func (loc *ScenarioLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Scenario", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Scenario", "destroy")
 	if err != nil {
 		return err
@@ -3210,32 +5598,62 @@ func (loc *ScenarioLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ScenarioForecastOptions contains the optional parameters of ScenarioLocator.Forecast.
+type ScenarioForecastOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScenarioForecastOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScenarioLocator.Forecast", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/scenarios/:id/actions/forecast
 //
 // Run a simulation to generate a 3-year forecast showing the `average_instance_count`, `instance_upfront_cost`,
 // `instance_usage_cost` and `instance_recurring_cost` metrics. This call might get major changes so it's best to avoid using it currently.
 // If there are missing prices for any of the InstanceCombinations then these metrics will be excluded from the results for that InstanceCombination.
-func (loc *ScenarioLocator) Forecast(options rsapi.APIParams) (*TimeSeriesMetricsResult, error) {
+func (loc *ScenarioLocator) Forecast(options *ScenarioForecastOptions) (*TimeSeriesMetricsResult, error) {
 	var res *TimeSeriesMetricsResult
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Scenario", "forecast", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Scenario", "forecast")
 	if err != nil {
 		return res, err
@@ -3248,21 +5666,11 @@ func (loc *ScenarioLocator) Forecast(options rsapi.APIParams) (*TimeSeriesMetric
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -3286,12 +5694,66 @@ func (api *API) ScheduledReportLocator(href string) *ScheduledReportLocator {
 	return &ScheduledReportLocator{Href(href), api}
 }
 
-//===== Actions
-
+// ScheduledReportLocatorInterface lists the ScheduledReportLocator methods, code that depends on the interface
+// rather than on ScheduledReportLocator can be unit tested using the generated fake locators.
+type ScheduledReportLocatorInterface interface {
+	Create(frequency string, name string, options *ScheduledReportCreateOptions) (*ScheduledReportLocator, error)
+	Index(options *ScheduledReportIndexOptions) (*ScheduledReport, error)
+	Show(options *ScheduledReportShowOptions) (*ScheduledReport, error)
+	Update(options *ScheduledReportUpdateOptions) (*ScheduledReport, error)
+	Destroy() error
+	CreateDefaults(options *ScheduledReportCreateDefaultsOptions) (*ScheduledReport, error)
+}
+
+//===== Actions
+
+// ScheduledReportCreateOptions contains the optional parameters of ScheduledReportLocator.Create.
+type ScheduledReportCreateOptions struct {
+	View string
+	// In addition to your email, the report will be sent to these additional email addresses.
+	AdditionalEmails []string
+	// Whether the emails should include a CSV attachement of the instance data.
+	AttachCsv bool
+	// Filters to use for the ScheduledReport.
+	Filters []*Filter
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScheduledReportCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "additional_emails", "attach_csv", "filters":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScheduledReportLocator.Create", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.AdditionalEmails != nil {
+		p["additional_emails"] = o.AdditionalEmails
+	}
+	if o.AttachCsv {
+		p["attach_csv"] = o.AttachCsv
+	}
+	if o.Filters != nil {
+		p["filters"] = o.Filters
+	}
+	return p, nil
+}
+
 // POST /api/scheduled_reports
 //
 // Create a new ScheduledReport.
-func (loc *ScheduledReportLocator) Create(frequency string, name string, options rsapi.APIParams) (*ScheduledReportLocator, error) {
+func (loc *ScheduledReportLocator) Create(frequency string, name string, options *ScheduledReportCreateOptions) (*ScheduledReportLocator, error) {
 	var res *ScheduledReportLocator
 	if frequency == "" {
 		return res, fmt.Errorf("frequency is required")
@@ -3299,9 +5761,13 @@ func (loc *ScheduledReportLocator) Create(frequency string, name string, options
 	if name == "" {
 		return res, fmt.Errorf("name is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
@@ -3310,18 +5776,21 @@ func (loc *ScheduledReportLocator) Create(frequency string, name string, options
 		"frequency": frequency,
 		"name":      name,
 	}
-	var additionalEmailsOpt = options["additional_emails"]
+	var additionalEmailsOpt = opts["additional_emails"]
 	if additionalEmailsOpt != nil {
 		p["additional_emails"] = additionalEmailsOpt
 	}
-	var attachCsvOpt = options["attach_csv"]
+	var attachCsvOpt = opts["attach_csv"]
 	if attachCsvOpt != nil {
 		p["attach_csv"] = attachCsvOpt
 	}
-	var filtersOpt = options["filters"]
+	var filtersOpt = opts["filters"]
 	if filtersOpt != nil {
 		p["filters"] = filtersOpt
 	}
+	if err := loc.api.ValidateParams("ScheduledReport", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ScheduledReport", "create")
 	if err != nil {
 		return res, err
@@ -3334,15 +5803,10 @@ func (loc *ScheduledReportLocator) Create(frequency string, name string, options
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -3351,18 +5815,53 @@ func (loc *ScheduledReportLocator) Create(frequency string, name string, options
 	}
 }
 
+// ScheduledReportIndexOptions contains the optional parameters of ScheduledReportLocator.Index.
+type ScheduledReportIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScheduledReportIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScheduledReportLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/scheduled_reports
 //
 // List all ScheduledReports.
-func (loc *ScheduledReportLocator) Index(options rsapi.APIParams) (*ScheduledReport, error) {
+func (loc *ScheduledReportLocator) Index(options *ScheduledReportIndexOptions) (*ScheduledReport, error) {
 	var res *ScheduledReport
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ScheduledReport", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ScheduledReport", "index")
 	if err != nil {
 		return res, err
@@ -3375,36 +5874,61 @@ func (loc *ScheduledReportLocator) Index(options rsapi.APIParams) (*ScheduledRep
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ScheduledReportShowOptions contains the optional parameters of ScheduledReportLocator.Show.
+type ScheduledReportShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScheduledReportShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScheduledReportLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/scheduled_reports/:id
 //
 // Show a specific ScheduledReport.
-func (loc *ScheduledReportLocator) Show(options rsapi.APIParams) (*ScheduledReport, error) {
+func (loc *ScheduledReportLocator) Show(options *ScheduledReportShowOptions) (*ScheduledReport, error) {
 	var res *ScheduledReport
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ScheduledReport", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ScheduledReport", "show")
 	if err != nil {
 		return res, err
@@ -3417,53 +5941,100 @@ func (loc *ScheduledReportLocator) Show(options rsapi.APIParams) (*ScheduledRepo
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ScheduledReportUpdateOptions contains the optional parameters of ScheduledReportLocator.Update.
+type ScheduledReportUpdateOptions struct {
+	View string
+	// In addition to your email, the report will be sent to these additional email addresses.
+	AdditionalEmails []string
+	// Whether the emails should include a CSV attachement of the instance data.
+	AttachCsv bool
+	// The frequency at which reports are emailed.
+	// Daily reports are sent every day but the cost reports can be a few days behind.
+	// Weekly reports are sent every Wednesday for the prior week (Sun - Mon).
+	// Monthly reports are sent on the 3rd of each month for the prior month.
+	Frequency string
+	Name      string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScheduledReportUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "additional_emails", "attach_csv", "frequency", "name":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScheduledReportLocator.Update", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.AdditionalEmails != nil {
+		p["additional_emails"] = o.AdditionalEmails
+	}
+	if o.AttachCsv {
+		p["attach_csv"] = o.AttachCsv
+	}
+	if o.Frequency != "" {
+		p["frequency"] = o.Frequency
+	}
+	if o.Name != "" {
+		p["name"] = o.Name
+	}
+	return p, nil
+}
+
 // PATCH /api/scheduled_reports/:id
 //
 // Update the provided attributes of a ScheduledReport.
-func (loc *ScheduledReportLocator) Update(options rsapi.APIParams) (*ScheduledReport, error) {
+func (loc *ScheduledReportLocator) Update(options *ScheduledReportUpdateOptions) (*ScheduledReport, error) {
 	var res *ScheduledReport
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var additionalEmailsOpt = options["additional_emails"]
+	var additionalEmailsOpt = opts["additional_emails"]
 	if additionalEmailsOpt != nil {
 		p["additional_emails"] = additionalEmailsOpt
 	}
-	var attachCsvOpt = options["attach_csv"]
+	var attachCsvOpt = opts["attach_csv"]
 	if attachCsvOpt != nil {
 		p["attach_csv"] = attachCsvOpt
 	}
-	var frequencyOpt = options["frequency"]
+	var frequencyOpt = opts["frequency"]
 	if frequencyOpt != nil {
 		p["frequency"] = frequencyOpt
 	}
-	var nameOpt = options["name"]
+	var nameOpt = opts["name"]
 	if nameOpt != nil {
 		p["name"] = nameOpt
 	}
+	if err := loc.api.ValidateParams("ScheduledReport", "update", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ScheduledReport", "update")
 	if err != nil {
 		return res, err
@@ -3476,21 +6047,11 @@ func (loc *ScheduledReportLocator) Update(options rsapi.APIParams) (*ScheduledRe
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -3500,6 +6061,9 @@ func (loc *ScheduledReportLocator) Update(options rsapi.APIParams) (*ScheduledRe
 func (loc *ScheduledReportLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ScheduledReport", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ScheduledReport", "destroy")
 	if err != nil {
 		return err
@@ -3512,30 +6076,60 @@ func (loc *ScheduledReportLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ScheduledReportCreateDefaultsOptions contains the optional parameters of ScheduledReportLocator.CreateDefaults.
+type ScheduledReportCreateDefaultsOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScheduledReportCreateDefaultsOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScheduledReportLocator.CreateDefaults", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // POST /api/scheduled_reports/actions/create_defaults
 //
 // Create the default Scheduled Report: a weekly report with no filters
-func (loc *ScheduledReportLocator) CreateDefaults(options rsapi.APIParams) (*ScheduledReport, error) {
+func (loc *ScheduledReportLocator) CreateDefaults(options *ScheduledReportCreateDefaultsOptions) (*ScheduledReport, error) {
 	var res *ScheduledReport
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ScheduledReport", "create_defaults", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ScheduledReport", "create_defaults")
 	if err != nil {
 		return res, err
@@ -3548,21 +6142,11 @@ func (loc *ScheduledReportLocator) CreateDefaults(options rsapi.APIParams) (*Sch
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -3586,6 +6170,13 @@ func (api *API) TempInstancePriceLocator(href string) *TempInstancePriceLocator
 	return &TempInstancePriceLocator{Href(href), api}
 }
 
+// TempInstancePriceLocatorInterface lists the TempInstancePriceLocator methods, code that depends on the interface
+// rather than on TempInstancePriceLocator can be unit tested using the generated fake locators.
+type TempInstancePriceLocatorInterface interface {
+	Index() (string, error)
+	IndexReader() (io.ReadCloser, error)
+}
+
 //===== Actions
 
 // GET /api/temp_instance_prices
@@ -3595,6 +6186,9 @@ func (loc *TempInstancePriceLocator) Index() (string, error) {
 	var res string
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("TempInstancePrice", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("TempInstancePrice", "index")
 	if err != nil {
 		return res, err
@@ -3607,14 +6201,8 @@ func (loc *TempInstancePriceLocator) Index() (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -3625,6 +6213,32 @@ func (loc *TempInstancePriceLocator) Index() (string, error) {
 	return res, err
 }
 
+// IndexReader is identical to Index except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *TempInstancePriceLocator) IndexReader() (io.ReadCloser, error) {
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("TempInstancePrice", "index", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("TempInstancePrice", "index")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 /******  User ******/
 
 // Users can have various permissions on multiple accounts. Users with admin permissions in an account
@@ -3645,13 +6259,51 @@ func (api *API) UserLocator(href string) *UserLocator {
 	return &UserLocator{Href(href), api}
 }
 
+// UserLocatorInterface lists the UserLocator methods, code that depends on the interface
+// rather than on UserLocator can be unit tested using the generated fake locators.
+type UserLocatorInterface interface {
+	Create(accounts []*UserAccounts, email string, options *UserCreateOptions) (*UserLocator, error)
+	Index(options *UserIndexOptions) (*User, error)
+	Show(options *UserShowOptions) (*User, error)
+	Update(options *UserUpdateOptions) (*User, error)
+	Invite(options *UserInviteOptions) (*User, error)
+}
+
 //===== Actions
 
+// UserCreateOptions contains the optional parameters of UserLocator.Create.
+type UserCreateOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *UserCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of UserLocator.Create", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // POST /api/users
 //
 // Create a new user with the requested permissions in the requested accounts, and emails
 // them the login details. Returns an error if the user already exists.
-func (loc *UserLocator) Create(accounts []*UserAccounts, email string, options rsapi.APIParams) (*UserLocator, error) {
+func (loc *UserLocator) Create(accounts []*UserAccounts, email string, options *UserCreateOptions) (*UserLocator, error) {
 	var res *UserLocator
 	if len(accounts) == 0 {
 		return res, fmt.Errorf("accounts is required")
@@ -3659,9 +6311,13 @@ func (loc *UserLocator) Create(accounts []*UserAccounts, email string, options r
 	if email == "" {
 		return res, fmt.Errorf("email is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
@@ -3670,6 +6326,9 @@ func (loc *UserLocator) Create(accounts []*UserAccounts, email string, options r
 		"accounts": accounts,
 		"email":    email,
 	}
+	if err := loc.api.ValidateParams("User", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("User", "create")
 	if err != nil {
 		return res, err
@@ -3682,15 +6341,10 @@ func (loc *UserLocator) Create(accounts []*UserAccounts, email string, options r
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -3699,18 +6353,53 @@ func (loc *UserLocator) Create(accounts []*UserAccounts, email string, options r
 	}
 }
 
+// UserIndexOptions contains the optional parameters of UserLocator.Index.
+type UserIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *UserIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of UserLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/users
 //
 // List all users.
-func (loc *UserLocator) Index(options rsapi.APIParams) (*User, error) {
+func (loc *UserLocator) Index(options *UserIndexOptions) (*User, error) {
 	var res *User
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("User", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("User", "index")
 	if err != nil {
 		return res, err
@@ -3723,36 +6412,61 @@ func (loc *UserLocator) Index(options rsapi.APIParams) (*User, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// UserShowOptions contains the optional parameters of UserLocator.Show.
+type UserShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *UserShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of UserLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/users/:id
 //
 // Show a specific user.
-func (loc *UserLocator) Show(options rsapi.APIParams) (*User, error) {
+func (loc *UserLocator) Show(options *UserShowOptions) (*User, error) {
 	var res *User
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("User", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("User", "show")
 	if err != nil {
 		return res, err
@@ -3765,42 +6479,72 @@ func (loc *UserLocator) Show(options rsapi.APIParams) (*User, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// UserUpdateOptions contains the optional parameters of UserLocator.Update.
+type UserUpdateOptions struct {
+	View string
+	// List of accounts that the user has access to.
+	Accounts []*UserAccounts
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *UserUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "accounts":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of UserLocator.Update", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.Accounts != nil {
+		p["accounts"] = o.Accounts
+	}
+	return p, nil
+}
+
 // PATCH /api/users/:id
 //
 // Update a specific user's account permissions.
 // This cannot be used to update other user parameters such as their name or password.
-func (loc *UserLocator) Update(options rsapi.APIParams) (*User, error) {
+func (loc *UserLocator) Update(options *UserUpdateOptions) (*User, error) {
 	var res *User
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var accountsOpt = options["accounts"]
+	var accountsOpt = opts["accounts"]
 	if accountsOpt != nil {
 		p["accounts"] = accountsOpt
 	}
+	if err := loc.api.ValidateParams("User", "update", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("User", "update")
 	if err != nil {
 		return res, err
@@ -3813,47 +6557,83 @@ func (loc *UserLocator) Update(options rsapi.APIParams) (*User, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// UserInviteOptions contains the optional parameters of UserLocator.Invite.
+type UserInviteOptions struct {
+	// RightScale account ID.
+	AccountId int
+	// User's email address.
+	Email string
+	// Optional message to include in the invitation email.
+	Message string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *UserInviteOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "account_id", "email", "message":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of UserLocator.Invite", n)
+		}
+	}
+	if o.AccountId != 0 {
+		p["account_id"] = o.AccountId
+	}
+	if o.Email != "" {
+		p["email"] = o.Email
+	}
+	if o.Message != "" {
+		p["message"] = o.Message
+	}
+	return p, nil
+}
+
 // POST /api/users/actions/invite
 //
 // Invites a user to the requested account and gives them the required permissions
 // so they can add/edit cloud credentials, the user is created if they don't already exist.
 // This is used during new user onboarding as the user who signs-up might not be the person who has
 // the cloud credentials required to connect their clouds to RightScale.
-func (loc *UserLocator) Invite(options rsapi.APIParams) (*User, error) {
+func (loc *UserLocator) Invite(options *UserInviteOptions) (*User, error) {
 	var res *User
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var accountIdOpt = options["account_id"]
+	var accountIdOpt = opts["account_id"]
 	if accountIdOpt != nil {
 		p["account_id"] = accountIdOpt
 	}
-	var emailOpt = options["email"]
+	var emailOpt = opts["email"]
 	if emailOpt != nil {
 		p["email"] = emailOpt
 	}
-	var messageOpt = options["message"]
+	var messageOpt = opts["message"]
 	if messageOpt != nil {
 		p["message"] = messageOpt
 	}
+	if err := loc.api.ValidateParams("User", "invite", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("User", "invite")
 	if err != nil {
 		return res, err
@@ -3866,21 +6646,11 @@ func (loc *UserLocator) Invite(options rsapi.APIParams) (*User, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -3903,20 +6673,62 @@ func (api *API) UserSettingLocator(href string) *UserSettingLocator {
 	return &UserSettingLocator{Href(href), api}
 }
 
+// UserSettingLocatorInterface lists the UserSettingLocator methods, code that depends on the interface
+// rather than on UserSettingLocator can be unit tested using the generated fake locators.
+type UserSettingLocatorInterface interface {
+	Show(options *UserSettingShowOptions) (*UserSetting, error)
+	Update(options *UserSettingUpdateOptions) (*UserSetting, error)
+}
+
 //===== Actions
 
+// UserSettingShowOptions contains the optional parameters of UserSettingLocator.Show.
+type UserSettingShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *UserSettingShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of UserSettingLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/user_settings
 //
 // List the UserSettings.
-func (loc *UserSettingLocator) Show(options rsapi.APIParams) (*UserSetting, error) {
+func (loc *UserSettingLocator) Show(options *UserSettingShowOptions) (*UserSetting, error) {
 	var res *UserSetting
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("UserSetting", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("UserSetting", "show")
 	if err != nil {
 		return res, err
@@ -3929,85 +6741,158 @@ func (loc *UserSettingLocator) Show(options rsapi.APIParams) (*UserSetting, erro
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// UserSettingUpdateOptions contains the optional parameters of UserSettingLocator.Update.
+type UserSettingUpdateOptions struct {
+	View                     string
+	DateRange                *DateRangeStruct
+	DismissedDialogs         map[string]interface{}
+	ExcludedTagTypes         []string
+	Filters                  []*Filter
+	Granularity              string
+	MainMenuVisibility       string
+	Metrics                  []string
+	ModuleStates             []*ModuleState
+	OnboardingStatus         string
+	SelectedCloudVendorNames map[string]interface{}
+	Sorting                  map[string]interface{}
+	TableColumnVisibility    map[string]interface{}
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *UserSettingUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view", "date_range", "dismissed_dialogs", "excluded_tag_types", "filters", "granularity", "main_menu_visibility", "metrics", "module_states", "onboarding_status", "selected_cloud_vendor_names", "sorting", "table_column_visibility":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of UserSettingLocator.Update", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.DateRange != nil {
+		p["date_range"] = o.DateRange
+	}
+	if o.DismissedDialogs != nil {
+		p["dismissed_dialogs"] = o.DismissedDialogs
+	}
+	if o.ExcludedTagTypes != nil {
+		p["excluded_tag_types"] = o.ExcludedTagTypes
+	}
+	if o.Filters != nil {
+		p["filters"] = o.Filters
+	}
+	if o.Granularity != "" {
+		p["granularity"] = o.Granularity
+	}
+	if o.MainMenuVisibility != "" {
+		p["main_menu_visibility"] = o.MainMenuVisibility
+	}
+	if o.Metrics != nil {
+		p["metrics"] = o.Metrics
+	}
+	if o.ModuleStates != nil {
+		p["module_states"] = o.ModuleStates
+	}
+	if o.OnboardingStatus != "" {
+		p["onboarding_status"] = o.OnboardingStatus
+	}
+	if o.SelectedCloudVendorNames != nil {
+		p["selected_cloud_vendor_names"] = o.SelectedCloudVendorNames
+	}
+	if o.Sorting != nil {
+		p["sorting"] = o.Sorting
+	}
+	if o.TableColumnVisibility != nil {
+		p["table_column_visibility"] = o.TableColumnVisibility
+	}
+	return p, nil
+}
+
 // PATCH /api/user_settings
 //
 // Update the provided attributes of UserSettings.
-func (loc *UserSettingLocator) Update(options rsapi.APIParams) (*UserSetting, error) {
+func (loc *UserSettingLocator) Update(options *UserSettingUpdateOptions) (*UserSetting, error) {
 	var res *UserSetting
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var dateRangeOpt = options["date_range"]
+	var dateRangeOpt = opts["date_range"]
 	if dateRangeOpt != nil {
 		p["date_range"] = dateRangeOpt
 	}
-	var dismissedDialogsOpt = options["dismissed_dialogs"]
+	var dismissedDialogsOpt = opts["dismissed_dialogs"]
 	if dismissedDialogsOpt != nil {
 		p["dismissed_dialogs"] = dismissedDialogsOpt
 	}
-	var excludedTagTypesOpt = options["excluded_tag_types"]
+	var excludedTagTypesOpt = opts["excluded_tag_types"]
 	if excludedTagTypesOpt != nil {
 		p["excluded_tag_types"] = excludedTagTypesOpt
 	}
-	var filtersOpt = options["filters"]
+	var filtersOpt = opts["filters"]
 	if filtersOpt != nil {
 		p["filters"] = filtersOpt
 	}
-	var granularityOpt = options["granularity"]
+	var granularityOpt = opts["granularity"]
 	if granularityOpt != nil {
 		p["granularity"] = granularityOpt
 	}
-	var mainMenuVisibilityOpt = options["main_menu_visibility"]
+	var mainMenuVisibilityOpt = opts["main_menu_visibility"]
 	if mainMenuVisibilityOpt != nil {
 		p["main_menu_visibility"] = mainMenuVisibilityOpt
 	}
-	var metricsOpt = options["metrics"]
+	var metricsOpt = opts["metrics"]
 	if metricsOpt != nil {
 		p["metrics"] = metricsOpt
 	}
-	var moduleStatesOpt = options["module_states"]
+	var moduleStatesOpt = opts["module_states"]
 	if moduleStatesOpt != nil {
 		p["module_states"] = moduleStatesOpt
 	}
-	var onboardingStatusOpt = options["onboarding_status"]
+	var onboardingStatusOpt = opts["onboarding_status"]
 	if onboardingStatusOpt != nil {
 		p["onboarding_status"] = onboardingStatusOpt
 	}
-	var selectedCloudVendorNamesOpt = options["selected_cloud_vendor_names"]
+	var selectedCloudVendorNamesOpt = opts["selected_cloud_vendor_names"]
 	if selectedCloudVendorNamesOpt != nil {
 		p["selected_cloud_vendor_names"] = selectedCloudVendorNamesOpt
 	}
-	var sortingOpt = options["sorting"]
+	var sortingOpt = opts["sorting"]
 	if sortingOpt != nil {
 		p["sorting"] = sortingOpt
 	}
-	var tableColumnVisibilityOpt = options["table_column_visibility"]
+	var tableColumnVisibilityOpt = opts["table_column_visibility"]
 	if tableColumnVisibilityOpt != nil {
 		p["table_column_visibility"] = tableColumnVisibilityOpt
 	}
+	if err := loc.api.ValidateParams("UserSetting", "update", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("UserSetting", "update")
 	if err != nil {
 		return res, err
@@ -4020,21 +6905,11 @@ func (loc *UserSettingLocator) Update(options rsapi.APIParams) (*UserSetting, er
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -4064,9 +6939,9 @@ type AccountParam struct {
 }
 
 type AnalysisSnapshotParam struct {
-	CreatedAt                   *time.Time     `json:"created_at,omitempty"`
+	CreatedAt                   *rsapi.Time    `json:"created_at,omitempty"`
 	CreatedBy                   string         `json:"created_by,omitempty"`
-	EndTime                     *time.Time     `json:"end_time,omitempty"`
+	EndTime                     *rsapi.Time    `json:"end_time,omitempty"`
 	ExcludedTagTypes            []string       `json:"excluded_tag_types,omitempty"`
 	Filters                     []*Filter      `json:"filters,omitempty"`
 	Granularity                 string         `json:"granularity,omitempty"`
@@ -4076,8 +6951,8 @@ type AnalysisSnapshotParam struct {
 	Metrics                     []string       `json:"metrics,omitempty"`
 	MissingAccessToSomeAccounts bool           `json:"missing_access_to_some_accounts,omitempty"`
 	ModuleStates                []*ModuleState `json:"module_states,omitempty"`
-	StartTime                   *time.Time     `json:"start_time,omitempty"`
-	UpdatedAt                   *time.Time     `json:"updated_at,omitempty"`
+	StartTime                   *rsapi.Time    `json:"start_time,omitempty"`
+	UpdatedAt                   *rsapi.Time    `json:"updated_at,omitempty"`
 	Uuid                        string         `json:"uuid,omitempty"`
 }
 
@@ -4085,7 +6960,7 @@ type BudgetAlertParam struct {
 	AdditionalEmails []string            `json:"additional_emails,omitempty"`
 	AttachCsv        bool                `json:"attach_csv,omitempty"`
 	Budget           *ReturnBudgetStruct `json:"budget,omitempty"`
-	CreatedAt        *time.Time          `json:"created_at,omitempty"`
+	CreatedAt        *rsapi.Time         `json:"created_at,omitempty"`
 	Filters          []*Filter           `json:"filters,omitempty"`
 	Frequency        string              `json:"frequency,omitempty"`
 	Href             string              `json:"href,omitempty"`
@@ -4093,7 +6968,7 @@ type BudgetAlertParam struct {
 	Kind             string              `json:"kind,omitempty"`
 	Name             string              `json:"name,omitempty"`
 	Type_            string              `json:"type,omitempty"`
-	UpdatedAt        *time.Time          `json:"updated_at,omitempty"`
+	UpdatedAt        *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 type BudgetStruct struct {
@@ -4109,23 +6984,23 @@ type CloudAccount struct {
 }
 
 type CurrentUserParam struct {
-	Company   string     `json:"company,omitempty"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	Email     string     `json:"email,omitempty"`
-	FirstName string     `json:"first_name,omitempty"`
-	Id        int        `json:"id,omitempty"`
-	Kind      string     `json:"kind,omitempty"`
-	LastName  string     `json:"last_name,omitempty"`
-	Phone     string     `json:"phone,omitempty"`
-	Timezone  string     `json:"timezone,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Company   string      `json:"company,omitempty"`
+	CreatedAt *rsapi.Time `json:"created_at,omitempty"`
+	Email     string      `json:"email,omitempty"`
+	FirstName string      `json:"first_name,omitempty"`
+	Id        int         `json:"id,omitempty"`
+	Kind      string      `json:"kind,omitempty"`
+	LastName  string      `json:"last_name,omitempty"`
+	Phone     string      `json:"phone,omitempty"`
+	Timezone  string      `json:"timezone,omitempty"`
+	UpdatedAt *rsapi.Time `json:"updated_at,omitempty"`
 }
 
 type DateRangeStruct struct {
-	EndTime      *time.Time `json:"end_time,omitempty"`
-	IsComparison bool       `json:"is_comparison,omitempty"`
-	StartTime    *time.Time `json:"start_time,omitempty"`
-	Type_        string     `json:"type,omitempty"`
+	EndTime      *rsapi.Time `json:"end_time,omitempty"`
+	IsComparison bool        `json:"is_comparison,omitempty"`
+	StartTime    *rsapi.Time `json:"start_time,omitempty"`
+	Type_        string      `json:"type,omitempty"`
 }
 
 type Filter struct {
@@ -4143,7 +7018,7 @@ type InstanceCombinationLinks struct {
 type InstanceCombinationParam struct {
 	CloudName                 string                           `json:"cloud_name,omitempty"`
 	CloudVendorName           string                           `json:"cloud_vendor_name,omitempty"`
-	CreatedAt                 *time.Time                       `json:"created_at,omitempty"`
+	CreatedAt                 *rsapi.Time                      `json:"created_at,omitempty"`
 	DatacenterName            string                           `json:"datacenter_name,omitempty"`
 	Href                      string                           `json:"href,omitempty"`
 	Id                        int                              `json:"id,omitempty"`
@@ -4157,54 +7032,54 @@ type InstanceCombinationParam struct {
 	Quantity                  int                              `json:"quantity,omitempty"`
 	ReservedInstancePurchases []*ReservedInstancePurchaseParam `json:"reserved_instance_purchases,omitempty"`
 	Scenario                  *ScenarioParam                   `json:"scenario,omitempty"`
-	UpdatedAt                 *time.Time                       `json:"updated_at,omitempty"`
+	UpdatedAt                 *rsapi.Time                      `json:"updated_at,omitempty"`
 }
 
 type InstanceParam struct {
-	AccountId                         int        `json:"account_id,omitempty"`
-	AccountName                       string     `json:"account_name,omitempty"`
-	CloudId                           int        `json:"cloud_id,omitempty"`
-	CloudName                         string     `json:"cloud_name,omitempty"`
-	CloudVendorName                   string     `json:"cloud_vendor_name,omitempty"`
-	DatacenterKey                     string     `json:"datacenter_key,omitempty"`
-	DatacenterName                    string     `json:"datacenter_name,omitempty"`
-	DeploymentId                      int        `json:"deployment_id,omitempty"`
-	DeploymentName                    string     `json:"deployment_name,omitempty"`
-	EstimatedCostForPeriod            float64    `json:"estimated_cost_for_period,omitempty"`
-	EstimatedManagedRcuCountForPeriod float64    `json:"estimated_managed_rcu_count_for_period,omitempty"`
-	IncarnatorId                      int        `json:"incarnator_id,omitempty"`
-	IncarnatorType                    string     `json:"incarnator_type,omitempty"`
-	InstanceEndAt                     *time.Time `json:"instance_end_at,omitempty"`
-	InstanceKey                       string     `json:"instance_key,omitempty"`
-	InstanceName                      string     `json:"instance_name,omitempty"`
-	InstanceRsid                      string     `json:"instance_rsid,omitempty"`
-	InstanceStartAt                   *time.Time `json:"instance_start_at,omitempty"`
-	InstanceTypeKey                   string     `json:"instance_type_key,omitempty"`
-	InstanceTypeName                  string     `json:"instance_type_name,omitempty"`
-	InstanceUid                       string     `json:"instance_uid,omitempty"`
-	Kind                              string     `json:"kind,omitempty"`
-	Platform                          string     `json:"platform,omitempty"`
-	ProvisionedByUserEmail            string     `json:"provisioned_by_user_email,omitempty"`
-	ProvisionedByUserId               int        `json:"provisioned_by_user_id,omitempty"`
-	ServerTemplateId                  int        `json:"server_template_id,omitempty"`
-	ServerTemplateName                string     `json:"server_template_name,omitempty"`
-	State                             string     `json:"state,omitempty"`
-	Tags                              []*Tag     `json:"tags,omitempty"`
-	TotalUsageHours                   float64    `json:"total_usage_hours,omitempty"`
+	AccountId                         int         `json:"account_id,omitempty"`
+	AccountName                       string      `json:"account_name,omitempty"`
+	CloudId                           int         `json:"cloud_id,omitempty"`
+	CloudName                         string      `json:"cloud_name,omitempty"`
+	CloudVendorName                   string      `json:"cloud_vendor_name,omitempty"`
+	DatacenterKey                     string      `json:"datacenter_key,omitempty"`
+	DatacenterName                    string      `json:"datacenter_name,omitempty"`
+	DeploymentId                      int         `json:"deployment_id,omitempty"`
+	DeploymentName                    string      `json:"deployment_name,omitempty"`
+	EstimatedCostForPeriod            float64     `json:"estimated_cost_for_period,omitempty"`
+	EstimatedManagedRcuCountForPeriod float64     `json:"estimated_managed_rcu_count_for_period,omitempty"`
+	IncarnatorId                      int         `json:"incarnator_id,omitempty"`
+	IncarnatorType                    string      `json:"incarnator_type,omitempty"`
+	InstanceEndAt                     *rsapi.Time `json:"instance_end_at,omitempty"`
+	InstanceKey                       string      `json:"instance_key,omitempty"`
+	InstanceName                      string      `json:"instance_name,omitempty"`
+	InstanceRsid                      string      `json:"instance_rsid,omitempty"`
+	InstanceStartAt                   *rsapi.Time `json:"instance_start_at,omitempty"`
+	InstanceTypeKey                   string      `json:"instance_type_key,omitempty"`
+	InstanceTypeName                  string      `json:"instance_type_name,omitempty"`
+	InstanceUid                       string      `json:"instance_uid,omitempty"`
+	Kind                              string      `json:"kind,omitempty"`
+	Platform                          string      `json:"platform,omitempty"`
+	ProvisionedByUserEmail            string      `json:"provisioned_by_user_email,omitempty"`
+	ProvisionedByUserId               int         `json:"provisioned_by_user_id,omitempty"`
+	ServerTemplateId                  int         `json:"server_template_id,omitempty"`
+	ServerTemplateName                string      `json:"server_template_name,omitempty"`
+	State                             string      `json:"state,omitempty"`
+	Tags                              []*Tag      `json:"tags,omitempty"`
+	TotalUsageHours                   float64     `json:"total_usage_hours,omitempty"`
 }
 
 type InstanceUsagePeriodParam struct {
-	EstimatedCost            float64    `json:"estimated_cost,omitempty"`
-	EstimatedManagedRcuCount float64    `json:"estimated_managed_rcu_count,omitempty"`
-	HourlyPrice              float64    `json:"hourly_price,omitempty"`
-	InstanceKey              string     `json:"instance_key,omitempty"`
-	InstanceTypeName         string     `json:"instance_type_name,omitempty"`
-	Kind                     string     `json:"kind,omitempty"`
-	PricingType              string     `json:"pricing_type,omitempty"`
-	RcuRate                  float64    `json:"rcu_rate,omitempty"`
-	ReservationUid           string     `json:"reservation_uid,omitempty"`
-	UsageEndAt               *time.Time `json:"usage_end_at,omitempty"`
-	UsageStartAt             *time.Time `json:"usage_start_at,omitempty"`
+	EstimatedCost            float64     `json:"estimated_cost,omitempty"`
+	EstimatedManagedRcuCount float64     `json:"estimated_managed_rcu_count,omitempty"`
+	HourlyPrice              float64     `json:"hourly_price,omitempty"`
+	InstanceKey              string      `json:"instance_key,omitempty"`
+	InstanceTypeName         string      `json:"instance_type_name,omitempty"`
+	Kind                     string      `json:"kind,omitempty"`
+	PricingType              string      `json:"pricing_type,omitempty"`
+	RcuRate                  float64     `json:"rcu_rate,omitempty"`
+	ReservationUid           string      `json:"reservation_uid,omitempty"`
+	UsageEndAt               *rsapi.Time `json:"usage_end_at,omitempty"`
+	UsageStartAt             *rsapi.Time `json:"usage_start_at,omitempty"`
 }
 
 type Metrics struct {
@@ -4239,7 +7114,7 @@ type ModuleState struct {
 }
 
 type PatternParam struct {
-	CreatedAt *time.Time       `json:"created_at,omitempty"`
+	CreatedAt *rsapi.Time      `json:"created_at,omitempty"`
 	Href      string           `json:"href,omitempty"`
 	Id        int              `json:"id,omitempty"`
 	Kind      string           `json:"kind,omitempty"`
@@ -4249,34 +7124,34 @@ type PatternParam struct {
 	Scenarios []*ScenarioParam `json:"scenarios,omitempty"`
 	Summary   string           `json:"summary,omitempty"`
 	Type_     string           `json:"type,omitempty"`
-	UpdatedAt *time.Time       `json:"updated_at,omitempty"`
+	UpdatedAt *rsapi.Time      `json:"updated_at,omitempty"`
 	Value     float64          `json:"value,omitempty"`
 	Years     string           `json:"years,omitempty"`
 }
 
 type ReservedInstanceParam struct {
-	AccountId             int        `json:"account_id,omitempty"`
-	AccountName           string     `json:"account_name,omitempty"`
-	CloudId               int        `json:"cloud_id,omitempty"`
-	CloudName             string     `json:"cloud_name,omitempty"`
-	CloudVendorName       string     `json:"cloud_vendor_name,omitempty"`
-	CostSaved             float64    `json:"cost_saved,omitempty"`
-	DatacenterKey         string     `json:"datacenter_key,omitempty"`
-	DatacenterName        string     `json:"datacenter_name,omitempty"`
-	Duration              int        `json:"duration,omitempty"`
-	EndTime               *time.Time `json:"end_time,omitempty"`
-	InstanceCount         int        `json:"instance_count,omitempty"`
-	InstanceTypeKey       string     `json:"instance_type_key,omitempty"`
-	InstanceTypeName      string     `json:"instance_type_name,omitempty"`
-	Kind                  string     `json:"kind,omitempty"`
-	OfferingType          string     `json:"offering_type,omitempty"`
-	Platform              string     `json:"platform,omitempty"`
-	ReservationUid        string     `json:"reservation_uid,omitempty"`
-	StartTime             *time.Time `json:"start_time,omitempty"`
-	State                 string     `json:"state,omitempty"`
-	Tenancy               string     `json:"tenancy,omitempty"`
-	UnusedRecurringCost   float64    `json:"unused_recurring_cost,omitempty"`
-	UtilizationPercentage float64    `json:"utilization_percentage,omitempty"`
+	AccountId             int         `json:"account_id,omitempty"`
+	AccountName           string      `json:"account_name,omitempty"`
+	CloudId               int         `json:"cloud_id,omitempty"`
+	CloudName             string      `json:"cloud_name,omitempty"`
+	CloudVendorName       string      `json:"cloud_vendor_name,omitempty"`
+	CostSaved             float64     `json:"cost_saved,omitempty"`
+	DatacenterKey         string      `json:"datacenter_key,omitempty"`
+	DatacenterName        string      `json:"datacenter_name,omitempty"`
+	Duration              int         `json:"duration,omitempty"`
+	EndTime               *rsapi.Time `json:"end_time,omitempty"`
+	InstanceCount         int         `json:"instance_count,omitempty"`
+	InstanceTypeKey       string      `json:"instance_type_key,omitempty"`
+	InstanceTypeName      string      `json:"instance_type_name,omitempty"`
+	Kind                  string      `json:"kind,omitempty"`
+	OfferingType          string      `json:"offering_type,omitempty"`
+	Platform              string      `json:"platform,omitempty"`
+	ReservationUid        string      `json:"reservation_uid,omitempty"`
+	StartTime             *rsapi.Time `json:"start_time,omitempty"`
+	State                 string      `json:"state,omitempty"`
+	Tenancy               string      `json:"tenancy,omitempty"`
+	UnusedRecurringCost   float64     `json:"unused_recurring_cost,omitempty"`
+	UtilizationPercentage float64     `json:"utilization_percentage,omitempty"`
 }
 
 type ReservedInstancePurchaseLinks struct {
@@ -4285,7 +7160,7 @@ type ReservedInstancePurchaseLinks struct {
 
 type ReservedInstancePurchaseParam struct {
 	AutoRenew           bool                           `json:"auto_renew,omitempty"`
-	CreatedAt           *time.Time                     `json:"created_at,omitempty"`
+	CreatedAt           *rsapi.Time                    `json:"created_at,omitempty"`
 	Duration            int                            `json:"duration,omitempty"`
 	Href                string                         `json:"href,omitempty"`
 	Id                  int                            `json:"id,omitempty"`
@@ -4294,8 +7169,8 @@ type ReservedInstancePurchaseParam struct {
 	Links               *ReservedInstancePurchaseLinks `json:"links,omitempty"`
 	OfferingType        string                         `json:"offering_type,omitempty"`
 	Quantity            int                            `json:"quantity,omitempty"`
-	StartDate           *time.Time                     `json:"start_date,omitempty"`
-	UpdatedAt           *time.Time                     `json:"updated_at,omitempty"`
+	StartDate           *rsapi.Time                    `json:"start_date,omitempty"`
+	UpdatedAt           *rsapi.Time                    `json:"updated_at,omitempty"`
 }
 
 type ReturnBudgetStruct struct {
@@ -4304,30 +7179,30 @@ type ReturnBudgetStruct struct {
 }
 
 type ReturnCurrentUserStruct struct {
-	BetaEnabled                          bool       `json:"beta_enabled,omitempty"`
-	CanSeeCostAndRcuMetrics              bool       `json:"can_see_cost_and_rcu_metrics,omitempty"`
-	CanSeeManagedRcus                    bool       `json:"can_see_managed_rcus,omitempty"`
-	CanSeeUnmanagedRcus                  bool       `json:"can_see_unmanaged_rcus,omitempty"`
-	Company                              string     `json:"company,omitempty"`
-	Email                                string     `json:"email,omitempty"`
-	FirstLoginAt                         *time.Time `json:"first_login_at,omitempty"`
-	FirstName                            string     `json:"first_name,omitempty"`
-	HasAdminOnAnyAccount                 bool       `json:"has_admin_on_any_account,omitempty"`
-	HasCloudAnalyticsEnabledAccounts     bool       `json:"has_cloud_analytics_enabled_accounts,omitempty"`
-	HasNonIpWhitelistedAccountsWithAdmin bool       `json:"has_non_ip_whitelisted_accounts_with_admin,omitempty"`
-	HasOnlyExpiredAccounts               bool       `json:"has_only_expired_accounts,omitempty"`
-	Id                                   int        `json:"id,omitempty"`
-	IsCloudAnalyticsOnly                 bool       `json:"is_cloud_analytics_only,omitempty"`
-	IsRightscaleEmployee                 bool       `json:"is_rightscale_employee,omitempty"`
-	IsSelfserviceUser                    bool       `json:"is_selfservice_user,omitempty"`
-	IsTeamUser                           bool       `json:"is_team_user,omitempty"`
-	LastName                             string     `json:"last_name,omitempty"`
-	NotificationMessage                  string     `json:"notification_message,omitempty"`
-	Phone                                string     `json:"phone,omitempty"`
-	SelfserviceUrl                       string     `json:"selfservice_url,omitempty"`
-	Timezone                             string     `json:"timezone,omitempty"`
-	TimezoneOffsetSeconds                int        `json:"timezone_offset_seconds,omitempty"`
-	TrialEndDate                         *time.Time `json:"trial_end_date,omitempty"`
+	BetaEnabled                          bool        `json:"beta_enabled,omitempty"`
+	CanSeeCostAndRcuMetrics              bool        `json:"can_see_cost_and_rcu_metrics,omitempty"`
+	CanSeeManagedRcus                    bool        `json:"can_see_managed_rcus,omitempty"`
+	CanSeeUnmanagedRcus                  bool        `json:"can_see_unmanaged_rcus,omitempty"`
+	Company                              string      `json:"company,omitempty"`
+	Email                                string      `json:"email,omitempty"`
+	FirstLoginAt                         *rsapi.Time `json:"first_login_at,omitempty"`
+	FirstName                            string      `json:"first_name,omitempty"`
+	HasAdminOnAnyAccount                 bool        `json:"has_admin_on_any_account,omitempty"`
+	HasCloudAnalyticsEnabledAccounts     bool        `json:"has_cloud_analytics_enabled_accounts,omitempty"`
+	HasNonIpWhitelistedAccountsWithAdmin bool        `json:"has_non_ip_whitelisted_accounts_with_admin,omitempty"`
+	HasOnlyExpiredAccounts               bool        `json:"has_only_expired_accounts,omitempty"`
+	Id                                   int         `json:"id,omitempty"`
+	IsCloudAnalyticsOnly                 bool        `json:"is_cloud_analytics_only,omitempty"`
+	IsRightscaleEmployee                 bool        `json:"is_rightscale_employee,omitempty"`
+	IsSelfserviceUser                    bool        `json:"is_selfservice_user,omitempty"`
+	IsTeamUser                           bool        `json:"is_team_user,omitempty"`
+	LastName                             string      `json:"last_name,omitempty"`
+	NotificationMessage                  string      `json:"notification_message,omitempty"`
+	Phone                                string      `json:"phone,omitempty"`
+	SelfserviceUrl                       string      `json:"selfservice_url,omitempty"`
+	Timezone                             string      `json:"timezone,omitempty"`
+	TimezoneOffsetSeconds                int         `json:"timezone_offset_seconds,omitempty"`
+	TrialEndDate                         *rsapi.Time `json:"trial_end_date,omitempty"`
 }
 
 type ReturnGoogleAnalyticsStruct struct {
@@ -4336,14 +7211,14 @@ type ReturnGoogleAnalyticsStruct struct {
 }
 
 type ReturnUserSettingsDateRangeStruct struct {
-	EndTime      *time.Time `json:"end_time,omitempty"`
-	IsComparison bool       `json:"is_comparison,omitempty"`
-	StartTime    *time.Time `json:"start_time,omitempty"`
-	Type_        string     `json:"type,omitempty"`
+	EndTime      *rsapi.Time `json:"end_time,omitempty"`
+	IsComparison bool        `json:"is_comparison,omitempty"`
+	StartTime    *rsapi.Time `json:"start_time,omitempty"`
+	Type_        string      `json:"type,omitempty"`
 }
 
 type ScenarioParam struct {
-	CreatedAt                 *time.Time                  `json:"created_at,omitempty"`
+	CreatedAt                 *rsapi.Time                 `json:"created_at,omitempty"`
 	Filters                   []*Filter                   `json:"filters,omitempty"`
 	HistoricMetricsResults    []*TimeSeriesMetricsResult  `json:"historic_metrics_results,omitempty"`
 	Href                      string                      `json:"href,omitempty"`
@@ -4353,21 +7228,21 @@ type ScenarioParam struct {
 	Kind                      string                      `json:"kind,omitempty"`
 	Name                      string                      `json:"name,omitempty"`
 	PrivateCloudInstanceCount int                         `json:"private_cloud_instance_count,omitempty"`
-	SnapshotTimestamp         *time.Time                  `json:"snapshot_timestamp,omitempty"`
-	UpdatedAt                 *time.Time                  `json:"updated_at,omitempty"`
+	SnapshotTimestamp         *rsapi.Time                 `json:"snapshot_timestamp,omitempty"`
+	UpdatedAt                 *rsapi.Time                 `json:"updated_at,omitempty"`
 }
 
 type ScheduledReportParam struct {
-	AdditionalEmails []string   `json:"additional_emails,omitempty"`
-	AttachCsv        bool       `json:"attach_csv,omitempty"`
-	CreatedAt        *time.Time `json:"created_at,omitempty"`
-	Filters          []*Filter  `json:"filters,omitempty"`
-	Frequency        string     `json:"frequency,omitempty"`
-	Href             string     `json:"href,omitempty"`
-	Id               int        `json:"id,omitempty"`
-	Kind             string     `json:"kind,omitempty"`
-	Name             string     `json:"name,omitempty"`
-	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+	AdditionalEmails []string    `json:"additional_emails,omitempty"`
+	AttachCsv        bool        `json:"attach_csv,omitempty"`
+	CreatedAt        *rsapi.Time `json:"created_at,omitempty"`
+	Filters          []*Filter   `json:"filters,omitempty"`
+	Frequency        string      `json:"frequency,omitempty"`
+	Href             string      `json:"href,omitempty"`
+	Id               int         `json:"id,omitempty"`
+	Kind             string      `json:"kind,omitempty"`
+	Name             string      `json:"name,omitempty"`
+	UpdatedAt        *rsapi.Time `json:"updated_at,omitempty"`
 }
 
 type Tag struct {
@@ -4380,7 +7255,7 @@ type Tag struct {
 type TimeSeriesMetricsResult struct {
 	Kind      string           `json:"kind,omitempty"`
 	Results   []*MetricsResult `json:"results,omitempty"`
-	Timestamp *time.Time       `json:"timestamp,omitempty"`
+	Timestamp *rsapi.Time      `json:"timestamp,omitempty"`
 }
 
 type UserAccounts struct {
@@ -150,7 +150,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the snapshot.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -278,7 +278,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the snapshot.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -296,7 +296,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the snapshot.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -353,7 +353,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the snapshot.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -849,7 +849,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -865,7 +865,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -891,7 +891,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -907,7 +907,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -953,7 +953,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -969,7 +969,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -995,7 +995,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1011,7 +1011,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1378,7 +1378,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1418,7 +1418,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1445,7 +1445,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1485,7 +1485,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1525,7 +1525,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1541,7 +1541,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1560,7 +1560,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1576,7 +1576,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1608,7 +1608,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -1624,7 +1624,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -1643,7 +1643,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -1659,7 +1659,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -1691,7 +1691,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1731,7 +1731,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1758,7 +1758,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1798,7 +1798,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1838,7 +1838,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1894,7 +1894,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1921,7 +1921,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -1977,7 +1977,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -2081,7 +2081,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "patterns[][created_at]",
 						Description: `Timestamp of when the pattern was created.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -2160,7 +2160,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "patterns[][updated_at]",
 						Description: `Timestamp of when the pattern was last updated.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -2394,7 +2394,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "patterns[][created_at]",
 						Description: `Timestamp of when the pattern was created.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -2473,7 +2473,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "patterns[][updated_at]",
 						Description: `Timestamp of when the pattern was last updated.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -2670,7 +2670,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -2694,7 +2694,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -2721,7 +2721,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -2745,7 +2745,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -2787,7 +2787,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -2843,7 +2843,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -2870,7 +2870,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -2926,7 +2926,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -2968,7 +2968,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -3009,7 +3009,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -3036,7 +3036,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -3077,7 +3077,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -3120,7 +3120,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -3193,7 +3193,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -3220,7 +3220,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -3293,7 +3293,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -3872,7 +3872,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -3912,7 +3912,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -3939,7 +3939,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -3979,7 +3979,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4019,7 +4019,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4035,7 +4035,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4054,7 +4054,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4070,7 +4070,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4102,7 +4102,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -4118,7 +4118,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -4137,7 +4137,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -4153,7 +4153,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -4185,7 +4185,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4225,7 +4225,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4252,7 +4252,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4292,7 +4292,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4332,7 +4332,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4388,7 +4388,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4415,7 +4415,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "end_time",
 						Description: `The end time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4471,7 +4471,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_time",
 						Description: `The start time of the period.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.QueryParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4558,7 +4558,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_date",
 						Description: `Date at which the ReservedInstance purchase should start from, this can be a future date.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4610,7 +4610,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_date",
 						Description: `Date at which the ReservedInstance purchase should start from, this can be a future date.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4741,7 +4741,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_date",
 						Description: `Date at which the ReservedInstance purchase should start from, this can be a future date.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -4793,7 +4793,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "start_date",
 						Description: `Date at which the ReservedInstance purchase should start from, this can be a future date.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -4921,7 +4921,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "snapshot_timestamp",
 						Description: `The timestamp of when a snapshot of historic data was taken when creating the Scenario. When creating a new Scenario, you usually want to use the current time.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -4979,7 +4979,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "snapshot_timestamp",
 						Description: `The timestamp of when a snapshot of historic data was taken when creating the Scenario. When creating a new Scenario, you usually want to use the current time.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -5116,7 +5116,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "snapshot_timestamp",
 						Description: `The timestamp of when a snapshot of historic data was taken when creating the Scenario. When creating a new Scenario, you usually want to use the current time.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -5158,7 +5158,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "snapshot_timestamp",
 						Description: `The timestamp of when a snapshot of historic data was taken when creating the Scenario. When creating a new Scenario, you usually want to use the current time.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -6021,7 +6021,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "date_range[end_time]",
 						Description: ``,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -6037,7 +6037,7 @@ var GenMetadata = map[string]*metadata.Resource{
 					&metadata.ActionParam{
 						Name:        "date_range[start_time]",
 						Description: ``,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -38,8 +38,18 @@ func FromCommandLine(cmdLine *cmd.CommandLine) (*API, error) {
 	return fromAPI(raw), nil
 }
 
+// FromEnv builds a client from the environment variables set by rsc when running plugins.
+func FromEnv() (*API, error) {
+	raw, err := rsapi.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return fromAPI(raw), nil
+}
+
 // Wrap generic client into API 1.5 client
 func fromAPI(api *rsapi.API) *API {
 	api.Metadata = GenMetadata
+	api.TimeLayout = rsapi.RubyTimeLayout // API 1.5 date parameters must use the Ruby format
 	return &API{api}
 }
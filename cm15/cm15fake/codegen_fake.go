@@ -0,0 +1,11504 @@
+//************************************************************************//
+//                     RightScale API client fakes
+//
+// Generated with:
+// $ api15gen -metadata=cm15 -output=cm15
+//
+// The content of this file is auto-generated, DO NOT MODIFY
+//************************************************************************//
+
+// Package cm15fake provides fake implementations of the cm15 locator interfaces.
+// Each fake records the calls made to its methods and returns the values returned by the
+// corresponding stub functions if set, zero values otherwise.
+package cm15fake
+
+import (
+	"io"
+	"sync"
+
+	"github.com/rightscale/rsc/cm15"
+	"github.com/rightscale/rsc/rsapi"
+)
+
+/******  Account ******/
+
+// AccountLocator is a fake implementation of cm15.AccountLocatorInterface.
+type AccountLocator struct {
+	mu sync.Mutex
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.Account, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*AccountShowCall
+}
+
+var _ cm15.AccountLocatorInterface = (*AccountLocator)(nil)
+
+// AccountShowCall records the arguments of a call to AccountLocator.Show.
+type AccountShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *AccountLocator) Show() (*cm15.Account, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &AccountShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.Account
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *AccountLocator) ShowReturns(res *cm15.Account, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.Account, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  AccountGroup ******/
+
+// AccountGroupLocator is a fake implementation of cm15.AccountGroupLocatorInterface.
+type AccountGroupLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.AccountGroupIndexOptions) ([]*cm15.AccountGroup, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*AccountGroupIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.AccountGroupIndexOptions, func(*cm15.AccountGroup) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*AccountGroupIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.AccountGroupShowOptions) (*cm15.AccountGroup, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*AccountGroupShowCall
+}
+
+var _ cm15.AccountGroupLocatorInterface = (*AccountGroupLocator)(nil)
+
+// AccountGroupIndexCall records the arguments of a call to AccountGroupLocator.Index.
+type AccountGroupIndexCall struct {
+	Options *cm15.AccountGroupIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *AccountGroupLocator) Index(options *cm15.AccountGroupIndexOptions) ([]*cm15.AccountGroup, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &AccountGroupIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.AccountGroup
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *AccountGroupLocator) IndexReturns(res []*cm15.AccountGroup, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.AccountGroupIndexOptions) ([]*cm15.AccountGroup, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AccountGroupIndexEachCall records the arguments of a call to AccountGroupLocator.IndexEach.
+type AccountGroupIndexEachCall struct {
+	Options *cm15.AccountGroupIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *AccountGroupLocator) IndexEach(options *cm15.AccountGroupIndexOptions, fn func(*cm15.AccountGroup) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &AccountGroupIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *AccountGroupLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.AccountGroupIndexOptions, func(*cm15.AccountGroup) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// AccountGroupShowCall records the arguments of a call to AccountGroupLocator.Show.
+type AccountGroupShowCall struct {
+	Options *cm15.AccountGroupShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *AccountGroupLocator) Show(options *cm15.AccountGroupShowOptions) (*cm15.AccountGroup, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &AccountGroupShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.AccountGroup
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *AccountGroupLocator) ShowReturns(res *cm15.AccountGroup, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.AccountGroupShowOptions) (*cm15.AccountGroup, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Alert ******/
+
+// AlertLocator is a fake implementation of cm15.AlertLocatorInterface.
+type AlertLocator struct {
+	mu sync.Mutex
+
+	// DisableStub is called by Disable if not nil.
+	DisableStub func() error
+	// DisableCalls records the arguments of the calls made to Disable.
+	DisableCalls []*AlertDisableCall
+
+	// EnableStub is called by Enable if not nil.
+	EnableStub func() error
+	// EnableCalls records the arguments of the calls made to Enable.
+	EnableCalls []*AlertEnableCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.AlertIndexOptions) ([]*cm15.Alert, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*AlertIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.AlertIndexOptions, func(*cm15.Alert) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*AlertIndexEachCall
+
+	// QuenchStub is called by Quench if not nil.
+	QuenchStub func(string) error
+	// QuenchCalls records the arguments of the calls made to Quench.
+	QuenchCalls []*AlertQuenchCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.AlertShowOptions) (*cm15.Alert, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*AlertShowCall
+}
+
+var _ cm15.AlertLocatorInterface = (*AlertLocator)(nil)
+
+// AlertDisableCall records the arguments of a call to AlertLocator.Disable.
+type AlertDisableCall struct{}
+
+// Disable records the call and returns the results of DisableStub if set.
+func (fake *AlertLocator) Disable() error {
+	fake.mu.Lock()
+	fake.DisableCalls = append(fake.DisableCalls, &AlertDisableCall{})
+	stub := fake.DisableStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DisableReturns sets DisableStub to a function that returns the given values.
+func (fake *AlertLocator) DisableReturns(err error) {
+	fake.mu.Lock()
+	fake.DisableStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// AlertEnableCall records the arguments of a call to AlertLocator.Enable.
+type AlertEnableCall struct{}
+
+// Enable records the call and returns the results of EnableStub if set.
+func (fake *AlertLocator) Enable() error {
+	fake.mu.Lock()
+	fake.EnableCalls = append(fake.EnableCalls, &AlertEnableCall{})
+	stub := fake.EnableStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// EnableReturns sets EnableStub to a function that returns the given values.
+func (fake *AlertLocator) EnableReturns(err error) {
+	fake.mu.Lock()
+	fake.EnableStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// AlertIndexCall records the arguments of a call to AlertLocator.Index.
+type AlertIndexCall struct {
+	Options *cm15.AlertIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *AlertLocator) Index(options *cm15.AlertIndexOptions) ([]*cm15.Alert, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &AlertIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Alert
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *AlertLocator) IndexReturns(res []*cm15.Alert, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.AlertIndexOptions) ([]*cm15.Alert, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AlertIndexEachCall records the arguments of a call to AlertLocator.IndexEach.
+type AlertIndexEachCall struct {
+	Options *cm15.AlertIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *AlertLocator) IndexEach(options *cm15.AlertIndexOptions, fn func(*cm15.Alert) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &AlertIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *AlertLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.AlertIndexOptions, func(*cm15.Alert) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// AlertQuenchCall records the arguments of a call to AlertLocator.Quench.
+type AlertQuenchCall struct {
+	Duration string
+}
+
+// Quench records the call and returns the results of QuenchStub if set.
+func (fake *AlertLocator) Quench(duration string) error {
+	fake.mu.Lock()
+	fake.QuenchCalls = append(fake.QuenchCalls, &AlertQuenchCall{Duration: duration})
+	stub := fake.QuenchStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(duration)
+	}
+	return nil
+}
+
+// QuenchReturns sets QuenchStub to a function that returns the given values.
+func (fake *AlertLocator) QuenchReturns(err error) {
+	fake.mu.Lock()
+	fake.QuenchStub = func(string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// AlertShowCall records the arguments of a call to AlertLocator.Show.
+type AlertShowCall struct {
+	Options *cm15.AlertShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *AlertLocator) Show(options *cm15.AlertShowOptions) (*cm15.Alert, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &AlertShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Alert
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *AlertLocator) ShowReturns(res *cm15.Alert, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.AlertShowOptions) (*cm15.Alert, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  AlertSpec ******/
+
+// AlertSpecLocator is a fake implementation of cm15.AlertSpecLocatorInterface.
+type AlertSpecLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.AlertSpecParam) (*cm15.AlertSpecLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*AlertSpecCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*AlertSpecDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.AlertSpecIndexOptions) ([]*cm15.AlertSpec, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*AlertSpecIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.AlertSpecIndexOptions, func(*cm15.AlertSpec) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*AlertSpecIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.AlertSpecShowOptions) (*cm15.AlertSpec, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*AlertSpecShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.AlertSpecParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*AlertSpecUpdateCall
+}
+
+var _ cm15.AlertSpecLocatorInterface = (*AlertSpecLocator)(nil)
+
+// AlertSpecCreateCall records the arguments of a call to AlertSpecLocator.Create.
+type AlertSpecCreateCall struct {
+	AlertSpec *cm15.AlertSpecParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *AlertSpecLocator) Create(alertSpec *cm15.AlertSpecParam) (*cm15.AlertSpecLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &AlertSpecCreateCall{AlertSpec: alertSpec})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(alertSpec)
+	}
+	var res *cm15.AlertSpecLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *AlertSpecLocator) CreateReturns(res *cm15.AlertSpecLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.AlertSpecParam) (*cm15.AlertSpecLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AlertSpecDestroyCall records the arguments of a call to AlertSpecLocator.Destroy.
+type AlertSpecDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *AlertSpecLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &AlertSpecDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *AlertSpecLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// AlertSpecIndexCall records the arguments of a call to AlertSpecLocator.Index.
+type AlertSpecIndexCall struct {
+	Options *cm15.AlertSpecIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *AlertSpecLocator) Index(options *cm15.AlertSpecIndexOptions) ([]*cm15.AlertSpec, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &AlertSpecIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.AlertSpec
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *AlertSpecLocator) IndexReturns(res []*cm15.AlertSpec, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.AlertSpecIndexOptions) ([]*cm15.AlertSpec, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AlertSpecIndexEachCall records the arguments of a call to AlertSpecLocator.IndexEach.
+type AlertSpecIndexEachCall struct {
+	Options *cm15.AlertSpecIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *AlertSpecLocator) IndexEach(options *cm15.AlertSpecIndexOptions, fn func(*cm15.AlertSpec) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &AlertSpecIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *AlertSpecLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.AlertSpecIndexOptions, func(*cm15.AlertSpec) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// AlertSpecShowCall records the arguments of a call to AlertSpecLocator.Show.
+type AlertSpecShowCall struct {
+	Options *cm15.AlertSpecShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *AlertSpecLocator) Show(options *cm15.AlertSpecShowOptions) (*cm15.AlertSpec, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &AlertSpecShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.AlertSpec
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *AlertSpecLocator) ShowReturns(res *cm15.AlertSpec, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.AlertSpecShowOptions) (*cm15.AlertSpec, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AlertSpecUpdateCall records the arguments of a call to AlertSpecLocator.Update.
+type AlertSpecUpdateCall struct {
+	AlertSpec *cm15.AlertSpecParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *AlertSpecLocator) Update(alertSpec *cm15.AlertSpecParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &AlertSpecUpdateCall{AlertSpec: alertSpec})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(alertSpec)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *AlertSpecLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.AlertSpecParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  AuditEntry ******/
+
+// AuditEntryLocator is a fake implementation of cm15.AuditEntryLocatorInterface.
+type AuditEntryLocator struct {
+	mu sync.Mutex
+
+	// AppendStub is called by Append if not nil.
+	AppendStub func(*cm15.AuditEntryAppendOptions) error
+	// AppendCalls records the arguments of the calls made to Append.
+	AppendCalls []*AuditEntryAppendCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.AuditEntryParam, *cm15.AuditEntryCreateOptions) (*cm15.AuditEntryLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*AuditEntryCreateCall
+
+	// DetailStub is called by Detail if not nil.
+	DetailStub func() (string, error)
+	// DetailCalls records the arguments of the calls made to Detail.
+	DetailCalls []*AuditEntryDetailCall
+
+	// DetailReaderStub is called by DetailReader if not nil.
+	DetailReaderStub func() (io.ReadCloser, error)
+	// DetailReaderCalls records the arguments of the calls made to DetailReader.
+	DetailReaderCalls []*AuditEntryDetailCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*rsapi.Time, string, *rsapi.Time, *cm15.AuditEntryIndexOptions) ([]*cm15.AuditEntry, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*AuditEntryIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*rsapi.Time, string, *rsapi.Time, *cm15.AuditEntryIndexOptions, func(*cm15.AuditEntry) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*AuditEntryIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.AuditEntryShowOptions) (*cm15.AuditEntry, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*AuditEntryShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.AuditEntryParam2, *cm15.AuditEntryUpdateOptions) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*AuditEntryUpdateCall
+}
+
+var _ cm15.AuditEntryLocatorInterface = (*AuditEntryLocator)(nil)
+
+// AuditEntryAppendCall records the arguments of a call to AuditEntryLocator.Append.
+type AuditEntryAppendCall struct {
+	Options *cm15.AuditEntryAppendOptions
+}
+
+// Append records the call and returns the results of AppendStub if set.
+func (fake *AuditEntryLocator) Append(options *cm15.AuditEntryAppendOptions) error {
+	fake.mu.Lock()
+	fake.AppendCalls = append(fake.AppendCalls, &AuditEntryAppendCall{Options: options})
+	stub := fake.AppendStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// AppendReturns sets AppendStub to a function that returns the given values.
+func (fake *AuditEntryLocator) AppendReturns(err error) {
+	fake.mu.Lock()
+	fake.AppendStub = func(*cm15.AuditEntryAppendOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// AuditEntryCreateCall records the arguments of a call to AuditEntryLocator.Create.
+type AuditEntryCreateCall struct {
+	AuditEntry *cm15.AuditEntryParam
+	Options    *cm15.AuditEntryCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *AuditEntryLocator) Create(auditEntry *cm15.AuditEntryParam, options *cm15.AuditEntryCreateOptions) (*cm15.AuditEntryLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &AuditEntryCreateCall{AuditEntry: auditEntry, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(auditEntry, options)
+	}
+	var res *cm15.AuditEntryLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *AuditEntryLocator) CreateReturns(res *cm15.AuditEntryLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.AuditEntryParam, *cm15.AuditEntryCreateOptions) (*cm15.AuditEntryLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AuditEntryDetailCall records the arguments of a call to AuditEntryLocator.Detail.
+type AuditEntryDetailCall struct{}
+
+// Detail records the call and returns the results of DetailStub if set.
+func (fake *AuditEntryLocator) Detail() (string, error) {
+	fake.mu.Lock()
+	fake.DetailCalls = append(fake.DetailCalls, &AuditEntryDetailCall{})
+	stub := fake.DetailStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res string
+	return res, nil
+}
+
+// DetailReturns sets DetailStub to a function that returns the given values.
+func (fake *AuditEntryLocator) DetailReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.DetailStub = func() (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// DetailReader records the call and returns the results of DetailReaderStub if set.
+func (fake *AuditEntryLocator) DetailReader() (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.DetailReaderCalls = append(fake.DetailReaderCalls, &AuditEntryDetailCall{})
+	stub := fake.DetailReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// DetailReaderReturns sets DetailReaderStub to a function that returns the given values.
+func (fake *AuditEntryLocator) DetailReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.DetailReaderStub = func() (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AuditEntryIndexCall records the arguments of a call to AuditEntryLocator.Index.
+type AuditEntryIndexCall struct {
+	EndDate   *rsapi.Time
+	Limit     string
+	StartDate *rsapi.Time
+	Options   *cm15.AuditEntryIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *AuditEntryLocator) Index(endDate *rsapi.Time, limit string, startDate *rsapi.Time, options *cm15.AuditEntryIndexOptions) ([]*cm15.AuditEntry, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &AuditEntryIndexCall{EndDate: endDate, Limit: limit, StartDate: startDate, Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endDate, limit, startDate, options)
+	}
+	var res []*cm15.AuditEntry
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *AuditEntryLocator) IndexReturns(res []*cm15.AuditEntry, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*rsapi.Time, string, *rsapi.Time, *cm15.AuditEntryIndexOptions) ([]*cm15.AuditEntry, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AuditEntryIndexEachCall records the arguments of a call to AuditEntryLocator.IndexEach.
+type AuditEntryIndexEachCall struct {
+	EndDate   *rsapi.Time
+	Limit     string
+	StartDate *rsapi.Time
+	Options   *cm15.AuditEntryIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *AuditEntryLocator) IndexEach(endDate *rsapi.Time, limit string, startDate *rsapi.Time, options *cm15.AuditEntryIndexOptions, fn func(*cm15.AuditEntry) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &AuditEntryIndexEachCall{EndDate: endDate, Limit: limit, StartDate: startDate, Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(endDate, limit, startDate, options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(endDate, limit, startDate, options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *AuditEntryLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*rsapi.Time, string, *rsapi.Time, *cm15.AuditEntryIndexOptions, func(*cm15.AuditEntry) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// AuditEntryShowCall records the arguments of a call to AuditEntryLocator.Show.
+type AuditEntryShowCall struct {
+	Options *cm15.AuditEntryShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *AuditEntryLocator) Show(options *cm15.AuditEntryShowOptions) (*cm15.AuditEntry, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &AuditEntryShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.AuditEntry
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *AuditEntryLocator) ShowReturns(res *cm15.AuditEntry, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.AuditEntryShowOptions) (*cm15.AuditEntry, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AuditEntryUpdateCall records the arguments of a call to AuditEntryLocator.Update.
+type AuditEntryUpdateCall struct {
+	AuditEntry *cm15.AuditEntryParam2
+	Options    *cm15.AuditEntryUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *AuditEntryLocator) Update(auditEntry *cm15.AuditEntryParam2, options *cm15.AuditEntryUpdateOptions) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &AuditEntryUpdateCall{AuditEntry: auditEntry, Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(auditEntry, options)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *AuditEntryLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.AuditEntryParam2, *cm15.AuditEntryUpdateOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Backup ******/
+
+// BackupLocator is a fake implementation of cm15.BackupLocatorInterface.
+type BackupLocator struct {
+	mu sync.Mutex
+
+	// CleanupStub is called by Cleanup if not nil.
+	CleanupStub func(string, string, *cm15.BackupCleanupOptions) error
+	// CleanupCalls records the arguments of the calls made to Cleanup.
+	CleanupCalls []*BackupCleanupCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.BackupParam) (*cm15.BackupLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*BackupCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*BackupDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(string, *cm15.BackupIndexOptions) ([]*cm15.Backup, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*BackupIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(string, *cm15.BackupIndexOptions, func(*cm15.Backup) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*BackupIndexEachCall
+
+	// RestoreStub is called by Restore if not nil.
+	RestoreStub func(string, *cm15.BackupRestoreOptions) error
+	// RestoreCalls records the arguments of the calls made to Restore.
+	RestoreCalls []*BackupRestoreCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.Backup, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*BackupShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.BackupParam3) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*BackupUpdateCall
+}
+
+var _ cm15.BackupLocatorInterface = (*BackupLocator)(nil)
+
+// BackupCleanupCall records the arguments of a call to BackupLocator.Cleanup.
+type BackupCleanupCall struct {
+	KeepLast string
+	Lineage  string
+	Options  *cm15.BackupCleanupOptions
+}
+
+// Cleanup records the call and returns the results of CleanupStub if set.
+func (fake *BackupLocator) Cleanup(keepLast string, lineage string, options *cm15.BackupCleanupOptions) error {
+	fake.mu.Lock()
+	fake.CleanupCalls = append(fake.CleanupCalls, &BackupCleanupCall{KeepLast: keepLast, Lineage: lineage, Options: options})
+	stub := fake.CleanupStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(keepLast, lineage, options)
+	}
+	return nil
+}
+
+// CleanupReturns sets CleanupStub to a function that returns the given values.
+func (fake *BackupLocator) CleanupReturns(err error) {
+	fake.mu.Lock()
+	fake.CleanupStub = func(string, string, *cm15.BackupCleanupOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// BackupCreateCall records the arguments of a call to BackupLocator.Create.
+type BackupCreateCall struct {
+	Backup *cm15.BackupParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *BackupLocator) Create(backup *cm15.BackupParam) (*cm15.BackupLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &BackupCreateCall{Backup: backup})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(backup)
+	}
+	var res *cm15.BackupLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *BackupLocator) CreateReturns(res *cm15.BackupLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.BackupParam) (*cm15.BackupLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// BackupDestroyCall records the arguments of a call to BackupLocator.Destroy.
+type BackupDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *BackupLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &BackupDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *BackupLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// BackupIndexCall records the arguments of a call to BackupLocator.Index.
+type BackupIndexCall struct {
+	Lineage string
+	Options *cm15.BackupIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *BackupLocator) Index(lineage string, options *cm15.BackupIndexOptions) ([]*cm15.Backup, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &BackupIndexCall{Lineage: lineage, Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(lineage, options)
+	}
+	var res []*cm15.Backup
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *BackupLocator) IndexReturns(res []*cm15.Backup, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(string, *cm15.BackupIndexOptions) ([]*cm15.Backup, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// BackupIndexEachCall records the arguments of a call to BackupLocator.IndexEach.
+type BackupIndexEachCall struct {
+	Lineage string
+	Options *cm15.BackupIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *BackupLocator) IndexEach(lineage string, options *cm15.BackupIndexOptions, fn func(*cm15.Backup) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &BackupIndexEachCall{Lineage: lineage, Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(lineage, options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(lineage, options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *BackupLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(string, *cm15.BackupIndexOptions, func(*cm15.Backup) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// BackupRestoreCall records the arguments of a call to BackupLocator.Restore.
+type BackupRestoreCall struct {
+	InstanceHref string
+	Options      *cm15.BackupRestoreOptions
+}
+
+// Restore records the call and returns the results of RestoreStub if set.
+func (fake *BackupLocator) Restore(instanceHref string, options *cm15.BackupRestoreOptions) error {
+	fake.mu.Lock()
+	fake.RestoreCalls = append(fake.RestoreCalls, &BackupRestoreCall{InstanceHref: instanceHref, Options: options})
+	stub := fake.RestoreStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(instanceHref, options)
+	}
+	return nil
+}
+
+// RestoreReturns sets RestoreStub to a function that returns the given values.
+func (fake *BackupLocator) RestoreReturns(err error) {
+	fake.mu.Lock()
+	fake.RestoreStub = func(string, *cm15.BackupRestoreOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// BackupShowCall records the arguments of a call to BackupLocator.Show.
+type BackupShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *BackupLocator) Show() (*cm15.Backup, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &BackupShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.Backup
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *BackupLocator) ShowReturns(res *cm15.Backup, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.Backup, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// BackupUpdateCall records the arguments of a call to BackupLocator.Update.
+type BackupUpdateCall struct {
+	Backup *cm15.BackupParam3
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *BackupLocator) Update(backup *cm15.BackupParam3) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &BackupUpdateCall{Backup: backup})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(backup)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *BackupLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.BackupParam3) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  ChildAccount ******/
+
+// ChildAccountLocator is a fake implementation of cm15.ChildAccountLocatorInterface.
+type ChildAccountLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.ChildAccountParam) (*cm15.ChildAccountLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*ChildAccountCreateCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.ChildAccountIndexOptions) ([]*cm15.Account, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ChildAccountIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.ChildAccountIndexOptions, func(*cm15.Account) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*ChildAccountIndexEachCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.ChildAccountParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*ChildAccountUpdateCall
+}
+
+var _ cm15.ChildAccountLocatorInterface = (*ChildAccountLocator)(nil)
+
+// ChildAccountCreateCall records the arguments of a call to ChildAccountLocator.Create.
+type ChildAccountCreateCall struct {
+	ChildAccount *cm15.ChildAccountParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *ChildAccountLocator) Create(childAccount *cm15.ChildAccountParam) (*cm15.ChildAccountLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &ChildAccountCreateCall{ChildAccount: childAccount})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(childAccount)
+	}
+	var res *cm15.ChildAccountLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *ChildAccountLocator) CreateReturns(res *cm15.ChildAccountLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.ChildAccountParam) (*cm15.ChildAccountLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ChildAccountIndexCall records the arguments of a call to ChildAccountLocator.Index.
+type ChildAccountIndexCall struct {
+	Options *cm15.ChildAccountIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ChildAccountLocator) Index(options *cm15.ChildAccountIndexOptions) ([]*cm15.Account, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ChildAccountIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Account
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ChildAccountLocator) IndexReturns(res []*cm15.Account, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.ChildAccountIndexOptions) ([]*cm15.Account, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ChildAccountIndexEachCall records the arguments of a call to ChildAccountLocator.IndexEach.
+type ChildAccountIndexEachCall struct {
+	Options *cm15.ChildAccountIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *ChildAccountLocator) IndexEach(options *cm15.ChildAccountIndexOptions, fn func(*cm15.Account) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &ChildAccountIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *ChildAccountLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.ChildAccountIndexOptions, func(*cm15.Account) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ChildAccountUpdateCall records the arguments of a call to ChildAccountLocator.Update.
+type ChildAccountUpdateCall struct {
+	ChildAccount *cm15.ChildAccountParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *ChildAccountLocator) Update(childAccount *cm15.ChildAccountParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &ChildAccountUpdateCall{ChildAccount: childAccount})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(childAccount)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *ChildAccountLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.ChildAccountParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Cloud ******/
+
+// CloudLocator is a fake implementation of cm15.CloudLocatorInterface.
+type CloudLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.CloudIndexOptions) ([]*cm15.Cloud, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*CloudIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.CloudIndexOptions, func(*cm15.Cloud) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*CloudIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.CloudShowOptions) (*cm15.Cloud, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*CloudShowCall
+}
+
+var _ cm15.CloudLocatorInterface = (*CloudLocator)(nil)
+
+// CloudIndexCall records the arguments of a call to CloudLocator.Index.
+type CloudIndexCall struct {
+	Options *cm15.CloudIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *CloudLocator) Index(options *cm15.CloudIndexOptions) ([]*cm15.Cloud, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &CloudIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Cloud
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *CloudLocator) IndexReturns(res []*cm15.Cloud, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.CloudIndexOptions) ([]*cm15.Cloud, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CloudIndexEachCall records the arguments of a call to CloudLocator.IndexEach.
+type CloudIndexEachCall struct {
+	Options *cm15.CloudIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *CloudLocator) IndexEach(options *cm15.CloudIndexOptions, fn func(*cm15.Cloud) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &CloudIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *CloudLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.CloudIndexOptions, func(*cm15.Cloud) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CloudShowCall records the arguments of a call to CloudLocator.Show.
+type CloudShowCall struct {
+	Options *cm15.CloudShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *CloudLocator) Show(options *cm15.CloudShowOptions) (*cm15.Cloud, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &CloudShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Cloud
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *CloudLocator) ShowReturns(res *cm15.Cloud, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.CloudShowOptions) (*cm15.Cloud, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  CloudAccount ******/
+
+// CloudAccountLocator is a fake implementation of cm15.CloudAccountLocatorInterface.
+type CloudAccountLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.CloudAccountParam) (*cm15.CloudAccountLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*CloudAccountCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*CloudAccountDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func() ([]*cm15.CloudAccount, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*CloudAccountIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(func(*cm15.CloudAccount) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*CloudAccountIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.CloudAccount, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*CloudAccountShowCall
+}
+
+var _ cm15.CloudAccountLocatorInterface = (*CloudAccountLocator)(nil)
+
+// CloudAccountCreateCall records the arguments of a call to CloudAccountLocator.Create.
+type CloudAccountCreateCall struct {
+	CloudAccount *cm15.CloudAccountParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *CloudAccountLocator) Create(cloudAccount *cm15.CloudAccountParam) (*cm15.CloudAccountLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &CloudAccountCreateCall{CloudAccount: cloudAccount})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(cloudAccount)
+	}
+	var res *cm15.CloudAccountLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *CloudAccountLocator) CreateReturns(res *cm15.CloudAccountLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.CloudAccountParam) (*cm15.CloudAccountLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CloudAccountDestroyCall records the arguments of a call to CloudAccountLocator.Destroy.
+type CloudAccountDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *CloudAccountLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &CloudAccountDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *CloudAccountLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CloudAccountIndexCall records the arguments of a call to CloudAccountLocator.Index.
+type CloudAccountIndexCall struct{}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *CloudAccountLocator) Index() ([]*cm15.CloudAccount, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &CloudAccountIndexCall{})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res []*cm15.CloudAccount
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *CloudAccountLocator) IndexReturns(res []*cm15.CloudAccount, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func() ([]*cm15.CloudAccount, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CloudAccountIndexEachCall records the arguments of a call to CloudAccountLocator.IndexEach.
+type CloudAccountIndexEachCall struct{}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *CloudAccountLocator) IndexEach(fn func(*cm15.CloudAccount) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &CloudAccountIndexEachCall{})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each()
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *CloudAccountLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(func(*cm15.CloudAccount) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CloudAccountShowCall records the arguments of a call to CloudAccountLocator.Show.
+type CloudAccountShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *CloudAccountLocator) Show() (*cm15.CloudAccount, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &CloudAccountShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.CloudAccount
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *CloudAccountLocator) ShowReturns(res *cm15.CloudAccount, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.CloudAccount, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Cookbook ******/
+
+// CookbookLocator is a fake implementation of cm15.CookbookLocatorInterface.
+type CookbookLocator struct {
+	mu sync.Mutex
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*CookbookDestroyCall
+
+	// FollowStub is called by Follow if not nil.
+	FollowStub func(string) error
+	// FollowCalls records the arguments of the calls made to Follow.
+	FollowCalls []*CookbookFollowCall
+
+	// FreezeStub is called by Freeze if not nil.
+	FreezeStub func(string) error
+	// FreezeCalls records the arguments of the calls made to Freeze.
+	FreezeCalls []*CookbookFreezeCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.CookbookIndexOptions) ([]*cm15.Cookbook, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*CookbookIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.CookbookIndexOptions, func(*cm15.Cookbook) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*CookbookIndexEachCall
+
+	// ObsoleteStub is called by Obsolete if not nil.
+	ObsoleteStub func(string) error
+	// ObsoleteCalls records the arguments of the calls made to Obsolete.
+	ObsoleteCalls []*CookbookObsoleteCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.CookbookShowOptions) (*cm15.Cookbook, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*CookbookShowCall
+}
+
+var _ cm15.CookbookLocatorInterface = (*CookbookLocator)(nil)
+
+// CookbookDestroyCall records the arguments of a call to CookbookLocator.Destroy.
+type CookbookDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *CookbookLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &CookbookDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *CookbookLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CookbookFollowCall records the arguments of a call to CookbookLocator.Follow.
+type CookbookFollowCall struct {
+	Value string
+}
+
+// Follow records the call and returns the results of FollowStub if set.
+func (fake *CookbookLocator) Follow(value string) error {
+	fake.mu.Lock()
+	fake.FollowCalls = append(fake.FollowCalls, &CookbookFollowCall{Value: value})
+	stub := fake.FollowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(value)
+	}
+	return nil
+}
+
+// FollowReturns sets FollowStub to a function that returns the given values.
+func (fake *CookbookLocator) FollowReturns(err error) {
+	fake.mu.Lock()
+	fake.FollowStub = func(string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CookbookFreezeCall records the arguments of a call to CookbookLocator.Freeze.
+type CookbookFreezeCall struct {
+	Value string
+}
+
+// Freeze records the call and returns the results of FreezeStub if set.
+func (fake *CookbookLocator) Freeze(value string) error {
+	fake.mu.Lock()
+	fake.FreezeCalls = append(fake.FreezeCalls, &CookbookFreezeCall{Value: value})
+	stub := fake.FreezeStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(value)
+	}
+	return nil
+}
+
+// FreezeReturns sets FreezeStub to a function that returns the given values.
+func (fake *CookbookLocator) FreezeReturns(err error) {
+	fake.mu.Lock()
+	fake.FreezeStub = func(string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CookbookIndexCall records the arguments of a call to CookbookLocator.Index.
+type CookbookIndexCall struct {
+	Options *cm15.CookbookIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *CookbookLocator) Index(options *cm15.CookbookIndexOptions) ([]*cm15.Cookbook, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &CookbookIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Cookbook
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *CookbookLocator) IndexReturns(res []*cm15.Cookbook, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.CookbookIndexOptions) ([]*cm15.Cookbook, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CookbookIndexEachCall records the arguments of a call to CookbookLocator.IndexEach.
+type CookbookIndexEachCall struct {
+	Options *cm15.CookbookIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *CookbookLocator) IndexEach(options *cm15.CookbookIndexOptions, fn func(*cm15.Cookbook) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &CookbookIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *CookbookLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.CookbookIndexOptions, func(*cm15.Cookbook) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CookbookObsoleteCall records the arguments of a call to CookbookLocator.Obsolete.
+type CookbookObsoleteCall struct {
+	Value string
+}
+
+// Obsolete records the call and returns the results of ObsoleteStub if set.
+func (fake *CookbookLocator) Obsolete(value string) error {
+	fake.mu.Lock()
+	fake.ObsoleteCalls = append(fake.ObsoleteCalls, &CookbookObsoleteCall{Value: value})
+	stub := fake.ObsoleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(value)
+	}
+	return nil
+}
+
+// ObsoleteReturns sets ObsoleteStub to a function that returns the given values.
+func (fake *CookbookLocator) ObsoleteReturns(err error) {
+	fake.mu.Lock()
+	fake.ObsoleteStub = func(string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CookbookShowCall records the arguments of a call to CookbookLocator.Show.
+type CookbookShowCall struct {
+	Options *cm15.CookbookShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *CookbookLocator) Show(options *cm15.CookbookShowOptions) (*cm15.Cookbook, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &CookbookShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Cookbook
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *CookbookLocator) ShowReturns(res *cm15.Cookbook, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.CookbookShowOptions) (*cm15.Cookbook, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  CookbookAttachment ******/
+
+// CookbookAttachmentLocator is a fake implementation of cm15.CookbookAttachmentLocatorInterface.
+type CookbookAttachmentLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.CookbookAttachmentCreateOptions) (*cm15.CookbookAttachmentLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*CookbookAttachmentCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*CookbookAttachmentDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.CookbookAttachmentIndexOptions) ([]*cm15.CookbookAttachment, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*CookbookAttachmentIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.CookbookAttachmentIndexOptions, func(*cm15.CookbookAttachment) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*CookbookAttachmentIndexEachCall
+
+	// MultiAttachStub is called by MultiAttach if not nil.
+	MultiAttachStub func(*cm15.CookbookAttachments) error
+	// MultiAttachCalls records the arguments of the calls made to MultiAttach.
+	MultiAttachCalls []*CookbookAttachmentMultiAttachCall
+
+	// MultiDetachStub is called by MultiDetach if not nil.
+	MultiDetachStub func(*cm15.CookbookAttachments2) error
+	// MultiDetachCalls records the arguments of the calls made to MultiDetach.
+	MultiDetachCalls []*CookbookAttachmentMultiDetachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.CookbookAttachmentShowOptions) (*cm15.CookbookAttachment, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*CookbookAttachmentShowCall
+}
+
+var _ cm15.CookbookAttachmentLocatorInterface = (*CookbookAttachmentLocator)(nil)
+
+// CookbookAttachmentCreateCall records the arguments of a call to CookbookAttachmentLocator.Create.
+type CookbookAttachmentCreateCall struct {
+	Options *cm15.CookbookAttachmentCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *CookbookAttachmentLocator) Create(options *cm15.CookbookAttachmentCreateOptions) (*cm15.CookbookAttachmentLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &CookbookAttachmentCreateCall{Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.CookbookAttachmentLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *CookbookAttachmentLocator) CreateReturns(res *cm15.CookbookAttachmentLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.CookbookAttachmentCreateOptions) (*cm15.CookbookAttachmentLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CookbookAttachmentDestroyCall records the arguments of a call to CookbookAttachmentLocator.Destroy.
+type CookbookAttachmentDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *CookbookAttachmentLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &CookbookAttachmentDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *CookbookAttachmentLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CookbookAttachmentIndexCall records the arguments of a call to CookbookAttachmentLocator.Index.
+type CookbookAttachmentIndexCall struct {
+	Options *cm15.CookbookAttachmentIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *CookbookAttachmentLocator) Index(options *cm15.CookbookAttachmentIndexOptions) ([]*cm15.CookbookAttachment, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &CookbookAttachmentIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.CookbookAttachment
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *CookbookAttachmentLocator) IndexReturns(res []*cm15.CookbookAttachment, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.CookbookAttachmentIndexOptions) ([]*cm15.CookbookAttachment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CookbookAttachmentIndexEachCall records the arguments of a call to CookbookAttachmentLocator.IndexEach.
+type CookbookAttachmentIndexEachCall struct {
+	Options *cm15.CookbookAttachmentIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *CookbookAttachmentLocator) IndexEach(options *cm15.CookbookAttachmentIndexOptions, fn func(*cm15.CookbookAttachment) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &CookbookAttachmentIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *CookbookAttachmentLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.CookbookAttachmentIndexOptions, func(*cm15.CookbookAttachment) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CookbookAttachmentMultiAttachCall records the arguments of a call to CookbookAttachmentLocator.MultiAttach.
+type CookbookAttachmentMultiAttachCall struct {
+	CookbookAttachments *cm15.CookbookAttachments
+}
+
+// MultiAttach records the call and returns the results of MultiAttachStub if set.
+func (fake *CookbookAttachmentLocator) MultiAttach(cookbookAttachments *cm15.CookbookAttachments) error {
+	fake.mu.Lock()
+	fake.MultiAttachCalls = append(fake.MultiAttachCalls, &CookbookAttachmentMultiAttachCall{CookbookAttachments: cookbookAttachments})
+	stub := fake.MultiAttachStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(cookbookAttachments)
+	}
+	return nil
+}
+
+// MultiAttachReturns sets MultiAttachStub to a function that returns the given values.
+func (fake *CookbookAttachmentLocator) MultiAttachReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiAttachStub = func(*cm15.CookbookAttachments) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CookbookAttachmentMultiDetachCall records the arguments of a call to CookbookAttachmentLocator.MultiDetach.
+type CookbookAttachmentMultiDetachCall struct {
+	CookbookAttachments *cm15.CookbookAttachments2
+}
+
+// MultiDetach records the call and returns the results of MultiDetachStub if set.
+func (fake *CookbookAttachmentLocator) MultiDetach(cookbookAttachments *cm15.CookbookAttachments2) error {
+	fake.mu.Lock()
+	fake.MultiDetachCalls = append(fake.MultiDetachCalls, &CookbookAttachmentMultiDetachCall{CookbookAttachments: cookbookAttachments})
+	stub := fake.MultiDetachStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(cookbookAttachments)
+	}
+	return nil
+}
+
+// MultiDetachReturns sets MultiDetachStub to a function that returns the given values.
+func (fake *CookbookAttachmentLocator) MultiDetachReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiDetachStub = func(*cm15.CookbookAttachments2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CookbookAttachmentShowCall records the arguments of a call to CookbookAttachmentLocator.Show.
+type CookbookAttachmentShowCall struct {
+	Options *cm15.CookbookAttachmentShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *CookbookAttachmentLocator) Show(options *cm15.CookbookAttachmentShowOptions) (*cm15.CookbookAttachment, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &CookbookAttachmentShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.CookbookAttachment
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *CookbookAttachmentLocator) ShowReturns(res *cm15.CookbookAttachment, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.CookbookAttachmentShowOptions) (*cm15.CookbookAttachment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Credential ******/
+
+// CredentialLocator is a fake implementation of cm15.CredentialLocatorInterface.
+type CredentialLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.CredentialParam) (*cm15.CredentialLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*CredentialCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*CredentialDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.CredentialIndexOptions) ([]*cm15.Credential, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*CredentialIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.CredentialIndexOptions, func(*cm15.Credential) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*CredentialIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.CredentialShowOptions) (*cm15.Credential, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*CredentialShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.CredentialParam) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*CredentialUpdateCall
+}
+
+var _ cm15.CredentialLocatorInterface = (*CredentialLocator)(nil)
+
+// CredentialCreateCall records the arguments of a call to CredentialLocator.Create.
+type CredentialCreateCall struct {
+	Credential *cm15.CredentialParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *CredentialLocator) Create(credential *cm15.CredentialParam) (*cm15.CredentialLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &CredentialCreateCall{Credential: credential})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(credential)
+	}
+	var res *cm15.CredentialLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *CredentialLocator) CreateReturns(res *cm15.CredentialLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.CredentialParam) (*cm15.CredentialLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CredentialDestroyCall records the arguments of a call to CredentialLocator.Destroy.
+type CredentialDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *CredentialLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &CredentialDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *CredentialLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CredentialIndexCall records the arguments of a call to CredentialLocator.Index.
+type CredentialIndexCall struct {
+	Options *cm15.CredentialIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *CredentialLocator) Index(options *cm15.CredentialIndexOptions) ([]*cm15.Credential, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &CredentialIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Credential
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *CredentialLocator) IndexReturns(res []*cm15.Credential, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.CredentialIndexOptions) ([]*cm15.Credential, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CredentialIndexEachCall records the arguments of a call to CredentialLocator.IndexEach.
+type CredentialIndexEachCall struct {
+	Options *cm15.CredentialIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *CredentialLocator) IndexEach(options *cm15.CredentialIndexOptions, fn func(*cm15.Credential) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &CredentialIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *CredentialLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.CredentialIndexOptions, func(*cm15.Credential) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CredentialShowCall records the arguments of a call to CredentialLocator.Show.
+type CredentialShowCall struct {
+	Options *cm15.CredentialShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *CredentialLocator) Show(options *cm15.CredentialShowOptions) (*cm15.Credential, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &CredentialShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Credential
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *CredentialLocator) ShowReturns(res *cm15.Credential, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.CredentialShowOptions) (*cm15.Credential, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// CredentialUpdateCall records the arguments of a call to CredentialLocator.Update.
+type CredentialUpdateCall struct {
+	Credential *cm15.CredentialParam
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *CredentialLocator) Update(credential *cm15.CredentialParam) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &CredentialUpdateCall{Credential: credential})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(credential)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *CredentialLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.CredentialParam) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Datacenter ******/
+
+// DatacenterLocator is a fake implementation of cm15.DatacenterLocatorInterface.
+type DatacenterLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.DatacenterIndexOptions) ([]*cm15.Datacenter, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*DatacenterIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.DatacenterIndexOptions, func(*cm15.Datacenter) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*DatacenterIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.DatacenterShowOptions) (*cm15.Datacenter, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*DatacenterShowCall
+}
+
+var _ cm15.DatacenterLocatorInterface = (*DatacenterLocator)(nil)
+
+// DatacenterIndexCall records the arguments of a call to DatacenterLocator.Index.
+type DatacenterIndexCall struct {
+	Options *cm15.DatacenterIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *DatacenterLocator) Index(options *cm15.DatacenterIndexOptions) ([]*cm15.Datacenter, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &DatacenterIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Datacenter
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *DatacenterLocator) IndexReturns(res []*cm15.Datacenter, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.DatacenterIndexOptions) ([]*cm15.Datacenter, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// DatacenterIndexEachCall records the arguments of a call to DatacenterLocator.IndexEach.
+type DatacenterIndexEachCall struct {
+	Options *cm15.DatacenterIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *DatacenterLocator) IndexEach(options *cm15.DatacenterIndexOptions, fn func(*cm15.Datacenter) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &DatacenterIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *DatacenterLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.DatacenterIndexOptions, func(*cm15.Datacenter) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// DatacenterShowCall records the arguments of a call to DatacenterLocator.Show.
+type DatacenterShowCall struct {
+	Options *cm15.DatacenterShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *DatacenterLocator) Show(options *cm15.DatacenterShowOptions) (*cm15.Datacenter, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &DatacenterShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Datacenter
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *DatacenterLocator) ShowReturns(res *cm15.Datacenter, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.DatacenterShowOptions) (*cm15.Datacenter, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Deployment ******/
+
+// DeploymentLocator is a fake implementation of cm15.DeploymentLocatorInterface.
+type DeploymentLocator struct {
+	mu sync.Mutex
+
+	// CloneStub is called by Clone if not nil.
+	CloneStub func(*cm15.DeploymentCloneOptions) error
+	// CloneCalls records the arguments of the calls made to Clone.
+	CloneCalls []*DeploymentCloneCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.DeploymentParam) (*cm15.DeploymentLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*DeploymentCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*DeploymentDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.DeploymentIndexOptions) ([]*cm15.Deployment, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*DeploymentIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.DeploymentIndexOptions, func(*cm15.Deployment) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*DeploymentIndexEachCall
+
+	// LockStub is called by Lock if not nil.
+	LockStub func() error
+	// LockCalls records the arguments of the calls made to Lock.
+	LockCalls []*DeploymentLockCall
+
+	// ServersStub is called by Servers if not nil.
+	ServersStub func() error
+	// ServersCalls records the arguments of the calls made to Servers.
+	ServersCalls []*DeploymentServersCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.DeploymentShowOptions) (*cm15.Deployment, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*DeploymentShowCall
+
+	// UnlockStub is called by Unlock if not nil.
+	UnlockStub func() error
+	// UnlockCalls records the arguments of the calls made to Unlock.
+	UnlockCalls []*DeploymentUnlockCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.DeploymentParam) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*DeploymentUpdateCall
+}
+
+var _ cm15.DeploymentLocatorInterface = (*DeploymentLocator)(nil)
+
+// DeploymentCloneCall records the arguments of a call to DeploymentLocator.Clone.
+type DeploymentCloneCall struct {
+	Options *cm15.DeploymentCloneOptions
+}
+
+// Clone records the call and returns the results of CloneStub if set.
+func (fake *DeploymentLocator) Clone(options *cm15.DeploymentCloneOptions) error {
+	fake.mu.Lock()
+	fake.CloneCalls = append(fake.CloneCalls, &DeploymentCloneCall{Options: options})
+	stub := fake.CloneStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// CloneReturns sets CloneStub to a function that returns the given values.
+func (fake *DeploymentLocator) CloneReturns(err error) {
+	fake.mu.Lock()
+	fake.CloneStub = func(*cm15.DeploymentCloneOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// DeploymentCreateCall records the arguments of a call to DeploymentLocator.Create.
+type DeploymentCreateCall struct {
+	Deployment *cm15.DeploymentParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *DeploymentLocator) Create(deployment *cm15.DeploymentParam) (*cm15.DeploymentLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &DeploymentCreateCall{Deployment: deployment})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(deployment)
+	}
+	var res *cm15.DeploymentLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *DeploymentLocator) CreateReturns(res *cm15.DeploymentLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.DeploymentParam) (*cm15.DeploymentLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// DeploymentDestroyCall records the arguments of a call to DeploymentLocator.Destroy.
+type DeploymentDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *DeploymentLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &DeploymentDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *DeploymentLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// DeploymentIndexCall records the arguments of a call to DeploymentLocator.Index.
+type DeploymentIndexCall struct {
+	Options *cm15.DeploymentIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *DeploymentLocator) Index(options *cm15.DeploymentIndexOptions) ([]*cm15.Deployment, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &DeploymentIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Deployment
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *DeploymentLocator) IndexReturns(res []*cm15.Deployment, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.DeploymentIndexOptions) ([]*cm15.Deployment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// DeploymentIndexEachCall records the arguments of a call to DeploymentLocator.IndexEach.
+type DeploymentIndexEachCall struct {
+	Options *cm15.DeploymentIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *DeploymentLocator) IndexEach(options *cm15.DeploymentIndexOptions, fn func(*cm15.Deployment) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &DeploymentIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *DeploymentLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.DeploymentIndexOptions, func(*cm15.Deployment) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// DeploymentLockCall records the arguments of a call to DeploymentLocator.Lock.
+type DeploymentLockCall struct{}
+
+// Lock records the call and returns the results of LockStub if set.
+func (fake *DeploymentLocator) Lock() error {
+	fake.mu.Lock()
+	fake.LockCalls = append(fake.LockCalls, &DeploymentLockCall{})
+	stub := fake.LockStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// LockReturns sets LockStub to a function that returns the given values.
+func (fake *DeploymentLocator) LockReturns(err error) {
+	fake.mu.Lock()
+	fake.LockStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// DeploymentServersCall records the arguments of a call to DeploymentLocator.Servers.
+type DeploymentServersCall struct{}
+
+// Servers records the call and returns the results of ServersStub if set.
+func (fake *DeploymentLocator) Servers() error {
+	fake.mu.Lock()
+	fake.ServersCalls = append(fake.ServersCalls, &DeploymentServersCall{})
+	stub := fake.ServersStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// ServersReturns sets ServersStub to a function that returns the given values.
+func (fake *DeploymentLocator) ServersReturns(err error) {
+	fake.mu.Lock()
+	fake.ServersStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// DeploymentShowCall records the arguments of a call to DeploymentLocator.Show.
+type DeploymentShowCall struct {
+	Options *cm15.DeploymentShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *DeploymentLocator) Show(options *cm15.DeploymentShowOptions) (*cm15.Deployment, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &DeploymentShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Deployment
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *DeploymentLocator) ShowReturns(res *cm15.Deployment, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.DeploymentShowOptions) (*cm15.Deployment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// DeploymentUnlockCall records the arguments of a call to DeploymentLocator.Unlock.
+type DeploymentUnlockCall struct{}
+
+// Unlock records the call and returns the results of UnlockStub if set.
+func (fake *DeploymentLocator) Unlock() error {
+	fake.mu.Lock()
+	fake.UnlockCalls = append(fake.UnlockCalls, &DeploymentUnlockCall{})
+	stub := fake.UnlockStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// UnlockReturns sets UnlockStub to a function that returns the given values.
+func (fake *DeploymentLocator) UnlockReturns(err error) {
+	fake.mu.Lock()
+	fake.UnlockStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// DeploymentUpdateCall records the arguments of a call to DeploymentLocator.Update.
+type DeploymentUpdateCall struct {
+	Deployment *cm15.DeploymentParam
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *DeploymentLocator) Update(deployment *cm15.DeploymentParam) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &DeploymentUpdateCall{Deployment: deployment})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(deployment)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *DeploymentLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.DeploymentParam) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  HealthCheck ******/
+
+// HealthCheckLocator is a fake implementation of cm15.HealthCheckLocatorInterface.
+type HealthCheckLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func() ([]map[string]interface{}, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*HealthCheckIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(func(map[string]interface{}) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*HealthCheckIndexEachCall
+}
+
+var _ cm15.HealthCheckLocatorInterface = (*HealthCheckLocator)(nil)
+
+// HealthCheckIndexCall records the arguments of a call to HealthCheckLocator.Index.
+type HealthCheckIndexCall struct{}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *HealthCheckLocator) Index() ([]map[string]interface{}, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &HealthCheckIndexCall{})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res []map[string]interface{}
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *HealthCheckLocator) IndexReturns(res []map[string]interface{}, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func() ([]map[string]interface{}, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// HealthCheckIndexEachCall records the arguments of a call to HealthCheckLocator.IndexEach.
+type HealthCheckIndexEachCall struct{}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *HealthCheckLocator) IndexEach(fn func(map[string]interface{}) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &HealthCheckIndexEachCall{})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each()
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *HealthCheckLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(func(map[string]interface{}) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  IdentityProvider ******/
+
+// IdentityProviderLocator is a fake implementation of cm15.IdentityProviderLocatorInterface.
+type IdentityProviderLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.IdentityProviderIndexOptions) ([]*cm15.IdentityProvider, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*IdentityProviderIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.IdentityProviderIndexOptions, func(*cm15.IdentityProvider) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*IdentityProviderIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.IdentityProviderShowOptions) (*cm15.IdentityProvider, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*IdentityProviderShowCall
+}
+
+var _ cm15.IdentityProviderLocatorInterface = (*IdentityProviderLocator)(nil)
+
+// IdentityProviderIndexCall records the arguments of a call to IdentityProviderLocator.Index.
+type IdentityProviderIndexCall struct {
+	Options *cm15.IdentityProviderIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *IdentityProviderLocator) Index(options *cm15.IdentityProviderIndexOptions) ([]*cm15.IdentityProvider, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &IdentityProviderIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.IdentityProvider
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *IdentityProviderLocator) IndexReturns(res []*cm15.IdentityProvider, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.IdentityProviderIndexOptions) ([]*cm15.IdentityProvider, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// IdentityProviderIndexEachCall records the arguments of a call to IdentityProviderLocator.IndexEach.
+type IdentityProviderIndexEachCall struct {
+	Options *cm15.IdentityProviderIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *IdentityProviderLocator) IndexEach(options *cm15.IdentityProviderIndexOptions, fn func(*cm15.IdentityProvider) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &IdentityProviderIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *IdentityProviderLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.IdentityProviderIndexOptions, func(*cm15.IdentityProvider) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// IdentityProviderShowCall records the arguments of a call to IdentityProviderLocator.Show.
+type IdentityProviderShowCall struct {
+	Options *cm15.IdentityProviderShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *IdentityProviderLocator) Show(options *cm15.IdentityProviderShowOptions) (*cm15.IdentityProvider, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &IdentityProviderShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.IdentityProvider
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *IdentityProviderLocator) ShowReturns(res *cm15.IdentityProvider, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.IdentityProviderShowOptions) (*cm15.IdentityProvider, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Image ******/
+
+// ImageLocator is a fake implementation of cm15.ImageLocatorInterface.
+type ImageLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.ImageIndexOptions) ([]*cm15.Image, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ImageIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.ImageIndexOptions, func(*cm15.Image) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*ImageIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.ImageShowOptions) (*cm15.Image, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ImageShowCall
+}
+
+var _ cm15.ImageLocatorInterface = (*ImageLocator)(nil)
+
+// ImageIndexCall records the arguments of a call to ImageLocator.Index.
+type ImageIndexCall struct {
+	Options *cm15.ImageIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ImageLocator) Index(options *cm15.ImageIndexOptions) ([]*cm15.Image, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ImageIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Image
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ImageLocator) IndexReturns(res []*cm15.Image, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.ImageIndexOptions) ([]*cm15.Image, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ImageIndexEachCall records the arguments of a call to ImageLocator.IndexEach.
+type ImageIndexEachCall struct {
+	Options *cm15.ImageIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *ImageLocator) IndexEach(options *cm15.ImageIndexOptions, fn func(*cm15.Image) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &ImageIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *ImageLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.ImageIndexOptions, func(*cm15.Image) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ImageShowCall records the arguments of a call to ImageLocator.Show.
+type ImageShowCall struct {
+	Options *cm15.ImageShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ImageLocator) Show(options *cm15.ImageShowOptions) (*cm15.Image, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ImageShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Image
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ImageLocator) ShowReturns(res *cm15.Image, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.ImageShowOptions) (*cm15.Image, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Input ******/
+
+// InputLocator is a fake implementation of cm15.InputLocatorInterface.
+type InputLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.InputIndexOptions) ([]*cm15.Input, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*InputIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.InputIndexOptions, func(*cm15.Input) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*InputIndexEachCall
+
+	// MultiUpdateStub is called by MultiUpdate if not nil.
+	MultiUpdateStub func(map[string]interface{}) error
+	// MultiUpdateCalls records the arguments of the calls made to MultiUpdate.
+	MultiUpdateCalls []*InputMultiUpdateCall
+}
+
+var _ cm15.InputLocatorInterface = (*InputLocator)(nil)
+
+// InputIndexCall records the arguments of a call to InputLocator.Index.
+type InputIndexCall struct {
+	Options *cm15.InputIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *InputLocator) Index(options *cm15.InputIndexOptions) ([]*cm15.Input, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &InputIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Input
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *InputLocator) IndexReturns(res []*cm15.Input, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.InputIndexOptions) ([]*cm15.Input, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InputIndexEachCall records the arguments of a call to InputLocator.IndexEach.
+type InputIndexEachCall struct {
+	Options *cm15.InputIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *InputLocator) IndexEach(options *cm15.InputIndexOptions, fn func(*cm15.Input) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &InputIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *InputLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.InputIndexOptions, func(*cm15.Input) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InputMultiUpdateCall records the arguments of a call to InputLocator.MultiUpdate.
+type InputMultiUpdateCall struct {
+	Inputs map[string]interface{}
+}
+
+// MultiUpdate records the call and returns the results of MultiUpdateStub if set.
+func (fake *InputLocator) MultiUpdate(inputs map[string]interface{}) error {
+	fake.mu.Lock()
+	fake.MultiUpdateCalls = append(fake.MultiUpdateCalls, &InputMultiUpdateCall{Inputs: inputs})
+	stub := fake.MultiUpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(inputs)
+	}
+	return nil
+}
+
+// MultiUpdateReturns sets MultiUpdateStub to a function that returns the given values.
+func (fake *InputLocator) MultiUpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiUpdateStub = func(map[string]interface{}) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Instance ******/
+
+// InstanceLocator is a fake implementation of cm15.InstanceLocatorInterface.
+type InstanceLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.InstanceParam, *cm15.InstanceCreateOptions) (*cm15.InstanceLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*InstanceCreateCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.InstanceIndexOptions) ([]*cm15.Instance, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*InstanceIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.InstanceIndexOptions, func(*cm15.Instance) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*InstanceIndexEachCall
+
+	// LaunchStub is called by Launch if not nil.
+	LaunchStub func(*cm15.InstanceLaunchOptions) error
+	// LaunchCalls records the arguments of the calls made to Launch.
+	LaunchCalls []*InstanceLaunchCall
+
+	// LockStub is called by Lock if not nil.
+	LockStub func() error
+	// LockCalls records the arguments of the calls made to Lock.
+	LockCalls []*InstanceLockCall
+
+	// MultiRunExecutableStub is called by MultiRunExecutable if not nil.
+	MultiRunExecutableStub func(*cm15.InstanceMultiRunExecutableOptions) error
+	// MultiRunExecutableCalls records the arguments of the calls made to MultiRunExecutable.
+	MultiRunExecutableCalls []*InstanceMultiRunExecutableCall
+
+	// MultiTerminateStub is called by MultiTerminate if not nil.
+	MultiTerminateStub func(*cm15.InstanceMultiTerminateOptions) error
+	// MultiTerminateCalls records the arguments of the calls made to MultiTerminate.
+	MultiTerminateCalls []*InstanceMultiTerminateCall
+
+	// RebootStub is called by Reboot if not nil.
+	RebootStub func() error
+	// RebootCalls records the arguments of the calls made to Reboot.
+	RebootCalls []*InstanceRebootCall
+
+	// RunExecutableStub is called by RunExecutable if not nil.
+	RunExecutableStub func(*cm15.InstanceRunExecutableOptions) error
+	// RunExecutableCalls records the arguments of the calls made to RunExecutable.
+	RunExecutableCalls []*InstanceRunExecutableCall
+
+	// SetCustomLodgementStub is called by SetCustomLodgement if not nil.
+	SetCustomLodgementStub func([]*cm15.Quantity, string) error
+	// SetCustomLodgementCalls records the arguments of the calls made to SetCustomLodgement.
+	SetCustomLodgementCalls []*InstanceSetCustomLodgementCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.InstanceShowOptions) (*cm15.Instance, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*InstanceShowCall
+
+	// StartStub is called by Start if not nil.
+	StartStub func() error
+	// StartCalls records the arguments of the calls made to Start.
+	StartCalls []*InstanceStartCall
+
+	// StopStub is called by Stop if not nil.
+	StopStub func() error
+	// StopCalls records the arguments of the calls made to Stop.
+	StopCalls []*InstanceStopCall
+
+	// TerminateStub is called by Terminate if not nil.
+	TerminateStub func() error
+	// TerminateCalls records the arguments of the calls made to Terminate.
+	TerminateCalls []*InstanceTerminateCall
+
+	// UnlockStub is called by Unlock if not nil.
+	UnlockStub func() error
+	// UnlockCalls records the arguments of the calls made to Unlock.
+	UnlockCalls []*InstanceUnlockCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.InstanceParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*InstanceUpdateCall
+}
+
+var _ cm15.InstanceLocatorInterface = (*InstanceLocator)(nil)
+
+// InstanceCreateCall records the arguments of a call to InstanceLocator.Create.
+type InstanceCreateCall struct {
+	Instance *cm15.InstanceParam
+	Options  *cm15.InstanceCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *InstanceLocator) Create(instance *cm15.InstanceParam, options *cm15.InstanceCreateOptions) (*cm15.InstanceLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &InstanceCreateCall{Instance: instance, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(instance, options)
+	}
+	var res *cm15.InstanceLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *InstanceLocator) CreateReturns(res *cm15.InstanceLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.InstanceParam, *cm15.InstanceCreateOptions) (*cm15.InstanceLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceIndexCall records the arguments of a call to InstanceLocator.Index.
+type InstanceIndexCall struct {
+	Options *cm15.InstanceIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *InstanceLocator) Index(options *cm15.InstanceIndexOptions) ([]*cm15.Instance, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &InstanceIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Instance
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *InstanceLocator) IndexReturns(res []*cm15.Instance, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.InstanceIndexOptions) ([]*cm15.Instance, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceIndexEachCall records the arguments of a call to InstanceLocator.IndexEach.
+type InstanceIndexEachCall struct {
+	Options *cm15.InstanceIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *InstanceLocator) IndexEach(options *cm15.InstanceIndexOptions, fn func(*cm15.Instance) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &InstanceIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *InstanceLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.InstanceIndexOptions, func(*cm15.Instance) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceLaunchCall records the arguments of a call to InstanceLocator.Launch.
+type InstanceLaunchCall struct {
+	Options *cm15.InstanceLaunchOptions
+}
+
+// Launch records the call and returns the results of LaunchStub if set.
+func (fake *InstanceLocator) Launch(options *cm15.InstanceLaunchOptions) error {
+	fake.mu.Lock()
+	fake.LaunchCalls = append(fake.LaunchCalls, &InstanceLaunchCall{Options: options})
+	stub := fake.LaunchStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// LaunchReturns sets LaunchStub to a function that returns the given values.
+func (fake *InstanceLocator) LaunchReturns(err error) {
+	fake.mu.Lock()
+	fake.LaunchStub = func(*cm15.InstanceLaunchOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceLockCall records the arguments of a call to InstanceLocator.Lock.
+type InstanceLockCall struct{}
+
+// Lock records the call and returns the results of LockStub if set.
+func (fake *InstanceLocator) Lock() error {
+	fake.mu.Lock()
+	fake.LockCalls = append(fake.LockCalls, &InstanceLockCall{})
+	stub := fake.LockStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// LockReturns sets LockStub to a function that returns the given values.
+func (fake *InstanceLocator) LockReturns(err error) {
+	fake.mu.Lock()
+	fake.LockStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceMultiRunExecutableCall records the arguments of a call to InstanceLocator.MultiRunExecutable.
+type InstanceMultiRunExecutableCall struct {
+	Options *cm15.InstanceMultiRunExecutableOptions
+}
+
+// MultiRunExecutable records the call and returns the results of MultiRunExecutableStub if set.
+func (fake *InstanceLocator) MultiRunExecutable(options *cm15.InstanceMultiRunExecutableOptions) error {
+	fake.mu.Lock()
+	fake.MultiRunExecutableCalls = append(fake.MultiRunExecutableCalls, &InstanceMultiRunExecutableCall{Options: options})
+	stub := fake.MultiRunExecutableStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// MultiRunExecutableReturns sets MultiRunExecutableStub to a function that returns the given values.
+func (fake *InstanceLocator) MultiRunExecutableReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiRunExecutableStub = func(*cm15.InstanceMultiRunExecutableOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceMultiTerminateCall records the arguments of a call to InstanceLocator.MultiTerminate.
+type InstanceMultiTerminateCall struct {
+	Options *cm15.InstanceMultiTerminateOptions
+}
+
+// MultiTerminate records the call and returns the results of MultiTerminateStub if set.
+func (fake *InstanceLocator) MultiTerminate(options *cm15.InstanceMultiTerminateOptions) error {
+	fake.mu.Lock()
+	fake.MultiTerminateCalls = append(fake.MultiTerminateCalls, &InstanceMultiTerminateCall{Options: options})
+	stub := fake.MultiTerminateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// MultiTerminateReturns sets MultiTerminateStub to a function that returns the given values.
+func (fake *InstanceLocator) MultiTerminateReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiTerminateStub = func(*cm15.InstanceMultiTerminateOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceRebootCall records the arguments of a call to InstanceLocator.Reboot.
+type InstanceRebootCall struct{}
+
+// Reboot records the call and returns the results of RebootStub if set.
+func (fake *InstanceLocator) Reboot() error {
+	fake.mu.Lock()
+	fake.RebootCalls = append(fake.RebootCalls, &InstanceRebootCall{})
+	stub := fake.RebootStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// RebootReturns sets RebootStub to a function that returns the given values.
+func (fake *InstanceLocator) RebootReturns(err error) {
+	fake.mu.Lock()
+	fake.RebootStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceRunExecutableCall records the arguments of a call to InstanceLocator.RunExecutable.
+type InstanceRunExecutableCall struct {
+	Options *cm15.InstanceRunExecutableOptions
+}
+
+// RunExecutable records the call and returns the results of RunExecutableStub if set.
+func (fake *InstanceLocator) RunExecutable(options *cm15.InstanceRunExecutableOptions) error {
+	fake.mu.Lock()
+	fake.RunExecutableCalls = append(fake.RunExecutableCalls, &InstanceRunExecutableCall{Options: options})
+	stub := fake.RunExecutableStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// RunExecutableReturns sets RunExecutableStub to a function that returns the given values.
+func (fake *InstanceLocator) RunExecutableReturns(err error) {
+	fake.mu.Lock()
+	fake.RunExecutableStub = func(*cm15.InstanceRunExecutableOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceSetCustomLodgementCall records the arguments of a call to InstanceLocator.SetCustomLodgement.
+type InstanceSetCustomLodgementCall struct {
+	Quantity  []*cm15.Quantity
+	Timeframe string
+}
+
+// SetCustomLodgement records the call and returns the results of SetCustomLodgementStub if set.
+func (fake *InstanceLocator) SetCustomLodgement(quantity []*cm15.Quantity, timeframe string) error {
+	fake.mu.Lock()
+	fake.SetCustomLodgementCalls = append(fake.SetCustomLodgementCalls, &InstanceSetCustomLodgementCall{Quantity: quantity, Timeframe: timeframe})
+	stub := fake.SetCustomLodgementStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(quantity, timeframe)
+	}
+	return nil
+}
+
+// SetCustomLodgementReturns sets SetCustomLodgementStub to a function that returns the given values.
+func (fake *InstanceLocator) SetCustomLodgementReturns(err error) {
+	fake.mu.Lock()
+	fake.SetCustomLodgementStub = func([]*cm15.Quantity, string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceShowCall records the arguments of a call to InstanceLocator.Show.
+type InstanceShowCall struct {
+	Options *cm15.InstanceShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *InstanceLocator) Show(options *cm15.InstanceShowOptions) (*cm15.Instance, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &InstanceShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Instance
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *InstanceLocator) ShowReturns(res *cm15.Instance, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.InstanceShowOptions) (*cm15.Instance, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceStartCall records the arguments of a call to InstanceLocator.Start.
+type InstanceStartCall struct{}
+
+// Start records the call and returns the results of StartStub if set.
+func (fake *InstanceLocator) Start() error {
+	fake.mu.Lock()
+	fake.StartCalls = append(fake.StartCalls, &InstanceStartCall{})
+	stub := fake.StartStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// StartReturns sets StartStub to a function that returns the given values.
+func (fake *InstanceLocator) StartReturns(err error) {
+	fake.mu.Lock()
+	fake.StartStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceStopCall records the arguments of a call to InstanceLocator.Stop.
+type InstanceStopCall struct{}
+
+// Stop records the call and returns the results of StopStub if set.
+func (fake *InstanceLocator) Stop() error {
+	fake.mu.Lock()
+	fake.StopCalls = append(fake.StopCalls, &InstanceStopCall{})
+	stub := fake.StopStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// StopReturns sets StopStub to a function that returns the given values.
+func (fake *InstanceLocator) StopReturns(err error) {
+	fake.mu.Lock()
+	fake.StopStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceTerminateCall records the arguments of a call to InstanceLocator.Terminate.
+type InstanceTerminateCall struct{}
+
+// Terminate records the call and returns the results of TerminateStub if set.
+func (fake *InstanceLocator) Terminate() error {
+	fake.mu.Lock()
+	fake.TerminateCalls = append(fake.TerminateCalls, &InstanceTerminateCall{})
+	stub := fake.TerminateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// TerminateReturns sets TerminateStub to a function that returns the given values.
+func (fake *InstanceLocator) TerminateReturns(err error) {
+	fake.mu.Lock()
+	fake.TerminateStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceUnlockCall records the arguments of a call to InstanceLocator.Unlock.
+type InstanceUnlockCall struct{}
+
+// Unlock records the call and returns the results of UnlockStub if set.
+func (fake *InstanceLocator) Unlock() error {
+	fake.mu.Lock()
+	fake.UnlockCalls = append(fake.UnlockCalls, &InstanceUnlockCall{})
+	stub := fake.UnlockStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// UnlockReturns sets UnlockStub to a function that returns the given values.
+func (fake *InstanceLocator) UnlockReturns(err error) {
+	fake.mu.Lock()
+	fake.UnlockStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceUpdateCall records the arguments of a call to InstanceLocator.Update.
+type InstanceUpdateCall struct {
+	Instance *cm15.InstanceParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *InstanceLocator) Update(instance *cm15.InstanceParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &InstanceUpdateCall{Instance: instance})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(instance)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *InstanceLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.InstanceParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  InstanceCustomLodgement ******/
+
+// InstanceCustomLodgementLocator is a fake implementation of cm15.InstanceCustomLodgementLocatorInterface.
+type InstanceCustomLodgementLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func([]*cm15.Quantity, string) (*cm15.InstanceCustomLodgementLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*InstanceCustomLodgementCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*InstanceCustomLodgementDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.InstanceCustomLodgementIndexOptions) ([]*cm15.InstanceCustomLodgement, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*InstanceCustomLodgementIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.InstanceCustomLodgementIndexOptions, func(*cm15.InstanceCustomLodgement) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*InstanceCustomLodgementIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.InstanceCustomLodgement, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*InstanceCustomLodgementShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func([]*cm15.Quantity) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*InstanceCustomLodgementUpdateCall
+}
+
+var _ cm15.InstanceCustomLodgementLocatorInterface = (*InstanceCustomLodgementLocator)(nil)
+
+// InstanceCustomLodgementCreateCall records the arguments of a call to InstanceCustomLodgementLocator.Create.
+type InstanceCustomLodgementCreateCall struct {
+	Quantity  []*cm15.Quantity
+	Timeframe string
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *InstanceCustomLodgementLocator) Create(quantity []*cm15.Quantity, timeframe string) (*cm15.InstanceCustomLodgementLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &InstanceCustomLodgementCreateCall{Quantity: quantity, Timeframe: timeframe})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(quantity, timeframe)
+	}
+	var res *cm15.InstanceCustomLodgementLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *InstanceCustomLodgementLocator) CreateReturns(res *cm15.InstanceCustomLodgementLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func([]*cm15.Quantity, string) (*cm15.InstanceCustomLodgementLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceCustomLodgementDestroyCall records the arguments of a call to InstanceCustomLodgementLocator.Destroy.
+type InstanceCustomLodgementDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *InstanceCustomLodgementLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &InstanceCustomLodgementDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *InstanceCustomLodgementLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceCustomLodgementIndexCall records the arguments of a call to InstanceCustomLodgementLocator.Index.
+type InstanceCustomLodgementIndexCall struct {
+	Options *cm15.InstanceCustomLodgementIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *InstanceCustomLodgementLocator) Index(options *cm15.InstanceCustomLodgementIndexOptions) ([]*cm15.InstanceCustomLodgement, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &InstanceCustomLodgementIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.InstanceCustomLodgement
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *InstanceCustomLodgementLocator) IndexReturns(res []*cm15.InstanceCustomLodgement, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.InstanceCustomLodgementIndexOptions) ([]*cm15.InstanceCustomLodgement, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceCustomLodgementIndexEachCall records the arguments of a call to InstanceCustomLodgementLocator.IndexEach.
+type InstanceCustomLodgementIndexEachCall struct {
+	Options *cm15.InstanceCustomLodgementIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *InstanceCustomLodgementLocator) IndexEach(options *cm15.InstanceCustomLodgementIndexOptions, fn func(*cm15.InstanceCustomLodgement) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &InstanceCustomLodgementIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *InstanceCustomLodgementLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.InstanceCustomLodgementIndexOptions, func(*cm15.InstanceCustomLodgement) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceCustomLodgementShowCall records the arguments of a call to InstanceCustomLodgementLocator.Show.
+type InstanceCustomLodgementShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *InstanceCustomLodgementLocator) Show() (*cm15.InstanceCustomLodgement, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &InstanceCustomLodgementShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.InstanceCustomLodgement
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *InstanceCustomLodgementLocator) ShowReturns(res *cm15.InstanceCustomLodgement, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.InstanceCustomLodgement, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceCustomLodgementUpdateCall records the arguments of a call to InstanceCustomLodgementLocator.Update.
+type InstanceCustomLodgementUpdateCall struct {
+	Quantity []*cm15.Quantity
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *InstanceCustomLodgementLocator) Update(quantity []*cm15.Quantity) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &InstanceCustomLodgementUpdateCall{Quantity: quantity})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(quantity)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *InstanceCustomLodgementLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func([]*cm15.Quantity) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  InstanceType ******/
+
+// InstanceTypeLocator is a fake implementation of cm15.InstanceTypeLocatorInterface.
+type InstanceTypeLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.InstanceTypeIndexOptions) ([]*cm15.InstanceType, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*InstanceTypeIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.InstanceTypeIndexOptions, func(*cm15.InstanceType) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*InstanceTypeIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.InstanceTypeShowOptions) (*cm15.InstanceType, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*InstanceTypeShowCall
+}
+
+var _ cm15.InstanceTypeLocatorInterface = (*InstanceTypeLocator)(nil)
+
+// InstanceTypeIndexCall records the arguments of a call to InstanceTypeLocator.Index.
+type InstanceTypeIndexCall struct {
+	Options *cm15.InstanceTypeIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *InstanceTypeLocator) Index(options *cm15.InstanceTypeIndexOptions) ([]*cm15.InstanceType, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &InstanceTypeIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.InstanceType
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *InstanceTypeLocator) IndexReturns(res []*cm15.InstanceType, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.InstanceTypeIndexOptions) ([]*cm15.InstanceType, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceTypeIndexEachCall records the arguments of a call to InstanceTypeLocator.IndexEach.
+type InstanceTypeIndexEachCall struct {
+	Options *cm15.InstanceTypeIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *InstanceTypeLocator) IndexEach(options *cm15.InstanceTypeIndexOptions, fn func(*cm15.InstanceType) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &InstanceTypeIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *InstanceTypeLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.InstanceTypeIndexOptions, func(*cm15.InstanceType) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceTypeShowCall records the arguments of a call to InstanceTypeLocator.Show.
+type InstanceTypeShowCall struct {
+	Options *cm15.InstanceTypeShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *InstanceTypeLocator) Show(options *cm15.InstanceTypeShowOptions) (*cm15.InstanceType, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &InstanceTypeShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.InstanceType
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *InstanceTypeLocator) ShowReturns(res *cm15.InstanceType, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.InstanceTypeShowOptions) (*cm15.InstanceType, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  IpAddress ******/
+
+// IpAddressLocator is a fake implementation of cm15.IpAddressLocatorInterface.
+type IpAddressLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.IpAddressParam) (*cm15.IpAddressLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*IpAddressCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*IpAddressDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.IpAddressIndexOptions) ([]*cm15.IpAddress, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*IpAddressIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.IpAddressIndexOptions, func(*cm15.IpAddress) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*IpAddressIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.IpAddress, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*IpAddressShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.IpAddressParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*IpAddressUpdateCall
+}
+
+var _ cm15.IpAddressLocatorInterface = (*IpAddressLocator)(nil)
+
+// IpAddressCreateCall records the arguments of a call to IpAddressLocator.Create.
+type IpAddressCreateCall struct {
+	IpAddress *cm15.IpAddressParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *IpAddressLocator) Create(ipAddress *cm15.IpAddressParam) (*cm15.IpAddressLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &IpAddressCreateCall{IpAddress: ipAddress})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(ipAddress)
+	}
+	var res *cm15.IpAddressLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *IpAddressLocator) CreateReturns(res *cm15.IpAddressLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.IpAddressParam) (*cm15.IpAddressLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// IpAddressDestroyCall records the arguments of a call to IpAddressLocator.Destroy.
+type IpAddressDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *IpAddressLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &IpAddressDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *IpAddressLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// IpAddressIndexCall records the arguments of a call to IpAddressLocator.Index.
+type IpAddressIndexCall struct {
+	Options *cm15.IpAddressIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *IpAddressLocator) Index(options *cm15.IpAddressIndexOptions) ([]*cm15.IpAddress, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &IpAddressIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.IpAddress
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *IpAddressLocator) IndexReturns(res []*cm15.IpAddress, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.IpAddressIndexOptions) ([]*cm15.IpAddress, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// IpAddressIndexEachCall records the arguments of a call to IpAddressLocator.IndexEach.
+type IpAddressIndexEachCall struct {
+	Options *cm15.IpAddressIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *IpAddressLocator) IndexEach(options *cm15.IpAddressIndexOptions, fn func(*cm15.IpAddress) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &IpAddressIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *IpAddressLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.IpAddressIndexOptions, func(*cm15.IpAddress) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// IpAddressShowCall records the arguments of a call to IpAddressLocator.Show.
+type IpAddressShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *IpAddressLocator) Show() (*cm15.IpAddress, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &IpAddressShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.IpAddress
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *IpAddressLocator) ShowReturns(res *cm15.IpAddress, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.IpAddress, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// IpAddressUpdateCall records the arguments of a call to IpAddressLocator.Update.
+type IpAddressUpdateCall struct {
+	IpAddress *cm15.IpAddressParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *IpAddressLocator) Update(ipAddress *cm15.IpAddressParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &IpAddressUpdateCall{IpAddress: ipAddress})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(ipAddress)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *IpAddressLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.IpAddressParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  IpAddressBinding ******/
+
+// IpAddressBindingLocator is a fake implementation of cm15.IpAddressBindingLocatorInterface.
+type IpAddressBindingLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.IpAddressBindingParam) (*cm15.IpAddressBindingLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*IpAddressBindingCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*IpAddressBindingDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.IpAddressBindingIndexOptions) ([]*cm15.IpAddressBinding, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*IpAddressBindingIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.IpAddressBindingIndexOptions, func(*cm15.IpAddressBinding) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*IpAddressBindingIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.IpAddressBinding, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*IpAddressBindingShowCall
+}
+
+var _ cm15.IpAddressBindingLocatorInterface = (*IpAddressBindingLocator)(nil)
+
+// IpAddressBindingCreateCall records the arguments of a call to IpAddressBindingLocator.Create.
+type IpAddressBindingCreateCall struct {
+	IpAddressBinding *cm15.IpAddressBindingParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *IpAddressBindingLocator) Create(ipAddressBinding *cm15.IpAddressBindingParam) (*cm15.IpAddressBindingLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &IpAddressBindingCreateCall{IpAddressBinding: ipAddressBinding})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(ipAddressBinding)
+	}
+	var res *cm15.IpAddressBindingLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *IpAddressBindingLocator) CreateReturns(res *cm15.IpAddressBindingLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.IpAddressBindingParam) (*cm15.IpAddressBindingLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// IpAddressBindingDestroyCall records the arguments of a call to IpAddressBindingLocator.Destroy.
+type IpAddressBindingDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *IpAddressBindingLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &IpAddressBindingDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *IpAddressBindingLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// IpAddressBindingIndexCall records the arguments of a call to IpAddressBindingLocator.Index.
+type IpAddressBindingIndexCall struct {
+	Options *cm15.IpAddressBindingIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *IpAddressBindingLocator) Index(options *cm15.IpAddressBindingIndexOptions) ([]*cm15.IpAddressBinding, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &IpAddressBindingIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.IpAddressBinding
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *IpAddressBindingLocator) IndexReturns(res []*cm15.IpAddressBinding, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.IpAddressBindingIndexOptions) ([]*cm15.IpAddressBinding, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// IpAddressBindingIndexEachCall records the arguments of a call to IpAddressBindingLocator.IndexEach.
+type IpAddressBindingIndexEachCall struct {
+	Options *cm15.IpAddressBindingIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *IpAddressBindingLocator) IndexEach(options *cm15.IpAddressBindingIndexOptions, fn func(*cm15.IpAddressBinding) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &IpAddressBindingIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *IpAddressBindingLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.IpAddressBindingIndexOptions, func(*cm15.IpAddressBinding) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// IpAddressBindingShowCall records the arguments of a call to IpAddressBindingLocator.Show.
+type IpAddressBindingShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *IpAddressBindingLocator) Show() (*cm15.IpAddressBinding, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &IpAddressBindingShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.IpAddressBinding
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *IpAddressBindingLocator) ShowReturns(res *cm15.IpAddressBinding, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.IpAddressBinding, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  MonitoringMetric ******/
+
+// MonitoringMetricLocator is a fake implementation of cm15.MonitoringMetricLocatorInterface.
+type MonitoringMetricLocator struct {
+	mu sync.Mutex
+
+	// DataStub is called by Data if not nil.
+	DataStub func(string, string) (map[string]interface{}, error)
+	// DataCalls records the arguments of the calls made to Data.
+	DataCalls []*MonitoringMetricDataCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.MonitoringMetricIndexOptions) ([]*cm15.MonitoringMetric, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*MonitoringMetricIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.MonitoringMetricIndexOptions, func(*cm15.MonitoringMetric) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*MonitoringMetricIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.MonitoringMetricShowOptions) (*cm15.MonitoringMetric, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*MonitoringMetricShowCall
+}
+
+var _ cm15.MonitoringMetricLocatorInterface = (*MonitoringMetricLocator)(nil)
+
+// MonitoringMetricDataCall records the arguments of a call to MonitoringMetricLocator.Data.
+type MonitoringMetricDataCall struct {
+	End   string
+	Start string
+}
+
+// Data records the call and returns the results of DataStub if set.
+func (fake *MonitoringMetricLocator) Data(end string, start string) (map[string]interface{}, error) {
+	fake.mu.Lock()
+	fake.DataCalls = append(fake.DataCalls, &MonitoringMetricDataCall{End: end, Start: start})
+	stub := fake.DataStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(end, start)
+	}
+	var res map[string]interface{}
+	return res, nil
+}
+
+// DataReturns sets DataStub to a function that returns the given values.
+func (fake *MonitoringMetricLocator) DataReturns(res map[string]interface{}, err error) {
+	fake.mu.Lock()
+	fake.DataStub = func(string, string) (map[string]interface{}, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// MonitoringMetricIndexCall records the arguments of a call to MonitoringMetricLocator.Index.
+type MonitoringMetricIndexCall struct {
+	Options *cm15.MonitoringMetricIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *MonitoringMetricLocator) Index(options *cm15.MonitoringMetricIndexOptions) ([]*cm15.MonitoringMetric, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &MonitoringMetricIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.MonitoringMetric
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *MonitoringMetricLocator) IndexReturns(res []*cm15.MonitoringMetric, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.MonitoringMetricIndexOptions) ([]*cm15.MonitoringMetric, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// MonitoringMetricIndexEachCall records the arguments of a call to MonitoringMetricLocator.IndexEach.
+type MonitoringMetricIndexEachCall struct {
+	Options *cm15.MonitoringMetricIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *MonitoringMetricLocator) IndexEach(options *cm15.MonitoringMetricIndexOptions, fn func(*cm15.MonitoringMetric) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &MonitoringMetricIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *MonitoringMetricLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.MonitoringMetricIndexOptions, func(*cm15.MonitoringMetric) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// MonitoringMetricShowCall records the arguments of a call to MonitoringMetricLocator.Show.
+type MonitoringMetricShowCall struct {
+	Options *cm15.MonitoringMetricShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *MonitoringMetricLocator) Show(options *cm15.MonitoringMetricShowOptions) (*cm15.MonitoringMetric, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &MonitoringMetricShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.MonitoringMetric
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *MonitoringMetricLocator) ShowReturns(res *cm15.MonitoringMetric, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.MonitoringMetricShowOptions) (*cm15.MonitoringMetric, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  MultiCloudImage ******/
+
+// MultiCloudImageLocator is a fake implementation of cm15.MultiCloudImageLocatorInterface.
+type MultiCloudImageLocator struct {
+	mu sync.Mutex
+
+	// CloneStub is called by Clone if not nil.
+	CloneStub func(*cm15.MultiCloudImageParam) error
+	// CloneCalls records the arguments of the calls made to Clone.
+	CloneCalls []*MultiCloudImageCloneCall
+
+	// CommitStub is called by Commit if not nil.
+	CommitStub func(string) error
+	// CommitCalls records the arguments of the calls made to Commit.
+	CommitCalls []*MultiCloudImageCommitCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.MultiCloudImageParam) (*cm15.MultiCloudImageLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*MultiCloudImageCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*MultiCloudImageDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.MultiCloudImageIndexOptions) ([]*cm15.MultiCloudImage, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*MultiCloudImageIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.MultiCloudImageIndexOptions, func(*cm15.MultiCloudImage) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*MultiCloudImageIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.MultiCloudImage, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*MultiCloudImageShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.MultiCloudImageParam) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*MultiCloudImageUpdateCall
+}
+
+var _ cm15.MultiCloudImageLocatorInterface = (*MultiCloudImageLocator)(nil)
+
+// MultiCloudImageCloneCall records the arguments of a call to MultiCloudImageLocator.Clone.
+type MultiCloudImageCloneCall struct {
+	MultiCloudImage *cm15.MultiCloudImageParam
+}
+
+// Clone records the call and returns the results of CloneStub if set.
+func (fake *MultiCloudImageLocator) Clone(multiCloudImage *cm15.MultiCloudImageParam) error {
+	fake.mu.Lock()
+	fake.CloneCalls = append(fake.CloneCalls, &MultiCloudImageCloneCall{MultiCloudImage: multiCloudImage})
+	stub := fake.CloneStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(multiCloudImage)
+	}
+	return nil
+}
+
+// CloneReturns sets CloneStub to a function that returns the given values.
+func (fake *MultiCloudImageLocator) CloneReturns(err error) {
+	fake.mu.Lock()
+	fake.CloneStub = func(*cm15.MultiCloudImageParam) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageCommitCall records the arguments of a call to MultiCloudImageLocator.Commit.
+type MultiCloudImageCommitCall struct {
+	CommitMessage string
+}
+
+// Commit records the call and returns the results of CommitStub if set.
+func (fake *MultiCloudImageLocator) Commit(commitMessage string) error {
+	fake.mu.Lock()
+	fake.CommitCalls = append(fake.CommitCalls, &MultiCloudImageCommitCall{CommitMessage: commitMessage})
+	stub := fake.CommitStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(commitMessage)
+	}
+	return nil
+}
+
+// CommitReturns sets CommitStub to a function that returns the given values.
+func (fake *MultiCloudImageLocator) CommitReturns(err error) {
+	fake.mu.Lock()
+	fake.CommitStub = func(string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageCreateCall records the arguments of a call to MultiCloudImageLocator.Create.
+type MultiCloudImageCreateCall struct {
+	MultiCloudImage *cm15.MultiCloudImageParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *MultiCloudImageLocator) Create(multiCloudImage *cm15.MultiCloudImageParam) (*cm15.MultiCloudImageLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &MultiCloudImageCreateCall{MultiCloudImage: multiCloudImage})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(multiCloudImage)
+	}
+	var res *cm15.MultiCloudImageLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *MultiCloudImageLocator) CreateReturns(res *cm15.MultiCloudImageLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.MultiCloudImageParam) (*cm15.MultiCloudImageLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageDestroyCall records the arguments of a call to MultiCloudImageLocator.Destroy.
+type MultiCloudImageDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *MultiCloudImageLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &MultiCloudImageDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *MultiCloudImageLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageIndexCall records the arguments of a call to MultiCloudImageLocator.Index.
+type MultiCloudImageIndexCall struct {
+	Options *cm15.MultiCloudImageIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *MultiCloudImageLocator) Index(options *cm15.MultiCloudImageIndexOptions) ([]*cm15.MultiCloudImage, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &MultiCloudImageIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.MultiCloudImage
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *MultiCloudImageLocator) IndexReturns(res []*cm15.MultiCloudImage, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.MultiCloudImageIndexOptions) ([]*cm15.MultiCloudImage, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageIndexEachCall records the arguments of a call to MultiCloudImageLocator.IndexEach.
+type MultiCloudImageIndexEachCall struct {
+	Options *cm15.MultiCloudImageIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *MultiCloudImageLocator) IndexEach(options *cm15.MultiCloudImageIndexOptions, fn func(*cm15.MultiCloudImage) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &MultiCloudImageIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *MultiCloudImageLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.MultiCloudImageIndexOptions, func(*cm15.MultiCloudImage) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageShowCall records the arguments of a call to MultiCloudImageLocator.Show.
+type MultiCloudImageShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *MultiCloudImageLocator) Show() (*cm15.MultiCloudImage, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &MultiCloudImageShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.MultiCloudImage
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *MultiCloudImageLocator) ShowReturns(res *cm15.MultiCloudImage, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.MultiCloudImage, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageUpdateCall records the arguments of a call to MultiCloudImageLocator.Update.
+type MultiCloudImageUpdateCall struct {
+	MultiCloudImage *cm15.MultiCloudImageParam
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *MultiCloudImageLocator) Update(multiCloudImage *cm15.MultiCloudImageParam) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &MultiCloudImageUpdateCall{MultiCloudImage: multiCloudImage})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(multiCloudImage)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *MultiCloudImageLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.MultiCloudImageParam) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  MultiCloudImageSetting ******/
+
+// MultiCloudImageSettingLocator is a fake implementation of cm15.MultiCloudImageSettingLocatorInterface.
+type MultiCloudImageSettingLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.MultiCloudImageSettingParam) (*cm15.MultiCloudImageSettingLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*MultiCloudImageSettingCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*MultiCloudImageSettingDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.MultiCloudImageSettingIndexOptions) ([]*cm15.MultiCloudImageSetting, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*MultiCloudImageSettingIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.MultiCloudImageSettingIndexOptions, func(*cm15.MultiCloudImageSetting) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*MultiCloudImageSettingIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.MultiCloudImageSetting, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*MultiCloudImageSettingShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.MultiCloudImageSettingParam) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*MultiCloudImageSettingUpdateCall
+}
+
+var _ cm15.MultiCloudImageSettingLocatorInterface = (*MultiCloudImageSettingLocator)(nil)
+
+// MultiCloudImageSettingCreateCall records the arguments of a call to MultiCloudImageSettingLocator.Create.
+type MultiCloudImageSettingCreateCall struct {
+	MultiCloudImageSetting *cm15.MultiCloudImageSettingParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *MultiCloudImageSettingLocator) Create(multiCloudImageSetting *cm15.MultiCloudImageSettingParam) (*cm15.MultiCloudImageSettingLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &MultiCloudImageSettingCreateCall{MultiCloudImageSetting: multiCloudImageSetting})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(multiCloudImageSetting)
+	}
+	var res *cm15.MultiCloudImageSettingLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *MultiCloudImageSettingLocator) CreateReturns(res *cm15.MultiCloudImageSettingLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.MultiCloudImageSettingParam) (*cm15.MultiCloudImageSettingLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageSettingDestroyCall records the arguments of a call to MultiCloudImageSettingLocator.Destroy.
+type MultiCloudImageSettingDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *MultiCloudImageSettingLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &MultiCloudImageSettingDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *MultiCloudImageSettingLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageSettingIndexCall records the arguments of a call to MultiCloudImageSettingLocator.Index.
+type MultiCloudImageSettingIndexCall struct {
+	Options *cm15.MultiCloudImageSettingIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *MultiCloudImageSettingLocator) Index(options *cm15.MultiCloudImageSettingIndexOptions) ([]*cm15.MultiCloudImageSetting, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &MultiCloudImageSettingIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.MultiCloudImageSetting
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *MultiCloudImageSettingLocator) IndexReturns(res []*cm15.MultiCloudImageSetting, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.MultiCloudImageSettingIndexOptions) ([]*cm15.MultiCloudImageSetting, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageSettingIndexEachCall records the arguments of a call to MultiCloudImageSettingLocator.IndexEach.
+type MultiCloudImageSettingIndexEachCall struct {
+	Options *cm15.MultiCloudImageSettingIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *MultiCloudImageSettingLocator) IndexEach(options *cm15.MultiCloudImageSettingIndexOptions, fn func(*cm15.MultiCloudImageSetting) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &MultiCloudImageSettingIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *MultiCloudImageSettingLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.MultiCloudImageSettingIndexOptions, func(*cm15.MultiCloudImageSetting) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageSettingShowCall records the arguments of a call to MultiCloudImageSettingLocator.Show.
+type MultiCloudImageSettingShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *MultiCloudImageSettingLocator) Show() (*cm15.MultiCloudImageSetting, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &MultiCloudImageSettingShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.MultiCloudImageSetting
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *MultiCloudImageSettingLocator) ShowReturns(res *cm15.MultiCloudImageSetting, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.MultiCloudImageSetting, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageSettingUpdateCall records the arguments of a call to MultiCloudImageSettingLocator.Update.
+type MultiCloudImageSettingUpdateCall struct {
+	MultiCloudImageSetting *cm15.MultiCloudImageSettingParam
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *MultiCloudImageSettingLocator) Update(multiCloudImageSetting *cm15.MultiCloudImageSettingParam) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &MultiCloudImageSettingUpdateCall{MultiCloudImageSetting: multiCloudImageSetting})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(multiCloudImageSetting)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *MultiCloudImageSettingLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.MultiCloudImageSettingParam) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Network ******/
+
+// NetworkLocator is a fake implementation of cm15.NetworkLocatorInterface.
+type NetworkLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.NetworkParam) (*cm15.NetworkLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*NetworkCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*NetworkDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.NetworkIndexOptions) ([]*cm15.Network, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*NetworkIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.NetworkIndexOptions, func(*cm15.Network) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*NetworkIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.Network, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*NetworkShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.NetworkParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*NetworkUpdateCall
+}
+
+var _ cm15.NetworkLocatorInterface = (*NetworkLocator)(nil)
+
+// NetworkCreateCall records the arguments of a call to NetworkLocator.Create.
+type NetworkCreateCall struct {
+	Network *cm15.NetworkParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *NetworkLocator) Create(network *cm15.NetworkParam) (*cm15.NetworkLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &NetworkCreateCall{Network: network})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(network)
+	}
+	var res *cm15.NetworkLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *NetworkLocator) CreateReturns(res *cm15.NetworkLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.NetworkParam) (*cm15.NetworkLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkDestroyCall records the arguments of a call to NetworkLocator.Destroy.
+type NetworkDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *NetworkLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &NetworkDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *NetworkLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkIndexCall records the arguments of a call to NetworkLocator.Index.
+type NetworkIndexCall struct {
+	Options *cm15.NetworkIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *NetworkLocator) Index(options *cm15.NetworkIndexOptions) ([]*cm15.Network, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &NetworkIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Network
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *NetworkLocator) IndexReturns(res []*cm15.Network, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.NetworkIndexOptions) ([]*cm15.Network, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkIndexEachCall records the arguments of a call to NetworkLocator.IndexEach.
+type NetworkIndexEachCall struct {
+	Options *cm15.NetworkIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *NetworkLocator) IndexEach(options *cm15.NetworkIndexOptions, fn func(*cm15.Network) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &NetworkIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *NetworkLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.NetworkIndexOptions, func(*cm15.Network) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkShowCall records the arguments of a call to NetworkLocator.Show.
+type NetworkShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *NetworkLocator) Show() (*cm15.Network, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &NetworkShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.Network
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *NetworkLocator) ShowReturns(res *cm15.Network, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.Network, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkUpdateCall records the arguments of a call to NetworkLocator.Update.
+type NetworkUpdateCall struct {
+	Network *cm15.NetworkParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *NetworkLocator) Update(network *cm15.NetworkParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &NetworkUpdateCall{Network: network})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(network)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *NetworkLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.NetworkParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  NetworkGateway ******/
+
+// NetworkGatewayLocator is a fake implementation of cm15.NetworkGatewayLocatorInterface.
+type NetworkGatewayLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.NetworkGatewayParam) (*cm15.NetworkGatewayLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*NetworkGatewayCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*NetworkGatewayDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.NetworkGatewayIndexOptions) ([]*cm15.NetworkGateway, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*NetworkGatewayIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.NetworkGatewayIndexOptions, func(*cm15.NetworkGateway) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*NetworkGatewayIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.NetworkGateway, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*NetworkGatewayShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.NetworkGatewayParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*NetworkGatewayUpdateCall
+}
+
+var _ cm15.NetworkGatewayLocatorInterface = (*NetworkGatewayLocator)(nil)
+
+// NetworkGatewayCreateCall records the arguments of a call to NetworkGatewayLocator.Create.
+type NetworkGatewayCreateCall struct {
+	NetworkGateway *cm15.NetworkGatewayParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *NetworkGatewayLocator) Create(networkGateway *cm15.NetworkGatewayParam) (*cm15.NetworkGatewayLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &NetworkGatewayCreateCall{NetworkGateway: networkGateway})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(networkGateway)
+	}
+	var res *cm15.NetworkGatewayLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *NetworkGatewayLocator) CreateReturns(res *cm15.NetworkGatewayLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.NetworkGatewayParam) (*cm15.NetworkGatewayLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkGatewayDestroyCall records the arguments of a call to NetworkGatewayLocator.Destroy.
+type NetworkGatewayDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *NetworkGatewayLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &NetworkGatewayDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *NetworkGatewayLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkGatewayIndexCall records the arguments of a call to NetworkGatewayLocator.Index.
+type NetworkGatewayIndexCall struct {
+	Options *cm15.NetworkGatewayIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *NetworkGatewayLocator) Index(options *cm15.NetworkGatewayIndexOptions) ([]*cm15.NetworkGateway, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &NetworkGatewayIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.NetworkGateway
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *NetworkGatewayLocator) IndexReturns(res []*cm15.NetworkGateway, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.NetworkGatewayIndexOptions) ([]*cm15.NetworkGateway, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkGatewayIndexEachCall records the arguments of a call to NetworkGatewayLocator.IndexEach.
+type NetworkGatewayIndexEachCall struct {
+	Options *cm15.NetworkGatewayIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *NetworkGatewayLocator) IndexEach(options *cm15.NetworkGatewayIndexOptions, fn func(*cm15.NetworkGateway) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &NetworkGatewayIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *NetworkGatewayLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.NetworkGatewayIndexOptions, func(*cm15.NetworkGateway) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkGatewayShowCall records the arguments of a call to NetworkGatewayLocator.Show.
+type NetworkGatewayShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *NetworkGatewayLocator) Show() (*cm15.NetworkGateway, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &NetworkGatewayShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.NetworkGateway
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *NetworkGatewayLocator) ShowReturns(res *cm15.NetworkGateway, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.NetworkGateway, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkGatewayUpdateCall records the arguments of a call to NetworkGatewayLocator.Update.
+type NetworkGatewayUpdateCall struct {
+	NetworkGateway *cm15.NetworkGatewayParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *NetworkGatewayLocator) Update(networkGateway *cm15.NetworkGatewayParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &NetworkGatewayUpdateCall{NetworkGateway: networkGateway})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(networkGateway)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *NetworkGatewayLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.NetworkGatewayParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  NetworkOptionGroup ******/
+
+// NetworkOptionGroupLocator is a fake implementation of cm15.NetworkOptionGroupLocatorInterface.
+type NetworkOptionGroupLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.NetworkOptionGroupParam) (*cm15.NetworkOptionGroupLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*NetworkOptionGroupCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*NetworkOptionGroupDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.NetworkOptionGroupIndexOptions) ([]*cm15.NetworkOptionGroup, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*NetworkOptionGroupIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.NetworkOptionGroupIndexOptions, func(*cm15.NetworkOptionGroup) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*NetworkOptionGroupIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.NetworkOptionGroup, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*NetworkOptionGroupShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.NetworkOptionGroupParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*NetworkOptionGroupUpdateCall
+}
+
+var _ cm15.NetworkOptionGroupLocatorInterface = (*NetworkOptionGroupLocator)(nil)
+
+// NetworkOptionGroupCreateCall records the arguments of a call to NetworkOptionGroupLocator.Create.
+type NetworkOptionGroupCreateCall struct {
+	NetworkOptionGroup *cm15.NetworkOptionGroupParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *NetworkOptionGroupLocator) Create(networkOptionGroup *cm15.NetworkOptionGroupParam) (*cm15.NetworkOptionGroupLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &NetworkOptionGroupCreateCall{NetworkOptionGroup: networkOptionGroup})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(networkOptionGroup)
+	}
+	var res *cm15.NetworkOptionGroupLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *NetworkOptionGroupLocator) CreateReturns(res *cm15.NetworkOptionGroupLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.NetworkOptionGroupParam) (*cm15.NetworkOptionGroupLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkOptionGroupDestroyCall records the arguments of a call to NetworkOptionGroupLocator.Destroy.
+type NetworkOptionGroupDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *NetworkOptionGroupLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &NetworkOptionGroupDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *NetworkOptionGroupLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkOptionGroupIndexCall records the arguments of a call to NetworkOptionGroupLocator.Index.
+type NetworkOptionGroupIndexCall struct {
+	Options *cm15.NetworkOptionGroupIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *NetworkOptionGroupLocator) Index(options *cm15.NetworkOptionGroupIndexOptions) ([]*cm15.NetworkOptionGroup, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &NetworkOptionGroupIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.NetworkOptionGroup
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *NetworkOptionGroupLocator) IndexReturns(res []*cm15.NetworkOptionGroup, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.NetworkOptionGroupIndexOptions) ([]*cm15.NetworkOptionGroup, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkOptionGroupIndexEachCall records the arguments of a call to NetworkOptionGroupLocator.IndexEach.
+type NetworkOptionGroupIndexEachCall struct {
+	Options *cm15.NetworkOptionGroupIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *NetworkOptionGroupLocator) IndexEach(options *cm15.NetworkOptionGroupIndexOptions, fn func(*cm15.NetworkOptionGroup) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &NetworkOptionGroupIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *NetworkOptionGroupLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.NetworkOptionGroupIndexOptions, func(*cm15.NetworkOptionGroup) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkOptionGroupShowCall records the arguments of a call to NetworkOptionGroupLocator.Show.
+type NetworkOptionGroupShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *NetworkOptionGroupLocator) Show() (*cm15.NetworkOptionGroup, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &NetworkOptionGroupShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.NetworkOptionGroup
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *NetworkOptionGroupLocator) ShowReturns(res *cm15.NetworkOptionGroup, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.NetworkOptionGroup, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkOptionGroupUpdateCall records the arguments of a call to NetworkOptionGroupLocator.Update.
+type NetworkOptionGroupUpdateCall struct {
+	NetworkOptionGroup *cm15.NetworkOptionGroupParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *NetworkOptionGroupLocator) Update(networkOptionGroup *cm15.NetworkOptionGroupParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &NetworkOptionGroupUpdateCall{NetworkOptionGroup: networkOptionGroup})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(networkOptionGroup)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *NetworkOptionGroupLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.NetworkOptionGroupParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  NetworkOptionGroupAttachment ******/
+
+// NetworkOptionGroupAttachmentLocator is a fake implementation of cm15.NetworkOptionGroupAttachmentLocatorInterface.
+type NetworkOptionGroupAttachmentLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.NetworkOptionGroupAttachmentParam) (*cm15.NetworkOptionGroupAttachmentLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*NetworkOptionGroupAttachmentCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*NetworkOptionGroupAttachmentDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.NetworkOptionGroupAttachmentIndexOptions) ([]*cm15.NetworkOptionGroupAttachment, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*NetworkOptionGroupAttachmentIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.NetworkOptionGroupAttachmentIndexOptions, func(*cm15.NetworkOptionGroupAttachment) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*NetworkOptionGroupAttachmentIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.NetworkOptionGroupAttachmentShowOptions) (*cm15.NetworkOptionGroupAttachment, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*NetworkOptionGroupAttachmentShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.NetworkOptionGroupAttachmentParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*NetworkOptionGroupAttachmentUpdateCall
+}
+
+var _ cm15.NetworkOptionGroupAttachmentLocatorInterface = (*NetworkOptionGroupAttachmentLocator)(nil)
+
+// NetworkOptionGroupAttachmentCreateCall records the arguments of a call to NetworkOptionGroupAttachmentLocator.Create.
+type NetworkOptionGroupAttachmentCreateCall struct {
+	NetworkOptionGroupAttachment *cm15.NetworkOptionGroupAttachmentParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *NetworkOptionGroupAttachmentLocator) Create(networkOptionGroupAttachment *cm15.NetworkOptionGroupAttachmentParam) (*cm15.NetworkOptionGroupAttachmentLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &NetworkOptionGroupAttachmentCreateCall{NetworkOptionGroupAttachment: networkOptionGroupAttachment})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(networkOptionGroupAttachment)
+	}
+	var res *cm15.NetworkOptionGroupAttachmentLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *NetworkOptionGroupAttachmentLocator) CreateReturns(res *cm15.NetworkOptionGroupAttachmentLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.NetworkOptionGroupAttachmentParam) (*cm15.NetworkOptionGroupAttachmentLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkOptionGroupAttachmentDestroyCall records the arguments of a call to NetworkOptionGroupAttachmentLocator.Destroy.
+type NetworkOptionGroupAttachmentDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *NetworkOptionGroupAttachmentLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &NetworkOptionGroupAttachmentDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *NetworkOptionGroupAttachmentLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkOptionGroupAttachmentIndexCall records the arguments of a call to NetworkOptionGroupAttachmentLocator.Index.
+type NetworkOptionGroupAttachmentIndexCall struct {
+	Options *cm15.NetworkOptionGroupAttachmentIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *NetworkOptionGroupAttachmentLocator) Index(options *cm15.NetworkOptionGroupAttachmentIndexOptions) ([]*cm15.NetworkOptionGroupAttachment, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &NetworkOptionGroupAttachmentIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.NetworkOptionGroupAttachment
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *NetworkOptionGroupAttachmentLocator) IndexReturns(res []*cm15.NetworkOptionGroupAttachment, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.NetworkOptionGroupAttachmentIndexOptions) ([]*cm15.NetworkOptionGroupAttachment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkOptionGroupAttachmentIndexEachCall records the arguments of a call to NetworkOptionGroupAttachmentLocator.IndexEach.
+type NetworkOptionGroupAttachmentIndexEachCall struct {
+	Options *cm15.NetworkOptionGroupAttachmentIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *NetworkOptionGroupAttachmentLocator) IndexEach(options *cm15.NetworkOptionGroupAttachmentIndexOptions, fn func(*cm15.NetworkOptionGroupAttachment) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &NetworkOptionGroupAttachmentIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *NetworkOptionGroupAttachmentLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.NetworkOptionGroupAttachmentIndexOptions, func(*cm15.NetworkOptionGroupAttachment) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkOptionGroupAttachmentShowCall records the arguments of a call to NetworkOptionGroupAttachmentLocator.Show.
+type NetworkOptionGroupAttachmentShowCall struct {
+	Options *cm15.NetworkOptionGroupAttachmentShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *NetworkOptionGroupAttachmentLocator) Show(options *cm15.NetworkOptionGroupAttachmentShowOptions) (*cm15.NetworkOptionGroupAttachment, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &NetworkOptionGroupAttachmentShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.NetworkOptionGroupAttachment
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *NetworkOptionGroupAttachmentLocator) ShowReturns(res *cm15.NetworkOptionGroupAttachment, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.NetworkOptionGroupAttachmentShowOptions) (*cm15.NetworkOptionGroupAttachment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkOptionGroupAttachmentUpdateCall records the arguments of a call to NetworkOptionGroupAttachmentLocator.Update.
+type NetworkOptionGroupAttachmentUpdateCall struct {
+	NetworkOptionGroupAttachment *cm15.NetworkOptionGroupAttachmentParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *NetworkOptionGroupAttachmentLocator) Update(networkOptionGroupAttachment *cm15.NetworkOptionGroupAttachmentParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &NetworkOptionGroupAttachmentUpdateCall{NetworkOptionGroupAttachment: networkOptionGroupAttachment})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(networkOptionGroupAttachment)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *NetworkOptionGroupAttachmentLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.NetworkOptionGroupAttachmentParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Oauth2 ******/
+
+// Oauth2Locator is a fake implementation of cm15.Oauth2LocatorInterface.
+type Oauth2Locator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(string, *cm15.Oauth2CreateOptions) (map[string]interface{}, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*Oauth2CreateCall
+}
+
+var _ cm15.Oauth2LocatorInterface = (*Oauth2Locator)(nil)
+
+// Oauth2CreateCall records the arguments of a call to Oauth2Locator.Create.
+type Oauth2CreateCall struct {
+	GrantType string
+	Options   *cm15.Oauth2CreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *Oauth2Locator) Create(grantType string, options *cm15.Oauth2CreateOptions) (map[string]interface{}, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &Oauth2CreateCall{GrantType: grantType, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(grantType, options)
+	}
+	var res map[string]interface{}
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *Oauth2Locator) CreateReturns(res map[string]interface{}, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(string, *cm15.Oauth2CreateOptions) (map[string]interface{}, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Permission ******/
+
+// PermissionLocator is a fake implementation of cm15.PermissionLocatorInterface.
+type PermissionLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.PermissionParam) (*cm15.PermissionLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*PermissionCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*PermissionDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.PermissionIndexOptions) ([]*cm15.Permission, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*PermissionIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.PermissionIndexOptions, func(*cm15.Permission) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*PermissionIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.Permission, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*PermissionShowCall
+}
+
+var _ cm15.PermissionLocatorInterface = (*PermissionLocator)(nil)
+
+// PermissionCreateCall records the arguments of a call to PermissionLocator.Create.
+type PermissionCreateCall struct {
+	Permission *cm15.PermissionParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *PermissionLocator) Create(permission *cm15.PermissionParam) (*cm15.PermissionLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &PermissionCreateCall{Permission: permission})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(permission)
+	}
+	var res *cm15.PermissionLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *PermissionLocator) CreateReturns(res *cm15.PermissionLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.PermissionParam) (*cm15.PermissionLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PermissionDestroyCall records the arguments of a call to PermissionLocator.Destroy.
+type PermissionDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *PermissionLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &PermissionDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *PermissionLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// PermissionIndexCall records the arguments of a call to PermissionLocator.Index.
+type PermissionIndexCall struct {
+	Options *cm15.PermissionIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *PermissionLocator) Index(options *cm15.PermissionIndexOptions) ([]*cm15.Permission, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &PermissionIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Permission
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *PermissionLocator) IndexReturns(res []*cm15.Permission, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.PermissionIndexOptions) ([]*cm15.Permission, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PermissionIndexEachCall records the arguments of a call to PermissionLocator.IndexEach.
+type PermissionIndexEachCall struct {
+	Options *cm15.PermissionIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *PermissionLocator) IndexEach(options *cm15.PermissionIndexOptions, fn func(*cm15.Permission) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &PermissionIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *PermissionLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.PermissionIndexOptions, func(*cm15.Permission) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// PermissionShowCall records the arguments of a call to PermissionLocator.Show.
+type PermissionShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *PermissionLocator) Show() (*cm15.Permission, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &PermissionShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.Permission
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *PermissionLocator) ShowReturns(res *cm15.Permission, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.Permission, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  PlacementGroup ******/
+
+// PlacementGroupLocator is a fake implementation of cm15.PlacementGroupLocatorInterface.
+type PlacementGroupLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.PlacementGroupParam) (*cm15.PlacementGroupLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*PlacementGroupCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*PlacementGroupDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.PlacementGroupIndexOptions) ([]*cm15.PlacementGroup, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*PlacementGroupIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.PlacementGroupIndexOptions, func(*cm15.PlacementGroup) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*PlacementGroupIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.PlacementGroupShowOptions) (*cm15.PlacementGroup, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*PlacementGroupShowCall
+}
+
+var _ cm15.PlacementGroupLocatorInterface = (*PlacementGroupLocator)(nil)
+
+// PlacementGroupCreateCall records the arguments of a call to PlacementGroupLocator.Create.
+type PlacementGroupCreateCall struct {
+	PlacementGroup *cm15.PlacementGroupParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *PlacementGroupLocator) Create(placementGroup *cm15.PlacementGroupParam) (*cm15.PlacementGroupLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &PlacementGroupCreateCall{PlacementGroup: placementGroup})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(placementGroup)
+	}
+	var res *cm15.PlacementGroupLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *PlacementGroupLocator) CreateReturns(res *cm15.PlacementGroupLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.PlacementGroupParam) (*cm15.PlacementGroupLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PlacementGroupDestroyCall records the arguments of a call to PlacementGroupLocator.Destroy.
+type PlacementGroupDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *PlacementGroupLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &PlacementGroupDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *PlacementGroupLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// PlacementGroupIndexCall records the arguments of a call to PlacementGroupLocator.Index.
+type PlacementGroupIndexCall struct {
+	Options *cm15.PlacementGroupIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *PlacementGroupLocator) Index(options *cm15.PlacementGroupIndexOptions) ([]*cm15.PlacementGroup, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &PlacementGroupIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.PlacementGroup
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *PlacementGroupLocator) IndexReturns(res []*cm15.PlacementGroup, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.PlacementGroupIndexOptions) ([]*cm15.PlacementGroup, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PlacementGroupIndexEachCall records the arguments of a call to PlacementGroupLocator.IndexEach.
+type PlacementGroupIndexEachCall struct {
+	Options *cm15.PlacementGroupIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *PlacementGroupLocator) IndexEach(options *cm15.PlacementGroupIndexOptions, fn func(*cm15.PlacementGroup) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &PlacementGroupIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *PlacementGroupLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.PlacementGroupIndexOptions, func(*cm15.PlacementGroup) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// PlacementGroupShowCall records the arguments of a call to PlacementGroupLocator.Show.
+type PlacementGroupShowCall struct {
+	Options *cm15.PlacementGroupShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *PlacementGroupLocator) Show(options *cm15.PlacementGroupShowOptions) (*cm15.PlacementGroup, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &PlacementGroupShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.PlacementGroup
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *PlacementGroupLocator) ShowReturns(res *cm15.PlacementGroup, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.PlacementGroupShowOptions) (*cm15.PlacementGroup, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Preference ******/
+
+// PreferenceLocator is a fake implementation of cm15.PreferenceLocatorInterface.
+type PreferenceLocator struct {
+	mu sync.Mutex
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*PreferenceDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.PreferenceIndexOptions) ([]*cm15.Preference, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*PreferenceIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.PreferenceIndexOptions, func(*cm15.Preference) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*PreferenceIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.Preference, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*PreferenceShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.PreferenceParam) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*PreferenceUpdateCall
+}
+
+var _ cm15.PreferenceLocatorInterface = (*PreferenceLocator)(nil)
+
+// PreferenceDestroyCall records the arguments of a call to PreferenceLocator.Destroy.
+type PreferenceDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *PreferenceLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &PreferenceDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *PreferenceLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// PreferenceIndexCall records the arguments of a call to PreferenceLocator.Index.
+type PreferenceIndexCall struct {
+	Options *cm15.PreferenceIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *PreferenceLocator) Index(options *cm15.PreferenceIndexOptions) ([]*cm15.Preference, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &PreferenceIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Preference
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *PreferenceLocator) IndexReturns(res []*cm15.Preference, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.PreferenceIndexOptions) ([]*cm15.Preference, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PreferenceIndexEachCall records the arguments of a call to PreferenceLocator.IndexEach.
+type PreferenceIndexEachCall struct {
+	Options *cm15.PreferenceIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *PreferenceLocator) IndexEach(options *cm15.PreferenceIndexOptions, fn func(*cm15.Preference) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &PreferenceIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *PreferenceLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.PreferenceIndexOptions, func(*cm15.Preference) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// PreferenceShowCall records the arguments of a call to PreferenceLocator.Show.
+type PreferenceShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *PreferenceLocator) Show() (*cm15.Preference, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &PreferenceShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.Preference
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *PreferenceLocator) ShowReturns(res *cm15.Preference, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.Preference, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PreferenceUpdateCall records the arguments of a call to PreferenceLocator.Update.
+type PreferenceUpdateCall struct {
+	Preference *cm15.PreferenceParam
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *PreferenceLocator) Update(preference *cm15.PreferenceParam) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &PreferenceUpdateCall{Preference: preference})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(preference)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *PreferenceLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.PreferenceParam) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Publication ******/
+
+// PublicationLocator is a fake implementation of cm15.PublicationLocatorInterface.
+type PublicationLocator struct {
+	mu sync.Mutex
+
+	// ImportStub is called by Import if not nil.
+	ImportStub func() error
+	// ImportCalls records the arguments of the calls made to Import.
+	ImportCalls []*PublicationImportCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.PublicationIndexOptions) ([]*cm15.Publication, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*PublicationIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.PublicationIndexOptions, func(*cm15.Publication) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*PublicationIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.PublicationShowOptions) (*cm15.Publication, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*PublicationShowCall
+}
+
+var _ cm15.PublicationLocatorInterface = (*PublicationLocator)(nil)
+
+// PublicationImportCall records the arguments of a call to PublicationLocator.Import.
+type PublicationImportCall struct{}
+
+// Import records the call and returns the results of ImportStub if set.
+func (fake *PublicationLocator) Import() error {
+	fake.mu.Lock()
+	fake.ImportCalls = append(fake.ImportCalls, &PublicationImportCall{})
+	stub := fake.ImportStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// ImportReturns sets ImportStub to a function that returns the given values.
+func (fake *PublicationLocator) ImportReturns(err error) {
+	fake.mu.Lock()
+	fake.ImportStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// PublicationIndexCall records the arguments of a call to PublicationLocator.Index.
+type PublicationIndexCall struct {
+	Options *cm15.PublicationIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *PublicationLocator) Index(options *cm15.PublicationIndexOptions) ([]*cm15.Publication, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &PublicationIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Publication
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *PublicationLocator) IndexReturns(res []*cm15.Publication, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.PublicationIndexOptions) ([]*cm15.Publication, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PublicationIndexEachCall records the arguments of a call to PublicationLocator.IndexEach.
+type PublicationIndexEachCall struct {
+	Options *cm15.PublicationIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *PublicationLocator) IndexEach(options *cm15.PublicationIndexOptions, fn func(*cm15.Publication) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &PublicationIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *PublicationLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.PublicationIndexOptions, func(*cm15.Publication) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// PublicationShowCall records the arguments of a call to PublicationLocator.Show.
+type PublicationShowCall struct {
+	Options *cm15.PublicationShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *PublicationLocator) Show(options *cm15.PublicationShowOptions) (*cm15.Publication, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &PublicationShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Publication
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *PublicationLocator) ShowReturns(res *cm15.Publication, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.PublicationShowOptions) (*cm15.Publication, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  PublicationLineage ******/
+
+// PublicationLineageLocator is a fake implementation of cm15.PublicationLineageLocatorInterface.
+type PublicationLineageLocator struct {
+	mu sync.Mutex
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.PublicationLineageShowOptions) (*cm15.PublicationLineage, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*PublicationLineageShowCall
+}
+
+var _ cm15.PublicationLineageLocatorInterface = (*PublicationLineageLocator)(nil)
+
+// PublicationLineageShowCall records the arguments of a call to PublicationLineageLocator.Show.
+type PublicationLineageShowCall struct {
+	Options *cm15.PublicationLineageShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *PublicationLineageLocator) Show(options *cm15.PublicationLineageShowOptions) (*cm15.PublicationLineage, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &PublicationLineageShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.PublicationLineage
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *PublicationLineageLocator) ShowReturns(res *cm15.PublicationLineage, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.PublicationLineageShowOptions) (*cm15.PublicationLineage, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  RecurringVolumeAttachment ******/
+
+// RecurringVolumeAttachmentLocator is a fake implementation of cm15.RecurringVolumeAttachmentLocatorInterface.
+type RecurringVolumeAttachmentLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.RecurringVolumeAttachmentParam) (*cm15.RecurringVolumeAttachmentLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*RecurringVolumeAttachmentCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*RecurringVolumeAttachmentDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.RecurringVolumeAttachmentIndexOptions) ([]*cm15.RecurringVolumeAttachment, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*RecurringVolumeAttachmentIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.RecurringVolumeAttachmentIndexOptions, func(*cm15.RecurringVolumeAttachment) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*RecurringVolumeAttachmentIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.RecurringVolumeAttachmentShowOptions) (*cm15.RecurringVolumeAttachment, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*RecurringVolumeAttachmentShowCall
+}
+
+var _ cm15.RecurringVolumeAttachmentLocatorInterface = (*RecurringVolumeAttachmentLocator)(nil)
+
+// RecurringVolumeAttachmentCreateCall records the arguments of a call to RecurringVolumeAttachmentLocator.Create.
+type RecurringVolumeAttachmentCreateCall struct {
+	RecurringVolumeAttachment *cm15.RecurringVolumeAttachmentParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *RecurringVolumeAttachmentLocator) Create(recurringVolumeAttachment *cm15.RecurringVolumeAttachmentParam) (*cm15.RecurringVolumeAttachmentLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &RecurringVolumeAttachmentCreateCall{RecurringVolumeAttachment: recurringVolumeAttachment})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(recurringVolumeAttachment)
+	}
+	var res *cm15.RecurringVolumeAttachmentLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *RecurringVolumeAttachmentLocator) CreateReturns(res *cm15.RecurringVolumeAttachmentLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.RecurringVolumeAttachmentParam) (*cm15.RecurringVolumeAttachmentLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RecurringVolumeAttachmentDestroyCall records the arguments of a call to RecurringVolumeAttachmentLocator.Destroy.
+type RecurringVolumeAttachmentDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *RecurringVolumeAttachmentLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &RecurringVolumeAttachmentDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *RecurringVolumeAttachmentLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RecurringVolumeAttachmentIndexCall records the arguments of a call to RecurringVolumeAttachmentLocator.Index.
+type RecurringVolumeAttachmentIndexCall struct {
+	Options *cm15.RecurringVolumeAttachmentIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *RecurringVolumeAttachmentLocator) Index(options *cm15.RecurringVolumeAttachmentIndexOptions) ([]*cm15.RecurringVolumeAttachment, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &RecurringVolumeAttachmentIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.RecurringVolumeAttachment
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *RecurringVolumeAttachmentLocator) IndexReturns(res []*cm15.RecurringVolumeAttachment, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.RecurringVolumeAttachmentIndexOptions) ([]*cm15.RecurringVolumeAttachment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RecurringVolumeAttachmentIndexEachCall records the arguments of a call to RecurringVolumeAttachmentLocator.IndexEach.
+type RecurringVolumeAttachmentIndexEachCall struct {
+	Options *cm15.RecurringVolumeAttachmentIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *RecurringVolumeAttachmentLocator) IndexEach(options *cm15.RecurringVolumeAttachmentIndexOptions, fn func(*cm15.RecurringVolumeAttachment) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &RecurringVolumeAttachmentIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *RecurringVolumeAttachmentLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.RecurringVolumeAttachmentIndexOptions, func(*cm15.RecurringVolumeAttachment) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RecurringVolumeAttachmentShowCall records the arguments of a call to RecurringVolumeAttachmentLocator.Show.
+type RecurringVolumeAttachmentShowCall struct {
+	Options *cm15.RecurringVolumeAttachmentShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *RecurringVolumeAttachmentLocator) Show(options *cm15.RecurringVolumeAttachmentShowOptions) (*cm15.RecurringVolumeAttachment, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &RecurringVolumeAttachmentShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.RecurringVolumeAttachment
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *RecurringVolumeAttachmentLocator) ShowReturns(res *cm15.RecurringVolumeAttachment, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.RecurringVolumeAttachmentShowOptions) (*cm15.RecurringVolumeAttachment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Repository ******/
+
+// RepositoryLocator is a fake implementation of cm15.RepositoryLocatorInterface.
+type RepositoryLocator struct {
+	mu sync.Mutex
+
+	// CookbookImportStub is called by CookbookImport if not nil.
+	CookbookImportStub func([]string, *cm15.RepositoryCookbookImportOptions) error
+	// CookbookImportCalls records the arguments of the calls made to CookbookImport.
+	CookbookImportCalls []*RepositoryCookbookImportCall
+
+	// CookbookImportPreviewStub is called by CookbookImportPreview if not nil.
+	CookbookImportPreviewStub func([]string, string) ([]map[string]interface{}, error)
+	// CookbookImportPreviewCalls records the arguments of the calls made to CookbookImportPreview.
+	CookbookImportPreviewCalls []*RepositoryCookbookImportPreviewCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.RepositoryParam) (*cm15.RepositoryLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*RepositoryCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*RepositoryDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.RepositoryIndexOptions) ([]*cm15.Repository, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*RepositoryIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.RepositoryIndexOptions, func(*cm15.Repository) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*RepositoryIndexEachCall
+
+	// RefetchStub is called by Refetch if not nil.
+	RefetchStub func(*cm15.RepositoryRefetchOptions) error
+	// RefetchCalls records the arguments of the calls made to Refetch.
+	RefetchCalls []*RepositoryRefetchCall
+
+	// ResolveStub is called by Resolve if not nil.
+	ResolveStub func(*cm15.RepositoryResolveOptions) ([]*cm15.Repository, error)
+	// ResolveCalls records the arguments of the calls made to Resolve.
+	ResolveCalls []*RepositoryResolveCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.RepositoryShowOptions) (*cm15.Repository, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*RepositoryShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.RepositoryParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*RepositoryUpdateCall
+}
+
+var _ cm15.RepositoryLocatorInterface = (*RepositoryLocator)(nil)
+
+// RepositoryCookbookImportCall records the arguments of a call to RepositoryLocator.CookbookImport.
+type RepositoryCookbookImportCall struct {
+	AssetHrefs []string
+	Options    *cm15.RepositoryCookbookImportOptions
+}
+
+// CookbookImport records the call and returns the results of CookbookImportStub if set.
+func (fake *RepositoryLocator) CookbookImport(assetHrefs []string, options *cm15.RepositoryCookbookImportOptions) error {
+	fake.mu.Lock()
+	fake.CookbookImportCalls = append(fake.CookbookImportCalls, &RepositoryCookbookImportCall{AssetHrefs: assetHrefs, Options: options})
+	stub := fake.CookbookImportStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(assetHrefs, options)
+	}
+	return nil
+}
+
+// CookbookImportReturns sets CookbookImportStub to a function that returns the given values.
+func (fake *RepositoryLocator) CookbookImportReturns(err error) {
+	fake.mu.Lock()
+	fake.CookbookImportStub = func([]string, *cm15.RepositoryCookbookImportOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RepositoryCookbookImportPreviewCall records the arguments of a call to RepositoryLocator.CookbookImportPreview.
+type RepositoryCookbookImportPreviewCall struct {
+	AssetHrefs []string
+	Namespace  string
+}
+
+// CookbookImportPreview records the call and returns the results of CookbookImportPreviewStub if set.
+func (fake *RepositoryLocator) CookbookImportPreview(assetHrefs []string, namespace string) ([]map[string]interface{}, error) {
+	fake.mu.Lock()
+	fake.CookbookImportPreviewCalls = append(fake.CookbookImportPreviewCalls, &RepositoryCookbookImportPreviewCall{AssetHrefs: assetHrefs, Namespace: namespace})
+	stub := fake.CookbookImportPreviewStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(assetHrefs, namespace)
+	}
+	var res []map[string]interface{}
+	return res, nil
+}
+
+// CookbookImportPreviewReturns sets CookbookImportPreviewStub to a function that returns the given values.
+func (fake *RepositoryLocator) CookbookImportPreviewReturns(res []map[string]interface{}, err error) {
+	fake.mu.Lock()
+	fake.CookbookImportPreviewStub = func([]string, string) ([]map[string]interface{}, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RepositoryCreateCall records the arguments of a call to RepositoryLocator.Create.
+type RepositoryCreateCall struct {
+	Repository *cm15.RepositoryParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *RepositoryLocator) Create(repository *cm15.RepositoryParam) (*cm15.RepositoryLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &RepositoryCreateCall{Repository: repository})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(repository)
+	}
+	var res *cm15.RepositoryLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *RepositoryLocator) CreateReturns(res *cm15.RepositoryLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.RepositoryParam) (*cm15.RepositoryLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RepositoryDestroyCall records the arguments of a call to RepositoryLocator.Destroy.
+type RepositoryDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *RepositoryLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &RepositoryDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *RepositoryLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RepositoryIndexCall records the arguments of a call to RepositoryLocator.Index.
+type RepositoryIndexCall struct {
+	Options *cm15.RepositoryIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *RepositoryLocator) Index(options *cm15.RepositoryIndexOptions) ([]*cm15.Repository, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &RepositoryIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Repository
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *RepositoryLocator) IndexReturns(res []*cm15.Repository, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.RepositoryIndexOptions) ([]*cm15.Repository, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RepositoryIndexEachCall records the arguments of a call to RepositoryLocator.IndexEach.
+type RepositoryIndexEachCall struct {
+	Options *cm15.RepositoryIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *RepositoryLocator) IndexEach(options *cm15.RepositoryIndexOptions, fn func(*cm15.Repository) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &RepositoryIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *RepositoryLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.RepositoryIndexOptions, func(*cm15.Repository) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RepositoryRefetchCall records the arguments of a call to RepositoryLocator.Refetch.
+type RepositoryRefetchCall struct {
+	Options *cm15.RepositoryRefetchOptions
+}
+
+// Refetch records the call and returns the results of RefetchStub if set.
+func (fake *RepositoryLocator) Refetch(options *cm15.RepositoryRefetchOptions) error {
+	fake.mu.Lock()
+	fake.RefetchCalls = append(fake.RefetchCalls, &RepositoryRefetchCall{Options: options})
+	stub := fake.RefetchStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// RefetchReturns sets RefetchStub to a function that returns the given values.
+func (fake *RepositoryLocator) RefetchReturns(err error) {
+	fake.mu.Lock()
+	fake.RefetchStub = func(*cm15.RepositoryRefetchOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RepositoryResolveCall records the arguments of a call to RepositoryLocator.Resolve.
+type RepositoryResolveCall struct {
+	Options *cm15.RepositoryResolveOptions
+}
+
+// Resolve records the call and returns the results of ResolveStub if set.
+func (fake *RepositoryLocator) Resolve(options *cm15.RepositoryResolveOptions) ([]*cm15.Repository, error) {
+	fake.mu.Lock()
+	fake.ResolveCalls = append(fake.ResolveCalls, &RepositoryResolveCall{Options: options})
+	stub := fake.ResolveStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Repository
+	return res, nil
+}
+
+// ResolveReturns sets ResolveStub to a function that returns the given values.
+func (fake *RepositoryLocator) ResolveReturns(res []*cm15.Repository, err error) {
+	fake.mu.Lock()
+	fake.ResolveStub = func(*cm15.RepositoryResolveOptions) ([]*cm15.Repository, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RepositoryShowCall records the arguments of a call to RepositoryLocator.Show.
+type RepositoryShowCall struct {
+	Options *cm15.RepositoryShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *RepositoryLocator) Show(options *cm15.RepositoryShowOptions) (*cm15.Repository, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &RepositoryShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Repository
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *RepositoryLocator) ShowReturns(res *cm15.Repository, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.RepositoryShowOptions) (*cm15.Repository, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RepositoryUpdateCall records the arguments of a call to RepositoryLocator.Update.
+type RepositoryUpdateCall struct {
+	Repository *cm15.RepositoryParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *RepositoryLocator) Update(repository *cm15.RepositoryParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &RepositoryUpdateCall{Repository: repository})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(repository)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *RepositoryLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.RepositoryParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  RepositoryAsset ******/
+
+// RepositoryAssetLocator is a fake implementation of cm15.RepositoryAssetLocatorInterface.
+type RepositoryAssetLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.RepositoryAssetIndexOptions) ([]*cm15.RepositoryAsset, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*RepositoryAssetIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.RepositoryAssetIndexOptions, func(*cm15.RepositoryAsset) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*RepositoryAssetIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.RepositoryAssetShowOptions) (*cm15.RepositoryAsset, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*RepositoryAssetShowCall
+}
+
+var _ cm15.RepositoryAssetLocatorInterface = (*RepositoryAssetLocator)(nil)
+
+// RepositoryAssetIndexCall records the arguments of a call to RepositoryAssetLocator.Index.
+type RepositoryAssetIndexCall struct {
+	Options *cm15.RepositoryAssetIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *RepositoryAssetLocator) Index(options *cm15.RepositoryAssetIndexOptions) ([]*cm15.RepositoryAsset, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &RepositoryAssetIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.RepositoryAsset
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *RepositoryAssetLocator) IndexReturns(res []*cm15.RepositoryAsset, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.RepositoryAssetIndexOptions) ([]*cm15.RepositoryAsset, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RepositoryAssetIndexEachCall records the arguments of a call to RepositoryAssetLocator.IndexEach.
+type RepositoryAssetIndexEachCall struct {
+	Options *cm15.RepositoryAssetIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *RepositoryAssetLocator) IndexEach(options *cm15.RepositoryAssetIndexOptions, fn func(*cm15.RepositoryAsset) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &RepositoryAssetIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *RepositoryAssetLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.RepositoryAssetIndexOptions, func(*cm15.RepositoryAsset) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RepositoryAssetShowCall records the arguments of a call to RepositoryAssetLocator.Show.
+type RepositoryAssetShowCall struct {
+	Options *cm15.RepositoryAssetShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *RepositoryAssetLocator) Show(options *cm15.RepositoryAssetShowOptions) (*cm15.RepositoryAsset, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &RepositoryAssetShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.RepositoryAsset
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *RepositoryAssetLocator) ShowReturns(res *cm15.RepositoryAsset, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.RepositoryAssetShowOptions) (*cm15.RepositoryAsset, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  RightScript ******/
+
+// RightScriptLocator is a fake implementation of cm15.RightScriptLocatorInterface.
+type RightScriptLocator struct {
+	mu sync.Mutex
+
+	// CommitStub is called by Commit if not nil.
+	CommitStub func(*cm15.RightScriptParam) error
+	// CommitCalls records the arguments of the calls made to Commit.
+	CommitCalls []*RightScriptCommitCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.RightScriptIndexOptions) ([]*cm15.RightScript, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*RightScriptIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.RightScriptIndexOptions, func(*cm15.RightScript) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*RightScriptIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.RightScript, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*RightScriptShowCall
+
+	// ShowSourceStub is called by ShowSource if not nil.
+	ShowSourceStub func() error
+	// ShowSourceCalls records the arguments of the calls made to ShowSource.
+	ShowSourceCalls []*RightScriptShowSourceCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.RightScriptParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*RightScriptUpdateCall
+
+	// UpdateSourceStub is called by UpdateSource if not nil.
+	UpdateSourceStub func() error
+	// UpdateSourceCalls records the arguments of the calls made to UpdateSource.
+	UpdateSourceCalls []*RightScriptUpdateSourceCall
+}
+
+var _ cm15.RightScriptLocatorInterface = (*RightScriptLocator)(nil)
+
+// RightScriptCommitCall records the arguments of a call to RightScriptLocator.Commit.
+type RightScriptCommitCall struct {
+	RightScript *cm15.RightScriptParam
+}
+
+// Commit records the call and returns the results of CommitStub if set.
+func (fake *RightScriptLocator) Commit(rightScript *cm15.RightScriptParam) error {
+	fake.mu.Lock()
+	fake.CommitCalls = append(fake.CommitCalls, &RightScriptCommitCall{RightScript: rightScript})
+	stub := fake.CommitStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(rightScript)
+	}
+	return nil
+}
+
+// CommitReturns sets CommitStub to a function that returns the given values.
+func (fake *RightScriptLocator) CommitReturns(err error) {
+	fake.mu.Lock()
+	fake.CommitStub = func(*cm15.RightScriptParam) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RightScriptIndexCall records the arguments of a call to RightScriptLocator.Index.
+type RightScriptIndexCall struct {
+	Options *cm15.RightScriptIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *RightScriptLocator) Index(options *cm15.RightScriptIndexOptions) ([]*cm15.RightScript, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &RightScriptIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.RightScript
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *RightScriptLocator) IndexReturns(res []*cm15.RightScript, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.RightScriptIndexOptions) ([]*cm15.RightScript, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RightScriptIndexEachCall records the arguments of a call to RightScriptLocator.IndexEach.
+type RightScriptIndexEachCall struct {
+	Options *cm15.RightScriptIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *RightScriptLocator) IndexEach(options *cm15.RightScriptIndexOptions, fn func(*cm15.RightScript) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &RightScriptIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *RightScriptLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.RightScriptIndexOptions, func(*cm15.RightScript) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RightScriptShowCall records the arguments of a call to RightScriptLocator.Show.
+type RightScriptShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *RightScriptLocator) Show() (*cm15.RightScript, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &RightScriptShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.RightScript
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *RightScriptLocator) ShowReturns(res *cm15.RightScript, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.RightScript, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RightScriptShowSourceCall records the arguments of a call to RightScriptLocator.ShowSource.
+type RightScriptShowSourceCall struct{}
+
+// ShowSource records the call and returns the results of ShowSourceStub if set.
+func (fake *RightScriptLocator) ShowSource() error {
+	fake.mu.Lock()
+	fake.ShowSourceCalls = append(fake.ShowSourceCalls, &RightScriptShowSourceCall{})
+	stub := fake.ShowSourceStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// ShowSourceReturns sets ShowSourceStub to a function that returns the given values.
+func (fake *RightScriptLocator) ShowSourceReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowSourceStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RightScriptUpdateCall records the arguments of a call to RightScriptLocator.Update.
+type RightScriptUpdateCall struct {
+	RightScript *cm15.RightScriptParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *RightScriptLocator) Update(rightScript *cm15.RightScriptParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &RightScriptUpdateCall{RightScript: rightScript})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(rightScript)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *RightScriptLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.RightScriptParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RightScriptUpdateSourceCall records the arguments of a call to RightScriptLocator.UpdateSource.
+type RightScriptUpdateSourceCall struct{}
+
+// UpdateSource records the call and returns the results of UpdateSourceStub if set.
+func (fake *RightScriptLocator) UpdateSource() error {
+	fake.mu.Lock()
+	fake.UpdateSourceCalls = append(fake.UpdateSourceCalls, &RightScriptUpdateSourceCall{})
+	stub := fake.UpdateSourceStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// UpdateSourceReturns sets UpdateSourceStub to a function that returns the given values.
+func (fake *RightScriptLocator) UpdateSourceReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateSourceStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Route ******/
+
+// RouteLocator is a fake implementation of cm15.RouteLocatorInterface.
+type RouteLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.RouteParam) (*cm15.RouteLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*RouteCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*RouteDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.RouteIndexOptions) ([]*cm15.Route, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*RouteIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.RouteIndexOptions, func(*cm15.Route) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*RouteIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.Route, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*RouteShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.RouteParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*RouteUpdateCall
+}
+
+var _ cm15.RouteLocatorInterface = (*RouteLocator)(nil)
+
+// RouteCreateCall records the arguments of a call to RouteLocator.Create.
+type RouteCreateCall struct {
+	Route *cm15.RouteParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *RouteLocator) Create(route *cm15.RouteParam) (*cm15.RouteLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &RouteCreateCall{Route: route})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(route)
+	}
+	var res *cm15.RouteLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *RouteLocator) CreateReturns(res *cm15.RouteLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.RouteParam) (*cm15.RouteLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RouteDestroyCall records the arguments of a call to RouteLocator.Destroy.
+type RouteDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *RouteLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &RouteDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *RouteLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RouteIndexCall records the arguments of a call to RouteLocator.Index.
+type RouteIndexCall struct {
+	Options *cm15.RouteIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *RouteLocator) Index(options *cm15.RouteIndexOptions) ([]*cm15.Route, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &RouteIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Route
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *RouteLocator) IndexReturns(res []*cm15.Route, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.RouteIndexOptions) ([]*cm15.Route, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RouteIndexEachCall records the arguments of a call to RouteLocator.IndexEach.
+type RouteIndexEachCall struct {
+	Options *cm15.RouteIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *RouteLocator) IndexEach(options *cm15.RouteIndexOptions, fn func(*cm15.Route) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &RouteIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *RouteLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.RouteIndexOptions, func(*cm15.Route) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RouteShowCall records the arguments of a call to RouteLocator.Show.
+type RouteShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *RouteLocator) Show() (*cm15.Route, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &RouteShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.Route
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *RouteLocator) ShowReturns(res *cm15.Route, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.Route, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RouteUpdateCall records the arguments of a call to RouteLocator.Update.
+type RouteUpdateCall struct {
+	Route *cm15.RouteParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *RouteLocator) Update(route *cm15.RouteParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &RouteUpdateCall{Route: route})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(route)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *RouteLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.RouteParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  RouteTable ******/
+
+// RouteTableLocator is a fake implementation of cm15.RouteTableLocatorInterface.
+type RouteTableLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.RouteTableParam) (*cm15.RouteTableLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*RouteTableCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*RouteTableDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.RouteTableIndexOptions) ([]*cm15.RouteTable, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*RouteTableIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.RouteTableIndexOptions, func(*cm15.RouteTable) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*RouteTableIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.RouteTableShowOptions) (*cm15.RouteTable, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*RouteTableShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.RouteTableParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*RouteTableUpdateCall
+}
+
+var _ cm15.RouteTableLocatorInterface = (*RouteTableLocator)(nil)
+
+// RouteTableCreateCall records the arguments of a call to RouteTableLocator.Create.
+type RouteTableCreateCall struct {
+	RouteTable *cm15.RouteTableParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *RouteTableLocator) Create(routeTable *cm15.RouteTableParam) (*cm15.RouteTableLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &RouteTableCreateCall{RouteTable: routeTable})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(routeTable)
+	}
+	var res *cm15.RouteTableLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *RouteTableLocator) CreateReturns(res *cm15.RouteTableLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.RouteTableParam) (*cm15.RouteTableLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RouteTableDestroyCall records the arguments of a call to RouteTableLocator.Destroy.
+type RouteTableDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *RouteTableLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &RouteTableDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *RouteTableLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RouteTableIndexCall records the arguments of a call to RouteTableLocator.Index.
+type RouteTableIndexCall struct {
+	Options *cm15.RouteTableIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *RouteTableLocator) Index(options *cm15.RouteTableIndexOptions) ([]*cm15.RouteTable, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &RouteTableIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.RouteTable
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *RouteTableLocator) IndexReturns(res []*cm15.RouteTable, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.RouteTableIndexOptions) ([]*cm15.RouteTable, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RouteTableIndexEachCall records the arguments of a call to RouteTableLocator.IndexEach.
+type RouteTableIndexEachCall struct {
+	Options *cm15.RouteTableIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *RouteTableLocator) IndexEach(options *cm15.RouteTableIndexOptions, fn func(*cm15.RouteTable) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &RouteTableIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *RouteTableLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.RouteTableIndexOptions, func(*cm15.RouteTable) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RouteTableShowCall records the arguments of a call to RouteTableLocator.Show.
+type RouteTableShowCall struct {
+	Options *cm15.RouteTableShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *RouteTableLocator) Show(options *cm15.RouteTableShowOptions) (*cm15.RouteTable, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &RouteTableShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.RouteTable
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *RouteTableLocator) ShowReturns(res *cm15.RouteTable, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.RouteTableShowOptions) (*cm15.RouteTable, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RouteTableUpdateCall records the arguments of a call to RouteTableLocator.Update.
+type RouteTableUpdateCall struct {
+	RouteTable *cm15.RouteTableParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *RouteTableLocator) Update(routeTable *cm15.RouteTableParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &RouteTableUpdateCall{RouteTable: routeTable})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(routeTable)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *RouteTableLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.RouteTableParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  RunnableBinding ******/
+
+// RunnableBindingLocator is a fake implementation of cm15.RunnableBindingLocatorInterface.
+type RunnableBindingLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.RunnableBindingParam) (*cm15.RunnableBindingLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*RunnableBindingCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*RunnableBindingDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.RunnableBindingIndexOptions) ([]*cm15.RunnableBinding, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*RunnableBindingIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.RunnableBindingIndexOptions, func(*cm15.RunnableBinding) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*RunnableBindingIndexEachCall
+
+	// MultiUpdateStub is called by MultiUpdate if not nil.
+	MultiUpdateStub func([]*cm15.RunnableBindings) error
+	// MultiUpdateCalls records the arguments of the calls made to MultiUpdate.
+	MultiUpdateCalls []*RunnableBindingMultiUpdateCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.RunnableBindingShowOptions) (*cm15.RunnableBinding, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*RunnableBindingShowCall
+}
+
+var _ cm15.RunnableBindingLocatorInterface = (*RunnableBindingLocator)(nil)
+
+// RunnableBindingCreateCall records the arguments of a call to RunnableBindingLocator.Create.
+type RunnableBindingCreateCall struct {
+	RunnableBinding *cm15.RunnableBindingParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *RunnableBindingLocator) Create(runnableBinding *cm15.RunnableBindingParam) (*cm15.RunnableBindingLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &RunnableBindingCreateCall{RunnableBinding: runnableBinding})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(runnableBinding)
+	}
+	var res *cm15.RunnableBindingLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *RunnableBindingLocator) CreateReturns(res *cm15.RunnableBindingLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.RunnableBindingParam) (*cm15.RunnableBindingLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RunnableBindingDestroyCall records the arguments of a call to RunnableBindingLocator.Destroy.
+type RunnableBindingDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *RunnableBindingLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &RunnableBindingDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *RunnableBindingLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RunnableBindingIndexCall records the arguments of a call to RunnableBindingLocator.Index.
+type RunnableBindingIndexCall struct {
+	Options *cm15.RunnableBindingIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *RunnableBindingLocator) Index(options *cm15.RunnableBindingIndexOptions) ([]*cm15.RunnableBinding, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &RunnableBindingIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.RunnableBinding
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *RunnableBindingLocator) IndexReturns(res []*cm15.RunnableBinding, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.RunnableBindingIndexOptions) ([]*cm15.RunnableBinding, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RunnableBindingIndexEachCall records the arguments of a call to RunnableBindingLocator.IndexEach.
+type RunnableBindingIndexEachCall struct {
+	Options *cm15.RunnableBindingIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *RunnableBindingLocator) IndexEach(options *cm15.RunnableBindingIndexOptions, fn func(*cm15.RunnableBinding) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &RunnableBindingIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *RunnableBindingLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.RunnableBindingIndexOptions, func(*cm15.RunnableBinding) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RunnableBindingMultiUpdateCall records the arguments of a call to RunnableBindingLocator.MultiUpdate.
+type RunnableBindingMultiUpdateCall struct {
+	RunnableBindings []*cm15.RunnableBindings
+}
+
+// MultiUpdate records the call and returns the results of MultiUpdateStub if set.
+func (fake *RunnableBindingLocator) MultiUpdate(runnableBindings []*cm15.RunnableBindings) error {
+	fake.mu.Lock()
+	fake.MultiUpdateCalls = append(fake.MultiUpdateCalls, &RunnableBindingMultiUpdateCall{RunnableBindings: runnableBindings})
+	stub := fake.MultiUpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(runnableBindings)
+	}
+	return nil
+}
+
+// MultiUpdateReturns sets MultiUpdateStub to a function that returns the given values.
+func (fake *RunnableBindingLocator) MultiUpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiUpdateStub = func([]*cm15.RunnableBindings) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// RunnableBindingShowCall records the arguments of a call to RunnableBindingLocator.Show.
+type RunnableBindingShowCall struct {
+	Options *cm15.RunnableBindingShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *RunnableBindingLocator) Show(options *cm15.RunnableBindingShowOptions) (*cm15.RunnableBinding, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &RunnableBindingShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.RunnableBinding
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *RunnableBindingLocator) ShowReturns(res *cm15.RunnableBinding, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.RunnableBindingShowOptions) (*cm15.RunnableBinding, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Scheduler ******/
+
+// SchedulerLocator is a fake implementation of cm15.SchedulerLocatorInterface.
+type SchedulerLocator struct {
+	mu sync.Mutex
+
+	// ScheduleRecipeStub is called by ScheduleRecipe if not nil.
+	ScheduleRecipeStub func(*cm15.SchedulerScheduleRecipeOptions) error
+	// ScheduleRecipeCalls records the arguments of the calls made to ScheduleRecipe.
+	ScheduleRecipeCalls []*SchedulerScheduleRecipeCall
+
+	// ScheduleRightScriptStub is called by ScheduleRightScript if not nil.
+	ScheduleRightScriptStub func(*cm15.SchedulerScheduleRightScriptOptions) error
+	// ScheduleRightScriptCalls records the arguments of the calls made to ScheduleRightScript.
+	ScheduleRightScriptCalls []*SchedulerScheduleRightScriptCall
+}
+
+var _ cm15.SchedulerLocatorInterface = (*SchedulerLocator)(nil)
+
+// SchedulerScheduleRecipeCall records the arguments of a call to SchedulerLocator.ScheduleRecipe.
+type SchedulerScheduleRecipeCall struct {
+	Options *cm15.SchedulerScheduleRecipeOptions
+}
+
+// ScheduleRecipe records the call and returns the results of ScheduleRecipeStub if set.
+func (fake *SchedulerLocator) ScheduleRecipe(options *cm15.SchedulerScheduleRecipeOptions) error {
+	fake.mu.Lock()
+	fake.ScheduleRecipeCalls = append(fake.ScheduleRecipeCalls, &SchedulerScheduleRecipeCall{Options: options})
+	stub := fake.ScheduleRecipeStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ScheduleRecipeReturns sets ScheduleRecipeStub to a function that returns the given values.
+func (fake *SchedulerLocator) ScheduleRecipeReturns(err error) {
+	fake.mu.Lock()
+	fake.ScheduleRecipeStub = func(*cm15.SchedulerScheduleRecipeOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SchedulerScheduleRightScriptCall records the arguments of a call to SchedulerLocator.ScheduleRightScript.
+type SchedulerScheduleRightScriptCall struct {
+	Options *cm15.SchedulerScheduleRightScriptOptions
+}
+
+// ScheduleRightScript records the call and returns the results of ScheduleRightScriptStub if set.
+func (fake *SchedulerLocator) ScheduleRightScript(options *cm15.SchedulerScheduleRightScriptOptions) error {
+	fake.mu.Lock()
+	fake.ScheduleRightScriptCalls = append(fake.ScheduleRightScriptCalls, &SchedulerScheduleRightScriptCall{Options: options})
+	stub := fake.ScheduleRightScriptStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ScheduleRightScriptReturns sets ScheduleRightScriptStub to a function that returns the given values.
+func (fake *SchedulerLocator) ScheduleRightScriptReturns(err error) {
+	fake.mu.Lock()
+	fake.ScheduleRightScriptStub = func(*cm15.SchedulerScheduleRightScriptOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  SecurityGroup ******/
+
+// SecurityGroupLocator is a fake implementation of cm15.SecurityGroupLocatorInterface.
+type SecurityGroupLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.SecurityGroupParam) (*cm15.SecurityGroupLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*SecurityGroupCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*SecurityGroupDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.SecurityGroupIndexOptions) ([]*cm15.SecurityGroup, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*SecurityGroupIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.SecurityGroupIndexOptions, func(*cm15.SecurityGroup) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*SecurityGroupIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.SecurityGroupShowOptions) (*cm15.SecurityGroup, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*SecurityGroupShowCall
+}
+
+var _ cm15.SecurityGroupLocatorInterface = (*SecurityGroupLocator)(nil)
+
+// SecurityGroupCreateCall records the arguments of a call to SecurityGroupLocator.Create.
+type SecurityGroupCreateCall struct {
+	SecurityGroup *cm15.SecurityGroupParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *SecurityGroupLocator) Create(securityGroup *cm15.SecurityGroupParam) (*cm15.SecurityGroupLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &SecurityGroupCreateCall{SecurityGroup: securityGroup})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(securityGroup)
+	}
+	var res *cm15.SecurityGroupLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *SecurityGroupLocator) CreateReturns(res *cm15.SecurityGroupLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.SecurityGroupParam) (*cm15.SecurityGroupLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// SecurityGroupDestroyCall records the arguments of a call to SecurityGroupLocator.Destroy.
+type SecurityGroupDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *SecurityGroupLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &SecurityGroupDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *SecurityGroupLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SecurityGroupIndexCall records the arguments of a call to SecurityGroupLocator.Index.
+type SecurityGroupIndexCall struct {
+	Options *cm15.SecurityGroupIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *SecurityGroupLocator) Index(options *cm15.SecurityGroupIndexOptions) ([]*cm15.SecurityGroup, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &SecurityGroupIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.SecurityGroup
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *SecurityGroupLocator) IndexReturns(res []*cm15.SecurityGroup, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.SecurityGroupIndexOptions) ([]*cm15.SecurityGroup, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// SecurityGroupIndexEachCall records the arguments of a call to SecurityGroupLocator.IndexEach.
+type SecurityGroupIndexEachCall struct {
+	Options *cm15.SecurityGroupIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *SecurityGroupLocator) IndexEach(options *cm15.SecurityGroupIndexOptions, fn func(*cm15.SecurityGroup) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &SecurityGroupIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *SecurityGroupLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.SecurityGroupIndexOptions, func(*cm15.SecurityGroup) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SecurityGroupShowCall records the arguments of a call to SecurityGroupLocator.Show.
+type SecurityGroupShowCall struct {
+	Options *cm15.SecurityGroupShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *SecurityGroupLocator) Show(options *cm15.SecurityGroupShowOptions) (*cm15.SecurityGroup, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &SecurityGroupShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.SecurityGroup
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *SecurityGroupLocator) ShowReturns(res *cm15.SecurityGroup, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.SecurityGroupShowOptions) (*cm15.SecurityGroup, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  SecurityGroupRule ******/
+
+// SecurityGroupRuleLocator is a fake implementation of cm15.SecurityGroupRuleLocatorInterface.
+type SecurityGroupRuleLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.SecurityGroupRuleParam) (*cm15.SecurityGroupRuleLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*SecurityGroupRuleCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*SecurityGroupRuleDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.SecurityGroupRuleIndexOptions) ([]*cm15.SecurityGroupRule, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*SecurityGroupRuleIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.SecurityGroupRuleIndexOptions, func(*cm15.SecurityGroupRule) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*SecurityGroupRuleIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.SecurityGroupRuleShowOptions) (*cm15.SecurityGroupRule, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*SecurityGroupRuleShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.SecurityGroupRuleParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*SecurityGroupRuleUpdateCall
+}
+
+var _ cm15.SecurityGroupRuleLocatorInterface = (*SecurityGroupRuleLocator)(nil)
+
+// SecurityGroupRuleCreateCall records the arguments of a call to SecurityGroupRuleLocator.Create.
+type SecurityGroupRuleCreateCall struct {
+	SecurityGroupRule *cm15.SecurityGroupRuleParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *SecurityGroupRuleLocator) Create(securityGroupRule *cm15.SecurityGroupRuleParam) (*cm15.SecurityGroupRuleLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &SecurityGroupRuleCreateCall{SecurityGroupRule: securityGroupRule})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(securityGroupRule)
+	}
+	var res *cm15.SecurityGroupRuleLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *SecurityGroupRuleLocator) CreateReturns(res *cm15.SecurityGroupRuleLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.SecurityGroupRuleParam) (*cm15.SecurityGroupRuleLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// SecurityGroupRuleDestroyCall records the arguments of a call to SecurityGroupRuleLocator.Destroy.
+type SecurityGroupRuleDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *SecurityGroupRuleLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &SecurityGroupRuleDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *SecurityGroupRuleLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SecurityGroupRuleIndexCall records the arguments of a call to SecurityGroupRuleLocator.Index.
+type SecurityGroupRuleIndexCall struct {
+	Options *cm15.SecurityGroupRuleIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *SecurityGroupRuleLocator) Index(options *cm15.SecurityGroupRuleIndexOptions) ([]*cm15.SecurityGroupRule, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &SecurityGroupRuleIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.SecurityGroupRule
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *SecurityGroupRuleLocator) IndexReturns(res []*cm15.SecurityGroupRule, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.SecurityGroupRuleIndexOptions) ([]*cm15.SecurityGroupRule, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// SecurityGroupRuleIndexEachCall records the arguments of a call to SecurityGroupRuleLocator.IndexEach.
+type SecurityGroupRuleIndexEachCall struct {
+	Options *cm15.SecurityGroupRuleIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *SecurityGroupRuleLocator) IndexEach(options *cm15.SecurityGroupRuleIndexOptions, fn func(*cm15.SecurityGroupRule) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &SecurityGroupRuleIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *SecurityGroupRuleLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.SecurityGroupRuleIndexOptions, func(*cm15.SecurityGroupRule) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SecurityGroupRuleShowCall records the arguments of a call to SecurityGroupRuleLocator.Show.
+type SecurityGroupRuleShowCall struct {
+	Options *cm15.SecurityGroupRuleShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *SecurityGroupRuleLocator) Show(options *cm15.SecurityGroupRuleShowOptions) (*cm15.SecurityGroupRule, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &SecurityGroupRuleShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.SecurityGroupRule
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *SecurityGroupRuleLocator) ShowReturns(res *cm15.SecurityGroupRule, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.SecurityGroupRuleShowOptions) (*cm15.SecurityGroupRule, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// SecurityGroupRuleUpdateCall records the arguments of a call to SecurityGroupRuleLocator.Update.
+type SecurityGroupRuleUpdateCall struct {
+	SecurityGroupRule *cm15.SecurityGroupRuleParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *SecurityGroupRuleLocator) Update(securityGroupRule *cm15.SecurityGroupRuleParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &SecurityGroupRuleUpdateCall{SecurityGroupRule: securityGroupRule})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(securityGroupRule)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *SecurityGroupRuleLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.SecurityGroupRuleParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Server ******/
+
+// ServerLocator is a fake implementation of cm15.ServerLocatorInterface.
+type ServerLocator struct {
+	mu sync.Mutex
+
+	// CloneStub is called by Clone if not nil.
+	CloneStub func() error
+	// CloneCalls records the arguments of the calls made to Clone.
+	CloneCalls []*ServerCloneCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.ServerParam) (*cm15.ServerLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*ServerCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*ServerDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.ServerIndexOptions) ([]*cm15.Server, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ServerIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.ServerIndexOptions, func(*cm15.Server) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*ServerIndexEachCall
+
+	// LaunchStub is called by Launch if not nil.
+	LaunchStub func() error
+	// LaunchCalls records the arguments of the calls made to Launch.
+	LaunchCalls []*ServerLaunchCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.ServerShowOptions) (*cm15.Server, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ServerShowCall
+
+	// TerminateStub is called by Terminate if not nil.
+	TerminateStub func() error
+	// TerminateCalls records the arguments of the calls made to Terminate.
+	TerminateCalls []*ServerTerminateCall
+
+	// UnwrapStub is called by Unwrap if not nil.
+	UnwrapStub func() error
+	// UnwrapCalls records the arguments of the calls made to Unwrap.
+	UnwrapCalls []*ServerUnwrapCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.ServerParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*ServerUpdateCall
+
+	// WrapInstanceStub is called by WrapInstance if not nil.
+	WrapInstanceStub func(*cm15.ServerParam3) error
+	// WrapInstanceCalls records the arguments of the calls made to WrapInstance.
+	WrapInstanceCalls []*ServerWrapInstanceCall
+}
+
+var _ cm15.ServerLocatorInterface = (*ServerLocator)(nil)
+
+// ServerCloneCall records the arguments of a call to ServerLocator.Clone.
+type ServerCloneCall struct{}
+
+// Clone records the call and returns the results of CloneStub if set.
+func (fake *ServerLocator) Clone() error {
+	fake.mu.Lock()
+	fake.CloneCalls = append(fake.CloneCalls, &ServerCloneCall{})
+	stub := fake.CloneStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// CloneReturns sets CloneStub to a function that returns the given values.
+func (fake *ServerLocator) CloneReturns(err error) {
+	fake.mu.Lock()
+	fake.CloneStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerCreateCall records the arguments of a call to ServerLocator.Create.
+type ServerCreateCall struct {
+	Server *cm15.ServerParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *ServerLocator) Create(server *cm15.ServerParam) (*cm15.ServerLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &ServerCreateCall{Server: server})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(server)
+	}
+	var res *cm15.ServerLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *ServerLocator) CreateReturns(res *cm15.ServerLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.ServerParam) (*cm15.ServerLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerDestroyCall records the arguments of a call to ServerLocator.Destroy.
+type ServerDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *ServerLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &ServerDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *ServerLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerIndexCall records the arguments of a call to ServerLocator.Index.
+type ServerIndexCall struct {
+	Options *cm15.ServerIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ServerLocator) Index(options *cm15.ServerIndexOptions) ([]*cm15.Server, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ServerIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Server
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ServerLocator) IndexReturns(res []*cm15.Server, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.ServerIndexOptions) ([]*cm15.Server, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerIndexEachCall records the arguments of a call to ServerLocator.IndexEach.
+type ServerIndexEachCall struct {
+	Options *cm15.ServerIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *ServerLocator) IndexEach(options *cm15.ServerIndexOptions, fn func(*cm15.Server) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &ServerIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *ServerLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.ServerIndexOptions, func(*cm15.Server) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerLaunchCall records the arguments of a call to ServerLocator.Launch.
+type ServerLaunchCall struct{}
+
+// Launch records the call and returns the results of LaunchStub if set.
+func (fake *ServerLocator) Launch() error {
+	fake.mu.Lock()
+	fake.LaunchCalls = append(fake.LaunchCalls, &ServerLaunchCall{})
+	stub := fake.LaunchStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// LaunchReturns sets LaunchStub to a function that returns the given values.
+func (fake *ServerLocator) LaunchReturns(err error) {
+	fake.mu.Lock()
+	fake.LaunchStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerShowCall records the arguments of a call to ServerLocator.Show.
+type ServerShowCall struct {
+	Options *cm15.ServerShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ServerLocator) Show(options *cm15.ServerShowOptions) (*cm15.Server, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ServerShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Server
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ServerLocator) ShowReturns(res *cm15.Server, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.ServerShowOptions) (*cm15.Server, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTerminateCall records the arguments of a call to ServerLocator.Terminate.
+type ServerTerminateCall struct{}
+
+// Terminate records the call and returns the results of TerminateStub if set.
+func (fake *ServerLocator) Terminate() error {
+	fake.mu.Lock()
+	fake.TerminateCalls = append(fake.TerminateCalls, &ServerTerminateCall{})
+	stub := fake.TerminateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// TerminateReturns sets TerminateStub to a function that returns the given values.
+func (fake *ServerLocator) TerminateReturns(err error) {
+	fake.mu.Lock()
+	fake.TerminateStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerUnwrapCall records the arguments of a call to ServerLocator.Unwrap.
+type ServerUnwrapCall struct{}
+
+// Unwrap records the call and returns the results of UnwrapStub if set.
+func (fake *ServerLocator) Unwrap() error {
+	fake.mu.Lock()
+	fake.UnwrapCalls = append(fake.UnwrapCalls, &ServerUnwrapCall{})
+	stub := fake.UnwrapStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// UnwrapReturns sets UnwrapStub to a function that returns the given values.
+func (fake *ServerLocator) UnwrapReturns(err error) {
+	fake.mu.Lock()
+	fake.UnwrapStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerUpdateCall records the arguments of a call to ServerLocator.Update.
+type ServerUpdateCall struct {
+	Server *cm15.ServerParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *ServerLocator) Update(server *cm15.ServerParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &ServerUpdateCall{Server: server})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(server)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *ServerLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.ServerParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerWrapInstanceCall records the arguments of a call to ServerLocator.WrapInstance.
+type ServerWrapInstanceCall struct {
+	Server *cm15.ServerParam3
+}
+
+// WrapInstance records the call and returns the results of WrapInstanceStub if set.
+func (fake *ServerLocator) WrapInstance(server *cm15.ServerParam3) error {
+	fake.mu.Lock()
+	fake.WrapInstanceCalls = append(fake.WrapInstanceCalls, &ServerWrapInstanceCall{Server: server})
+	stub := fake.WrapInstanceStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(server)
+	}
+	return nil
+}
+
+// WrapInstanceReturns sets WrapInstanceStub to a function that returns the given values.
+func (fake *ServerLocator) WrapInstanceReturns(err error) {
+	fake.mu.Lock()
+	fake.WrapInstanceStub = func(*cm15.ServerParam3) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  ServerArray ******/
+
+// ServerArrayLocator is a fake implementation of cm15.ServerArrayLocatorInterface.
+type ServerArrayLocator struct {
+	mu sync.Mutex
+
+	// CloneStub is called by Clone if not nil.
+	CloneStub func() error
+	// CloneCalls records the arguments of the calls made to Clone.
+	CloneCalls []*ServerArrayCloneCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.ServerArrayParam) (*cm15.ServerArrayLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*ServerArrayCreateCall
+
+	// CurrentInstancesStub is called by CurrentInstances if not nil.
+	CurrentInstancesStub func() ([]*cm15.Instance, error)
+	// CurrentInstancesCalls records the arguments of the calls made to CurrentInstances.
+	CurrentInstancesCalls []*ServerArrayCurrentInstancesCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*ServerArrayDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.ServerArrayIndexOptions) ([]*cm15.ServerArray, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ServerArrayIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.ServerArrayIndexOptions, func(*cm15.ServerArray) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*ServerArrayIndexEachCall
+
+	// LaunchStub is called by Launch if not nil.
+	LaunchStub func() error
+	// LaunchCalls records the arguments of the calls made to Launch.
+	LaunchCalls []*ServerArrayLaunchCall
+
+	// MultiRunExecutableStub is called by MultiRunExecutable if not nil.
+	MultiRunExecutableStub func() error
+	// MultiRunExecutableCalls records the arguments of the calls made to MultiRunExecutable.
+	MultiRunExecutableCalls []*ServerArrayMultiRunExecutableCall
+
+	// MultiTerminateStub is called by MultiTerminate if not nil.
+	MultiTerminateStub func() error
+	// MultiTerminateCalls records the arguments of the calls made to MultiTerminate.
+	MultiTerminateCalls []*ServerArrayMultiTerminateCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.ServerArrayShowOptions) (*cm15.ServerArray, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ServerArrayShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.ServerArrayParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*ServerArrayUpdateCall
+}
+
+var _ cm15.ServerArrayLocatorInterface = (*ServerArrayLocator)(nil)
+
+// ServerArrayCloneCall records the arguments of a call to ServerArrayLocator.Clone.
+type ServerArrayCloneCall struct{}
+
+// Clone records the call and returns the results of CloneStub if set.
+func (fake *ServerArrayLocator) Clone() error {
+	fake.mu.Lock()
+	fake.CloneCalls = append(fake.CloneCalls, &ServerArrayCloneCall{})
+	stub := fake.CloneStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// CloneReturns sets CloneStub to a function that returns the given values.
+func (fake *ServerArrayLocator) CloneReturns(err error) {
+	fake.mu.Lock()
+	fake.CloneStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerArrayCreateCall records the arguments of a call to ServerArrayLocator.Create.
+type ServerArrayCreateCall struct {
+	ServerArray *cm15.ServerArrayParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *ServerArrayLocator) Create(serverArray *cm15.ServerArrayParam) (*cm15.ServerArrayLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &ServerArrayCreateCall{ServerArray: serverArray})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(serverArray)
+	}
+	var res *cm15.ServerArrayLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *ServerArrayLocator) CreateReturns(res *cm15.ServerArrayLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.ServerArrayParam) (*cm15.ServerArrayLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerArrayCurrentInstancesCall records the arguments of a call to ServerArrayLocator.CurrentInstances.
+type ServerArrayCurrentInstancesCall struct{}
+
+// CurrentInstances records the call and returns the results of CurrentInstancesStub if set.
+func (fake *ServerArrayLocator) CurrentInstances() ([]*cm15.Instance, error) {
+	fake.mu.Lock()
+	fake.CurrentInstancesCalls = append(fake.CurrentInstancesCalls, &ServerArrayCurrentInstancesCall{})
+	stub := fake.CurrentInstancesStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res []*cm15.Instance
+	return res, nil
+}
+
+// CurrentInstancesReturns sets CurrentInstancesStub to a function that returns the given values.
+func (fake *ServerArrayLocator) CurrentInstancesReturns(res []*cm15.Instance, err error) {
+	fake.mu.Lock()
+	fake.CurrentInstancesStub = func() ([]*cm15.Instance, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerArrayDestroyCall records the arguments of a call to ServerArrayLocator.Destroy.
+type ServerArrayDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *ServerArrayLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &ServerArrayDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *ServerArrayLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerArrayIndexCall records the arguments of a call to ServerArrayLocator.Index.
+type ServerArrayIndexCall struct {
+	Options *cm15.ServerArrayIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ServerArrayLocator) Index(options *cm15.ServerArrayIndexOptions) ([]*cm15.ServerArray, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ServerArrayIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.ServerArray
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ServerArrayLocator) IndexReturns(res []*cm15.ServerArray, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.ServerArrayIndexOptions) ([]*cm15.ServerArray, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerArrayIndexEachCall records the arguments of a call to ServerArrayLocator.IndexEach.
+type ServerArrayIndexEachCall struct {
+	Options *cm15.ServerArrayIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *ServerArrayLocator) IndexEach(options *cm15.ServerArrayIndexOptions, fn func(*cm15.ServerArray) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &ServerArrayIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *ServerArrayLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.ServerArrayIndexOptions, func(*cm15.ServerArray) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerArrayLaunchCall records the arguments of a call to ServerArrayLocator.Launch.
+type ServerArrayLaunchCall struct{}
+
+// Launch records the call and returns the results of LaunchStub if set.
+func (fake *ServerArrayLocator) Launch() error {
+	fake.mu.Lock()
+	fake.LaunchCalls = append(fake.LaunchCalls, &ServerArrayLaunchCall{})
+	stub := fake.LaunchStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// LaunchReturns sets LaunchStub to a function that returns the given values.
+func (fake *ServerArrayLocator) LaunchReturns(err error) {
+	fake.mu.Lock()
+	fake.LaunchStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerArrayMultiRunExecutableCall records the arguments of a call to ServerArrayLocator.MultiRunExecutable.
+type ServerArrayMultiRunExecutableCall struct{}
+
+// MultiRunExecutable records the call and returns the results of MultiRunExecutableStub if set.
+func (fake *ServerArrayLocator) MultiRunExecutable() error {
+	fake.mu.Lock()
+	fake.MultiRunExecutableCalls = append(fake.MultiRunExecutableCalls, &ServerArrayMultiRunExecutableCall{})
+	stub := fake.MultiRunExecutableStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// MultiRunExecutableReturns sets MultiRunExecutableStub to a function that returns the given values.
+func (fake *ServerArrayLocator) MultiRunExecutableReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiRunExecutableStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerArrayMultiTerminateCall records the arguments of a call to ServerArrayLocator.MultiTerminate.
+type ServerArrayMultiTerminateCall struct{}
+
+// MultiTerminate records the call and returns the results of MultiTerminateStub if set.
+func (fake *ServerArrayLocator) MultiTerminate() error {
+	fake.mu.Lock()
+	fake.MultiTerminateCalls = append(fake.MultiTerminateCalls, &ServerArrayMultiTerminateCall{})
+	stub := fake.MultiTerminateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// MultiTerminateReturns sets MultiTerminateStub to a function that returns the given values.
+func (fake *ServerArrayLocator) MultiTerminateReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiTerminateStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerArrayShowCall records the arguments of a call to ServerArrayLocator.Show.
+type ServerArrayShowCall struct {
+	Options *cm15.ServerArrayShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ServerArrayLocator) Show(options *cm15.ServerArrayShowOptions) (*cm15.ServerArray, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ServerArrayShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.ServerArray
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ServerArrayLocator) ShowReturns(res *cm15.ServerArray, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.ServerArrayShowOptions) (*cm15.ServerArray, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerArrayUpdateCall records the arguments of a call to ServerArrayLocator.Update.
+type ServerArrayUpdateCall struct {
+	ServerArray *cm15.ServerArrayParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *ServerArrayLocator) Update(serverArray *cm15.ServerArrayParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &ServerArrayUpdateCall{ServerArray: serverArray})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(serverArray)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *ServerArrayLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.ServerArrayParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  ServerTemplate ******/
+
+// ServerTemplateLocator is a fake implementation of cm15.ServerTemplateLocatorInterface.
+type ServerTemplateLocator struct {
+	mu sync.Mutex
+
+	// CloneStub is called by Clone if not nil.
+	CloneStub func(*cm15.ServerTemplateParam) error
+	// CloneCalls records the arguments of the calls made to Clone.
+	CloneCalls []*ServerTemplateCloneCall
+
+	// CommitStub is called by Commit if not nil.
+	CommitStub func(string, string, string) error
+	// CommitCalls records the arguments of the calls made to Commit.
+	CommitCalls []*ServerTemplateCommitCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.ServerTemplateParam) (*cm15.ServerTemplateLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*ServerTemplateCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*ServerTemplateDestroyCall
+
+	// DetectChangesInHeadStub is called by DetectChangesInHead if not nil.
+	DetectChangesInHeadStub func() ([]map[string]interface{}, error)
+	// DetectChangesInHeadCalls records the arguments of the calls made to DetectChangesInHead.
+	DetectChangesInHeadCalls []*ServerTemplateDetectChangesInHeadCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.ServerTemplateIndexOptions) ([]*cm15.ServerTemplate, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ServerTemplateIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.ServerTemplateIndexOptions, func(*cm15.ServerTemplate) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*ServerTemplateIndexEachCall
+
+	// PublishStub is called by Publish if not nil.
+	PublishStub func([]string, *cm15.Descriptions, *cm15.ServerTemplatePublishOptions) error
+	// PublishCalls records the arguments of the calls made to Publish.
+	PublishCalls []*ServerTemplatePublishCall
+
+	// ResolveStub is called by Resolve if not nil.
+	ResolveStub func() ([]map[string]interface{}, error)
+	// ResolveCalls records the arguments of the calls made to Resolve.
+	ResolveCalls []*ServerTemplateResolveCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.ServerTemplateShowOptions) (*cm15.ServerTemplate, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ServerTemplateShowCall
+
+	// SwapRepositoryStub is called by SwapRepository if not nil.
+	SwapRepositoryStub func(string, string) error
+	// SwapRepositoryCalls records the arguments of the calls made to SwapRepository.
+	SwapRepositoryCalls []*ServerTemplateSwapRepositoryCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.ServerTemplateParam) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*ServerTemplateUpdateCall
+}
+
+var _ cm15.ServerTemplateLocatorInterface = (*ServerTemplateLocator)(nil)
+
+// ServerTemplateCloneCall records the arguments of a call to ServerTemplateLocator.Clone.
+type ServerTemplateCloneCall struct {
+	ServerTemplate *cm15.ServerTemplateParam
+}
+
+// Clone records the call and returns the results of CloneStub if set.
+func (fake *ServerTemplateLocator) Clone(serverTemplate *cm15.ServerTemplateParam) error {
+	fake.mu.Lock()
+	fake.CloneCalls = append(fake.CloneCalls, &ServerTemplateCloneCall{ServerTemplate: serverTemplate})
+	stub := fake.CloneStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(serverTemplate)
+	}
+	return nil
+}
+
+// CloneReturns sets CloneStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) CloneReturns(err error) {
+	fake.mu.Lock()
+	fake.CloneStub = func(*cm15.ServerTemplateParam) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateCommitCall records the arguments of a call to ServerTemplateLocator.Commit.
+type ServerTemplateCommitCall struct {
+	CommitHeadDependencies string
+	CommitMessage          string
+	FreezeRepositories     string
+}
+
+// Commit records the call and returns the results of CommitStub if set.
+func (fake *ServerTemplateLocator) Commit(commitHeadDependencies string, commitMessage string, freezeRepositories string) error {
+	fake.mu.Lock()
+	fake.CommitCalls = append(fake.CommitCalls, &ServerTemplateCommitCall{CommitHeadDependencies: commitHeadDependencies, CommitMessage: commitMessage, FreezeRepositories: freezeRepositories})
+	stub := fake.CommitStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(commitHeadDependencies, commitMessage, freezeRepositories)
+	}
+	return nil
+}
+
+// CommitReturns sets CommitStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) CommitReturns(err error) {
+	fake.mu.Lock()
+	fake.CommitStub = func(string, string, string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateCreateCall records the arguments of a call to ServerTemplateLocator.Create.
+type ServerTemplateCreateCall struct {
+	ServerTemplate *cm15.ServerTemplateParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *ServerTemplateLocator) Create(serverTemplate *cm15.ServerTemplateParam) (*cm15.ServerTemplateLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &ServerTemplateCreateCall{ServerTemplate: serverTemplate})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(serverTemplate)
+	}
+	var res *cm15.ServerTemplateLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) CreateReturns(res *cm15.ServerTemplateLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.ServerTemplateParam) (*cm15.ServerTemplateLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateDestroyCall records the arguments of a call to ServerTemplateLocator.Destroy.
+type ServerTemplateDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *ServerTemplateLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &ServerTemplateDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateDetectChangesInHeadCall records the arguments of a call to ServerTemplateLocator.DetectChangesInHead.
+type ServerTemplateDetectChangesInHeadCall struct{}
+
+// DetectChangesInHead records the call and returns the results of DetectChangesInHeadStub if set.
+func (fake *ServerTemplateLocator) DetectChangesInHead() ([]map[string]interface{}, error) {
+	fake.mu.Lock()
+	fake.DetectChangesInHeadCalls = append(fake.DetectChangesInHeadCalls, &ServerTemplateDetectChangesInHeadCall{})
+	stub := fake.DetectChangesInHeadStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res []map[string]interface{}
+	return res, nil
+}
+
+// DetectChangesInHeadReturns sets DetectChangesInHeadStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) DetectChangesInHeadReturns(res []map[string]interface{}, err error) {
+	fake.mu.Lock()
+	fake.DetectChangesInHeadStub = func() ([]map[string]interface{}, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateIndexCall records the arguments of a call to ServerTemplateLocator.Index.
+type ServerTemplateIndexCall struct {
+	Options *cm15.ServerTemplateIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ServerTemplateLocator) Index(options *cm15.ServerTemplateIndexOptions) ([]*cm15.ServerTemplate, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ServerTemplateIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.ServerTemplate
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) IndexReturns(res []*cm15.ServerTemplate, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.ServerTemplateIndexOptions) ([]*cm15.ServerTemplate, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateIndexEachCall records the arguments of a call to ServerTemplateLocator.IndexEach.
+type ServerTemplateIndexEachCall struct {
+	Options *cm15.ServerTemplateIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *ServerTemplateLocator) IndexEach(options *cm15.ServerTemplateIndexOptions, fn func(*cm15.ServerTemplate) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &ServerTemplateIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.ServerTemplateIndexOptions, func(*cm15.ServerTemplate) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplatePublishCall records the arguments of a call to ServerTemplateLocator.Publish.
+type ServerTemplatePublishCall struct {
+	AccountGroupHrefs []string
+	Descriptions      *cm15.Descriptions
+	Options           *cm15.ServerTemplatePublishOptions
+}
+
+// Publish records the call and returns the results of PublishStub if set.
+func (fake *ServerTemplateLocator) Publish(accountGroupHrefs []string, descriptions *cm15.Descriptions, options *cm15.ServerTemplatePublishOptions) error {
+	fake.mu.Lock()
+	fake.PublishCalls = append(fake.PublishCalls, &ServerTemplatePublishCall{AccountGroupHrefs: accountGroupHrefs, Descriptions: descriptions, Options: options})
+	stub := fake.PublishStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(accountGroupHrefs, descriptions, options)
+	}
+	return nil
+}
+
+// PublishReturns sets PublishStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) PublishReturns(err error) {
+	fake.mu.Lock()
+	fake.PublishStub = func([]string, *cm15.Descriptions, *cm15.ServerTemplatePublishOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateResolveCall records the arguments of a call to ServerTemplateLocator.Resolve.
+type ServerTemplateResolveCall struct{}
+
+// Resolve records the call and returns the results of ResolveStub if set.
+func (fake *ServerTemplateLocator) Resolve() ([]map[string]interface{}, error) {
+	fake.mu.Lock()
+	fake.ResolveCalls = append(fake.ResolveCalls, &ServerTemplateResolveCall{})
+	stub := fake.ResolveStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res []map[string]interface{}
+	return res, nil
+}
+
+// ResolveReturns sets ResolveStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) ResolveReturns(res []map[string]interface{}, err error) {
+	fake.mu.Lock()
+	fake.ResolveStub = func() ([]map[string]interface{}, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateShowCall records the arguments of a call to ServerTemplateLocator.Show.
+type ServerTemplateShowCall struct {
+	Options *cm15.ServerTemplateShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ServerTemplateLocator) Show(options *cm15.ServerTemplateShowOptions) (*cm15.ServerTemplate, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ServerTemplateShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.ServerTemplate
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) ShowReturns(res *cm15.ServerTemplate, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.ServerTemplateShowOptions) (*cm15.ServerTemplate, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateSwapRepositoryCall records the arguments of a call to ServerTemplateLocator.SwapRepository.
+type ServerTemplateSwapRepositoryCall struct {
+	SourceRepositoryHref string
+	TargetRepositoryHref string
+}
+
+// SwapRepository records the call and returns the results of SwapRepositoryStub if set.
+func (fake *ServerTemplateLocator) SwapRepository(sourceRepositoryHref string, targetRepositoryHref string) error {
+	fake.mu.Lock()
+	fake.SwapRepositoryCalls = append(fake.SwapRepositoryCalls, &ServerTemplateSwapRepositoryCall{SourceRepositoryHref: sourceRepositoryHref, TargetRepositoryHref: targetRepositoryHref})
+	stub := fake.SwapRepositoryStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(sourceRepositoryHref, targetRepositoryHref)
+	}
+	return nil
+}
+
+// SwapRepositoryReturns sets SwapRepositoryStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) SwapRepositoryReturns(err error) {
+	fake.mu.Lock()
+	fake.SwapRepositoryStub = func(string, string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateUpdateCall records the arguments of a call to ServerTemplateLocator.Update.
+type ServerTemplateUpdateCall struct {
+	ServerTemplate *cm15.ServerTemplateParam
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *ServerTemplateLocator) Update(serverTemplate *cm15.ServerTemplateParam) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &ServerTemplateUpdateCall{ServerTemplate: serverTemplate})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(serverTemplate)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.ServerTemplateParam) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  ServerTemplateMultiCloudImage ******/
+
+// ServerTemplateMultiCloudImageLocator is a fake implementation of cm15.ServerTemplateMultiCloudImageLocatorInterface.
+type ServerTemplateMultiCloudImageLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.ServerTemplateMultiCloudImageParam) (*cm15.ServerTemplateMultiCloudImageLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*ServerTemplateMultiCloudImageCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*ServerTemplateMultiCloudImageDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.ServerTemplateMultiCloudImageIndexOptions) ([]*cm15.ServerTemplateMultiCloudImage, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ServerTemplateMultiCloudImageIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.ServerTemplateMultiCloudImageIndexOptions, func(*cm15.ServerTemplateMultiCloudImage) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*ServerTemplateMultiCloudImageIndexEachCall
+
+	// MakeDefaultStub is called by MakeDefault if not nil.
+	MakeDefaultStub func() error
+	// MakeDefaultCalls records the arguments of the calls made to MakeDefault.
+	MakeDefaultCalls []*ServerTemplateMultiCloudImageMakeDefaultCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.ServerTemplateMultiCloudImageShowOptions) (*cm15.ServerTemplateMultiCloudImage, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ServerTemplateMultiCloudImageShowCall
+}
+
+var _ cm15.ServerTemplateMultiCloudImageLocatorInterface = (*ServerTemplateMultiCloudImageLocator)(nil)
+
+// ServerTemplateMultiCloudImageCreateCall records the arguments of a call to ServerTemplateMultiCloudImageLocator.Create.
+type ServerTemplateMultiCloudImageCreateCall struct {
+	ServerTemplateMultiCloudImage *cm15.ServerTemplateMultiCloudImageParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *ServerTemplateMultiCloudImageLocator) Create(serverTemplateMultiCloudImage *cm15.ServerTemplateMultiCloudImageParam) (*cm15.ServerTemplateMultiCloudImageLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &ServerTemplateMultiCloudImageCreateCall{ServerTemplateMultiCloudImage: serverTemplateMultiCloudImage})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(serverTemplateMultiCloudImage)
+	}
+	var res *cm15.ServerTemplateMultiCloudImageLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *ServerTemplateMultiCloudImageLocator) CreateReturns(res *cm15.ServerTemplateMultiCloudImageLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.ServerTemplateMultiCloudImageParam) (*cm15.ServerTemplateMultiCloudImageLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateMultiCloudImageDestroyCall records the arguments of a call to ServerTemplateMultiCloudImageLocator.Destroy.
+type ServerTemplateMultiCloudImageDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *ServerTemplateMultiCloudImageLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &ServerTemplateMultiCloudImageDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *ServerTemplateMultiCloudImageLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateMultiCloudImageIndexCall records the arguments of a call to ServerTemplateMultiCloudImageLocator.Index.
+type ServerTemplateMultiCloudImageIndexCall struct {
+	Options *cm15.ServerTemplateMultiCloudImageIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ServerTemplateMultiCloudImageLocator) Index(options *cm15.ServerTemplateMultiCloudImageIndexOptions) ([]*cm15.ServerTemplateMultiCloudImage, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ServerTemplateMultiCloudImageIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.ServerTemplateMultiCloudImage
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ServerTemplateMultiCloudImageLocator) IndexReturns(res []*cm15.ServerTemplateMultiCloudImage, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.ServerTemplateMultiCloudImageIndexOptions) ([]*cm15.ServerTemplateMultiCloudImage, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateMultiCloudImageIndexEachCall records the arguments of a call to ServerTemplateMultiCloudImageLocator.IndexEach.
+type ServerTemplateMultiCloudImageIndexEachCall struct {
+	Options *cm15.ServerTemplateMultiCloudImageIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *ServerTemplateMultiCloudImageLocator) IndexEach(options *cm15.ServerTemplateMultiCloudImageIndexOptions, fn func(*cm15.ServerTemplateMultiCloudImage) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &ServerTemplateMultiCloudImageIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *ServerTemplateMultiCloudImageLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.ServerTemplateMultiCloudImageIndexOptions, func(*cm15.ServerTemplateMultiCloudImage) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateMultiCloudImageMakeDefaultCall records the arguments of a call to ServerTemplateMultiCloudImageLocator.MakeDefault.
+type ServerTemplateMultiCloudImageMakeDefaultCall struct{}
+
+// MakeDefault records the call and returns the results of MakeDefaultStub if set.
+func (fake *ServerTemplateMultiCloudImageLocator) MakeDefault() error {
+	fake.mu.Lock()
+	fake.MakeDefaultCalls = append(fake.MakeDefaultCalls, &ServerTemplateMultiCloudImageMakeDefaultCall{})
+	stub := fake.MakeDefaultStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// MakeDefaultReturns sets MakeDefaultStub to a function that returns the given values.
+func (fake *ServerTemplateMultiCloudImageLocator) MakeDefaultReturns(err error) {
+	fake.mu.Lock()
+	fake.MakeDefaultStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateMultiCloudImageShowCall records the arguments of a call to ServerTemplateMultiCloudImageLocator.Show.
+type ServerTemplateMultiCloudImageShowCall struct {
+	Options *cm15.ServerTemplateMultiCloudImageShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ServerTemplateMultiCloudImageLocator) Show(options *cm15.ServerTemplateMultiCloudImageShowOptions) (*cm15.ServerTemplateMultiCloudImage, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ServerTemplateMultiCloudImageShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.ServerTemplateMultiCloudImage
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ServerTemplateMultiCloudImageLocator) ShowReturns(res *cm15.ServerTemplateMultiCloudImage, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.ServerTemplateMultiCloudImageShowOptions) (*cm15.ServerTemplateMultiCloudImage, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Session ******/
+
+// SessionLocator is a fake implementation of cm15.SessionLocatorInterface.
+type SessionLocator struct {
+	mu sync.Mutex
+
+	// AccountsStub is called by Accounts if not nil.
+	AccountsStub func(*cm15.SessionAccountsOptions) ([]*cm15.Account, error)
+	// AccountsCalls records the arguments of the calls made to Accounts.
+	AccountsCalls []*SessionAccountsCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func() ([]*cm15.Session, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*SessionIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(func(*cm15.Session) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*SessionIndexEachCall
+
+	// IndexInstanceSessionStub is called by IndexInstanceSession if not nil.
+	IndexInstanceSessionStub func() (*cm15.Instance, error)
+	// IndexInstanceSessionCalls records the arguments of the calls made to IndexInstanceSession.
+	IndexInstanceSessionCalls []*SessionIndexInstanceSessionCall
+}
+
+var _ cm15.SessionLocatorInterface = (*SessionLocator)(nil)
+
+// SessionAccountsCall records the arguments of a call to SessionLocator.Accounts.
+type SessionAccountsCall struct {
+	Options *cm15.SessionAccountsOptions
+}
+
+// Accounts records the call and returns the results of AccountsStub if set.
+func (fake *SessionLocator) Accounts(options *cm15.SessionAccountsOptions) ([]*cm15.Account, error) {
+	fake.mu.Lock()
+	fake.AccountsCalls = append(fake.AccountsCalls, &SessionAccountsCall{Options: options})
+	stub := fake.AccountsStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Account
+	return res, nil
+}
+
+// AccountsReturns sets AccountsStub to a function that returns the given values.
+func (fake *SessionLocator) AccountsReturns(res []*cm15.Account, err error) {
+	fake.mu.Lock()
+	fake.AccountsStub = func(*cm15.SessionAccountsOptions) ([]*cm15.Account, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// SessionIndexCall records the arguments of a call to SessionLocator.Index.
+type SessionIndexCall struct{}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *SessionLocator) Index() ([]*cm15.Session, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &SessionIndexCall{})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res []*cm15.Session
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *SessionLocator) IndexReturns(res []*cm15.Session, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func() ([]*cm15.Session, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// SessionIndexEachCall records the arguments of a call to SessionLocator.IndexEach.
+type SessionIndexEachCall struct{}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *SessionLocator) IndexEach(fn func(*cm15.Session) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &SessionIndexEachCall{})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each()
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *SessionLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(func(*cm15.Session) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SessionIndexInstanceSessionCall records the arguments of a call to SessionLocator.IndexInstanceSession.
+type SessionIndexInstanceSessionCall struct{}
+
+// IndexInstanceSession records the call and returns the results of IndexInstanceSessionStub if set.
+func (fake *SessionLocator) IndexInstanceSession() (*cm15.Instance, error) {
+	fake.mu.Lock()
+	fake.IndexInstanceSessionCalls = append(fake.IndexInstanceSessionCalls, &SessionIndexInstanceSessionCall{})
+	stub := fake.IndexInstanceSessionStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.Instance
+	return res, nil
+}
+
+// IndexInstanceSessionReturns sets IndexInstanceSessionStub to a function that returns the given values.
+func (fake *SessionLocator) IndexInstanceSessionReturns(res *cm15.Instance, err error) {
+	fake.mu.Lock()
+	fake.IndexInstanceSessionStub = func() (*cm15.Instance, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  SshKey ******/
+
+// SshKeyLocator is a fake implementation of cm15.SshKeyLocatorInterface.
+type SshKeyLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.SshKeyParam) (*cm15.SshKeyLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*SshKeyCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*SshKeyDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.SshKeyIndexOptions) ([]*cm15.SshKey, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*SshKeyIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.SshKeyIndexOptions, func(*cm15.SshKey) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*SshKeyIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.SshKeyShowOptions) (*cm15.SshKey, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*SshKeyShowCall
+}
+
+var _ cm15.SshKeyLocatorInterface = (*SshKeyLocator)(nil)
+
+// SshKeyCreateCall records the arguments of a call to SshKeyLocator.Create.
+type SshKeyCreateCall struct {
+	SshKey *cm15.SshKeyParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *SshKeyLocator) Create(sshKey *cm15.SshKeyParam) (*cm15.SshKeyLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &SshKeyCreateCall{SshKey: sshKey})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(sshKey)
+	}
+	var res *cm15.SshKeyLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *SshKeyLocator) CreateReturns(res *cm15.SshKeyLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.SshKeyParam) (*cm15.SshKeyLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// SshKeyDestroyCall records the arguments of a call to SshKeyLocator.Destroy.
+type SshKeyDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *SshKeyLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &SshKeyDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *SshKeyLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SshKeyIndexCall records the arguments of a call to SshKeyLocator.Index.
+type SshKeyIndexCall struct {
+	Options *cm15.SshKeyIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *SshKeyLocator) Index(options *cm15.SshKeyIndexOptions) ([]*cm15.SshKey, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &SshKeyIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.SshKey
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *SshKeyLocator) IndexReturns(res []*cm15.SshKey, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.SshKeyIndexOptions) ([]*cm15.SshKey, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// SshKeyIndexEachCall records the arguments of a call to SshKeyLocator.IndexEach.
+type SshKeyIndexEachCall struct {
+	Options *cm15.SshKeyIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *SshKeyLocator) IndexEach(options *cm15.SshKeyIndexOptions, fn func(*cm15.SshKey) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &SshKeyIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *SshKeyLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.SshKeyIndexOptions, func(*cm15.SshKey) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SshKeyShowCall records the arguments of a call to SshKeyLocator.Show.
+type SshKeyShowCall struct {
+	Options *cm15.SshKeyShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *SshKeyLocator) Show(options *cm15.SshKeyShowOptions) (*cm15.SshKey, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &SshKeyShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.SshKey
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *SshKeyLocator) ShowReturns(res *cm15.SshKey, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.SshKeyShowOptions) (*cm15.SshKey, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Subnet ******/
+
+// SubnetLocator is a fake implementation of cm15.SubnetLocatorInterface.
+type SubnetLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.SubnetParam) (*cm15.SubnetLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*SubnetCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*SubnetDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.SubnetIndexOptions) ([]*cm15.Subnet, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*SubnetIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.SubnetIndexOptions, func(*cm15.Subnet) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*SubnetIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.Subnet, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*SubnetShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.SubnetParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*SubnetUpdateCall
+}
+
+var _ cm15.SubnetLocatorInterface = (*SubnetLocator)(nil)
+
+// SubnetCreateCall records the arguments of a call to SubnetLocator.Create.
+type SubnetCreateCall struct {
+	Subnet *cm15.SubnetParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *SubnetLocator) Create(subnet *cm15.SubnetParam) (*cm15.SubnetLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &SubnetCreateCall{Subnet: subnet})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(subnet)
+	}
+	var res *cm15.SubnetLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *SubnetLocator) CreateReturns(res *cm15.SubnetLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.SubnetParam) (*cm15.SubnetLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// SubnetDestroyCall records the arguments of a call to SubnetLocator.Destroy.
+type SubnetDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *SubnetLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &SubnetDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *SubnetLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SubnetIndexCall records the arguments of a call to SubnetLocator.Index.
+type SubnetIndexCall struct {
+	Options *cm15.SubnetIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *SubnetLocator) Index(options *cm15.SubnetIndexOptions) ([]*cm15.Subnet, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &SubnetIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Subnet
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *SubnetLocator) IndexReturns(res []*cm15.Subnet, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.SubnetIndexOptions) ([]*cm15.Subnet, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// SubnetIndexEachCall records the arguments of a call to SubnetLocator.IndexEach.
+type SubnetIndexEachCall struct {
+	Options *cm15.SubnetIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *SubnetLocator) IndexEach(options *cm15.SubnetIndexOptions, fn func(*cm15.Subnet) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &SubnetIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *SubnetLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.SubnetIndexOptions, func(*cm15.Subnet) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SubnetShowCall records the arguments of a call to SubnetLocator.Show.
+type SubnetShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *SubnetLocator) Show() (*cm15.Subnet, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &SubnetShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.Subnet
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *SubnetLocator) ShowReturns(res *cm15.Subnet, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.Subnet, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// SubnetUpdateCall records the arguments of a call to SubnetLocator.Update.
+type SubnetUpdateCall struct {
+	Subnet *cm15.SubnetParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *SubnetLocator) Update(subnet *cm15.SubnetParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &SubnetUpdateCall{Subnet: subnet})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(subnet)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *SubnetLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.SubnetParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Tag ******/
+
+// TagLocator is a fake implementation of cm15.TagLocatorInterface.
+type TagLocator struct {
+	mu sync.Mutex
+
+	// ByResourceStub is called by ByResource if not nil.
+	ByResourceStub func([]string) ([]map[string]interface{}, error)
+	// ByResourceCalls records the arguments of the calls made to ByResource.
+	ByResourceCalls []*TagByResourceCall
+
+	// ByTagStub is called by ByTag if not nil.
+	ByTagStub func(string, []string, *cm15.TagByTagOptions) ([]map[string]interface{}, error)
+	// ByTagCalls records the arguments of the calls made to ByTag.
+	ByTagCalls []*TagByTagCall
+
+	// MultiAddStub is called by MultiAdd if not nil.
+	MultiAddStub func([]string, []string) error
+	// MultiAddCalls records the arguments of the calls made to MultiAdd.
+	MultiAddCalls []*TagMultiAddCall
+
+	// MultiDeleteStub is called by MultiDelete if not nil.
+	MultiDeleteStub func([]string, []string) error
+	// MultiDeleteCalls records the arguments of the calls made to MultiDelete.
+	MultiDeleteCalls []*TagMultiDeleteCall
+}
+
+var _ cm15.TagLocatorInterface = (*TagLocator)(nil)
+
+// TagByResourceCall records the arguments of a call to TagLocator.ByResource.
+type TagByResourceCall struct {
+	ResourceHrefs []string
+}
+
+// ByResource records the call and returns the results of ByResourceStub if set.
+func (fake *TagLocator) ByResource(resourceHrefs []string) ([]map[string]interface{}, error) {
+	fake.mu.Lock()
+	fake.ByResourceCalls = append(fake.ByResourceCalls, &TagByResourceCall{ResourceHrefs: resourceHrefs})
+	stub := fake.ByResourceStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(resourceHrefs)
+	}
+	var res []map[string]interface{}
+	return res, nil
+}
+
+// ByResourceReturns sets ByResourceStub to a function that returns the given values.
+func (fake *TagLocator) ByResourceReturns(res []map[string]interface{}, err error) {
+	fake.mu.Lock()
+	fake.ByResourceStub = func([]string) ([]map[string]interface{}, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// TagByTagCall records the arguments of a call to TagLocator.ByTag.
+type TagByTagCall struct {
+	ResourceType string
+	Tags         []string
+	Options      *cm15.TagByTagOptions
+}
+
+// ByTag records the call and returns the results of ByTagStub if set.
+func (fake *TagLocator) ByTag(resourceType string, tags []string, options *cm15.TagByTagOptions) ([]map[string]interface{}, error) {
+	fake.mu.Lock()
+	fake.ByTagCalls = append(fake.ByTagCalls, &TagByTagCall{ResourceType: resourceType, Tags: tags, Options: options})
+	stub := fake.ByTagStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(resourceType, tags, options)
+	}
+	var res []map[string]interface{}
+	return res, nil
+}
+
+// ByTagReturns sets ByTagStub to a function that returns the given values.
+func (fake *TagLocator) ByTagReturns(res []map[string]interface{}, err error) {
+	fake.mu.Lock()
+	fake.ByTagStub = func(string, []string, *cm15.TagByTagOptions) ([]map[string]interface{}, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// TagMultiAddCall records the arguments of a call to TagLocator.MultiAdd.
+type TagMultiAddCall struct {
+	ResourceHrefs []string
+	Tags          []string
+}
+
+// MultiAdd records the call and returns the results of MultiAddStub if set.
+func (fake *TagLocator) MultiAdd(resourceHrefs []string, tags []string) error {
+	fake.mu.Lock()
+	fake.MultiAddCalls = append(fake.MultiAddCalls, &TagMultiAddCall{ResourceHrefs: resourceHrefs, Tags: tags})
+	stub := fake.MultiAddStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(resourceHrefs, tags)
+	}
+	return nil
+}
+
+// MultiAddReturns sets MultiAddStub to a function that returns the given values.
+func (fake *TagLocator) MultiAddReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiAddStub = func([]string, []string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// TagMultiDeleteCall records the arguments of a call to TagLocator.MultiDelete.
+type TagMultiDeleteCall struct {
+	ResourceHrefs []string
+	Tags          []string
+}
+
+// MultiDelete records the call and returns the results of MultiDeleteStub if set.
+func (fake *TagLocator) MultiDelete(resourceHrefs []string, tags []string) error {
+	fake.mu.Lock()
+	fake.MultiDeleteCalls = append(fake.MultiDeleteCalls, &TagMultiDeleteCall{ResourceHrefs: resourceHrefs, Tags: tags})
+	stub := fake.MultiDeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(resourceHrefs, tags)
+	}
+	return nil
+}
+
+// MultiDeleteReturns sets MultiDeleteStub to a function that returns the given values.
+func (fake *TagLocator) MultiDeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiDeleteStub = func([]string, []string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Task ******/
+
+// TaskLocator is a fake implementation of cm15.TaskLocatorInterface.
+type TaskLocator struct {
+	mu sync.Mutex
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.TaskShowOptions) (*cm15.Task, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*TaskShowCall
+}
+
+var _ cm15.TaskLocatorInterface = (*TaskLocator)(nil)
+
+// TaskShowCall records the arguments of a call to TaskLocator.Show.
+type TaskShowCall struct {
+	Options *cm15.TaskShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *TaskLocator) Show(options *cm15.TaskShowOptions) (*cm15.Task, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &TaskShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Task
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *TaskLocator) ShowReturns(res *cm15.Task, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.TaskShowOptions) (*cm15.Task, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  User ******/
+
+// UserLocator is a fake implementation of cm15.UserLocatorInterface.
+type UserLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.UserParam) (*cm15.UserLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*UserCreateCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.UserIndexOptions) ([]*cm15.User, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*UserIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.UserIndexOptions, func(*cm15.User) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*UserIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*cm15.User, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*UserShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.UserParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*UserUpdateCall
+}
+
+var _ cm15.UserLocatorInterface = (*UserLocator)(nil)
+
+// UserCreateCall records the arguments of a call to UserLocator.Create.
+type UserCreateCall struct {
+	User *cm15.UserParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *UserLocator) Create(user *cm15.UserParam) (*cm15.UserLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &UserCreateCall{User: user})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(user)
+	}
+	var res *cm15.UserLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *UserLocator) CreateReturns(res *cm15.UserLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.UserParam) (*cm15.UserLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UserIndexCall records the arguments of a call to UserLocator.Index.
+type UserIndexCall struct {
+	Options *cm15.UserIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *UserLocator) Index(options *cm15.UserIndexOptions) ([]*cm15.User, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &UserIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.User
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *UserLocator) IndexReturns(res []*cm15.User, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.UserIndexOptions) ([]*cm15.User, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UserIndexEachCall records the arguments of a call to UserLocator.IndexEach.
+type UserIndexEachCall struct {
+	Options *cm15.UserIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *UserLocator) IndexEach(options *cm15.UserIndexOptions, fn func(*cm15.User) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &UserIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *UserLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.UserIndexOptions, func(*cm15.User) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// UserShowCall records the arguments of a call to UserLocator.Show.
+type UserShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *UserLocator) Show() (*cm15.User, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &UserShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *cm15.User
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *UserLocator) ShowReturns(res *cm15.User, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*cm15.User, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UserUpdateCall records the arguments of a call to UserLocator.Update.
+type UserUpdateCall struct {
+	User *cm15.UserParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *UserLocator) Update(user *cm15.UserParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &UserUpdateCall{User: user})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(user)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *UserLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.UserParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  UserData ******/
+
+// UserDataLocator is a fake implementation of cm15.UserDataLocatorInterface.
+type UserDataLocator struct {
+	mu sync.Mutex
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (map[string]interface{}, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*UserDataShowCall
+}
+
+var _ cm15.UserDataLocatorInterface = (*UserDataLocator)(nil)
+
+// UserDataShowCall records the arguments of a call to UserDataLocator.Show.
+type UserDataShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *UserDataLocator) Show() (map[string]interface{}, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &UserDataShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res map[string]interface{}
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *UserDataLocator) ShowReturns(res map[string]interface{}, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (map[string]interface{}, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Volume ******/
+
+// VolumeLocator is a fake implementation of cm15.VolumeLocatorInterface.
+type VolumeLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.VolumeParam) (*cm15.VolumeLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*VolumeCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*VolumeDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.VolumeIndexOptions) ([]*cm15.Volume, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*VolumeIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.VolumeIndexOptions, func(*cm15.Volume) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*VolumeIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.VolumeShowOptions) (*cm15.Volume, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*VolumeShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*cm15.VolumeParam2) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*VolumeUpdateCall
+}
+
+var _ cm15.VolumeLocatorInterface = (*VolumeLocator)(nil)
+
+// VolumeCreateCall records the arguments of a call to VolumeLocator.Create.
+type VolumeCreateCall struct {
+	Volume *cm15.VolumeParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *VolumeLocator) Create(volume *cm15.VolumeParam) (*cm15.VolumeLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &VolumeCreateCall{Volume: volume})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(volume)
+	}
+	var res *cm15.VolumeLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *VolumeLocator) CreateReturns(res *cm15.VolumeLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.VolumeParam) (*cm15.VolumeLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeDestroyCall records the arguments of a call to VolumeLocator.Destroy.
+type VolumeDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *VolumeLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &VolumeDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *VolumeLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeIndexCall records the arguments of a call to VolumeLocator.Index.
+type VolumeIndexCall struct {
+	Options *cm15.VolumeIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *VolumeLocator) Index(options *cm15.VolumeIndexOptions) ([]*cm15.Volume, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &VolumeIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.Volume
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *VolumeLocator) IndexReturns(res []*cm15.Volume, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.VolumeIndexOptions) ([]*cm15.Volume, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeIndexEachCall records the arguments of a call to VolumeLocator.IndexEach.
+type VolumeIndexEachCall struct {
+	Options *cm15.VolumeIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *VolumeLocator) IndexEach(options *cm15.VolumeIndexOptions, fn func(*cm15.Volume) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &VolumeIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *VolumeLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.VolumeIndexOptions, func(*cm15.Volume) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeShowCall records the arguments of a call to VolumeLocator.Show.
+type VolumeShowCall struct {
+	Options *cm15.VolumeShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *VolumeLocator) Show(options *cm15.VolumeShowOptions) (*cm15.Volume, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &VolumeShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.Volume
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *VolumeLocator) ShowReturns(res *cm15.Volume, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.VolumeShowOptions) (*cm15.Volume, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeUpdateCall records the arguments of a call to VolumeLocator.Update.
+type VolumeUpdateCall struct {
+	Volume *cm15.VolumeParam2
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *VolumeLocator) Update(volume *cm15.VolumeParam2) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &VolumeUpdateCall{Volume: volume})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(volume)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *VolumeLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*cm15.VolumeParam2) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  VolumeAttachment ******/
+
+// VolumeAttachmentLocator is a fake implementation of cm15.VolumeAttachmentLocatorInterface.
+type VolumeAttachmentLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.VolumeAttachmentParam) (*cm15.VolumeAttachmentLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*VolumeAttachmentCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func(*cm15.VolumeAttachmentDestroyOptions) error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*VolumeAttachmentDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.VolumeAttachmentIndexOptions) ([]*cm15.VolumeAttachment, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*VolumeAttachmentIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.VolumeAttachmentIndexOptions, func(*cm15.VolumeAttachment) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*VolumeAttachmentIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.VolumeAttachmentShowOptions) (*cm15.VolumeAttachment, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*VolumeAttachmentShowCall
+}
+
+var _ cm15.VolumeAttachmentLocatorInterface = (*VolumeAttachmentLocator)(nil)
+
+// VolumeAttachmentCreateCall records the arguments of a call to VolumeAttachmentLocator.Create.
+type VolumeAttachmentCreateCall struct {
+	VolumeAttachment *cm15.VolumeAttachmentParam
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *VolumeAttachmentLocator) Create(volumeAttachment *cm15.VolumeAttachmentParam) (*cm15.VolumeAttachmentLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &VolumeAttachmentCreateCall{VolumeAttachment: volumeAttachment})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(volumeAttachment)
+	}
+	var res *cm15.VolumeAttachmentLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *VolumeAttachmentLocator) CreateReturns(res *cm15.VolumeAttachmentLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.VolumeAttachmentParam) (*cm15.VolumeAttachmentLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeAttachmentDestroyCall records the arguments of a call to VolumeAttachmentLocator.Destroy.
+type VolumeAttachmentDestroyCall struct {
+	Options *cm15.VolumeAttachmentDestroyOptions
+}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *VolumeAttachmentLocator) Destroy(options *cm15.VolumeAttachmentDestroyOptions) error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &VolumeAttachmentDestroyCall{Options: options})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *VolumeAttachmentLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func(*cm15.VolumeAttachmentDestroyOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeAttachmentIndexCall records the arguments of a call to VolumeAttachmentLocator.Index.
+type VolumeAttachmentIndexCall struct {
+	Options *cm15.VolumeAttachmentIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *VolumeAttachmentLocator) Index(options *cm15.VolumeAttachmentIndexOptions) ([]*cm15.VolumeAttachment, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &VolumeAttachmentIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.VolumeAttachment
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *VolumeAttachmentLocator) IndexReturns(res []*cm15.VolumeAttachment, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.VolumeAttachmentIndexOptions) ([]*cm15.VolumeAttachment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeAttachmentIndexEachCall records the arguments of a call to VolumeAttachmentLocator.IndexEach.
+type VolumeAttachmentIndexEachCall struct {
+	Options *cm15.VolumeAttachmentIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *VolumeAttachmentLocator) IndexEach(options *cm15.VolumeAttachmentIndexOptions, fn func(*cm15.VolumeAttachment) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &VolumeAttachmentIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *VolumeAttachmentLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.VolumeAttachmentIndexOptions, func(*cm15.VolumeAttachment) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeAttachmentShowCall records the arguments of a call to VolumeAttachmentLocator.Show.
+type VolumeAttachmentShowCall struct {
+	Options *cm15.VolumeAttachmentShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *VolumeAttachmentLocator) Show(options *cm15.VolumeAttachmentShowOptions) (*cm15.VolumeAttachment, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &VolumeAttachmentShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.VolumeAttachment
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *VolumeAttachmentLocator) ShowReturns(res *cm15.VolumeAttachment, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.VolumeAttachmentShowOptions) (*cm15.VolumeAttachment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  VolumeSnapshot ******/
+
+// VolumeSnapshotLocator is a fake implementation of cm15.VolumeSnapshotLocatorInterface.
+type VolumeSnapshotLocator struct {
+	mu sync.Mutex
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*cm15.VolumeSnapshotCreateOptions) (*cm15.VolumeSnapshotLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*VolumeSnapshotCreateCall
+
+	// DestroyStub is called by Destroy if not nil.
+	DestroyStub func() error
+	// DestroyCalls records the arguments of the calls made to Destroy.
+	DestroyCalls []*VolumeSnapshotDestroyCall
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.VolumeSnapshotIndexOptions) ([]*cm15.VolumeSnapshot, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*VolumeSnapshotIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.VolumeSnapshotIndexOptions, func(*cm15.VolumeSnapshot) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*VolumeSnapshotIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.VolumeSnapshotShowOptions) (*cm15.VolumeSnapshot, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*VolumeSnapshotShowCall
+}
+
+var _ cm15.VolumeSnapshotLocatorInterface = (*VolumeSnapshotLocator)(nil)
+
+// VolumeSnapshotCreateCall records the arguments of a call to VolumeSnapshotLocator.Create.
+type VolumeSnapshotCreateCall struct {
+	Options *cm15.VolumeSnapshotCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *VolumeSnapshotLocator) Create(options *cm15.VolumeSnapshotCreateOptions) (*cm15.VolumeSnapshotLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &VolumeSnapshotCreateCall{Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.VolumeSnapshotLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *VolumeSnapshotLocator) CreateReturns(res *cm15.VolumeSnapshotLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*cm15.VolumeSnapshotCreateOptions) (*cm15.VolumeSnapshotLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeSnapshotDestroyCall records the arguments of a call to VolumeSnapshotLocator.Destroy.
+type VolumeSnapshotDestroyCall struct{}
+
+// Destroy records the call and returns the results of DestroyStub if set.
+func (fake *VolumeSnapshotLocator) Destroy() error {
+	fake.mu.Lock()
+	fake.DestroyCalls = append(fake.DestroyCalls, &VolumeSnapshotDestroyCall{})
+	stub := fake.DestroyStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DestroyReturns sets DestroyStub to a function that returns the given values.
+func (fake *VolumeSnapshotLocator) DestroyReturns(err error) {
+	fake.mu.Lock()
+	fake.DestroyStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeSnapshotIndexCall records the arguments of a call to VolumeSnapshotLocator.Index.
+type VolumeSnapshotIndexCall struct {
+	Options *cm15.VolumeSnapshotIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *VolumeSnapshotLocator) Index(options *cm15.VolumeSnapshotIndexOptions) ([]*cm15.VolumeSnapshot, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &VolumeSnapshotIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.VolumeSnapshot
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *VolumeSnapshotLocator) IndexReturns(res []*cm15.VolumeSnapshot, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.VolumeSnapshotIndexOptions) ([]*cm15.VolumeSnapshot, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeSnapshotIndexEachCall records the arguments of a call to VolumeSnapshotLocator.IndexEach.
+type VolumeSnapshotIndexEachCall struct {
+	Options *cm15.VolumeSnapshotIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *VolumeSnapshotLocator) IndexEach(options *cm15.VolumeSnapshotIndexOptions, fn func(*cm15.VolumeSnapshot) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &VolumeSnapshotIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *VolumeSnapshotLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.VolumeSnapshotIndexOptions, func(*cm15.VolumeSnapshot) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeSnapshotShowCall records the arguments of a call to VolumeSnapshotLocator.Show.
+type VolumeSnapshotShowCall struct {
+	Options *cm15.VolumeSnapshotShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *VolumeSnapshotLocator) Show(options *cm15.VolumeSnapshotShowOptions) (*cm15.VolumeSnapshot, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &VolumeSnapshotShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.VolumeSnapshot
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *VolumeSnapshotLocator) ShowReturns(res *cm15.VolumeSnapshot, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.VolumeSnapshotShowOptions) (*cm15.VolumeSnapshot, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  VolumeType ******/
+
+// VolumeTypeLocator is a fake implementation of cm15.VolumeTypeLocatorInterface.
+type VolumeTypeLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm15.VolumeTypeIndexOptions) ([]*cm15.VolumeType, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*VolumeTypeIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*cm15.VolumeTypeIndexOptions, func(*cm15.VolumeType) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*VolumeTypeIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm15.VolumeTypeShowOptions) (*cm15.VolumeType, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*VolumeTypeShowCall
+}
+
+var _ cm15.VolumeTypeLocatorInterface = (*VolumeTypeLocator)(nil)
+
+// VolumeTypeIndexCall records the arguments of a call to VolumeTypeLocator.Index.
+type VolumeTypeIndexCall struct {
+	Options *cm15.VolumeTypeIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *VolumeTypeLocator) Index(options *cm15.VolumeTypeIndexOptions) ([]*cm15.VolumeType, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &VolumeTypeIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*cm15.VolumeType
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *VolumeTypeLocator) IndexReturns(res []*cm15.VolumeType, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm15.VolumeTypeIndexOptions) ([]*cm15.VolumeType, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeTypeIndexEachCall records the arguments of a call to VolumeTypeLocator.IndexEach.
+type VolumeTypeIndexEachCall struct {
+	Options *cm15.VolumeTypeIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *VolumeTypeLocator) IndexEach(options *cm15.VolumeTypeIndexOptions, fn func(*cm15.VolumeType) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &VolumeTypeIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *VolumeTypeLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*cm15.VolumeTypeIndexOptions, func(*cm15.VolumeType) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// VolumeTypeShowCall records the arguments of a call to VolumeTypeLocator.Show.
+type VolumeTypeShowCall struct {
+	Options *cm15.VolumeTypeShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *VolumeTypeLocator) Show(options *cm15.VolumeTypeShowOptions) (*cm15.VolumeType, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &VolumeTypeShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm15.VolumeType
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *VolumeTypeLocator) ShowReturns(res *cm15.VolumeType, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm15.VolumeTypeShowOptions) (*cm15.VolumeType, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
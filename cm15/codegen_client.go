@@ -12,6 +12,7 @@ package cm15
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	"github.com/rightscale/rsc/metadata"
@@ -57,12 +58,12 @@ func (r *Href) ActionPath(rName, aName string) (*metadata.ActionPath, error) {
 /******  Account ******/
 
 type Account struct {
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Links       []map[string]string `json:"links,omitempty"`
 	Name        string              `json:"name,omitempty"`
 	Permissions []Permission        `json:"permissions,omitempty"`
 	Products    []string            `json:"products,omitempty"`
-	UpdatedAt   *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt   *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -88,6 +89,12 @@ func (api *API) AccountLocator(href string) *AccountLocator {
 	return &AccountLocator{Href(href), api}
 }
 
+// AccountLocatorInterface lists the AccountLocator methods, code that depends on the interface
+// rather than on AccountLocator can be unit tested using the generated fake locators.
+type AccountLocatorInterface interface {
+	Show() (*Account, error)
+}
+
 //===== Actions
 
 // GET /api/accounts/:id
@@ -97,6 +104,9 @@ func (loc *AccountLocator) Show() (*Account, error) {
 	var res *Account
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Account", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Account", "show")
 	if err != nil {
 		return res, err
@@ -109,21 +119,11 @@ func (loc *AccountLocator) Show() (*Account, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -132,11 +132,11 @@ func (loc *AccountLocator) Show() (*Account, error) {
 // An Account Group specifies which RightScale accounts will have access to import a shared RightScale component (e.g. ServerTemplate, RightScript, etc.) from the MultiCloud Marketplace.
 type AccountGroup struct {
 	Actions     []map[string]string `json:"actions,omitempty"`
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Description string              `json:"description,omitempty"`
 	Links       []map[string]string `json:"links,omitempty"`
 	Name        string              `json:"name,omitempty"`
-	UpdatedAt   *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt   *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -149,6 +149,17 @@ func (r *AccountGroup) Locator(api *API) *AccountGroupLocator {
 	return nil
 }
 
+// AccountLocator returns a locator for the resource pointed to by the "account" link.
+// It returns nil if the link is absent.
+func (r *AccountGroup) AccountLocator(api *API) *AccountLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "account" {
+			return api.AccountLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // AccountGroupLocator exposes the AccountGroup resource actions.
@@ -162,27 +173,74 @@ func (api *API) AccountGroupLocator(href string) *AccountGroupLocator {
 	return &AccountGroupLocator{Href(href), api}
 }
 
+// AccountGroupLocatorInterface lists the AccountGroupLocator methods, code that depends on the interface
+// rather than on AccountGroupLocator can be unit tested using the generated fake locators.
+type AccountGroupLocatorInterface interface {
+	Index(options *AccountGroupIndexOptions) ([]*AccountGroup, error)
+	IndexEach(options *AccountGroupIndexOptions, fn func(*AccountGroup) error) error
+	Show(options *AccountGroupShowOptions) (*AccountGroup, error)
+}
+
 //===== Actions
 
+// AccountGroupIndexOptions contains the optional parameters of AccountGroupLocator.Index.
+type AccountGroupIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AccountGroupIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AccountGroupLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/account_groups
 //
 // Lists the AccountGroups owned by this Account.
 // Optional parameters:
 // filter
 // view
-func (loc *AccountGroupLocator) Index(options rsapi.APIParams) ([]*AccountGroup, error) {
+func (loc *AccountGroupLocator) Index(options *AccountGroupIndexOptions) ([]*AccountGroup, error) {
 	var res []*AccountGroup
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AccountGroup", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AccountGroup", "index")
 	if err != nil {
 		return res, err
@@ -195,22 +253,87 @@ func (loc *AccountGroupLocator) Index(options rsapi.APIParams) ([]*AccountGroup,
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *AccountGroupLocator) IndexEach(options *AccountGroupIndexOptions, fn func(*AccountGroup) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AccountGroup", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("AccountGroup", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *AccountGroup
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// AccountGroupShowOptions contains the optional parameters of AccountGroupLocator.Show.
+type AccountGroupShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AccountGroupShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AccountGroupLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/account_groups/:id
@@ -218,15 +341,22 @@ func (loc *AccountGroupLocator) Index(options rsapi.APIParams) ([]*AccountGroup,
 // Show information about a single AccountGroup.
 // Optional parameters:
 // view
-func (loc *AccountGroupLocator) Show(options rsapi.APIParams) (*AccountGroup, error) {
+func (loc *AccountGroupLocator) Show(options *AccountGroupShowOptions) (*AccountGroup, error) {
 	var res *AccountGroup
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AccountGroup", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AccountGroup", "show")
 	if err != nil {
 		return res, err
@@ -239,21 +369,11 @@ func (loc *AccountGroupLocator) Show(options rsapi.APIParams) (*AccountGroup, er
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -262,12 +382,12 @@ func (loc *AccountGroupLocator) Show(options rsapi.APIParams) (*AccountGroup, er
 // An Alert represents an AlertSpec bound to a running Instance.
 type Alert struct {
 	Actions       []map[string]string `json:"actions,omitempty"`
-	CreatedAt     *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt     *rsapi.Time         `json:"created_at,omitempty"`
 	Links         []map[string]string `json:"links,omitempty"`
-	QuenchedUntil *RubyTime           `json:"quenched_until,omitempty"`
+	QuenchedUntil *rsapi.Time         `json:"quenched_until,omitempty"`
 	Status        string              `json:"status,omitempty"`
-	TriggeredAt   *RubyTime           `json:"triggered_at,omitempty"`
-	UpdatedAt     *RubyTime           `json:"updated_at,omitempty"`
+	TriggeredAt   *rsapi.Time         `json:"triggered_at,omitempty"`
+	UpdatedAt     *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -280,6 +400,28 @@ func (r *Alert) Locator(api *API) *AlertLocator {
 	return nil
 }
 
+// AlertSpecLocator returns a locator for the resource pointed to by the "alert_spec" link.
+// It returns nil if the link is absent.
+func (r *Alert) AlertSpecLocator(api *API) *AlertSpecLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "alert_spec" {
+			return api.AlertSpecLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// InstanceLocator returns a locator for the resource pointed to by the "instance" link.
+// It returns nil if the link is absent.
+func (r *Alert) InstanceLocator(api *API) *InstanceLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "instance" {
+			return api.InstanceLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // AlertLocator exposes the Alert resource actions.
@@ -293,6 +435,17 @@ func (api *API) AlertLocator(href string) *AlertLocator {
 	return &AlertLocator{Href(href), api}
 }
 
+// AlertLocatorInterface lists the AlertLocator methods, code that depends on the interface
+// rather than on AlertLocator can be unit tested using the generated fake locators.
+type AlertLocatorInterface interface {
+	Disable() error
+	Enable() error
+	Index(options *AlertIndexOptions) ([]*Alert, error)
+	IndexEach(options *AlertIndexOptions, fn func(*Alert) error) error
+	Quench(duration string) error
+	Show(options *AlertShowOptions) (*Alert, error)
+}
+
 //===== Actions
 
 // POST /api/clouds/:cloud_id/instances/:instance_id/alerts/:id/disable
@@ -305,6 +458,9 @@ func (api *API) AlertLocator(href string) *AlertLocator {
 func (loc *AlertLocator) Disable() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Alert", "disable", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Alert", "disable")
 	if err != nil {
 		return err
@@ -317,15 +473,10 @@ func (loc *AlertLocator) Disable() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -339,6 +490,9 @@ func (loc *AlertLocator) Disable() error {
 func (loc *AlertLocator) Enable() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Alert", "enable", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Alert", "enable")
 	if err != nil {
 		return err
@@ -351,18 +505,45 @@ func (loc *AlertLocator) Enable() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// AlertIndexOptions contains the optional parameters of AlertLocator.Index.
+type AlertIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AlertIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AlertLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/instances/:instance_id/alerts
 // GET /api/servers/:server_id/alerts
 // GET /api/server_arrays/:server_array_id/alerts
@@ -373,19 +554,26 @@ func (loc *AlertLocator) Enable() error {
 // Optional parameters:
 // filter
 // view
-func (loc *AlertLocator) Index(options rsapi.APIParams) ([]*Alert, error) {
+func (loc *AlertLocator) Index(options *AlertIndexOptions) ([]*Alert, error) {
 	var res []*Alert
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Alert", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Alert", "index")
 	if err != nil {
 		return res, err
@@ -398,22 +586,59 @@ func (loc *AlertLocator) Index(options rsapi.APIParams) ([]*Alert, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *AlertLocator) IndexEach(options *AlertIndexOptions, fn func(*Alert) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Alert", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Alert", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Alert
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // POST /api/clouds/:cloud_id/instances/:instance_id/alerts/:id/quench
@@ -434,6 +659,9 @@ func (loc *AlertLocator) Quench(duration string) error {
 	p = rsapi.APIParams{
 		"duration": duration,
 	}
+	if err := loc.api.ValidateParams("Alert", "quench", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Alert", "quench")
 	if err != nil {
 		return err
@@ -446,18 +674,41 @@ func (loc *AlertLocator) Quench(duration string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// AlertShowOptions contains the optional parameters of AlertLocator.Show.
+type AlertShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AlertShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AlertLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/instances/:instance_id/alerts/:id
 // GET /api/servers/:server_id/alerts/:id
 // GET /api/server_arrays/:server_array_id/alerts/:id
@@ -467,15 +718,22 @@ func (loc *AlertLocator) Quench(duration string) error {
 // Shows the attributes of a specified Alert.
 // Optional parameters:
 // view
-func (loc *AlertLocator) Show(options rsapi.APIParams) (*Alert, error) {
+func (loc *AlertLocator) Show(options *AlertShowOptions) (*Alert, error) {
 	var res *Alert
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Alert", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Alert", "show")
 	if err != nil {
 		return res, err
@@ -488,21 +746,11 @@ func (loc *AlertLocator) Show(options rsapi.APIParams) (*Alert, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -513,7 +761,7 @@ func (loc *AlertLocator) Show(options rsapi.APIParams) (*Alert, error) {
 type AlertSpec struct {
 	Actions        []map[string]string `json:"actions,omitempty"`
 	Condition      string              `json:"condition,omitempty"`
-	CreatedAt      *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt      *rsapi.Time         `json:"created_at,omitempty"`
 	Description    string              `json:"description,omitempty"`
 	Duration       int                 `json:"duration,omitempty"`
 	EscalationName string              `json:"escalation_name,omitempty"`
@@ -521,7 +769,7 @@ type AlertSpec struct {
 	Links          []map[string]string `json:"links,omitempty"`
 	Name           string              `json:"name,omitempty"`
 	Threshold      string              `json:"threshold,omitempty"`
-	UpdatedAt      *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt      *rsapi.Time         `json:"updated_at,omitempty"`
 	Variable       string              `json:"variable,omitempty"`
 	VoteTag        string              `json:"vote_tag,omitempty"`
 	VoteType       string              `json:"vote_type,omitempty"`
@@ -550,6 +798,17 @@ func (api *API) AlertSpecLocator(href string) *AlertSpecLocator {
 	return &AlertSpecLocator{Href(href), api}
 }
 
+// AlertSpecLocatorInterface lists the AlertSpecLocator methods, code that depends on the interface
+// rather than on AlertSpecLocator can be unit tested using the generated fake locators.
+type AlertSpecLocatorInterface interface {
+	Create(alertSpec *AlertSpecParam) (*AlertSpecLocator, error)
+	Destroy() error
+	Index(options *AlertSpecIndexOptions) ([]*AlertSpec, error)
+	IndexEach(options *AlertSpecIndexOptions, fn func(*AlertSpec) error) error
+	Show(options *AlertSpecShowOptions) (*AlertSpec, error)
+	Update(alertSpec *AlertSpecParam2) error
+}
+
 //===== Actions
 
 // POST /api/servers/:server_id/alert_specs
@@ -570,6 +829,9 @@ func (loc *AlertSpecLocator) Create(alertSpec *AlertSpecParam) (*AlertSpecLocato
 	p = rsapi.APIParams{
 		"alert_spec": alertSpec,
 	}
+	if err := loc.api.ValidateParams("AlertSpec", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AlertSpec", "create")
 	if err != nil {
 		return res, err
@@ -582,15 +844,10 @@ func (loc *AlertSpecLocator) Create(alertSpec *AlertSpecParam) (*AlertSpecLocato
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -608,6 +865,9 @@ func (loc *AlertSpecLocator) Create(alertSpec *AlertSpecParam) (*AlertSpecLocato
 func (loc *AlertSpecLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AlertSpec", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("AlertSpec", "destroy")
 	if err != nil {
 		return err
@@ -620,18 +880,50 @@ func (loc *AlertSpecLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// AlertSpecIndexOptions contains the optional parameters of AlertSpecLocator.Index.
+type AlertSpecIndexOptions struct {
+	Filter []string
+	View   string
+	// Flag indicating whether or not to include AlertSpecs from the ServerTemplate in the index.
+	WithInherited string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AlertSpecIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view", "with_inherited":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AlertSpecLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	if o.WithInherited != "" {
+		p["with_inherited"] = o.WithInherited
+	}
+	return p, nil
+}
+
 // GET /api/servers/:server_id/alert_specs
 // GET /api/server_arrays/:server_array_id/alert_specs
 // GET /api/server_templates/:server_template_id/alert_specs
@@ -642,24 +934,31 @@ func (loc *AlertSpecLocator) Destroy() error {
 // filter
 // view
 // with_inherited: Flag indicating whether or not to include AlertSpecs from the ServerTemplate in the index.
-func (loc *AlertSpecLocator) Index(options rsapi.APIParams) ([]*AlertSpec, error) {
+func (loc *AlertSpecLocator) Index(options *AlertSpecIndexOptions) ([]*AlertSpec, error) {
 	var res []*AlertSpec
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var withInheritedOpt = options["with_inherited"]
+	var withInheritedOpt = opts["with_inherited"]
 	if withInheritedOpt != nil {
 		p["with_inherited"] = withInheritedOpt
 	}
+	if err := loc.api.ValidateParams("AlertSpec", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AlertSpec", "index")
 	if err != nil {
 		return res, err
@@ -672,68 +971,135 @@ func (loc *AlertSpecLocator) Index(options rsapi.APIParams) ([]*AlertSpec, error
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
-// GET /api/servers/:server_id/alert_specs/:id
-// GET /api/server_arrays/:server_array_id/alert_specs/:id
-// GET /api/server_templates/:server_template_id/alert_specs/:id
-// GET /api/alert_specs/:id
-//
-// No description provided for show.
-// Optional parameters:
-// view
-func (loc *AlertSpecLocator) Show(options rsapi.APIParams) (*AlertSpec, error) {
-	var res *AlertSpec
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *AlertSpecLocator) IndexEach(options *AlertSpecIndexOptions, fn func(*AlertSpec) error) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
-	uri, err := loc.ActionPath("AlertSpec", "show")
+	p = rsapi.APIParams{}
+	var withInheritedOpt = opts["with_inherited"]
+	if withInheritedOpt != nil {
+		p["with_inherited"] = withInheritedOpt
+	}
+	if err := loc.api.ValidateParams("AlertSpec", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("AlertSpec", "index")
 	if err != nil {
-		return res, err
+		return err
 	}
 	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
 	if err != nil {
-		return res, err
+		return err
 	}
 	resp, err := loc.api.PerformRequest(req)
 	if err != nil {
-		return res, err
+		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *AlertSpec
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// AlertSpecShowOptions contains the optional parameters of AlertSpecLocator.Show.
+type AlertSpecShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AlertSpecShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AlertSpecLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
+// GET /api/servers/:server_id/alert_specs/:id
+// GET /api/server_arrays/:server_array_id/alert_specs/:id
+// GET /api/server_templates/:server_template_id/alert_specs/:id
+// GET /api/alert_specs/:id
+//
+// No description provided for show.
+// Optional parameters:
+// view
+func (loc *AlertSpecLocator) Show(options *AlertSpecShowOptions) (*AlertSpec, error) {
+	var res *AlertSpec
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AlertSpec", "show", params, p); err != nil {
+		return res, err
+	}
+	uri, err := loc.ActionPath("AlertSpec", "show")
+	if err != nil {
+		return res, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return res, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return res, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return res, rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -754,6 +1120,9 @@ func (loc *AlertSpecLocator) Update(alertSpec *AlertSpecParam2) error {
 	p = rsapi.APIParams{
 		"alert_spec": alertSpec,
 	}
+	if err := loc.api.ValidateParams("AlertSpec", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("AlertSpec", "update")
 	if err != nil {
 		return err
@@ -766,15 +1135,10 @@ func (loc *AlertSpecLocator) Update(alertSpec *AlertSpecParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -786,7 +1150,7 @@ type AuditEntry struct {
 	DetailSize int                 `json:"detail_size,omitempty"`
 	Links      []map[string]string `json:"links,omitempty"`
 	Summary    string              `json:"summary,omitempty"`
-	UpdatedAt  *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt  *rsapi.Time         `json:"updated_at,omitempty"`
 	UserEmail  string              `json:"user_email,omitempty"`
 }
 
@@ -813,8 +1177,65 @@ func (api *API) AuditEntryLocator(href string) *AuditEntryLocator {
 	return &AuditEntryLocator{Href(href), api}
 }
 
+// AuditEntryLocatorInterface lists the AuditEntryLocator methods, code that depends on the interface
+// rather than on AuditEntryLocator can be unit tested using the generated fake locators.
+type AuditEntryLocatorInterface interface {
+	Append(options *AuditEntryAppendOptions) error
+	Create(auditEntry *AuditEntryParam, options *AuditEntryCreateOptions) (*AuditEntryLocator, error)
+	Detail() (string, error)
+	DetailReader() (io.ReadCloser, error)
+	Index(endDate *rsapi.Time, limit string, startDate *rsapi.Time, options *AuditEntryIndexOptions) ([]*AuditEntry, error)
+	IndexEach(endDate *rsapi.Time, limit string, startDate *rsapi.Time, options *AuditEntryIndexOptions, fn func(*AuditEntry) error) error
+	Show(options *AuditEntryShowOptions) (*AuditEntry, error)
+	Update(auditEntry *AuditEntryParam2, options *AuditEntryUpdateOptions) error
+}
+
 //===== Actions
 
+// AuditEntryAppendOptions contains the optional parameters of AuditEntryLocator.Append.
+type AuditEntryAppendOptions struct {
+	// The details to be appended to the audit entry record.
+	Detail string
+	// The event notification category. Defaults to 'None'.
+	Notify string
+	// The offset where the new details should be appended to in the audit entry's existing details section. Also used in ordering of summary updates. Defaults to end.
+	Offset int
+	// The updated summary for the audit entry, maximum length is 255 characters.
+	Summary string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AuditEntryAppendOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "detail", "notify", "offset", "summary":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AuditEntryLocator.Append", n)
+		}
+	}
+	if o.Detail != "" {
+		p["detail"] = o.Detail
+	}
+	if o.Notify != "" {
+		p["notify"] = o.Notify
+	}
+	if o.Offset != 0 {
+		p["offset"] = o.Offset
+	}
+	if o.Summary != "" {
+		p["summary"] = o.Summary
+	}
+	return p, nil
+}
+
 // POST /api/audit_entries/:id/append
 //
 // Updates the summary and appends more details to a given AuditEntry. Each audit entry detail is stored
@@ -827,26 +1248,33 @@ func (api *API) AuditEntryLocator(href string) *AuditEntryLocator {
 // notify: The event notification category. Defaults to 'None'.
 // offset: The offset where the new details should be appended to in the audit entry's existing details section. Also used in ordering of summary updates. Defaults to end.
 // summary: The updated summary for the audit entry, maximum length is 255 characters.
-func (loc *AuditEntryLocator) Append(options rsapi.APIParams) error {
+func (loc *AuditEntryLocator) Append(options *AuditEntryAppendOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var detailOpt = options["detail"]
+	var detailOpt = opts["detail"]
 	if detailOpt != nil {
 		p["detail"] = detailOpt
 	}
-	var notifyOpt = options["notify"]
+	var notifyOpt = opts["notify"]
 	if notifyOpt != nil {
 		p["notify"] = notifyOpt
 	}
-	var offsetOpt = options["offset"]
+	var offsetOpt = opts["offset"]
 	if offsetOpt != nil {
 		p["offset"] = offsetOpt
 	}
-	var summaryOpt = options["summary"]
+	var summaryOpt = opts["summary"]
 	if summaryOpt != nil {
 		p["summary"] = summaryOpt
 	}
+	if err := loc.api.ValidateParams("AuditEntry", "append", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("AuditEntry", "append")
 	if err != nil {
 		return err
@@ -859,18 +1287,47 @@ func (loc *AuditEntryLocator) Append(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// AuditEntryCreateOptions contains the optional parameters of AuditEntryLocator.Create.
+type AuditEntryCreateOptions struct {
+	// The event notification category. Defaults to 'None'.
+	Notify string
+	// The email of the user (who created/triggered the audit entry). Only usable with instance role.
+	UserEmail string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AuditEntryCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "notify", "user_email":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AuditEntryLocator.Create", n)
+		}
+	}
+	if o.Notify != "" {
+		p["notify"] = o.Notify
+	}
+	if o.UserEmail != "" {
+		p["user_email"] = o.UserEmail
+	}
+	return p, nil
+}
+
 // POST /api/audit_entries
 //
 // Creates a new AuditEntry with the given parameters.
@@ -879,24 +1336,31 @@ func (loc *AuditEntryLocator) Append(options rsapi.APIParams) error {
 // Optional parameters:
 // notify: The event notification category. Defaults to 'None'.
 // user_email: The email of the user (who created/triggered the audit entry). Only usable with instance role.
-func (loc *AuditEntryLocator) Create(auditEntry *AuditEntryParam, options rsapi.APIParams) (*AuditEntryLocator, error) {
+func (loc *AuditEntryLocator) Create(auditEntry *AuditEntryParam, options *AuditEntryCreateOptions) (*AuditEntryLocator, error) {
 	var res *AuditEntryLocator
 	if auditEntry == nil {
 		return res, fmt.Errorf("auditEntry is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"audit_entry": auditEntry,
 	}
-	var notifyOpt = options["notify"]
+	var notifyOpt = opts["notify"]
 	if notifyOpt != nil {
 		p["notify"] = notifyOpt
 	}
-	var userEmailOpt = options["user_email"]
+	var userEmailOpt = opts["user_email"]
 	if userEmailOpt != nil {
 		p["user_email"] = userEmailOpt
 	}
+	if err := loc.api.ValidateParams("AuditEntry", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AuditEntry", "create")
 	if err != nil {
 		return res, err
@@ -909,15 +1373,10 @@ func (loc *AuditEntryLocator) Create(auditEntry *AuditEntryParam, options rsapi.
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -934,6 +1393,9 @@ func (loc *AuditEntryLocator) Detail() (string, error) {
 	var res string
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AuditEntry", "detail", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AuditEntry", "detail")
 	if err != nil {
 		return res, err
@@ -946,14 +1408,8 @@ func (loc *AuditEntryLocator) Detail() (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -964,6 +1420,64 @@ func (loc *AuditEntryLocator) Detail() (string, error) {
 	return res, err
 }
 
+// DetailReader is identical to Detail except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *AuditEntryLocator) DetailReader() (io.ReadCloser, error) {
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AuditEntry", "detail", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("AuditEntry", "detail")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
+// AuditEntryIndexOptions contains the optional parameters of AuditEntryLocator.Index.
+type AuditEntryIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AuditEntryIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AuditEntryLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/audit_entries
 //
 // Lists AuditEntries of the account. Due to the potentially large number of audit entries, a start and end date must
@@ -978,24 +1492,28 @@ func (loc *AuditEntryLocator) Detail() (string, error) {
 // Optional parameters:
 // filter
 // view
-func (loc *AuditEntryLocator) Index(endDate string, limit string, startDate string, options rsapi.APIParams) ([]*AuditEntry, error) {
+func (loc *AuditEntryLocator) Index(endDate *rsapi.Time, limit string, startDate *rsapi.Time, options *AuditEntryIndexOptions) ([]*AuditEntry, error) {
 	var res []*AuditEntry
-	if endDate == "" {
+	if endDate == nil {
 		return res, fmt.Errorf("endDate is required")
 	}
 	if limit == "" {
 		return res, fmt.Errorf("limit is required")
 	}
-	if startDate == "" {
+	if startDate == nil {
 		return res, fmt.Errorf("startDate is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
@@ -1005,6 +1523,9 @@ func (loc *AuditEntryLocator) Index(endDate string, limit string, startDate stri
 		"limit":      limit,
 		"start_date": startDate,
 	}
+	if err := loc.api.ValidateParams("AuditEntry", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AuditEntry", "index")
 	if err != nil {
 		return res, err
@@ -1017,22 +1538,101 @@ func (loc *AuditEntryLocator) Index(endDate string, limit string, startDate stri
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *AuditEntryLocator) IndexEach(endDate *rsapi.Time, limit string, startDate *rsapi.Time, options *AuditEntryIndexOptions, fn func(*AuditEntry) error) error {
+	if endDate == nil {
+		return fmt.Errorf("endDate is required")
+	}
+	if limit == "" {
+		return fmt.Errorf("limit is required")
+	}
+	if startDate == nil {
+		return fmt.Errorf("startDate is required")
+	}
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	p = rsapi.APIParams{
+		"end_date":   endDate,
+		"limit":      limit,
+		"start_date": startDate,
+	}
+	if err := loc.api.ValidateParams("AuditEntry", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("AuditEntry", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *AuditEntry
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// AuditEntryShowOptions contains the optional parameters of AuditEntryLocator.Show.
+type AuditEntryShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AuditEntryShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AuditEntryLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/audit_entries/:id
@@ -1040,15 +1640,22 @@ func (loc *AuditEntryLocator) Index(endDate string, limit string, startDate stri
 // Lists the attributes of a given audit entry.
 // Optional parameters:
 // view
-func (loc *AuditEntryLocator) Show(options rsapi.APIParams) (*AuditEntry, error) {
+func (loc *AuditEntryLocator) Show(options *AuditEntryShowOptions) (*AuditEntry, error) {
 	var res *AuditEntry
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AuditEntry", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AuditEntry", "show")
 	if err != nil {
 		return res, err
@@ -1061,24 +1668,43 @@ func (loc *AuditEntryLocator) Show(options rsapi.APIParams) (*AuditEntry, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// AuditEntryUpdateOptions contains the optional parameters of AuditEntryLocator.Update.
+type AuditEntryUpdateOptions struct {
+	// The event notification category. Defaults to 'None'.
+	Notify string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AuditEntryUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "notify":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AuditEntryLocator.Update", n)
+		}
+	}
+	if o.Notify != "" {
+		p["notify"] = o.Notify
+	}
+	return p, nil
+}
+
 // PUT /api/audit_entries/:id
 //
 // Updates the summary of a given AuditEntry.
@@ -1086,19 +1712,26 @@ func (loc *AuditEntryLocator) Show(options rsapi.APIParams) (*AuditEntry, error)
 // audit_entry
 // Optional parameters:
 // notify: The event notification category. Defaults to 'None'.
-func (loc *AuditEntryLocator) Update(auditEntry *AuditEntryParam2, options rsapi.APIParams) error {
+func (loc *AuditEntryLocator) Update(auditEntry *AuditEntryParam2, options *AuditEntryUpdateOptions) error {
 	if auditEntry == nil {
 		return fmt.Errorf("auditEntry is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"audit_entry": auditEntry,
 	}
-	var notifyOpt = options["notify"]
+	var notifyOpt = opts["notify"]
 	if notifyOpt != nil {
 		p["notify"] = notifyOpt
 	}
+	if err := loc.api.ValidateParams("AuditEntry", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("AuditEntry", "update")
 	if err != nil {
 		return err
@@ -1111,15 +1744,10 @@ func (loc *AuditEntryLocator) Update(auditEntry *AuditEntryParam2, options rsapi
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1129,7 +1757,7 @@ type Backup struct {
 	Actions             []map[string]string `json:"actions,omitempty"`
 	Committed           bool                `json:"committed,omitempty"`
 	Completed           bool                `json:"completed,omitempty"`
-	CreatedAt           *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt           *rsapi.Time         `json:"created_at,omitempty"`
 	Description         string              `json:"description,omitempty"`
 	FromMaster          bool                `json:"from_master,omitempty"`
 	Lineage             string              `json:"lineage,omitempty"`
@@ -1162,8 +1790,70 @@ func (api *API) BackupLocator(href string) *BackupLocator {
 	return &BackupLocator{Href(href), api}
 }
 
+// BackupLocatorInterface lists the BackupLocator methods, code that depends on the interface
+// rather than on BackupLocator can be unit tested using the generated fake locators.
+type BackupLocatorInterface interface {
+	Cleanup(keepLast string, lineage string, options *BackupCleanupOptions) error
+	Create(backup *BackupParam) (*BackupLocator, error)
+	Destroy() error
+	Index(lineage string, options *BackupIndexOptions) ([]*Backup, error)
+	IndexEach(lineage string, options *BackupIndexOptions, fn func(*Backup) error) error
+	Restore(instanceHref string, options *BackupRestoreOptions) error
+	Show() (*Backup, error)
+	Update(backup *BackupParam3) error
+}
+
 //===== Actions
 
+// BackupCleanupOptions contains the optional parameters of BackupLocator.Cleanup.
+type BackupCleanupOptions struct {
+	// Backups belonging to only this cloud are considered for cleanup. Otherwise, all backups in the account with the same lineage will be considered.
+	CloudHref string
+	// The number of daily backups(the latest one in each day) that should be kept.
+	Dailies string
+	// The number of monthly backups(the latest one in each month) that should be kept.
+	Monthlies string
+	// The number of weekly backups(the latest one in each week) that should be kept.
+	Weeklies string
+	// The number of yearly backups(the latest one in each year) that should be kept.
+	Yearlies string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *BackupCleanupOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "cloud_href", "dailies", "monthlies", "weeklies", "yearlies":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of BackupLocator.Cleanup", n)
+		}
+	}
+	if o.CloudHref != "" {
+		p["cloud_href"] = o.CloudHref
+	}
+	if o.Dailies != "" {
+		p["dailies"] = o.Dailies
+	}
+	if o.Monthlies != "" {
+		p["monthlies"] = o.Monthlies
+	}
+	if o.Weeklies != "" {
+		p["weeklies"] = o.Weeklies
+	}
+	if o.Yearlies != "" {
+		p["yearlies"] = o.Yearlies
+	}
+	return p, nil
+}
+
 // POST /api/backups/cleanup
 //
 // Deletes old backups that meet the given criteria. For example, if a user calls cleanup with keep monthlies set to 12,
@@ -1198,39 +1888,46 @@ func (api *API) BackupLocator(href string) *BackupLocator {
 // monthlies: The number of monthly backups(the latest one in each month) that should be kept.
 // weeklies: The number of weekly backups(the latest one in each week) that should be kept.
 // yearlies: The number of yearly backups(the latest one in each year) that should be kept.
-func (loc *BackupLocator) Cleanup(keepLast string, lineage string, options rsapi.APIParams) error {
+func (loc *BackupLocator) Cleanup(keepLast string, lineage string, options *BackupCleanupOptions) error {
 	if keepLast == "" {
 		return fmt.Errorf("keepLast is required")
 	}
 	if lineage == "" {
 		return fmt.Errorf("lineage is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"keep_last": keepLast,
 		"lineage":   lineage,
 	}
-	var cloudHrefOpt = options["cloud_href"]
+	var cloudHrefOpt = opts["cloud_href"]
 	if cloudHrefOpt != nil {
 		p["cloud_href"] = cloudHrefOpt
 	}
-	var dailiesOpt = options["dailies"]
+	var dailiesOpt = opts["dailies"]
 	if dailiesOpt != nil {
 		p["dailies"] = dailiesOpt
 	}
-	var monthliesOpt = options["monthlies"]
+	var monthliesOpt = opts["monthlies"]
 	if monthliesOpt != nil {
 		p["monthlies"] = monthliesOpt
 	}
-	var weekliesOpt = options["weeklies"]
+	var weekliesOpt = opts["weeklies"]
 	if weekliesOpt != nil {
 		p["weeklies"] = weekliesOpt
 	}
-	var yearliesOpt = options["yearlies"]
+	var yearliesOpt = opts["yearlies"]
 	if yearliesOpt != nil {
 		p["yearlies"] = yearliesOpt
 	}
+	if err := loc.api.ValidateParams("Backup", "cleanup", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Backup", "cleanup")
 	if err != nil {
 		return err
@@ -1243,15 +1940,10 @@ func (loc *BackupLocator) Cleanup(keepLast string, lineage string, options rsapi
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1271,6 +1963,9 @@ func (loc *BackupLocator) Create(backup *BackupParam) (*BackupLocator, error) {
 	p = rsapi.APIParams{
 		"backup": backup,
 	}
+	if err := loc.api.ValidateParams("Backup", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Backup", "create")
 	if err != nil {
 		return res, err
@@ -1283,15 +1978,10 @@ func (loc *BackupLocator) Create(backup *BackupParam) (*BackupLocator, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -1306,6 +1996,9 @@ func (loc *BackupLocator) Create(backup *BackupParam) (*BackupLocator, error) {
 func (loc *BackupLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Backup", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Backup", "destroy")
 	if err != nil {
 		return err
@@ -1318,18 +2011,41 @@ func (loc *BackupLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// BackupIndexOptions contains the optional parameters of BackupLocator.Index.
+type BackupIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *BackupIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of BackupLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/backups
 //
 // Lists all of the backups with the given lineage tag. Filters can be used to search for a particular backup. If the
@@ -1343,14 +2059,18 @@ func (loc *BackupLocator) Destroy() error {
 // lineage: Backups belonging to this lineage.
 // Optional parameters:
 // filter
-func (loc *BackupLocator) Index(lineage string, options rsapi.APIParams) ([]*Backup, error) {
+func (loc *BackupLocator) Index(lineage string, options *BackupIndexOptions) ([]*Backup, error) {
 	var res []*Backup
 	if lineage == "" {
 		return res, fmt.Errorf("lineage is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
@@ -1358,6 +2078,9 @@ func (loc *BackupLocator) Index(lineage string, options rsapi.APIParams) ([]*Bac
 	p = rsapi.APIParams{
 		"lineage": lineage,
 	}
+	if err := loc.api.ValidateParams("Backup", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Backup", "index")
 	if err != nil {
 		return res, err
@@ -1370,24 +2093,91 @@ func (loc *BackupLocator) Index(lineage string, options rsapi.APIParams) ([]*Bac
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *BackupLocator) IndexEach(lineage string, options *BackupIndexOptions, fn func(*Backup) error) error {
+	if lineage == "" {
+		return fmt.Errorf("lineage is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	p = rsapi.APIParams{
+		"lineage": lineage,
+	}
+	if err := loc.api.ValidateParams("Backup", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Backup", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Backup
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// BackupRestoreOptions contains the optional parameters of BackupLocator.Restore.
+type BackupRestoreOptions struct {
+	Backup *BackupParam2
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *BackupRestoreOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "backup":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of BackupLocator.Restore", n)
+		}
+	}
+	if o.Backup != nil {
+		p["backup"] = o.Backup
+	}
+	return p, nil
+}
+
 // POST /api/backups/:id/restore
 //
 // Restores the given Backup.
@@ -1399,19 +2189,26 @@ func (loc *BackupLocator) Index(lineage string, options rsapi.APIParams) ([]*Bac
 // instance_href: The instance href that the backup will be restored to.
 // Optional parameters:
 // backup
-func (loc *BackupLocator) Restore(instanceHref string, options rsapi.APIParams) error {
+func (loc *BackupLocator) Restore(instanceHref string, options *BackupRestoreOptions) error {
 	if instanceHref == "" {
 		return fmt.Errorf("instanceHref is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"instance_href": instanceHref,
 	}
-	var backupOpt = options["backup"]
+	var backupOpt = opts["backup"]
 	if backupOpt != nil {
 		p["backup"] = backupOpt
 	}
+	if err := loc.api.ValidateParams("Backup", "restore", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Backup", "restore")
 	if err != nil {
 		return err
@@ -1424,15 +2221,10 @@ func (loc *BackupLocator) Restore(instanceHref string, options rsapi.APIParams)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1443,6 +2235,9 @@ func (loc *BackupLocator) Show() (*Backup, error) {
 	var res *Backup
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Backup", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Backup", "show")
 	if err != nil {
 		return res, err
@@ -1455,21 +2250,11 @@ func (loc *BackupLocator) Show() (*Backup, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -1487,6 +2272,9 @@ func (loc *BackupLocator) Update(backup *BackupParam3) error {
 	p = rsapi.APIParams{
 		"backup": backup,
 	}
+	if err := loc.api.ValidateParams("Backup", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Backup", "update")
 	if err != nil {
 		return err
@@ -1499,15 +2287,10 @@ func (loc *BackupLocator) Update(backup *BackupParam3) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1529,6 +2312,15 @@ func (api *API) ChildAccountLocator(href string) *ChildAccountLocator {
 	return &ChildAccountLocator{Href(href), api}
 }
 
+// ChildAccountLocatorInterface lists the ChildAccountLocator methods, code that depends on the interface
+// rather than on ChildAccountLocator can be unit tested using the generated fake locators.
+type ChildAccountLocatorInterface interface {
+	Create(childAccount *ChildAccountParam) (*ChildAccountLocator, error)
+	Index(options *ChildAccountIndexOptions) ([]*Account, error)
+	IndexEach(options *ChildAccountIndexOptions, fn func(*Account) error) error
+	Update(childAccount *ChildAccountParam2) error
+}
+
 //===== Actions
 
 // POST /api/child_accounts
@@ -1549,6 +2341,9 @@ func (loc *ChildAccountLocator) Create(childAccount *ChildAccountParam) (*ChildA
 	p = rsapi.APIParams{
 		"child_account": childAccount,
 	}
+	if err := loc.api.ValidateParams("ChildAccount", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ChildAccount", "create")
 	if err != nil {
 		return res, err
@@ -1561,15 +2356,10 @@ func (loc *ChildAccountLocator) Create(childAccount *ChildAccountParam) (*ChildA
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -1578,20 +2368,55 @@ func (loc *ChildAccountLocator) Create(childAccount *ChildAccountParam) (*ChildA
 	}
 }
 
+// ChildAccountIndexOptions contains the optional parameters of ChildAccountLocator.Index.
+type ChildAccountIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ChildAccountIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ChildAccountLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/child_accounts
 //
 // Lists the enterprise ChildAccounts available for this Account.
 // Optional parameters:
 // filter
-func (loc *ChildAccountLocator) Index(options rsapi.APIParams) ([]*Account, error) {
+func (loc *ChildAccountLocator) Index(options *ChildAccountIndexOptions) ([]*Account, error) {
 	var res []*Account
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ChildAccount", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ChildAccount", "index")
 	if err != nil {
 		return res, err
@@ -1604,22 +2429,55 @@ func (loc *ChildAccountLocator) Index(options rsapi.APIParams) ([]*Account, erro
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *ChildAccountLocator) IndexEach(options *ChildAccountIndexOptions, fn func(*Account) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ChildAccount", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("ChildAccount", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Account
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // PUT /api/accounts/:id
@@ -1637,6 +2495,9 @@ func (loc *ChildAccountLocator) Update(childAccount *ChildAccountParam2) error {
 	p = rsapi.APIParams{
 		"child_account": childAccount,
 	}
+	if err := loc.api.ValidateParams("ChildAccount", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ChildAccount", "update")
 	if err != nil {
 		return err
@@ -1649,15 +2510,10 @@ func (loc *ChildAccountLocator) Update(childAccount *ChildAccountParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1683,6 +2539,160 @@ func (r *Cloud) Locator(api *API) *CloudLocator {
 	return nil
 }
 
+// DatacentersLocator returns a locator for the resource pointed to by the "datacenters" link.
+// It returns nil if the link is absent.
+func (r *Cloud) DatacentersLocator(api *API) *DatacenterLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "datacenters" {
+			return api.DatacenterLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ImagesLocator returns a locator for the resource pointed to by the "images" link.
+// It returns nil if the link is absent.
+func (r *Cloud) ImagesLocator(api *API) *ImageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "images" {
+			return api.ImageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// InstanceTypesLocator returns a locator for the resource pointed to by the "instance_types" link.
+// It returns nil if the link is absent.
+func (r *Cloud) InstanceTypesLocator(api *API) *InstanceTypeLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "instance_types" {
+			return api.InstanceTypeLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// InstancesLocator returns a locator for the resource pointed to by the "instances" link.
+// It returns nil if the link is absent.
+func (r *Cloud) InstancesLocator(api *API) *InstanceLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "instances" {
+			return api.InstanceLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// IpAddressBindingsLocator returns a locator for the resource pointed to by the "ip_address_bindings" link.
+// It returns nil if the link is absent.
+func (r *Cloud) IpAddressBindingsLocator(api *API) *IpAddressBindingLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "ip_address_bindings" {
+			return api.IpAddressBindingLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// IpAddressesLocator returns a locator for the resource pointed to by the "ip_addresses" link.
+// It returns nil if the link is absent.
+func (r *Cloud) IpAddressesLocator(api *API) *IpAddressLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "ip_addresses" {
+			return api.IpAddressLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RecurringVolumeAttachmentsLocator returns a locator for the resource pointed to by the "recurring_volume_attachments" link.
+// It returns nil if the link is absent.
+func (r *Cloud) RecurringVolumeAttachmentsLocator(api *API) *RecurringVolumeAttachmentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "recurring_volume_attachments" {
+			return api.RecurringVolumeAttachmentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// SecurityGroupsLocator returns a locator for the resource pointed to by the "security_groups" link.
+// It returns nil if the link is absent.
+func (r *Cloud) SecurityGroupsLocator(api *API) *SecurityGroupLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "security_groups" {
+			return api.SecurityGroupLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// SshKeysLocator returns a locator for the resource pointed to by the "ssh_keys" link.
+// It returns nil if the link is absent.
+func (r *Cloud) SshKeysLocator(api *API) *SshKeyLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "ssh_keys" {
+			return api.SshKeyLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// SubnetsLocator returns a locator for the resource pointed to by the "subnets" link.
+// It returns nil if the link is absent.
+func (r *Cloud) SubnetsLocator(api *API) *SubnetLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "subnets" {
+			return api.SubnetLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// VolumeAttachmentsLocator returns a locator for the resource pointed to by the "volume_attachments" link.
+// It returns nil if the link is absent.
+func (r *Cloud) VolumeAttachmentsLocator(api *API) *VolumeAttachmentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "volume_attachments" {
+			return api.VolumeAttachmentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// VolumeSnapshotsLocator returns a locator for the resource pointed to by the "volume_snapshots" link.
+// It returns nil if the link is absent.
+func (r *Cloud) VolumeSnapshotsLocator(api *API) *VolumeSnapshotLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "volume_snapshots" {
+			return api.VolumeSnapshotLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// VolumeTypesLocator returns a locator for the resource pointed to by the "volume_types" link.
+// It returns nil if the link is absent.
+func (r *Cloud) VolumeTypesLocator(api *API) *VolumeTypeLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "volume_types" {
+			return api.VolumeTypeLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// VolumesLocator returns a locator for the resource pointed to by the "volumes" link.
+// It returns nil if the link is absent.
+func (r *Cloud) VolumesLocator(api *API) *VolumeLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "volumes" {
+			return api.VolumeLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // CloudLocator exposes the Cloud resource actions.
@@ -1696,27 +2706,74 @@ func (api *API) CloudLocator(href string) *CloudLocator {
 	return &CloudLocator{Href(href), api}
 }
 
+// CloudLocatorInterface lists the CloudLocator methods, code that depends on the interface
+// rather than on CloudLocator can be unit tested using the generated fake locators.
+type CloudLocatorInterface interface {
+	Index(options *CloudIndexOptions) ([]*Cloud, error)
+	IndexEach(options *CloudIndexOptions, fn func(*Cloud) error) error
+	Show(options *CloudShowOptions) (*Cloud, error)
+}
+
 //===== Actions
 
+// CloudIndexOptions contains the optional parameters of CloudLocator.Index.
+type CloudIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CloudIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CloudLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds
 //
 // Lists the clouds available to this account.
 // Optional parameters:
 // filter
 // view
-func (loc *CloudLocator) Index(options rsapi.APIParams) ([]*Cloud, error) {
+func (loc *CloudLocator) Index(options *CloudIndexOptions) ([]*Cloud, error) {
 	var res []*Cloud
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Cloud", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Cloud", "index")
 	if err != nil {
 		return res, err
@@ -1729,38 +2786,110 @@ func (loc *CloudLocator) Index(options rsapi.APIParams) ([]*Cloud, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
-// GET /api/clouds/:id
-//
-// Show information about a single cloud.
-// Optional parameters:
-// view
-func (loc *CloudLocator) Show(options rsapi.APIParams) (*Cloud, error) {
-	var res *Cloud
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *CloudLocator) IndexEach(options *CloudIndexOptions, fn func(*Cloud) error) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Cloud", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Cloud", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Cloud
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// CloudShowOptions contains the optional parameters of CloudLocator.Show.
+type CloudShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CloudShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CloudLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
+// GET /api/clouds/:id
+//
+// Show information about a single cloud.
+// Optional parameters:
+// view
+func (loc *CloudLocator) Show(options *CloudShowOptions) (*Cloud, error) {
+	var res *Cloud
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Cloud", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Cloud", "show")
 	if err != nil {
 		return res, err
@@ -1773,21 +2902,11 @@ func (loc *CloudLocator) Show(options rsapi.APIParams) (*Cloud, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -1795,9 +2914,9 @@ func (loc *CloudLocator) Show(options rsapi.APIParams) (*Cloud, error) {
 
 // Represents a Cloud Account (An association between the account and a cloud).
 type CloudAccount struct {
-	CreatedAt *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt *rsapi.Time         `json:"created_at,omitempty"`
 	Links     []map[string]string `json:"links,omitempty"`
-	UpdatedAt *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -1810,6 +2929,28 @@ func (r *CloudAccount) Locator(api *API) *CloudAccountLocator {
 	return nil
 }
 
+// AccountLocator returns a locator for the resource pointed to by the "account" link.
+// It returns nil if the link is absent.
+func (r *CloudAccount) AccountLocator(api *API) *AccountLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "account" {
+			return api.AccountLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *CloudAccount) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // CloudAccountLocator exposes the CloudAccount resource actions.
@@ -1823,6 +2964,16 @@ func (api *API) CloudAccountLocator(href string) *CloudAccountLocator {
 	return &CloudAccountLocator{Href(href), api}
 }
 
+// CloudAccountLocatorInterface lists the CloudAccountLocator methods, code that depends on the interface
+// rather than on CloudAccountLocator can be unit tested using the generated fake locators.
+type CloudAccountLocatorInterface interface {
+	Create(cloudAccount *CloudAccountParam) (*CloudAccountLocator, error)
+	Destroy() error
+	Index() ([]*CloudAccount, error)
+	IndexEach(fn func(*CloudAccount) error) error
+	Show() (*CloudAccount, error)
+}
+
 //===== Actions
 
 // POST /api/cloud_accounts
@@ -1842,6 +2993,9 @@ func (loc *CloudAccountLocator) Create(cloudAccount *CloudAccountParam) (*CloudA
 	p = rsapi.APIParams{
 		"cloud_account": cloudAccount,
 	}
+	if err := loc.api.ValidateParams("CloudAccount", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("CloudAccount", "create")
 	if err != nil {
 		return res, err
@@ -1854,15 +3008,10 @@ func (loc *CloudAccountLocator) Create(cloudAccount *CloudAccountParam) (*CloudA
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -1877,6 +3026,9 @@ func (loc *CloudAccountLocator) Create(cloudAccount *CloudAccountParam) (*CloudA
 func (loc *CloudAccountLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CloudAccount", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("CloudAccount", "destroy")
 	if err != nil {
 		return err
@@ -1889,15 +3041,10 @@ func (loc *CloudAccountLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1908,6 +3055,9 @@ func (loc *CloudAccountLocator) Index() ([]*CloudAccount, error) {
 	var res []*CloudAccount
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CloudAccount", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("CloudAccount", "index")
 	if err != nil {
 		return res, err
@@ -1920,22 +3070,46 @@ func (loc *CloudAccountLocator) Index() ([]*CloudAccount, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *CloudAccountLocator) IndexEach(fn func(*CloudAccount) error) error {
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CloudAccount", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("CloudAccount", "index")
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *CloudAccount
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/cloud_accounts/:id
@@ -1945,6 +3119,9 @@ func (loc *CloudAccountLocator) Show() (*CloudAccount, error) {
 	var res *CloudAccount
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CloudAccount", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("CloudAccount", "show")
 	if err != nil {
 		return res, err
@@ -1957,21 +3134,11 @@ func (loc *CloudAccountLocator) Show() (*CloudAccount, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -1980,7 +3147,7 @@ func (loc *CloudAccountLocator) Show() (*CloudAccount, error) {
 // Represents a given instance of a single cookbook.
 type Cookbook struct {
 	Actions           []map[string]string `json:"actions,omitempty"`
-	CreatedAt         *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt         *rsapi.Time         `json:"created_at,omitempty"`
 	DownloadUrl       string              `json:"download_url,omitempty"`
 	Id                int                 `json:"id,omitempty"`
 	Links             []map[string]string `json:"links,omitempty"`
@@ -1989,7 +3156,7 @@ type Cookbook struct {
 	Namespace         string              `json:"namespace,omitempty"`
 	SourceInfoSummary string              `json:"source_info_summary,omitempty"`
 	State             string              `json:"state,omitempty"`
-	UpdatedAt         *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt         *rsapi.Time         `json:"updated_at,omitempty"`
 	Version           string              `json:"version,omitempty"`
 }
 
@@ -2003,6 +3170,28 @@ func (r *Cookbook) Locator(api *API) *CookbookLocator {
 	return nil
 }
 
+// CookbookAttachmentsLocator returns a locator for the resource pointed to by the "cookbook_attachments" link.
+// It returns nil if the link is absent.
+func (r *Cookbook) CookbookAttachmentsLocator(api *API) *CookbookAttachmentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cookbook_attachments" {
+			return api.CookbookAttachmentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RepositoryLocator returns a locator for the resource pointed to by the "repository" link.
+// It returns nil if the link is absent.
+func (r *Cookbook) RepositoryLocator(api *API) *RepositoryLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "repository" {
+			return api.RepositoryLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // CookbookLocator exposes the Cookbook resource actions.
@@ -2016,6 +3205,18 @@ func (api *API) CookbookLocator(href string) *CookbookLocator {
 	return &CookbookLocator{Href(href), api}
 }
 
+// CookbookLocatorInterface lists the CookbookLocator methods, code that depends on the interface
+// rather than on CookbookLocator can be unit tested using the generated fake locators.
+type CookbookLocatorInterface interface {
+	Destroy() error
+	Follow(value string) error
+	Freeze(value string) error
+	Index(options *CookbookIndexOptions) ([]*Cookbook, error)
+	IndexEach(options *CookbookIndexOptions, fn func(*Cookbook) error) error
+	Obsolete(value string) error
+	Show(options *CookbookShowOptions) (*Cookbook, error)
+}
+
 //===== Actions
 
 // DELETE /api/cookbooks/:id
@@ -2024,6 +3225,9 @@ func (api *API) CookbookLocator(href string) *CookbookLocator {
 func (loc *CookbookLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Cookbook", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Cookbook", "destroy")
 	if err != nil {
 		return err
@@ -2036,15 +3240,10 @@ func (loc *CookbookLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -2062,6 +3261,9 @@ func (loc *CookbookLocator) Follow(value string) error {
 	p = rsapi.APIParams{
 		"value": value,
 	}
+	if err := loc.api.ValidateParams("Cookbook", "follow", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Cookbook", "follow")
 	if err != nil {
 		return err
@@ -2074,15 +3276,10 @@ func (loc *CookbookLocator) Follow(value string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -2100,6 +3297,9 @@ func (loc *CookbookLocator) Freeze(value string) error {
 	p = rsapi.APIParams{
 		"value": value,
 	}
+	if err := loc.api.ValidateParams("Cookbook", "freeze", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Cookbook", "freeze")
 	if err != nil {
 		return err
@@ -2112,18 +3312,45 @@ func (loc *CookbookLocator) Freeze(value string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// CookbookIndexOptions contains the optional parameters of CookbookLocator.Index.
+type CookbookIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CookbookIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CookbookLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/cookbooks
 //
 // Lists the Cookbooks available to this account.
@@ -2131,19 +3358,26 @@ func (loc *CookbookLocator) Freeze(value string) error {
 // Optional parameters:
 // filter
 // view
-func (loc *CookbookLocator) Index(options rsapi.APIParams) ([]*Cookbook, error) {
+func (loc *CookbookLocator) Index(options *CookbookIndexOptions) ([]*Cookbook, error) {
 	var res []*Cookbook
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Cookbook", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Cookbook", "index")
 	if err != nil {
 		return res, err
@@ -2156,22 +3390,59 @@ func (loc *CookbookLocator) Index(options rsapi.APIParams) ([]*Cookbook, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *CookbookLocator) IndexEach(options *CookbookIndexOptions, fn func(*Cookbook) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Cookbook", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Cookbook", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Cookbook
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // POST /api/cookbooks/:id/obsolete
@@ -2188,6 +3459,9 @@ func (loc *CookbookLocator) Obsolete(value string) error {
 	p = rsapi.APIParams{
 		"value": value,
 	}
+	if err := loc.api.ValidateParams("Cookbook", "obsolete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Cookbook", "obsolete")
 	if err != nil {
 		return err
@@ -2200,33 +3474,63 @@ func (loc *CookbookLocator) Obsolete(value string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// CookbookShowOptions contains the optional parameters of CookbookLocator.Show.
+type CookbookShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CookbookShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CookbookLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/cookbooks/:id
 //
 // Show information about a single Cookbook.
 // The extended_designer view is only available to accounts with the designer permission.
 // Optional parameters:
 // view
-func (loc *CookbookLocator) Show(options rsapi.APIParams) (*Cookbook, error) {
+func (loc *CookbookLocator) Show(options *CookbookShowOptions) (*Cookbook, error) {
 	var res *Cookbook
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Cookbook", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Cookbook", "show")
 	if err != nil {
 		return res, err
@@ -2239,21 +3543,11 @@ func (loc *CookbookLocator) Show(options rsapi.APIParams) (*Cookbook, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -2277,6 +3571,28 @@ func (r *CookbookAttachment) Locator(api *API) *CookbookAttachmentLocator {
 	return nil
 }
 
+// CookbookLocator returns a locator for the resource pointed to by the "cookbook" link.
+// It returns nil if the link is absent.
+func (r *CookbookAttachment) CookbookLocator(api *API) *CookbookLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cookbook" {
+			return api.CookbookLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ServerTemplateLocator returns a locator for the resource pointed to by the "server_template" link.
+// It returns nil if the link is absent.
+func (r *CookbookAttachment) ServerTemplateLocator(api *API) *ServerTemplateLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "server_template" {
+			return api.ServerTemplateLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // CookbookAttachmentLocator exposes the CookbookAttachment resource actions.
@@ -2290,8 +3606,48 @@ func (api *API) CookbookAttachmentLocator(href string) *CookbookAttachmentLocato
 	return &CookbookAttachmentLocator{Href(href), api}
 }
 
+// CookbookAttachmentLocatorInterface lists the CookbookAttachmentLocator methods, code that depends on the interface
+// rather than on CookbookAttachmentLocator can be unit tested using the generated fake locators.
+type CookbookAttachmentLocatorInterface interface {
+	Create(options *CookbookAttachmentCreateOptions) (*CookbookAttachmentLocator, error)
+	Destroy() error
+	Index(options *CookbookAttachmentIndexOptions) ([]*CookbookAttachment, error)
+	IndexEach(options *CookbookAttachmentIndexOptions, fn func(*CookbookAttachment) error) error
+	MultiAttach(cookbookAttachments *CookbookAttachments) error
+	MultiDetach(cookbookAttachments *CookbookAttachments2) error
+	Show(options *CookbookAttachmentShowOptions) (*CookbookAttachment, error)
+}
+
 //===== Actions
 
+// CookbookAttachmentCreateOptions contains the optional parameters of CookbookAttachmentLocator.Create.
+type CookbookAttachmentCreateOptions struct {
+	CookbookAttachment *CookbookAttachmentParam
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CookbookAttachmentCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "cookbook_attachment":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CookbookAttachmentLocator.Create", n)
+		}
+	}
+	if o.CookbookAttachment != nil {
+		p["cookbook_attachment"] = o.CookbookAttachment
+	}
+	return p, nil
+}
+
 // POST /api/cookbooks/:cookbook_id/cookbook_attachments
 // POST /api/server_templates/:server_template_id/cookbook_attachments
 // POST /api/cookbook_attachments
@@ -2299,15 +3655,22 @@ func (api *API) CookbookAttachmentLocator(href string) *CookbookAttachmentLocato
 // Attach a cookbook to a given resource.
 // Optional parameters:
 // cookbook_attachment
-func (loc *CookbookAttachmentLocator) Create(options rsapi.APIParams) (*CookbookAttachmentLocator, error) {
+func (loc *CookbookAttachmentLocator) Create(options *CookbookAttachmentCreateOptions) (*CookbookAttachmentLocator, error) {
 	var res *CookbookAttachmentLocator
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var cookbookAttachmentOpt = options["cookbook_attachment"]
+	var cookbookAttachmentOpt = opts["cookbook_attachment"]
 	if cookbookAttachmentOpt != nil {
 		p["cookbook_attachment"] = cookbookAttachmentOpt
 	}
+	if err := loc.api.ValidateParams("CookbookAttachment", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("CookbookAttachment", "create")
 	if err != nil {
 		return res, err
@@ -2320,15 +3683,10 @@ func (loc *CookbookAttachmentLocator) Create(options rsapi.APIParams) (*Cookbook
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -2345,6 +3703,9 @@ func (loc *CookbookAttachmentLocator) Create(options rsapi.APIParams) (*Cookbook
 func (loc *CookbookAttachmentLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CookbookAttachment", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("CookbookAttachment", "destroy")
 	if err != nil {
 		return err
@@ -2357,34 +3718,64 @@ func (loc *CookbookAttachmentLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
-// GET /api/cookbooks/:cookbook_id/cookbook_attachments
+// CookbookAttachmentIndexOptions contains the optional parameters of CookbookAttachmentLocator.Index.
+type CookbookAttachmentIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CookbookAttachmentIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CookbookAttachmentLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
+// GET /api/cookbooks/:cookbook_id/cookbook_attachments
 // GET /api/server_templates/:server_template_id/cookbook_attachments
 // GET /api/cookbook_attachments
 //
 // Lists Cookbook Attachments.
 // Optional parameters:
 // view
-func (loc *CookbookAttachmentLocator) Index(options rsapi.APIParams) ([]*CookbookAttachment, error) {
+func (loc *CookbookAttachmentLocator) Index(options *CookbookAttachmentIndexOptions) ([]*CookbookAttachment, error) {
 	var res []*CookbookAttachment
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CookbookAttachment", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("CookbookAttachment", "index")
 	if err != nil {
 		return res, err
@@ -2397,22 +3788,55 @@ func (loc *CookbookAttachmentLocator) Index(options rsapi.APIParams) ([]*Cookboo
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *CookbookAttachmentLocator) IndexEach(options *CookbookAttachmentIndexOptions, fn func(*CookbookAttachment) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CookbookAttachment", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("CookbookAttachment", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *CookbookAttachment
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // POST /api/server_templates/:server_template_id/cookbook_attachments/multi_attach
@@ -2430,6 +3854,9 @@ func (loc *CookbookAttachmentLocator) MultiAttach(cookbookAttachments *CookbookA
 	p = rsapi.APIParams{
 		"cookbook_attachments": cookbookAttachments,
 	}
+	if err := loc.api.ValidateParams("CookbookAttachment", "multi_attach", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("CookbookAttachment", "multi_attach")
 	if err != nil {
 		return err
@@ -2442,15 +3869,10 @@ func (loc *CookbookAttachmentLocator) MultiAttach(cookbookAttachments *CookbookA
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -2469,6 +3891,9 @@ func (loc *CookbookAttachmentLocator) MultiDetach(cookbookAttachments *CookbookA
 	p = rsapi.APIParams{
 		"cookbook_attachments": cookbookAttachments,
 	}
+	if err := loc.api.ValidateParams("CookbookAttachment", "multi_detach", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("CookbookAttachment", "multi_detach")
 	if err != nil {
 		return err
@@ -2481,18 +3906,41 @@ func (loc *CookbookAttachmentLocator) MultiDetach(cookbookAttachments *CookbookA
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// CookbookAttachmentShowOptions contains the optional parameters of CookbookAttachmentLocator.Show.
+type CookbookAttachmentShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CookbookAttachmentShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CookbookAttachmentLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/cookbooks/:cookbook_id/cookbook_attachments/:id
 // GET /api/server_templates/:server_template_id/cookbook_attachments/:id
 // GET /api/cookbook_attachments/:id
@@ -2500,15 +3948,22 @@ func (loc *CookbookAttachmentLocator) MultiDetach(cookbookAttachments *CookbookA
 // Displays information about a single cookbook attachment to a ServerTemplate.
 // Optional parameters:
 // view
-func (loc *CookbookAttachmentLocator) Show(options rsapi.APIParams) (*CookbookAttachment, error) {
+func (loc *CookbookAttachmentLocator) Show(options *CookbookAttachmentShowOptions) (*CookbookAttachment, error) {
 	var res *CookbookAttachment
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("CookbookAttachment", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("CookbookAttachment", "show")
 	if err != nil {
 		return res, err
@@ -2521,21 +3976,11 @@ func (loc *CookbookAttachmentLocator) Show(options rsapi.APIParams) (*CookbookAt
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -2548,11 +3993,11 @@ func (loc *CookbookAttachmentLocator) Show(options rsapi.APIParams) (*CookbookAt
 // or Chef recipes. NOTE: Credential values may be updated through the API, but
 // values cannot be retrieved via the API.
 type Credential struct {
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Description string              `json:"description,omitempty"`
 	Links       []map[string]string `json:"links,omitempty"`
 	Name        string              `json:"name,omitempty"`
-	UpdatedAt   *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt   *rsapi.Time         `json:"updated_at,omitempty"`
 	Value       string              `json:"value,omitempty"`
 }
 
@@ -2579,6 +4024,17 @@ func (api *API) CredentialLocator(href string) *CredentialLocator {
 	return &CredentialLocator{Href(href), api}
 }
 
+// CredentialLocatorInterface lists the CredentialLocator methods, code that depends on the interface
+// rather than on CredentialLocator can be unit tested using the generated fake locators.
+type CredentialLocatorInterface interface {
+	Create(credential *CredentialParam) (*CredentialLocator, error)
+	Destroy() error
+	Index(options *CredentialIndexOptions) ([]*Credential, error)
+	IndexEach(options *CredentialIndexOptions, fn func(*Credential) error) error
+	Show(options *CredentialShowOptions) (*Credential, error)
+	Update(credential *CredentialParam) error
+}
+
 //===== Actions
 
 // POST /api/credentials
@@ -2596,6 +4052,9 @@ func (loc *CredentialLocator) Create(credential *CredentialParam) (*CredentialLo
 	p = rsapi.APIParams{
 		"credential": credential,
 	}
+	if err := loc.api.ValidateParams("Credential", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Credential", "create")
 	if err != nil {
 		return res, err
@@ -2608,15 +4067,10 @@ func (loc *CredentialLocator) Create(credential *CredentialParam) (*CredentialLo
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -2631,6 +4085,9 @@ func (loc *CredentialLocator) Create(credential *CredentialParam) (*CredentialLo
 func (loc *CredentialLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Credential", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Credential", "destroy")
 	if err != nil {
 		return err
@@ -2643,37 +4100,71 @@ func (loc *CredentialLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// CredentialIndexOptions contains the optional parameters of CredentialLocator.Index.
+type CredentialIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CredentialIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CredentialLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/credentials
 //
 // Lists the Credentials available to this account.
 // Optional parameters:
 // filter
 // view
-func (loc *CredentialLocator) Index(options rsapi.APIParams) ([]*Credential, error) {
+func (loc *CredentialLocator) Index(options *CredentialIndexOptions) ([]*Credential, error) {
 	var res []*Credential
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Credential", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Credential", "index")
 	if err != nil {
 		return res, err
@@ -2686,22 +4177,87 @@ func (loc *CredentialLocator) Index(options rsapi.APIParams) ([]*Credential, err
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *CredentialLocator) IndexEach(options *CredentialIndexOptions, fn func(*Credential) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Credential", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Credential", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Credential
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// CredentialShowOptions contains the optional parameters of CredentialLocator.Show.
+type CredentialShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CredentialShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CredentialLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/credentials/:id
@@ -2709,15 +4265,22 @@ func (loc *CredentialLocator) Index(options rsapi.APIParams) ([]*Credential, err
 // Show information about a single Credential. NOTE: Credential values may be updated through the API, but values cannot be retrieved via the API.
 // Optional parameters:
 // view
-func (loc *CredentialLocator) Show(options rsapi.APIParams) (*Credential, error) {
+func (loc *CredentialLocator) Show(options *CredentialShowOptions) (*Credential, error) {
 	var res *Credential
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Credential", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Credential", "show")
 	if err != nil {
 		return res, err
@@ -2730,21 +4293,11 @@ func (loc *CredentialLocator) Show(options rsapi.APIParams) (*Credential, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -2762,6 +4315,9 @@ func (loc *CredentialLocator) Update(credential *CredentialParam) error {
 	p = rsapi.APIParams{
 		"credential": credential,
 	}
+	if err := loc.api.ValidateParams("Credential", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Credential", "update")
 	if err != nil {
 		return err
@@ -2774,15 +4330,10 @@ func (loc *CredentialLocator) Update(credential *CredentialParam) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -2810,6 +4361,17 @@ func (r *Datacenter) Locator(api *API) *DatacenterLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *Datacenter) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // DatacenterLocator exposes the Datacenter resource actions.
@@ -2823,27 +4385,74 @@ func (api *API) DatacenterLocator(href string) *DatacenterLocator {
 	return &DatacenterLocator{Href(href), api}
 }
 
+// DatacenterLocatorInterface lists the DatacenterLocator methods, code that depends on the interface
+// rather than on DatacenterLocator can be unit tested using the generated fake locators.
+type DatacenterLocatorInterface interface {
+	Index(options *DatacenterIndexOptions) ([]*Datacenter, error)
+	IndexEach(options *DatacenterIndexOptions, fn func(*Datacenter) error) error
+	Show(options *DatacenterShowOptions) (*Datacenter, error)
+}
+
 //===== Actions
 
+// DatacenterIndexOptions contains the optional parameters of DatacenterLocator.Index.
+type DatacenterIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *DatacenterIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of DatacenterLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/datacenters
 //
 // Lists all Datacenters for a particular cloud.
 // Optional parameters:
 // filter
 // view
-func (loc *DatacenterLocator) Index(options rsapi.APIParams) ([]*Datacenter, error) {
+func (loc *DatacenterLocator) Index(options *DatacenterIndexOptions) ([]*Datacenter, error) {
 	var res []*Datacenter
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Datacenter", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Datacenter", "index")
 	if err != nil {
 		return res, err
@@ -2856,22 +4465,87 @@ func (loc *DatacenterLocator) Index(options rsapi.APIParams) ([]*Datacenter, err
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *DatacenterLocator) IndexEach(options *DatacenterIndexOptions, fn func(*Datacenter) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Datacenter", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Datacenter", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Datacenter
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// DatacenterShowOptions contains the optional parameters of DatacenterLocator.Show.
+type DatacenterShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *DatacenterShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of DatacenterLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/clouds/:cloud_id/datacenters/:id
@@ -2879,15 +4553,22 @@ func (loc *DatacenterLocator) Index(options rsapi.APIParams) ([]*Datacenter, err
 // Displays information about a single Datacenter.
 // Optional parameters:
 // view
-func (loc *DatacenterLocator) Show(options rsapi.APIParams) (*Datacenter, error) {
+func (loc *DatacenterLocator) Show(options *DatacenterShowOptions) (*Datacenter, error) {
 	var res *Datacenter
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Datacenter", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Datacenter", "show")
 	if err != nil {
 		return res, err
@@ -2900,21 +4581,11 @@ func (loc *DatacenterLocator) Show(options rsapi.APIParams) (*Datacenter, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -2941,34 +4612,139 @@ func (r *Deployment) Locator(api *API) *DeploymentLocator {
 	return nil
 }
 
-//===== Locator
+// AlertsLocator returns a locator for the resource pointed to by the "alerts" link.
+// It returns nil if the link is absent.
+func (r *Deployment) AlertsLocator(api *API) *AlertLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "alerts" {
+			return api.AlertLocator(l["href"])
+		}
+	}
+	return nil
+}
 
-// DeploymentLocator exposes the Deployment resource actions.
-type DeploymentLocator struct {
-	Href
-	api *API
+// InputsLocator returns a locator for the resource pointed to by the "inputs" link.
+// It returns nil if the link is absent.
+func (r *Deployment) InputsLocator(api *API) *InputLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "inputs" {
+			return api.InputLocator(l["href"])
+		}
+	}
+	return nil
 }
 
-// DeploymentLocator builds a locator from the given href.
+// LockUserLocator returns a locator for the resource pointed to by the "lock_user" link.
+// It returns nil if the link is absent.
+func (r *Deployment) LockUserLocator(api *API) *UserLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "lock_user" {
+			return api.UserLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ServerArraysLocator returns a locator for the resource pointed to by the "server_arrays" link.
+// It returns nil if the link is absent.
+func (r *Deployment) ServerArraysLocator(api *API) *ServerArrayLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "server_arrays" {
+			return api.ServerArrayLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ServersLocator returns a locator for the resource pointed to by the "servers" link.
+// It returns nil if the link is absent.
+func (r *Deployment) ServersLocator(api *API) *ServerLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "servers" {
+			return api.ServerLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+//===== Locator
+
+// DeploymentLocator exposes the Deployment resource actions.
+type DeploymentLocator struct {
+	Href
+	api *API
+}
+
+// DeploymentLocator builds a locator from the given href.
 func (api *API) DeploymentLocator(href string) *DeploymentLocator {
 	return &DeploymentLocator{Href(href), api}
 }
 
+// DeploymentLocatorInterface lists the DeploymentLocator methods, code that depends on the interface
+// rather than on DeploymentLocator can be unit tested using the generated fake locators.
+type DeploymentLocatorInterface interface {
+	Clone(options *DeploymentCloneOptions) error
+	Create(deployment *DeploymentParam) (*DeploymentLocator, error)
+	Destroy() error
+	Index(options *DeploymentIndexOptions) ([]*Deployment, error)
+	IndexEach(options *DeploymentIndexOptions, fn func(*Deployment) error) error
+	Lock() error
+	Servers() error
+	Show(options *DeploymentShowOptions) (*Deployment, error)
+	Unlock() error
+	Update(deployment *DeploymentParam) error
+}
+
 //===== Actions
 
+// DeploymentCloneOptions contains the optional parameters of DeploymentLocator.Clone.
+type DeploymentCloneOptions struct {
+	Deployment *DeploymentParam
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *DeploymentCloneOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "deployment":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of DeploymentLocator.Clone", n)
+		}
+	}
+	if o.Deployment != nil {
+		p["deployment"] = o.Deployment
+	}
+	return p, nil
+}
+
 // POST /api/deployments/:id/clone
 //
 // Clones a given deployment.
 // Optional parameters:
 // deployment
-func (loc *DeploymentLocator) Clone(options rsapi.APIParams) error {
+func (loc *DeploymentLocator) Clone(options *DeploymentCloneOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var deploymentOpt = options["deployment"]
+	var deploymentOpt = opts["deployment"]
 	if deploymentOpt != nil {
 		p["deployment"] = deploymentOpt
 	}
+	if err := loc.api.ValidateParams("Deployment", "clone", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Deployment", "clone")
 	if err != nil {
 		return err
@@ -2981,15 +4757,10 @@ func (loc *DeploymentLocator) Clone(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -3008,6 +4779,9 @@ func (loc *DeploymentLocator) Create(deployment *DeploymentParam) (*DeploymentLo
 	p = rsapi.APIParams{
 		"deployment": deployment,
 	}
+	if err := loc.api.ValidateParams("Deployment", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Deployment", "create")
 	if err != nil {
 		return res, err
@@ -3020,15 +4794,10 @@ func (loc *DeploymentLocator) Create(deployment *DeploymentParam) (*DeploymentLo
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -3043,6 +4812,9 @@ func (loc *DeploymentLocator) Create(deployment *DeploymentParam) (*DeploymentLo
 func (loc *DeploymentLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Deployment", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Deployment", "destroy")
 	if err != nil {
 		return err
@@ -3055,18 +4827,45 @@ func (loc *DeploymentLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// DeploymentIndexOptions contains the optional parameters of DeploymentLocator.Index.
+type DeploymentIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *DeploymentIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of DeploymentLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/deployments
 //
 // Lists deployments of the account.
@@ -3076,19 +4875,26 @@ func (loc *DeploymentLocator) Destroy() error {
 // Optional parameters:
 // filter
 // view
-func (loc *DeploymentLocator) Index(options rsapi.APIParams) ([]*Deployment, error) {
+func (loc *DeploymentLocator) Index(options *DeploymentIndexOptions) ([]*Deployment, error) {
 	var res []*Deployment
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Deployment", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Deployment", "index")
 	if err != nil {
 		return res, err
@@ -3101,22 +4907,59 @@ func (loc *DeploymentLocator) Index(options rsapi.APIParams) ([]*Deployment, err
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *DeploymentLocator) IndexEach(options *DeploymentIndexOptions, fn func(*Deployment) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Deployment", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Deployment", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Deployment
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // POST /api/deployments/:id/lock
@@ -3127,6 +4970,9 @@ func (loc *DeploymentLocator) Index(options rsapi.APIParams) ([]*Deployment, err
 func (loc *DeploymentLocator) Lock() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Deployment", "lock", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Deployment", "lock")
 	if err != nil {
 		return err
@@ -3139,15 +4985,10 @@ func (loc *DeploymentLocator) Lock() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -3158,6 +4999,9 @@ func (loc *DeploymentLocator) Lock() error {
 func (loc *DeploymentLocator) Servers() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Deployment", "servers", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Deployment", "servers")
 	if err != nil {
 		return err
@@ -3170,18 +5014,41 @@ func (loc *DeploymentLocator) Servers() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// DeploymentShowOptions contains the optional parameters of DeploymentLocator.Show.
+type DeploymentShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *DeploymentShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of DeploymentLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/deployments/:id
 //
 // Lists the attributes of a given deployment.
@@ -3189,15 +5056,22 @@ func (loc *DeploymentLocator) Servers() error {
 // details please see Inputs#index.)
 // Optional parameters:
 // view
-func (loc *DeploymentLocator) Show(options rsapi.APIParams) (*Deployment, error) {
+func (loc *DeploymentLocator) Show(options *DeploymentShowOptions) (*Deployment, error) {
 	var res *Deployment
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Deployment", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Deployment", "show")
 	if err != nil {
 		return res, err
@@ -3210,21 +5084,11 @@ func (loc *DeploymentLocator) Show(options rsapi.APIParams) (*Deployment, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -3234,6 +5098,9 @@ func (loc *DeploymentLocator) Show(options rsapi.APIParams) (*Deployment, error)
 func (loc *DeploymentLocator) Unlock() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Deployment", "unlock", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Deployment", "unlock")
 	if err != nil {
 		return err
@@ -3246,15 +5113,10 @@ func (loc *DeploymentLocator) Unlock() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -3272,6 +5134,9 @@ func (loc *DeploymentLocator) Update(deployment *DeploymentParam) error {
 	p = rsapi.APIParams{
 		"deployment": deployment,
 	}
+	if err := loc.api.ValidateParams("Deployment", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Deployment", "update")
 	if err != nil {
 		return err
@@ -3284,15 +5149,10 @@ func (loc *DeploymentLocator) Update(deployment *DeploymentParam) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -3314,6 +5174,13 @@ func (api *API) HealthCheckLocator(href string) *HealthCheckLocator {
 	return &HealthCheckLocator{Href(href), api}
 }
 
+// HealthCheckLocatorInterface lists the HealthCheckLocator methods, code that depends on the interface
+// rather than on HealthCheckLocator can be unit tested using the generated fake locators.
+type HealthCheckLocatorInterface interface {
+	Index() ([]map[string]interface{}, error)
+	IndexEach(fn func(map[string]interface{}) error) error
+}
+
 //===== Actions
 
 // GET /api/health-check/
@@ -3323,6 +5190,9 @@ func (loc *HealthCheckLocator) Index() ([]map[string]interface{}, error) {
 	var res []map[string]interface{}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("HealthCheck", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("HealthCheck", "index")
 	if err != nil {
 		return res, err
@@ -3335,22 +5205,46 @@ func (loc *HealthCheckLocator) Index() ([]map[string]interface{}, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *HealthCheckLocator) IndexEach(fn func(map[string]interface{}) error) error {
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("HealthCheck", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("HealthCheck", "index")
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e map[string]interface{}
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 /******  IdentityProvider ******/
@@ -3360,11 +5254,11 @@ func (loc *HealthCheckLocator) Index() ([]map[string]interface{}, error) {
 // To register an Identity Provider, contact your account manager.
 type IdentityProvider struct {
 	Actions       []map[string]string `json:"actions,omitempty"`
-	CreatedAt     *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt     *rsapi.Time         `json:"created_at,omitempty"`
 	DiscoveryHint string              `json:"discovery_hint,omitempty"`
 	Links         []map[string]string `json:"links,omitempty"`
 	Name          string              `json:"name,omitempty"`
-	UpdatedAt     *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt     *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -3390,27 +5284,74 @@ func (api *API) IdentityProviderLocator(href string) *IdentityProviderLocator {
 	return &IdentityProviderLocator{Href(href), api}
 }
 
+// IdentityProviderLocatorInterface lists the IdentityProviderLocator methods, code that depends on the interface
+// rather than on IdentityProviderLocator can be unit tested using the generated fake locators.
+type IdentityProviderLocatorInterface interface {
+	Index(options *IdentityProviderIndexOptions) ([]*IdentityProvider, error)
+	IndexEach(options *IdentityProviderIndexOptions, fn func(*IdentityProvider) error) error
+	Show(options *IdentityProviderShowOptions) (*IdentityProvider, error)
+}
+
 //===== Actions
 
+// IdentityProviderIndexOptions contains the optional parameters of IdentityProviderLocator.Index.
+type IdentityProviderIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *IdentityProviderIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of IdentityProviderLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/identity_providers
 //
 // Lists the identity providers associated with this enterprise account.
 // Optional parameters:
 // filter
 // view
-func (loc *IdentityProviderLocator) Index(options rsapi.APIParams) ([]*IdentityProvider, error) {
+func (loc *IdentityProviderLocator) Index(options *IdentityProviderIndexOptions) ([]*IdentityProvider, error) {
 	var res []*IdentityProvider
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IdentityProvider", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("IdentityProvider", "index")
 	if err != nil {
 		return res, err
@@ -3423,22 +5364,87 @@ func (loc *IdentityProviderLocator) Index(options rsapi.APIParams) ([]*IdentityP
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *IdentityProviderLocator) IndexEach(options *IdentityProviderIndexOptions, fn func(*IdentityProvider) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IdentityProvider", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("IdentityProvider", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *IdentityProvider
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// IdentityProviderShowOptions contains the optional parameters of IdentityProviderLocator.Show.
+type IdentityProviderShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *IdentityProviderShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of IdentityProviderLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/identity_providers/:id
@@ -3446,15 +5452,22 @@ func (loc *IdentityProviderLocator) Index(options rsapi.APIParams) ([]*IdentityP
 // Show the specified identity provider, if associated with this enterprise account.
 // Optional parameters:
 // view
-func (loc *IdentityProviderLocator) Show(options rsapi.APIParams) (*IdentityProvider, error) {
+func (loc *IdentityProviderLocator) Show(options *IdentityProviderShowOptions) (*IdentityProvider, error) {
 	var res *IdentityProvider
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IdentityProvider", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("IdentityProvider", "show")
 	if err != nil {
 		return res, err
@@ -3467,21 +5480,11 @@ func (loc *IdentityProviderLocator) Show(options rsapi.APIParams) (*IdentityProv
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -3513,6 +5516,17 @@ func (r *Image) Locator(api *API) *ImageLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *Image) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // ImageLocator exposes the Image resource actions.
@@ -3526,7 +5540,47 @@ func (api *API) ImageLocator(href string) *ImageLocator {
 	return &ImageLocator{Href(href), api}
 }
 
-//===== Actions
+// ImageLocatorInterface lists the ImageLocator methods, code that depends on the interface
+// rather than on ImageLocator can be unit tested using the generated fake locators.
+type ImageLocatorInterface interface {
+	Index(options *ImageIndexOptions) ([]*Image, error)
+	IndexEach(options *ImageIndexOptions, fn func(*Image) error) error
+	Show(options *ImageShowOptions) (*Image, error)
+}
+
+//===== Actions
+
+// ImageIndexOptions contains the optional parameters of ImageLocator.Index.
+type ImageIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ImageIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ImageLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
 
 // GET /api/clouds/:cloud_id/images
 //
@@ -3534,19 +5588,26 @@ func (api *API) ImageLocator(href string) *ImageLocator {
 // Optional parameters:
 // filter
 // view
-func (loc *ImageLocator) Index(options rsapi.APIParams) ([]*Image, error) {
+func (loc *ImageLocator) Index(options *ImageIndexOptions) ([]*Image, error) {
 	var res []*Image
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Image", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Image", "index")
 	if err != nil {
 		return res, err
@@ -3559,22 +5620,87 @@ func (loc *ImageLocator) Index(options rsapi.APIParams) ([]*Image, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *ImageLocator) IndexEach(options *ImageIndexOptions, fn func(*Image) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Image", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Image", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Image
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// ImageShowOptions contains the optional parameters of ImageLocator.Show.
+type ImageShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ImageShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ImageLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/clouds/:cloud_id/images/:id
@@ -3582,15 +5708,22 @@ func (loc *ImageLocator) Index(options rsapi.APIParams) ([]*Image, error) {
 // Shows information about a single Image.
 // Optional parameters:
 // view
-func (loc *ImageLocator) Show(options rsapi.APIParams) (*Image, error) {
+func (loc *ImageLocator) Show(options *ImageShowOptions) (*Image, error) {
 	var res *Image
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Image", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Image", "show")
 	if err != nil {
 		return res, err
@@ -3603,21 +5736,11 @@ func (loc *ImageLocator) Show(options rsapi.APIParams) (*Image, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -3645,8 +5768,44 @@ func (api *API) InputLocator(href string) *InputLocator {
 	return &InputLocator{Href(href), api}
 }
 
+// InputLocatorInterface lists the InputLocator methods, code that depends on the interface
+// rather than on InputLocator can be unit tested using the generated fake locators.
+type InputLocatorInterface interface {
+	Index(options *InputIndexOptions) ([]*Input, error)
+	IndexEach(options *InputIndexOptions, fn func(*Input) error) error
+	MultiUpdate(inputs map[string]interface{}) error
+}
+
 //===== Actions
 
+// InputIndexOptions contains the optional parameters of InputLocator.Index.
+type InputIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InputIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InputLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/instances/:instance_id/inputs
 // GET /api/deployments/:deployment_id/inputs
 // GET /api/server_templates/:server_template_id/inputs
@@ -3654,15 +5813,22 @@ func (api *API) InputLocator(href string) *InputLocator {
 // Retrieves the full list of existing inputs of the specified resource.
 // Optional parameters:
 // view
-func (loc *InputLocator) Index(options rsapi.APIParams) ([]*Input, error) {
+func (loc *InputLocator) Index(options *InputIndexOptions) ([]*Input, error) {
 	var res []*Input
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Input", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Input", "index")
 	if err != nil {
 		return res, err
@@ -3675,22 +5841,55 @@ func (loc *InputLocator) Index(options rsapi.APIParams) ([]*Input, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *InputLocator) IndexEach(options *InputIndexOptions, fn func(*Input) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Input", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Input", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Input
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // PUT /api/clouds/:cloud_id/instances/:instance_id/inputs/multi_update
@@ -3771,6 +5970,9 @@ func (loc *InputLocator) MultiUpdate(inputs map[string]interface{}) error {
 	p = rsapi.APIParams{
 		"inputs": inputs,
 	}
+	if err := loc.api.ValidateParams("Input", "multi_update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Input", "multi_update")
 	if err != nil {
 		return err
@@ -3783,15 +5985,10 @@ func (loc *InputLocator) MultiUpdate(inputs map[string]interface{}) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -3808,7 +6005,7 @@ type Instance struct {
 	AdminPassword            string                 `json:"admin_password,omitempty"`
 	AssociatePublicIpAddress bool                   `json:"associate_public_ip_address,omitempty"`
 	CloudSpecificAttributes  map[string]interface{} `json:"cloud_specific_attributes,omitempty"`
-	CreatedAt                *RubyTime              `json:"created_at,omitempty"`
+	CreatedAt                *rsapi.Time            `json:"created_at,omitempty"`
 	Description              string                 `json:"description,omitempty"`
 	InheritedSources         []string               `json:"inherited_sources,omitempty"`
 	Inputs                   []map[string]string    `json:"inputs,omitempty"`
@@ -3828,8 +6025,8 @@ type Instance struct {
 	SecurityGroups           []SecurityGroup        `json:"security_groups,omitempty"`
 	State                    string                 `json:"state,omitempty"`
 	Subnets                  []Subnet               `json:"subnets,omitempty"`
-	TerminatedAt             *RubyTime              `json:"terminated_at,omitempty"`
-	UpdatedAt                *RubyTime              `json:"updated_at,omitempty"`
+	TerminatedAt             *rsapi.Time            `json:"terminated_at,omitempty"`
+	UpdatedAt                *rsapi.Time            `json:"updated_at,omitempty"`
 	UserData                 string                 `json:"user_data,omitempty"`
 }
 
@@ -3843,6 +6040,182 @@ func (r *Instance) Locator(api *API) *InstanceLocator {
 	return nil
 }
 
+// AlertsLocator returns a locator for the resource pointed to by the "alerts" link.
+// It returns nil if the link is absent.
+func (r *Instance) AlertsLocator(api *API) *AlertLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "alerts" {
+			return api.AlertLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *Instance) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// DatacenterLocator returns a locator for the resource pointed to by the "datacenter" link.
+// It returns nil if the link is absent.
+func (r *Instance) DatacenterLocator(api *API) *DatacenterLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "datacenter" {
+			return api.DatacenterLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// DeploymentLocator returns a locator for the resource pointed to by the "deployment" link.
+// It returns nil if the link is absent.
+func (r *Instance) DeploymentLocator(api *API) *DeploymentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "deployment" {
+			return api.DeploymentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ImageLocator returns a locator for the resource pointed to by the "image" link.
+// It returns nil if the link is absent.
+func (r *Instance) ImageLocator(api *API) *ImageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "image" {
+			return api.ImageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// InputsLocator returns a locator for the resource pointed to by the "inputs" link.
+// It returns nil if the link is absent.
+func (r *Instance) InputsLocator(api *API) *InputLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "inputs" {
+			return api.InputLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// InstanceTypeLocator returns a locator for the resource pointed to by the "instance_type" link.
+// It returns nil if the link is absent.
+func (r *Instance) InstanceTypeLocator(api *API) *InstanceTypeLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "instance_type" {
+			return api.InstanceTypeLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// KernelImageLocator returns a locator for the resource pointed to by the "kernel_image" link.
+// It returns nil if the link is absent.
+func (r *Instance) KernelImageLocator(api *API) *ImageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "kernel_image" {
+			return api.ImageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// LockUserLocator returns a locator for the resource pointed to by the "lock_user" link.
+// It returns nil if the link is absent.
+func (r *Instance) LockUserLocator(api *API) *UserLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "lock_user" {
+			return api.UserLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// MonitoringMetricsLocator returns a locator for the resource pointed to by the "monitoring_metrics" link.
+// It returns nil if the link is absent.
+func (r *Instance) MonitoringMetricsLocator(api *API) *MonitoringMetricLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "monitoring_metrics" {
+			return api.MonitoringMetricLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// MultiCloudImageLocator returns a locator for the resource pointed to by the "multi_cloud_image" link.
+// It returns nil if the link is absent.
+func (r *Instance) MultiCloudImageLocator(api *API) *MultiCloudImageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "multi_cloud_image" {
+			return api.MultiCloudImageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// PlacementGroupLocator returns a locator for the resource pointed to by the "placement_group" link.
+// It returns nil if the link is absent.
+func (r *Instance) PlacementGroupLocator(api *API) *PlacementGroupLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "placement_group" {
+			return api.PlacementGroupLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RamdiskImageLocator returns a locator for the resource pointed to by the "ramdisk_image" link.
+// It returns nil if the link is absent.
+func (r *Instance) RamdiskImageLocator(api *API) *ImageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "ramdisk_image" {
+			return api.ImageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ServerTemplateLocator returns a locator for the resource pointed to by the "server_template" link.
+// It returns nil if the link is absent.
+func (r *Instance) ServerTemplateLocator(api *API) *ServerTemplateLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "server_template" {
+			return api.ServerTemplateLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// SshKeyLocator returns a locator for the resource pointed to by the "ssh_key" link.
+// It returns nil if the link is absent.
+func (r *Instance) SshKeyLocator(api *API) *SshKeyLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "ssh_key" {
+			return api.SshKeyLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// VolumeAttachmentsLocator returns a locator for the resource pointed to by the "volume_attachments" link.
+// It returns nil if the link is absent.
+func (r *Instance) VolumeAttachmentsLocator(api *API) *VolumeAttachmentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "volume_attachments" {
+			return api.VolumeAttachmentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // InstanceLocator exposes the Instance resource actions.
@@ -3856,8 +6229,58 @@ func (api *API) InstanceLocator(href string) *InstanceLocator {
 	return &InstanceLocator{Href(href), api}
 }
 
+// InstanceLocatorInterface lists the InstanceLocator methods, code that depends on the interface
+// rather than on InstanceLocator can be unit tested using the generated fake locators.
+type InstanceLocatorInterface interface {
+	Create(instance *InstanceParam, options *InstanceCreateOptions) (*InstanceLocator, error)
+	Index(options *InstanceIndexOptions) ([]*Instance, error)
+	IndexEach(options *InstanceIndexOptions, fn func(*Instance) error) error
+	Launch(options *InstanceLaunchOptions) error
+	Lock() error
+	MultiRunExecutable(options *InstanceMultiRunExecutableOptions) error
+	MultiTerminate(options *InstanceMultiTerminateOptions) error
+	Reboot() error
+	RunExecutable(options *InstanceRunExecutableOptions) error
+	SetCustomLodgement(quantity []*Quantity, timeframe string) error
+	Show(options *InstanceShowOptions) (*Instance, error)
+	Start() error
+	Stop() error
+	Terminate() error
+	Unlock() error
+	Update(instance *InstanceParam2) error
+}
+
 //===== Actions
 
+// InstanceCreateOptions contains the optional parameters of InstanceLocator.Create.
+type InstanceCreateOptions struct {
+	// When set to 'async', an instance resource will be returned immediately and processing will be handled in the background. Errors will not be returned and must be checked through the instance's audit entries. Default value is 'sync'
+	ApiBehavior string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "api_behavior":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.Create", n)
+		}
+	}
+	if o.ApiBehavior != "" {
+		p["api_behavior"] = o.ApiBehavior
+	}
+	return p, nil
+}
+
 // POST /api/clouds/:cloud_id/instances
 //
 // Creates and launches a raw instance using the provided parameters.
@@ -3865,20 +6288,27 @@ func (api *API) InstanceLocator(href string) *InstanceLocator {
 // instance
 // Optional parameters:
 // api_behavior: When set to 'async', an instance resource will be returned immediately and processing will be handled in the background. Errors will not be returned and must be checked through the instance's audit entries. Default value is 'sync'
-func (loc *InstanceLocator) Create(instance *InstanceParam, options rsapi.APIParams) (*InstanceLocator, error) {
+func (loc *InstanceLocator) Create(instance *InstanceParam, options *InstanceCreateOptions) (*InstanceLocator, error) {
 	var res *InstanceLocator
 	if instance == nil {
 		return res, fmt.Errorf("instance is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"instance": instance,
 	}
-	var apiBehaviorOpt = options["api_behavior"]
+	var apiBehaviorOpt = opts["api_behavior"]
 	if apiBehaviorOpt != nil {
 		p["api_behavior"] = apiBehaviorOpt
 	}
+	if err := loc.api.ValidateParams("Instance", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Instance", "create")
 	if err != nil {
 		return res, err
@@ -3891,15 +6321,10 @@ func (loc *InstanceLocator) Create(instance *InstanceParam, options rsapi.APIPar
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -3908,8 +6333,40 @@ func (loc *InstanceLocator) Create(instance *InstanceParam, options rsapi.APIPar
 	}
 }
 
-// GET /api/clouds/:cloud_id/instances
-// GET /api/clouds/:cloud_id/instances
+// InstanceIndexOptions contains the optional parameters of InstanceLocator.Index.
+type InstanceIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
+// GET /api/clouds/:cloud_id/instances
+// GET /api/clouds/:cloud_id/instances
 // GET /api/server_arrays/:server_array_id/current_instances
 //
 // Lists instances of a given cloud, server array.
@@ -3925,19 +6382,26 @@ func (loc *InstanceLocator) Create(instance *InstanceParam, options rsapi.APIPar
 // Optional parameters:
 // filter
 // view
-func (loc *InstanceLocator) Index(options rsapi.APIParams) ([]*Instance, error) {
+func (loc *InstanceLocator) Index(options *InstanceIndexOptions) ([]*Instance, error) {
 	var res []*Instance
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Instance", "index")
 	if err != nil {
 		return res, err
@@ -3950,22 +6414,97 @@ func (loc *InstanceLocator) Index(options rsapi.APIParams) ([]*Instance, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *InstanceLocator) IndexEach(options *InstanceIndexOptions, fn func(*Instance) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Instance", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Instance
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// InstanceLaunchOptions contains the optional parameters of InstanceLocator.Launch.
+type InstanceLaunchOptions struct {
+	// When set to 'async', an instance resource will be returned immediately and processing will be handled in the background. Errors will not be returned and must be checked through the instance's audit entries. Default value is 'sync'
+	ApiBehavior string
+	// For Server Arrays, will launch the specified number of instances into the ServerArray. Attempting to call this action on non-server array objects will result in a parameter error
+	Count  string
+	Inputs map[string]interface{}
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceLaunchOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "api_behavior", "count", "inputs":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.Launch", n)
+		}
+	}
+	if o.ApiBehavior != "" {
+		p["api_behavior"] = o.ApiBehavior
+	}
+	if o.Count != "" {
+		p["count"] = o.Count
+	}
+	if o.Inputs != nil {
+		p["inputs"] = o.Inputs
+	}
+	return p, nil
 }
 
 // POST /api/clouds/:cloud_id/instances/:id/launch
@@ -3978,22 +6517,29 @@ func (loc *InstanceLocator) Index(options rsapi.APIParams) ([]*Instance, error)
 // api_behavior: When set to 'async', an instance resource will be returned immediately and processing will be handled in the background. Errors will not be returned and must be checked through the instance's audit entries. Default value is 'sync'
 // count: For Server Arrays, will launch the specified number of instances into the ServerArray. Attempting to call this action on non-server array objects will result in a parameter error
 // inputs
-func (loc *InstanceLocator) Launch(options rsapi.APIParams) error {
+func (loc *InstanceLocator) Launch(options *InstanceLaunchOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var apiBehaviorOpt = options["api_behavior"]
+	var apiBehaviorOpt = opts["api_behavior"]
 	if apiBehaviorOpt != nil {
 		p["api_behavior"] = apiBehaviorOpt
 	}
-	var countOpt = options["count"]
+	var countOpt = opts["count"]
 	if countOpt != nil {
 		p["count"] = countOpt
 	}
-	var inputsOpt = options["inputs"]
+	var inputsOpt = opts["inputs"]
 	if inputsOpt != nil {
 		p["inputs"] = inputsOpt
 	}
+	if err := loc.api.ValidateParams("Instance", "launch", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "launch")
 	if err != nil {
 		return err
@@ -4006,15 +6552,10 @@ func (loc *InstanceLocator) Launch(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -4024,6 +6565,9 @@ func (loc *InstanceLocator) Launch(options rsapi.APIParams) error {
 func (loc *InstanceLocator) Lock() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "lock", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "lock")
 	if err != nil {
 		return err
@@ -4036,18 +6580,60 @@ func (loc *InstanceLocator) Lock() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// InstanceMultiRunExecutableOptions contains the optional parameters of InstanceLocator.MultiRunExecutable.
+type InstanceMultiRunExecutableOptions struct {
+	Filter []string
+	// Specifies the ability to ignore the lock(s) on the Instance(s).
+	IgnoreLock string
+	Inputs     map[string]interface{}
+	// The name of the recipe to be run.
+	RecipeName string
+	// The href of the RightScript to run. Should be of the form '/api/right_scripts/:id'.
+	RightScriptHref string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceMultiRunExecutableOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "ignore_lock", "inputs", "recipe_name", "right_script_href":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.MultiRunExecutable", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.IgnoreLock != "" {
+		p["ignore_lock"] = o.IgnoreLock
+	}
+	if o.Inputs != nil {
+		p["inputs"] = o.Inputs
+	}
+	if o.RecipeName != "" {
+		p["recipe_name"] = o.RecipeName
+	}
+	if o.RightScriptHref != "" {
+		p["right_script_href"] = o.RightScriptHref
+	}
+	return p, nil
+}
+
 // POST /api/clouds/:cloud_id/instances/multi_run_executable
 // POST /api/server_arrays/:server_array_id/multi_run_executable
 //
@@ -4060,31 +6646,38 @@ func (loc *InstanceLocator) Lock() error {
 // inputs
 // recipe_name: The name of the recipe to be run.
 // right_script_href: The href of the RightScript to run. Should be of the form '/api/right_scripts/:id'.
-func (loc *InstanceLocator) MultiRunExecutable(options rsapi.APIParams) error {
+func (loc *InstanceLocator) MultiRunExecutable(options *InstanceMultiRunExecutableOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var ignoreLockOpt = options["ignore_lock"]
+	var ignoreLockOpt = opts["ignore_lock"]
 	if ignoreLockOpt != nil {
 		p["ignore_lock"] = ignoreLockOpt
 	}
-	var inputsOpt = options["inputs"]
+	var inputsOpt = opts["inputs"]
 	if inputsOpt != nil {
 		p["inputs"] = inputsOpt
 	}
-	var recipeNameOpt = options["recipe_name"]
+	var recipeNameOpt = opts["recipe_name"]
 	if recipeNameOpt != nil {
 		p["recipe_name"] = recipeNameOpt
 	}
-	var rightScriptHrefOpt = options["right_script_href"]
+	var rightScriptHrefOpt = opts["right_script_href"]
 	if rightScriptHrefOpt != nil {
 		p["right_script_href"] = rightScriptHrefOpt
 	}
+	if err := loc.api.ValidateParams("Instance", "multi_run_executable", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "multi_run_executable")
 	if err != nil {
 		return err
@@ -4097,18 +6690,46 @@ func (loc *InstanceLocator) MultiRunExecutable(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// InstanceMultiTerminateOptions contains the optional parameters of InstanceLocator.MultiTerminate.
+type InstanceMultiTerminateOptions struct {
+	Filter []string
+	// Specifies the ability to terminate all instances.
+	TerminateAll string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceMultiTerminateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "terminate_all":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.MultiTerminate", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.TerminateAll != "" {
+		p["terminate_all"] = o.TerminateAll
+	}
+	return p, nil
+}
+
 // POST /api/clouds/:cloud_id/instances/multi_terminate
 // POST /api/server_arrays/:server_array_id/multi_terminate
 //
@@ -4117,19 +6738,26 @@ func (loc *InstanceLocator) MultiRunExecutable(options rsapi.APIParams) error {
 // Optional parameters:
 // filter
 // terminate_all: Specifies the ability to terminate all instances.
-func (loc *InstanceLocator) MultiTerminate(options rsapi.APIParams) error {
+func (loc *InstanceLocator) MultiTerminate(options *InstanceMultiTerminateOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var terminateAllOpt = options["terminate_all"]
+	var terminateAllOpt = opts["terminate_all"]
 	if terminateAllOpt != nil {
 		p["terminate_all"] = terminateAllOpt
 	}
+	if err := loc.api.ValidateParams("Instance", "multi_terminate", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "multi_terminate")
 	if err != nil {
 		return err
@@ -4142,15 +6770,10 @@ func (loc *InstanceLocator) MultiTerminate(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -4162,6 +6785,9 @@ func (loc *InstanceLocator) MultiTerminate(options rsapi.APIParams) error {
 func (loc *InstanceLocator) Reboot() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "reboot", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "reboot")
 	if err != nil {
 		return err
@@ -4174,18 +6800,56 @@ func (loc *InstanceLocator) Reboot() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// InstanceRunExecutableOptions contains the optional parameters of InstanceLocator.RunExecutable.
+type InstanceRunExecutableOptions struct {
+	// Specifies the ability to ignore the lock on the Instance.
+	IgnoreLock string
+	Inputs     map[string]interface{}
+	// The name of the recipe to run.
+	RecipeName string
+	// The href of the RightScript to run. Should be of the form '/api/right_scripts/:id'.
+	RightScriptHref string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceRunExecutableOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "ignore_lock", "inputs", "recipe_name", "right_script_href":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.RunExecutable", n)
+		}
+	}
+	if o.IgnoreLock != "" {
+		p["ignore_lock"] = o.IgnoreLock
+	}
+	if o.Inputs != nil {
+		p["inputs"] = o.Inputs
+	}
+	if o.RecipeName != "" {
+		p["recipe_name"] = o.RecipeName
+	}
+	if o.RightScriptHref != "" {
+		p["right_script_href"] = o.RightScriptHref
+	}
+	return p, nil
+}
+
 // POST /api/clouds/:cloud_id/instances/:id/run_executable
 //
 // Runs a script or a recipe in the running instance.
@@ -4197,26 +6861,33 @@ func (loc *InstanceLocator) Reboot() error {
 // inputs
 // recipe_name: The name of the recipe to run.
 // right_script_href: The href of the RightScript to run. Should be of the form '/api/right_scripts/:id'.
-func (loc *InstanceLocator) RunExecutable(options rsapi.APIParams) error {
+func (loc *InstanceLocator) RunExecutable(options *InstanceRunExecutableOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var ignoreLockOpt = options["ignore_lock"]
+	var ignoreLockOpt = opts["ignore_lock"]
 	if ignoreLockOpt != nil {
 		p["ignore_lock"] = ignoreLockOpt
 	}
-	var inputsOpt = options["inputs"]
+	var inputsOpt = opts["inputs"]
 	if inputsOpt != nil {
 		p["inputs"] = inputsOpt
 	}
-	var recipeNameOpt = options["recipe_name"]
+	var recipeNameOpt = opts["recipe_name"]
 	if recipeNameOpt != nil {
 		p["recipe_name"] = recipeNameOpt
 	}
-	var rightScriptHrefOpt = options["right_script_href"]
+	var rightScriptHrefOpt = opts["right_script_href"]
 	if rightScriptHrefOpt != nil {
 		p["right_script_href"] = rightScriptHrefOpt
 	}
+	if err := loc.api.ValidateParams("Instance", "run_executable", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "run_executable")
 	if err != nil {
 		return err
@@ -4229,15 +6900,10 @@ func (loc *InstanceLocator) RunExecutable(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -4260,6 +6926,9 @@ func (loc *InstanceLocator) SetCustomLodgement(quantity []*Quantity, timeframe s
 		"quantity":  quantity,
 		"timeframe": timeframe,
 	}
+	if err := loc.api.ValidateParams("Instance", "set_custom_lodgement", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "set_custom_lodgement")
 	if err != nil {
 		return err
@@ -4272,18 +6941,41 @@ func (loc *InstanceLocator) SetCustomLodgement(quantity []*Quantity, timeframe s
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// InstanceShowOptions contains the optional parameters of InstanceLocator.Show.
+type InstanceShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/instances/:id
 //
 // Shows attributes of a single instance.
@@ -4291,15 +6983,22 @@ func (loc *InstanceLocator) SetCustomLodgement(quantity []*Quantity, timeframe s
 // details please see Inputs#index.)
 // Optional parameters:
 // view
-func (loc *InstanceLocator) Show(options rsapi.APIParams) (*Instance, error) {
+func (loc *InstanceLocator) Show(options *InstanceShowOptions) (*Instance, error) {
 	var res *Instance
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Instance", "show")
 	if err != nil {
 		return res, err
@@ -4312,21 +7011,11 @@ func (loc *InstanceLocator) Show(options rsapi.APIParams) (*Instance, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -4340,6 +7029,9 @@ func (loc *InstanceLocator) Show(options rsapi.APIParams) (*Instance, error) {
 func (loc *InstanceLocator) Start() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "start", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "start")
 	if err != nil {
 		return err
@@ -4352,15 +7044,10 @@ func (loc *InstanceLocator) Start() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -4373,6 +7060,9 @@ func (loc *InstanceLocator) Start() error {
 func (loc *InstanceLocator) Stop() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "stop", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "stop")
 	if err != nil {
 		return err
@@ -4385,15 +7075,10 @@ func (loc *InstanceLocator) Stop() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -4405,6 +7090,9 @@ func (loc *InstanceLocator) Stop() error {
 func (loc *InstanceLocator) Terminate() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "terminate", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "terminate")
 	if err != nil {
 		return err
@@ -4417,15 +7105,10 @@ func (loc *InstanceLocator) Terminate() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -4435,6 +7118,9 @@ func (loc *InstanceLocator) Terminate() error {
 func (loc *InstanceLocator) Unlock() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "unlock", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "unlock")
 	if err != nil {
 		return err
@@ -4447,15 +7133,10 @@ func (loc *InstanceLocator) Unlock() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -4473,6 +7154,9 @@ func (loc *InstanceLocator) Update(instance *InstanceParam2) error {
 	p = rsapi.APIParams{
 		"instance": instance,
 	}
+	if err := loc.api.ValidateParams("Instance", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "update")
 	if err != nil {
 		return err
@@ -4485,15 +7169,10 @@ func (loc *InstanceLocator) Update(instance *InstanceParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -4503,18 +7182,18 @@ func (loc *InstanceLocator) Update(instance *InstanceParam2) error {
 type InstanceCustomLodgement struct {
 	AccountOwner                         string                   `json:"account_owner,omitempty"`
 	Actions                              []map[string]string      `json:"actions,omitempty"`
-	EndAt                                *RubyTime                `json:"end_at,omitempty"`
+	EndAt                                *rsapi.Time              `json:"end_at,omitempty"`
 	Links                                []map[string]string      `json:"links,omitempty"`
 	Quantity                             []map[string]interface{} `json:"quantity,omitempty"`
-	ResourceBillingEndAt                 *RubyTime                `json:"resource_billing_end_at,omitempty"`
-	ResourceBillingStartAt               *RubyTime                `json:"resource_billing_start_at,omitempty"`
+	ResourceBillingEndAt                 *rsapi.Time              `json:"resource_billing_end_at,omitempty"`
+	ResourceBillingStartAt               *rsapi.Time              `json:"resource_billing_start_at,omitempty"`
 	ResourceInstanceType                 string                   `json:"resource_instance_type,omitempty"`
 	ResourceLaunchedBy                   string                   `json:"resource_launched_by,omitempty"`
 	ResourceTemplateLibraryHref          string                   `json:"resource_template_library_href,omitempty"`
 	ResourceTemplateName                 string                   `json:"resource_template_name,omitempty"`
 	ResourceTemplatePublishedByAccountId string                   `json:"resource_template_published_by_account_id,omitempty"`
 	ResourceUid                          string                   `json:"resource_uid,omitempty"`
-	StartAt                              *RubyTime                `json:"start_at,omitempty"`
+	StartAt                              *rsapi.Time              `json:"start_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -4527,6 +7206,61 @@ func (r *InstanceCustomLodgement) Locator(api *API) *InstanceCustomLodgementLoca
 	return nil
 }
 
+// AccountLocator returns a locator for the resource pointed to by the "account" link.
+// It returns nil if the link is absent.
+func (r *InstanceCustomLodgement) AccountLocator(api *API) *AccountLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "account" {
+			return api.AccountLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *InstanceCustomLodgement) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// DeploymentLocator returns a locator for the resource pointed to by the "deployment" link.
+// It returns nil if the link is absent.
+func (r *InstanceCustomLodgement) DeploymentLocator(api *API) *DeploymentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "deployment" {
+			return api.DeploymentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// InstanceLocator returns a locator for the resource pointed to by the "instance" link.
+// It returns nil if the link is absent.
+func (r *InstanceCustomLodgement) InstanceLocator(api *API) *InstanceLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "instance" {
+			return api.InstanceLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ServerTemplateLocator returns a locator for the resource pointed to by the "server_template" link.
+// It returns nil if the link is absent.
+func (r *InstanceCustomLodgement) ServerTemplateLocator(api *API) *ServerTemplateLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "server_template" {
+			return api.ServerTemplateLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // InstanceCustomLodgementLocator exposes the InstanceCustomLodgement resource actions.
@@ -4540,6 +7274,17 @@ func (api *API) InstanceCustomLodgementLocator(href string) *InstanceCustomLodge
 	return &InstanceCustomLodgementLocator{Href(href), api}
 }
 
+// InstanceCustomLodgementLocatorInterface lists the InstanceCustomLodgementLocator methods, code that depends on the interface
+// rather than on InstanceCustomLodgementLocator can be unit tested using the generated fake locators.
+type InstanceCustomLodgementLocatorInterface interface {
+	Create(quantity []*Quantity, timeframe string) (*InstanceCustomLodgementLocator, error)
+	Destroy() error
+	Index(options *InstanceCustomLodgementIndexOptions) ([]*InstanceCustomLodgement, error)
+	IndexEach(options *InstanceCustomLodgementIndexOptions, fn func(*InstanceCustomLodgement) error) error
+	Show() (*InstanceCustomLodgement, error)
+	Update(quantity []*Quantity) error
+}
+
 //===== Actions
 
 // POST /api/clouds/:cloud_id/instances/:instance_id/instance_custom_lodgements
@@ -4562,6 +7307,9 @@ func (loc *InstanceCustomLodgementLocator) Create(quantity []*Quantity, timefram
 		"quantity":  quantity,
 		"timeframe": timeframe,
 	}
+	if err := loc.api.ValidateParams("InstanceCustomLodgement", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceCustomLodgement", "create")
 	if err != nil {
 		return res, err
@@ -4574,15 +7322,10 @@ func (loc *InstanceCustomLodgementLocator) Create(quantity []*Quantity, timefram
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -4597,6 +7340,9 @@ func (loc *InstanceCustomLodgementLocator) Create(quantity []*Quantity, timefram
 func (loc *InstanceCustomLodgementLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceCustomLodgement", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("InstanceCustomLodgement", "destroy")
 	if err != nil {
 		return err
@@ -4609,32 +7355,62 @@ func (loc *InstanceCustomLodgementLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// InstanceCustomLodgementIndexOptions contains the optional parameters of InstanceCustomLodgementLocator.Index.
+type InstanceCustomLodgementIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceCustomLodgementIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceCustomLodgementLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/instances/:instance_id/instance_custom_lodgements
 //
 // List InstanceCustomLodgements of a given cloud and instance.
 // Optional parameters:
 // view
-func (loc *InstanceCustomLodgementLocator) Index(options rsapi.APIParams) ([]*InstanceCustomLodgement, error) {
+func (loc *InstanceCustomLodgementLocator) Index(options *InstanceCustomLodgementIndexOptions) ([]*InstanceCustomLodgement, error) {
 	var res []*InstanceCustomLodgement
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceCustomLodgement", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceCustomLodgement", "index")
 	if err != nil {
 		return res, err
@@ -4647,31 +7423,67 @@ func (loc *InstanceCustomLodgementLocator) Index(options rsapi.APIParams) ([]*In
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
-// GET /api/clouds/:cloud_id/instances/:instance_id/instance_custom_lodgements/:id
-//
-// Show the specified lodgement.
-func (loc *InstanceCustomLodgementLocator) Show() (*InstanceCustomLodgement, error) {
-	var res *InstanceCustomLodgement
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *InstanceCustomLodgementLocator) IndexEach(options *InstanceCustomLodgementIndexOptions, fn func(*InstanceCustomLodgement) error) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceCustomLodgement", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("InstanceCustomLodgement", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *InstanceCustomLodgement
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// GET /api/clouds/:cloud_id/instances/:instance_id/instance_custom_lodgements/:id
+//
+// Show the specified lodgement.
+func (loc *InstanceCustomLodgementLocator) Show() (*InstanceCustomLodgement, error) {
+	var res *InstanceCustomLodgement
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceCustomLodgement", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceCustomLodgement", "show")
 	if err != nil {
 		return res, err
@@ -4684,21 +7496,11 @@ func (loc *InstanceCustomLodgementLocator) Show() (*InstanceCustomLodgement, err
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -4716,6 +7518,9 @@ func (loc *InstanceCustomLodgementLocator) Update(quantity []*Quantity) error {
 	p = rsapi.APIParams{
 		"quantity": quantity,
 	}
+	if err := loc.api.ValidateParams("InstanceCustomLodgement", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("InstanceCustomLodgement", "update")
 	if err != nil {
 		return err
@@ -4728,15 +7533,10 @@ func (loc *InstanceCustomLodgementLocator) Update(quantity []*Quantity) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -4766,6 +7566,17 @@ func (r *InstanceType) Locator(api *API) *InstanceTypeLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *InstanceType) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // InstanceTypeLocator exposes the InstanceType resource actions.
@@ -4779,27 +7590,74 @@ func (api *API) InstanceTypeLocator(href string) *InstanceTypeLocator {
 	return &InstanceTypeLocator{Href(href), api}
 }
 
+// InstanceTypeLocatorInterface lists the InstanceTypeLocator methods, code that depends on the interface
+// rather than on InstanceTypeLocator can be unit tested using the generated fake locators.
+type InstanceTypeLocatorInterface interface {
+	Index(options *InstanceTypeIndexOptions) ([]*InstanceType, error)
+	IndexEach(options *InstanceTypeIndexOptions, fn func(*InstanceType) error) error
+	Show(options *InstanceTypeShowOptions) (*InstanceType, error)
+}
+
 //===== Actions
 
+// InstanceTypeIndexOptions contains the optional parameters of InstanceTypeLocator.Index.
+type InstanceTypeIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceTypeIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceTypeLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/instance_types
 //
 // Lists instance types.
 // Optional parameters:
 // filter
 // view
-func (loc *InstanceTypeLocator) Index(options rsapi.APIParams) ([]*InstanceType, error) {
+func (loc *InstanceTypeLocator) Index(options *InstanceTypeIndexOptions) ([]*InstanceType, error) {
 	var res []*InstanceType
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceType", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceType", "index")
 	if err != nil {
 		return res, err
@@ -4812,22 +7670,87 @@ func (loc *InstanceTypeLocator) Index(options rsapi.APIParams) ([]*InstanceType,
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *InstanceTypeLocator) IndexEach(options *InstanceTypeIndexOptions, fn func(*InstanceType) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceType", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("InstanceType", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *InstanceType
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// InstanceTypeShowOptions contains the optional parameters of InstanceTypeLocator.Show.
+type InstanceTypeShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceTypeShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceTypeLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/clouds/:cloud_id/instance_types/:id
@@ -4835,15 +7758,22 @@ func (loc *InstanceTypeLocator) Index(options rsapi.APIParams) ([]*InstanceType,
 // Displays information about a single Instance type.
 // Optional parameters:
 // view
-func (loc *InstanceTypeLocator) Show(options rsapi.APIParams) (*InstanceType, error) {
+func (loc *InstanceTypeLocator) Show(options *InstanceTypeShowOptions) (*InstanceType, error) {
 	var res *InstanceType
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceType", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("InstanceType", "show")
 	if err != nil {
 		return res, err
@@ -4856,21 +7786,11 @@ func (loc *InstanceTypeLocator) Show(options rsapi.APIParams) (*InstanceType, er
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -4879,11 +7799,11 @@ func (loc *InstanceTypeLocator) Show(options rsapi.APIParams) (*InstanceType, er
 // An IpAddress provides an abstraction for IPv4 addresses bindable to Instance resources running in a Cloud.
 type IpAddress struct {
 	Address   string              `json:"address,omitempty"`
-	CreatedAt *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt *rsapi.Time         `json:"created_at,omitempty"`
 	Domain    string              `json:"domain,omitempty"`
 	Links     []map[string]string `json:"links,omitempty"`
 	Name      string              `json:"name,omitempty"`
-	UpdatedAt *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -4896,6 +7816,39 @@ func (r *IpAddress) Locator(api *API) *IpAddressLocator {
 	return nil
 }
 
+// DeploymentLocator returns a locator for the resource pointed to by the "deployment" link.
+// It returns nil if the link is absent.
+func (r *IpAddress) DeploymentLocator(api *API) *DeploymentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "deployment" {
+			return api.DeploymentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// IpAddressBindingsLocator returns a locator for the resource pointed to by the "ip_address_bindings" link.
+// It returns nil if the link is absent.
+func (r *IpAddress) IpAddressBindingsLocator(api *API) *IpAddressBindingLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "ip_address_bindings" {
+			return api.IpAddressBindingLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NetworkLocator returns a locator for the resource pointed to by the "network" link.
+// It returns nil if the link is absent.
+func (r *IpAddress) NetworkLocator(api *API) *NetworkLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "network" {
+			return api.NetworkLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // IpAddressLocator exposes the IpAddress resource actions.
@@ -4909,6 +7862,17 @@ func (api *API) IpAddressLocator(href string) *IpAddressLocator {
 	return &IpAddressLocator{Href(href), api}
 }
 
+// IpAddressLocatorInterface lists the IpAddressLocator methods, code that depends on the interface
+// rather than on IpAddressLocator can be unit tested using the generated fake locators.
+type IpAddressLocatorInterface interface {
+	Create(ipAddress *IpAddressParam) (*IpAddressLocator, error)
+	Destroy() error
+	Index(options *IpAddressIndexOptions) ([]*IpAddress, error)
+	IndexEach(options *IpAddressIndexOptions, fn func(*IpAddress) error) error
+	Show() (*IpAddress, error)
+	Update(ipAddress *IpAddressParam2) error
+}
+
 //===== Actions
 
 // POST /api/clouds/:cloud_id/ip_addresses
@@ -4926,6 +7890,9 @@ func (loc *IpAddressLocator) Create(ipAddress *IpAddressParam) (*IpAddressLocato
 	p = rsapi.APIParams{
 		"ip_address": ipAddress,
 	}
+	if err := loc.api.ValidateParams("IpAddress", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("IpAddress", "create")
 	if err != nil {
 		return res, err
@@ -4938,15 +7905,10 @@ func (loc *IpAddressLocator) Create(ipAddress *IpAddressParam) (*IpAddressLocato
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -4961,6 +7923,9 @@ func (loc *IpAddressLocator) Create(ipAddress *IpAddressParam) (*IpAddressLocato
 func (loc *IpAddressLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IpAddress", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("IpAddress", "destroy")
 	if err != nil {
 		return err
@@ -4973,32 +7938,62 @@ func (loc *IpAddressLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// IpAddressIndexOptions contains the optional parameters of IpAddressLocator.Index.
+type IpAddressIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *IpAddressIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of IpAddressLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/ip_addresses
 //
 // Lists the IpAddresses available to this account.
 // Optional parameters:
 // filter
-func (loc *IpAddressLocator) Index(options rsapi.APIParams) ([]*IpAddress, error) {
+func (loc *IpAddressLocator) Index(options *IpAddressIndexOptions) ([]*IpAddress, error) {
 	var res []*IpAddress
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IpAddress", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("IpAddress", "index")
 	if err != nil {
 		return res, err
@@ -5011,22 +8006,55 @@ func (loc *IpAddressLocator) Index(options rsapi.APIParams) ([]*IpAddress, error
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *IpAddressLocator) IndexEach(options *IpAddressIndexOptions, fn func(*IpAddress) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IpAddress", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("IpAddress", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *IpAddress
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/clouds/:cloud_id/ip_addresses/:id
@@ -5036,6 +8064,9 @@ func (loc *IpAddressLocator) Show() (*IpAddress, error) {
 	var res *IpAddress
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IpAddress", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("IpAddress", "show")
 	if err != nil {
 		return res, err
@@ -5048,21 +8079,11 @@ func (loc *IpAddressLocator) Show() (*IpAddress, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -5080,6 +8101,9 @@ func (loc *IpAddressLocator) Update(ipAddress *IpAddressParam2) error {
 	p = rsapi.APIParams{
 		"ip_address": ipAddress,
 	}
+	if err := loc.api.ValidateParams("IpAddress", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("IpAddress", "update")
 	if err != nil {
 		return err
@@ -5092,15 +8116,10 @@ func (loc *IpAddressLocator) Update(ipAddress *IpAddressParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -5110,7 +8129,7 @@ func (loc *IpAddressLocator) Update(ipAddress *IpAddressParam2) error {
 // The IpAddress is bound immediately for a current instance, or on launch for a next instance.
 // It also allows specifying port forwarding rules for that particular IpAddress and Instance pair.
 type IpAddressBinding struct {
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Links       []map[string]string `json:"links,omitempty"`
 	PrivatePort string              `json:"private_port,omitempty"`
 	Protocol    string              `json:"protocol,omitempty"`
@@ -5128,6 +8147,17 @@ func (r *IpAddressBinding) Locator(api *API) *IpAddressBindingLocator {
 	return nil
 }
 
+// InstanceLocator returns a locator for the resource pointed to by the "instance" link.
+// It returns nil if the link is absent.
+func (r *IpAddressBinding) InstanceLocator(api *API) *InstanceLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "instance" {
+			return api.InstanceLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // IpAddressBindingLocator exposes the IpAddressBinding resource actions.
@@ -5141,6 +8171,16 @@ func (api *API) IpAddressBindingLocator(href string) *IpAddressBindingLocator {
 	return &IpAddressBindingLocator{Href(href), api}
 }
 
+// IpAddressBindingLocatorInterface lists the IpAddressBindingLocator methods, code that depends on the interface
+// rather than on IpAddressBindingLocator can be unit tested using the generated fake locators.
+type IpAddressBindingLocatorInterface interface {
+	Create(ipAddressBinding *IpAddressBindingParam) (*IpAddressBindingLocator, error)
+	Destroy() error
+	Index(options *IpAddressBindingIndexOptions) ([]*IpAddressBinding, error)
+	IndexEach(options *IpAddressBindingIndexOptions, fn func(*IpAddressBinding) error) error
+	Show() (*IpAddressBinding, error)
+}
+
 //===== Actions
 
 // POST /api/clouds/:cloud_id/ip_addresses/:ip_address_id/ip_address_bindings
@@ -5164,6 +8204,9 @@ func (loc *IpAddressBindingLocator) Create(ipAddressBinding *IpAddressBindingPar
 	p = rsapi.APIParams{
 		"ip_address_binding": ipAddressBinding,
 	}
+	if err := loc.api.ValidateParams("IpAddressBinding", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("IpAddressBinding", "create")
 	if err != nil {
 		return res, err
@@ -5176,15 +8219,10 @@ func (loc *IpAddressBindingLocator) Create(ipAddressBinding *IpAddressBindingPar
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -5200,6 +8238,9 @@ func (loc *IpAddressBindingLocator) Create(ipAddressBinding *IpAddressBindingPar
 func (loc *IpAddressBindingLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IpAddressBinding", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("IpAddressBinding", "destroy")
 	if err != nil {
 		return err
@@ -5212,33 +8253,63 @@ func (loc *IpAddressBindingLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// IpAddressBindingIndexOptions contains the optional parameters of IpAddressBindingLocator.Index.
+type IpAddressBindingIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *IpAddressBindingIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of IpAddressBindingLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/ip_addresses/:ip_address_id/ip_address_bindings
 // GET /api/clouds/:cloud_id/ip_address_bindings
 //
 // Lists the ip address bindings available to this account.
 // Optional parameters:
 // filter
-func (loc *IpAddressBindingLocator) Index(options rsapi.APIParams) ([]*IpAddressBinding, error) {
+func (loc *IpAddressBindingLocator) Index(options *IpAddressBindingIndexOptions) ([]*IpAddressBinding, error) {
 	var res []*IpAddressBinding
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IpAddressBinding", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("IpAddressBinding", "index")
 	if err != nil {
 		return res, err
@@ -5251,25 +8322,58 @@ func (loc *IpAddressBindingLocator) Index(options rsapi.APIParams) ([]*IpAddress
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
-// GET /api/clouds/:cloud_id/ip_addresses/:ip_address_id/ip_address_bindings/:id
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *IpAddressBindingLocator) IndexEach(options *IpAddressBindingIndexOptions, fn func(*IpAddressBinding) error) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IpAddressBinding", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("IpAddressBinding", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *IpAddressBinding
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// GET /api/clouds/:cloud_id/ip_addresses/:ip_address_id/ip_address_bindings/:id
 // GET /api/clouds/:cloud_id/ip_address_bindings/:id
 //
 // Show information about a single ip address binding.
@@ -5277,6 +8381,9 @@ func (loc *IpAddressBindingLocator) Show() (*IpAddressBinding, error) {
 	var res *IpAddressBinding
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IpAddressBinding", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("IpAddressBinding", "show")
 	if err != nil {
 		return res, err
@@ -5289,21 +8396,11 @@ func (loc *IpAddressBindingLocator) Show() (*IpAddressBinding, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -5341,6 +8438,15 @@ func (api *API) MonitoringMetricLocator(href string) *MonitoringMetricLocator {
 	return &MonitoringMetricLocator{Href(href), api}
 }
 
+// MonitoringMetricLocatorInterface lists the MonitoringMetricLocator methods, code that depends on the interface
+// rather than on MonitoringMetricLocator can be unit tested using the generated fake locators.
+type MonitoringMetricLocatorInterface interface {
+	Data(end string, start string) (map[string]interface{}, error)
+	Index(options *MonitoringMetricIndexOptions) ([]*MonitoringMetric, error)
+	IndexEach(options *MonitoringMetricIndexOptions, fn func(*MonitoringMetric) error) error
+	Show(options *MonitoringMetricShowOptions) (*MonitoringMetric, error)
+}
+
 //===== Actions
 
 // GET /api/clouds/:cloud_id/instances/:instance_id/monitoring_metrics/:id/data
@@ -5366,6 +8472,9 @@ func (loc *MonitoringMetricLocator) Data(end string, start string) (map[string]i
 		"end":   end,
 		"start": start,
 	}
+	if err := loc.api.ValidateParams("MonitoringMetric", "data", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("MonitoringMetric", "data")
 	if err != nil {
 		return res, err
@@ -5378,24 +8487,62 @@ func (loc *MonitoringMetricLocator) Data(end string, start string) (map[string]i
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// MonitoringMetricIndexOptions contains the optional parameters of MonitoringMetricLocator.Index.
+type MonitoringMetricIndexOptions struct {
+	Filter []string
+	// The time scale for which the graph is generated. Default is 'day'
+	Period string
+	// The size of the graph to be generated. Default is 'small'.
+	Size string
+	// The title of the graph.
+	Title string
+	// The time zone in which the graph will be displayed. Default will be 'America/Los_Angeles'. For more zones, see User Settings -> Preferences.
+	Tz string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *MonitoringMetricIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "period", "size", "title", "tz":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of MonitoringMetricLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.Period != "" {
+		p["period"] = o.Period
+	}
+	if o.Size != "" {
+		p["size"] = o.Size
+	}
+	if o.Title != "" {
+		p["title"] = o.Title
+	}
+	if o.Tz != "" {
+		p["tz"] = o.Tz
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/instances/:instance_id/monitoring_metrics
 //
 // Lists the monitoring metrics available for the instance and their corresponding graph hrefs.
@@ -5406,32 +8553,39 @@ func (loc *MonitoringMetricLocator) Data(end string, start string) (map[string]i
 // size: The size of the graph to be generated. Default is 'small'.
 // title: The title of the graph.
 // tz: The time zone in which the graph will be displayed. Default will be 'America/Los_Angeles'. For more zones, see User Settings -> Preferences.
-func (loc *MonitoringMetricLocator) Index(options rsapi.APIParams) ([]*MonitoringMetric, error) {
+func (loc *MonitoringMetricLocator) Index(options *MonitoringMetricIndexOptions) ([]*MonitoringMetric, error) {
 	var res []*MonitoringMetric
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var periodOpt = options["period"]
+	var periodOpt = opts["period"]
 	if periodOpt != nil {
 		p["period"] = periodOpt
 	}
-	var sizeOpt = options["size"]
+	var sizeOpt = opts["size"]
 	if sizeOpt != nil {
 		p["size"] = sizeOpt
 	}
-	var titleOpt = options["title"]
+	var titleOpt = opts["title"]
 	if titleOpt != nil {
 		p["title"] = titleOpt
 	}
-	var tzOpt = options["tz"]
+	var tzOpt = opts["tz"]
 	if tzOpt != nil {
 		p["tz"] = tzOpt
 	}
+	if err := loc.api.ValidateParams("MonitoringMetric", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("MonitoringMetric", "index")
 	if err != nil {
 		return res, err
@@ -5444,22 +8598,116 @@ func (loc *MonitoringMetricLocator) Index(options rsapi.APIParams) ([]*Monitorin
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *MonitoringMetricLocator) IndexEach(options *MonitoringMetricIndexOptions, fn func(*MonitoringMetric) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	p = rsapi.APIParams{}
+	var periodOpt = opts["period"]
+	if periodOpt != nil {
+		p["period"] = periodOpt
+	}
+	var sizeOpt = opts["size"]
+	if sizeOpt != nil {
+		p["size"] = sizeOpt
+	}
+	var titleOpt = opts["title"]
+	if titleOpt != nil {
+		p["title"] = titleOpt
+	}
+	var tzOpt = opts["tz"]
+	if tzOpt != nil {
+		p["tz"] = tzOpt
+	}
+	if err := loc.api.ValidateParams("MonitoringMetric", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("MonitoringMetric", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *MonitoringMetric
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// MonitoringMetricShowOptions contains the optional parameters of MonitoringMetricLocator.Show.
+type MonitoringMetricShowOptions struct {
+	// The time scale for which the graph is generated. Default is 'day'.
+	Period string
+	// The size of the graph to be generated. Default is 'small'.
+	Size string
+	// The title of the graph.
+	Title string
+	// The time zone in which the graph will be displayed. Default will be 'America/Los_Angeles'. For more zones, see User Settings -> Preferences.
+	Tz string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *MonitoringMetricShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "period", "size", "title", "tz":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of MonitoringMetricLocator.Show", n)
+		}
+	}
+	if o.Period != "" {
+		p["period"] = o.Period
+	}
+	if o.Size != "" {
+		p["size"] = o.Size
+	}
+	if o.Title != "" {
+		p["title"] = o.Title
+	}
+	if o.Tz != "" {
+		p["tz"] = o.Tz
+	}
+	return p, nil
 }
 
 // GET /api/clouds/:cloud_id/instances/:instance_id/monitoring_metrics/:id
@@ -5471,27 +8719,34 @@ func (loc *MonitoringMetricLocator) Index(options rsapi.APIParams) ([]*Monitorin
 // size: The size of the graph to be generated. Default is 'small'.
 // title: The title of the graph.
 // tz: The time zone in which the graph will be displayed. Default will be 'America/Los_Angeles'. For more zones, see User Settings -> Preferences.
-func (loc *MonitoringMetricLocator) Show(options rsapi.APIParams) (*MonitoringMetric, error) {
+func (loc *MonitoringMetricLocator) Show(options *MonitoringMetricShowOptions) (*MonitoringMetric, error) {
 	var res *MonitoringMetric
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var periodOpt = options["period"]
+	var periodOpt = opts["period"]
 	if periodOpt != nil {
 		p["period"] = periodOpt
 	}
-	var sizeOpt = options["size"]
+	var sizeOpt = opts["size"]
 	if sizeOpt != nil {
 		p["size"] = sizeOpt
 	}
-	var titleOpt = options["title"]
+	var titleOpt = opts["title"]
 	if titleOpt != nil {
 		p["title"] = titleOpt
 	}
-	var tzOpt = options["tz"]
+	var tzOpt = opts["tz"]
 	if tzOpt != nil {
 		p["tz"] = tzOpt
 	}
+	if err := loc.api.ValidateParams("MonitoringMetric", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("MonitoringMetric", "show")
 	if err != nil {
 		return res, err
@@ -5504,21 +8759,11 @@ func (loc *MonitoringMetricLocator) Show(options rsapi.APIParams) (*MonitoringMe
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -5545,6 +8790,17 @@ func (r *MultiCloudImage) Locator(api *API) *MultiCloudImageLocator {
 	return nil
 }
 
+// SettingsLocator returns a locator for the resource pointed to by the "settings" link.
+// It returns nil if the link is absent.
+func (r *MultiCloudImage) SettingsLocator(api *API) *MultiCloudImageSettingLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "settings" {
+			return api.MultiCloudImageSettingLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // MultiCloudImageLocator exposes the MultiCloudImage resource actions.
@@ -5558,6 +8814,19 @@ func (api *API) MultiCloudImageLocator(href string) *MultiCloudImageLocator {
 	return &MultiCloudImageLocator{Href(href), api}
 }
 
+// MultiCloudImageLocatorInterface lists the MultiCloudImageLocator methods, code that depends on the interface
+// rather than on MultiCloudImageLocator can be unit tested using the generated fake locators.
+type MultiCloudImageLocatorInterface interface {
+	Clone(multiCloudImage *MultiCloudImageParam) error
+	Commit(commitMessage string) error
+	Create(multiCloudImage *MultiCloudImageParam) (*MultiCloudImageLocator, error)
+	Destroy() error
+	Index(options *MultiCloudImageIndexOptions) ([]*MultiCloudImage, error)
+	IndexEach(options *MultiCloudImageIndexOptions, fn func(*MultiCloudImage) error) error
+	Show() (*MultiCloudImage, error)
+	Update(multiCloudImage *MultiCloudImageParam) error
+}
+
 //===== Actions
 
 // POST /api/multi_cloud_images/:id/clone
@@ -5574,6 +8843,9 @@ func (loc *MultiCloudImageLocator) Clone(multiCloudImage *MultiCloudImageParam)
 	p = rsapi.APIParams{
 		"multi_cloud_image": multiCloudImage,
 	}
+	if err := loc.api.ValidateParams("MultiCloudImage", "clone", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("MultiCloudImage", "clone")
 	if err != nil {
 		return err
@@ -5586,15 +8858,10 @@ func (loc *MultiCloudImageLocator) Clone(multiCloudImage *MultiCloudImageParam)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -5612,6 +8879,9 @@ func (loc *MultiCloudImageLocator) Commit(commitMessage string) error {
 	p = rsapi.APIParams{
 		"commit_message": commitMessage,
 	}
+	if err := loc.api.ValidateParams("MultiCloudImage", "commit", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("MultiCloudImage", "commit")
 	if err != nil {
 		return err
@@ -5624,15 +8894,10 @@ func (loc *MultiCloudImageLocator) Commit(commitMessage string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -5652,6 +8917,9 @@ func (loc *MultiCloudImageLocator) Create(multiCloudImage *MultiCloudImageParam)
 	p = rsapi.APIParams{
 		"multi_cloud_image": multiCloudImage,
 	}
+	if err := loc.api.ValidateParams("MultiCloudImage", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("MultiCloudImage", "create")
 	if err != nil {
 		return res, err
@@ -5664,15 +8932,10 @@ func (loc *MultiCloudImageLocator) Create(multiCloudImage *MultiCloudImageParam)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -5688,6 +8951,9 @@ func (loc *MultiCloudImageLocator) Create(multiCloudImage *MultiCloudImageParam)
 func (loc *MultiCloudImageLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("MultiCloudImage", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("MultiCloudImage", "destroy")
 	if err != nil {
 		return err
@@ -5700,33 +8966,63 @@ func (loc *MultiCloudImageLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// MultiCloudImageIndexOptions contains the optional parameters of MultiCloudImageLocator.Index.
+type MultiCloudImageIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *MultiCloudImageIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of MultiCloudImageLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/server_templates/:server_template_id/multi_cloud_images
 // GET /api/multi_cloud_images
 //
 // Lists the MultiCloudImages available to this account. HEAD revisions have a revision of 0.
 // Optional parameters:
 // filter
-func (loc *MultiCloudImageLocator) Index(options rsapi.APIParams) ([]*MultiCloudImage, error) {
+func (loc *MultiCloudImageLocator) Index(options *MultiCloudImageIndexOptions) ([]*MultiCloudImage, error) {
 	var res []*MultiCloudImage
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("MultiCloudImage", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("MultiCloudImage", "index")
 	if err != nil {
 		return res, err
@@ -5739,22 +9035,55 @@ func (loc *MultiCloudImageLocator) Index(options rsapi.APIParams) ([]*MultiCloud
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *MultiCloudImageLocator) IndexEach(options *MultiCloudImageIndexOptions, fn func(*MultiCloudImage) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("MultiCloudImage", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("MultiCloudImage", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *MultiCloudImage
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/server_templates/:server_template_id/multi_cloud_images/:id
@@ -5765,6 +9094,9 @@ func (loc *MultiCloudImageLocator) Show() (*MultiCloudImage, error) {
 	var res *MultiCloudImage
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("MultiCloudImage", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("MultiCloudImage", "show")
 	if err != nil {
 		return res, err
@@ -5777,21 +9109,11 @@ func (loc *MultiCloudImageLocator) Show() (*MultiCloudImage, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -5811,6 +9133,9 @@ func (loc *MultiCloudImageLocator) Update(multiCloudImage *MultiCloudImageParam)
 	p = rsapi.APIParams{
 		"multi_cloud_image": multiCloudImage,
 	}
+	if err := loc.api.ValidateParams("MultiCloudImage", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("MultiCloudImage", "update")
 	if err != nil {
 		return err
@@ -5823,15 +9148,10 @@ func (loc *MultiCloudImageLocator) Update(multiCloudImage *MultiCloudImageParam)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -5854,6 +9174,50 @@ func (r *MultiCloudImageSetting) Locator(api *API) *MultiCloudImageSettingLocato
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *MultiCloudImageSetting) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ImageLocator returns a locator for the resource pointed to by the "image" link.
+// It returns nil if the link is absent.
+func (r *MultiCloudImageSetting) ImageLocator(api *API) *ImageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "image" {
+			return api.ImageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// InstanceTypeLocator returns a locator for the resource pointed to by the "instance_type" link.
+// It returns nil if the link is absent.
+func (r *MultiCloudImageSetting) InstanceTypeLocator(api *API) *InstanceTypeLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "instance_type" {
+			return api.InstanceTypeLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// MultiCloudImageLocator returns a locator for the resource pointed to by the "multi_cloud_image" link.
+// It returns nil if the link is absent.
+func (r *MultiCloudImageSetting) MultiCloudImageLocator(api *API) *MultiCloudImageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "multi_cloud_image" {
+			return api.MultiCloudImageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // MultiCloudImageSettingLocator exposes the MultiCloudImageSetting resource actions.
@@ -5867,6 +9231,17 @@ func (api *API) MultiCloudImageSettingLocator(href string) *MultiCloudImageSetti
 	return &MultiCloudImageSettingLocator{Href(href), api}
 }
 
+// MultiCloudImageSettingLocatorInterface lists the MultiCloudImageSettingLocator methods, code that depends on the interface
+// rather than on MultiCloudImageSettingLocator can be unit tested using the generated fake locators.
+type MultiCloudImageSettingLocatorInterface interface {
+	Create(multiCloudImageSetting *MultiCloudImageSettingParam) (*MultiCloudImageSettingLocator, error)
+	Destroy() error
+	Index(options *MultiCloudImageSettingIndexOptions) ([]*MultiCloudImageSetting, error)
+	IndexEach(options *MultiCloudImageSettingIndexOptions, fn func(*MultiCloudImageSetting) error) error
+	Show() (*MultiCloudImageSetting, error)
+	Update(multiCloudImageSetting *MultiCloudImageSettingParam) error
+}
+
 //===== Actions
 
 // POST /api/multi_cloud_images/:multi_cloud_image_id/settings
@@ -5884,6 +9259,9 @@ func (loc *MultiCloudImageSettingLocator) Create(multiCloudImageSetting *MultiCl
 	p = rsapi.APIParams{
 		"multi_cloud_image_setting": multiCloudImageSetting,
 	}
+	if err := loc.api.ValidateParams("MultiCloudImageSetting", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("MultiCloudImageSetting", "create")
 	if err != nil {
 		return res, err
@@ -5896,15 +9274,10 @@ func (loc *MultiCloudImageSettingLocator) Create(multiCloudImageSetting *MultiCl
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -5919,6 +9292,9 @@ func (loc *MultiCloudImageSettingLocator) Create(multiCloudImageSetting *MultiCl
 func (loc *MultiCloudImageSettingLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("MultiCloudImageSetting", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("MultiCloudImageSetting", "destroy")
 	if err != nil {
 		return err
@@ -5931,32 +9307,62 @@ func (loc *MultiCloudImageSettingLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// MultiCloudImageSettingIndexOptions contains the optional parameters of MultiCloudImageSettingLocator.Index.
+type MultiCloudImageSettingIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *MultiCloudImageSettingIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of MultiCloudImageSettingLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/multi_cloud_images/:multi_cloud_image_id/settings
 //
 // Lists the MultiCloudImage settings.
 // Optional parameters:
 // filter
-func (loc *MultiCloudImageSettingLocator) Index(options rsapi.APIParams) ([]*MultiCloudImageSetting, error) {
+func (loc *MultiCloudImageSettingLocator) Index(options *MultiCloudImageSettingIndexOptions) ([]*MultiCloudImageSetting, error) {
 	var res []*MultiCloudImageSetting
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("MultiCloudImageSetting", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("MultiCloudImageSetting", "index")
 	if err != nil {
 		return res, err
@@ -5969,22 +9375,55 @@ func (loc *MultiCloudImageSettingLocator) Index(options rsapi.APIParams) ([]*Mul
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *MultiCloudImageSettingLocator) IndexEach(options *MultiCloudImageSettingIndexOptions, fn func(*MultiCloudImageSetting) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("MultiCloudImageSetting", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("MultiCloudImageSetting", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *MultiCloudImageSetting
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/multi_cloud_images/:multi_cloud_image_id/settings/:id
@@ -5994,6 +9433,9 @@ func (loc *MultiCloudImageSettingLocator) Show() (*MultiCloudImageSetting, error
 	var res *MultiCloudImageSetting
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("MultiCloudImageSetting", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("MultiCloudImageSetting", "show")
 	if err != nil {
 		return res, err
@@ -6006,21 +9448,11 @@ func (loc *MultiCloudImageSettingLocator) Show() (*MultiCloudImageSetting, error
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -6038,6 +9470,9 @@ func (loc *MultiCloudImageSettingLocator) Update(multiCloudImageSetting *MultiCl
 	p = rsapi.APIParams{
 		"multi_cloud_image_setting": multiCloudImageSetting,
 	}
+	if err := loc.api.ValidateParams("MultiCloudImageSetting", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("MultiCloudImageSetting", "update")
 	if err != nil {
 		return err
@@ -6050,15 +9485,10 @@ func (loc *MultiCloudImageSettingLocator) Update(multiCloudImageSetting *MultiCl
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -6086,6 +9516,28 @@ func (r *Network) Locator(api *API) *NetworkLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *Network) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// DefaultRouteTableLocator returns a locator for the resource pointed to by the "default_route_table" link.
+// It returns nil if the link is absent.
+func (r *Network) DefaultRouteTableLocator(api *API) *RouteTableLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "default_route_table" {
+			return api.RouteTableLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // NetworkLocator exposes the Network resource actions.
@@ -6099,6 +9551,17 @@ func (api *API) NetworkLocator(href string) *NetworkLocator {
 	return &NetworkLocator{Href(href), api}
 }
 
+// NetworkLocatorInterface lists the NetworkLocator methods, code that depends on the interface
+// rather than on NetworkLocator can be unit tested using the generated fake locators.
+type NetworkLocatorInterface interface {
+	Create(network *NetworkParam) (*NetworkLocator, error)
+	Destroy() error
+	Index(options *NetworkIndexOptions) ([]*Network, error)
+	IndexEach(options *NetworkIndexOptions, fn func(*Network) error) error
+	Show() (*Network, error)
+	Update(network *NetworkParam2) error
+}
+
 //===== Actions
 
 // POST /api/networks
@@ -6116,6 +9579,9 @@ func (loc *NetworkLocator) Create(network *NetworkParam) (*NetworkLocator, error
 	p = rsapi.APIParams{
 		"network": network,
 	}
+	if err := loc.api.ValidateParams("Network", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Network", "create")
 	if err != nil {
 		return res, err
@@ -6128,15 +9594,10 @@ func (loc *NetworkLocator) Create(network *NetworkParam) (*NetworkLocator, error
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -6151,6 +9612,9 @@ func (loc *NetworkLocator) Create(network *NetworkParam) (*NetworkLocator, error
 func (loc *NetworkLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Network", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Network", "destroy")
 	if err != nil {
 		return err
@@ -6163,32 +9627,62 @@ func (loc *NetworkLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// NetworkIndexOptions contains the optional parameters of NetworkLocator.Index.
+type NetworkIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NetworkIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NetworkLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/networks
 //
 // Lists networks in this account.
 // Optional parameters:
 // filter
-func (loc *NetworkLocator) Index(options rsapi.APIParams) ([]*Network, error) {
+func (loc *NetworkLocator) Index(options *NetworkIndexOptions) ([]*Network, error) {
 	var res []*Network
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Network", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Network", "index")
 	if err != nil {
 		return res, err
@@ -6201,22 +9695,55 @@ func (loc *NetworkLocator) Index(options rsapi.APIParams) ([]*Network, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *NetworkLocator) IndexEach(options *NetworkIndexOptions, fn func(*Network) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Network", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Network", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Network
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/networks/:id
@@ -6226,6 +9753,9 @@ func (loc *NetworkLocator) Show() (*Network, error) {
 	var res *Network
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Network", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Network", "show")
 	if err != nil {
 		return res, err
@@ -6238,21 +9768,11 @@ func (loc *NetworkLocator) Show() (*Network, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -6270,6 +9790,9 @@ func (loc *NetworkLocator) Update(network *NetworkParam2) error {
 	p = rsapi.APIParams{
 		"network": network,
 	}
+	if err := loc.api.ValidateParams("Network", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Network", "update")
 	if err != nil {
 		return err
@@ -6282,15 +9805,10 @@ func (loc *NetworkLocator) Update(network *NetworkParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -6299,14 +9817,14 @@ func (loc *NetworkLocator) Update(network *NetworkParam2) error {
 // A NetworkGateway is an interface that allows traffic to be routed between networks.
 type NetworkGateway struct {
 	Actions     []map[string]string `json:"actions,omitempty"`
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Description string              `json:"description,omitempty"`
 	Links       []map[string]string `json:"links,omitempty"`
 	Name        string              `json:"name,omitempty"`
 	ResourceUid string              `json:"resource_uid,omitempty"`
 	State       string              `json:"state,omitempty"`
 	Type        string              `json:"type,omitempty"`
-	UpdatedAt   *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt   *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -6319,6 +9837,28 @@ func (r *NetworkGateway) Locator(api *API) *NetworkGatewayLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *NetworkGateway) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NetworkLocator returns a locator for the resource pointed to by the "network" link.
+// It returns nil if the link is absent.
+func (r *NetworkGateway) NetworkLocator(api *API) *NetworkLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "network" {
+			return api.NetworkLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // NetworkGatewayLocator exposes the NetworkGateway resource actions.
@@ -6332,6 +9872,17 @@ func (api *API) NetworkGatewayLocator(href string) *NetworkGatewayLocator {
 	return &NetworkGatewayLocator{Href(href), api}
 }
 
+// NetworkGatewayLocatorInterface lists the NetworkGatewayLocator methods, code that depends on the interface
+// rather than on NetworkGatewayLocator can be unit tested using the generated fake locators.
+type NetworkGatewayLocatorInterface interface {
+	Create(networkGateway *NetworkGatewayParam) (*NetworkGatewayLocator, error)
+	Destroy() error
+	Index(options *NetworkGatewayIndexOptions) ([]*NetworkGateway, error)
+	IndexEach(options *NetworkGatewayIndexOptions, fn func(*NetworkGateway) error) error
+	Show() (*NetworkGateway, error)
+	Update(networkGateway *NetworkGatewayParam2) error
+}
+
 //===== Actions
 
 // POST /api/network_gateways
@@ -6349,6 +9900,9 @@ func (loc *NetworkGatewayLocator) Create(networkGateway *NetworkGatewayParam) (*
 	p = rsapi.APIParams{
 		"network_gateway": networkGateway,
 	}
+	if err := loc.api.ValidateParams("NetworkGateway", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("NetworkGateway", "create")
 	if err != nil {
 		return res, err
@@ -6361,15 +9915,10 @@ func (loc *NetworkGatewayLocator) Create(networkGateway *NetworkGatewayParam) (*
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -6384,6 +9933,9 @@ func (loc *NetworkGatewayLocator) Create(networkGateway *NetworkGatewayParam) (*
 func (loc *NetworkGatewayLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkGateway", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NetworkGateway", "destroy")
 	if err != nil {
 		return err
@@ -6396,32 +9948,62 @@ func (loc *NetworkGatewayLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// NetworkGatewayIndexOptions contains the optional parameters of NetworkGatewayLocator.Index.
+type NetworkGatewayIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NetworkGatewayIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NetworkGatewayLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/network_gateways
 //
 // Lists the NetworkGateways available to this account.
 // Optional parameters:
 // filter
-func (loc *NetworkGatewayLocator) Index(options rsapi.APIParams) ([]*NetworkGateway, error) {
+func (loc *NetworkGatewayLocator) Index(options *NetworkGatewayIndexOptions) ([]*NetworkGateway, error) {
 	var res []*NetworkGateway
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkGateway", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("NetworkGateway", "index")
 	if err != nil {
 		return res, err
@@ -6434,22 +10016,55 @@ func (loc *NetworkGatewayLocator) Index(options rsapi.APIParams) ([]*NetworkGate
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *NetworkGatewayLocator) IndexEach(options *NetworkGatewayIndexOptions, fn func(*NetworkGateway) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkGateway", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("NetworkGateway", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *NetworkGateway
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/network_gateways/:id
@@ -6459,6 +10074,9 @@ func (loc *NetworkGatewayLocator) Show() (*NetworkGateway, error) {
 	var res *NetworkGateway
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkGateway", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("NetworkGateway", "show")
 	if err != nil {
 		return res, err
@@ -6471,21 +10089,11 @@ func (loc *NetworkGatewayLocator) Show() (*NetworkGateway, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -6503,6 +10111,9 @@ func (loc *NetworkGatewayLocator) Update(networkGateway *NetworkGatewayParam2) e
 	p = rsapi.APIParams{
 		"network_gateway": networkGateway,
 	}
+	if err := loc.api.ValidateParams("NetworkGateway", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NetworkGateway", "update")
 	if err != nil {
 		return err
@@ -6515,15 +10126,10 @@ func (loc *NetworkGatewayLocator) Update(networkGateway *NetworkGatewayParam2) e
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -6537,14 +10143,14 @@ func (loc *NetworkGatewayLocator) Update(networkGateway *NetworkGatewayParam2) e
 // your particular cloud's documentation for available option keys.
 type NetworkOptionGroup struct {
 	Actions     []map[string]string `json:"actions,omitempty"`
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Description string              `json:"description,omitempty"`
 	Links       []map[string]string `json:"links,omitempty"`
 	Name        string              `json:"name,omitempty"`
 	Options     map[string]string   `json:"options,omitempty"`
 	ResourceUid string              `json:"resource_uid,omitempty"`
 	Type        string              `json:"type,omitempty"`
-	UpdatedAt   *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt   *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -6557,6 +10163,17 @@ func (r *NetworkOptionGroup) Locator(api *API) *NetworkOptionGroupLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *NetworkOptionGroup) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // NetworkOptionGroupLocator exposes the NetworkOptionGroup resource actions.
@@ -6570,6 +10187,17 @@ func (api *API) NetworkOptionGroupLocator(href string) *NetworkOptionGroupLocato
 	return &NetworkOptionGroupLocator{Href(href), api}
 }
 
+// NetworkOptionGroupLocatorInterface lists the NetworkOptionGroupLocator methods, code that depends on the interface
+// rather than on NetworkOptionGroupLocator can be unit tested using the generated fake locators.
+type NetworkOptionGroupLocatorInterface interface {
+	Create(networkOptionGroup *NetworkOptionGroupParam) (*NetworkOptionGroupLocator, error)
+	Destroy() error
+	Index(options *NetworkOptionGroupIndexOptions) ([]*NetworkOptionGroup, error)
+	IndexEach(options *NetworkOptionGroupIndexOptions, fn func(*NetworkOptionGroup) error) error
+	Show() (*NetworkOptionGroup, error)
+	Update(networkOptionGroup *NetworkOptionGroupParam2) error
+}
+
 //===== Actions
 
 // POST /api/network_option_groups
@@ -6587,6 +10215,9 @@ func (loc *NetworkOptionGroupLocator) Create(networkOptionGroup *NetworkOptionGr
 	p = rsapi.APIParams{
 		"network_option_group": networkOptionGroup,
 	}
+	if err := loc.api.ValidateParams("NetworkOptionGroup", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("NetworkOptionGroup", "create")
 	if err != nil {
 		return res, err
@@ -6599,15 +10230,10 @@ func (loc *NetworkOptionGroupLocator) Create(networkOptionGroup *NetworkOptionGr
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -6622,6 +10248,9 @@ func (loc *NetworkOptionGroupLocator) Create(networkOptionGroup *NetworkOptionGr
 func (loc *NetworkOptionGroupLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkOptionGroup", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NetworkOptionGroup", "destroy")
 	if err != nil {
 		return err
@@ -6634,32 +10263,62 @@ func (loc *NetworkOptionGroupLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
-// GET /api/network_option_groups
-//
-// List NetworkOptionGroups available in this account.
+// NetworkOptionGroupIndexOptions contains the optional parameters of NetworkOptionGroupLocator.Index.
+type NetworkOptionGroupIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NetworkOptionGroupIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NetworkOptionGroupLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
+// GET /api/network_option_groups
+//
+// List NetworkOptionGroups available in this account.
 // Optional parameters:
 // filter
-func (loc *NetworkOptionGroupLocator) Index(options rsapi.APIParams) ([]*NetworkOptionGroup, error) {
+func (loc *NetworkOptionGroupLocator) Index(options *NetworkOptionGroupIndexOptions) ([]*NetworkOptionGroup, error) {
 	var res []*NetworkOptionGroup
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkOptionGroup", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("NetworkOptionGroup", "index")
 	if err != nil {
 		return res, err
@@ -6672,22 +10331,55 @@ func (loc *NetworkOptionGroupLocator) Index(options rsapi.APIParams) ([]*Network
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *NetworkOptionGroupLocator) IndexEach(options *NetworkOptionGroupIndexOptions, fn func(*NetworkOptionGroup) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkOptionGroup", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("NetworkOptionGroup", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *NetworkOptionGroup
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/network_option_groups/:id
@@ -6697,6 +10389,9 @@ func (loc *NetworkOptionGroupLocator) Show() (*NetworkOptionGroup, error) {
 	var res *NetworkOptionGroup
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkOptionGroup", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("NetworkOptionGroup", "show")
 	if err != nil {
 		return res, err
@@ -6709,21 +10404,11 @@ func (loc *NetworkOptionGroupLocator) Show() (*NetworkOptionGroup, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -6741,6 +10426,9 @@ func (loc *NetworkOptionGroupLocator) Update(networkOptionGroup *NetworkOptionGr
 	p = rsapi.APIParams{
 		"network_option_group": networkOptionGroup,
 	}
+	if err := loc.api.ValidateParams("NetworkOptionGroup", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NetworkOptionGroup", "update")
 	if err != nil {
 		return err
@@ -6753,15 +10441,10 @@ func (loc *NetworkOptionGroupLocator) Update(networkOptionGroup *NetworkOptionGr
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -6777,11 +10460,11 @@ func (loc *NetworkOptionGroupLocator) Update(networkOptionGroup *NetworkOptionGr
 // Other clouds in the future may support attaching to Subnets.
 type NetworkOptionGroupAttachment struct {
 	Actions            []map[string]string `json:"actions,omitempty"`
-	CreatedAt          *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt          *rsapi.Time         `json:"created_at,omitempty"`
 	Links              []map[string]string `json:"links,omitempty"`
 	NetworkOptionGroup string              `json:"network_option_group,omitempty"`
 	ResourceUid        string              `json:"resource_uid,omitempty"`
-	UpdatedAt          *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt          *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -6794,6 +10477,39 @@ func (r *NetworkOptionGroupAttachment) Locator(api *API) *NetworkOptionGroupAtta
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *NetworkOptionGroupAttachment) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NetworkLocator returns a locator for the resource pointed to by the "network" link.
+// It returns nil if the link is absent.
+func (r *NetworkOptionGroupAttachment) NetworkLocator(api *API) *NetworkLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "network" {
+			return api.NetworkLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NetworkOptionGroupLocator returns a locator for the resource pointed to by the "network_option_group" link.
+// It returns nil if the link is absent.
+func (r *NetworkOptionGroupAttachment) NetworkOptionGroupLocator(api *API) *NetworkOptionGroupLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "network_option_group" {
+			return api.NetworkOptionGroupLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // NetworkOptionGroupAttachmentLocator exposes the NetworkOptionGroupAttachment resource actions.
@@ -6807,6 +10523,17 @@ func (api *API) NetworkOptionGroupAttachmentLocator(href string) *NetworkOptionG
 	return &NetworkOptionGroupAttachmentLocator{Href(href), api}
 }
 
+// NetworkOptionGroupAttachmentLocatorInterface lists the NetworkOptionGroupAttachmentLocator methods, code that depends on the interface
+// rather than on NetworkOptionGroupAttachmentLocator can be unit tested using the generated fake locators.
+type NetworkOptionGroupAttachmentLocatorInterface interface {
+	Create(networkOptionGroupAttachment *NetworkOptionGroupAttachmentParam) (*NetworkOptionGroupAttachmentLocator, error)
+	Destroy() error
+	Index(options *NetworkOptionGroupAttachmentIndexOptions) ([]*NetworkOptionGroupAttachment, error)
+	IndexEach(options *NetworkOptionGroupAttachmentIndexOptions, fn func(*NetworkOptionGroupAttachment) error) error
+	Show(options *NetworkOptionGroupAttachmentShowOptions) (*NetworkOptionGroupAttachment, error)
+	Update(networkOptionGroupAttachment *NetworkOptionGroupAttachmentParam2) error
+}
+
 //===== Actions
 
 // POST /api/network_option_group_attachments
@@ -6824,6 +10551,9 @@ func (loc *NetworkOptionGroupAttachmentLocator) Create(networkOptionGroupAttachm
 	p = rsapi.APIParams{
 		"network_option_group_attachment": networkOptionGroupAttachment,
 	}
+	if err := loc.api.ValidateParams("NetworkOptionGroupAttachment", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("NetworkOptionGroupAttachment", "create")
 	if err != nil {
 		return res, err
@@ -6836,15 +10566,10 @@ func (loc *NetworkOptionGroupAttachmentLocator) Create(networkOptionGroupAttachm
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -6859,6 +10584,9 @@ func (loc *NetworkOptionGroupAttachmentLocator) Create(networkOptionGroupAttachm
 func (loc *NetworkOptionGroupAttachmentLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkOptionGroupAttachment", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NetworkOptionGroupAttachment", "destroy")
 	if err != nil {
 		return err
@@ -6871,37 +10599,71 @@ func (loc *NetworkOptionGroupAttachmentLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// NetworkOptionGroupAttachmentIndexOptions contains the optional parameters of NetworkOptionGroupAttachmentLocator.Index.
+type NetworkOptionGroupAttachmentIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NetworkOptionGroupAttachmentIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NetworkOptionGroupAttachmentLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/network_option_group_attachments
 //
 // List NetworkOptionGroupAttachments in this account.
 // Optional parameters:
 // filter
 // view
-func (loc *NetworkOptionGroupAttachmentLocator) Index(options rsapi.APIParams) ([]*NetworkOptionGroupAttachment, error) {
+func (loc *NetworkOptionGroupAttachmentLocator) Index(options *NetworkOptionGroupAttachmentIndexOptions) ([]*NetworkOptionGroupAttachment, error) {
 	var res []*NetworkOptionGroupAttachment
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkOptionGroupAttachment", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("NetworkOptionGroupAttachment", "index")
 	if err != nil {
 		return res, err
@@ -6914,22 +10676,87 @@ func (loc *NetworkOptionGroupAttachmentLocator) Index(options rsapi.APIParams) (
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *NetworkOptionGroupAttachmentLocator) IndexEach(options *NetworkOptionGroupAttachmentIndexOptions, fn func(*NetworkOptionGroupAttachment) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkOptionGroupAttachment", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("NetworkOptionGroupAttachment", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *NetworkOptionGroupAttachment
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// NetworkOptionGroupAttachmentShowOptions contains the optional parameters of NetworkOptionGroupAttachmentLocator.Show.
+type NetworkOptionGroupAttachmentShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NetworkOptionGroupAttachmentShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NetworkOptionGroupAttachmentLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/network_option_group_attachments/:id
@@ -6937,15 +10764,22 @@ func (loc *NetworkOptionGroupAttachmentLocator) Index(options rsapi.APIParams) (
 // Show information about a single NetworkOptionGroupAttachment.
 // Optional parameters:
 // view
-func (loc *NetworkOptionGroupAttachmentLocator) Show(options rsapi.APIParams) (*NetworkOptionGroupAttachment, error) {
+func (loc *NetworkOptionGroupAttachmentLocator) Show(options *NetworkOptionGroupAttachmentShowOptions) (*NetworkOptionGroupAttachment, error) {
 	var res *NetworkOptionGroupAttachment
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkOptionGroupAttachment", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("NetworkOptionGroupAttachment", "show")
 	if err != nil {
 		return res, err
@@ -6958,21 +10792,11 @@ func (loc *NetworkOptionGroupAttachmentLocator) Show(options rsapi.APIParams) (*
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -6990,6 +10814,9 @@ func (loc *NetworkOptionGroupAttachmentLocator) Update(networkOptionGroupAttachm
 	p = rsapi.APIParams{
 		"network_option_group_attachment": networkOptionGroupAttachment,
 	}
+	if err := loc.api.ValidateParams("NetworkOptionGroupAttachment", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NetworkOptionGroupAttachment", "update")
 	if err != nil {
 		return err
@@ -7002,15 +10829,10 @@ func (loc *NetworkOptionGroupAttachmentLocator) Update(networkOptionGroupAttachm
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -7055,8 +10877,68 @@ func (api *API) Oauth2Locator(href string) *Oauth2Locator {
 	return &Oauth2Locator{Href(href), api}
 }
 
+// Oauth2LocatorInterface lists the Oauth2Locator methods, code that depends on the interface
+// rather than on Oauth2Locator can be unit tested using the generated fake locators.
+type Oauth2LocatorInterface interface {
+	Create(grantType string, options *Oauth2CreateOptions) (map[string]interface{}, error)
+}
+
 //===== Actions
 
+// Oauth2CreateOptions contains the optional parameters of Oauth2Locator.Create.
+type Oauth2CreateOptions struct {
+	// The client's account ID (only needed for instance agent clients).
+	AccountId int
+	// The client ID (only needed for confidential clients).
+	ClientId string
+	// The client secret (only needed for confidential clients).
+	ClientSecret string
+	// The RightAgent protocol version the client conforms to (only needed for instance agent clients).
+	RsVersion int
+	// The refresh token obtained from OAuth grant.
+	RefreshToken string
+	// The RightLink gem version the client conforms to (only needed for instance agent clients).
+	RightLinkVersion string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *Oauth2CreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "account_id", "client_id", "client_secret", "r_s_version", "refresh_token", "right_link_version":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of Oauth2Locator.Create", n)
+		}
+	}
+	if o.AccountId != 0 {
+		p["account_id"] = o.AccountId
+	}
+	if o.ClientId != "" {
+		p["client_id"] = o.ClientId
+	}
+	if o.ClientSecret != "" {
+		p["client_secret"] = o.ClientSecret
+	}
+	if o.RsVersion != 0 {
+		p["r_s_version"] = o.RsVersion
+	}
+	if o.RefreshToken != "" {
+		p["refresh_token"] = o.RefreshToken
+	}
+	if o.RightLinkVersion != "" {
+		p["right_link_version"] = o.RightLinkVersion
+	}
+	return p, nil
+}
+
 // POST /api/oauth2/
 //
 // Perform an OAuth 2.0 token_refresh operation to obtain an access token that
@@ -7087,40 +10969,47 @@ func (api *API) Oauth2Locator(href string) *Oauth2Locator {
 // r_s_version: The RightAgent protocol version the client conforms to (only needed for instance agent clients).
 // refresh_token: The refresh token obtained from OAuth grant.
 // right_link_version: The RightLink gem version the client conforms to (only needed for instance agent clients).
-func (loc *Oauth2Locator) Create(grantType string, options rsapi.APIParams) (map[string]interface{}, error) {
+func (loc *Oauth2Locator) Create(grantType string, options *Oauth2CreateOptions) (map[string]interface{}, error) {
 	var res map[string]interface{}
 	if grantType == "" {
 		return res, fmt.Errorf("grantType is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"grant_type": grantType,
 	}
-	var accountIdOpt = options["account_id"]
+	var accountIdOpt = opts["account_id"]
 	if accountIdOpt != nil {
 		p["account_id"] = accountIdOpt
 	}
-	var clientIdOpt = options["client_id"]
+	var clientIdOpt = opts["client_id"]
 	if clientIdOpt != nil {
 		p["client_id"] = clientIdOpt
 	}
-	var clientSecretOpt = options["client_secret"]
+	var clientSecretOpt = opts["client_secret"]
 	if clientSecretOpt != nil {
 		p["client_secret"] = clientSecretOpt
 	}
-	var rsVersionOpt = options["r_s_version"]
+	var rsVersionOpt = opts["r_s_version"]
 	if rsVersionOpt != nil {
 		p["r_s_version"] = rsVersionOpt
 	}
-	var refreshTokenOpt = options["refresh_token"]
+	var refreshTokenOpt = opts["refresh_token"]
 	if refreshTokenOpt != nil {
 		p["refresh_token"] = refreshTokenOpt
 	}
-	var rightLinkVersionOpt = options["right_link_version"]
+	var rightLinkVersionOpt = opts["right_link_version"]
 	if rightLinkVersionOpt != nil {
 		p["right_link_version"] = rightLinkVersionOpt
 	}
+	if err := loc.api.ValidateParams("Oauth2", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Oauth2", "create")
 	if err != nil {
 		return res, err
@@ -7133,21 +11022,11 @@ func (loc *Oauth2Locator) Create(grantType string, options rsapi.APIParams) (map
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -7155,7 +11034,7 @@ func (loc *Oauth2Locator) Create(grantType string, options rsapi.APIParams) (map
 
 type Permission struct {
 	Actions   []map[string]string `json:"actions,omitempty"`
-	CreatedAt *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt *rsapi.Time         `json:"created_at,omitempty"`
 	Links     []map[string]string `json:"links,omitempty"`
 	RoleTitle string              `json:"role_title,omitempty"`
 }
@@ -7170,6 +11049,28 @@ func (r *Permission) Locator(api *API) *PermissionLocator {
 	return nil
 }
 
+// AccountLocator returns a locator for the resource pointed to by the "account" link.
+// It returns nil if the link is absent.
+func (r *Permission) AccountLocator(api *API) *AccountLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "account" {
+			return api.AccountLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// UserLocator returns a locator for the resource pointed to by the "user" link.
+// It returns nil if the link is absent.
+func (r *Permission) UserLocator(api *API) *UserLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "user" {
+			return api.UserLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // PermissionLocator exposes the Permission resource actions.
@@ -7183,6 +11084,16 @@ func (api *API) PermissionLocator(href string) *PermissionLocator {
 	return &PermissionLocator{Href(href), api}
 }
 
+// PermissionLocatorInterface lists the PermissionLocator methods, code that depends on the interface
+// rather than on PermissionLocator can be unit tested using the generated fake locators.
+type PermissionLocatorInterface interface {
+	Create(permission *PermissionParam) (*PermissionLocator, error)
+	Destroy() error
+	Index(options *PermissionIndexOptions) ([]*Permission, error)
+	IndexEach(options *PermissionIndexOptions, fn func(*Permission) error) error
+	Show() (*Permission, error)
+}
+
 //===== Actions
 
 // POST /api/permissions
@@ -7209,6 +11120,9 @@ func (loc *PermissionLocator) Create(permission *PermissionParam) (*PermissionLo
 	p = rsapi.APIParams{
 		"permission": permission,
 	}
+	if err := loc.api.ValidateParams("Permission", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Permission", "create")
 	if err != nil {
 		return res, err
@@ -7221,15 +11135,10 @@ func (loc *PermissionLocator) Create(permission *PermissionParam) (*PermissionLo
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -7250,6 +11159,9 @@ func (loc *PermissionLocator) Create(permission *PermissionParam) (*PermissionLo
 func (loc *PermissionLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Permission", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Permission", "destroy")
 	if err != nil {
 		return err
@@ -7262,32 +11174,62 @@ func (loc *PermissionLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// PermissionIndexOptions contains the optional parameters of PermissionLocator.Index.
+type PermissionIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *PermissionIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of PermissionLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/permissions
 //
 // List all permissions for all users of the current acount.
 // Optional parameters:
 // filter
-func (loc *PermissionLocator) Index(options rsapi.APIParams) ([]*Permission, error) {
+func (loc *PermissionLocator) Index(options *PermissionIndexOptions) ([]*Permission, error) {
 	var res []*Permission
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Permission", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Permission", "index")
 	if err != nil {
 		return res, err
@@ -7300,58 +11242,84 @@ func (loc *PermissionLocator) Index(options rsapi.APIParams) ([]*Permission, err
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
-// GET /api/permissions/:id
-//
-// Show information about a single permission.
-func (loc *PermissionLocator) Show() (*Permission, error) {
-	var res *Permission
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *PermissionLocator) IndexEach(options *PermissionIndexOptions, fn func(*Permission) error) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
 	var p rsapi.APIParams
-	uri, err := loc.ActionPath("Permission", "show")
-	if err != nil {
-		return res, err
+	if err := loc.api.ValidateParams("Permission", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Permission", "index")
+	if err != nil {
+		return err
 	}
 	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
 	if err != nil {
-		return res, err
+		return err
 	}
 	resp, err := loc.api.PerformRequest(req)
 	if err != nil {
-		return res, err
+		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Permission
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// GET /api/permissions/:id
+//
+// Show information about a single permission.
+func (loc *PermissionLocator) Show() (*Permission, error) {
+	var res *Permission
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Permission", "show", params, p); err != nil {
+		return res, err
+	}
+	uri, err := loc.ActionPath("Permission", "show")
+	if err != nil {
+		return res, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return res, err
+	}
+	resp, err := loc.api.PerformRequest(req)
 	if err != nil {
 		return res, err
 	}
-	err = json.Unmarshal(respBody, &res)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return res, rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -7359,13 +11327,13 @@ func (loc *PermissionLocator) Show() (*Permission, error) {
 
 type PlacementGroup struct {
 	Actions     []map[string]string `json:"actions,omitempty"`
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Description string              `json:"description,omitempty"`
 	Links       []map[string]string `json:"links,omitempty"`
 	Name        string              `json:"name,omitempty"`
 	ResourceUid string              `json:"resource_uid,omitempty"`
 	State       string              `json:"state,omitempty"`
-	UpdatedAt   *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt   *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -7378,6 +11346,17 @@ func (r *PlacementGroup) Locator(api *API) *PlacementGroupLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *PlacementGroup) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // PlacementGroupLocator exposes the PlacementGroup resource actions.
@@ -7391,6 +11370,16 @@ func (api *API) PlacementGroupLocator(href string) *PlacementGroupLocator {
 	return &PlacementGroupLocator{Href(href), api}
 }
 
+// PlacementGroupLocatorInterface lists the PlacementGroupLocator methods, code that depends on the interface
+// rather than on PlacementGroupLocator can be unit tested using the generated fake locators.
+type PlacementGroupLocatorInterface interface {
+	Create(placementGroup *PlacementGroupParam) (*PlacementGroupLocator, error)
+	Destroy() error
+	Index(options *PlacementGroupIndexOptions) ([]*PlacementGroup, error)
+	IndexEach(options *PlacementGroupIndexOptions, fn func(*PlacementGroup) error) error
+	Show(options *PlacementGroupShowOptions) (*PlacementGroup, error)
+}
+
 //===== Actions
 
 // POST /api/placement_groups
@@ -7408,6 +11397,9 @@ func (loc *PlacementGroupLocator) Create(placementGroup *PlacementGroupParam) (*
 	p = rsapi.APIParams{
 		"placement_group": placementGroup,
 	}
+	if err := loc.api.ValidateParams("PlacementGroup", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("PlacementGroup", "create")
 	if err != nil {
 		return res, err
@@ -7420,15 +11412,10 @@ func (loc *PlacementGroupLocator) Create(placementGroup *PlacementGroupParam) (*
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -7443,6 +11430,9 @@ func (loc *PlacementGroupLocator) Create(placementGroup *PlacementGroupParam) (*
 func (loc *PlacementGroupLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("PlacementGroup", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("PlacementGroup", "destroy")
 	if err != nil {
 		return err
@@ -7455,37 +11445,71 @@ func (loc *PlacementGroupLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// PlacementGroupIndexOptions contains the optional parameters of PlacementGroupLocator.Index.
+type PlacementGroupIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *PlacementGroupIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of PlacementGroupLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/placement_groups
 //
 // Lists all PlacementGroups in an account.
 // Optional parameters:
 // filter
 // view
-func (loc *PlacementGroupLocator) Index(options rsapi.APIParams) ([]*PlacementGroup, error) {
+func (loc *PlacementGroupLocator) Index(options *PlacementGroupIndexOptions) ([]*PlacementGroup, error) {
 	var res []*PlacementGroup
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("PlacementGroup", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("PlacementGroup", "index")
 	if err != nil {
 		return res, err
@@ -7498,22 +11522,87 @@ func (loc *PlacementGroupLocator) Index(options rsapi.APIParams) ([]*PlacementGr
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *PlacementGroupLocator) IndexEach(options *PlacementGroupIndexOptions, fn func(*PlacementGroup) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("PlacementGroup", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("PlacementGroup", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *PlacementGroup
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// PlacementGroupShowOptions contains the optional parameters of PlacementGroupLocator.Show.
+type PlacementGroupShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *PlacementGroupShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of PlacementGroupLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/placement_groups/:id
@@ -7521,15 +11610,22 @@ func (loc *PlacementGroupLocator) Index(options rsapi.APIParams) ([]*PlacementGr
 // Shows information about a single PlacementGroup.
 // Optional parameters:
 // view
-func (loc *PlacementGroupLocator) Show(options rsapi.APIParams) (*PlacementGroup, error) {
+func (loc *PlacementGroupLocator) Show(options *PlacementGroupShowOptions) (*PlacementGroup, error) {
 	var res *PlacementGroup
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("PlacementGroup", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("PlacementGroup", "show")
 	if err != nil {
 		return res, err
@@ -7542,21 +11638,11 @@ func (loc *PlacementGroupLocator) Show(options rsapi.APIParams) (*PlacementGroup
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -7566,9 +11652,9 @@ func (loc *PlacementGroupLocator) Show(options rsapi.APIParams) (*PlacementGroup
 type Preference struct {
 	Actions   []map[string]string `json:"actions,omitempty"`
 	Contents  string              `json:"contents,omitempty"`
-	CreatedAt *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt *rsapi.Time         `json:"created_at,omitempty"`
 	Links     []map[string]string `json:"links,omitempty"`
-	UpdatedAt *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -7594,6 +11680,16 @@ func (api *API) PreferenceLocator(href string) *PreferenceLocator {
 	return &PreferenceLocator{Href(href), api}
 }
 
+// PreferenceLocatorInterface lists the PreferenceLocator methods, code that depends on the interface
+// rather than on PreferenceLocator can be unit tested using the generated fake locators.
+type PreferenceLocatorInterface interface {
+	Destroy() error
+	Index(options *PreferenceIndexOptions) ([]*Preference, error)
+	IndexEach(options *PreferenceIndexOptions, fn func(*Preference) error) error
+	Show() (*Preference, error)
+	Update(preference *PreferenceParam) error
+}
+
 //===== Actions
 
 // DELETE /api/preferences/:id
@@ -7602,6 +11698,9 @@ func (api *API) PreferenceLocator(href string) *PreferenceLocator {
 func (loc *PreferenceLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Preference", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Preference", "destroy")
 	if err != nil {
 		return err
@@ -7614,32 +11713,62 @@ func (loc *PreferenceLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// PreferenceIndexOptions contains the optional parameters of PreferenceLocator.Index.
+type PreferenceIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *PreferenceIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of PreferenceLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/preferences
 //
 // Lists all preferences.
 // Optional parameters:
 // filter
-func (loc *PreferenceLocator) Index(options rsapi.APIParams) ([]*Preference, error) {
+func (loc *PreferenceLocator) Index(options *PreferenceIndexOptions) ([]*Preference, error) {
 	var res []*Preference
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Preference", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Preference", "index")
 	if err != nil {
 		return res, err
@@ -7652,22 +11781,55 @@ func (loc *PreferenceLocator) Index(options rsapi.APIParams) ([]*Preference, err
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *PreferenceLocator) IndexEach(options *PreferenceIndexOptions, fn func(*Preference) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Preference", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Preference", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Preference
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/preferences/:id
@@ -7677,6 +11839,9 @@ func (loc *PreferenceLocator) Show() (*Preference, error) {
 	var res *Preference
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Preference", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Preference", "show")
 	if err != nil {
 		return res, err
@@ -7689,21 +11854,11 @@ func (loc *PreferenceLocator) Show() (*Preference, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -7723,6 +11878,9 @@ func (loc *PreferenceLocator) Update(preference *PreferenceParam) error {
 	p = rsapi.APIParams{
 		"preference": preference,
 	}
+	if err := loc.api.ValidateParams("Preference", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Preference", "update")
 	if err != nil {
 		return err
@@ -7735,15 +11893,10 @@ func (loc *PreferenceLocator) Update(preference *PreferenceParam) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -7755,14 +11908,14 @@ type Publication struct {
 	Actions       []map[string]string `json:"actions,omitempty"`
 	CommitMessage string              `json:"commit_message,omitempty"`
 	ContentType   string              `json:"content_type,omitempty"`
-	CreatedAt     *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt     *rsapi.Time         `json:"created_at,omitempty"`
 	Description   string              `json:"description,omitempty"`
 	Links         []map[string]string `json:"links,omitempty"`
 	Name          string              `json:"name,omitempty"`
 	Publisher     string              `json:"publisher,omitempty"`
 	Revision      int                 `json:"revision,omitempty"`
 	RevisionNotes string              `json:"revision_notes,omitempty"`
-	UpdatedAt     *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt     *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -7775,6 +11928,17 @@ func (r *Publication) Locator(api *API) *PublicationLocator {
 	return nil
 }
 
+// LineageLocator returns a locator for the resource pointed to by the "lineage" link.
+// It returns nil if the link is absent.
+func (r *Publication) LineageLocator(api *API) *PublicationLineageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "lineage" {
+			return api.PublicationLineageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // PublicationLocator exposes the Publication resource actions.
@@ -7788,6 +11952,15 @@ func (api *API) PublicationLocator(href string) *PublicationLocator {
 	return &PublicationLocator{Href(href), api}
 }
 
+// PublicationLocatorInterface lists the PublicationLocator methods, code that depends on the interface
+// rather than on PublicationLocator can be unit tested using the generated fake locators.
+type PublicationLocatorInterface interface {
+	Import() error
+	Index(options *PublicationIndexOptions) ([]*Publication, error)
+	IndexEach(options *PublicationIndexOptions, fn func(*Publication) error) error
+	Show(options *PublicationShowOptions) (*Publication, error)
+}
+
 //===== Actions
 
 // POST /api/publications/:id/import
@@ -7797,6 +11970,9 @@ func (api *API) PublicationLocator(href string) *PublicationLocator {
 func (loc *PublicationLocator) Import() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Publication", "import", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Publication", "import")
 	if err != nil {
 		return err
@@ -7809,37 +11985,71 @@ func (loc *PublicationLocator) Import() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// PublicationIndexOptions contains the optional parameters of PublicationLocator.Index.
+type PublicationIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *PublicationIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of PublicationLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/publications
 //
 // Lists the publications available to this account. Only non-HEAD revisions are possible.
 // Optional parameters:
 // filter
 // view
-func (loc *PublicationLocator) Index(options rsapi.APIParams) ([]*Publication, error) {
+func (loc *PublicationLocator) Index(options *PublicationIndexOptions) ([]*Publication, error) {
 	var res []*Publication
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Publication", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Publication", "index")
 	if err != nil {
 		return res, err
@@ -7852,83 +12062,145 @@ func (loc *PublicationLocator) Index(options rsapi.APIParams) ([]*Publication, e
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
-// GET /api/publications/:id
-//
-// Show information about a single publication. Only non-HEAD revisions are possible.
-// Optional parameters:
-// view
-func (loc *PublicationLocator) Show(options rsapi.APIParams) (*Publication, error) {
-	var res *Publication
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *PublicationLocator) IndexEach(options *PublicationIndexOptions, fn func(*Publication) error) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
-	uri, err := loc.ActionPath("Publication", "show")
+	if err := loc.api.ValidateParams("Publication", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Publication", "index")
 	if err != nil {
-		return res, err
+		return err
 	}
 	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
 	if err != nil {
-		return res, err
+		return err
 	}
 	resp, err := loc.api.PerformRequest(req)
 	if err != nil {
-		return res, err
+		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Publication
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
-/******  PublicationLineage ******/
+// PublicationShowOptions contains the optional parameters of PublicationLocator.Show.
+type PublicationShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
 
-// A Publication Lineage contains lineage information for a Publication in the MultiCloudMarketplace.
+// params returns the parameters indexed by name.
+func (o *PublicationShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of PublicationLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
+// GET /api/publications/:id
+//
+// Show information about a single publication. Only non-HEAD revisions are possible.
+// Optional parameters:
+// view
+func (loc *PublicationLocator) Show(options *PublicationShowOptions) (*Publication, error) {
+	var res *Publication
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Publication", "show", params, p); err != nil {
+		return res, err
+	}
+	uri, err := loc.ActionPath("Publication", "show")
+	if err != nil {
+		return res, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return res, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return res, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return res, rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+/******  PublicationLineage ******/
+
+// A Publication Lineage contains lineage information for a Publication in the MultiCloudMarketplace.
 // It is shared among all revisions of a Publication within the marketplace.
 // Publication Lineages are different than lineages that exist within an account.
 type PublicationLineage struct {
 	Actions          []map[string]string `json:"actions,omitempty"`
 	CommentsEmailed  bool                `json:"comments_emailed,omitempty"`
 	CommentsEnabled  bool                `json:"comments_enabled,omitempty"`
-	CreatedAt        *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt        *rsapi.Time         `json:"created_at,omitempty"`
 	Links            []map[string]string `json:"links,omitempty"`
 	LongDescription  string              `json:"long_description,omitempty"`
 	Name             string              `json:"name,omitempty"`
 	ShortDescription string              `json:"short_description,omitempty"`
-	UpdatedAt        *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt        *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -7954,22 +12226,63 @@ func (api *API) PublicationLineageLocator(href string) *PublicationLineageLocato
 	return &PublicationLineageLocator{Href(href), api}
 }
 
+// PublicationLineageLocatorInterface lists the PublicationLineageLocator methods, code that depends on the interface
+// rather than on PublicationLineageLocator can be unit tested using the generated fake locators.
+type PublicationLineageLocatorInterface interface {
+	Show(options *PublicationLineageShowOptions) (*PublicationLineage, error)
+}
+
 //===== Actions
 
+// PublicationLineageShowOptions contains the optional parameters of PublicationLineageLocator.Show.
+type PublicationLineageShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *PublicationLineageShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of PublicationLineageLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/publication_lineages/:id
 //
 // Show information about a single publication lineage. Only non-HEAD revisions are possible.
 // Optional parameters:
 // view
-func (loc *PublicationLineageLocator) Show(options rsapi.APIParams) (*PublicationLineage, error) {
+func (loc *PublicationLineageLocator) Show(options *PublicationLineageShowOptions) (*PublicationLineage, error) {
 	var res *PublicationLineage
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("PublicationLineage", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("PublicationLineage", "show")
 	if err != nil {
 		return res, err
@@ -7982,21 +12295,11 @@ func (loc *PublicationLineageLocator) Show(options rsapi.APIParams) (*Publicatio
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -8005,7 +12308,7 @@ func (loc *PublicationLineageLocator) Show(options rsapi.APIParams) (*Publicatio
 // A RecurringVolumeAttachment specifies a Volume/VolumeSnapshot to attach to a Server/ServerArray the next time an instance is launched.
 type RecurringVolumeAttachment struct {
 	Actions      []map[string]string `json:"actions,omitempty"`
-	CreatedAt    *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt    *rsapi.Time         `json:"created_at,omitempty"`
 	Device       string              `json:"device,omitempty"`
 	DeviceId     string              `json:"device_id,omitempty"`
 	Links        []map[string]string `json:"links,omitempty"`
@@ -8014,7 +12317,7 @@ type RecurringVolumeAttachment struct {
 	Size         string              `json:"size,omitempty"`
 	Status       string              `json:"status,omitempty"`
 	StorageType  string              `json:"storage_type,omitempty"`
-	UpdatedAt    *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt    *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -8027,6 +12330,17 @@ func (r *RecurringVolumeAttachment) Locator(api *API) *RecurringVolumeAttachment
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *RecurringVolumeAttachment) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // RecurringVolumeAttachmentLocator exposes the RecurringVolumeAttachment resource actions.
@@ -8040,6 +12354,16 @@ func (api *API) RecurringVolumeAttachmentLocator(href string) *RecurringVolumeAt
 	return &RecurringVolumeAttachmentLocator{Href(href), api}
 }
 
+// RecurringVolumeAttachmentLocatorInterface lists the RecurringVolumeAttachmentLocator methods, code that depends on the interface
+// rather than on RecurringVolumeAttachmentLocator can be unit tested using the generated fake locators.
+type RecurringVolumeAttachmentLocatorInterface interface {
+	Create(recurringVolumeAttachment *RecurringVolumeAttachmentParam) (*RecurringVolumeAttachmentLocator, error)
+	Destroy() error
+	Index(options *RecurringVolumeAttachmentIndexOptions) ([]*RecurringVolumeAttachment, error)
+	IndexEach(options *RecurringVolumeAttachmentIndexOptions, fn func(*RecurringVolumeAttachment) error) error
+	Show(options *RecurringVolumeAttachmentShowOptions) (*RecurringVolumeAttachment, error)
+}
+
 //===== Actions
 
 // POST /api/clouds/:cloud_id/recurring_volume_attachments
@@ -8059,6 +12383,9 @@ func (loc *RecurringVolumeAttachmentLocator) Create(recurringVolumeAttachment *R
 	p = rsapi.APIParams{
 		"recurring_volume_attachment": recurringVolumeAttachment,
 	}
+	if err := loc.api.ValidateParams("RecurringVolumeAttachment", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RecurringVolumeAttachment", "create")
 	if err != nil {
 		return res, err
@@ -8071,15 +12398,10 @@ func (loc *RecurringVolumeAttachmentLocator) Create(recurringVolumeAttachment *R
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -8096,6 +12418,9 @@ func (loc *RecurringVolumeAttachmentLocator) Create(recurringVolumeAttachment *R
 func (loc *RecurringVolumeAttachmentLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RecurringVolumeAttachment", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("RecurringVolumeAttachment", "destroy")
 	if err != nil {
 		return err
@@ -8108,18 +12433,45 @@ func (loc *RecurringVolumeAttachmentLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// RecurringVolumeAttachmentIndexOptions contains the optional parameters of RecurringVolumeAttachmentLocator.Index.
+type RecurringVolumeAttachmentIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RecurringVolumeAttachmentIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RecurringVolumeAttachmentLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/recurring_volume_attachments
 // GET /api/clouds/:cloud_id/volumes/:volume_id/recurring_volume_attachments
 // GET /api/clouds/:cloud_id/volume_snapshots/:volume_snapshot_id/recurring_volume_attachments
@@ -8128,19 +12480,26 @@ func (loc *RecurringVolumeAttachmentLocator) Destroy() error {
 // Optional parameters:
 // filter
 // view
-func (loc *RecurringVolumeAttachmentLocator) Index(options rsapi.APIParams) ([]*RecurringVolumeAttachment, error) {
+func (loc *RecurringVolumeAttachmentLocator) Index(options *RecurringVolumeAttachmentIndexOptions) ([]*RecurringVolumeAttachment, error) {
 	var res []*RecurringVolumeAttachment
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RecurringVolumeAttachment", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RecurringVolumeAttachment", "index")
 	if err != nil {
 		return res, err
@@ -8153,22 +12512,87 @@ func (loc *RecurringVolumeAttachmentLocator) Index(options rsapi.APIParams) ([]*
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *RecurringVolumeAttachmentLocator) IndexEach(options *RecurringVolumeAttachmentIndexOptions, fn func(*RecurringVolumeAttachment) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RecurringVolumeAttachment", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("RecurringVolumeAttachment", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *RecurringVolumeAttachment
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// RecurringVolumeAttachmentShowOptions contains the optional parameters of RecurringVolumeAttachmentLocator.Show.
+type RecurringVolumeAttachmentShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RecurringVolumeAttachmentShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RecurringVolumeAttachmentLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/clouds/:cloud_id/recurring_volume_attachments/:id
@@ -8178,15 +12602,22 @@ func (loc *RecurringVolumeAttachmentLocator) Index(options rsapi.APIParams) ([]*
 // Displays information about a single recurring volume attachment.
 // Optional parameters:
 // view
-func (loc *RecurringVolumeAttachmentLocator) Show(options rsapi.APIParams) (*RecurringVolumeAttachment, error) {
+func (loc *RecurringVolumeAttachmentLocator) Show(options *RecurringVolumeAttachmentShowOptions) (*RecurringVolumeAttachment, error) {
 	var res *RecurringVolumeAttachment
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RecurringVolumeAttachment", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RecurringVolumeAttachment", "show")
 	if err != nil {
 		return res, err
@@ -8199,21 +12630,11 @@ func (loc *RecurringVolumeAttachmentLocator) Show(options rsapi.APIParams) (*Rec
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -8226,7 +12647,7 @@ type Repository struct {
 	AssetCounts     int                 `json:"asset_counts,omitempty"`
 	AssetPaths      []string            `json:"asset_paths,omitempty"`
 	CommitReference string              `json:"commit_reference,omitempty"`
-	CreatedAt       *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt       *rsapi.Time         `json:"created_at,omitempty"`
 	Credentials     map[string]string   `json:"credentials,omitempty"`
 	Description     string              `json:"description,omitempty"`
 	FetchStatus     string              `json:"fetch_status,omitempty"`
@@ -8236,7 +12657,7 @@ type Repository struct {
 	ReadOnly        bool                `json:"read_only,omitempty"`
 	Source          string              `json:"source,omitempty"`
 	SourceType      string              `json:"source_type,omitempty"`
-	UpdatedAt       *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt       *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -8249,6 +12670,17 @@ func (r *Repository) Locator(api *API) *RepositoryLocator {
 	return nil
 }
 
+// RepositoryAssetsLocator returns a locator for the resource pointed to by the "repository_assets" link.
+// It returns nil if the link is absent.
+func (r *Repository) RepositoryAssetsLocator(api *API) *RepositoryAssetLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "repository_assets" {
+			return api.RepositoryAssetLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // RepositoryLocator exposes the Repository resource actions.
@@ -8262,8 +12694,67 @@ func (api *API) RepositoryLocator(href string) *RepositoryLocator {
 	return &RepositoryLocator{Href(href), api}
 }
 
+// RepositoryLocatorInterface lists the RepositoryLocator methods, code that depends on the interface
+// rather than on RepositoryLocator can be unit tested using the generated fake locators.
+type RepositoryLocatorInterface interface {
+	CookbookImport(assetHrefs []string, options *RepositoryCookbookImportOptions) error
+	CookbookImportPreview(assetHrefs []string, namespace string) ([]map[string]interface{}, error)
+	Create(repository *RepositoryParam) (*RepositoryLocator, error)
+	Destroy() error
+	Index(options *RepositoryIndexOptions) ([]*Repository, error)
+	IndexEach(options *RepositoryIndexOptions, fn func(*Repository) error) error
+	Refetch(options *RepositoryRefetchOptions) error
+	Resolve(options *RepositoryResolveOptions) ([]*Repository, error)
+	Show(options *RepositoryShowOptions) (*Repository, error)
+	Update(repository *RepositoryParam2) error
+}
+
 //===== Actions
 
+// RepositoryCookbookImportOptions contains the optional parameters of RepositoryLocator.CookbookImport.
+type RepositoryCookbookImportOptions struct {
+	// A flag indicating whether imported cookbooks should be followed.
+	Follow string
+	// The namespace to import into.
+	Namespace string
+	// Optional commit reference indicating last succeeded commit. Must match the Repository's fetch_status.succeeded_commit attribute or the import will not be performed.
+	RepositoryCommitReference string
+	// A flag indicating whether dependencies should automatically be imported.
+	WithDependencies string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RepositoryCookbookImportOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "follow", "namespace", "repository_commit_reference", "with_dependencies":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RepositoryLocator.CookbookImport", n)
+		}
+	}
+	if o.Follow != "" {
+		p["follow"] = o.Follow
+	}
+	if o.Namespace != "" {
+		p["namespace"] = o.Namespace
+	}
+	if o.RepositoryCommitReference != "" {
+		p["repository_commit_reference"] = o.RepositoryCommitReference
+	}
+	if o.WithDependencies != "" {
+		p["with_dependencies"] = o.WithDependencies
+	}
+	return p, nil
+}
+
 // POST /api/repositories/:id/cookbook_import
 //
 // Performs a Cookbook import, which allows you to use the specified cookbooks in your design objects.
@@ -8274,31 +12765,38 @@ func (api *API) RepositoryLocator(href string) *RepositoryLocator {
 // namespace: The namespace to import into.
 // repository_commit_reference: Optional commit reference indicating last succeeded commit. Must match the Repository's fetch_status.succeeded_commit attribute or the import will not be performed.
 // with_dependencies: A flag indicating whether dependencies should automatically be imported.
-func (loc *RepositoryLocator) CookbookImport(assetHrefs []string, options rsapi.APIParams) error {
+func (loc *RepositoryLocator) CookbookImport(assetHrefs []string, options *RepositoryCookbookImportOptions) error {
 	if len(assetHrefs) == 0 {
 		return fmt.Errorf("assetHrefs is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"asset_hrefs": assetHrefs,
 	}
-	var followOpt = options["follow"]
+	var followOpt = opts["follow"]
 	if followOpt != nil {
 		p["follow"] = followOpt
 	}
-	var namespaceOpt = options["namespace"]
+	var namespaceOpt = opts["namespace"]
 	if namespaceOpt != nil {
 		p["namespace"] = namespaceOpt
 	}
-	var repositoryCommitReferenceOpt = options["repository_commit_reference"]
+	var repositoryCommitReferenceOpt = opts["repository_commit_reference"]
 	if repositoryCommitReferenceOpt != nil {
 		p["repository_commit_reference"] = repositoryCommitReferenceOpt
 	}
-	var withDependenciesOpt = options["with_dependencies"]
+	var withDependenciesOpt = opts["with_dependencies"]
 	if withDependenciesOpt != nil {
 		p["with_dependencies"] = withDependenciesOpt
 	}
+	if err := loc.api.ValidateParams("Repository", "cookbook_import", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Repository", "cookbook_import")
 	if err != nil {
 		return err
@@ -8311,15 +12809,10 @@ func (loc *RepositoryLocator) CookbookImport(assetHrefs []string, options rsapi.
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -8346,6 +12839,9 @@ func (loc *RepositoryLocator) CookbookImportPreview(assetHrefs []string, namespa
 		"asset_hrefs": assetHrefs,
 		"namespace":   namespace,
 	}
+	if err := loc.api.ValidateParams("Repository", "cookbook_import_preview", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Repository", "cookbook_import_preview")
 	if err != nil {
 		return res, err
@@ -8358,21 +12854,11 @@ func (loc *RepositoryLocator) CookbookImportPreview(assetHrefs []string, namespa
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -8401,6 +12887,9 @@ func (loc *RepositoryLocator) Create(repository *RepositoryParam) (*RepositoryLo
 	p = rsapi.APIParams{
 		"repository": repository,
 	}
+	if err := loc.api.ValidateParams("Repository", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Repository", "create")
 	if err != nil {
 		return res, err
@@ -8413,15 +12902,10 @@ func (loc *RepositoryLocator) Create(repository *RepositoryParam) (*RepositoryLo
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -8436,6 +12920,9 @@ func (loc *RepositoryLocator) Create(repository *RepositoryParam) (*RepositoryLo
 func (loc *RepositoryLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Repository", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Repository", "destroy")
 	if err != nil {
 		return err
@@ -8448,37 +12935,71 @@ func (loc *RepositoryLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// RepositoryIndexOptions contains the optional parameters of RepositoryLocator.Index.
+type RepositoryIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RepositoryIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RepositoryLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/repositories
 //
 // Lists all Repositories for this Account.
 // Optional parameters:
 // filter
 // view
-func (loc *RepositoryLocator) Index(options rsapi.APIParams) ([]*Repository, error) {
+func (loc *RepositoryLocator) Index(options *RepositoryIndexOptions) ([]*Repository, error) {
 	var res []*Repository
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Repository", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Repository", "index")
 	if err != nil {
 		return res, err
@@ -8491,40 +13012,37 @@ func (loc *RepositoryLocator) Index(options rsapi.APIParams) ([]*Repository, err
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
-// POST /api/repositories/:id/refetch
-//
-// Refetches all RepositoryAssets associated with the Repository.
-// Note that a refetch simply updates RightScale's view of the contents of the repository.
-// You must perform an import to use the assets in your design objects (or use the auto import parameter).
-// Optional parameters:
-// auto_import: Whether cookbooks should automatically be imported after repositories are fetched.
-func (loc *RepositoryLocator) Refetch(options rsapi.APIParams) error {
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *RepositoryLocator) IndexEach(options *RepositoryIndexOptions, fn func(*Repository) error) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
 	var p rsapi.APIParams
-	p = rsapi.APIParams{}
-	var autoImportOpt = options["auto_import"]
-	if autoImportOpt != nil {
-		p["auto_import"] = autoImportOpt
+	if err := loc.api.ValidateParams("Repository", "index", params, p); err != nil {
+		return err
 	}
-	uri, err := loc.ActionPath("Repository", "refetch")
+	uri, err := loc.ActionPath("Repository", "index")
 	if err != nil {
 		return err
 	}
@@ -8536,35 +13054,142 @@ func (loc *RepositoryLocator) Refetch(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Repository
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// RepositoryRefetchOptions contains the optional parameters of RepositoryLocator.Refetch.
+type RepositoryRefetchOptions struct {
+	// Whether cookbooks should automatically be imported after repositories are fetched.
+	AutoImport string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RepositoryRefetchOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "auto_import":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RepositoryLocator.Refetch", n)
 		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
 	}
-	return nil
+	if o.AutoImport != "" {
+		p["auto_import"] = o.AutoImport
+	}
+	return p, nil
 }
 
-// POST /api/repositories/resolve
+// POST /api/repositories/:id/refetch
 //
-// Show a list of repositories that have imported cookbooks with the given names.
+// Refetches all RepositoryAssets associated with the Repository.
+// Note that a refetch simply updates RightScale's view of the contents of the repository.
+// You must perform an import to use the assets in your design objects (or use the auto import parameter).
+// Optional parameters:
+// auto_import: Whether cookbooks should automatically be imported after repositories are fetched.
+func (loc *RepositoryLocator) Refetch(options *RepositoryRefetchOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	p = rsapi.APIParams{}
+	var autoImportOpt = opts["auto_import"]
+	if autoImportOpt != nil {
+		p["auto_import"] = autoImportOpt
+	}
+	if err := loc.api.ValidateParams("Repository", "refetch", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Repository", "refetch")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// RepositoryResolveOptions contains the optional parameters of RepositoryLocator.Resolve.
+type RepositoryResolveOptions struct {
+	// A list of cookbook names that were imported by the repository.
+	ImportedCookbookName []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RepositoryResolveOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "imported_cookbook_name":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RepositoryLocator.Resolve", n)
+		}
+	}
+	if o.ImportedCookbookName != nil {
+		p["imported_cookbook_name"] = o.ImportedCookbookName
+	}
+	return p, nil
+}
+
+// POST /api/repositories/resolve
+//
+// Show a list of repositories that have imported cookbooks with the given names.
 // This operation returns a list of repositories that would later satisfy a call
 // to the swap_repository
 // action on a ServerTemplate.
 // Optional parameters:
 // imported_cookbook_name: A list of cookbook names that were imported by the repository.
-func (loc *RepositoryLocator) Resolve(options rsapi.APIParams) ([]*Repository, error) {
+func (loc *RepositoryLocator) Resolve(options *RepositoryResolveOptions) ([]*Repository, error) {
 	var res []*Repository
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var importedCookbookNameOpt = options["imported_cookbook_name"]
+	var importedCookbookNameOpt = opts["imported_cookbook_name"]
 	if importedCookbookNameOpt != nil {
 		p["imported_cookbook_name"] = importedCookbookNameOpt
 	}
+	if err := loc.api.ValidateParams("Repository", "resolve", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Repository", "resolve")
 	if err != nil {
 		return res, err
@@ -8577,38 +13202,63 @@ func (loc *RepositoryLocator) Resolve(options rsapi.APIParams) ([]*Repository, e
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// RepositoryShowOptions contains the optional parameters of RepositoryLocator.Show.
+type RepositoryShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RepositoryShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RepositoryLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/repositories/:id
 //
 // Shows a specified Repository.
 // Optional parameters:
 // view
-func (loc *RepositoryLocator) Show(options rsapi.APIParams) (*Repository, error) {
+func (loc *RepositoryLocator) Show(options *RepositoryShowOptions) (*Repository, error) {
 	var res *Repository
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Repository", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Repository", "show")
 	if err != nil {
 		return res, err
@@ -8621,21 +13271,11 @@ func (loc *RepositoryLocator) Show(options rsapi.APIParams) (*Repository, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -8663,6 +13303,9 @@ func (loc *RepositoryLocator) Update(repository *RepositoryParam2) error {
 	p = rsapi.APIParams{
 		"repository": repository,
 	}
+	if err := loc.api.ValidateParams("Repository", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Repository", "update")
 	if err != nil {
 		return err
@@ -8675,15 +13318,10 @@ func (loc *RepositoryLocator) Update(repository *RepositoryParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -8723,8 +13361,44 @@ func (api *API) RepositoryAssetLocator(href string) *RepositoryAssetLocator {
 	return &RepositoryAssetLocator{Href(href), api}
 }
 
+// RepositoryAssetLocatorInterface lists the RepositoryAssetLocator methods, code that depends on the interface
+// rather than on RepositoryAssetLocator can be unit tested using the generated fake locators.
+type RepositoryAssetLocatorInterface interface {
+	Index(options *RepositoryAssetIndexOptions) ([]*RepositoryAsset, error)
+	IndexEach(options *RepositoryAssetIndexOptions, fn func(*RepositoryAsset) error) error
+	Show(options *RepositoryAssetShowOptions) (*RepositoryAsset, error)
+}
+
 //===== Actions
 
+// RepositoryAssetIndexOptions contains the optional parameters of RepositoryAssetLocator.Index.
+type RepositoryAssetIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RepositoryAssetIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RepositoryAssetLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/repositories/:repository_id/repository_assets
 //
 // List a repository's current assets.
@@ -8732,15 +13406,22 @@ func (api *API) RepositoryAssetLocator(href string) *RepositoryAssetLocator {
 // given repository.
 // Optional parameters:
 // view
-func (loc *RepositoryAssetLocator) Index(options rsapi.APIParams) ([]*RepositoryAsset, error) {
+func (loc *RepositoryAssetLocator) Index(options *RepositoryAssetIndexOptions) ([]*RepositoryAsset, error) {
 	var res []*RepositoryAsset
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RepositoryAsset", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RepositoryAsset", "index")
 	if err != nil {
 		return res, err
@@ -8753,22 +13434,83 @@ func (loc *RepositoryAssetLocator) Index(options rsapi.APIParams) ([]*Repository
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *RepositoryAssetLocator) IndexEach(options *RepositoryAssetIndexOptions, fn func(*RepositoryAsset) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RepositoryAsset", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("RepositoryAsset", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *RepositoryAsset
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// RepositoryAssetShowOptions contains the optional parameters of RepositoryAssetLocator.Show.
+type RepositoryAssetShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RepositoryAssetShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RepositoryAssetLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/repositories/:repository_id/repository_assets/:id
@@ -8778,15 +13520,22 @@ func (loc *RepositoryAssetLocator) Index(options rsapi.APIParams) ([]*Repository
 // repository.
 // Optional parameters:
 // view
-func (loc *RepositoryAssetLocator) Show(options rsapi.APIParams) (*RepositoryAsset, error) {
+func (loc *RepositoryAssetLocator) Show(options *RepositoryAssetShowOptions) (*RepositoryAsset, error) {
 	var res *RepositoryAsset
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RepositoryAsset", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RepositoryAsset", "show")
 	if err != nil {
 		return res, err
@@ -8799,21 +13548,11 @@ func (loc *RepositoryAssetLocator) Show(options rsapi.APIParams) (*RepositoryAss
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -8826,7 +13565,7 @@ func (loc *RepositoryAssetLocator) Show(options rsapi.APIParams) (*RepositoryAss
 // "lineage" attribute (NOTE: This attribute is merely a string to locate
 // all revisions of a RightScript and NOT a working URL).
 type RightScript struct {
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Description string              `json:"description,omitempty"`
 	Id          string              `json:"id,omitempty"`
 	Lineage     string              `json:"lineage,omitempty"`
@@ -8834,7 +13573,7 @@ type RightScript struct {
 	Name        string              `json:"name,omitempty"`
 	Revision    int                 `json:"revision,omitempty"`
 	Source      string              `json:"source,omitempty"`
-	UpdatedAt   *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt   *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -8860,6 +13599,18 @@ func (api *API) RightScriptLocator(href string) *RightScriptLocator {
 	return &RightScriptLocator{Href(href), api}
 }
 
+// RightScriptLocatorInterface lists the RightScriptLocator methods, code that depends on the interface
+// rather than on RightScriptLocator can be unit tested using the generated fake locators.
+type RightScriptLocatorInterface interface {
+	Commit(rightScript *RightScriptParam) error
+	Index(options *RightScriptIndexOptions) ([]*RightScript, error)
+	IndexEach(options *RightScriptIndexOptions, fn func(*RightScript) error) error
+	Show() (*RightScript, error)
+	ShowSource() error
+	Update(rightScript *RightScriptParam2) error
+	UpdateSource() error
+}
+
 //===== Actions
 
 // POST /api/right_scripts/:id/commit
@@ -8876,6 +13627,9 @@ func (loc *RightScriptLocator) Commit(rightScript *RightScriptParam) error {
 	p = rsapi.APIParams{
 		"right_script": rightScript,
 	}
+	if err := loc.api.ValidateParams("RightScript", "commit", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("RightScript", "commit")
 	if err != nil {
 		return err
@@ -8888,18 +13642,50 @@ func (loc *RightScriptLocator) Commit(rightScript *RightScriptParam) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// RightScriptIndexOptions contains the optional parameters of RightScriptLocator.Index.
+type RightScriptIndexOptions struct {
+	Filter []string
+	// Whether or not to return only the latest version for each lineage.
+	LatestOnly string
+	View       string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RightScriptIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "latest_only", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RightScriptLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.LatestOnly != "" {
+		p["latest_only"] = o.LatestOnly
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/right_scripts
 //
 // Lists RightScripts.
@@ -8907,24 +13693,31 @@ func (loc *RightScriptLocator) Commit(rightScript *RightScriptParam) error {
 // filter
 // latest_only: Whether or not to return only the latest version for each lineage.
 // view
-func (loc *RightScriptLocator) Index(options rsapi.APIParams) ([]*RightScript, error) {
+func (loc *RightScriptLocator) Index(options *RightScriptIndexOptions) ([]*RightScript, error) {
 	var res []*RightScript
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var latestOnlyOpt = options["latest_only"]
+	var latestOnlyOpt = opts["latest_only"]
 	if latestOnlyOpt != nil {
 		p["latest_only"] = latestOnlyOpt
 	}
+	if err := loc.api.ValidateParams("RightScript", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RightScript", "index")
 	if err != nil {
 		return res, err
@@ -8937,22 +13730,64 @@ func (loc *RightScriptLocator) Index(options rsapi.APIParams) ([]*RightScript, e
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *RightScriptLocator) IndexEach(options *RightScriptIndexOptions, fn func(*RightScript) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	p = rsapi.APIParams{}
+	var latestOnlyOpt = opts["latest_only"]
+	if latestOnlyOpt != nil {
+		p["latest_only"] = latestOnlyOpt
+	}
+	if err := loc.api.ValidateParams("RightScript", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("RightScript", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *RightScript
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/right_scripts/:id
@@ -8962,6 +13797,9 @@ func (loc *RightScriptLocator) Show() (*RightScript, error) {
 	var res *RightScript
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RightScript", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RightScript", "show")
 	if err != nil {
 		return res, err
@@ -8974,21 +13812,11 @@ func (loc *RightScriptLocator) Show() (*RightScript, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -8998,6 +13826,9 @@ func (loc *RightScriptLocator) Show() (*RightScript, error) {
 func (loc *RightScriptLocator) ShowSource() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RightScript", "show_source", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("RightScript", "show_source")
 	if err != nil {
 		return err
@@ -9010,15 +13841,10 @@ func (loc *RightScriptLocator) ShowSource() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -9036,6 +13862,9 @@ func (loc *RightScriptLocator) Update(rightScript *RightScriptParam2) error {
 	p = rsapi.APIParams{
 		"right_script": rightScript,
 	}
+	if err := loc.api.ValidateParams("RightScript", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("RightScript", "update")
 	if err != nil {
 		return err
@@ -9048,15 +13877,10 @@ func (loc *RightScriptLocator) Update(rightScript *RightScriptParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -9066,6 +13890,9 @@ func (loc *RightScriptLocator) Update(rightScript *RightScriptParam2) error {
 func (loc *RightScriptLocator) UpdateSource() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RightScript", "update_source", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("RightScript", "update_source")
 	if err != nil {
 		return err
@@ -9078,15 +13905,10 @@ func (loc *RightScriptLocator) UpdateSource() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -9095,7 +13917,7 @@ func (loc *RightScriptLocator) UpdateSource() error {
 // A Route defines how networking traffic should be routed from one
 // destination to another. See nexthoptype for available endpoint targets.
 type Route struct {
-	CreatedAt            *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt            *rsapi.Time         `json:"created_at,omitempty"`
 	Description          string              `json:"description,omitempty"`
 	DestinationCidrBlock string              `json:"destination_cidr_block,omitempty"`
 	Links                []map[string]string `json:"links,omitempty"`
@@ -9103,7 +13925,7 @@ type Route struct {
 	NextHopType          string              `json:"next_hop_type,omitempty"`
 	ResourceUid          string              `json:"resource_uid,omitempty"`
 	State                string              `json:"state,omitempty"`
-	UpdatedAt            *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt            *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -9116,6 +13938,28 @@ func (r *Route) Locator(api *API) *RouteLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *Route) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RouteTableLocator returns a locator for the resource pointed to by the "route_table" link.
+// It returns nil if the link is absent.
+func (r *Route) RouteTableLocator(api *API) *RouteTableLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "route_table" {
+			return api.RouteTableLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // RouteLocator exposes the Route resource actions.
@@ -9129,6 +13973,17 @@ func (api *API) RouteLocator(href string) *RouteLocator {
 	return &RouteLocator{Href(href), api}
 }
 
+// RouteLocatorInterface lists the RouteLocator methods, code that depends on the interface
+// rather than on RouteLocator can be unit tested using the generated fake locators.
+type RouteLocatorInterface interface {
+	Create(route *RouteParam) (*RouteLocator, error)
+	Destroy() error
+	Index(options *RouteIndexOptions) ([]*Route, error)
+	IndexEach(options *RouteIndexOptions, fn func(*Route) error) error
+	Show() (*Route, error)
+	Update(route *RouteParam2) error
+}
+
 //===== Actions
 
 // POST /api/routes
@@ -9147,6 +14002,9 @@ func (loc *RouteLocator) Create(route *RouteParam) (*RouteLocator, error) {
 	p = rsapi.APIParams{
 		"route": route,
 	}
+	if err := loc.api.ValidateParams("Route", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Route", "create")
 	if err != nil {
 		return res, err
@@ -9159,15 +14017,10 @@ func (loc *RouteLocator) Create(route *RouteParam) (*RouteLocator, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -9183,6 +14036,9 @@ func (loc *RouteLocator) Create(route *RouteParam) (*RouteLocator, error) {
 func (loc *RouteLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Route", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Route", "destroy")
 	if err != nil {
 		return err
@@ -9195,33 +14051,63 @@ func (loc *RouteLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// RouteIndexOptions contains the optional parameters of RouteLocator.Index.
+type RouteIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RouteIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RouteLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/routes
 // GET /api/route_tables/:route_table_id/routes
 //
 // List Routes available in this account.
 // Optional parameters:
 // filter
-func (loc *RouteLocator) Index(options rsapi.APIParams) ([]*Route, error) {
+func (loc *RouteLocator) Index(options *RouteIndexOptions) ([]*Route, error) {
 	var res []*Route
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Route", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Route", "index")
 	if err != nil {
 		return res, err
@@ -9234,22 +14120,55 @@ func (loc *RouteLocator) Index(options rsapi.APIParams) ([]*Route, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *RouteLocator) IndexEach(options *RouteIndexOptions, fn func(*Route) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Route", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Route", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Route
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/routes/:id
@@ -9260,6 +14179,9 @@ func (loc *RouteLocator) Show() (*Route, error) {
 	var res *Route
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Route", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Route", "show")
 	if err != nil {
 		return res, err
@@ -9272,21 +14194,11 @@ func (loc *RouteLocator) Show() (*Route, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -9305,6 +14217,9 @@ func (loc *RouteLocator) Update(route *RouteParam2) error {
 	p = rsapi.APIParams{
 		"route": route,
 	}
+	if err := loc.api.ValidateParams("Route", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Route", "update")
 	if err != nil {
 		return err
@@ -9317,15 +14232,10 @@ func (loc *RouteLocator) Update(route *RouteParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -9334,13 +14244,13 @@ func (loc *RouteLocator) Update(route *RouteParam2) error {
 // Grouped listing of Routes
 type RouteTable struct {
 	Actions     []map[string]string `json:"actions,omitempty"`
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Description string              `json:"description,omitempty"`
 	Links       []map[string]string `json:"links,omitempty"`
 	Name        string              `json:"name,omitempty"`
 	ResourceUid string              `json:"resource_uid,omitempty"`
 	Routes      []Route             `json:"routes,omitempty"`
-	UpdatedAt   *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt   *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -9353,6 +14263,39 @@ func (r *RouteTable) Locator(api *API) *RouteTableLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *RouteTable) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NetworkLocator returns a locator for the resource pointed to by the "network" link.
+// It returns nil if the link is absent.
+func (r *RouteTable) NetworkLocator(api *API) *NetworkLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "network" {
+			return api.NetworkLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RoutesLocator returns a locator for the resource pointed to by the "routes" link.
+// It returns nil if the link is absent.
+func (r *RouteTable) RoutesLocator(api *API) *RouteLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "routes" {
+			return api.RouteLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // RouteTableLocator exposes the RouteTable resource actions.
@@ -9366,6 +14309,17 @@ func (api *API) RouteTableLocator(href string) *RouteTableLocator {
 	return &RouteTableLocator{Href(href), api}
 }
 
+// RouteTableLocatorInterface lists the RouteTableLocator methods, code that depends on the interface
+// rather than on RouteTableLocator can be unit tested using the generated fake locators.
+type RouteTableLocatorInterface interface {
+	Create(routeTable *RouteTableParam) (*RouteTableLocator, error)
+	Destroy() error
+	Index(options *RouteTableIndexOptions) ([]*RouteTable, error)
+	IndexEach(options *RouteTableIndexOptions, fn func(*RouteTable) error) error
+	Show(options *RouteTableShowOptions) (*RouteTable, error)
+	Update(routeTable *RouteTableParam2) error
+}
+
 //===== Actions
 
 // POST /api/route_tables
@@ -9383,6 +14337,9 @@ func (loc *RouteTableLocator) Create(routeTable *RouteTableParam) (*RouteTableLo
 	p = rsapi.APIParams{
 		"route_table": routeTable,
 	}
+	if err := loc.api.ValidateParams("RouteTable", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RouteTable", "create")
 	if err != nil {
 		return res, err
@@ -9395,15 +14352,10 @@ func (loc *RouteTableLocator) Create(routeTable *RouteTableParam) (*RouteTableLo
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -9418,6 +14370,9 @@ func (loc *RouteTableLocator) Create(routeTable *RouteTableParam) (*RouteTableLo
 func (loc *RouteTableLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RouteTable", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("RouteTable", "destroy")
 	if err != nil {
 		return err
@@ -9430,37 +14385,71 @@ func (loc *RouteTableLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// RouteTableIndexOptions contains the optional parameters of RouteTableLocator.Index.
+type RouteTableIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RouteTableIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RouteTableLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/route_tables
 //
 // List RouteTables available in this account.
 // Optional parameters:
 // filter
 // view
-func (loc *RouteTableLocator) Index(options rsapi.APIParams) ([]*RouteTable, error) {
+func (loc *RouteTableLocator) Index(options *RouteTableIndexOptions) ([]*RouteTable, error) {
 	var res []*RouteTable
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RouteTable", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RouteTable", "index")
 	if err != nil {
 		return res, err
@@ -9473,22 +14462,87 @@ func (loc *RouteTableLocator) Index(options rsapi.APIParams) ([]*RouteTable, err
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *RouteTableLocator) IndexEach(options *RouteTableIndexOptions, fn func(*RouteTable) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RouteTable", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("RouteTable", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *RouteTable
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// RouteTableShowOptions contains the optional parameters of RouteTableLocator.Show.
+type RouteTableShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RouteTableShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RouteTableLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/route_tables/:id
@@ -9496,15 +14550,22 @@ func (loc *RouteTableLocator) Index(options rsapi.APIParams) ([]*RouteTable, err
 // Show information about a single RouteTable.
 // Optional parameters:
 // view
-func (loc *RouteTableLocator) Show(options rsapi.APIParams) (*RouteTable, error) {
+func (loc *RouteTableLocator) Show(options *RouteTableShowOptions) (*RouteTable, error) {
 	var res *RouteTable
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RouteTable", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RouteTable", "show")
 	if err != nil {
 		return res, err
@@ -9517,21 +14578,11 @@ func (loc *RouteTableLocator) Show(options rsapi.APIParams) (*RouteTable, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -9549,6 +14600,9 @@ func (loc *RouteTableLocator) Update(routeTable *RouteTableParam2) error {
 	p = rsapi.APIParams{
 		"route_table": routeTable,
 	}
+	if err := loc.api.ValidateParams("RouteTable", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("RouteTable", "update")
 	if err != nil {
 		return err
@@ -9561,15 +14615,10 @@ func (loc *RouteTableLocator) Update(routeTable *RouteTableParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -9598,6 +14647,28 @@ func (r *RunnableBinding) Locator(api *API) *RunnableBindingLocator {
 	return nil
 }
 
+// RightScriptLocator returns a locator for the resource pointed to by the "right_script" link.
+// It returns nil if the link is absent.
+func (r *RunnableBinding) RightScriptLocator(api *API) *RightScriptLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "right_script" {
+			return api.RightScriptLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ServerTemplateLocator returns a locator for the resource pointed to by the "server_template" link.
+// It returns nil if the link is absent.
+func (r *RunnableBinding) ServerTemplateLocator(api *API) *ServerTemplateLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "server_template" {
+			return api.ServerTemplateLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // RunnableBindingLocator exposes the RunnableBinding resource actions.
@@ -9611,6 +14682,17 @@ func (api *API) RunnableBindingLocator(href string) *RunnableBindingLocator {
 	return &RunnableBindingLocator{Href(href), api}
 }
 
+// RunnableBindingLocatorInterface lists the RunnableBindingLocator methods, code that depends on the interface
+// rather than on RunnableBindingLocator can be unit tested using the generated fake locators.
+type RunnableBindingLocatorInterface interface {
+	Create(runnableBinding *RunnableBindingParam) (*RunnableBindingLocator, error)
+	Destroy() error
+	Index(options *RunnableBindingIndexOptions) ([]*RunnableBinding, error)
+	IndexEach(options *RunnableBindingIndexOptions, fn func(*RunnableBinding) error) error
+	MultiUpdate(runnableBindings []*RunnableBindings) error
+	Show(options *RunnableBindingShowOptions) (*RunnableBinding, error)
+}
+
 //===== Actions
 
 // POST /api/server_templates/:server_template_id/runnable_bindings
@@ -9630,6 +14712,9 @@ func (loc *RunnableBindingLocator) Create(runnableBinding *RunnableBindingParam)
 	p = rsapi.APIParams{
 		"runnable_binding": runnableBinding,
 	}
+	if err := loc.api.ValidateParams("RunnableBinding", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RunnableBinding", "create")
 	if err != nil {
 		return res, err
@@ -9642,15 +14727,10 @@ func (loc *RunnableBindingLocator) Create(runnableBinding *RunnableBindingParam)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -9666,6 +14746,9 @@ func (loc *RunnableBindingLocator) Create(runnableBinding *RunnableBindingParam)
 func (loc *RunnableBindingLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RunnableBinding", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("RunnableBinding", "destroy")
 	if err != nil {
 		return err
@@ -9678,33 +14761,63 @@ func (loc *RunnableBindingLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// RunnableBindingIndexOptions contains the optional parameters of RunnableBindingLocator.Index.
+type RunnableBindingIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RunnableBindingIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RunnableBindingLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/server_templates/:server_template_id/runnable_bindings
 //
 // Lists the executables bound to the ServerTemplate.
 // An excutable may be either a RightScript or Chef Recipe.
 // Optional parameters:
 // view
-func (loc *RunnableBindingLocator) Index(options rsapi.APIParams) ([]*RunnableBinding, error) {
+func (loc *RunnableBindingLocator) Index(options *RunnableBindingIndexOptions) ([]*RunnableBinding, error) {
 	var res []*RunnableBinding
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RunnableBinding", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RunnableBinding", "index")
 	if err != nil {
 		return res, err
@@ -9717,22 +14830,55 @@ func (loc *RunnableBindingLocator) Index(options rsapi.APIParams) ([]*RunnableBi
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *RunnableBindingLocator) IndexEach(options *RunnableBindingIndexOptions, fn func(*RunnableBinding) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RunnableBinding", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("RunnableBinding", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *RunnableBinding
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // PUT /api/server_templates/:server_template_id/runnable_bindings/multi_update
@@ -9750,6 +14896,9 @@ func (loc *RunnableBindingLocator) MultiUpdate(runnableBindings []*RunnableBindi
 	p = rsapi.APIParams{
 		"runnable_bindings": runnableBindings,
 	}
+	if err := loc.api.ValidateParams("RunnableBinding", "multi_update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("RunnableBinding", "multi_update")
 	if err != nil {
 		return err
@@ -9762,33 +14911,63 @@ func (loc *RunnableBindingLocator) MultiUpdate(runnableBindings []*RunnableBindi
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// RunnableBindingShowOptions contains the optional parameters of RunnableBindingLocator.Show.
+type RunnableBindingShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *RunnableBindingShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of RunnableBindingLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/server_templates/:server_template_id/runnable_bindings/:id
 //
 // Show information about a single executable binding.
 // An excutable may be either a RightScript or Chef Recipe.
 // Optional parameters:
 // view
-func (loc *RunnableBindingLocator) Show(options rsapi.APIParams) (*RunnableBinding, error) {
+func (loc *RunnableBindingLocator) Show(options *RunnableBindingShowOptions) (*RunnableBinding, error) {
 	var res *RunnableBinding
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("RunnableBinding", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("RunnableBinding", "show")
 	if err != nil {
 		return res, err
@@ -9801,21 +14980,11 @@ func (loc *RunnableBindingLocator) Show(options rsapi.APIParams) (*RunnableBindi
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -9838,8 +15007,79 @@ func (api *API) SchedulerLocator(href string) *SchedulerLocator {
 	return &SchedulerLocator{Href(href), api}
 }
 
+// SchedulerLocatorInterface lists the SchedulerLocator methods, code that depends on the interface
+// rather than on SchedulerLocator can be unit tested using the generated fake locators.
+type SchedulerLocatorInterface interface {
+	ScheduleRecipe(options *SchedulerScheduleRecipeOptions) error
+	ScheduleRightScript(options *SchedulerScheduleRightScriptOptions) error
+}
+
 //===== Actions
 
+// SchedulerScheduleRecipeOptions contains the optional parameters of SchedulerLocator.ScheduleRecipe.
+type SchedulerScheduleRecipeOptions struct {
+	// Serialized recipe execution arguments values keyed by name
+	Arguments map[string]interface{}
+	// Optional, reuse audit if specified
+	AuditId int
+	// RunlistPolicy audit period
+	AuditPeriod string
+	// Formal input parameter values
+	FormalValues map[string]interface{}
+	// RunlistPolicy policy name
+	Policy string
+	// Chef recipe name, overridden by recipe_id
+	Recipe string
+	// ServerTemplateChefRecipe ID
+	RecipeId int
+	// RunlistPolicy thread name
+	Thread string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SchedulerScheduleRecipeOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "arguments", "audit_id", "audit_period", "formal_values", "policy", "recipe", "recipe_id", "thread":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SchedulerLocator.ScheduleRecipe", n)
+		}
+	}
+	if o.Arguments != nil {
+		p["arguments"] = o.Arguments
+	}
+	if o.AuditId != 0 {
+		p["audit_id"] = o.AuditId
+	}
+	if o.AuditPeriod != "" {
+		p["audit_period"] = o.AuditPeriod
+	}
+	if o.FormalValues != nil {
+		p["formal_values"] = o.FormalValues
+	}
+	if o.Policy != "" {
+		p["policy"] = o.Policy
+	}
+	if o.Recipe != "" {
+		p["recipe"] = o.Recipe
+	}
+	if o.RecipeId != 0 {
+		p["recipe_id"] = o.RecipeId
+	}
+	if o.Thread != "" {
+		p["thread"] = o.Thread
+	}
+	return p, nil
+}
+
 // POST /api/right_net/scheduler/schedule_recipe
 //
 // Schedules a chef recipe for execution on the current instance
@@ -9852,42 +15092,49 @@ func (api *API) SchedulerLocator(href string) *SchedulerLocator {
 // recipe: Chef recipe name, overridden by recipe_id
 // recipe_id: ServerTemplateChefRecipe ID
 // thread: RunlistPolicy thread name
-func (loc *SchedulerLocator) ScheduleRecipe(options rsapi.APIParams) error {
+func (loc *SchedulerLocator) ScheduleRecipe(options *SchedulerScheduleRecipeOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var argumentsOpt = options["arguments"]
+	var argumentsOpt = opts["arguments"]
 	if argumentsOpt != nil {
 		p["arguments"] = argumentsOpt
 	}
-	var auditIdOpt = options["audit_id"]
+	var auditIdOpt = opts["audit_id"]
 	if auditIdOpt != nil {
 		p["audit_id"] = auditIdOpt
 	}
-	var auditPeriodOpt = options["audit_period"]
+	var auditPeriodOpt = opts["audit_period"]
 	if auditPeriodOpt != nil {
 		p["audit_period"] = auditPeriodOpt
 	}
-	var formalValuesOpt = options["formal_values"]
+	var formalValuesOpt = opts["formal_values"]
 	if formalValuesOpt != nil {
 		p["formal_values"] = formalValuesOpt
 	}
-	var policyOpt = options["policy"]
+	var policyOpt = opts["policy"]
 	if policyOpt != nil {
 		p["policy"] = policyOpt
 	}
-	var recipeOpt = options["recipe"]
+	var recipeOpt = opts["recipe"]
 	if recipeOpt != nil {
 		p["recipe"] = recipeOpt
 	}
-	var recipeIdOpt = options["recipe_id"]
+	var recipeIdOpt = opts["recipe_id"]
 	if recipeIdOpt != nil {
 		p["recipe_id"] = recipeIdOpt
 	}
-	var threadOpt = options["thread"]
+	var threadOpt = opts["thread"]
 	if threadOpt != nil {
 		p["thread"] = threadOpt
 	}
+	if err := loc.api.ValidateParams("Scheduler", "schedule_recipe", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Scheduler", "schedule_recipe")
 	if err != nil {
 		return err
@@ -9900,18 +15147,77 @@ func (loc *SchedulerLocator) ScheduleRecipe(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// SchedulerScheduleRightScriptOptions contains the optional parameters of SchedulerLocator.ScheduleRightScript.
+type SchedulerScheduleRightScriptOptions struct {
+	// Serialized script execution arguments values keyed by name
+	Arguments map[string]interface{}
+	// Optional, reuse audit if specified
+	AuditId int
+	// RunlistPolicy audit period
+	AuditPeriod string
+	// Formal input parameter values
+	FormalValues map[string]interface{}
+	// RunlistPolicy policy name
+	Policy string
+	// RightScript name, overridden by right_script_id
+	RightScript string
+	// RightScript ID
+	RightScriptId int
+	// RunlistPolicy thread name
+	Thread string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SchedulerScheduleRightScriptOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "arguments", "audit_id", "audit_period", "formal_values", "policy", "right_script", "right_script_id", "thread":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SchedulerLocator.ScheduleRightScript", n)
+		}
+	}
+	if o.Arguments != nil {
+		p["arguments"] = o.Arguments
+	}
+	if o.AuditId != 0 {
+		p["audit_id"] = o.AuditId
+	}
+	if o.AuditPeriod != "" {
+		p["audit_period"] = o.AuditPeriod
+	}
+	if o.FormalValues != nil {
+		p["formal_values"] = o.FormalValues
+	}
+	if o.Policy != "" {
+		p["policy"] = o.Policy
+	}
+	if o.RightScript != "" {
+		p["right_script"] = o.RightScript
+	}
+	if o.RightScriptId != 0 {
+		p["right_script_id"] = o.RightScriptId
+	}
+	if o.Thread != "" {
+		p["thread"] = o.Thread
+	}
+	return p, nil
+}
+
 // POST /api/right_net/scheduler/schedule_right_script
 //
 // Schedules a RightScript for execution on the current instance
@@ -9924,42 +15230,49 @@ func (loc *SchedulerLocator) ScheduleRecipe(options rsapi.APIParams) error {
 // right_script: RightScript name, overridden by right_script_id
 // right_script_id: RightScript ID
 // thread: RunlistPolicy thread name
-func (loc *SchedulerLocator) ScheduleRightScript(options rsapi.APIParams) error {
+func (loc *SchedulerLocator) ScheduleRightScript(options *SchedulerScheduleRightScriptOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var argumentsOpt = options["arguments"]
+	var argumentsOpt = opts["arguments"]
 	if argumentsOpt != nil {
 		p["arguments"] = argumentsOpt
 	}
-	var auditIdOpt = options["audit_id"]
+	var auditIdOpt = opts["audit_id"]
 	if auditIdOpt != nil {
 		p["audit_id"] = auditIdOpt
 	}
-	var auditPeriodOpt = options["audit_period"]
+	var auditPeriodOpt = opts["audit_period"]
 	if auditPeriodOpt != nil {
 		p["audit_period"] = auditPeriodOpt
 	}
-	var formalValuesOpt = options["formal_values"]
+	var formalValuesOpt = opts["formal_values"]
 	if formalValuesOpt != nil {
 		p["formal_values"] = formalValuesOpt
 	}
-	var policyOpt = options["policy"]
+	var policyOpt = opts["policy"]
 	if policyOpt != nil {
 		p["policy"] = policyOpt
 	}
-	var rightScriptOpt = options["right_script"]
+	var rightScriptOpt = opts["right_script"]
 	if rightScriptOpt != nil {
 		p["right_script"] = rightScriptOpt
 	}
-	var rightScriptIdOpt = options["right_script_id"]
+	var rightScriptIdOpt = opts["right_script_id"]
 	if rightScriptIdOpt != nil {
 		p["right_script_id"] = rightScriptIdOpt
 	}
-	var threadOpt = options["thread"]
+	var threadOpt = opts["thread"]
 	if threadOpt != nil {
 		p["thread"] = threadOpt
 	}
+	if err := loc.api.ValidateParams("Scheduler", "schedule_right_script", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Scheduler", "schedule_right_script")
 	if err != nil {
 		return err
@@ -9972,15 +15285,10 @@ func (loc *SchedulerLocator) ScheduleRightScript(options rsapi.APIParams) error
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -10007,6 +15315,39 @@ func (r *SecurityGroup) Locator(api *API) *SecurityGroupLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *SecurityGroup) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NetworkLocator returns a locator for the resource pointed to by the "network" link.
+// It returns nil if the link is absent.
+func (r *SecurityGroup) NetworkLocator(api *API) *NetworkLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "network" {
+			return api.NetworkLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// SecurityGroupRulesLocator returns a locator for the resource pointed to by the "security_group_rules" link.
+// It returns nil if the link is absent.
+func (r *SecurityGroup) SecurityGroupRulesLocator(api *API) *SecurityGroupRuleLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "security_group_rules" {
+			return api.SecurityGroupRuleLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // SecurityGroupLocator exposes the SecurityGroup resource actions.
@@ -10020,6 +15361,16 @@ func (api *API) SecurityGroupLocator(href string) *SecurityGroupLocator {
 	return &SecurityGroupLocator{Href(href), api}
 }
 
+// SecurityGroupLocatorInterface lists the SecurityGroupLocator methods, code that depends on the interface
+// rather than on SecurityGroupLocator can be unit tested using the generated fake locators.
+type SecurityGroupLocatorInterface interface {
+	Create(securityGroup *SecurityGroupParam) (*SecurityGroupLocator, error)
+	Destroy() error
+	Index(options *SecurityGroupIndexOptions) ([]*SecurityGroup, error)
+	IndexEach(options *SecurityGroupIndexOptions, fn func(*SecurityGroup) error) error
+	Show(options *SecurityGroupShowOptions) (*SecurityGroup, error)
+}
+
 //===== Actions
 
 // POST /api/clouds/:cloud_id/security_groups
@@ -10037,6 +15388,9 @@ func (loc *SecurityGroupLocator) Create(securityGroup *SecurityGroupParam) (*Sec
 	p = rsapi.APIParams{
 		"security_group": securityGroup,
 	}
+	if err := loc.api.ValidateParams("SecurityGroup", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("SecurityGroup", "create")
 	if err != nil {
 		return res, err
@@ -10049,15 +15403,10 @@ func (loc *SecurityGroupLocator) Create(securityGroup *SecurityGroupParam) (*Sec
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -10072,6 +15421,9 @@ func (loc *SecurityGroupLocator) Create(securityGroup *SecurityGroupParam) (*Sec
 func (loc *SecurityGroupLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SecurityGroup", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("SecurityGroup", "destroy")
 	if err != nil {
 		return err
@@ -10084,37 +15436,71 @@ func (loc *SecurityGroupLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// SecurityGroupIndexOptions contains the optional parameters of SecurityGroupLocator.Index.
+type SecurityGroupIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SecurityGroupIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SecurityGroupLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/security_groups
 //
 // Lists Security Groups.
 // Optional parameters:
 // filter
 // view
-func (loc *SecurityGroupLocator) Index(options rsapi.APIParams) ([]*SecurityGroup, error) {
+func (loc *SecurityGroupLocator) Index(options *SecurityGroupIndexOptions) ([]*SecurityGroup, error) {
 	var res []*SecurityGroup
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SecurityGroup", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("SecurityGroup", "index")
 	if err != nil {
 		return res, err
@@ -10127,22 +15513,87 @@ func (loc *SecurityGroupLocator) Index(options rsapi.APIParams) ([]*SecurityGrou
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *SecurityGroupLocator) IndexEach(options *SecurityGroupIndexOptions, fn func(*SecurityGroup) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SecurityGroup", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("SecurityGroup", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *SecurityGroup
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// SecurityGroupShowOptions contains the optional parameters of SecurityGroupLocator.Show.
+type SecurityGroupShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SecurityGroupShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SecurityGroupLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/clouds/:cloud_id/security_groups/:id
@@ -10150,15 +15601,22 @@ func (loc *SecurityGroupLocator) Index(options rsapi.APIParams) ([]*SecurityGrou
 // Displays information about a single Security Group.
 // Optional parameters:
 // view
-func (loc *SecurityGroupLocator) Show(options rsapi.APIParams) (*SecurityGroup, error) {
+func (loc *SecurityGroupLocator) Show(options *SecurityGroupShowOptions) (*SecurityGroup, error) {
 	var res *SecurityGroup
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SecurityGroup", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("SecurityGroup", "show")
 	if err != nil {
 		return res, err
@@ -10171,21 +15629,11 @@ func (loc *SecurityGroupLocator) Show(options rsapi.APIParams) (*SecurityGroup,
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -10219,6 +15667,17 @@ func (r *SecurityGroupRule) Locator(api *API) *SecurityGroupRuleLocator {
 	return nil
 }
 
+// SecurityGroupLocator returns a locator for the resource pointed to by the "security_group" link.
+// It returns nil if the link is absent.
+func (r *SecurityGroupRule) SecurityGroupLocator(api *API) *SecurityGroupLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "security_group" {
+			return api.SecurityGroupLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // SecurityGroupRuleLocator exposes the SecurityGroupRule resource actions.
@@ -10232,6 +15691,17 @@ func (api *API) SecurityGroupRuleLocator(href string) *SecurityGroupRuleLocator
 	return &SecurityGroupRuleLocator{Href(href), api}
 }
 
+// SecurityGroupRuleLocatorInterface lists the SecurityGroupRuleLocator methods, code that depends on the interface
+// rather than on SecurityGroupRuleLocator can be unit tested using the generated fake locators.
+type SecurityGroupRuleLocatorInterface interface {
+	Create(securityGroupRule *SecurityGroupRuleParam) (*SecurityGroupRuleLocator, error)
+	Destroy() error
+	Index(options *SecurityGroupRuleIndexOptions) ([]*SecurityGroupRule, error)
+	IndexEach(options *SecurityGroupRuleIndexOptions, fn func(*SecurityGroupRule) error) error
+	Show(options *SecurityGroupRuleShowOptions) (*SecurityGroupRule, error)
+	Update(securityGroupRule *SecurityGroupRuleParam2) error
+}
+
 //===== Actions
 
 // POST /api/security_group_rules
@@ -10255,6 +15725,9 @@ func (loc *SecurityGroupRuleLocator) Create(securityGroupRule *SecurityGroupRule
 	p = rsapi.APIParams{
 		"security_group_rule": securityGroupRule,
 	}
+	if err := loc.api.ValidateParams("SecurityGroupRule", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("SecurityGroupRule", "create")
 	if err != nil {
 		return res, err
@@ -10267,15 +15740,10 @@ func (loc *SecurityGroupRuleLocator) Create(securityGroupRule *SecurityGroupRule
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -10291,6 +15759,9 @@ func (loc *SecurityGroupRuleLocator) Create(securityGroupRule *SecurityGroupRule
 func (loc *SecurityGroupRuleLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SecurityGroupRule", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("SecurityGroupRule", "destroy")
 	if err != nil {
 		return err
@@ -10303,33 +15774,63 @@ func (loc *SecurityGroupRuleLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// SecurityGroupRuleIndexOptions contains the optional parameters of SecurityGroupRuleLocator.Index.
+type SecurityGroupRuleIndexOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SecurityGroupRuleIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SecurityGroupRuleLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/security_group_rules
 // GET /api/clouds/:cloud_id/security_groups/:security_group_id/security_group_rules
 //
 // Lists SecurityGroupRules.
 // Optional parameters:
 // view
-func (loc *SecurityGroupRuleLocator) Index(options rsapi.APIParams) ([]*SecurityGroupRule, error) {
+func (loc *SecurityGroupRuleLocator) Index(options *SecurityGroupRuleIndexOptions) ([]*SecurityGroupRule, error) {
 	var res []*SecurityGroupRule
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SecurityGroupRule", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("SecurityGroupRule", "index")
 	if err != nil {
 		return res, err
@@ -10342,22 +15843,83 @@ func (loc *SecurityGroupRuleLocator) Index(options rsapi.APIParams) ([]*Security
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *SecurityGroupRuleLocator) IndexEach(options *SecurityGroupRuleIndexOptions, fn func(*SecurityGroupRule) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SecurityGroupRule", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("SecurityGroupRule", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *SecurityGroupRule
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// SecurityGroupRuleShowOptions contains the optional parameters of SecurityGroupRuleLocator.Show.
+type SecurityGroupRuleShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SecurityGroupRuleShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SecurityGroupRuleLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/security_group_rules/:id
@@ -10366,15 +15928,22 @@ func (loc *SecurityGroupRuleLocator) Index(options rsapi.APIParams) ([]*Security
 // Displays information about a single SecurityGroupRule.
 // Optional parameters:
 // view
-func (loc *SecurityGroupRuleLocator) Show(options rsapi.APIParams) (*SecurityGroupRule, error) {
+func (loc *SecurityGroupRuleLocator) Show(options *SecurityGroupRuleShowOptions) (*SecurityGroupRule, error) {
 	var res *SecurityGroupRule
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SecurityGroupRule", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("SecurityGroupRule", "show")
 	if err != nil {
 		return res, err
@@ -10387,21 +15956,11 @@ func (loc *SecurityGroupRuleLocator) Show(options rsapi.APIParams) (*SecurityGro
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -10419,6 +15978,9 @@ func (loc *SecurityGroupRuleLocator) Update(securityGroupRule *SecurityGroupRule
 	p = rsapi.APIParams{
 		"security_group_rule": securityGroupRule,
 	}
+	if err := loc.api.ValidateParams("SecurityGroupRule", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("SecurityGroupRule", "update")
 	if err != nil {
 		return err
@@ -10431,15 +15993,10 @@ func (loc *SecurityGroupRuleLocator) Update(securityGroupRule *SecurityGroupRule
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -10456,7 +16013,7 @@ func (loc *SecurityGroupRuleLocator) Update(securityGroupRule *SecurityGroupRule
 // configuration for the next instance launch/start (therefore they have no effect until such operation is performed).
 type Server struct {
 	Actions         []map[string]string `json:"actions,omitempty"`
-	CreatedAt       *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt       *rsapi.Time         `json:"created_at,omitempty"`
 	CurrentInstance *Instance           `json:"current_instance,omitempty"`
 	Description     string              `json:"description,omitempty"`
 	Links           []map[string]string `json:"links,omitempty"`
@@ -10464,7 +16021,7 @@ type Server struct {
 	NextInstance    *Instance           `json:"next_instance,omitempty"`
 	Optimized       bool                `json:"optimized,omitempty"`
 	State           string              `json:"state,omitempty"`
-	UpdatedAt       *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt       *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -10477,10 +16034,65 @@ func (r *Server) Locator(api *API) *ServerLocator {
 	return nil
 }
 
-//===== Locator
+// AlertSpecsLocator returns a locator for the resource pointed to by the "alert_specs" link.
+// It returns nil if the link is absent.
+func (r *Server) AlertSpecsLocator(api *API) *AlertSpecLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "alert_specs" {
+			return api.AlertSpecLocator(l["href"])
+		}
+	}
+	return nil
+}
 
-// ServerLocator exposes the Server resource actions.
-type ServerLocator struct {
+// AlertsLocator returns a locator for the resource pointed to by the "alerts" link.
+// It returns nil if the link is absent.
+func (r *Server) AlertsLocator(api *API) *AlertLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "alerts" {
+			return api.AlertLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// CurrentInstanceLocator returns a locator for the resource pointed to by the "current_instance" link.
+// It returns nil if the link is absent.
+func (r *Server) CurrentInstanceLocator(api *API) *InstanceLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "current_instance" {
+			return api.InstanceLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// DeploymentLocator returns a locator for the resource pointed to by the "deployment" link.
+// It returns nil if the link is absent.
+func (r *Server) DeploymentLocator(api *API) *DeploymentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "deployment" {
+			return api.DeploymentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NextInstanceLocator returns a locator for the resource pointed to by the "next_instance" link.
+// It returns nil if the link is absent.
+func (r *Server) NextInstanceLocator(api *API) *InstanceLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "next_instance" {
+			return api.InstanceLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+//===== Locator
+
+// ServerLocator exposes the Server resource actions.
+type ServerLocator struct {
 	Href
 	api *API
 }
@@ -10490,6 +16102,22 @@ func (api *API) ServerLocator(href string) *ServerLocator {
 	return &ServerLocator{Href(href), api}
 }
 
+// ServerLocatorInterface lists the ServerLocator methods, code that depends on the interface
+// rather than on ServerLocator can be unit tested using the generated fake locators.
+type ServerLocatorInterface interface {
+	Clone() error
+	Create(server *ServerParam) (*ServerLocator, error)
+	Destroy() error
+	Index(options *ServerIndexOptions) ([]*Server, error)
+	IndexEach(options *ServerIndexOptions, fn func(*Server) error) error
+	Launch() error
+	Show(options *ServerShowOptions) (*Server, error)
+	Terminate() error
+	Unwrap() error
+	Update(server *ServerParam2) error
+	WrapInstance(server *ServerParam3) error
+}
+
 //===== Actions
 
 // POST /api/servers/:id/clone
@@ -10498,6 +16126,9 @@ func (api *API) ServerLocator(href string) *ServerLocator {
 func (loc *ServerLocator) Clone() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Server", "clone", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Server", "clone")
 	if err != nil {
 		return err
@@ -10510,15 +16141,10 @@ func (loc *ServerLocator) Clone() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -10538,6 +16164,9 @@ func (loc *ServerLocator) Create(server *ServerParam) (*ServerLocator, error) {
 	p = rsapi.APIParams{
 		"server": server,
 	}
+	if err := loc.api.ValidateParams("Server", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Server", "create")
 	if err != nil {
 		return res, err
@@ -10550,15 +16179,10 @@ func (loc *ServerLocator) Create(server *ServerParam) (*ServerLocator, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -10574,6 +16198,9 @@ func (loc *ServerLocator) Create(server *ServerParam) (*ServerLocator, error) {
 func (loc *ServerLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Server", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Server", "destroy")
 	if err != nil {
 		return err
@@ -10586,18 +16213,45 @@ func (loc *ServerLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ServerIndexOptions contains the optional parameters of ServerLocator.Index.
+type ServerIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/servers
 // GET /api/deployments/:deployment_id/servers
 //
@@ -10611,19 +16265,26 @@ func (loc *ServerLocator) Destroy() error {
 // Optional parameters:
 // filter
 // view
-func (loc *ServerLocator) Index(options rsapi.APIParams) ([]*Server, error) {
+func (loc *ServerLocator) Index(options *ServerIndexOptions) ([]*Server, error) {
 	var res []*Server
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Server", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Server", "index")
 	if err != nil {
 		return res, err
@@ -10636,22 +16297,59 @@ func (loc *ServerLocator) Index(options rsapi.APIParams) ([]*Server, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *ServerLocator) IndexEach(options *ServerIndexOptions, fn func(*Server) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Server", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Server", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Server
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // POST /api/servers/:id/launch
@@ -10661,6 +16359,9 @@ func (loc *ServerLocator) Index(options rsapi.APIParams) ([]*Server, error) {
 func (loc *ServerLocator) Launch() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Server", "launch", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Server", "launch")
 	if err != nil {
 		return err
@@ -10673,33 +16374,63 @@ func (loc *ServerLocator) Launch() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ServerShowOptions contains the optional parameters of ServerLocator.Show.
+type ServerShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/servers/:id
 // GET /api/deployments/:deployment_id/servers/:id
 //
 // Shows the information of a single server.
 // Optional parameters:
 // view
-func (loc *ServerLocator) Show(options rsapi.APIParams) (*Server, error) {
+func (loc *ServerLocator) Show(options *ServerShowOptions) (*Server, error) {
 	var res *Server
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Server", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Server", "show")
 	if err != nil {
 		return res, err
@@ -10712,21 +16443,11 @@ func (loc *ServerLocator) Show(options rsapi.APIParams) (*Server, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -10737,6 +16458,9 @@ func (loc *ServerLocator) Show(options rsapi.APIParams) (*Server, error) {
 func (loc *ServerLocator) Terminate() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Server", "terminate", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Server", "terminate")
 	if err != nil {
 		return err
@@ -10749,15 +16473,10 @@ func (loc *ServerLocator) Terminate() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -10768,6 +16487,9 @@ func (loc *ServerLocator) Terminate() error {
 func (loc *ServerLocator) Unwrap() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Server", "unwrap", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Server", "unwrap")
 	if err != nil {
 		return err
@@ -10780,15 +16502,10 @@ func (loc *ServerLocator) Unwrap() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -10807,6 +16524,9 @@ func (loc *ServerLocator) Update(server *ServerParam2) error {
 	p = rsapi.APIParams{
 		"server": server,
 	}
+	if err := loc.api.ValidateParams("Server", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Server", "update")
 	if err != nil {
 		return err
@@ -10819,15 +16539,10 @@ func (loc *ServerLocator) Update(server *ServerParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -10846,6 +16561,9 @@ func (loc *ServerLocator) WrapInstance(server *ServerParam3) error {
 	p = rsapi.APIParams{
 		"server": server,
 	}
+	if err := loc.api.ValidateParams("Server", "wrap_instance", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Server", "wrap_instance")
 	if err != nil {
 		return err
@@ -10858,15 +16576,10 @@ func (loc *ServerLocator) WrapInstance(server *ServerParam3) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -10903,6 +16616,61 @@ func (r *ServerArray) Locator(api *API) *ServerArrayLocator {
 	return nil
 }
 
+// AlertSpecsLocator returns a locator for the resource pointed to by the "alert_specs" link.
+// It returns nil if the link is absent.
+func (r *ServerArray) AlertSpecsLocator(api *API) *AlertSpecLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "alert_specs" {
+			return api.AlertSpecLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// AlertsLocator returns a locator for the resource pointed to by the "alerts" link.
+// It returns nil if the link is absent.
+func (r *ServerArray) AlertsLocator(api *API) *AlertLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "alerts" {
+			return api.AlertLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// CurrentInstancesLocator returns a locator for the resource pointed to by the "current_instances" link.
+// It returns nil if the link is absent.
+func (r *ServerArray) CurrentInstancesLocator(api *API) *InstanceLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "current_instances" {
+			return api.InstanceLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// DeploymentLocator returns a locator for the resource pointed to by the "deployment" link.
+// It returns nil if the link is absent.
+func (r *ServerArray) DeploymentLocator(api *API) *DeploymentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "deployment" {
+			return api.DeploymentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NextInstanceLocator returns a locator for the resource pointed to by the "next_instance" link.
+// It returns nil if the link is absent.
+func (r *ServerArray) NextInstanceLocator(api *API) *InstanceLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "next_instance" {
+			return api.InstanceLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // ServerArrayLocator exposes the ServerArray resource actions.
@@ -10916,6 +16684,22 @@ func (api *API) ServerArrayLocator(href string) *ServerArrayLocator {
 	return &ServerArrayLocator{Href(href), api}
 }
 
+// ServerArrayLocatorInterface lists the ServerArrayLocator methods, code that depends on the interface
+// rather than on ServerArrayLocator can be unit tested using the generated fake locators.
+type ServerArrayLocatorInterface interface {
+	Clone() error
+	Create(serverArray *ServerArrayParam) (*ServerArrayLocator, error)
+	CurrentInstances() ([]*Instance, error)
+	Destroy() error
+	Index(options *ServerArrayIndexOptions) ([]*ServerArray, error)
+	IndexEach(options *ServerArrayIndexOptions, fn func(*ServerArray) error) error
+	Launch() error
+	MultiRunExecutable() error
+	MultiTerminate() error
+	Show(options *ServerArrayShowOptions) (*ServerArray, error)
+	Update(serverArray *ServerArrayParam2) error
+}
+
 //===== Actions
 
 // POST /api/server_arrays/:id/clone
@@ -10924,6 +16708,9 @@ func (api *API) ServerArrayLocator(href string) *ServerArrayLocator {
 func (loc *ServerArrayLocator) Clone() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerArray", "clone", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerArray", "clone")
 	if err != nil {
 		return err
@@ -10936,15 +16723,10 @@ func (loc *ServerArrayLocator) Clone() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -10964,6 +16746,9 @@ func (loc *ServerArrayLocator) Create(serverArray *ServerArrayParam) (*ServerArr
 	p = rsapi.APIParams{
 		"server_array": serverArray,
 	}
+	if err := loc.api.ValidateParams("ServerArray", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ServerArray", "create")
 	if err != nil {
 		return res, err
@@ -10976,15 +16761,10 @@ func (loc *ServerArrayLocator) Create(serverArray *ServerArrayParam) (*ServerArr
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -11002,6 +16782,9 @@ func (loc *ServerArrayLocator) CurrentInstances() ([]*Instance, error) {
 	var res []*Instance
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerArray", "current_instances", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ServerArray", "current_instances")
 	if err != nil {
 		return res, err
@@ -11014,21 +16797,11 @@ func (loc *ServerArrayLocator) CurrentInstances() ([]*Instance, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -11039,6 +16812,9 @@ func (loc *ServerArrayLocator) CurrentInstances() ([]*Instance, error) {
 func (loc *ServerArrayLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerArray", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerArray", "destroy")
 	if err != nil {
 		return err
@@ -11051,18 +16827,45 @@ func (loc *ServerArrayLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ServerArrayIndexOptions contains the optional parameters of ServerArrayLocator.Index.
+type ServerArrayIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerArrayIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerArrayLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/server_arrays
 // GET /api/deployments/:deployment_id/server_arrays
 //
@@ -11074,19 +16877,26 @@ func (loc *ServerArrayLocator) Destroy() error {
 // Optional parameters:
 // filter
 // view
-func (loc *ServerArrayLocator) Index(options rsapi.APIParams) ([]*ServerArray, error) {
+func (loc *ServerArrayLocator) Index(options *ServerArrayIndexOptions) ([]*ServerArray, error) {
 	var res []*ServerArray
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerArray", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ServerArray", "index")
 	if err != nil {
 		return res, err
@@ -11099,22 +16909,59 @@ func (loc *ServerArrayLocator) Index(options rsapi.APIParams) ([]*ServerArray, e
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *ServerArrayLocator) IndexEach(options *ServerArrayIndexOptions, fn func(*ServerArray) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerArray", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("ServerArray", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *ServerArray
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // POST /api/server_arrays/:id/launch
@@ -11124,6 +16971,9 @@ func (loc *ServerArrayLocator) Index(options rsapi.APIParams) ([]*ServerArray, e
 func (loc *ServerArrayLocator) Launch() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerArray", "launch", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerArray", "launch")
 	if err != nil {
 		return err
@@ -11136,15 +16986,10 @@ func (loc *ServerArrayLocator) Launch() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -11156,6 +17001,9 @@ func (loc *ServerArrayLocator) Launch() error {
 func (loc *ServerArrayLocator) MultiRunExecutable() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerArray", "multi_run_executable", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerArray", "multi_run_executable")
 	if err != nil {
 		return err
@@ -11168,15 +17016,10 @@ func (loc *ServerArrayLocator) MultiRunExecutable() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -11188,6 +17031,9 @@ func (loc *ServerArrayLocator) MultiRunExecutable() error {
 func (loc *ServerArrayLocator) MultiTerminate() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerArray", "multi_terminate", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerArray", "multi_terminate")
 	if err != nil {
 		return err
@@ -11200,33 +17046,63 @@ func (loc *ServerArrayLocator) MultiTerminate() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ServerArrayShowOptions contains the optional parameters of ServerArrayLocator.Show.
+type ServerArrayShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerArrayShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerArrayLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/server_arrays/:id
 // GET /api/deployments/:deployment_id/server_arrays/:id
 //
 // Shows the information of a single server array.
 // Optional parameters:
 // view
-func (loc *ServerArrayLocator) Show(options rsapi.APIParams) (*ServerArray, error) {
+func (loc *ServerArrayLocator) Show(options *ServerArrayShowOptions) (*ServerArray, error) {
 	var res *ServerArray
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerArray", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ServerArray", "show")
 	if err != nil {
 		return res, err
@@ -11239,21 +17115,11 @@ func (loc *ServerArrayLocator) Show(options rsapi.APIParams) (*ServerArray, erro
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -11272,6 +17138,9 @@ func (loc *ServerArrayLocator) Update(serverArray *ServerArrayParam2) error {
 	p = rsapi.APIParams{
 		"server_array": serverArray,
 	}
+	if err := loc.api.ValidateParams("ServerArray", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerArray", "update")
 	if err != nil {
 		return err
@@ -11284,15 +17153,10 @@ func (loc *ServerArrayLocator) Update(serverArray *ServerArrayParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -11323,6 +17187,83 @@ func (r *ServerTemplate) Locator(api *API) *ServerTemplateLocator {
 	return nil
 }
 
+// AlertSpecsLocator returns a locator for the resource pointed to by the "alert_specs" link.
+// It returns nil if the link is absent.
+func (r *ServerTemplate) AlertSpecsLocator(api *API) *AlertSpecLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "alert_specs" {
+			return api.AlertSpecLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// CookbookAttachmentsLocator returns a locator for the resource pointed to by the "cookbook_attachments" link.
+// It returns nil if the link is absent.
+func (r *ServerTemplate) CookbookAttachmentsLocator(api *API) *CookbookAttachmentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cookbook_attachments" {
+			return api.CookbookAttachmentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// DefaultMultiCloudImageLocator returns a locator for the resource pointed to by the "default_multi_cloud_image" link.
+// It returns nil if the link is absent.
+func (r *ServerTemplate) DefaultMultiCloudImageLocator(api *API) *MultiCloudImageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "default_multi_cloud_image" {
+			return api.MultiCloudImageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// InputsLocator returns a locator for the resource pointed to by the "inputs" link.
+// It returns nil if the link is absent.
+func (r *ServerTemplate) InputsLocator(api *API) *InputLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "inputs" {
+			return api.InputLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// MultiCloudImagesLocator returns a locator for the resource pointed to by the "multi_cloud_images" link.
+// It returns nil if the link is absent.
+func (r *ServerTemplate) MultiCloudImagesLocator(api *API) *MultiCloudImageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "multi_cloud_images" {
+			return api.MultiCloudImageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// PublicationLocator returns a locator for the resource pointed to by the "publication" link.
+// It returns nil if the link is absent.
+func (r *ServerTemplate) PublicationLocator(api *API) *PublicationLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "publication" {
+			return api.PublicationLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RunnableBindingsLocator returns a locator for the resource pointed to by the "runnable_bindings" link.
+// It returns nil if the link is absent.
+func (r *ServerTemplate) RunnableBindingsLocator(api *API) *RunnableBindingLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "runnable_bindings" {
+			return api.RunnableBindingLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // ServerTemplateLocator exposes the ServerTemplate resource actions.
@@ -11336,6 +17277,23 @@ func (api *API) ServerTemplateLocator(href string) *ServerTemplateLocator {
 	return &ServerTemplateLocator{Href(href), api}
 }
 
+// ServerTemplateLocatorInterface lists the ServerTemplateLocator methods, code that depends on the interface
+// rather than on ServerTemplateLocator can be unit tested using the generated fake locators.
+type ServerTemplateLocatorInterface interface {
+	Clone(serverTemplate *ServerTemplateParam) error
+	Commit(commitHeadDependencies string, commitMessage string, freezeRepositories string) error
+	Create(serverTemplate *ServerTemplateParam) (*ServerTemplateLocator, error)
+	Destroy() error
+	DetectChangesInHead() ([]map[string]interface{}, error)
+	Index(options *ServerTemplateIndexOptions) ([]*ServerTemplate, error)
+	IndexEach(options *ServerTemplateIndexOptions, fn func(*ServerTemplate) error) error
+	Publish(accountGroupHrefs []string, descriptions *Descriptions, options *ServerTemplatePublishOptions) error
+	Resolve() ([]map[string]interface{}, error)
+	Show(options *ServerTemplateShowOptions) (*ServerTemplate, error)
+	SwapRepository(sourceRepositoryHref string, targetRepositoryHref string) error
+	Update(serverTemplate *ServerTemplateParam) error
+}
+
 //===== Actions
 
 // POST /api/server_templates/:id/clone
@@ -11352,6 +17310,9 @@ func (loc *ServerTemplateLocator) Clone(serverTemplate *ServerTemplateParam) err
 	p = rsapi.APIParams{
 		"server_template": serverTemplate,
 	}
+	if err := loc.api.ValidateParams("ServerTemplate", "clone", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "clone")
 	if err != nil {
 		return err
@@ -11364,15 +17325,10 @@ func (loc *ServerTemplateLocator) Clone(serverTemplate *ServerTemplateParam) err
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -11400,6 +17356,9 @@ func (loc *ServerTemplateLocator) Commit(commitHeadDependencies string, commitMe
 		"commit_message":           commitMessage,
 		"freeze_repositories":      freezeRepositories,
 	}
+	if err := loc.api.ValidateParams("ServerTemplate", "commit", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "commit")
 	if err != nil {
 		return err
@@ -11412,15 +17371,10 @@ func (loc *ServerTemplateLocator) Commit(commitHeadDependencies string, commitMe
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -11439,6 +17393,9 @@ func (loc *ServerTemplateLocator) Create(serverTemplate *ServerTemplateParam) (*
 	p = rsapi.APIParams{
 		"server_template": serverTemplate,
 	}
+	if err := loc.api.ValidateParams("ServerTemplate", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "create")
 	if err != nil {
 		return res, err
@@ -11451,15 +17408,10 @@ func (loc *ServerTemplateLocator) Create(serverTemplate *ServerTemplateParam) (*
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -11474,6 +17426,9 @@ func (loc *ServerTemplateLocator) Create(serverTemplate *ServerTemplateParam) (*
 func (loc *ServerTemplateLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplate", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "destroy")
 	if err != nil {
 		return err
@@ -11486,15 +17441,10 @@ func (loc *ServerTemplateLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -11507,6 +17457,9 @@ func (loc *ServerTemplateLocator) DetectChangesInHead() ([]map[string]interface{
 	var res []map[string]interface{}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplate", "detect_changes_in_head", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "detect_changes_in_head")
 	if err != nil {
 		return res, err
@@ -11519,24 +17472,46 @@ func (loc *ServerTemplateLocator) DetectChangesInHead() ([]map[string]interface{
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ServerTemplateIndexOptions contains the optional parameters of ServerTemplateLocator.Index.
+type ServerTemplateIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerTemplateIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerTemplateLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/server_templates
 //
 // Lists the ServerTemplates available to this account. HEAD revisions have a revision of 0.
@@ -11545,19 +17520,26 @@ func (loc *ServerTemplateLocator) DetectChangesInHead() ([]map[string]interface{
 // Optional parameters:
 // filter
 // view
-func (loc *ServerTemplateLocator) Index(options rsapi.APIParams) ([]*ServerTemplate, error) {
+func (loc *ServerTemplateLocator) Index(options *ServerTemplateIndexOptions) ([]*ServerTemplate, error) {
 	var res []*ServerTemplate
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplate", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "index")
 	if err != nil {
 		return res, err
@@ -11570,22 +17552,98 @@ func (loc *ServerTemplateLocator) Index(options rsapi.APIParams) ([]*ServerTempl
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *ServerTemplateLocator) IndexEach(options *ServerTemplateIndexOptions, fn func(*ServerTemplate) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplate", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("ServerTemplate", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *ServerTemplate
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// ServerTemplatePublishOptions contains the optional parameters of ServerTemplateLocator.Publish.
+type ServerTemplatePublishOptions struct {
+	// Allow users to leave comments on this ServerTemplate.
+	AllowComments string
+	// List of Categories.
+	Categories []string
+	// Email me when a user comments on this ServerTemplate.
+	EmailComments string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerTemplatePublishOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "allow_comments", "categories", "email_comments":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerTemplateLocator.Publish", n)
+		}
+	}
+	if o.AllowComments != "" {
+		p["allow_comments"] = o.AllowComments
+	}
+	if o.Categories != nil {
+		p["categories"] = o.Categories
+	}
+	if o.EmailComments != "" {
+		p["email_comments"] = o.EmailComments
+	}
+	return p, nil
 }
 
 // POST /api/server_templates/:id/publish
@@ -11599,31 +17657,38 @@ func (loc *ServerTemplateLocator) Index(options rsapi.APIParams) ([]*ServerTempl
 // allow_comments: Allow users to leave comments on this ServerTemplate.
 // categories: List of Categories.
 // email_comments: Email me when a user comments on this ServerTemplate.
-func (loc *ServerTemplateLocator) Publish(accountGroupHrefs []string, descriptions *Descriptions, options rsapi.APIParams) error {
+func (loc *ServerTemplateLocator) Publish(accountGroupHrefs []string, descriptions *Descriptions, options *ServerTemplatePublishOptions) error {
 	if len(accountGroupHrefs) == 0 {
 		return fmt.Errorf("accountGroupHrefs is required")
 	}
 	if descriptions == nil {
 		return fmt.Errorf("descriptions is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"account_group_hrefs": accountGroupHrefs,
 		"descriptions":        descriptions,
 	}
-	var allowCommentsOpt = options["allow_comments"]
+	var allowCommentsOpt = opts["allow_comments"]
 	if allowCommentsOpt != nil {
 		p["allow_comments"] = allowCommentsOpt
 	}
-	var categoriesOpt = options["categories"]
+	var categoriesOpt = opts["categories"]
 	if categoriesOpt != nil {
 		p["categories"] = categoriesOpt
 	}
-	var emailCommentsOpt = options["email_comments"]
+	var emailCommentsOpt = opts["email_comments"]
 	if emailCommentsOpt != nil {
 		p["email_comments"] = emailCommentsOpt
 	}
+	if err := loc.api.ValidateParams("ServerTemplate", "publish", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "publish")
 	if err != nil {
 		return err
@@ -11636,15 +17701,10 @@ func (loc *ServerTemplateLocator) Publish(accountGroupHrefs []string, descriptio
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -11658,6 +17718,9 @@ func (loc *ServerTemplateLocator) Resolve() ([]map[string]interface{}, error) {
 	var res []map[string]interface{}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplate", "resolve", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "resolve")
 	if err != nil {
 		return res, err
@@ -11670,24 +17733,42 @@ func (loc *ServerTemplateLocator) Resolve() ([]map[string]interface{}, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ServerTemplateShowOptions contains the optional parameters of ServerTemplateLocator.Show.
+type ServerTemplateShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerTemplateShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerTemplateLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/server_templates/:id
 //
 // Show information about a single ServerTemplate. HEAD revisions have a revision of 0.
@@ -11695,15 +17776,22 @@ func (loc *ServerTemplateLocator) Resolve() ([]map[string]interface{}, error) {
 // details please see Inputs#index.)
 // Optional parameters:
 // view
-func (loc *ServerTemplateLocator) Show(options rsapi.APIParams) (*ServerTemplate, error) {
+func (loc *ServerTemplateLocator) Show(options *ServerTemplateShowOptions) (*ServerTemplate, error) {
 	var res *ServerTemplate
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplate", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "show")
 	if err != nil {
 		return res, err
@@ -11716,21 +17804,11 @@ func (loc *ServerTemplateLocator) Show(options rsapi.APIParams) (*ServerTemplate
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -11765,6 +17843,9 @@ func (loc *ServerTemplateLocator) SwapRepository(sourceRepositoryHref string, ta
 		"source_repository_href": sourceRepositoryHref,
 		"target_repository_href": targetRepositoryHref,
 	}
+	if err := loc.api.ValidateParams("ServerTemplate", "swap_repository", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "swap_repository")
 	if err != nil {
 		return err
@@ -11777,15 +17858,10 @@ func (loc *ServerTemplateLocator) SwapRepository(sourceRepositoryHref string, ta
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -11805,6 +17881,9 @@ func (loc *ServerTemplateLocator) Update(serverTemplate *ServerTemplateParam) er
 	p = rsapi.APIParams{
 		"server_template": serverTemplate,
 	}
+	if err := loc.api.ValidateParams("ServerTemplate", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "update")
 	if err != nil {
 		return err
@@ -11817,15 +17896,10 @@ func (loc *ServerTemplateLocator) Update(serverTemplate *ServerTemplateParam) er
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -11835,10 +17909,10 @@ func (loc *ServerTemplateLocator) Update(serverTemplate *ServerTemplateParam) er
 // add/delete MultiCloudImages to ServerTemplates and make them the default one.
 type ServerTemplateMultiCloudImage struct {
 	Actions   []map[string]string `json:"actions,omitempty"`
-	CreatedAt *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt *rsapi.Time         `json:"created_at,omitempty"`
 	IsDefault bool                `json:"is_default,omitempty"`
 	Links     []map[string]string `json:"links,omitempty"`
-	UpdatedAt *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -11851,6 +17925,28 @@ func (r *ServerTemplateMultiCloudImage) Locator(api *API) *ServerTemplateMultiCl
 	return nil
 }
 
+// MultiCloudImageLocator returns a locator for the resource pointed to by the "multi_cloud_image" link.
+// It returns nil if the link is absent.
+func (r *ServerTemplateMultiCloudImage) MultiCloudImageLocator(api *API) *MultiCloudImageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "multi_cloud_image" {
+			return api.MultiCloudImageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ServerTemplateLocator returns a locator for the resource pointed to by the "server_template" link.
+// It returns nil if the link is absent.
+func (r *ServerTemplateMultiCloudImage) ServerTemplateLocator(api *API) *ServerTemplateLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "server_template" {
+			return api.ServerTemplateLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // ServerTemplateMultiCloudImageLocator exposes the ServerTemplateMultiCloudImage resource actions.
@@ -11864,6 +17960,17 @@ func (api *API) ServerTemplateMultiCloudImageLocator(href string) *ServerTemplat
 	return &ServerTemplateMultiCloudImageLocator{Href(href), api}
 }
 
+// ServerTemplateMultiCloudImageLocatorInterface lists the ServerTemplateMultiCloudImageLocator methods, code that depends on the interface
+// rather than on ServerTemplateMultiCloudImageLocator can be unit tested using the generated fake locators.
+type ServerTemplateMultiCloudImageLocatorInterface interface {
+	Create(serverTemplateMultiCloudImage *ServerTemplateMultiCloudImageParam) (*ServerTemplateMultiCloudImageLocator, error)
+	Destroy() error
+	Index(options *ServerTemplateMultiCloudImageIndexOptions) ([]*ServerTemplateMultiCloudImage, error)
+	IndexEach(options *ServerTemplateMultiCloudImageIndexOptions, fn func(*ServerTemplateMultiCloudImage) error) error
+	MakeDefault() error
+	Show(options *ServerTemplateMultiCloudImageShowOptions) (*ServerTemplateMultiCloudImage, error)
+}
+
 //===== Actions
 
 // POST /api/server_template_multi_cloud_images
@@ -11881,6 +17988,9 @@ func (loc *ServerTemplateMultiCloudImageLocator) Create(serverTemplateMultiCloud
 	p = rsapi.APIParams{
 		"server_template_multi_cloud_image": serverTemplateMultiCloudImage,
 	}
+	if err := loc.api.ValidateParams("ServerTemplateMultiCloudImage", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ServerTemplateMultiCloudImage", "create")
 	if err != nil {
 		return res, err
@@ -11893,15 +18003,10 @@ func (loc *ServerTemplateMultiCloudImageLocator) Create(serverTemplateMultiCloud
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -11916,6 +18021,9 @@ func (loc *ServerTemplateMultiCloudImageLocator) Create(serverTemplateMultiCloud
 func (loc *ServerTemplateMultiCloudImageLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplateMultiCloudImage", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerTemplateMultiCloudImage", "destroy")
 	if err != nil {
 		return err
@@ -11928,37 +18036,71 @@ func (loc *ServerTemplateMultiCloudImageLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ServerTemplateMultiCloudImageIndexOptions contains the optional parameters of ServerTemplateMultiCloudImageLocator.Index.
+type ServerTemplateMultiCloudImageIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerTemplateMultiCloudImageIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerTemplateMultiCloudImageLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/server_template_multi_cloud_images
 //
 // Lists the ServerTemplateMultiCloudImages available to this account.
 // Optional parameters:
 // filter
 // view
-func (loc *ServerTemplateMultiCloudImageLocator) Index(options rsapi.APIParams) ([]*ServerTemplateMultiCloudImage, error) {
+func (loc *ServerTemplateMultiCloudImageLocator) Index(options *ServerTemplateMultiCloudImageIndexOptions) ([]*ServerTemplateMultiCloudImage, error) {
 	var res []*ServerTemplateMultiCloudImage
-	var params rsapi.APIParams
-	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplateMultiCloudImage", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ServerTemplateMultiCloudImage", "index")
 	if err != nil {
 		return res, err
@@ -11971,22 +18113,59 @@ func (loc *ServerTemplateMultiCloudImageLocator) Index(options rsapi.APIParams)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *ServerTemplateMultiCloudImageLocator) IndexEach(options *ServerTemplateMultiCloudImageIndexOptions, fn func(*ServerTemplateMultiCloudImage) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplateMultiCloudImage", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("ServerTemplateMultiCloudImage", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *ServerTemplateMultiCloudImage
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // POST /api/server_template_multi_cloud_images/:id/make_default
@@ -11995,6 +18174,9 @@ func (loc *ServerTemplateMultiCloudImageLocator) Index(options rsapi.APIParams)
 func (loc *ServerTemplateMultiCloudImageLocator) MakeDefault() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplateMultiCloudImage", "make_default", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerTemplateMultiCloudImage", "make_default")
 	if err != nil {
 		return err
@@ -12007,32 +18189,62 @@ func (loc *ServerTemplateMultiCloudImageLocator) MakeDefault() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ServerTemplateMultiCloudImageShowOptions contains the optional parameters of ServerTemplateMultiCloudImageLocator.Show.
+type ServerTemplateMultiCloudImageShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerTemplateMultiCloudImageShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerTemplateMultiCloudImageLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/server_template_multi_cloud_images/:id
 //
 // Show information about a single ServerTemplateMultiCloudImage which represents an association between a ServerTemplate and a MultiCloudImage.
 // Optional parameters:
 // view
-func (loc *ServerTemplateMultiCloudImageLocator) Show(options rsapi.APIParams) (*ServerTemplateMultiCloudImage, error) {
+func (loc *ServerTemplateMultiCloudImageLocator) Show(options *ServerTemplateMultiCloudImageShowOptions) (*ServerTemplateMultiCloudImage, error) {
 	var res *ServerTemplateMultiCloudImage
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplateMultiCloudImage", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ServerTemplateMultiCloudImage", "show")
 	if err != nil {
 		return res, err
@@ -12045,21 +18257,11 @@ func (loc *ServerTemplateMultiCloudImageLocator) Show(options rsapi.APIParams) (
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -12088,6 +18290,380 @@ func (r *Session) Locator(api *API) *SessionLocator {
 	return nil
 }
 
+// AccountGroupsLocator returns a locator for the resource pointed to by the "account_groups" link.
+// It returns nil if the link is absent.
+func (r *Session) AccountGroupsLocator(api *API) *AccountGroupLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "account_groups" {
+			return api.AccountGroupLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// AccountsLocator returns a locator for the resource pointed to by the "accounts" link.
+// It returns nil if the link is absent.
+func (r *Session) AccountsLocator(api *API) *AccountLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "accounts" {
+			return api.AccountLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// AlertSpecsLocator returns a locator for the resource pointed to by the "alert_specs" link.
+// It returns nil if the link is absent.
+func (r *Session) AlertSpecsLocator(api *API) *AlertSpecLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "alert_specs" {
+			return api.AlertSpecLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// AlertsLocator returns a locator for the resource pointed to by the "alerts" link.
+// It returns nil if the link is absent.
+func (r *Session) AlertsLocator(api *API) *AlertLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "alerts" {
+			return api.AlertLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// AuditEntriesLocator returns a locator for the resource pointed to by the "audit_entries" link.
+// It returns nil if the link is absent.
+func (r *Session) AuditEntriesLocator(api *API) *AuditEntryLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "audit_entries" {
+			return api.AuditEntryLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// BackupsLocator returns a locator for the resource pointed to by the "backups" link.
+// It returns nil if the link is absent.
+func (r *Session) BackupsLocator(api *API) *BackupLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "backups" {
+			return api.BackupLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ChildAccountsLocator returns a locator for the resource pointed to by the "child_accounts" link.
+// It returns nil if the link is absent.
+func (r *Session) ChildAccountsLocator(api *API) *ChildAccountLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "child_accounts" {
+			return api.ChildAccountLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// CloudAccountsLocator returns a locator for the resource pointed to by the "cloud_accounts" link.
+// It returns nil if the link is absent.
+func (r *Session) CloudAccountsLocator(api *API) *CloudAccountLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud_accounts" {
+			return api.CloudAccountLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// CloudsLocator returns a locator for the resource pointed to by the "clouds" link.
+// It returns nil if the link is absent.
+func (r *Session) CloudsLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "clouds" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// CookbooksLocator returns a locator for the resource pointed to by the "cookbooks" link.
+// It returns nil if the link is absent.
+func (r *Session) CookbooksLocator(api *API) *CookbookLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cookbooks" {
+			return api.CookbookLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// CredentialsLocator returns a locator for the resource pointed to by the "credentials" link.
+// It returns nil if the link is absent.
+func (r *Session) CredentialsLocator(api *API) *CredentialLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "credentials" {
+			return api.CredentialLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// DeploymentsLocator returns a locator for the resource pointed to by the "deployments" link.
+// It returns nil if the link is absent.
+func (r *Session) DeploymentsLocator(api *API) *DeploymentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "deployments" {
+			return api.DeploymentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// IdentityProvidersLocator returns a locator for the resource pointed to by the "identity_providers" link.
+// It returns nil if the link is absent.
+func (r *Session) IdentityProvidersLocator(api *API) *IdentityProviderLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "identity_providers" {
+			return api.IdentityProviderLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// MultiCloudImagesLocator returns a locator for the resource pointed to by the "multi_cloud_images" link.
+// It returns nil if the link is absent.
+func (r *Session) MultiCloudImagesLocator(api *API) *MultiCloudImageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "multi_cloud_images" {
+			return api.MultiCloudImageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NetworkGatewaysLocator returns a locator for the resource pointed to by the "network_gateways" link.
+// It returns nil if the link is absent.
+func (r *Session) NetworkGatewaysLocator(api *API) *NetworkGatewayLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "network_gateways" {
+			return api.NetworkGatewayLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NetworkOptionGroupAttachmentsLocator returns a locator for the resource pointed to by the "network_option_group_attachments" link.
+// It returns nil if the link is absent.
+func (r *Session) NetworkOptionGroupAttachmentsLocator(api *API) *NetworkOptionGroupAttachmentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "network_option_group_attachments" {
+			return api.NetworkOptionGroupAttachmentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NetworkOptionGroupsLocator returns a locator for the resource pointed to by the "network_option_groups" link.
+// It returns nil if the link is absent.
+func (r *Session) NetworkOptionGroupsLocator(api *API) *NetworkOptionGroupLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "network_option_groups" {
+			return api.NetworkOptionGroupLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NetworksLocator returns a locator for the resource pointed to by the "networks" link.
+// It returns nil if the link is absent.
+func (r *Session) NetworksLocator(api *API) *NetworkLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "networks" {
+			return api.NetworkLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// PermissionsLocator returns a locator for the resource pointed to by the "permissions" link.
+// It returns nil if the link is absent.
+func (r *Session) PermissionsLocator(api *API) *PermissionLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "permissions" {
+			return api.PermissionLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// PlacementGroupsLocator returns a locator for the resource pointed to by the "placement_groups" link.
+// It returns nil if the link is absent.
+func (r *Session) PlacementGroupsLocator(api *API) *PlacementGroupLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "placement_groups" {
+			return api.PlacementGroupLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// PreferencesLocator returns a locator for the resource pointed to by the "preferences" link.
+// It returns nil if the link is absent.
+func (r *Session) PreferencesLocator(api *API) *PreferenceLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "preferences" {
+			return api.PreferenceLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// PublicationLineagesLocator returns a locator for the resource pointed to by the "publication_lineages" link.
+// It returns nil if the link is absent.
+func (r *Session) PublicationLineagesLocator(api *API) *PublicationLineageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "publication_lineages" {
+			return api.PublicationLineageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// PublicationsLocator returns a locator for the resource pointed to by the "publications" link.
+// It returns nil if the link is absent.
+func (r *Session) PublicationsLocator(api *API) *PublicationLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "publications" {
+			return api.PublicationLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RepositoriesLocator returns a locator for the resource pointed to by the "repositories" link.
+// It returns nil if the link is absent.
+func (r *Session) RepositoriesLocator(api *API) *RepositoryLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "repositories" {
+			return api.RepositoryLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RightScriptsLocator returns a locator for the resource pointed to by the "right_scripts" link.
+// It returns nil if the link is absent.
+func (r *Session) RightScriptsLocator(api *API) *RightScriptLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "right_scripts" {
+			return api.RightScriptLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RouteTablesLocator returns a locator for the resource pointed to by the "route_tables" link.
+// It returns nil if the link is absent.
+func (r *Session) RouteTablesLocator(api *API) *RouteTableLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "route_tables" {
+			return api.RouteTableLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RoutesLocator returns a locator for the resource pointed to by the "routes" link.
+// It returns nil if the link is absent.
+func (r *Session) RoutesLocator(api *API) *RouteLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "routes" {
+			return api.RouteLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// SecurityGroupRulesLocator returns a locator for the resource pointed to by the "security_group_rules" link.
+// It returns nil if the link is absent.
+func (r *Session) SecurityGroupRulesLocator(api *API) *SecurityGroupRuleLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "security_group_rules" {
+			return api.SecurityGroupRuleLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ServerArraysLocator returns a locator for the resource pointed to by the "server_arrays" link.
+// It returns nil if the link is absent.
+func (r *Session) ServerArraysLocator(api *API) *ServerArrayLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "server_arrays" {
+			return api.ServerArrayLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ServerTemplateMultiCloudImagesLocator returns a locator for the resource pointed to by the "server_template_multi_cloud_images" link.
+// It returns nil if the link is absent.
+func (r *Session) ServerTemplateMultiCloudImagesLocator(api *API) *ServerTemplateMultiCloudImageLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "server_template_multi_cloud_images" {
+			return api.ServerTemplateMultiCloudImageLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ServerTemplatesLocator returns a locator for the resource pointed to by the "server_templates" link.
+// It returns nil if the link is absent.
+func (r *Session) ServerTemplatesLocator(api *API) *ServerTemplateLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "server_templates" {
+			return api.ServerTemplateLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ServersLocator returns a locator for the resource pointed to by the "servers" link.
+// It returns nil if the link is absent.
+func (r *Session) ServersLocator(api *API) *ServerLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "servers" {
+			return api.ServerLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// TagsLocator returns a locator for the resource pointed to by the "tags" link.
+// It returns nil if the link is absent.
+func (r *Session) TagsLocator(api *API) *TagLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "tags" {
+			return api.TagLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// UsersLocator returns a locator for the resource pointed to by the "users" link.
+// It returns nil if the link is absent.
+func (r *Session) UsersLocator(api *API) *UserLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "users" {
+			return api.UserLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // SessionLocator exposes the Session resource actions.
@@ -12101,8 +18677,56 @@ func (api *API) SessionLocator(href string) *SessionLocator {
 	return &SessionLocator{Href(href), api}
 }
 
+// SessionLocatorInterface lists the SessionLocator methods, code that depends on the interface
+// rather than on SessionLocator can be unit tested using the generated fake locators.
+type SessionLocatorInterface interface {
+	Accounts(options *SessionAccountsOptions) ([]*Account, error)
+	Index() ([]*Session, error)
+	IndexEach(fn func(*Session) error) error
+	IndexInstanceSession() (*Instance, error)
+}
+
 //===== Actions
 
+// SessionAccountsOptions contains the optional parameters of SessionLocator.Accounts.
+type SessionAccountsOptions struct {
+	// The email to login with if not using existing session.
+	Email string
+	// The corresponding password.
+	Password string
+	// Extended view shows account permissions and products
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SessionAccountsOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "email", "password", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SessionLocator.Accounts", n)
+		}
+	}
+	if o.Email != "" {
+		p["email"] = o.Email
+	}
+	if o.Password != "" {
+		p["password"] = o.Password
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/sessions/accounts
 //
 // List all the accounts that a user has access to.
@@ -12118,24 +18742,31 @@ func (api *API) SessionLocator(href string) *SessionLocator {
 // email: The email to login with if not using existing session.
 // password: The corresponding password.
 // view: Extended view shows account permissions and products
-func (loc *SessionLocator) Accounts(options rsapi.APIParams) ([]*Account, error) {
+func (loc *SessionLocator) Accounts(options *SessionAccountsOptions) ([]*Account, error) {
 	var res []*Account
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var emailOpt = options["email"]
+	var emailOpt = opts["email"]
 	if emailOpt != nil {
 		p["email"] = emailOpt
 	}
-	var passwordOpt = options["password"]
+	var passwordOpt = opts["password"]
 	if passwordOpt != nil {
 		p["password"] = passwordOpt
 	}
+	if err := loc.api.ValidateParams("Session", "accounts", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Session", "accounts")
 	if err != nil {
 		return res, err
@@ -12148,21 +18779,11 @@ func (loc *SessionLocator) Accounts(options rsapi.APIParams) ([]*Account, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -12176,6 +18797,9 @@ func (loc *SessionLocator) Index() ([]*Session, error) {
 	var res []*Session
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Session", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Session", "index")
 	if err != nil {
 		return res, err
@@ -12188,22 +18812,46 @@ func (loc *SessionLocator) Index() ([]*Session, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *SessionLocator) IndexEach(fn func(*Session) error) error {
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Session", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Session", "index")
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Session
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/sessions/instance
@@ -12216,6 +18864,9 @@ func (loc *SessionLocator) IndexInstanceSession() (*Instance, error) {
 	var res *Instance
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Session", "index_instance_session", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Session", "index_instance_session")
 	if err != nil {
 		return res, err
@@ -12228,21 +18879,11 @@ func (loc *SessionLocator) IndexInstanceSession() (*Instance, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -12267,6 +18908,17 @@ func (r *SshKey) Locator(api *API) *SshKeyLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *SshKey) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // SshKeyLocator exposes the SshKey resource actions.
@@ -12280,6 +18932,16 @@ func (api *API) SshKeyLocator(href string) *SshKeyLocator {
 	return &SshKeyLocator{Href(href), api}
 }
 
+// SshKeyLocatorInterface lists the SshKeyLocator methods, code that depends on the interface
+// rather than on SshKeyLocator can be unit tested using the generated fake locators.
+type SshKeyLocatorInterface interface {
+	Create(sshKey *SshKeyParam) (*SshKeyLocator, error)
+	Destroy() error
+	Index(options *SshKeyIndexOptions) ([]*SshKey, error)
+	IndexEach(options *SshKeyIndexOptions, fn func(*SshKey) error) error
+	Show(options *SshKeyShowOptions) (*SshKey, error)
+}
+
 //===== Actions
 
 // POST /api/clouds/:cloud_id/ssh_keys
@@ -12297,6 +18959,9 @@ func (loc *SshKeyLocator) Create(sshKey *SshKeyParam) (*SshKeyLocator, error) {
 	p = rsapi.APIParams{
 		"ssh_key": sshKey,
 	}
+	if err := loc.api.ValidateParams("SshKey", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("SshKey", "create")
 	if err != nil {
 		return res, err
@@ -12309,15 +18974,10 @@ func (loc *SshKeyLocator) Create(sshKey *SshKeyParam) (*SshKeyLocator, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -12332,6 +18992,9 @@ func (loc *SshKeyLocator) Create(sshKey *SshKeyParam) (*SshKeyLocator, error) {
 func (loc *SshKeyLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SshKey", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("SshKey", "destroy")
 	if err != nil {
 		return err
@@ -12344,65 +19007,164 @@ func (loc *SshKeyLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// SshKeyIndexOptions contains the optional parameters of SshKeyLocator.Index.
+type SshKeyIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SshKeyIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SshKeyLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/ssh_keys
 //
 // Lists ssh keys.
 // Optional parameters:
 // filter
 // view
-func (loc *SshKeyLocator) Index(options rsapi.APIParams) ([]*SshKey, error) {
+func (loc *SshKeyLocator) Index(options *SshKeyIndexOptions) ([]*SshKey, error) {
 	var res []*SshKey
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SshKey", "index", params, p); err != nil {
+		return res, err
+	}
+	uri, err := loc.ActionPath("SshKey", "index")
+	if err != nil {
+		return res, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return res, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return res, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return res, rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *SshKeyLocator) IndexEach(options *SshKeyIndexOptions, fn func(*SshKey) error) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SshKey", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("SshKey", "index")
 	if err != nil {
-		return res, err
+		return err
 	}
 	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
 	if err != nil {
-		return res, err
+		return err
 	}
 	resp, err := loc.api.PerformRequest(req)
 	if err != nil {
-		return res, err
+		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *SshKey
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// SshKeyShowOptions contains the optional parameters of SshKeyLocator.Show.
+type SshKeyShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SshKeyShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SshKeyLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/clouds/:cloud_id/ssh_keys/:id
@@ -12410,15 +19172,22 @@ func (loc *SshKeyLocator) Index(options rsapi.APIParams) ([]*SshKey, error) {
 // Displays information about a single ssh key.
 // Optional parameters:
 // view
-func (loc *SshKeyLocator) Show(options rsapi.APIParams) (*SshKey, error) {
+func (loc *SshKeyLocator) Show(options *SshKeyShowOptions) (*SshKey, error) {
 	var res *SshKey
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SshKey", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("SshKey", "show")
 	if err != nil {
 		return res, err
@@ -12431,21 +19200,11 @@ func (loc *SshKeyLocator) Show(options rsapi.APIParams) (*SshKey, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -12474,6 +19233,50 @@ func (r *Subnet) Locator(api *API) *SubnetLocator {
 	return nil
 }
 
+// DatacenterLocator returns a locator for the resource pointed to by the "datacenter" link.
+// It returns nil if the link is absent.
+func (r *Subnet) DatacenterLocator(api *API) *DatacenterLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "datacenter" {
+			return api.DatacenterLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// EffectiveRouteTableLocator returns a locator for the resource pointed to by the "effective_route_table" link.
+// It returns nil if the link is absent.
+func (r *Subnet) EffectiveRouteTableLocator(api *API) *RouteTableLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "effective_route_table" {
+			return api.RouteTableLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// NetworkLocator returns a locator for the resource pointed to by the "network" link.
+// It returns nil if the link is absent.
+func (r *Subnet) NetworkLocator(api *API) *NetworkLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "network" {
+			return api.NetworkLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RouteTableLocator returns a locator for the resource pointed to by the "route_table" link.
+// It returns nil if the link is absent.
+func (r *Subnet) RouteTableLocator(api *API) *RouteTableLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "route_table" {
+			return api.RouteTableLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // SubnetLocator exposes the Subnet resource actions.
@@ -12487,6 +19290,17 @@ func (api *API) SubnetLocator(href string) *SubnetLocator {
 	return &SubnetLocator{Href(href), api}
 }
 
+// SubnetLocatorInterface lists the SubnetLocator methods, code that depends on the interface
+// rather than on SubnetLocator can be unit tested using the generated fake locators.
+type SubnetLocatorInterface interface {
+	Create(subnet *SubnetParam) (*SubnetLocator, error)
+	Destroy() error
+	Index(options *SubnetIndexOptions) ([]*Subnet, error)
+	IndexEach(options *SubnetIndexOptions, fn func(*Subnet) error) error
+	Show() (*Subnet, error)
+	Update(subnet *SubnetParam2) error
+}
+
 //===== Actions
 
 // POST /api/clouds/:cloud_id/instances/:instance_id/subnets
@@ -12505,6 +19319,9 @@ func (loc *SubnetLocator) Create(subnet *SubnetParam) (*SubnetLocator, error) {
 	p = rsapi.APIParams{
 		"subnet": subnet,
 	}
+	if err := loc.api.ValidateParams("Subnet", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Subnet", "create")
 	if err != nil {
 		return res, err
@@ -12517,15 +19334,10 @@ func (loc *SubnetLocator) Create(subnet *SubnetParam) (*SubnetLocator, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -12541,6 +19353,9 @@ func (loc *SubnetLocator) Create(subnet *SubnetParam) (*SubnetLocator, error) {
 func (loc *SubnetLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Subnet", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Subnet", "destroy")
 	if err != nil {
 		return err
@@ -12553,33 +19368,63 @@ func (loc *SubnetLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// SubnetIndexOptions contains the optional parameters of SubnetLocator.Index.
+type SubnetIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SubnetIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SubnetLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/instances/:instance_id/subnets
 // GET /api/clouds/:cloud_id/subnets
 //
 // Lists subnets of a given cloud.
 // Optional parameters:
 // filter
-func (loc *SubnetLocator) Index(options rsapi.APIParams) ([]*Subnet, error) {
+func (loc *SubnetLocator) Index(options *SubnetIndexOptions) ([]*Subnet, error) {
 	var res []*Subnet
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Subnet", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Subnet", "index")
 	if err != nil {
 		return res, err
@@ -12592,22 +19437,55 @@ func (loc *SubnetLocator) Index(options rsapi.APIParams) ([]*Subnet, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *SubnetLocator) IndexEach(options *SubnetIndexOptions, fn func(*Subnet) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Subnet", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Subnet", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Subnet
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/clouds/:cloud_id/instances/:instance_id/subnets/:id
@@ -12618,6 +19496,9 @@ func (loc *SubnetLocator) Show() (*Subnet, error) {
 	var res *Subnet
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Subnet", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Subnet", "show")
 	if err != nil {
 		return res, err
@@ -12630,21 +19511,11 @@ func (loc *SubnetLocator) Show() (*Subnet, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -12663,6 +19534,9 @@ func (loc *SubnetLocator) Update(subnet *SubnetParam2) error {
 	p = rsapi.APIParams{
 		"subnet": subnet,
 	}
+	if err := loc.api.ValidateParams("Subnet", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Subnet", "update")
 	if err != nil {
 		return err
@@ -12675,15 +19549,10 @@ func (loc *SubnetLocator) Update(subnet *SubnetParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -12708,6 +19577,15 @@ func (api *API) TagLocator(href string) *TagLocator {
 	return &TagLocator{Href(href), api}
 }
 
+// TagLocatorInterface lists the TagLocator methods, code that depends on the interface
+// rather than on TagLocator can be unit tested using the generated fake locators.
+type TagLocatorInterface interface {
+	ByResource(resourceHrefs []string) ([]map[string]interface{}, error)
+	ByTag(resourceType string, tags []string, options *TagByTagOptions) ([]map[string]interface{}, error)
+	MultiAdd(resourceHrefs []string, tags []string) error
+	MultiDelete(resourceHrefs []string, tags []string) error
+}
+
 //===== Actions
 
 // POST /api/tags/by_resource
@@ -12726,6 +19604,9 @@ func (loc *TagLocator) ByResource(resourceHrefs []string) ([]map[string]interfac
 	p = rsapi.APIParams{
 		"resource_hrefs": resourceHrefs,
 	}
+	if err := loc.api.ValidateParams("Tag", "by_resource", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Tag", "by_resource")
 	if err != nil {
 		return res, err
@@ -12738,24 +19619,53 @@ func (loc *TagLocator) ByResource(resourceHrefs []string) ([]map[string]interfac
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// TagByTagOptions contains the optional parameters of TagLocator.ByTag.
+type TagByTagOptions struct {
+	// If included, all tags matching this prefix will be returned. If not included, no tags will be returned.
+	IncludeTagsWithPrefix string
+	// If set to 'true', resources having all the tags specified in the 'tags' parameter are returned. Otherwise, resources having any of the tags are returned.
+	MatchAll string
+	// If set to 'true', tags for deleted resources will also be returned. Default value is 'false'.
+	WithDeleted string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *TagByTagOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "include_tags_with_prefix", "match_all", "with_deleted":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of TagLocator.ByTag", n)
+		}
+	}
+	if o.IncludeTagsWithPrefix != "" {
+		p["include_tags_with_prefix"] = o.IncludeTagsWithPrefix
+	}
+	if o.MatchAll != "" {
+		p["match_all"] = o.MatchAll
+	}
+	if o.WithDeleted != "" {
+		p["with_deleted"] = o.WithDeleted
+	}
+	return p, nil
+}
+
 // POST /api/tags/by_tag
 //
 // Search for resources having a list of tags in a specific resource_type.
@@ -12778,7 +19688,7 @@ func (loc *TagLocator) ByResource(resourceHrefs []string) ([]map[string]interfac
 // include_tags_with_prefix: If included, all tags matching this prefix will be returned. If not included, no tags will be returned.
 // match_all: If set to 'true', resources having all the tags specified in the 'tags' parameter are returned. Otherwise, resources having any of the tags are returned.
 // with_deleted: If set to 'true', tags for deleted resources will also be returned. Default value is 'false'.
-func (loc *TagLocator) ByTag(resourceType string, tags []string, options rsapi.APIParams) ([]map[string]interface{}, error) {
+func (loc *TagLocator) ByTag(resourceType string, tags []string, options *TagByTagOptions) ([]map[string]interface{}, error) {
 	var res []map[string]interface{}
 	if resourceType == "" {
 		return res, fmt.Errorf("resourceType is required")
@@ -12786,24 +19696,31 @@ func (loc *TagLocator) ByTag(resourceType string, tags []string, options rsapi.A
 	if len(tags) == 0 {
 		return res, fmt.Errorf("tags is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"resource_type": resourceType,
 		"tags":          tags,
 	}
-	var includeTagsWithPrefixOpt = options["include_tags_with_prefix"]
+	var includeTagsWithPrefixOpt = opts["include_tags_with_prefix"]
 	if includeTagsWithPrefixOpt != nil {
 		p["include_tags_with_prefix"] = includeTagsWithPrefixOpt
 	}
-	var matchAllOpt = options["match_all"]
+	var matchAllOpt = opts["match_all"]
 	if matchAllOpt != nil {
 		p["match_all"] = matchAllOpt
 	}
-	var withDeletedOpt = options["with_deleted"]
+	var withDeletedOpt = opts["with_deleted"]
 	if withDeletedOpt != nil {
 		p["with_deleted"] = withDeletedOpt
 	}
+	if err := loc.api.ValidateParams("Tag", "by_tag", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Tag", "by_tag")
 	if err != nil {
 		return res, err
@@ -12816,21 +19733,11 @@ func (loc *TagLocator) ByTag(resourceType string, tags []string, options rsapi.A
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -12857,6 +19764,9 @@ func (loc *TagLocator) MultiAdd(resourceHrefs []string, tags []string) error {
 		"resource_hrefs": resourceHrefs,
 		"tags":           tags,
 	}
+	if err := loc.api.ValidateParams("Tag", "multi_add", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Tag", "multi_add")
 	if err != nil {
 		return err
@@ -12869,15 +19779,10 @@ func (loc *TagLocator) MultiAdd(resourceHrefs []string, tags []string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -12903,6 +19808,9 @@ func (loc *TagLocator) MultiDelete(resourceHrefs []string, tags []string) error
 		"resource_hrefs": resourceHrefs,
 		"tags":           tags,
 	}
+	if err := loc.api.ValidateParams("Tag", "multi_delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Tag", "multi_delete")
 	if err != nil {
 		return err
@@ -12915,15 +19823,10 @@ func (loc *TagLocator) MultiDelete(resourceHrefs []string, tags []string) error
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -12962,23 +19865,64 @@ func (api *API) TaskLocator(href string) *TaskLocator {
 	return &TaskLocator{Href(href), api}
 }
 
+// TaskLocatorInterface lists the TaskLocator methods, code that depends on the interface
+// rather than on TaskLocator can be unit tested using the generated fake locators.
+type TaskLocatorInterface interface {
+	Show(options *TaskShowOptions) (*Task, error)
+}
+
 //===== Actions
 
+// TaskShowOptions contains the optional parameters of TaskLocator.Show.
+type TaskShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *TaskShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of TaskLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/instances/:instance_id/live/tasks/:id
 // GET /api/server_arrays/:server_array_id/live/tasks/:id
 //
 // Displays information of a given task within the context of an instance.
 // Optional parameters:
 // view
-func (loc *TaskLocator) Show(options rsapi.APIParams) (*Task, error) {
+func (loc *TaskLocator) Show(options *TaskShowOptions) (*Task, error) {
 	var res *Task
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Task", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Task", "show")
 	if err != nil {
 		return res, err
@@ -12991,21 +19935,11 @@ func (loc *TaskLocator) Show(options rsapi.APIParams) (*Task, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -13016,7 +19950,7 @@ func (loc *TaskLocator) Show(options rsapi.APIParams) (*Task, error) {
 type User struct {
 	Actions      []map[string]string `json:"actions,omitempty"`
 	Company      string              `json:"company,omitempty"`
-	CreatedAt    *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt    *rsapi.Time         `json:"created_at,omitempty"`
 	Email        string              `json:"email,omitempty"`
 	FirstName    string              `json:"first_name,omitempty"`
 	LastName     string              `json:"last_name,omitempty"`
@@ -13024,7 +19958,7 @@ type User struct {
 	Phone        string              `json:"phone,omitempty"`
 	PrincipalUid string              `json:"principal_uid,omitempty"`
 	TimezoneName string              `json:"timezone_name,omitempty"`
-	UpdatedAt    *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt    *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -13037,6 +19971,17 @@ func (r *User) Locator(api *API) *UserLocator {
 	return nil
 }
 
+// IdentityProviderLocator returns a locator for the resource pointed to by the "identity_provider" link.
+// It returns nil if the link is absent.
+func (r *User) IdentityProviderLocator(api *API) *IdentityProviderLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "identity_provider" {
+			return api.IdentityProviderLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // UserLocator exposes the User resource actions.
@@ -13050,6 +19995,16 @@ func (api *API) UserLocator(href string) *UserLocator {
 	return &UserLocator{Href(href), api}
 }
 
+// UserLocatorInterface lists the UserLocator methods, code that depends on the interface
+// rather than on UserLocator can be unit tested using the generated fake locators.
+type UserLocatorInterface interface {
+	Create(user *UserParam) (*UserLocator, error)
+	Index(options *UserIndexOptions) ([]*User, error)
+	IndexEach(options *UserIndexOptions, fn func(*User) error) error
+	Show() (*User, error)
+	Update(user *UserParam2) error
+}
+
 //===== Actions
 
 // POST /api/users
@@ -13081,6 +20036,9 @@ func (loc *UserLocator) Create(user *UserParam) (*UserLocator, error) {
 	p = rsapi.APIParams{
 		"user": user,
 	}
+	if err := loc.api.ValidateParams("User", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("User", "create")
 	if err != nil {
 		return res, err
@@ -13093,15 +20051,10 @@ func (loc *UserLocator) Create(user *UserParam) (*UserLocator, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -13110,21 +20063,56 @@ func (loc *UserLocator) Create(user *UserParam) (*UserLocator, error) {
 	}
 }
 
+// UserIndexOptions contains the optional parameters of UserLocator.Index.
+type UserIndexOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *UserIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of UserLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /api/users
 //
 // List the users available to the account the user is logged in to. Therefore, to list the users of
 // a child account, the user has to login to the child account first.
 // Optional parameters:
 // filter
-func (loc *UserLocator) Index(options rsapi.APIParams) ([]*User, error) {
+func (loc *UserLocator) Index(options *UserIndexOptions) ([]*User, error) {
 	var res []*User
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("User", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("User", "index")
 	if err != nil {
 		return res, err
@@ -13137,22 +20125,55 @@ func (loc *UserLocator) Index(options rsapi.APIParams) ([]*User, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *UserLocator) IndexEach(options *UserIndexOptions, fn func(*User) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("User", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("User", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *User
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /api/users/:id
@@ -13162,6 +20183,9 @@ func (loc *UserLocator) Show() (*User, error) {
 	var res *User
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("User", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("User", "show")
 	if err != nil {
 		return res, err
@@ -13174,21 +20198,11 @@ func (loc *UserLocator) Show() (*User, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -13224,6 +20238,9 @@ func (loc *UserLocator) Update(user *UserParam2) error {
 	p = rsapi.APIParams{
 		"user": user,
 	}
+	if err := loc.api.ValidateParams("User", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("User", "update")
 	if err != nil {
 		return err
@@ -13236,15 +20253,10 @@ func (loc *UserLocator) Update(user *UserParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -13266,6 +20278,12 @@ func (api *API) UserDataLocator(href string) *UserDataLocator {
 	return &UserDataLocator{Href(href), api}
 }
 
+// UserDataLocatorInterface lists the UserDataLocator methods, code that depends on the interface
+// rather than on UserDataLocator can be unit tested using the generated fake locators.
+type UserDataLocatorInterface interface {
+	Show() (map[string]interface{}, error)
+}
+
 //===== Actions
 
 // GET /api/user_data/
@@ -13275,6 +20293,9 @@ func (loc *UserDataLocator) Show() (map[string]interface{}, error) {
 	var res map[string]interface{}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("UserData", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("UserData", "show")
 	if err != nil {
 		return res, err
@@ -13287,21 +20308,11 @@ func (loc *UserDataLocator) Show() (map[string]interface{}, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -13310,7 +20321,7 @@ func (loc *UserDataLocator) Show() (map[string]interface{}, error) {
 // A Volume provides a highly reliable, efficient and persistent storage solution that can be mounted to a cloud instance (in the same datacenter / zone).
 type Volume struct {
 	Actions     []map[string]string `json:"actions,omitempty"`
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Description string              `json:"description,omitempty"`
 	Iops        string              `json:"iops,omitempty"`
 	Links       []map[string]string `json:"links,omitempty"`
@@ -13318,7 +20329,7 @@ type Volume struct {
 	ResourceUid string              `json:"resource_uid,omitempty"`
 	Size        int                 `json:"size,omitempty"`
 	Status      string              `json:"status,omitempty"`
-	UpdatedAt   *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt   *rsapi.Time         `json:"updated_at,omitempty"`
 	VolumeType  string              `json:"volume_type,omitempty"`
 }
 
@@ -13332,6 +20343,94 @@ func (r *Volume) Locator(api *API) *VolumeLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *Volume) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// CurrentVolumeAttachmentLocator returns a locator for the resource pointed to by the "current_volume_attachment" link.
+// It returns nil if the link is absent.
+func (r *Volume) CurrentVolumeAttachmentLocator(api *API) *VolumeAttachmentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "current_volume_attachment" {
+			return api.VolumeAttachmentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// DatacenterLocator returns a locator for the resource pointed to by the "datacenter" link.
+// It returns nil if the link is absent.
+func (r *Volume) DatacenterLocator(api *API) *DatacenterLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "datacenter" {
+			return api.DatacenterLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// DeploymentLocator returns a locator for the resource pointed to by the "deployment" link.
+// It returns nil if the link is absent.
+func (r *Volume) DeploymentLocator(api *API) *DeploymentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "deployment" {
+			return api.DeploymentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ParentVolumeSnapshotLocator returns a locator for the resource pointed to by the "parent_volume_snapshot" link.
+// It returns nil if the link is absent.
+func (r *Volume) ParentVolumeSnapshotLocator(api *API) *VolumeSnapshotLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "parent_volume_snapshot" {
+			return api.VolumeSnapshotLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RecurringVolumeAttachmentsLocator returns a locator for the resource pointed to by the "recurring_volume_attachments" link.
+// It returns nil if the link is absent.
+func (r *Volume) RecurringVolumeAttachmentsLocator(api *API) *RecurringVolumeAttachmentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "recurring_volume_attachments" {
+			return api.RecurringVolumeAttachmentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// VolumeSnapshotsLocator returns a locator for the resource pointed to by the "volume_snapshots" link.
+// It returns nil if the link is absent.
+func (r *Volume) VolumeSnapshotsLocator(api *API) *VolumeSnapshotLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "volume_snapshots" {
+			return api.VolumeSnapshotLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// VolumeTypeLocator returns a locator for the resource pointed to by the "volume_type" link.
+// It returns nil if the link is absent.
+func (r *Volume) VolumeTypeLocator(api *API) *VolumeTypeLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "volume_type" {
+			return api.VolumeTypeLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // VolumeLocator exposes the Volume resource actions.
@@ -13345,6 +20444,17 @@ func (api *API) VolumeLocator(href string) *VolumeLocator {
 	return &VolumeLocator{Href(href), api}
 }
 
+// VolumeLocatorInterface lists the VolumeLocator methods, code that depends on the interface
+// rather than on VolumeLocator can be unit tested using the generated fake locators.
+type VolumeLocatorInterface interface {
+	Create(volume *VolumeParam) (*VolumeLocator, error)
+	Destroy() error
+	Index(options *VolumeIndexOptions) ([]*Volume, error)
+	IndexEach(options *VolumeIndexOptions, fn func(*Volume) error) error
+	Show(options *VolumeShowOptions) (*Volume, error)
+	Update(volume *VolumeParam2) error
+}
+
 //===== Actions
 
 // POST /api/clouds/:cloud_id/volumes
@@ -13362,6 +20472,9 @@ func (loc *VolumeLocator) Create(volume *VolumeParam) (*VolumeLocator, error) {
 	p = rsapi.APIParams{
 		"volume": volume,
 	}
+	if err := loc.api.ValidateParams("Volume", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Volume", "create")
 	if err != nil {
 		return res, err
@@ -13374,15 +20487,10 @@ func (loc *VolumeLocator) Create(volume *VolumeParam) (*VolumeLocator, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -13397,6 +20505,9 @@ func (loc *VolumeLocator) Create(volume *VolumeParam) (*VolumeLocator, error) {
 func (loc *VolumeLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Volume", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Volume", "destroy")
 	if err != nil {
 		return err
@@ -13409,37 +20520,71 @@ func (loc *VolumeLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// VolumeIndexOptions contains the optional parameters of VolumeLocator.Index.
+type VolumeIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *VolumeIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of VolumeLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/volumes
 //
 // Lists volumes.
 // Optional parameters:
 // filter
 // view
-func (loc *VolumeLocator) Index(options rsapi.APIParams) ([]*Volume, error) {
+func (loc *VolumeLocator) Index(options *VolumeIndexOptions) ([]*Volume, error) {
 	var res []*Volume
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Volume", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Volume", "index")
 	if err != nil {
 		return res, err
@@ -13452,22 +20597,87 @@ func (loc *VolumeLocator) Index(options rsapi.APIParams) ([]*Volume, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *VolumeLocator) IndexEach(options *VolumeIndexOptions, fn func(*Volume) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Volume", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Volume", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Volume
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// VolumeShowOptions contains the optional parameters of VolumeLocator.Show.
+type VolumeShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *VolumeShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of VolumeLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/clouds/:cloud_id/volumes/:id
@@ -13475,15 +20685,22 @@ func (loc *VolumeLocator) Index(options rsapi.APIParams) ([]*Volume, error) {
 // Displays information about a single volume.
 // Optional parameters:
 // view
-func (loc *VolumeLocator) Show(options rsapi.APIParams) (*Volume, error) {
+func (loc *VolumeLocator) Show(options *VolumeShowOptions) (*Volume, error) {
 	var res *Volume
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Volume", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Volume", "show")
 	if err != nil {
 		return res, err
@@ -13496,21 +20713,11 @@ func (loc *VolumeLocator) Show(options rsapi.APIParams) (*Volume, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -13528,6 +20735,9 @@ func (loc *VolumeLocator) Update(volume *VolumeParam2) error {
 	p = rsapi.APIParams{
 		"volume": volume,
 	}
+	if err := loc.api.ValidateParams("Volume", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Volume", "update")
 	if err != nil {
 		return err
@@ -13540,15 +20750,10 @@ func (loc *VolumeLocator) Update(volume *VolumeParam2) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -13557,13 +20762,13 @@ func (loc *VolumeLocator) Update(volume *VolumeParam2) error {
 // A VolumeAttachment represents a relationship between a volume and an instance.
 type VolumeAttachment struct {
 	Actions     []map[string]string `json:"actions,omitempty"`
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Device      string              `json:"device,omitempty"`
 	DeviceId    string              `json:"device_id,omitempty"`
 	Links       []map[string]string `json:"links,omitempty"`
 	ResourceUid string              `json:"resource_uid,omitempty"`
 	State       string              `json:"state,omitempty"`
-	UpdatedAt   *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt   *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -13576,6 +20781,39 @@ func (r *VolumeAttachment) Locator(api *API) *VolumeAttachmentLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *VolumeAttachment) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// InstanceLocator returns a locator for the resource pointed to by the "instance" link.
+// It returns nil if the link is absent.
+func (r *VolumeAttachment) InstanceLocator(api *API) *InstanceLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "instance" {
+			return api.InstanceLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// VolumeLocator returns a locator for the resource pointed to by the "volume" link.
+// It returns nil if the link is absent.
+func (r *VolumeAttachment) VolumeLocator(api *API) *VolumeLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "volume" {
+			return api.VolumeLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // VolumeAttachmentLocator exposes the VolumeAttachment resource actions.
@@ -13589,6 +20827,16 @@ func (api *API) VolumeAttachmentLocator(href string) *VolumeAttachmentLocator {
 	return &VolumeAttachmentLocator{Href(href), api}
 }
 
+// VolumeAttachmentLocatorInterface lists the VolumeAttachmentLocator methods, code that depends on the interface
+// rather than on VolumeAttachmentLocator can be unit tested using the generated fake locators.
+type VolumeAttachmentLocatorInterface interface {
+	Create(volumeAttachment *VolumeAttachmentParam) (*VolumeAttachmentLocator, error)
+	Destroy(options *VolumeAttachmentDestroyOptions) error
+	Index(options *VolumeAttachmentIndexOptions) ([]*VolumeAttachment, error)
+	IndexEach(options *VolumeAttachmentIndexOptions, fn func(*VolumeAttachment) error) error
+	Show(options *VolumeAttachmentShowOptions) (*VolumeAttachment, error)
+}
+
 //===== Actions
 
 // POST /api/clouds/:cloud_id/instances/:instance_id/volume_attachments
@@ -13609,6 +20857,9 @@ func (loc *VolumeAttachmentLocator) Create(volumeAttachment *VolumeAttachmentPar
 	p = rsapi.APIParams{
 		"volume_attachment": volumeAttachment,
 	}
+	if err := loc.api.ValidateParams("VolumeAttachment", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("VolumeAttachment", "create")
 	if err != nil {
 		return res, err
@@ -13621,15 +20872,10 @@ func (loc *VolumeAttachmentLocator) Create(volumeAttachment *VolumeAttachmentPar
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -13638,6 +20884,35 @@ func (loc *VolumeAttachmentLocator) Create(volumeAttachment *VolumeAttachmentPar
 	}
 }
 
+// VolumeAttachmentDestroyOptions contains the optional parameters of VolumeAttachmentLocator.Destroy.
+type VolumeAttachmentDestroyOptions struct {
+	// Specifies whether to force the detachment of a volume.
+	Force string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *VolumeAttachmentDestroyOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "force":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of VolumeAttachmentLocator.Destroy", n)
+		}
+	}
+	if o.Force != "" {
+		p["force"] = o.Force
+	}
+	return p, nil
+}
+
 // DELETE /api/clouds/:cloud_id/instances/:instance_id/volume_attachments/:id
 // DELETE /api/clouds/:cloud_id/volume_attachments/:id
 // DELETE /api/clouds/:cloud_id/volumes/:volume_id/volume_attachments
@@ -13646,86 +20921,192 @@ func (loc *VolumeAttachmentLocator) Create(volumeAttachment *VolumeAttachmentPar
 // Deletes a given volume attachment.
 // Optional parameters:
 // force: Specifies whether to force the detachment of a volume.
-func (loc *VolumeAttachmentLocator) Destroy(options rsapi.APIParams) error {
+func (loc *VolumeAttachmentLocator) Destroy(options *VolumeAttachmentDestroyOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var forceOpt = options["force"]
+	var forceOpt = opts["force"]
 	if forceOpt != nil {
 		p["force"] = forceOpt
 	}
-	uri, err := loc.ActionPath("VolumeAttachment", "destroy")
+	if err := loc.api.ValidateParams("VolumeAttachment", "destroy", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("VolumeAttachment", "destroy")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// VolumeAttachmentIndexOptions contains the optional parameters of VolumeAttachmentLocator.Index.
+type VolumeAttachmentIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *VolumeAttachmentIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of VolumeAttachmentLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
+// GET /api/clouds/:cloud_id/instances/:instance_id/volume_attachments
+// GET /api/clouds/:cloud_id/volume_attachments
+//
+// Lists all volume attachments.
+// Optional parameters:
+// filter
+// view
+func (loc *VolumeAttachmentLocator) Index(options *VolumeAttachmentIndexOptions) ([]*VolumeAttachment, error) {
+	var res []*VolumeAttachment
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("VolumeAttachment", "index", params, p); err != nil {
+		return res, err
+	}
+	uri, err := loc.ActionPath("VolumeAttachment", "index")
 	if err != nil {
-		return err
+		return res, err
 	}
 	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
 	if err != nil {
-		return err
+		return res, err
 	}
 	resp, err := loc.api.PerformRequest(req)
 	if err != nil {
-		return err
+		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
-	return nil
+	defer resp.Body.Close()
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
 }
 
-// GET /api/clouds/:cloud_id/instances/:instance_id/volume_attachments
-// GET /api/clouds/:cloud_id/volume_attachments
-//
-// Lists all volume attachments.
-// Optional parameters:
-// filter
-// view
-func (loc *VolumeAttachmentLocator) Index(options rsapi.APIParams) ([]*VolumeAttachment, error) {
-	var res []*VolumeAttachment
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *VolumeAttachmentLocator) IndexEach(options *VolumeAttachmentIndexOptions, fn func(*VolumeAttachment) error) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("VolumeAttachment", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("VolumeAttachment", "index")
 	if err != nil {
-		return res, err
+		return err
 	}
 	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
 	if err != nil {
-		return res, err
+		return err
 	}
 	resp, err := loc.api.PerformRequest(req)
 	if err != nil {
-		return res, err
+		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *VolumeAttachment
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// VolumeAttachmentShowOptions contains the optional parameters of VolumeAttachmentLocator.Show.
+type VolumeAttachmentShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *VolumeAttachmentShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of VolumeAttachmentLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/clouds/:cloud_id/instances/:instance_id/volume_attachments/:id
@@ -13736,15 +21117,22 @@ func (loc *VolumeAttachmentLocator) Index(options rsapi.APIParams) ([]*VolumeAtt
 // Displays information about a single volume attachment.
 // Optional parameters:
 // view
-func (loc *VolumeAttachmentLocator) Show(options rsapi.APIParams) (*VolumeAttachment, error) {
+func (loc *VolumeAttachmentLocator) Show(options *VolumeAttachmentShowOptions) (*VolumeAttachment, error) {
 	var res *VolumeAttachment
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("VolumeAttachment", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("VolumeAttachment", "show")
 	if err != nil {
 		return res, err
@@ -13757,21 +21145,11 @@ func (loc *VolumeAttachmentLocator) Show(options rsapi.APIParams) (*VolumeAttach
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -13782,7 +21160,7 @@ func (loc *VolumeAttachmentLocator) Show(options rsapi.APIParams) (*VolumeAttach
 // Snapshots consist of a series of data blocks that are incrementally saved.
 type VolumeSnapshot struct {
 	Actions     []map[string]string `json:"actions,omitempty"`
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Description string              `json:"description,omitempty"`
 	Links       []map[string]string `json:"links,omitempty"`
 	Name        string              `json:"name,omitempty"`
@@ -13790,7 +21168,7 @@ type VolumeSnapshot struct {
 	ResourceUid string              `json:"resource_uid,omitempty"`
 	Size        string              `json:"size,omitempty"`
 	State       string              `json:"state,omitempty"`
-	UpdatedAt   *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt   *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -13803,6 +21181,50 @@ func (r *VolumeSnapshot) Locator(api *API) *VolumeSnapshotLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *VolumeSnapshot) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// DeploymentLocator returns a locator for the resource pointed to by the "deployment" link.
+// It returns nil if the link is absent.
+func (r *VolumeSnapshot) DeploymentLocator(api *API) *DeploymentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "deployment" {
+			return api.DeploymentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// ParentVolumeLocator returns a locator for the resource pointed to by the "parent_volume" link.
+// It returns nil if the link is absent.
+func (r *VolumeSnapshot) ParentVolumeLocator(api *API) *VolumeLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "parent_volume" {
+			return api.VolumeLocator(l["href"])
+		}
+	}
+	return nil
+}
+
+// RecurringVolumeAttachmentsLocator returns a locator for the resource pointed to by the "recurring_volume_attachments" link.
+// It returns nil if the link is absent.
+func (r *VolumeSnapshot) RecurringVolumeAttachmentsLocator(api *API) *RecurringVolumeAttachmentLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "recurring_volume_attachments" {
+			return api.RecurringVolumeAttachmentLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // VolumeSnapshotLocator exposes the VolumeSnapshot resource actions.
@@ -13816,8 +21238,50 @@ func (api *API) VolumeSnapshotLocator(href string) *VolumeSnapshotLocator {
 	return &VolumeSnapshotLocator{Href(href), api}
 }
 
+// VolumeSnapshotLocatorInterface lists the VolumeSnapshotLocator methods, code that depends on the interface
+// rather than on VolumeSnapshotLocator can be unit tested using the generated fake locators.
+type VolumeSnapshotLocatorInterface interface {
+	Create(options *VolumeSnapshotCreateOptions) (*VolumeSnapshotLocator, error)
+	Destroy() error
+	Index(options *VolumeSnapshotIndexOptions) ([]*VolumeSnapshot, error)
+	IndexEach(options *VolumeSnapshotIndexOptions, fn func(*VolumeSnapshot) error) error
+	Show(options *VolumeSnapshotShowOptions) (*VolumeSnapshot, error)
+}
+
 //===== Actions
 
+// VolumeSnapshotCreateOptions contains the optional parameters of VolumeSnapshotLocator.Create.
+type VolumeSnapshotCreateOptions struct {
+	VolumeSnapshot     *VolumeSnapshotParam
+	VolumeSnapshotCopy *VolumeSnapshotCopy
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *VolumeSnapshotCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "volume_snapshot", "volume_snapshot_copy":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of VolumeSnapshotLocator.Create", n)
+		}
+	}
+	if o.VolumeSnapshot != nil {
+		p["volume_snapshot"] = o.VolumeSnapshot
+	}
+	if o.VolumeSnapshotCopy != nil {
+		p["volume_snapshot_copy"] = o.VolumeSnapshotCopy
+	}
+	return p, nil
+}
+
 // POST /api/clouds/:cloud_id/volumes/:volume_id/volume_snapshots
 // POST /api/clouds/:cloud_id/volume_snapshots
 //
@@ -13825,19 +21289,26 @@ func (api *API) VolumeSnapshotLocator(href string) *VolumeSnapshotLocator {
 // Optional parameters:
 // volume_snapshot
 // volume_snapshot_copy
-func (loc *VolumeSnapshotLocator) Create(options rsapi.APIParams) (*VolumeSnapshotLocator, error) {
+func (loc *VolumeSnapshotLocator) Create(options *VolumeSnapshotCreateOptions) (*VolumeSnapshotLocator, error) {
 	var res *VolumeSnapshotLocator
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var volumeSnapshotOpt = options["volume_snapshot"]
+	var volumeSnapshotOpt = opts["volume_snapshot"]
 	if volumeSnapshotOpt != nil {
 		p["volume_snapshot"] = volumeSnapshotOpt
 	}
-	var volumeSnapshotCopyOpt = options["volume_snapshot_copy"]
+	var volumeSnapshotCopyOpt = opts["volume_snapshot_copy"]
 	if volumeSnapshotCopyOpt != nil {
 		p["volume_snapshot_copy"] = volumeSnapshotCopyOpt
 	}
+	if err := loc.api.ValidateParams("VolumeSnapshot", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("VolumeSnapshot", "create")
 	if err != nil {
 		return res, err
@@ -13850,15 +21321,10 @@ func (loc *VolumeSnapshotLocator) Create(options rsapi.APIParams) (*VolumeSnapsh
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -13874,6 +21340,9 @@ func (loc *VolumeSnapshotLocator) Create(options rsapi.APIParams) (*VolumeSnapsh
 func (loc *VolumeSnapshotLocator) Destroy() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("VolumeSnapshot", "destroy", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("VolumeSnapshot", "destroy")
 	if err != nil {
 		return err
@@ -13886,18 +21355,45 @@ func (loc *VolumeSnapshotLocator) Destroy() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// VolumeSnapshotIndexOptions contains the optional parameters of VolumeSnapshotLocator.Index.
+type VolumeSnapshotIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *VolumeSnapshotIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of VolumeSnapshotLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/volumes/:volume_id/volume_snapshots
 // GET /api/clouds/:cloud_id/volume_snapshots
 //
@@ -13905,19 +21401,26 @@ func (loc *VolumeSnapshotLocator) Destroy() error {
 // Optional parameters:
 // filter
 // view
-func (loc *VolumeSnapshotLocator) Index(options rsapi.APIParams) ([]*VolumeSnapshot, error) {
+func (loc *VolumeSnapshotLocator) Index(options *VolumeSnapshotIndexOptions) ([]*VolumeSnapshot, error) {
 	var res []*VolumeSnapshot
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("VolumeSnapshot", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("VolumeSnapshot", "index")
 	if err != nil {
 		return res, err
@@ -13930,22 +21433,87 @@ func (loc *VolumeSnapshotLocator) Index(options rsapi.APIParams) ([]*VolumeSnaps
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *VolumeSnapshotLocator) IndexEach(options *VolumeSnapshotIndexOptions, fn func(*VolumeSnapshot) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("VolumeSnapshot", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("VolumeSnapshot", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *VolumeSnapshot
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// VolumeSnapshotShowOptions contains the optional parameters of VolumeSnapshotLocator.Show.
+type VolumeSnapshotShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *VolumeSnapshotShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of VolumeSnapshotLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/clouds/:cloud_id/volumes/:volume_id/volume_snapshots/:id
@@ -13954,15 +21522,22 @@ func (loc *VolumeSnapshotLocator) Index(options rsapi.APIParams) ([]*VolumeSnaps
 // Displays information about a single volume_snapshot.
 // Optional parameters:
 // view
-func (loc *VolumeSnapshotLocator) Show(options rsapi.APIParams) (*VolumeSnapshot, error) {
+func (loc *VolumeSnapshotLocator) Show(options *VolumeSnapshotShowOptions) (*VolumeSnapshot, error) {
 	var res *VolumeSnapshot
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("VolumeSnapshot", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("VolumeSnapshot", "show")
 	if err != nil {
 		return res, err
@@ -13975,21 +21550,11 @@ func (loc *VolumeSnapshotLocator) Show(options rsapi.APIParams) (*VolumeSnapshot
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -13998,13 +21563,13 @@ func (loc *VolumeSnapshotLocator) Show(options rsapi.APIParams) (*VolumeSnapshot
 // A VolumeType describes the type of volume, particularly the size.
 type VolumeType struct {
 	Actions     []map[string]string `json:"actions,omitempty"`
-	CreatedAt   *RubyTime           `json:"created_at,omitempty"`
+	CreatedAt   *rsapi.Time         `json:"created_at,omitempty"`
 	Description string              `json:"description,omitempty"`
 	Links       []map[string]string `json:"links,omitempty"`
 	Name        string              `json:"name,omitempty"`
 	ResourceUid string              `json:"resource_uid,omitempty"`
 	Size        string              `json:"size,omitempty"`
-	UpdatedAt   *RubyTime           `json:"updated_at,omitempty"`
+	UpdatedAt   *rsapi.Time         `json:"updated_at,omitempty"`
 }
 
 // Locator returns a locator for the given resource
@@ -14017,6 +21582,17 @@ func (r *VolumeType) Locator(api *API) *VolumeTypeLocator {
 	return nil
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *VolumeType) CloudLocator(api *API) *CloudLocator {
+	for _, l := range r.Links {
+		if l["rel"] == "cloud" {
+			return api.CloudLocator(l["href"])
+		}
+	}
+	return nil
+}
+
 //===== Locator
 
 // VolumeTypeLocator exposes the VolumeType resource actions.
@@ -14030,27 +21606,74 @@ func (api *API) VolumeTypeLocator(href string) *VolumeTypeLocator {
 	return &VolumeTypeLocator{Href(href), api}
 }
 
+// VolumeTypeLocatorInterface lists the VolumeTypeLocator methods, code that depends on the interface
+// rather than on VolumeTypeLocator can be unit tested using the generated fake locators.
+type VolumeTypeLocatorInterface interface {
+	Index(options *VolumeTypeIndexOptions) ([]*VolumeType, error)
+	IndexEach(options *VolumeTypeIndexOptions, fn func(*VolumeType) error) error
+	Show(options *VolumeTypeShowOptions) (*VolumeType, error)
+}
+
 //===== Actions
 
+// VolumeTypeIndexOptions contains the optional parameters of VolumeTypeLocator.Index.
+type VolumeTypeIndexOptions struct {
+	Filter []string
+	View   string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *VolumeTypeIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of VolumeTypeLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/volume_types
 //
 // Lists Volume Types.
 // Optional parameters:
 // filter
 // view
-func (loc *VolumeTypeLocator) Index(options rsapi.APIParams) ([]*VolumeType, error) {
+func (loc *VolumeTypeLocator) Index(options *VolumeTypeIndexOptions) ([]*VolumeType, error) {
 	var res []*VolumeType
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("VolumeType", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("VolumeType", "index")
 	if err != nil {
 		return res, err
@@ -14063,22 +21686,87 @@ func (loc *VolumeTypeLocator) Index(options rsapi.APIParams) ([]*VolumeType, err
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *VolumeTypeLocator) IndexEach(options *VolumeTypeIndexOptions, fn func(*VolumeType) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("VolumeType", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("VolumeType", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *VolumeType
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// VolumeTypeShowOptions contains the optional parameters of VolumeTypeLocator.Show.
+type VolumeTypeShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *VolumeTypeShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of VolumeTypeLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/clouds/:cloud_id/volume_types/:id
@@ -14086,15 +21774,22 @@ func (loc *VolumeTypeLocator) Index(options rsapi.APIParams) ([]*VolumeType, err
 // Displays information about a single Volume Type.
 // Optional parameters:
 // view
-func (loc *VolumeTypeLocator) Show(options rsapi.APIParams) (*VolumeType, error) {
+func (loc *VolumeTypeLocator) Show(options *VolumeTypeShowOptions) (*VolumeType, error) {
 	var res *VolumeType
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("VolumeType", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("VolumeType", "show")
 	if err != nil {
 		return res, err
@@ -14107,21 +21802,11 @@ func (loc *VolumeTypeLocator) Show(options rsapi.APIParams) (*VolumeType, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -478,21 +478,21 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "alert_spec[threshold]",
-						Description: `The threshold of the condition sentence.`,
+						Name:        "alert_spec[condition]",
+						Description: `The condition (operator) in the condition sentence.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    true,
+						ValidValues: []string{">", ">=", "<", "<=", "==", "!="},
 					},
 					&metadata.ActionParam{
-						Name:        "alert_spec[condition]",
-						Description: `The condition (operator) in the condition sentence.`,
+						Name:        "alert_spec[threshold]",
+						Description: `The threshold of the condition sentence.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    true,
-						ValidValues: []string{">", ">=", "<", "<=", "==", "!="},
 					},
 					&metadata.ActionParam{
 						Name:        "alert_spec[vote_type]",
@@ -504,16 +504,16 @@ Required parameters:
 						ValidValues: []string{"grow", "shrink"},
 					},
 					&metadata.ActionParam{
-						Name:        "alert_spec[variable]",
-						Description: `The RRD variable of the condition sentence.`,
+						Name:        "alert_spec[duration]",
+						Description: `The duration in minutes of the condition sentence.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "alert_spec[duration]",
-						Description: `The duration in minutes of the condition sentence.`,
+						Name:        "alert_spec[variable]",
+						Description: `The RRD variable of the condition sentence.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
@@ -528,16 +528,16 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "alert_spec[name]",
-						Description: `The name of the AlertSpec.`,
+						Name:        "alert_spec[file]",
+						Description: `The RRD path/file_name of the condition sentence.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "alert_spec[file]",
-						Description: `The RRD path/file_name of the condition sentence.`,
+						Name:        "alert_spec[name]",
+						Description: `The name of the AlertSpec.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
@@ -785,6 +785,15 @@ Required parameters:
 						Mandatory:   false,
 						NonBlank:    true,
 					},
+					&metadata.ActionParam{
+						Name:        "alert_spec[condition]",
+						Description: `The condition (operator) in the condition sentence.`,
+						Type:        "string",
+						Location:    metadata.PayloadParam,
+						Mandatory:   false,
+						NonBlank:    true,
+						ValidValues: []string{">", ">=", "<", "<=", "==", "!="},
+					},
 					&metadata.ActionParam{
 						Name:        "alert_spec[threshold]",
 						Description: `The threshold of the condition sentence.`,
@@ -803,13 +812,12 @@ Required parameters:
 						ValidValues: []string{"grow", "shrink"},
 					},
 					&metadata.ActionParam{
-						Name:        "alert_spec[condition]",
-						Description: `The condition (operator) in the condition sentence.`,
+						Name:        "alert_spec[duration]",
+						Description: `The duration in minutes of the condition sentence.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
-						ValidValues: []string{">", ">=", "<", "<=", "==", "!="},
 					},
 					&metadata.ActionParam{
 						Name:        "alert_spec[variable]",
@@ -819,14 +827,6 @@ Required parameters:
 						Mandatory:   false,
 						NonBlank:    true,
 					},
-					&metadata.ActionParam{
-						Name:        "alert_spec[duration]",
-						Description: `The duration in minutes of the condition sentence.`,
-						Type:        "string",
-						Location:    metadata.PayloadParam,
-						Mandatory:   false,
-						NonBlank:    true,
-					},
 					&metadata.ActionParam{
 						Name:        "alert_spec[vote_tag]",
 						Description: `Should correspond to a vote tag on a ServerArray if vote to grow or shrink.`,
@@ -1087,7 +1087,7 @@ Optional parameters:
 					&metadata.ActionParam{
 						Name:        "start_date",
 						Description: `The start date for retrieving audit entries, the format must be YYYY/MM/DD HH:MM:SS [+/-]ZZZZ e.g., 2011/06/25 00:00:00 +0000`,
-						Type:        "string",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    true,
@@ -1095,7 +1095,7 @@ Optional parameters:
 					&metadata.ActionParam{
 						Name:        "end_date",
 						Description: `The end date for retrieving audit entries (the format must be the same as start date). The time period between start and end date must be less than 3 months (93 days).`,
-						Type:        "string",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    true,
@@ -1131,7 +1131,7 @@ Optional parameters:
 					&metadata.ActionParam{
 						Name:        "end_date",
 						Description: `The end date for retrieving audit entries (the format must be the same as start date). The time period between start and end date must be less than 3 months (93 days).`,
-						Type:        "string",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    true,
@@ -1156,7 +1156,7 @@ Optional parameters:
 					&metadata.ActionParam{
 						Name:        "start_date",
 						Description: `The start date for retrieving audit entries, the format must be YYYY/MM/DD HH:MM:SS [+/-]ZZZZ e.g., 2011/06/25 00:00:00 +0000`,
-						Type:        "string",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    true,
@@ -3641,20 +3641,20 @@ Optional parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "instance[cloud_specific_attributes][root_volume_type_uid]",
-						Description: `The type of root volume for instance. Only available on clouds supporting root volume type.`,
+						Name:        "instance[cloud_specific_attributes][iam_instance_profile]",
+						Description: `The name or ARN of the IAM Instance Profile (IIP) to associate with the instance (Amazon only)`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
-						NonBlank:    true,
+						NonBlank:    false,
 					},
 					&metadata.ActionParam{
-						Name:        "instance[cloud_specific_attributes][iam_instance_profile]",
-						Description: `The name or ARN of the IAM Instance Profile (IIP) to associate with the instance (Amazon only)`,
+						Name:        "instance[cloud_specific_attributes][root_volume_type_uid]",
+						Description: `The type of root volume for instance. Only available on clouds supporting root volume type.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
-						NonBlank:    false,
+						NonBlank:    true,
 					},
 					&metadata.ActionParam{
 						Name:        "instance[cloud_specific_attributes][root_volume_size]",
@@ -3707,17 +3707,17 @@ Optional parameters:
 						ValidValues: []string{"true", "false"},
 					},
 					&metadata.ActionParam{
-						Name:        "instance[security_group_hrefs][]",
-						Description: `The hrefs of the security groups.`,
-						Type:        "[]string",
+						Name:        "instance[placement_group_href]",
+						Description: `The placement group to launch the instance in. Not supported by all clouds & instance types.`,
+						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "instance[placement_group_href]",
-						Description: `The placement group to launch the instance in. Not supported by all clouds & instance types.`,
-						Type:        "string",
+						Name:        "instance[security_group_hrefs][]",
+						Description: `The hrefs of the security groups.`,
+						Type:        "[]string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
@@ -4549,20 +4549,20 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "instance[cloud_specific_attributes][root_volume_type_uid]",
-						Description: `The type of root volume for instance. Only available on clouds supporting root volume type.`,
+						Name:        "instance[cloud_specific_attributes][iam_instance_profile]",
+						Description: `The name or ARN of the IAM Instance Profile (IIP) to associate with the instance (Amazon only)`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
-						NonBlank:    true,
+						NonBlank:    false,
 					},
 					&metadata.ActionParam{
-						Name:        "instance[cloud_specific_attributes][iam_instance_profile]",
-						Description: `The name or ARN of the IAM Instance Profile (IIP) to associate with the instance (Amazon only)`,
+						Name:        "instance[cloud_specific_attributes][root_volume_type_uid]",
+						Description: `The type of root volume for instance. Only available on clouds supporting root volume type.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
-						NonBlank:    false,
+						NonBlank:    true,
 					},
 					&metadata.ActionParam{
 						Name:        "instance[cloud_specific_attributes][root_volume_size]",
@@ -4622,14 +4622,6 @@ Required parameters:
 						NonBlank:    true,
 						ValidValues: []string{"true", "false"},
 					},
-					&metadata.ActionParam{
-						Name:        "instance[server_template_href]",
-						Description: `The href of the updated ServerTemplate for the Instance.`,
-						Type:        "string",
-						Location:    metadata.PayloadParam,
-						Mandatory:   false,
-						NonBlank:    true,
-					},
 					&metadata.ActionParam{
 						Name:        "instance[security_group_hrefs][]",
 						Description: `The hrefs of the updated security groups.`,
@@ -4639,8 +4631,8 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "instance[ramdisk_image_href]",
-						Description: `The href of the updated ramdisk image for the Instance.`,
+						Name:        "instance[server_template_href]",
+						Description: `The href of the updated ServerTemplate for the Instance.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
@@ -4654,6 +4646,14 @@ Required parameters:
 						Mandatory:   false,
 						NonBlank:    true,
 					},
+					&metadata.ActionParam{
+						Name:        "instance[ramdisk_image_href]",
+						Description: `The href of the updated ramdisk image for the Instance.`,
+						Type:        "string",
+						Location:    metadata.PayloadParam,
+						Mandatory:   false,
+						NonBlank:    true,
+					},
 					&metadata.ActionParam{
 						Name:        "instance[kernel_image_href]",
 						Description: `The href of the updated kernel image for the Instance.`,
@@ -4679,17 +4679,17 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "instance[subnet_hrefs][]",
-						Description: `The hrefs of the updated subnets.`,
-						Type:        "[]string",
+						Name:        "instance[ssh_key_href]",
+						Description: `The href of the updated SSH key for the Instance.`,
+						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "instance[ssh_key_href]",
-						Description: `The href of the updated SSH key for the Instance.`,
-						Type:        "string",
+						Name:        "instance[subnet_hrefs][]",
+						Description: `The hrefs of the updated subnets.`,
+						Type:        "[]string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
@@ -8079,15 +8079,6 @@ Required parameters:
 						Mandatory:   false,
 						NonBlank:    false,
 					},
-					&metadata.ActionParam{
-						Name:        "repository[source_type]",
-						Description: `The source type for the repository.`,
-						Type:        "string",
-						Location:    metadata.PayloadParam,
-						Mandatory:   true,
-						NonBlank:    true,
-						ValidValues: []string{"git", "svn", "download"},
-					},
 					&metadata.ActionParam{
 						Name:        "repository[auto_import]",
 						Description: `Whether cookbooks should automatically be imported upon repository creation.`,
@@ -8105,6 +8096,15 @@ Required parameters:
 						Mandatory:   false,
 						NonBlank:    false,
 					},
+					&metadata.ActionParam{
+						Name:        "repository[source_type]",
+						Description: `The source type for the repository.`,
+						Type:        "string",
+						Location:    metadata.PayloadParam,
+						Mandatory:   true,
+						NonBlank:    true,
+						ValidValues: []string{"git", "svn", "download"},
+					},
 					&metadata.ActionParam{
 						Name:        "repository[source]",
 						Description: `The URL for the repository.`,
@@ -8384,21 +8384,21 @@ Required parameters:
 						NonBlank:    false,
 					},
 					&metadata.ActionParam{
-						Name:        "repository[source_type]",
-						Description: `The updated source type for the repository.`,
+						Name:        "repository[description]",
+						Description: `The updated description for the repository.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
-						NonBlank:    true,
-						ValidValues: []string{"git", "svn", "download"},
+						NonBlank:    false,
 					},
 					&metadata.ActionParam{
-						Name:        "repository[description]",
-						Description: `The updated description for the repository.`,
+						Name:        "repository[source_type]",
+						Description: `The updated source type for the repository.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
-						NonBlank:    false,
+						NonBlank:    true,
+						ValidValues: []string{"git", "svn", "download"},
 					},
 					&metadata.ActionParam{
 						Name:        "repository[source]",
@@ -9457,14 +9457,6 @@ Optional parameters:
 						Mandatory:   false,
 						NonBlank:    false,
 					},
-					&metadata.ActionParam{
-						Name:        "recipe_id",
-						Description: `ServerTemplateChefRecipe ID`,
-						Type:        "int",
-						Location:    metadata.PayloadParam,
-						Mandatory:   false,
-						NonBlank:    true,
-					},
 					&metadata.ActionParam{
 						Name:        "arguments",
 						Description: `Serialized recipe execution arguments values keyed by name`,
@@ -9473,6 +9465,14 @@ Optional parameters:
 						Mandatory:   false,
 						NonBlank:    false,
 					},
+					&metadata.ActionParam{
+						Name:        "recipe_id",
+						Description: `ServerTemplateChefRecipe ID`,
+						Type:        "int",
+						Location:    metadata.PayloadParam,
+						Mandatory:   false,
+						NonBlank:    true,
+					},
 					&metadata.ActionParam{
 						Name:        "audit_id",
 						Description: `Optional, reuse audit if specified`,
@@ -9932,16 +9932,16 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "security_group_rule[protocol_details][icmp_type]",
-						Description: `ICMP type. Required if protocol is 'icmp'.`,
+						Name:        "security_group_rule[protocol_details][icmp_code]",
+						Description: `ICMP code. Required if protocol is 'icmp'.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "security_group_rule[protocol_details][icmp_code]",
-						Description: `ICMP code. Required if protocol is 'icmp'.`,
+						Name:        "security_group_rule[protocol_details][icmp_type]",
+						Description: `ICMP type. Required if protocol is 'icmp'.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
@@ -9964,21 +9964,21 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "security_group_rule[source_type]",
-						Description: `Source type. May be a CIDR block or another Security Group.`,
+						Name:        "security_group_rule[group_owner]",
+						Description: `Owner of source Security Group. Required if source_type is 'group'.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
-						Mandatory:   true,
+						Mandatory:   false,
 						NonBlank:    true,
-						ValidValues: []string{"cidr_ips", "group"},
 					},
 					&metadata.ActionParam{
-						Name:        "security_group_rule[group_owner]",
-						Description: `Owner of source Security Group. Required if source_type is 'group'.`,
+						Name:        "security_group_rule[source_type]",
+						Description: `Source type. May be a CIDR block or another Security Group.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
-						Mandatory:   false,
+						Mandatory:   true,
 						NonBlank:    true,
+						ValidValues: []string{"cidr_ips", "group"},
 					},
 					&metadata.ActionParam{
 						Name:        "security_group_rule[group_name]",
@@ -9998,21 +9998,21 @@ Required parameters:
 						ValidValues: []string{"ingress", "egress"},
 					},
 					&metadata.ActionParam{
-						Name:        "security_group_rule[protocol]",
-						Description: `Protocol to filter on.`,
+						Name:        "security_group_rule[cidr_ips]",
+						Description: `An IP address range in CIDR notation. Required if source_type is 'cidr_ips'.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
-						Mandatory:   true,
+						Mandatory:   false,
 						NonBlank:    true,
-						ValidValues: []string{"tcp", "udp", "icmp", "all"},
 					},
 					&metadata.ActionParam{
-						Name:        "security_group_rule[cidr_ips]",
-						Description: `An IP address range in CIDR notation. Required if source_type is 'cidr_ips'.`,
+						Name:        "security_group_rule[protocol]",
+						Description: `Protocol to filter on.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
-						Mandatory:   false,
+						Mandatory:   true,
 						NonBlank:    true,
+						ValidValues: []string{"tcp", "udp", "icmp", "all"},
 					},
 				},
 				APIParams: []*metadata.ActionParam{
@@ -10240,20 +10240,20 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server[instance][cloud_specific_attributes][root_volume_type_uid]",
-						Description: `The type of root volume for instance. Only available on clouds supporting root volume type.`,
+						Name:        "server[instance][cloud_specific_attributes][iam_instance_profile]",
+						Description: `The name or ARN of the IAM Instance Profile (IIP) to associate with the instance (Amazon only)`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
-						NonBlank:    true,
+						NonBlank:    false,
 					},
 					&metadata.ActionParam{
-						Name:        "server[instance][cloud_specific_attributes][iam_instance_profile]",
-						Description: `The name or ARN of the IAM Instance Profile (IIP) to associate with the instance (Amazon only)`,
+						Name:        "server[instance][cloud_specific_attributes][root_volume_type_uid]",
+						Description: `The type of root volume for instance. Only available on clouds supporting root volume type.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
-						NonBlank:    false,
+						NonBlank:    true,
 					},
 					&metadata.ActionParam{
 						Name:        "server[instance][cloud_specific_attributes][root_volume_size]",
@@ -10264,16 +10264,16 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server[instance][cloud_specific_attributes][num_cores]",
-						Description: `The number of CPU cores. Only available on UCA clouds.`,
+						Name:        "server[instance][cloud_specific_attributes][memory_mb]",
+						Description: `The memory size in MB. Only available on UCA clouds.`,
 						Type:        "int",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
 					},
 					&metadata.ActionParam{
-						Name:        "server[instance][cloud_specific_attributes][memory_mb]",
-						Description: `The memory size in MB. Only available on UCA clouds.`,
+						Name:        "server[instance][cloud_specific_attributes][num_cores]",
+						Description: `The number of CPU cores. Only available on UCA clouds.`,
 						Type:        "int",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
@@ -10314,17 +10314,17 @@ Required parameters:
 						ValidValues: []string{"true", "false"},
 					},
 					&metadata.ActionParam{
-						Name:        "server[instance][security_group_hrefs][]",
-						Description: `The hrefs of the security groups.`,
-						Type:        "[]string",
+						Name:        "server[instance][placement_group_href]",
+						Description: `The href of the Placement Group.`,
+						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server[instance][placement_group_href]",
-						Description: `The href of the Placement Group.`,
-						Type:        "string",
+						Name:        "server[instance][security_group_hrefs][]",
+						Description: `The hrefs of the security groups.`,
+						Type:        "[]string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
@@ -10393,14 +10393,6 @@ Required parameters:
 						Mandatory:   false,
 						NonBlank:    true,
 					},
-					&metadata.ActionParam{
-						Name:        "server[instance][subnet_hrefs][]",
-						Description: `The hrefs of the updated subnets.`,
-						Type:        "[]string",
-						Location:    metadata.PayloadParam,
-						Mandatory:   false,
-						NonBlank:    true,
-					},
 					&metadata.ActionParam{
 						Name:        "server[instance][ssh_key_href]",
 						Description: `The href of the SSH key to use.`,
@@ -10410,9 +10402,9 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server[instance][image_href]",
-						Description: `The href of the Image to use.`,
-						Type:        "string",
+						Name:        "server[instance][subnet_hrefs][]",
+						Description: `The hrefs of the updated subnets.`,
+						Type:        "[]string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
@@ -10425,6 +10417,14 @@ Required parameters:
 						Mandatory:   true,
 						NonBlank:    true,
 					},
+					&metadata.ActionParam{
+						Name:        "server[instance][image_href]",
+						Description: `The href of the Image to use.`,
+						Type:        "string",
+						Location:    metadata.PayloadParam,
+						Mandatory:   false,
+						NonBlank:    true,
+					},
 					&metadata.ActionParam{
 						Name:        "server[instance][user_data]",
 						Description: `User data that RightScale automatically passes to your instance at boot time.`,
@@ -10916,16 +10916,16 @@ Required parameters:
 						ValidValues: []string{"max_10", "avg_10"},
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[instance][cloud_specific_attributes][root_volume_performance]",
-						Description: `The number of IOPS (I/O Operations Per Second) this root volume should support. Only available on clouds supporting performance provisioning.`,
+						Name:        "server_array[elasticity_params][alert_specific_params][decision_threshold]",
+						Description: `The percentage of servers that must agree in order to trigger an alert before an action is taken.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[elasticity_params][alert_specific_params][decision_threshold]",
-						Description: `The percentage of servers that must agree in order to trigger an alert before an action is taken.`,
+						Name:        "server_array[instance][cloud_specific_attributes][root_volume_performance]",
+						Description: `The number of IOPS (I/O Operations Per Second) this root volume should support. Only available on clouds supporting performance provisioning.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
@@ -10948,20 +10948,20 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[instance][cloud_specific_attributes][root_volume_type_uid]",
-						Description: `The type of root volume for instance. Only available on clouds supporting root volume type.`,
+						Name:        "server_array[instance][cloud_specific_attributes][iam_instance_profile]",
+						Description: `The name or ARN of the IAM Instance Profile (IIP) to associate with the instance (Amazon only)`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
-						NonBlank:    true,
+						NonBlank:    false,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[instance][cloud_specific_attributes][iam_instance_profile]",
-						Description: `The name or ARN of the IAM Instance Profile (IIP) to associate with the instance (Amazon only)`,
+						Name:        "server_array[instance][cloud_specific_attributes][root_volume_type_uid]",
+						Description: `The type of root volume for instance. Only available on clouds supporting root volume type.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
-						NonBlank:    false,
+						NonBlank:    true,
 					},
 					&metadata.ActionParam{
 						Name:        "server_array[instance][cloud_specific_attributes][root_volume_size]",
@@ -11021,27 +11021,27 @@ Required parameters:
 						ValidValues: []string{"true", "false"},
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[elasticity_params][bounds][max_count]",
-						Description: `The maximum number of servers that can be operational at the same time in the server array.`,
+						Name:        "server_array[datacenter_policy][][datacenter_href]",
+						Description: `The href of the Datacenter / Zone.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
-						Mandatory:   false,
+						Mandatory:   true,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[elasticity_params][bounds][min_count]",
-						Description: `The minimum number of servers that must be operational at all times in the server array.`,
+						Name:        "server_array[elasticity_params][bounds][max_count]",
+						Description: `The maximum number of servers that can be operational at the same time in the server array.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[datacenter_policy][][datacenter_href]",
-						Description: `The href of the Datacenter / Zone.`,
+						Name:        "server_array[elasticity_params][bounds][min_count]",
+						Description: `The minimum number of servers that must be operational at all times in the server array.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
-						Mandatory:   true,
+						Mandatory:   false,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
@@ -11078,6 +11078,14 @@ Required parameters:
 						NonBlank:    true,
 						ValidValues: []string{"true", "false"},
 					},
+					&metadata.ActionParam{
+						Name:        "server_array[instance][placement_group_href]",
+						Description: `The href of the Placement Group.`,
+						Type:        "string",
+						Location:    metadata.PayloadParam,
+						Mandatory:   false,
+						NonBlank:    true,
+					},
 					&metadata.ActionParam{
 						Name:        "server_array[instance][security_group_hrefs][]",
 						Description: `The hrefs of the Security Groups.`,
@@ -11095,8 +11103,8 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[instance][placement_group_href]",
-						Description: `The href of the Placement Group.`,
+						Name:        "server_array[instance][instance_type_href]",
+						Description: `The href of the Instance Type.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
@@ -11110,14 +11118,6 @@ Required parameters:
 						Mandatory:   false,
 						NonBlank:    true,
 					},
-					&metadata.ActionParam{
-						Name:        "server_array[instance][instance_type_href]",
-						Description: `The href of the Instance Type.`,
-						Type:        "string",
-						Location:    metadata.PayloadParam,
-						Mandatory:   false,
-						NonBlank:    true,
-					},
 					&metadata.ActionParam{
 						Name:        "server_array[datacenter_policy][][weight]",
 						Description: `Instance allocation (should total 100%).`,
@@ -11135,25 +11135,25 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[instance][inputs][][value]",
-						Description: `The value of that Input. Should be of the form 'text:my_value' or 'cred:MY_CRED' etc.`,
+						Name:        "server_array[instance][datacenter_href]",
+						Description: `The href of the Datacenter / Zone. For multiple Datacenters, use 'datacenter_policy' instead.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[instance][inputs]",
-						Description: ``,
-						Type:        "map",
+						Name:        "server_array[instance][inputs][][value]",
+						Description: `The value of that Input. Should be of the form 'text:my_value' or 'cred:MY_CRED' etc.`,
+						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[instance][datacenter_href]",
-						Description: `The href of the Datacenter / Zone. For multiple Datacenters, use 'datacenter_policy' instead.`,
-						Type:        "string",
+						Name:        "server_array[instance][inputs]",
+						Description: ``,
+						Type:        "map",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
@@ -11569,16 +11569,16 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[elasticity_params][schedule][][min_count]",
-						Description: `The updated minimum number of servers that must be operational at all times in the server array. NOTE: Any changes that are made to the min/max count in the server array schedule will overwrite the array's default min/max count settings.`,
+						Name:        "server_array[elasticity_params][schedule][][max_count]",
+						Description: `The updated maximum number of servers that must be operational at all times in the server array. NOTE: Any changes that are made to the min/max count in the server array schedule will overwrite the array's default min/max count settings.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[elasticity_params][schedule][][max_count]",
-						Description: `The updated maximum number of servers that must be operational at all times in the server array. NOTE: Any changes that are made to the min/max count in the server array schedule will overwrite the array's default min/max count settings.`,
+						Name:        "server_array[elasticity_params][schedule][][min_count]",
+						Description: `The updated minimum number of servers that must be operational at all times in the server array. NOTE: Any changes that are made to the min/max count in the server array schedule will overwrite the array's default min/max count settings.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
@@ -11593,11 +11593,11 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[elasticity_params][bounds][min_count]",
-						Description: `The updated minimum number of servers that must be operational at all times in the server array.`,
+						Name:        "server_array[datacenter_policy][][datacenter_href]",
+						Description: `The href of the Datacenter / Zone.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
-						Mandatory:   false,
+						Mandatory:   true,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
@@ -11609,11 +11609,11 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "server_array[datacenter_policy][][datacenter_href]",
-						Description: `The href of the Datacenter / Zone.`,
+						Name:        "server_array[elasticity_params][bounds][min_count]",
+						Description: `The updated minimum number of servers that must be operational at all times in the server array.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
-						Mandatory:   true,
+						Mandatory:   false,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
@@ -12000,16 +12000,16 @@ Optional parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "descriptions[short]",
-						Description: `Short Description.`,
+						Name:        "descriptions[notes]",
+						Description: `New Revision Notes.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "descriptions[notes]",
-						Description: `New Revision Notes.`,
+						Name:        "descriptions[short]",
+						Description: `Short Description.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
@@ -13477,19 +13477,19 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "user[principal_uid]",
-						Description: `The updated principal identifier (SAML NameID or OpenID identity URL) of this user.`,
+						Name:        "user[current_email]",
+						Description: `The existing email of this user.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
-						Mandatory:   false,
+						Mandatory:   true,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "user[current_email]",
-						Description: `The existing email of this user.`,
+						Name:        "user[principal_uid]",
+						Description: `The updated principal identifier (SAML NameID or OpenID identity URL) of this user.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
-						Mandatory:   true,
+						Mandatory:   false,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
@@ -13625,16 +13625,16 @@ Required parameters:
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "volume[deployment_href]",
-						Description: `The href of the Deployment that owns this Volume.`,
+						Name:        "volume[datacenter_href]",
+						Description: `The href of the Datacenter / Zone that the Volume will be in. This parameter is required for non-OpenStack clouds.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    true,
 					},
 					&metadata.ActionParam{
-						Name:        "volume[datacenter_href]",
-						Description: `The href of the Datacenter / Zone that the Volume will be in. This parameter is required for non-OpenStack clouds.`,
+						Name:        "volume[deployment_href]",
+						Description: `The href of the Deployment that owns this Volume.`,
 						Type:        "string",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
@@ -71,12 +71,12 @@ func main() {
 // Make an API call and fetch the audit entries matching specified criteria
 func fetchAuditEntries(client *cm15.API, filterEmail string) ([]*cm15.AuditEntry, error) {
 	auditLocator := client.AuditEntryLocator("/api/audit_entries")
-	var apiParams = rsapi.APIParams{"filter": []string{"user_email==" + filterEmail}}
+	var options = cm15.AuditEntryIndexOptions{Filter: []string{"user_email==" + filterEmail}}
 	auditEntries, err := auditLocator.Index(
 		tomorrow(),  // End date
 		"100",       // Limit
 		yesterday(), // Start date
-		apiParams,
+		&options,
 	)
 	if err != nil {
 		return auditEntries, err
@@ -84,20 +84,20 @@ func fetchAuditEntries(client *cm15.API, filterEmail string) ([]*cm15.AuditEntry
 	return auditEntries, nil
 }
 
-// Returns tommorrow's date in the format used by RightScale API
-func tomorrow() string {
-	return formatTime(time.Now().UTC().AddDate(0, 0, 1))
+// Returns tommorrow's date
+func tomorrow() *rsapi.Time {
+	return day(1)
 }
 
-// Returns yesterday's date in the format used by RightScale API
-func yesterday() string {
-	return formatTime(time.Now().UTC().AddDate(0, 0, -1))
+// Returns yesterday's date
+func yesterday() *rsapi.Time {
+	return day(-1)
 }
 
-// Returns time in RightScale API supported format
-func formatTime(tm time.Time) string {
-	year, month, date := tm.Date()
-	return time.Date(year, month, date, 0, 0, 0, 0, time.UTC).Format("2006/01/02 15:04:05 -0700")
+// Returns the date of the day n days from today at midnight UTC
+func day(n int) *rsapi.Time {
+	year, month, date := time.Now().UTC().AddDate(0, 0, n).Date()
+	return rsapi.NewTime(time.Date(year, month, date, 0, 0, 0, 0, time.UTC))
 }
 
 // Prints the audit entries to console
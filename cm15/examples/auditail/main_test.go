@@ -0,0 +1,65 @@
+package main
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rightscale/rsc/cm15"
+	"github.com/rightscale/rsc/httpclient"
+	"github.com/rightscale/rsc/rsapi"
+	"github.com/rightscale/rsc/rsctest"
+
+	"testing"
+)
+
+func TestAuditailExample(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Auditail Example Suite")
+}
+
+var _ = Describe("Auditail Example", func() {
+	var (
+		fake   *rsctest.Server
+		client *cm15.API
+	)
+
+	BeforeEach(func() {
+		fake = rsctest.NewServer()
+		server := fake.AddServer("web", "")
+		fake.AddAuditEntry(server, "launching", "alice@example.com")
+		fake.AddAuditEntry(server, "operational", "alice@example.com")
+		fake.AddAuditEntry(server, "terminating", "bob@example.com")
+		httpclient.Insecure = true
+		client = cm15.New(fake.Host(), rsapi.NewBasicAuthenticator(fake.Email, fake.Password, 1))
+	})
+
+	AfterEach(func() {
+		httpclient.Insecure = false
+		fake.Close()
+	})
+
+	It("fetches the audit entries of the given user", func() {
+		entries, err := fetchAuditEntries(client, "alice@example.com")
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(entries).Should(HaveLen(2))
+		Ω(entries[0].Summary).Should(Equal("launching"))
+		Ω(entries[1].Summary).Should(Equal("operational"))
+	})
+
+	It("extracts new entries", func() {
+		old, err := fetchAuditEntries(client, "bob@example.com")
+		Ω(err).ShouldNot(HaveOccurred())
+		fake.AddAuditEntry(fake.AddDeployment("prod"), "deployment created", "bob@example.com")
+		entries, err := fetchAuditEntries(client, "bob@example.com")
+		Ω(err).ShouldNot(HaveOccurred())
+		unique := extractUnique(old, entries)
+		Ω(unique).Should(HaveLen(1))
+		Ω(unique[0].Summary).Should(Equal("deployment created"))
+	})
+
+	It("reports API errors", func() {
+		fake.Fail(rsctest.Failure{Path: "^/api/audit_entries$", Status: 500, Body: "boom"})
+		_, err := fetchAuditEntries(client, "alice@example.com")
+		Ω(err).Should(HaveOccurred())
+		Ω(err.Error()).Should(ContainSubstring("boom"))
+	})
+})
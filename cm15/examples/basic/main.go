@@ -49,14 +49,22 @@ func main() {
 		fail("invalid credentials: %s", err)
 	}
 
-	// 3. Make cloud index call using extended view
-	l := client.CloudLocator("/api/clouds")
-	clouds, err := l.Index(rsapi.APIParams{"view": "extended"})
-	if err != nil {
+	// 3. List clouds and their capabilities
+	if err := listClouds(client.CloudLocator("/api/clouds")); err != nil {
 		fail("failed to list clouds: %s", err)
 	}
+}
+
+// listClouds prints the capabilities of the clouds returned by the given locator. It accepts a
+// locator interface rather than a *cm15.CloudLocator so that it can be tested using a fake.
+func listClouds(l cm15.CloudLocatorInterface) error {
+	// Make cloud index call using extended view
+	clouds, err := l.Index(&cm15.CloudIndexOptions{View: "extended"})
+	if err != nil {
+		return err
+	}
 
-	// 4. Print cloud capabilities
+	// Print cloud capabilities
 	for i, c := range clouds {
 		fmt.Fprintln(osStdout, "")
 		fmt.Fprintf(osStdout, "%d. %s\n", i+1, c.Name)
@@ -64,6 +72,7 @@ func main() {
 			fmt.Fprintf(osStdout, "%s: %v\n", ca["name"], ca["value"])
 		}
 	}
+	return nil
 }
 
 // Print error message and exit with code 1
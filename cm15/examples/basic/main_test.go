@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httputil"
@@ -10,6 +11,8 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
+	"github.com/rightscale/rsc/cm15"
+	"github.com/rightscale/rsc/cm15/cm15fake"
 
 	"testing"
 )
@@ -62,6 +65,29 @@ var _ = Describe("Basic Example", func() {
 		Ω(out.String()).Should(Equal(output))
 	})
 
+	Context("using a fake locator", func() {
+		var fake *cm15fake.CloudLocator
+
+		BeforeEach(func() {
+			out.Reset()
+			fake = &cm15fake.CloudLocator{}
+		})
+
+		It("lists clouds", func() {
+			var clouds []*cm15.Cloud
+			Ω(json.Unmarshal([]byte(responseBody), &clouds)).Should(Succeed())
+			fake.IndexReturns(clouds, nil)
+			Ω(listClouds(fake)).Should(Succeed())
+			Ω(out.String()).Should(Equal(output))
+			Ω(fake.IndexCalls).Should(HaveLen(1))
+			Ω(fake.IndexCalls[0].Options.View).Should(Equal("extended"))
+		})
+
+		It("returns errors", func() {
+			fake.IndexReturns(nil, fmt.Errorf("boom"))
+			Ω(listClouds(fake)).Should(MatchError("boom"))
+		})
+	})
 })
 
 // Additional handler that can be used to debug requests
@@ -147,7 +147,7 @@ func buildAliases(sshConfig []SSHConfig, sshOptions, sshUser string) string {
 // Makes a GET call on the given server array and returns all its current instances.
 func serverArray(client *cm15.API, name string) []*cm15.Instance {
 	serverArrayLocator := client.ServerArrayLocator("/api/server_arrays")
-	serverArrays, err := serverArrayLocator.Index(rsapi.APIParams{"view": "default", "filter": []string{"name==" + name}})
+	serverArrays, err := serverArrayLocator.Index(&cm15.ServerArrayIndexOptions{View: "default", Filter: []string{"name==" + name}})
 	if err != nil {
 		fail("Failed to retrieve server array: %v\n", err.Error())
 	}
@@ -165,7 +165,7 @@ func serverArray(client *cm15.API, name string) []*cm15.Instance {
 		}
 	}
 	instanceLocator := client.InstanceLocator(instancesHref)
-	instances, err := instanceLocator.Index(rsapi.APIParams{})
+	instances, err := instanceLocator.Index(nil)
 	if err != nil {
 		fail("Failed to retrieve current instances of the server array: %v\n", err.Error())
 	}
@@ -178,7 +178,7 @@ func serverArray(client *cm15.API, name string) []*cm15.Instance {
 // Makes a GET call on the given server and returns the current instance of the server.
 func server(client *cm15.API, name string) *cm15.Instance {
 	serverLocator := client.ServerLocator("/api/servers")
-	servers, err := serverLocator.Index(rsapi.APIParams{"view": "instance_detail", "filter": []string{"name==" + name}})
+	servers, err := serverLocator.Index(&cm15.ServerIndexOptions{View: "instance_detail", Filter: []string{"name==" + name}})
 	if err != nil {
 		fail("Failed to retrieve server: %v\n", err.Error())
 	}
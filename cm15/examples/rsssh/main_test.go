@@ -10,6 +10,10 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
+	"github.com/rightscale/rsc/cm15"
+	"github.com/rightscale/rsc/httpclient"
+	"github.com/rightscale/rsc/rsapi"
+	"github.com/rightscale/rsc/rsctest"
 
 	"testing"
 )
@@ -77,6 +81,41 @@ var _ = Describe("RSSSH Example", func() {
 		Ω(string(written)).Should(Equal(output))
 	})
 
+	Context("with a fake CM 1.5 server", func() {
+		var fake *rsctest.Server
+		var client *cm15.API
+
+		BeforeEach(func() {
+			fake = rsctest.NewServer()
+			array := fake.AddServerArray("Application Server", "")
+			for i := 0; i < 2; i++ {
+				_, err := fake.Launch(array)
+				Ω(err).ShouldNot(HaveOccurred())
+			}
+			_, err := fake.Launch(fake.AddServer("Loadbalancer", ""))
+			Ω(err).ShouldNot(HaveOccurred())
+			httpclient.Insecure = true
+			auth := rsapi.NewBasicAuthenticator(fake.Email, fake.Password, 12345)
+			client = cm15.New(fake.Host(), auth)
+		})
+
+		AfterEach(func() {
+			httpclient.Insecure = false
+			fake.Close()
+		})
+
+		It("builds aliases for servers and server arrays", func() {
+			env := EnvironmentDetail{
+				Account:      12345,
+				ServerArrays: map[string]string{"app": "Application Server"},
+				Servers:      map[string]string{"lb": "Loadbalancer"},
+			}
+			var sshConfig []SSHConfig
+			fetchDetails(client, "dev", env, &sshConfig)
+			aliases := buildAliases(sshConfig, "-i ~/.id_rsa_rightscale -o StrictHostKeyChecking=no", "rightscale")
+			Ω(aliases).Should(Equal(fakeOutput))
+		})
+	})
 })
 
 // Additional handler that can be used to debug requests
@@ -108,6 +147,11 @@ alias dev_app#3='ssh -i ~/.id_rsa_rightscale -o StrictHostKeyChecking=no rightsc
 alias dev_lb='ssh -i ~/.id_rsa_rightscale -o StrictHostKeyChecking=no rightscale@54.144.183.128'
 `
 
+const fakeOutput = `alias dev_app#1='ssh -i ~/.id_rsa_rightscale -o StrictHostKeyChecking=no rightscale@203.0.113.1'
+alias dev_app#2='ssh -i ~/.id_rsa_rightscale -o StrictHostKeyChecking=no rightscale@203.0.113.2'
+alias dev_lb='ssh -i ~/.id_rsa_rightscale -o StrictHostKeyChecking=no rightscale@203.0.113.3'
+`
+
 const serverArraysResponseBody = `[{
 	"actions": [
 		{"rel": "launch"},
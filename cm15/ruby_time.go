@@ -4,6 +4,9 @@ import "time"
 
 // RubyTime is a wrapper around time.Time that adds the ability to unmarshal ruby JSON date time
 // values.
+//
+// Deprecated: the date and time attributes of the generated resources are now *rsapi.Time which
+// unmarshals all the date formats used by the API, use rsapi.Time and rsapi.ParseTime instead.
 type RubyTime struct {
 	time.Time
 }
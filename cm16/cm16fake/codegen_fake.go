@@ -0,0 +1,1372 @@
+//************************************************************************//
+//                     RightScale API client fakes
+//
+// Generated with:
+// $ praxisgen -metadata=cm16/api_docs -output=cm16 -pkg=cm16 -target=1.6 -client=API
+//
+// The content of this file is auto-generated, DO NOT MODIFY
+//************************************************************************//
+
+// Package cm16fake provides fake implementations of the cm16 locator interfaces.
+// Each fake records the calls made to its methods and returns the values returned by the
+// corresponding stub functions if set, zero values otherwise.
+package cm16fake
+
+import (
+	"sync"
+
+	"github.com/rightscale/rsc/cm16"
+)
+
+/******  Account ******/
+
+// AccountLocator is a fake implementation of cm16.AccountLocatorInterface.
+type AccountLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.AccountIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*AccountIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.AccountShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*AccountShowCall
+}
+
+var _ cm16.AccountLocatorInterface = (*AccountLocator)(nil)
+
+// AccountIndexCall records the arguments of a call to AccountLocator.Index.
+type AccountIndexCall struct {
+	Options *cm16.AccountIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *AccountLocator) Index(options *cm16.AccountIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &AccountIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *AccountLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.AccountIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// AccountShowCall records the arguments of a call to AccountLocator.Show.
+type AccountShowCall struct {
+	Options *cm16.AccountShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *AccountLocator) Show(options *cm16.AccountShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &AccountShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *AccountLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.AccountShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Cloud ******/
+
+// CloudLocator is a fake implementation of cm16.CloudLocatorInterface.
+type CloudLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.CloudIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*CloudIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.CloudShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*CloudShowCall
+}
+
+var _ cm16.CloudLocatorInterface = (*CloudLocator)(nil)
+
+// CloudIndexCall records the arguments of a call to CloudLocator.Index.
+type CloudIndexCall struct {
+	Options *cm16.CloudIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *CloudLocator) Index(options *cm16.CloudIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &CloudIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *CloudLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.CloudIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// CloudShowCall records the arguments of a call to CloudLocator.Show.
+type CloudShowCall struct {
+	Options *cm16.CloudShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *CloudLocator) Show(options *cm16.CloudShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &CloudShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *CloudLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.CloudShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Datacenter ******/
+
+// DatacenterLocator is a fake implementation of cm16.DatacenterLocatorInterface.
+type DatacenterLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.DatacenterIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*DatacenterIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.DatacenterShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*DatacenterShowCall
+}
+
+var _ cm16.DatacenterLocatorInterface = (*DatacenterLocator)(nil)
+
+// DatacenterIndexCall records the arguments of a call to DatacenterLocator.Index.
+type DatacenterIndexCall struct {
+	Options *cm16.DatacenterIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *DatacenterLocator) Index(options *cm16.DatacenterIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &DatacenterIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *DatacenterLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.DatacenterIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// DatacenterShowCall records the arguments of a call to DatacenterLocator.Show.
+type DatacenterShowCall struct {
+	Options *cm16.DatacenterShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *DatacenterLocator) Show(options *cm16.DatacenterShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &DatacenterShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *DatacenterLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.DatacenterShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Deployment ******/
+
+// DeploymentLocator is a fake implementation of cm16.DeploymentLocatorInterface.
+type DeploymentLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.DeploymentIndexOptions) (*cm16.Deployment, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*DeploymentIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.DeploymentShowOptions) (*cm16.Deployment, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*DeploymentShowCall
+}
+
+var _ cm16.DeploymentLocatorInterface = (*DeploymentLocator)(nil)
+
+// DeploymentIndexCall records the arguments of a call to DeploymentLocator.Index.
+type DeploymentIndexCall struct {
+	Options *cm16.DeploymentIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *DeploymentLocator) Index(options *cm16.DeploymentIndexOptions) (*cm16.Deployment, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &DeploymentIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm16.Deployment
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *DeploymentLocator) IndexReturns(res *cm16.Deployment, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.DeploymentIndexOptions) (*cm16.Deployment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// DeploymentShowCall records the arguments of a call to DeploymentLocator.Show.
+type DeploymentShowCall struct {
+	Options *cm16.DeploymentShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *DeploymentLocator) Show(options *cm16.DeploymentShowOptions) (*cm16.Deployment, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &DeploymentShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm16.Deployment
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *DeploymentLocator) ShowReturns(res *cm16.Deployment, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.DeploymentShowOptions) (*cm16.Deployment, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Image ******/
+
+// ImageLocator is a fake implementation of cm16.ImageLocatorInterface.
+type ImageLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.ImageIndexOptions) (*cm16.Image, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ImageIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.ImageShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ImageShowCall
+}
+
+var _ cm16.ImageLocatorInterface = (*ImageLocator)(nil)
+
+// ImageIndexCall records the arguments of a call to ImageLocator.Index.
+type ImageIndexCall struct {
+	Options *cm16.ImageIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ImageLocator) Index(options *cm16.ImageIndexOptions) (*cm16.Image, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ImageIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm16.Image
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ImageLocator) IndexReturns(res *cm16.Image, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.ImageIndexOptions) (*cm16.Image, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ImageShowCall records the arguments of a call to ImageLocator.Show.
+type ImageShowCall struct {
+	Options *cm16.ImageShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ImageLocator) Show(options *cm16.ImageShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ImageShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ImageLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.ImageShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Instance ******/
+
+// InstanceLocator is a fake implementation of cm16.InstanceLocatorInterface.
+type InstanceLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.InstanceIndexOptions) (*cm16.Instance, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*InstanceIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.InstanceShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*InstanceShowCall
+}
+
+var _ cm16.InstanceLocatorInterface = (*InstanceLocator)(nil)
+
+// InstanceIndexCall records the arguments of a call to InstanceLocator.Index.
+type InstanceIndexCall struct {
+	Options *cm16.InstanceIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *InstanceLocator) Index(options *cm16.InstanceIndexOptions) (*cm16.Instance, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &InstanceIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *cm16.Instance
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *InstanceLocator) IndexReturns(res *cm16.Instance, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.InstanceIndexOptions) (*cm16.Instance, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceShowCall records the arguments of a call to InstanceLocator.Show.
+type InstanceShowCall struct {
+	Options *cm16.InstanceShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *InstanceLocator) Show(options *cm16.InstanceShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &InstanceShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *InstanceLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.InstanceShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  InstanceType ******/
+
+// InstanceTypeLocator is a fake implementation of cm16.InstanceTypeLocatorInterface.
+type InstanceTypeLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.InstanceTypeIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*InstanceTypeIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.InstanceTypeShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*InstanceTypeShowCall
+}
+
+var _ cm16.InstanceTypeLocatorInterface = (*InstanceTypeLocator)(nil)
+
+// InstanceTypeIndexCall records the arguments of a call to InstanceTypeLocator.Index.
+type InstanceTypeIndexCall struct {
+	Options *cm16.InstanceTypeIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *InstanceTypeLocator) Index(options *cm16.InstanceTypeIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &InstanceTypeIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *InstanceTypeLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.InstanceTypeIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// InstanceTypeShowCall records the arguments of a call to InstanceTypeLocator.Show.
+type InstanceTypeShowCall struct {
+	Options *cm16.InstanceTypeShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *InstanceTypeLocator) Show(options *cm16.InstanceTypeShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &InstanceTypeShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *InstanceTypeLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.InstanceTypeShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  IpAddress ******/
+
+// IpAddressLocator is a fake implementation of cm16.IpAddressLocatorInterface.
+type IpAddressLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.IpAddressIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*IpAddressIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.IpAddressShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*IpAddressShowCall
+}
+
+var _ cm16.IpAddressLocatorInterface = (*IpAddressLocator)(nil)
+
+// IpAddressIndexCall records the arguments of a call to IpAddressLocator.Index.
+type IpAddressIndexCall struct {
+	Options *cm16.IpAddressIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *IpAddressLocator) Index(options *cm16.IpAddressIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &IpAddressIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *IpAddressLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.IpAddressIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// IpAddressShowCall records the arguments of a call to IpAddressLocator.Show.
+type IpAddressShowCall struct {
+	Options *cm16.IpAddressShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *IpAddressLocator) Show(options *cm16.IpAddressShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &IpAddressShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *IpAddressLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.IpAddressShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  IpAddressBinding ******/
+
+// IpAddressBindingLocator is a fake implementation of cm16.IpAddressBindingLocatorInterface.
+type IpAddressBindingLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.IpAddressBindingIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*IpAddressBindingIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.IpAddressBindingShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*IpAddressBindingShowCall
+}
+
+var _ cm16.IpAddressBindingLocatorInterface = (*IpAddressBindingLocator)(nil)
+
+// IpAddressBindingIndexCall records the arguments of a call to IpAddressBindingLocator.Index.
+type IpAddressBindingIndexCall struct {
+	Options *cm16.IpAddressBindingIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *IpAddressBindingLocator) Index(options *cm16.IpAddressBindingIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &IpAddressBindingIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *IpAddressBindingLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.IpAddressBindingIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// IpAddressBindingShowCall records the arguments of a call to IpAddressBindingLocator.Show.
+type IpAddressBindingShowCall struct {
+	Options *cm16.IpAddressBindingShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *IpAddressBindingLocator) Show(options *cm16.IpAddressBindingShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &IpAddressBindingShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *IpAddressBindingLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.IpAddressBindingShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  MultiCloudImage ******/
+
+// MultiCloudImageLocator is a fake implementation of cm16.MultiCloudImageLocatorInterface.
+type MultiCloudImageLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.MultiCloudImageIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*MultiCloudImageIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.MultiCloudImageShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*MultiCloudImageShowCall
+}
+
+var _ cm16.MultiCloudImageLocatorInterface = (*MultiCloudImageLocator)(nil)
+
+// MultiCloudImageIndexCall records the arguments of a call to MultiCloudImageLocator.Index.
+type MultiCloudImageIndexCall struct {
+	Options *cm16.MultiCloudImageIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *MultiCloudImageLocator) Index(options *cm16.MultiCloudImageIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &MultiCloudImageIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *MultiCloudImageLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.MultiCloudImageIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// MultiCloudImageShowCall records the arguments of a call to MultiCloudImageLocator.Show.
+type MultiCloudImageShowCall struct {
+	Options *cm16.MultiCloudImageShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *MultiCloudImageLocator) Show(options *cm16.MultiCloudImageShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &MultiCloudImageShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *MultiCloudImageLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.MultiCloudImageShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Network ******/
+
+// NetworkLocator is a fake implementation of cm16.NetworkLocatorInterface.
+type NetworkLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.NetworkIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*NetworkIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.NetworkShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*NetworkShowCall
+}
+
+var _ cm16.NetworkLocatorInterface = (*NetworkLocator)(nil)
+
+// NetworkIndexCall records the arguments of a call to NetworkLocator.Index.
+type NetworkIndexCall struct {
+	Options *cm16.NetworkIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *NetworkLocator) Index(options *cm16.NetworkIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &NetworkIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *NetworkLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.NetworkIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkShowCall records the arguments of a call to NetworkLocator.Show.
+type NetworkShowCall struct {
+	Options *cm16.NetworkShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *NetworkLocator) Show(options *cm16.NetworkShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &NetworkShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *NetworkLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.NetworkShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  NetworkInterface ******/
+
+// NetworkInterfaceLocator is a fake implementation of cm16.NetworkInterfaceLocatorInterface.
+type NetworkInterfaceLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.NetworkInterfaceIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*NetworkInterfaceIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.NetworkInterfaceShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*NetworkInterfaceShowCall
+}
+
+var _ cm16.NetworkInterfaceLocatorInterface = (*NetworkInterfaceLocator)(nil)
+
+// NetworkInterfaceIndexCall records the arguments of a call to NetworkInterfaceLocator.Index.
+type NetworkInterfaceIndexCall struct {
+	Options *cm16.NetworkInterfaceIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *NetworkInterfaceLocator) Index(options *cm16.NetworkInterfaceIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &NetworkInterfaceIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *NetworkInterfaceLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.NetworkInterfaceIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkInterfaceShowCall records the arguments of a call to NetworkInterfaceLocator.Show.
+type NetworkInterfaceShowCall struct {
+	Options *cm16.NetworkInterfaceShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *NetworkInterfaceLocator) Show(options *cm16.NetworkInterfaceShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &NetworkInterfaceShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *NetworkInterfaceLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.NetworkInterfaceShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  NetworkInterfaceAttachment ******/
+
+// NetworkInterfaceAttachmentLocator is a fake implementation of cm16.NetworkInterfaceAttachmentLocatorInterface.
+type NetworkInterfaceAttachmentLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.NetworkInterfaceAttachmentIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*NetworkInterfaceAttachmentIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.NetworkInterfaceAttachmentShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*NetworkInterfaceAttachmentShowCall
+}
+
+var _ cm16.NetworkInterfaceAttachmentLocatorInterface = (*NetworkInterfaceAttachmentLocator)(nil)
+
+// NetworkInterfaceAttachmentIndexCall records the arguments of a call to NetworkInterfaceAttachmentLocator.Index.
+type NetworkInterfaceAttachmentIndexCall struct {
+	Options *cm16.NetworkInterfaceAttachmentIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *NetworkInterfaceAttachmentLocator) Index(options *cm16.NetworkInterfaceAttachmentIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &NetworkInterfaceAttachmentIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *NetworkInterfaceAttachmentLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.NetworkInterfaceAttachmentIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NetworkInterfaceAttachmentShowCall records the arguments of a call to NetworkInterfaceAttachmentLocator.Show.
+type NetworkInterfaceAttachmentShowCall struct {
+	Options *cm16.NetworkInterfaceAttachmentShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *NetworkInterfaceAttachmentLocator) Show(options *cm16.NetworkInterfaceAttachmentShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &NetworkInterfaceAttachmentShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *NetworkInterfaceAttachmentLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.NetworkInterfaceAttachmentShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  SecurityGroup ******/
+
+// SecurityGroupLocator is a fake implementation of cm16.SecurityGroupLocatorInterface.
+type SecurityGroupLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.SecurityGroupIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*SecurityGroupIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.SecurityGroupShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*SecurityGroupShowCall
+}
+
+var _ cm16.SecurityGroupLocatorInterface = (*SecurityGroupLocator)(nil)
+
+// SecurityGroupIndexCall records the arguments of a call to SecurityGroupLocator.Index.
+type SecurityGroupIndexCall struct {
+	Options *cm16.SecurityGroupIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *SecurityGroupLocator) Index(options *cm16.SecurityGroupIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &SecurityGroupIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *SecurityGroupLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.SecurityGroupIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SecurityGroupShowCall records the arguments of a call to SecurityGroupLocator.Show.
+type SecurityGroupShowCall struct {
+	Options *cm16.SecurityGroupShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *SecurityGroupLocator) Show(options *cm16.SecurityGroupShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &SecurityGroupShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *SecurityGroupLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.SecurityGroupShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Server ******/
+
+// ServerLocator is a fake implementation of cm16.ServerLocatorInterface.
+type ServerLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.ServerIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ServerIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.ServerShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ServerShowCall
+}
+
+var _ cm16.ServerLocatorInterface = (*ServerLocator)(nil)
+
+// ServerIndexCall records the arguments of a call to ServerLocator.Index.
+type ServerIndexCall struct {
+	Options *cm16.ServerIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ServerLocator) Index(options *cm16.ServerIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ServerIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ServerLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.ServerIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerShowCall records the arguments of a call to ServerLocator.Show.
+type ServerShowCall struct {
+	Options *cm16.ServerShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ServerLocator) Show(options *cm16.ServerShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ServerShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ServerLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.ServerShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  ServerArray ******/
+
+// ServerArrayLocator is a fake implementation of cm16.ServerArrayLocatorInterface.
+type ServerArrayLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.ServerArrayIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ServerArrayIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.ServerArrayShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ServerArrayShowCall
+}
+
+var _ cm16.ServerArrayLocatorInterface = (*ServerArrayLocator)(nil)
+
+// ServerArrayIndexCall records the arguments of a call to ServerArrayLocator.Index.
+type ServerArrayIndexCall struct {
+	Options *cm16.ServerArrayIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ServerArrayLocator) Index(options *cm16.ServerArrayIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ServerArrayIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ServerArrayLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.ServerArrayIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerArrayShowCall records the arguments of a call to ServerArrayLocator.Show.
+type ServerArrayShowCall struct {
+	Options *cm16.ServerArrayShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ServerArrayLocator) Show(options *cm16.ServerArrayShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ServerArrayShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ServerArrayLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.ServerArrayShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  ServerTemplate ******/
+
+// ServerTemplateLocator is a fake implementation of cm16.ServerTemplateLocatorInterface.
+type ServerTemplateLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.ServerTemplateIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ServerTemplateIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.ServerTemplateShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ServerTemplateShowCall
+}
+
+var _ cm16.ServerTemplateLocatorInterface = (*ServerTemplateLocator)(nil)
+
+// ServerTemplateIndexCall records the arguments of a call to ServerTemplateLocator.Index.
+type ServerTemplateIndexCall struct {
+	Options *cm16.ServerTemplateIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ServerTemplateLocator) Index(options *cm16.ServerTemplateIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ServerTemplateIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.ServerTemplateIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ServerTemplateShowCall records the arguments of a call to ServerTemplateLocator.Show.
+type ServerTemplateShowCall struct {
+	Options *cm16.ServerTemplateShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ServerTemplateLocator) Show(options *cm16.ServerTemplateShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ServerTemplateShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ServerTemplateLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.ServerTemplateShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  SshKey ******/
+
+// SshKeyLocator is a fake implementation of cm16.SshKeyLocatorInterface.
+type SshKeyLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.SshKeyIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*SshKeyIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.SshKeyShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*SshKeyShowCall
+}
+
+var _ cm16.SshKeyLocatorInterface = (*SshKeyLocator)(nil)
+
+// SshKeyIndexCall records the arguments of a call to SshKeyLocator.Index.
+type SshKeyIndexCall struct {
+	Options *cm16.SshKeyIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *SshKeyLocator) Index(options *cm16.SshKeyIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &SshKeyIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *SshKeyLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.SshKeyIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SshKeyShowCall records the arguments of a call to SshKeyLocator.Show.
+type SshKeyShowCall struct {
+	Options *cm16.SshKeyShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *SshKeyLocator) Show(options *cm16.SshKeyShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &SshKeyShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *SshKeyLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.SshKeyShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Subnet ******/
+
+// SubnetLocator is a fake implementation of cm16.SubnetLocatorInterface.
+type SubnetLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*cm16.SubnetIndexOptions) error
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*SubnetIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*cm16.SubnetShowOptions) error
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*SubnetShowCall
+}
+
+var _ cm16.SubnetLocatorInterface = (*SubnetLocator)(nil)
+
+// SubnetIndexCall records the arguments of a call to SubnetLocator.Index.
+type SubnetIndexCall struct {
+	Options *cm16.SubnetIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *SubnetLocator) Index(options *cm16.SubnetIndexOptions) error {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &SubnetIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *SubnetLocator) IndexReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*cm16.SubnetIndexOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// SubnetShowCall records the arguments of a call to SubnetLocator.Show.
+type SubnetShowCall struct {
+	Options *cm16.SubnetShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *SubnetLocator) Show(options *cm16.SubnetShowOptions) error {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &SubnetShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *SubnetLocator) ShowReturns(err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*cm16.SubnetShowOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
@@ -12,8 +12,6 @@ package cm16
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"time"
 
 	"github.com/rightscale/rsc/metadata"
 	"github.com/rightscale/rsc/rsapi"
@@ -85,19 +83,62 @@ func (api *API) AccountLocator(href string) *AccountLocator {
 	return &AccountLocator{Href(href), api}
 }
 
+// AccountLocatorInterface lists the AccountLocator methods, code that depends on the interface
+// rather than on AccountLocator can be unit tested using the generated fake locators.
+type AccountLocatorInterface interface {
+	Index(options *AccountIndexOptions) error
+	Show(options *AccountShowOptions) error
+}
+
 //===== Actions
 
+// AccountIndexOptions contains the optional parameters of AccountLocator.Index.
+type AccountIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AccountIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AccountLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/accounts
 //
 // Currently not implemented.
-func (loc *AccountLocator) Index(options rsapi.APIParams) error {
+func (loc *AccountLocator) Index(options *AccountIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Account", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Account", "index")
 	if err != nil {
 		return err
@@ -110,29 +151,60 @@ func (loc *AccountLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// AccountShowOptions contains the optional parameters of AccountLocator.Show.
+type AccountShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AccountShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AccountLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/accounts/:id
 //
 // Currently not implemented.
-func (loc *AccountLocator) Show(options rsapi.APIParams) error {
+func (loc *AccountLocator) Show(options *AccountShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Account", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Account", "show")
 	if err != nil {
 		return err
@@ -145,15 +217,10 @@ func (loc *AccountLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -189,19 +256,62 @@ func (api *API) CloudLocator(href string) *CloudLocator {
 	return &CloudLocator{Href(href), api}
 }
 
+// CloudLocatorInterface lists the CloudLocator methods, code that depends on the interface
+// rather than on CloudLocator can be unit tested using the generated fake locators.
+type CloudLocatorInterface interface {
+	Index(options *CloudIndexOptions) error
+	Show(options *CloudShowOptions) error
+}
+
 //===== Actions
 
+// CloudIndexOptions contains the optional parameters of CloudLocator.Index.
+type CloudIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CloudIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CloudLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds
 //
 // Currently not implemented.
-func (loc *CloudLocator) Index(options rsapi.APIParams) error {
+func (loc *CloudLocator) Index(options *CloudIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Cloud", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Cloud", "index")
 	if err != nil {
 		return err
@@ -214,29 +324,60 @@ func (loc *CloudLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// CloudShowOptions contains the optional parameters of CloudLocator.Show.
+type CloudShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *CloudShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of CloudLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:id
 //
 // Currently not implemented.
-func (loc *CloudLocator) Show(options rsapi.APIParams) error {
+func (loc *CloudLocator) Show(options *CloudShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Cloud", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Cloud", "show")
 	if err != nil {
 		return err
@@ -249,15 +390,10 @@ func (loc *CloudLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -282,6 +418,15 @@ func (r *Datacenter) Locator(api *API) *DatacenterLocator {
 	return api.DatacenterLocator(r.Href)
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *Datacenter) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
 //===== Locator
 
 // DatacenterLocator exposes the Datacenter resource actions.
@@ -295,20 +440,63 @@ func (api *API) DatacenterLocator(href string) *DatacenterLocator {
 	return &DatacenterLocator{Href(href), api}
 }
 
+// DatacenterLocatorInterface lists the DatacenterLocator methods, code that depends on the interface
+// rather than on DatacenterLocator can be unit tested using the generated fake locators.
+type DatacenterLocatorInterface interface {
+	Index(options *DatacenterIndexOptions) error
+	Show(options *DatacenterShowOptions) error
+}
+
 //===== Actions
 
+// DatacenterIndexOptions contains the optional parameters of DatacenterLocator.Index.
+type DatacenterIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *DatacenterIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of DatacenterLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/datacenters
 // GET /api/clouds/:cloud_id/datacenters
 //
 // Currently not implemented.
-func (loc *DatacenterLocator) Index(options rsapi.APIParams) error {
+func (loc *DatacenterLocator) Index(options *DatacenterIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Datacenter", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Datacenter", "index")
 	if err != nil {
 		return err
@@ -321,30 +509,61 @@ func (loc *DatacenterLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// DatacenterShowOptions contains the optional parameters of DatacenterLocator.Show.
+type DatacenterShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *DatacenterShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of DatacenterLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/datacenters/:id
 // GET /api/clouds/:cloud_id/datacenters/:id
 //
 // Currently not implemented.
-func (loc *DatacenterLocator) Show(options rsapi.APIParams) error {
+func (loc *DatacenterLocator) Show(options *DatacenterShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Datacenter", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Datacenter", "show")
 	if err != nil {
 		return err
@@ -357,15 +576,10 @@ func (loc *DatacenterLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -392,6 +606,15 @@ func (r *Deployment) Locator(api *API) *DeploymentLocator {
 	return api.DeploymentLocator(r.Href)
 }
 
+// AccountLocator returns a locator for the resource pointed to by the "account" link.
+// It returns nil if the link is absent.
+func (r *Deployment) AccountLocator(api *API) *AccountLocator {
+	if r.Links == nil || r.Links.Account == nil || r.Links.Account.Href == "" {
+		return nil
+	}
+	return api.AccountLocator(r.Links.Account.Href)
+}
+
 //===== Locator
 
 // DeploymentLocator exposes the Deployment resource actions.
@@ -405,24 +628,72 @@ func (api *API) DeploymentLocator(href string) *DeploymentLocator {
 	return &DeploymentLocator{Href(href), api}
 }
 
+// DeploymentLocatorInterface lists the DeploymentLocator methods, code that depends on the interface
+// rather than on DeploymentLocator can be unit tested using the generated fake locators.
+type DeploymentLocatorInterface interface {
+	Index(options *DeploymentIndexOptions) (*Deployment, error)
+	Show(options *DeploymentShowOptions) (*Deployment, error)
+}
+
 //===== Actions
 
+// DeploymentIndexOptions contains the optional parameters of DeploymentLocator.Index.
+type DeploymentIndexOptions struct {
+	// Comma separated list of Deployment IDs
+	Ids string
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *DeploymentIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "ids", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of DeploymentLocator.Index", n)
+		}
+	}
+	if o.Ids != "" {
+		p["ids"] = o.Ids
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/deployments
 //
 // List all Deployments in an Account.
-func (loc *DeploymentLocator) Index(options rsapi.APIParams) (*Deployment, error) {
+func (loc *DeploymentLocator) Index(options *DeploymentIndexOptions) (*Deployment, error) {
 	var res *Deployment
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var idsOpt = options["ids"]
+	var idsOpt = opts["ids"]
 	if idsOpt != nil {
 		params["ids"] = idsOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Deployment", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Deployment", "index")
 	if err != nil {
 		return res, err
@@ -435,36 +706,61 @@ func (loc *DeploymentLocator) Index(options rsapi.APIParams) (*Deployment, error
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// DeploymentShowOptions contains the optional parameters of DeploymentLocator.Show.
+type DeploymentShowOptions struct {
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *DeploymentShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of DeploymentLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/deployments/:id
 //
 // Show a single Deployment
-func (loc *DeploymentLocator) Show(options rsapi.APIParams) (*Deployment, error) {
+func (loc *DeploymentLocator) Show(options *DeploymentShowOptions) (*Deployment, error) {
 	var res *Deployment
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Deployment", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Deployment", "show")
 	if err != nil {
 		return res, err
@@ -477,21 +773,11 @@ func (loc *DeploymentLocator) Show(options rsapi.APIParams) (*Deployment, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -522,6 +808,15 @@ func (r *Image) Locator(api *API) *ImageLocator {
 	return api.ImageLocator(r.Href)
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *Image) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
 //===== Locator
 
 // ImageLocator exposes the Image resource actions.
@@ -535,24 +830,78 @@ func (api *API) ImageLocator(href string) *ImageLocator {
 	return &ImageLocator{Href(href), api}
 }
 
+// ImageLocatorInterface lists the ImageLocator methods, code that depends on the interface
+// rather than on ImageLocator can be unit tested using the generated fake locators.
+type ImageLocatorInterface interface {
+	Index(options *ImageIndexOptions) (*Image, error)
+	Show(options *ImageShowOptions) error
+}
+
 //===== Actions
 
+// ImageIndexOptions contains the optional parameters of ImageLocator.Index.
+type ImageIndexOptions struct {
+	// Filter images by attribute. A filter takes the form <attribute><operator><value>.
+	// <operator> can be either "=" or "!=". <value> can be comma-separated list of values to
+	// express multiple possible values. For example, "image_type=machine,ramdisk" finds all
+	// images that have "machine" and "ramdisk" types. Multiple filters must be concatenated with an
+	// ampersand (&). For example, "image_type=machine&visibility=private" finds all images with
+	// type "machine" and visibility "private". All special characters in the filter must be
+	// URL encoded.
+	Filter string
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ImageIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ImageLocator.Index", n)
+		}
+	}
+	if o.Filter != "" {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/clouds/:cloud_id/images
 //
 // Lists all Images for the given Cloud.
-func (loc *ImageLocator) Index(options rsapi.APIParams) (*Image, error) {
+func (loc *ImageLocator) Index(options *ImageIndexOptions) (*Image, error) {
 	var res *Image
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Image", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Image", "index")
 	if err != nil {
 		return res, err
@@ -565,36 +914,62 @@ func (loc *ImageLocator) Index(options rsapi.APIParams) (*Image, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ImageShowOptions contains the optional parameters of ImageLocator.Show.
+type ImageShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ImageShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ImageLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/images/:id
 // GET /api/clouds/:cloud_id/images/:id
 //
 // Currently not implemented.
-func (loc *ImageLocator) Show(options rsapi.APIParams) error {
+func (loc *ImageLocator) Show(options *ImageShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Image", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Image", "show")
 	if err != nil {
 		return err
@@ -607,15 +982,10 @@ func (loc *ImageLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -665,6 +1035,114 @@ func (r *Instance) Locator(api *API) *InstanceLocator {
 	return api.InstanceLocator(r.Href)
 }
 
+// AccountLocator returns a locator for the resource pointed to by the "account" link.
+// It returns nil if the link is absent.
+func (r *Instance) AccountLocator(api *API) *AccountLocator {
+	if r.Links == nil || r.Links.Account == nil || r.Links.Account.Href == "" {
+		return nil
+	}
+	return api.AccountLocator(r.Links.Account.Href)
+}
+
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *Instance) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
+// ComputedImageLocator returns a locator for the resource pointed to by the "computed_image" link.
+// It returns nil if the link is absent.
+func (r *Instance) ComputedImageLocator(api *API) *ImageLocator {
+	if r.Links == nil || r.Links.ComputedImage == nil || r.Links.ComputedImage.Href == "" {
+		return nil
+	}
+	return api.ImageLocator(r.Links.ComputedImage.Href)
+}
+
+// ComputedMultiCloudImageLocator returns a locator for the resource pointed to by the "computed_multi_cloud_image" link.
+// It returns nil if the link is absent.
+func (r *Instance) ComputedMultiCloudImageLocator(api *API) *MultiCloudImageLocator {
+	if r.Links == nil || r.Links.ComputedMultiCloudImage == nil || r.Links.ComputedMultiCloudImage.Href == "" {
+		return nil
+	}
+	return api.MultiCloudImageLocator(r.Links.ComputedMultiCloudImage.Href)
+}
+
+// DatacenterLocator returns a locator for the resource pointed to by the "datacenter" link.
+// It returns nil if the link is absent.
+func (r *Instance) DatacenterLocator(api *API) *DatacenterLocator {
+	if r.Links == nil || r.Links.Datacenter == nil || r.Links.Datacenter.Href == "" {
+		return nil
+	}
+	return api.DatacenterLocator(r.Links.Datacenter.Href)
+}
+
+// DeploymentLocator returns a locator for the resource pointed to by the "deployment" link.
+// It returns nil if the link is absent.
+func (r *Instance) DeploymentLocator(api *API) *DeploymentLocator {
+	if r.Links == nil || r.Links.Deployment == nil || r.Links.Deployment.Href == "" {
+		return nil
+	}
+	return api.DeploymentLocator(r.Links.Deployment.Href)
+}
+
+// ImageLocator returns a locator for the resource pointed to by the "image" link.
+// It returns nil if the link is absent.
+func (r *Instance) ImageLocator(api *API) *ImageLocator {
+	if r.Links == nil || r.Links.Image == nil || r.Links.Image.Href == "" {
+		return nil
+	}
+	return api.ImageLocator(r.Links.Image.Href)
+}
+
+// InstanceTypeLocator returns a locator for the resource pointed to by the "instance_type" link.
+// It returns nil if the link is absent.
+func (r *Instance) InstanceTypeLocator(api *API) *InstanceTypeLocator {
+	if r.Links == nil || r.Links.InstanceType == nil || r.Links.InstanceType.Href == "" {
+		return nil
+	}
+	return api.InstanceTypeLocator(r.Links.InstanceType.Href)
+}
+
+// MultiCloudImageLocator returns a locator for the resource pointed to by the "multi_cloud_image" link.
+// It returns nil if the link is absent.
+func (r *Instance) MultiCloudImageLocator(api *API) *MultiCloudImageLocator {
+	if r.Links == nil || r.Links.MultiCloudImage == nil || r.Links.MultiCloudImage.Href == "" {
+		return nil
+	}
+	return api.MultiCloudImageLocator(r.Links.MultiCloudImage.Href)
+}
+
+// SecurityGroupsLocator returns a locator for the resource pointed to by the "security_groups" link.
+// It returns nil if the link is absent.
+func (r *Instance) SecurityGroupsLocator(api *API) *SecurityGroupLocator {
+	if r.Links == nil || r.Links.SecurityGroups == nil || r.Links.SecurityGroups.Href == "" {
+		return nil
+	}
+	return api.SecurityGroupLocator(r.Links.SecurityGroups.Href)
+}
+
+// SshKeyLocator returns a locator for the resource pointed to by the "ssh_key" link.
+// It returns nil if the link is absent.
+func (r *Instance) SshKeyLocator(api *API) *SshKeyLocator {
+	if r.Links == nil || r.Links.SshKey == nil || r.Links.SshKey.Href == "" {
+		return nil
+	}
+	return api.SshKeyLocator(r.Links.SshKey.Href)
+}
+
+// SubnetsLocator returns a locator for the resource pointed to by the "subnets" link.
+// It returns nil if the link is absent.
+func (r *Instance) SubnetsLocator(api *API) *SubnetLocator {
+	if r.Links == nil || r.Links.Subnets == nil || r.Links.Subnets.Href == "" {
+		return nil
+	}
+	return api.SubnetLocator(r.Links.Subnets.Href)
+}
+
 //===== Locator
 
 // InstanceLocator exposes the Instance resource actions.
@@ -678,33 +1156,100 @@ func (api *API) InstanceLocator(href string) *InstanceLocator {
 	return &InstanceLocator{Href(href), api}
 }
 
+// InstanceLocatorInterface lists the InstanceLocator methods, code that depends on the interface
+// rather than on InstanceLocator can be unit tested using the generated fake locators.
+type InstanceLocatorInterface interface {
+	Index(options *InstanceIndexOptions) (*Instance, error)
+	Show(options *InstanceShowOptions) error
+}
+
 //===== Actions
 
+// InstanceIndexOptions contains the optional parameters of InstanceLocator.Index.
+type InstanceIndexOptions struct {
+	// Filter instances by attribute. A filter takes the form <attribute><operator><value>.
+	// <operator> can be either "=" or "!=". <value> can be comma-separated list of values to
+	// express multiple possible values. For example, "state=booting,operational" finds all
+	// instances that are in "booting" or "operational" state. Percentage (%) wildcard character
+	// can be used to perform partial string match if supported by the filter. For example,
+	// "name=%test%" finds all instances with name containing the word "test",
+	// "name=test%" finds all instances with name beginning with "test", and "name=%test" finds
+	// all instances with name ending with "test". Multiple filters must be concatenated with an
+	// ampersand (&). For example, "name=test&state=operational" finds all instances with
+	// name "test" and are in "operational" state. All special characters in the filter must be
+	// URL encoded.
+	Filter string
+	// Comma separated list of Instance RsIds
+	Ids string
+	// The maximum number of resources to return for this index
+	Limit int
+	View  string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "ids", "limit", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.Index", n)
+		}
+	}
+	if o.Filter != "" {
+		p["filter"] = o.Filter
+	}
+	if o.Ids != "" {
+		p["ids"] = o.Ids
+	}
+	if o.Limit != 0 {
+		p["limit"] = o.Limit
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/instances
 // GET /api/clouds/:cloud_id/instances
 //
 // List all Instances in an account.
-func (loc *InstanceLocator) Index(options rsapi.APIParams) (*Instance, error) {
+func (loc *InstanceLocator) Index(options *InstanceIndexOptions) (*Instance, error) {
 	var res *Instance
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter"] = filterOpt
 	}
-	var idsOpt = options["ids"]
+	var idsOpt = opts["ids"]
 	if idsOpt != nil {
 		params["ids"] = idsOpt
 	}
-	var limitOpt = options["limit"]
+	var limitOpt = opts["limit"]
 	if limitOpt != nil {
 		params["limit"] = limitOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Instance", "index")
 	if err != nil {
 		return res, err
@@ -717,36 +1262,62 @@ func (loc *InstanceLocator) Index(options rsapi.APIParams) (*Instance, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// InstanceShowOptions contains the optional parameters of InstanceLocator.Show.
+type InstanceShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/instances/:id
 // GET /api/clouds/:cloud_id/instances/:id
 //
 // Currently not implemented.
-func (loc *InstanceLocator) Show(options rsapi.APIParams) error {
+func (loc *InstanceLocator) Show(options *InstanceShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Instance", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Instance", "show")
 	if err != nil {
 		return err
@@ -759,15 +1330,10 @@ func (loc *InstanceLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -796,6 +1362,15 @@ func (r *InstanceType) Locator(api *API) *InstanceTypeLocator {
 	return api.InstanceTypeLocator(r.Href)
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *InstanceType) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
 //===== Locator
 
 // InstanceTypeLocator exposes the InstanceType resource actions.
@@ -809,20 +1384,63 @@ func (api *API) InstanceTypeLocator(href string) *InstanceTypeLocator {
 	return &InstanceTypeLocator{Href(href), api}
 }
 
+// InstanceTypeLocatorInterface lists the InstanceTypeLocator methods, code that depends on the interface
+// rather than on InstanceTypeLocator can be unit tested using the generated fake locators.
+type InstanceTypeLocatorInterface interface {
+	Index(options *InstanceTypeIndexOptions) error
+	Show(options *InstanceTypeShowOptions) error
+}
+
 //===== Actions
 
+// InstanceTypeIndexOptions contains the optional parameters of InstanceTypeLocator.Index.
+type InstanceTypeIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceTypeIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceTypeLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/instance_types
 // GET /api/clouds/:cloud_id/instance_types
 //
 // Currently not implemented.
-func (loc *InstanceTypeLocator) Index(options rsapi.APIParams) error {
+func (loc *InstanceTypeLocator) Index(options *InstanceTypeIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceType", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("InstanceType", "index")
 	if err != nil {
 		return err
@@ -835,30 +1453,61 @@ func (loc *InstanceTypeLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// InstanceTypeShowOptions contains the optional parameters of InstanceTypeLocator.Show.
+type InstanceTypeShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *InstanceTypeShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of InstanceTypeLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/instance_types/:id
 // GET /api/clouds/:cloud_id/instance_types/:id
 //
 // Currently not implemented.
-func (loc *InstanceTypeLocator) Show(options rsapi.APIParams) error {
+func (loc *InstanceTypeLocator) Show(options *InstanceTypeShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("InstanceType", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("InstanceType", "show")
 	if err != nil {
 		return err
@@ -871,15 +1520,10 @@ func (loc *InstanceTypeLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -902,6 +1546,15 @@ func (r *IpAddress) Locator(api *API) *IpAddressLocator {
 	return api.IpAddressLocator(r.Href)
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *IpAddress) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
 //===== Locator
 
 // IpAddressLocator exposes the IpAddress resource actions.
@@ -915,20 +1568,63 @@ func (api *API) IpAddressLocator(href string) *IpAddressLocator {
 	return &IpAddressLocator{Href(href), api}
 }
 
+// IpAddressLocatorInterface lists the IpAddressLocator methods, code that depends on the interface
+// rather than on IpAddressLocator can be unit tested using the generated fake locators.
+type IpAddressLocatorInterface interface {
+	Index(options *IpAddressIndexOptions) error
+	Show(options *IpAddressShowOptions) error
+}
+
 //===== Actions
 
+// IpAddressIndexOptions contains the optional parameters of IpAddressLocator.Index.
+type IpAddressIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *IpAddressIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of IpAddressLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/ip_addresses
 // GET /api/clouds/:cloud_id/ip_addresses
 //
 // Currently not implemented.
-func (loc *IpAddressLocator) Index(options rsapi.APIParams) error {
+func (loc *IpAddressLocator) Index(options *IpAddressIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IpAddress", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("IpAddress", "index")
 	if err != nil {
 		return err
@@ -941,30 +1637,61 @@ func (loc *IpAddressLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// IpAddressShowOptions contains the optional parameters of IpAddressLocator.Show.
+type IpAddressShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *IpAddressShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of IpAddressLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/ip_addresses/:id
 // GET /api/clouds/:cloud_id/ip_addresses/:id
 //
 // Currently not implemented.
-func (loc *IpAddressLocator) Show(options rsapi.APIParams) error {
+func (loc *IpAddressLocator) Show(options *IpAddressShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IpAddress", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("IpAddress", "show")
 	if err != nil {
 		return err
@@ -977,15 +1704,10 @@ func (loc *IpAddressLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1010,6 +1732,33 @@ func (r *IpAddressBinding) Locator(api *API) *IpAddressBindingLocator {
 	return api.IpAddressBindingLocator(r.Href)
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *IpAddressBinding) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
+// InstanceLocator returns a locator for the resource pointed to by the "instance" link.
+// It returns nil if the link is absent.
+func (r *IpAddressBinding) InstanceLocator(api *API) *InstanceLocator {
+	if r.Links == nil || r.Links.Instance == nil || r.Links.Instance.Href == "" {
+		return nil
+	}
+	return api.InstanceLocator(r.Links.Instance.Href)
+}
+
+// IpAddressLocator returns a locator for the resource pointed to by the "ip_address" link.
+// It returns nil if the link is absent.
+func (r *IpAddressBinding) IpAddressLocator(api *API) *IpAddressLocator {
+	if r.Links == nil || r.Links.IpAddress == nil || r.Links.IpAddress.Href == "" {
+		return nil
+	}
+	return api.IpAddressLocator(r.Links.IpAddress.Href)
+}
+
 //===== Locator
 
 // IpAddressBindingLocator exposes the IpAddressBinding resource actions.
@@ -1023,20 +1772,63 @@ func (api *API) IpAddressBindingLocator(href string) *IpAddressBindingLocator {
 	return &IpAddressBindingLocator{Href(href), api}
 }
 
+// IpAddressBindingLocatorInterface lists the IpAddressBindingLocator methods, code that depends on the interface
+// rather than on IpAddressBindingLocator can be unit tested using the generated fake locators.
+type IpAddressBindingLocatorInterface interface {
+	Index(options *IpAddressBindingIndexOptions) error
+	Show(options *IpAddressBindingShowOptions) error
+}
+
 //===== Actions
 
+// IpAddressBindingIndexOptions contains the optional parameters of IpAddressBindingLocator.Index.
+type IpAddressBindingIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *IpAddressBindingIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of IpAddressBindingLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/ip_address_bindings
 // GET /api/clouds/:cloud_id/ip_address_bindings
 //
 // Currently not implemented.
-func (loc *IpAddressBindingLocator) Index(options rsapi.APIParams) error {
+func (loc *IpAddressBindingLocator) Index(options *IpAddressBindingIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IpAddressBinding", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("IpAddressBinding", "index")
 	if err != nil {
 		return err
@@ -1049,30 +1841,61 @@ func (loc *IpAddressBindingLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// IpAddressBindingShowOptions contains the optional parameters of IpAddressBindingLocator.Show.
+type IpAddressBindingShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *IpAddressBindingShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of IpAddressBindingLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/ip_address_bindings/:id
 // GET /api/clouds/:cloud_id/ip_address_bindings/:id
 //
 // Currently not implemented.
-func (loc *IpAddressBindingLocator) Show(options rsapi.APIParams) error {
+func (loc *IpAddressBindingLocator) Show(options *IpAddressBindingShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("IpAddressBinding", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("IpAddressBinding", "show")
 	if err != nil {
 		return err
@@ -1085,15 +1908,10 @@ func (loc *IpAddressBindingLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1132,19 +1950,62 @@ func (api *API) MultiCloudImageLocator(href string) *MultiCloudImageLocator {
 	return &MultiCloudImageLocator{Href(href), api}
 }
 
+// MultiCloudImageLocatorInterface lists the MultiCloudImageLocator methods, code that depends on the interface
+// rather than on MultiCloudImageLocator can be unit tested using the generated fake locators.
+type MultiCloudImageLocatorInterface interface {
+	Index(options *MultiCloudImageIndexOptions) error
+	Show(options *MultiCloudImageShowOptions) error
+}
+
 //===== Actions
 
+// MultiCloudImageIndexOptions contains the optional parameters of MultiCloudImageLocator.Index.
+type MultiCloudImageIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *MultiCloudImageIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of MultiCloudImageLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/multi_cloud_images
 //
 // Currently not implemented.
-func (loc *MultiCloudImageLocator) Index(options rsapi.APIParams) error {
+func (loc *MultiCloudImageLocator) Index(options *MultiCloudImageIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("MultiCloudImage", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("MultiCloudImage", "index")
 	if err != nil {
 		return err
@@ -1157,29 +2018,60 @@ func (loc *MultiCloudImageLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// MultiCloudImageShowOptions contains the optional parameters of MultiCloudImageLocator.Show.
+type MultiCloudImageShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *MultiCloudImageShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of MultiCloudImageLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/multi_cloud_images/:id
 //
 // Currently not implemented.
-func (loc *MultiCloudImageLocator) Show(options rsapi.APIParams) error {
+func (loc *MultiCloudImageLocator) Show(options *MultiCloudImageShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("MultiCloudImage", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("MultiCloudImage", "show")
 	if err != nil {
 		return err
@@ -1192,15 +2084,10 @@ func (loc *MultiCloudImageLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1222,6 +2109,15 @@ func (r *Network) Locator(api *API) *NetworkLocator {
 	return api.NetworkLocator(r.Href)
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *Network) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
 //===== Locator
 
 // NetworkLocator exposes the Network resource actions.
@@ -1235,19 +2131,62 @@ func (api *API) NetworkLocator(href string) *NetworkLocator {
 	return &NetworkLocator{Href(href), api}
 }
 
+// NetworkLocatorInterface lists the NetworkLocator methods, code that depends on the interface
+// rather than on NetworkLocator can be unit tested using the generated fake locators.
+type NetworkLocatorInterface interface {
+	Index(options *NetworkIndexOptions) error
+	Show(options *NetworkShowOptions) error
+}
+
 //===== Actions
 
+// NetworkIndexOptions contains the optional parameters of NetworkLocator.Index.
+type NetworkIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NetworkIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NetworkLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/networks
 //
 // Currently not implemented.
-func (loc *NetworkLocator) Index(options rsapi.APIParams) error {
+func (loc *NetworkLocator) Index(options *NetworkIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Network", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Network", "index")
 	if err != nil {
 		return err
@@ -1260,29 +2199,60 @@ func (loc *NetworkLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// NetworkShowOptions contains the optional parameters of NetworkLocator.Show.
+type NetworkShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NetworkShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NetworkLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/networks/:id
 //
 // Currently not implemented.
-func (loc *NetworkLocator) Show(options rsapi.APIParams) error {
+func (loc *NetworkLocator) Show(options *NetworkShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Network", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Network", "show")
 	if err != nil {
 		return err
@@ -1295,15 +2265,10 @@ func (loc *NetworkLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1324,6 +2289,15 @@ func (r *NetworkInterface) Locator(api *API) *NetworkInterfaceLocator {
 	return api.NetworkInterfaceLocator(r.Href)
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *NetworkInterface) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
 //===== Locator
 
 // NetworkInterfaceLocator exposes the NetworkInterface resource actions.
@@ -1337,19 +2311,62 @@ func (api *API) NetworkInterfaceLocator(href string) *NetworkInterfaceLocator {
 	return &NetworkInterfaceLocator{Href(href), api}
 }
 
+// NetworkInterfaceLocatorInterface lists the NetworkInterfaceLocator methods, code that depends on the interface
+// rather than on NetworkInterfaceLocator can be unit tested using the generated fake locators.
+type NetworkInterfaceLocatorInterface interface {
+	Index(options *NetworkInterfaceIndexOptions) error
+	Show(options *NetworkInterfaceShowOptions) error
+}
+
 //===== Actions
 
+// NetworkInterfaceIndexOptions contains the optional parameters of NetworkInterfaceLocator.Index.
+type NetworkInterfaceIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NetworkInterfaceIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NetworkInterfaceLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/network_interfaces
 //
 // Currently not implemented.
-func (loc *NetworkInterfaceLocator) Index(options rsapi.APIParams) error {
+func (loc *NetworkInterfaceLocator) Index(options *NetworkInterfaceIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkInterface", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NetworkInterface", "index")
 	if err != nil {
 		return err
@@ -1362,29 +2379,60 @@ func (loc *NetworkInterfaceLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// NetworkInterfaceShowOptions contains the optional parameters of NetworkInterfaceLocator.Show.
+type NetworkInterfaceShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NetworkInterfaceShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NetworkInterfaceLocator.Show", n)
 		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
 	}
-	return nil
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /api/network_interfaces/:id
 //
 // Currently not implemented.
-func (loc *NetworkInterfaceLocator) Show(options rsapi.APIParams) error {
+func (loc *NetworkInterfaceLocator) Show(options *NetworkInterfaceShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkInterface", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NetworkInterface", "show")
 	if err != nil {
 		return err
@@ -1397,15 +2445,10 @@ func (loc *NetworkInterfaceLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1425,6 +2468,15 @@ func (r *NetworkInterfaceAttachment) Locator(api *API) *NetworkInterfaceAttachme
 	return api.NetworkInterfaceAttachmentLocator(r.Href)
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *NetworkInterfaceAttachment) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
 //===== Locator
 
 // NetworkInterfaceAttachmentLocator exposes the NetworkInterfaceAttachment resource actions.
@@ -1438,19 +2490,62 @@ func (api *API) NetworkInterfaceAttachmentLocator(href string) *NetworkInterface
 	return &NetworkInterfaceAttachmentLocator{Href(href), api}
 }
 
+// NetworkInterfaceAttachmentLocatorInterface lists the NetworkInterfaceAttachmentLocator methods, code that depends on the interface
+// rather than on NetworkInterfaceAttachmentLocator can be unit tested using the generated fake locators.
+type NetworkInterfaceAttachmentLocatorInterface interface {
+	Index(options *NetworkInterfaceAttachmentIndexOptions) error
+	Show(options *NetworkInterfaceAttachmentShowOptions) error
+}
+
 //===== Actions
 
+// NetworkInterfaceAttachmentIndexOptions contains the optional parameters of NetworkInterfaceAttachmentLocator.Index.
+type NetworkInterfaceAttachmentIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NetworkInterfaceAttachmentIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NetworkInterfaceAttachmentLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/network_interface_attachments
 //
 // Currently not implemented.
-func (loc *NetworkInterfaceAttachmentLocator) Index(options rsapi.APIParams) error {
+func (loc *NetworkInterfaceAttachmentLocator) Index(options *NetworkInterfaceAttachmentIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkInterfaceAttachment", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NetworkInterfaceAttachment", "index")
 	if err != nil {
 		return err
@@ -1463,29 +2558,60 @@ func (loc *NetworkInterfaceAttachmentLocator) Index(options rsapi.APIParams) err
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// NetworkInterfaceAttachmentShowOptions contains the optional parameters of NetworkInterfaceAttachmentLocator.Show.
+type NetworkInterfaceAttachmentShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NetworkInterfaceAttachmentShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NetworkInterfaceAttachmentLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/network_interface_attachments/:id
 //
 // Currently not implemented.
-func (loc *NetworkInterfaceAttachmentLocator) Show(options rsapi.APIParams) error {
+func (loc *NetworkInterfaceAttachmentLocator) Show(options *NetworkInterfaceAttachmentShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NetworkInterfaceAttachment", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NetworkInterfaceAttachment", "show")
 	if err != nil {
 		return err
@@ -1498,15 +2624,10 @@ func (loc *NetworkInterfaceAttachmentLocator) Show(options rsapi.APIParams) erro
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1530,6 +2651,15 @@ func (r *SecurityGroup) Locator(api *API) *SecurityGroupLocator {
 	return api.SecurityGroupLocator(r.Href)
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *SecurityGroup) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
 //===== Locator
 
 // SecurityGroupLocator exposes the SecurityGroup resource actions.
@@ -1543,21 +2673,64 @@ func (api *API) SecurityGroupLocator(href string) *SecurityGroupLocator {
 	return &SecurityGroupLocator{Href(href), api}
 }
 
+// SecurityGroupLocatorInterface lists the SecurityGroupLocator methods, code that depends on the interface
+// rather than on SecurityGroupLocator can be unit tested using the generated fake locators.
+type SecurityGroupLocatorInterface interface {
+	Index(options *SecurityGroupIndexOptions) error
+	Show(options *SecurityGroupShowOptions) error
+}
+
 //===== Actions
 
+// SecurityGroupIndexOptions contains the optional parameters of SecurityGroupLocator.Index.
+type SecurityGroupIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SecurityGroupIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SecurityGroupLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/security_groups
 // GET /api/clouds/:cloud_id/security_groups
 // GET /api/clouds/:cloud_id/instances/:instance_id/security_groups
 //
 // Currently not implemented.
-func (loc *SecurityGroupLocator) Index(options rsapi.APIParams) error {
+func (loc *SecurityGroupLocator) Index(options *SecurityGroupIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SecurityGroup", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("SecurityGroup", "index")
 	if err != nil {
 		return err
@@ -1570,30 +2743,61 @@ func (loc *SecurityGroupLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// SecurityGroupShowOptions contains the optional parameters of SecurityGroupLocator.Show.
+type SecurityGroupShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SecurityGroupShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SecurityGroupLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/security_groups/:id
 // GET /api/clouds/:cloud_id/security_groups/:id
 //
 // Currently not implemented.
-func (loc *SecurityGroupLocator) Show(options rsapi.APIParams) error {
+func (loc *SecurityGroupLocator) Show(options *SecurityGroupShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SecurityGroup", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("SecurityGroup", "show")
 	if err != nil {
 		return err
@@ -1606,15 +2810,10 @@ func (loc *SecurityGroupLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1653,6 +2852,42 @@ func (r *Server) Locator(api *API) *ServerLocator {
 	return api.ServerLocator(r.Href)
 }
 
+// AccountLocator returns a locator for the resource pointed to by the "account" link.
+// It returns nil if the link is absent.
+func (r *Server) AccountLocator(api *API) *AccountLocator {
+	if r.Links == nil || r.Links.Account == nil || r.Links.Account.Href == "" {
+		return nil
+	}
+	return api.AccountLocator(r.Links.Account.Href)
+}
+
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *Server) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
+// CurrentInstanceLocator returns a locator for the resource pointed to by the "current_instance" link.
+// It returns nil if the link is absent.
+func (r *Server) CurrentInstanceLocator(api *API) *InstanceLocator {
+	if r.Links == nil || r.Links.CurrentInstance == nil || r.Links.CurrentInstance.Href == "" {
+		return nil
+	}
+	return api.InstanceLocator(r.Links.CurrentInstance.Href)
+}
+
+// NextInstanceLocator returns a locator for the resource pointed to by the "next_instance" link.
+// It returns nil if the link is absent.
+func (r *Server) NextInstanceLocator(api *API) *InstanceLocator {
+	if r.Links == nil || r.Links.NextInstance == nil || r.Links.NextInstance.Href == "" {
+		return nil
+	}
+	return api.InstanceLocator(r.Links.NextInstance.Href)
+}
+
 //===== Locator
 
 // ServerLocator exposes the Server resource actions.
@@ -1666,19 +2901,62 @@ func (api *API) ServerLocator(href string) *ServerLocator {
 	return &ServerLocator{Href(href), api}
 }
 
+// ServerLocatorInterface lists the ServerLocator methods, code that depends on the interface
+// rather than on ServerLocator can be unit tested using the generated fake locators.
+type ServerLocatorInterface interface {
+	Index(options *ServerIndexOptions) error
+	Show(options *ServerShowOptions) error
+}
+
 //===== Actions
 
+// ServerIndexOptions contains the optional parameters of ServerLocator.Index.
+type ServerIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/servers
 //
 // Currently not implemented.
-func (loc *ServerLocator) Index(options rsapi.APIParams) error {
+func (loc *ServerLocator) Index(options *ServerIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Server", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Server", "index")
 	if err != nil {
 		return err
@@ -1691,29 +2969,60 @@ func (loc *ServerLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ServerShowOptions contains the optional parameters of ServerLocator.Show.
+type ServerShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/servers/:id
 //
 // Currently not implemented.
-func (loc *ServerLocator) Show(options rsapi.APIParams) error {
+func (loc *ServerLocator) Show(options *ServerShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Server", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Server", "show")
 	if err != nil {
 		return err
@@ -1726,15 +3035,10 @@ func (loc *ServerLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1769,6 +3073,33 @@ func (r *ServerArray) Locator(api *API) *ServerArrayLocator {
 	return api.ServerArrayLocator(r.Href)
 }
 
+// AccountLocator returns a locator for the resource pointed to by the "account" link.
+// It returns nil if the link is absent.
+func (r *ServerArray) AccountLocator(api *API) *AccountLocator {
+	if r.Links == nil || r.Links.Account == nil || r.Links.Account.Href == "" {
+		return nil
+	}
+	return api.AccountLocator(r.Links.Account.Href)
+}
+
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *ServerArray) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
+// NextInstanceLocator returns a locator for the resource pointed to by the "next_instance" link.
+// It returns nil if the link is absent.
+func (r *ServerArray) NextInstanceLocator(api *API) *InstanceLocator {
+	if r.Links == nil || r.Links.NextInstance == nil || r.Links.NextInstance.Href == "" {
+		return nil
+	}
+	return api.InstanceLocator(r.Links.NextInstance.Href)
+}
+
 //===== Locator
 
 // ServerArrayLocator exposes the ServerArray resource actions.
@@ -1782,19 +3113,62 @@ func (api *API) ServerArrayLocator(href string) *ServerArrayLocator {
 	return &ServerArrayLocator{Href(href), api}
 }
 
+// ServerArrayLocatorInterface lists the ServerArrayLocator methods, code that depends on the interface
+// rather than on ServerArrayLocator can be unit tested using the generated fake locators.
+type ServerArrayLocatorInterface interface {
+	Index(options *ServerArrayIndexOptions) error
+	Show(options *ServerArrayShowOptions) error
+}
+
 //===== Actions
 
+// ServerArrayIndexOptions contains the optional parameters of ServerArrayLocator.Index.
+type ServerArrayIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerArrayIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerArrayLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/server_arrays
 //
 // Currently not implemented.
-func (loc *ServerArrayLocator) Index(options rsapi.APIParams) error {
+func (loc *ServerArrayLocator) Index(options *ServerArrayIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerArray", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerArray", "index")
 	if err != nil {
 		return err
@@ -1807,29 +3181,60 @@ func (loc *ServerArrayLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ServerArrayShowOptions contains the optional parameters of ServerArrayLocator.Show.
+type ServerArrayShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerArrayShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerArrayLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/server_arrays/:id
 //
 // Currently not implemented.
-func (loc *ServerArrayLocator) Show(options rsapi.APIParams) error {
+func (loc *ServerArrayLocator) Show(options *ServerArrayShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerArray", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerArray", "show")
 	if err != nil {
 		return err
@@ -1842,15 +3247,10 @@ func (loc *ServerArrayLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1892,19 +3292,62 @@ func (api *API) ServerTemplateLocator(href string) *ServerTemplateLocator {
 	return &ServerTemplateLocator{Href(href), api}
 }
 
+// ServerTemplateLocatorInterface lists the ServerTemplateLocator methods, code that depends on the interface
+// rather than on ServerTemplateLocator can be unit tested using the generated fake locators.
+type ServerTemplateLocatorInterface interface {
+	Index(options *ServerTemplateIndexOptions) error
+	Show(options *ServerTemplateShowOptions) error
+}
+
 //===== Actions
 
+// ServerTemplateIndexOptions contains the optional parameters of ServerTemplateLocator.Index.
+type ServerTemplateIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerTemplateIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerTemplateLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/server_templates
 //
 // Currently not implemented.
-func (loc *ServerTemplateLocator) Index(options rsapi.APIParams) error {
+func (loc *ServerTemplateLocator) Index(options *ServerTemplateIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplate", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "index")
 	if err != nil {
 		return err
@@ -1917,29 +3360,60 @@ func (loc *ServerTemplateLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ServerTemplateShowOptions contains the optional parameters of ServerTemplateLocator.Show.
+type ServerTemplateShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ServerTemplateShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ServerTemplateLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/server_templates/:id
 //
 // Currently not implemented.
-func (loc *ServerTemplateLocator) Show(options rsapi.APIParams) error {
+func (loc *ServerTemplateLocator) Show(options *ServerTemplateShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ServerTemplate", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ServerTemplate", "show")
 	if err != nil {
 		return err
@@ -1952,15 +3426,10 @@ func (loc *ServerTemplateLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1994,20 +3463,63 @@ func (api *API) SshKeyLocator(href string) *SshKeyLocator {
 	return &SshKeyLocator{Href(href), api}
 }
 
+// SshKeyLocatorInterface lists the SshKeyLocator methods, code that depends on the interface
+// rather than on SshKeyLocator can be unit tested using the generated fake locators.
+type SshKeyLocatorInterface interface {
+	Index(options *SshKeyIndexOptions) error
+	Show(options *SshKeyShowOptions) error
+}
+
 //===== Actions
 
+// SshKeyIndexOptions contains the optional parameters of SshKeyLocator.Index.
+type SshKeyIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SshKeyIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SshKeyLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/ssh_keys
 // GET /api/clouds/:cloud_id/ssh_keys
 //
 // Currently not implemented.
-func (loc *SshKeyLocator) Index(options rsapi.APIParams) error {
+func (loc *SshKeyLocator) Index(options *SshKeyIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SshKey", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("SshKey", "index")
 	if err != nil {
 		return err
@@ -2020,30 +3532,61 @@ func (loc *SshKeyLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// SshKeyShowOptions contains the optional parameters of SshKeyLocator.Show.
+type SshKeyShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SshKeyShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SshKeyLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/ssh_keys/:id
 // GET /api/clouds/:cloud_id/ssh_keys/:id
 //
 // Currently not implemented.
-func (loc *SshKeyLocator) Show(options rsapi.APIParams) error {
+func (loc *SshKeyLocator) Show(options *SshKeyShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("SshKey", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("SshKey", "show")
 	if err != nil {
 		return err
@@ -2056,15 +3599,10 @@ func (loc *SshKeyLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -2087,6 +3625,15 @@ func (r *Subnet) Locator(api *API) *SubnetLocator {
 	return api.SubnetLocator(r.Href)
 }
 
+// CloudLocator returns a locator for the resource pointed to by the "cloud" link.
+// It returns nil if the link is absent.
+func (r *Subnet) CloudLocator(api *API) *CloudLocator {
+	if r.Links == nil || r.Links.Cloud == nil || r.Links.Cloud.Href == "" {
+		return nil
+	}
+	return api.CloudLocator(r.Links.Cloud.Href)
+}
+
 //===== Locator
 
 // SubnetLocator exposes the Subnet resource actions.
@@ -2100,21 +3647,64 @@ func (api *API) SubnetLocator(href string) *SubnetLocator {
 	return &SubnetLocator{Href(href), api}
 }
 
+// SubnetLocatorInterface lists the SubnetLocator methods, code that depends on the interface
+// rather than on SubnetLocator can be unit tested using the generated fake locators.
+type SubnetLocatorInterface interface {
+	Index(options *SubnetIndexOptions) error
+	Show(options *SubnetShowOptions) error
+}
+
 //===== Actions
 
+// SubnetIndexOptions contains the optional parameters of SubnetLocator.Index.
+type SubnetIndexOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SubnetIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SubnetLocator.Index", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/subnets
 // GET /api/clouds/:cloud_id/subnets
 // GET /api/clouds/:cloud_id/instances/:instance_id/subnets
 //
 // Currently not implemented.
-func (loc *SubnetLocator) Index(options rsapi.APIParams) error {
+func (loc *SubnetLocator) Index(options *SubnetIndexOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Subnet", "index", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Subnet", "index")
 	if err != nil {
 		return err
@@ -2127,30 +3717,61 @@ func (loc *SubnetLocator) Index(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// SubnetShowOptions contains the optional parameters of SubnetLocator.Show.
+type SubnetShowOptions struct {
+	// The view to use to render this resource
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *SubnetShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of SubnetLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /api/subnets/:id
 // GET /api/clouds/:cloud_id/subnets/:id
 //
 // Currently not implemented.
-func (loc *SubnetLocator) Show(options rsapi.APIParams) error {
+func (loc *SubnetLocator) Show(options *SubnetShowOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Subnet", "show", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Subnet", "show")
 	if err != nil {
 		return err
@@ -2163,15 +3784,10 @@ func (loc *SubnetLocator) Show(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -2334,13 +3950,13 @@ type InstancesLinksDeploymentServerArraysInstanceSummaryStruct struct {
 }
 
 type InstancesTimestampsStruct struct {
-	BootedAt      *time.Time `json:"booted_at,omitempty"`
-	CreatedAt     *time.Time `json:"created_at,omitempty"`
-	OperationalAt *time.Time `json:"operational_at,omitempty"`
-	PendingAt     *time.Time `json:"pending_at,omitempty"`
-	StrandedAt    *time.Time `json:"stranded_at,omitempty"`
-	TerminatedAt  *time.Time `json:"terminated_at,omitempty"`
-	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+	BootedAt      *rsapi.Time `json:"booted_at,omitempty"`
+	CreatedAt     *rsapi.Time `json:"created_at,omitempty"`
+	OperationalAt *rsapi.Time `json:"operational_at,omitempty"`
+	PendingAt     *rsapi.Time `json:"pending_at,omitempty"`
+	StrandedAt    *rsapi.Time `json:"stranded_at,omitempty"`
+	TerminatedAt  *rsapi.Time `json:"terminated_at,omitempty"`
+	UpdatedAt     *rsapi.Time `json:"updated_at,omitempty"`
 }
 
 type IpAddressBindingLinks struct {
@@ -2474,11 +4090,11 @@ type SubnetLinks struct {
 }
 
 type TimestampsStruct struct {
-	BootedAt      *time.Time `json:"booted_at,omitempty"`
-	CreatedAt     *time.Time `json:"created_at,omitempty"`
-	OperationalAt *time.Time `json:"operational_at,omitempty"`
-	PendingAt     *time.Time `json:"pending_at,omitempty"`
-	StrandedAt    *time.Time `json:"stranded_at,omitempty"`
-	TerminatedAt  *time.Time `json:"terminated_at,omitempty"`
-	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+	BootedAt      *rsapi.Time `json:"booted_at,omitempty"`
+	CreatedAt     *rsapi.Time `json:"created_at,omitempty"`
+	OperationalAt *rsapi.Time `json:"operational_at,omitempty"`
+	PendingAt     *rsapi.Time `json:"pending_at,omitempty"`
+	StrandedAt    *rsapi.Time `json:"stranded_at,omitempty"`
+	TerminatedAt  *rsapi.Time `json:"terminated_at,omitempty"`
+	UpdatedAt     *rsapi.Time `json:"updated_at,omitempty"`
 }
@@ -1,33 +1,49 @@
 package cmd
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
 
 // CommandLine contains the command name and top level flags.
 // API clients register additional sub-commands with their own flags
 // This data structure is created by rsc and given to each API client command line tool for
 // processing.
 type CommandLine struct {
-	Command             string // Command to be run (e.g. "api15 index")
-	ConfigPath          string // Path to rsc config file, defaults to $HOME/.rsc
-	JSONSelect          string // jsonselect expression for json subcommand
-	Account             int    // RightScale account, optional
-	Host                string // API hostname, optional
-	OAuthToken          string // Auth refresh token, alternative to Username+Password, OAuthAccessToken, APIToken or RL10
-	OAuthAccessToken    string // Auth access token, alternative to Username+Password, OAuthToken, APIToken or RL10
-	APIToken            string // Instance API token, alternative to Username+Password, OAuthToken or RL10
-	Username            string // Login username, alternative to OAuthToken, APIToken or RL10
-	Password            string // Login pasword, alternative to OAuthToken, APIToken or RL10
-	RL10                bool   // Whether to send requests using the RL10 proxy
-	NoAuth              bool   // Whether to send requests unauthenticated
-	FetchResource       bool   // Whether to fetch resource returned in 'Location' header
-	ExtractOneSelect    string // JSON select expression to extract single value from response, optional
-	ExtractSelector     string // JSON select expression to extract zero or more values from response, optional
-	ExtractSelectorJSON string // JSON select expression to extract zero or more values from response, extracted values are displayed using JSON encoding, optional
-	ExtractHeader       string // Name of header to extract from response, optional
-	Dump                string // Whether to dump raw HTTP request and response to stdout (values are empty string - don't dump, "debug" or "json")
-	Verbose             bool   // Whether to dump auth requests and sensitive headers
-	Pretty              bool   // Whether to display response body or extract values using pretty printer
-	ShowHelp            bool   // Whether to show help for action flags
+	Command             string        // Command to be run (e.g. "api15 index")
+	ConfigPath          string        // Path to rsc config file, defaults to $HOME/.rsc
+	MetadataDir         string        // Path to directory containing API docs loaded at runtime, optional
+	JSONSelect          string        // jsonselect expression for json subcommand
+	Account             int           // RightScale account, optional
+	Host                string        // API hostname, optional
+	OAuthToken          string        // Auth refresh token, alternative to Username+Password, OAuthAccessToken, APIToken or RL10
+	OAuthAccessToken    string        // Auth access token, alternative to Username+Password, OAuthToken, APIToken or RL10
+	APIToken            string        // Instance API token, alternative to Username+Password, OAuthToken or RL10
+	Username            string        // Login username, alternative to OAuthToken, APIToken or RL10
+	Password            string        // Login pasword, alternative to OAuthToken, APIToken or RL10
+	RL10                bool          // Whether to send requests using the RL10 proxy
+	NoAuth              bool          // Whether to send requests unauthenticated
+	FetchResource       bool          // Whether to fetch resource returned in 'Location' header
+	ExtractOneSelect    string        // JSON select expression to extract single value from response, optional
+	ExtractSelector     string        // JSON select expression to extract zero or more values from response, optional
+	ExtractSelectorJSON string        // JSON select expression to extract zero or more values from response, extracted values are displayed using JSON encoding, optional
+	ExtractHeader       string        // Name of header to extract from response, optional
+	Dump                string        // Whether to dump raw HTTP request and response to stdout (values are empty string - don't dump, "debug" or "json")
+	Verbose             bool          // Whether to dump auth requests and sensitive headers
+	Pretty              bool          // Whether to display response body or extract values using pretty printer
+	Output              string        // Path to file response body is written to instead of being displayed, optional
+	ShowHelp            bool          // Whether to show help for action flags
+	Watch               time.Duration // Interval at which to re-run the command and display changes, optional
+	WatchCount          int           // Maximum number of times the command is run when Watch is set, 0 means no limit
+	DiffFrom            string        // Href or path to JSON file of first value to compare for diff subcommand
+	DiffTo              string        // Href or path to JSON file of second value to compare for diff subcommand
+	DiffAPI             string        // Name of API client used to retrieve hrefs for diff subcommand (e.g. "cm15")
+	DiffIgnore          []string      // Names or paths of fields ignored by diff subcommand (e.g. "updated_at")
+	DiffFormat          string        // Output format of diff subcommand, "text" or "json" (JSON patch)
+	PluginName          string        // Name of plugin to run, plugins are executables named rsc-NAME found in PATH
+	PluginArgs          []string      // Command line arguments given to plugin
+	AliasName           string        // Name of alias to add or remove for alias subcommands
+	AliasArgs           []string      // Command line arguments of alias to add
 }
 
 // CommandClient is the common interface between rsc package and API client packages.
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"os/exec"
 	"path"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/rightscale/rsc/cm15"
 	"github.com/rightscale/rsc/cm16"
 	"github.com/rightscale/rsc/cmd"
+	"github.com/rightscale/rsc/dynamic"
 	"github.com/rightscale/rsc/rl10"
 	"github.com/rightscale/rsc/rsapi"
 	"github.com/rightscale/rsc/ss"
@@ -21,11 +23,29 @@ func ParseCommandLine(app *kingpin.Application) (*cmd.CommandLine, error) {
 	// 1. Register all commands
 	app.Command("setup", "create config file, defaults to $HOME/.rsc, use '--config' to override")
 	app.Command("json", "apply jsonselect expression to STDIN")
+	cmdLine := cmd.CommandLine{}
+	diffCmd := app.Command("diff", "show structural differences between two resources or JSON files")
+	diffCmd.Flag("api", "API client used to retrieve resources given by href").Default(Cm15Command).
+		EnumVar(&cmdLine.DiffAPI, Cm15Command, Cm16Command, SsCommand, Rl10Command, CaCommand)
+	diffCmd.Flag("ignore", "Name or path of field to ignore (e.g. 'updated_at' or '.links'), may be repeated").StringsVar(&cmdLine.DiffIgnore)
+	diffCmd.Flag("format", "Output format, 'text' or 'json' (JSON patch)").Default("text").EnumVar(&cmdLine.DiffFormat, "text", "json")
+	diffCmd.Arg("from", "Href of resource, path to JSON file or '-' for STDIN").Required().StringVar(&cmdLine.DiffFrom)
+	diffCmd.Arg("to", "Href of resource, path to JSON file or '-' for STDIN").Required().StringVar(&cmdLine.DiffTo)
+	aliasCmd := app.Command("alias", "manage command aliases stored in config file")
+	aliasCmd.Command("list", "list aliases")
+	aliasAddCmd := aliasCmd.Command("add", "create or update alias, use '--' before arguments that start with '-'")
+	aliasAddCmd.Arg("name", "Alias name").Required().StringVar(&cmdLine.AliasName)
+	aliasAddCmd.Arg("args", "Command line arguments, may include positional ($1, $2...) and named (${name} or ${name:default}) placeholders").Required().StringsVar(&cmdLine.AliasArgs)
+	aliasRemoveCmd := aliasCmd.Command("remove", "remove alias")
+	aliasRemoveCmd.Arg("name", "Alias name").Required().StringVar(&cmdLine.AliasName)
+	pluginCmd := app.Command("plugin", "run rsc-NAME executable found in PATH").Hidden()
+	pluginCmd.Arg("name", "Plugin name").Required().StringVar(&cmdLine.PluginName)
+	pluginCmd.Arg("args", "Plugin arguments").StringsVar(&cmdLine.PluginArgs)
 	RegisterClientCommands(app)
 
 	// 2. Parse flags
-	cmdLine := cmd.CommandLine{}
 	app.Flag("config", "path to rsc config file").Short('c').Default(path.Join(os.Getenv("HOME"), ".rsc")).StringVar(&cmdLine.ConfigPath)
+	app.Flag("metadata", "path to directory containing API docs loaded at runtime, one sub-directory per API, the sub-directory name is the API command").StringVar(&cmdLine.MetadataDir)
 	app.Flag("account", "RightScale account ID").Short('a').IntVar(&cmdLine.Account)
 	app.Flag("host", "RightScale login endpoint (e.g. 'us-3.rightscale.com')").Short('h').StringVar(&cmdLine.Host)
 	app.Flag("email", "Login email, use --email and --password or use --refreshToken, --accessToken, --apiToken or --rl10").StringVar(&cmdLine.Username)
@@ -43,6 +63,9 @@ func ParseCommandLine(app *kingpin.Application) (*cmd.CommandLine, error) {
 	app.Flag("dump", "Dump HTTP request and response. Possible values are 'debug' or 'json'.").EnumVar(&cmdLine.Dump, "debug", "json", "record")
 	app.Flag("verbose", "Dump HTTP request and response including auth requests and headers, enables --dump=debug by default, use --dump=json to switch format").Short('v').BoolVar(&cmdLine.Verbose)
 	app.Flag("pp", "Pretty print response body").BoolVar(&cmdLine.Pretty)
+	app.Flag("output", "Write response body to given file instead of displaying it, the extension matching the response content type is added if the file has none").Short('o').StringVar(&cmdLine.Output)
+	app.Flag("watch", "Re-run command at given interval (e.g. '30s') and only print changes, use Ctrl-C to stop").DurationVar(&cmdLine.Watch)
+	app.Flag("watch-count", "Stop after command has run given number of times when using --watch").IntVar(&cmdLine.WatchCount)
 
 	// Keep around for a few releases for backwards compatibility
 	app.Flag("key", "OAuth refresh token, use --email and --password or use --refreshToken, --accessToken, --apiToken or --rl10").Short('k').Hidden().StringVar(&cmdLine.OAuthToken)
@@ -51,14 +74,35 @@ func ParseCommandLine(app *kingpin.Application) (*cmd.CommandLine, error) {
 	if len(args) == 0 {
 		args = []string{"--help"}
 	}
+	// Register APIs loaded at runtime and expand aliases defined in config file.
+	var aliases map[string][]string
+	var metadataDir string
+	if config, err := LoadConfig(configPathFromArgs(args)); err == nil {
+		aliases = config.Aliases
+		metadataDir = config.MetadataDir
+	}
+	if dir, ok := flagFromArgs(args, "metadata", ""); ok {
+		metadataDir = dir
+	}
+	if metadataDir != "" {
+		if err := registerRuntimeAPIs(app, metadataDir); err != nil {
+			return nil, err
+		}
+	}
+	registerAliases(app, aliases)
+	args, err := expandAliasArgs(app, aliases, args)
+	if err != nil {
+		return nil, err
+	}
+	// Unknown commands are delegated to plugins if there is a corresponding executable.
+	args, isPlugin := pluginArgs(app, args)
 	// This is a bit hacky: basically doing `rsc api15 index clouds --help` results
 	// in a command line that kingpin hijacks. So capture the `--help` try parsing
 	// without it so we can print our own help.
 	lastArgIndex := len(args)
 	help := args[lastArgIndex-1]
 	var cmd string
-	var err error
-	if help == "--help" || help == "-h" || help == "-help" || help == "-?" {
+	if !isPlugin && (help == "--help" || help == "-h" || help == "-help" || help == "-?") {
 		cmdLine.ShowHelp = true
 		lastArgIndex--
 		cmd, err = app.Parse(args[:lastArgIndex])
@@ -89,11 +133,15 @@ func ParseCommandLine(app *kingpin.Application) (*cmd.CommandLine, error) {
 		}
 	}
 	cmdLine.Command = cmd
+	cmdLine.MetadataDir = metadataDir
 
 	// 4. Special RL10 case (auth is handled differently)
 	if strings.Split(cmdLine.Command, " ")[0] == "rl10" {
 		cmdLine.RL10 = true
 	}
+	if cmdLine.Command == "diff" && cmdLine.DiffAPI == Rl10Command {
+		cmdLine.RL10 = true
+	}
 
 	// 6. Validate we have everything we need
 	validateCommandLine(&cmdLine)
@@ -104,13 +152,22 @@ func ParseCommandLine(app *kingpin.Application) (*cmd.CommandLine, error) {
 
 // Make sure all the required information is there
 func validateCommandLine(cmdLine *cmd.CommandLine) {
+	if cmdLine.Output != "" && (cmdLine.ExtractOneSelect != "" || cmdLine.ExtractSelector != "" ||
+		cmdLine.ExtractSelectorJSON != "" || cmdLine.ExtractHeader != "" || cmdLine.Pretty || cmdLine.Watch > 0) {
+		kingpin.Fatalf("--output cannot be used with --x1, --xm, --xj, --xh, --pp or --watch")
+	}
 	if cmdLine.Command == "setup" ||
 		cmdLine.Command == "actions" ||
 		cmdLine.Command == "json" ||
+		cmdLine.Command == "plugin" ||
+		strings.HasPrefix(cmdLine.Command, "alias ") ||
 		cmdLine.ShowHelp ||
 		cmdLine.RL10 {
 		return
 	}
+	if cmdLine.Command == "diff" && !isDiffHref(cmdLine.DiffFrom) && !isDiffHref(cmdLine.DiffTo) {
+		return // Comparing files does not require API access
+	}
 	if cmdLine.Account == 0 && cmdLine.OAuthToken == "" && cmdLine.OAuthAccessToken == "" && cmdLine.APIToken == "" && !cmdLine.NoAuth {
 		kingpin.Fatalf("missing --account option")
 	}
@@ -122,6 +179,25 @@ func validateCommandLine(cmdLine *cmd.CommandLine) {
 	}
 }
 
+// PluginPrefix is the prefix of the names of the executables that implement plugin commands.
+const PluginPrefix = "rsc-"
+
+// pluginArgs checks whether the command named on the command line is an unknown command for
+// which there is a plugin executable in PATH. If so it rewrites the command line so that it
+// invokes the hidden "plugin" command with the plugin arguments left untouched and returns true.
+func pluginArgs(app *kingpin.Application, args []string) ([]string, bool) {
+	i := commandIndex(args)
+	if i < 0 || app.GetCommand(args[i]) != nil {
+		return args, false
+	}
+	if _, err := exec.LookPath(PluginPrefix + args[i]); err != nil {
+		return args, false
+	}
+	newArgs := append([]string{}, args[:i]...)
+	newArgs = append(newArgs, "plugin", args[i], "--")
+	return append(newArgs, args[i+1:]...), true
+}
+
 // Update the code below when adding new clients. This is the only place that needs to be changed.
 
 // List all client commands below
@@ -142,6 +218,35 @@ const (
 	CaCommand = "ca"
 )
 
+// isBuiltinCommand returns true if the given name is the name of a rsc command (as opposed to an
+// alias or a plugin).
+func isBuiltinCommand(name string) bool {
+	switch name {
+	case "setup", "json", "diff", "alias", "plugin", "help",
+		Cm15Command, Cm16Command, SsCommand, Rl10Command, CaCommand:
+		return true
+	}
+	_, ok := runtimeAPIs[name]
+	return ok
+}
+
+// APIVersion returns the value of the X-API-Version header sent by the client with the given name.
+func APIVersion(name string) string {
+	switch name {
+	case Cm15Command:
+		return "1.5"
+	case Cm16Command:
+		return "1.6"
+	case SsCommand, CaCommand:
+		return "1.0"
+	default:
+		if def, ok := runtimeAPIs[name]; ok {
+			return def.Version
+		}
+		return ""
+	}
+}
+
 // APIClient instantiates a client with the given name from command line arguments.
 func APIClient(name string, cmdLine *cmd.CommandLine) (cmd.CommandClient, error) {
 	switch name {
@@ -156,6 +261,9 @@ func APIClient(name string, cmdLine *cmd.CommandLine) (cmd.CommandClient, error)
 	case CaCommand:
 		return ca.FromCommandLine(cmdLine)
 	default:
+		if def, ok := runtimeAPIs[name]; ok {
+			return def.FromCommandLine(cmdLine)
+		}
 		return nil, fmt.Errorf("No client for '%s'", name)
 	}
 }
@@ -182,3 +290,25 @@ func RegisterClientCommands(app *kingpin.Application) {
 	registrar = rsapi.Registrar{APICmd: caCmd}
 	ca.RegisterCommands(&registrar)
 }
+
+// runtimeAPIs contains the definitions of the APIs loaded at runtime from the directory given with
+// --metadata or in the config file indexed by command name.
+var runtimeAPIs = make(map[string]*dynamic.Definition)
+
+// registerRuntimeAPIs loads the docs of the APIs found in the given directory and registers a
+// command for each API.
+func registerRuntimeAPIs(app *kingpin.Application, dir string) error {
+	defs, err := dynamic.LoadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, def := range defs {
+		if app.GetCommand(def.Name) != nil {
+			return fmt.Errorf("Failed to load API from %s: '%s' is already a command", dir, def.Name)
+		}
+		apiCmd := app.Command(def.Name, fmt.Sprintf("%s (loaded from %s)", def.Title, dir))
+		def.RegisterCommands(&rsapi.Registrar{APICmd: apiCmd})
+		runtimeAPIs[def.Name] = def
+	}
+	return nil
+}
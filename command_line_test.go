@@ -1,13 +1,17 @@
 package main
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/rightscale/rsc/cm15"
 	"github.com/rightscale/rsc/cm16"
 	"github.com/rightscale/rsc/cmd"
+	"github.com/rightscale/rsc/dynamic"
 	"github.com/rightscale/rsc/ss"
 	"gopkg.in/alecthomas/kingpin.v2"
 )
@@ -124,6 +128,74 @@ var _ = Describe("Command line parsing", func() {
 					Ω(cmdLine.Pretty).Should(BeTrue())
 				})
 			})
+
+			Context("with watch", func() {
+				BeforeEach(func() {
+					args = append([]string{"--watch=10s", "--watch-count=3"}, args...)
+				})
+
+				It("initializes the command line struct", func() {
+					Ω(err).ShouldNot(HaveOccurred())
+					Ω(cmdLine.Watch).Should(Equal(10 * time.Second))
+					Ω(cmdLine.WatchCount).Should(Equal(3))
+				})
+			})
+
+			Context("with output", func() {
+				BeforeEach(func() {
+					args = append([]string{"-o", "detail.txt"}, args...)
+				})
+
+				It("initializes the command line struct", func() {
+					Ω(err).ShouldNot(HaveOccurred())
+					Ω(cmdLine.Output).Should(Equal("detail.txt"))
+				})
+			})
+		})
+
+		Context("using diff", func() {
+			BeforeEach(func() {
+				args = []string{"--noAuth", "--host=h", "diff", "--ignore=updated_at", "--ignore=links", "--format=json",
+					"/api/server_templates/1", "/api/server_templates/2"}
+			})
+
+			It("initializes the command line struct", func() {
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(cmdLine.Command).Should(Equal("diff"))
+				Ω(cmdLine.DiffFrom).Should(Equal("/api/server_templates/1"))
+				Ω(cmdLine.DiffTo).Should(Equal("/api/server_templates/2"))
+				Ω(cmdLine.DiffAPI).Should(Equal(Cm15Command))
+				Ω(cmdLine.DiffIgnore).Should(Equal([]string{"updated_at", "links"}))
+				Ω(cmdLine.DiffFormat).Should(Equal("json"))
+			})
+		})
+
+		Context("with --metadata", func() {
+			var dir string
+
+			BeforeEach(func() {
+				var err error
+				dir, err = ioutil.TempDir("", "rsc-metadata")
+				Ω(err).ShouldNot(HaveOccurred())
+				docs, err := filepath.Abs("rl10/docs/api")
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(os.Symlink(docs, filepath.Join(dir, "agent"))).Should(Succeed())
+				args = []string{"--noAuth", "--host=h", "--metadata", dir, "agent", "index", "/rll/env"}
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(dir)
+			})
+
+			It("registers the API loaded at runtime", func() {
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(cmdLine.Command).Should(Equal("agent index"))
+				Ω(cmdLine.MetadataDir).Should(Equal(dir))
+				client, err := APIClient("agent", cmdLine)
+				Ω(err).ShouldNot(HaveOccurred())
+				_, ok := client.(*dynamic.API)
+				Ω(ok).Should(BeTrue())
+			})
 		})
 
 		Context("creating a client", func() {
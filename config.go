@@ -9,10 +9,12 @@ import (
 
 // ClientConfig is the basic configuration settings required by all clients.
 type ClientConfig struct {
-	Account   int    // RightScale account ID
-	LoginHost string // RightScale API login host, e.g. "us-3.rightscale.com"
-	Email     string // RightScale API login email
-	Password  string // RightScale API login password
+	Account     int                 // RightScale account ID
+	LoginHost   string              // RightScale API login host, e.g. "us-3.rightscale.com"
+	Email       string              // RightScale API login email
+	Password    string              // RightScale API login password
+	Aliases     map[string][]string `json:",omitempty"` // Command line aliases indexed by name
+	MetadataDir string              `json:",omitempty"` // Directory containing API docs loaded at runtime
 }
 
 // LoadConfig loads the client configuration from disk
@@ -0,0 +1,369 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/rightscale/rsc/cmd"
+	"github.com/rightscale/rsc/rsapi"
+)
+
+// Change operations
+const (
+	// ChangeAdd indicates a value that only exists in the new JSON document.
+	ChangeAdd = "+"
+	// ChangeRemove indicates a value that only exists in the old JSON document.
+	ChangeRemove = "-"
+	// ChangeUpdate indicates a value that exists in both documents but differs.
+	ChangeUpdate = "~"
+)
+
+// Change describes a single structural difference between two JSON values.
+type Change struct {
+	Op      string      // One of ChangeAdd, ChangeRemove or ChangeUpdate
+	Path    string      // Path to value, e.g. `[/api/servers/1].name` or `.inputs[2]`
+	Pointer string      // JSON pointer (RFC 6901) to value, e.g. "/0/name" or "/inputs/2"
+	Old     interface{} // Old value, nil if Op is ChangeAdd
+	New     interface{} // New value, nil if Op is ChangeRemove
+}
+
+// differ holds the state of a diff computation.
+type differ struct {
+	ignore  []string  // Names or paths of fields that should not be compared
+	changes []*Change // Changes found so far
+}
+
+// DiffJSON computes the structural differences between two JSON values as returned by
+// json.Unmarshal. Arrays whose elements are all RightScale resources (objects with a "self"
+// link) are compared by matching elements using their self href, other arrays are compared
+// element by element.
+// ignore lists fields that should be skipped, a field is skipped if its name or its path (e.g.
+// ".links" or "[/api/servers/1].updated_at") is listed.
+// Changes are ordered so that applying them in sequence to the from value produces the to value
+// (modulo the order of elements in arrays of resources where new elements are appended).
+func DiffJSON(from, to interface{}, ignore ...string) []*Change {
+	d := differ{ignore: ignore}
+	d.diffValues("", "", from, to)
+	return d.changes
+}
+
+// JSONPatch returns the JSON patch (RFC 6902) operations corresponding to the given changes.
+func JSONPatch(changes []*Change) []map[string]interface{} {
+	ops := make([]map[string]interface{}, len(changes))
+	for i, c := range changes {
+		switch c.Op {
+		case ChangeAdd:
+			ops[i] = map[string]interface{}{"op": "add", "path": c.Pointer, "value": c.New}
+		case ChangeRemove:
+			ops[i] = map[string]interface{}{"op": "remove", "path": c.Pointer}
+		default:
+			ops[i] = map[string]interface{}{"op": "replace", "path": c.Pointer, "value": c.New}
+		}
+	}
+	return ops
+}
+
+// String returns a human friendly representation of the change.
+func (c *Change) String() string {
+	path := c.Path
+	if path == "" {
+		path = "."
+	}
+	switch c.Op {
+	case ChangeAdd:
+		return fmt.Sprintf("+ %s: %s", path, compactJSON(c.New))
+	case ChangeRemove:
+		return fmt.Sprintf("- %s: %s", path, compactJSON(c.Old))
+	default:
+		return fmt.Sprintf("~ %s: %s => %s", path, compactJSON(c.Old), compactJSON(c.New))
+	}
+}
+
+// diffValues records the differences between from and to.
+func (d *differ) diffValues(path, pointer string, from, to interface{}) {
+	switch f := from.(type) {
+	case map[string]interface{}:
+		if t, ok := to.(map[string]interface{}); ok {
+			d.diffObjects(path, pointer, f, t)
+			return
+		}
+	case []interface{}:
+		if t, ok := to.([]interface{}); ok {
+			d.diffArrays(path, pointer, f, t)
+			return
+		}
+	default:
+		if from == to {
+			return
+		}
+	}
+	d.record(ChangeUpdate, path, pointer, from, to)
+}
+
+// diffObjects records the differences between two JSON objects.
+func (d *differ) diffObjects(path, pointer string, from, to map[string]interface{}) {
+	keys := make([]string, 0, len(from)+len(to))
+	for k := range from {
+		keys = append(keys, k)
+	}
+	for k := range to {
+		if _, ok := from[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		p := path + "." + k
+		if d.ignored(k, p) {
+			continue
+		}
+		ptr := pointer + "/" + escapePointer(k)
+		f, inFrom := from[k]
+		t, inTo := to[k]
+		switch {
+		case !inTo:
+			d.record(ChangeRemove, p, ptr, f, nil)
+		case !inFrom:
+			d.record(ChangeAdd, p, ptr, nil, t)
+		default:
+			d.diffValues(p, ptr, f, t)
+		}
+	}
+}
+
+// diffArrays records the differences between two JSON arrays.
+// Removals are recorded in decreasing index order after updates so that the corresponding JSON
+// pointers remain valid when the changes are applied in sequence.
+func (d *differ) diffArrays(path, pointer string, from, to []interface{}) {
+	fromKeys, fromOK := selfHrefs(from)
+	toKeys, toOK := selfHrefs(to)
+	if !fromOK || !toOK {
+		for i := 0; i < len(from) && i < len(to); i++ {
+			d.diffValues(fmt.Sprintf("%s[%d]", path, i), fmt.Sprintf("%s/%d", pointer, i), from[i], to[i])
+		}
+		for i := len(from) - 1; i >= len(to); i-- {
+			d.record(ChangeRemove, fmt.Sprintf("%s[%d]", path, i), fmt.Sprintf("%s/%d", pointer, i), from[i], nil)
+		}
+		for i := len(from); i < len(to); i++ {
+			d.record(ChangeAdd, fmt.Sprintf("%s[%d]", path, i), fmt.Sprintf("%s/%d", pointer, i), nil, to[i])
+		}
+		return
+	}
+	toIndex := make(map[string]int, len(toKeys))
+	for i, k := range toKeys {
+		toIndex[k] = i
+	}
+	fromIndex := make(map[string]int, len(fromKeys))
+	var removed []int
+	for i, k := range fromKeys {
+		fromIndex[k] = i
+		if j, ok := toIndex[k]; ok {
+			d.diffValues(fmt.Sprintf("%s[%s]", path, k), fmt.Sprintf("%s/%d", pointer, i), from[i], to[j])
+		} else {
+			removed = append(removed, i)
+		}
+	}
+	for i := len(removed) - 1; i >= 0; i-- {
+		idx := removed[i]
+		d.record(ChangeRemove, fmt.Sprintf("%s[%s]", path, fromKeys[idx]), fmt.Sprintf("%s/%d", pointer, idx), from[idx], nil)
+	}
+	for j, k := range toKeys {
+		if _, ok := fromIndex[k]; !ok {
+			d.record(ChangeAdd, fmt.Sprintf("%s[%s]", path, k), pointer+"/-", nil, to[j])
+		}
+	}
+}
+
+// record appends a change to the list of changes.
+func (d *differ) record(op, path, pointer string, from, to interface{}) {
+	d.changes = append(d.changes, &Change{Op: op, Path: path, Pointer: pointer, Old: from, New: to})
+}
+
+// ignored returns true if the field with the given name and path should not be compared.
+func (d *differ) ignored(name, path string) bool {
+	for _, i := range d.ignore {
+		if i == name || i == path {
+			return true
+		}
+	}
+	return false
+}
+
+// escapePointer escapes a JSON pointer reference token as described in RFC 6901.
+func escapePointer(token string) string {
+	return strings.Replace(strings.Replace(token, "~", "~0", -1), "/", "~1", -1)
+}
+
+// selfHrefs returns the hrefs of the "self" links of all the elements of the given array.
+// The boolean return value is false if any of the elements is not a resource with a unique self
+// link.
+func selfHrefs(elems []interface{}) ([]string, bool) {
+	hrefs := make([]string, len(elems))
+	seen := make(map[string]bool, len(elems))
+	for i, e := range elems {
+		href := selfHref(e)
+		if href == "" || seen[href] {
+			return nil, false
+		}
+		seen[href] = true
+		hrefs[i] = href
+	}
+	return hrefs, true
+}
+
+// selfHref returns the href of the "self" link of the given resource, empty string if there
+// isn't one.
+func selfHref(resource interface{}) string {
+	obj, ok := resource.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	links, ok := obj["links"].([]interface{})
+	if !ok {
+		return ""
+	}
+	for _, l := range links {
+		link, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if link["rel"] == "self" {
+			href, _ := link["href"].(string)
+			return href
+		}
+	}
+	return ""
+}
+
+// compactJSON serializes the given value using compact JSON, it falls back to the default Go
+// formatting if the value cannot be serialized.
+func compactJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// hrefFetcher is implemented by all API clients through the embedded rsapi.API.
+type hrefFetcher interface {
+	BuildHTTPRequest(verb, path, version string, params, payload rsapi.APIParams) (*http.Request, error)
+	PerformRequest(req *http.Request) (*http.Response, error)
+}
+
+// runDiff loads the two values given on the command line and returns a response whose body
+// contains the differences between them so that it can be displayed like any API response.
+// Each value is either read from a JSON file, from STDIN if the argument is "-" or retrieved
+// from the API if the argument is an href.
+func runDiff(cmdLine *cmd.CommandLine) (*http.Response, error) {
+	if cmdLine.DiffFrom == "-" && cmdLine.DiffTo == "-" {
+		return nil, fmt.Errorf("Only one of the values to compare can be read from STDIN")
+	}
+	var fetcher hrefFetcher
+	load := func(arg string) (interface{}, error) {
+		if !isDiffHref(arg) {
+			return loadDiffFile(arg)
+		}
+		if fetcher == nil {
+			clientLine := *cmdLine
+			clientLine.Command = cmdLine.DiffAPI
+			client, err := APIClient(cmdLine.DiffAPI, &clientLine)
+			if err != nil {
+				return nil, err
+			}
+			var ok bool
+			if fetcher, ok = client.(hrefFetcher); !ok {
+				return nil, fmt.Errorf("%s client cannot retrieve resources", cmdLine.DiffAPI)
+			}
+		}
+		return fetchDiffHref(fetcher, APIVersion(cmdLine.DiffAPI), arg)
+	}
+	from, err := load(cmdLine.DiffFrom)
+	if err != nil {
+		return nil, err
+	}
+	to, err := load(cmdLine.DiffTo)
+	if err != nil {
+		return nil, err
+	}
+	changes := DiffJSON(from, to, cmdLine.DiffIgnore...)
+	var body []byte
+	if cmdLine.DiffFormat == "json" {
+		if body, err = json.Marshal(JSONPatch(changes)); err != nil {
+			return nil, fmt.Errorf("Failed to serialize JSON patch: %s", err)
+		}
+	} else {
+		var buffer bytes.Buffer
+		for _, c := range changes {
+			buffer.WriteString(c.String())
+			buffer.WriteString("\n")
+		}
+		body = buffer.Bytes()
+	}
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewBuffer(body)),
+	}, nil
+}
+
+// isDiffHref returns true if the given diff argument should be retrieved from the API, false if
+// it denotes STDIN or a file. Only absolute paths that do not denote an existing file are hrefs so
+// that a mistyped file name does not result in an API request.
+func isDiffHref(arg string) bool {
+	if !strings.HasPrefix(arg, "/") {
+		return false
+	}
+	_, err := os.Stat(arg)
+	return err != nil
+}
+
+// loadDiffFile reads and deserializes the JSON file with the given path, "-" denotes STDIN.
+func loadDiffFile(path string) (interface{}, error) {
+	var b []byte
+	var err error
+	if path == "-" {
+		b, err = ioutil.ReadAll(in)
+	} else {
+		b, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read %s: %s", path, err)
+	}
+	var val interface{}
+	if err := json.Unmarshal(b, &val); err != nil {
+		return nil, fmt.Errorf("Failed to load JSON from %s: %s", path, err)
+	}
+	return val, nil
+}
+
+// fetchDiffHref retrieves the resource with the given href.
+func fetchDiffHref(fetcher hrefFetcher, version, href string) (interface{}, error) {
+	req, err := fetcher.BuildHTTPRequest("GET", href, version, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := fetcher.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read response (%s)", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("Failed to retrieve %s: %s %s", href, resp.Status, string(b))
+	}
+	var val interface{}
+	if err := json.Unmarshal(b, &val); err != nil {
+		return nil, fmt.Errorf("Failed to load response of %s (%s)", href, err)
+	}
+	return val, nil
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/rightscale/rsc/cmd"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DiffJSON", func() {
+	var (
+		from, to string
+		ignore   []string
+		changes  []*Change
+	)
+
+	BeforeEach(func() {
+		ignore = nil
+	})
+
+	JustBeforeEach(func() {
+		var f, t interface{}
+		Ω(json.Unmarshal([]byte(from), &f)).ShouldNot(HaveOccurred())
+		Ω(json.Unmarshal([]byte(to), &t)).ShouldNot(HaveOccurred())
+		changes = DiffJSON(f, t, ignore...)
+	})
+
+	Context("with identical values", func() {
+		BeforeEach(func() {
+			from = `{"name":"foo","tags":["a","b"]}`
+			to = from
+		})
+
+		It("returns no change", func() {
+			Ω(changes).Should(BeEmpty())
+		})
+	})
+
+	Context("with objects", func() {
+		BeforeEach(func() {
+			from = `{"name":"foo","state":"pending","old":1}`
+			to = `{"name":"foo","state":"operational","new":true}`
+		})
+
+		It("returns changed, added and removed fields", func() {
+			Ω(changes).Should(HaveLen(3))
+			Ω(changes[0]).Should(Equal(&Change{Op: ChangeAdd, Path: ".new", Pointer: "/new", New: true}))
+			Ω(changes[1]).Should(Equal(&Change{Op: ChangeRemove, Path: ".old", Pointer: "/old", Old: float64(1)}))
+			Ω(changes[2]).Should(Equal(&Change{Op: ChangeUpdate, Path: ".state", Pointer: "/state", Old: "pending", New: "operational"}))
+		})
+
+		It("formats the changes", func() {
+			Ω(changes[0].String()).Should(Equal(`+ .new: true`))
+			Ω(changes[1].String()).Should(Equal(`- .old: 1`))
+			Ω(changes[2].String()).Should(Equal(`~ .state: "pending" => "operational"`))
+		})
+
+		It("produces a JSON patch", func() {
+			patch, err := json.Marshal(JSONPatch(changes))
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(patch).Should(MatchJSON(`[{"op":"add","path":"/new","value":true},` +
+				`{"op":"remove","path":"/old"},` +
+				`{"op":"replace","path":"/state","value":"operational"}]`))
+		})
+
+		Context("with ignored fields", func() {
+			BeforeEach(func() {
+				ignore = []string{"state", ".old"}
+			})
+
+			It("skips the ignored fields", func() {
+				Ω(changes).Should(HaveLen(1))
+				Ω(changes[0].Path).Should(Equal(".new"))
+			})
+		})
+	})
+
+	Context("with arrays of resources", func() {
+		BeforeEach(func() {
+			from = `[{"state":"booting","links":[{"rel":"self","href":"/api/instances/1"}]},
+			         {"state":"operational","links":[{"rel":"self","href":"/api/instances/2"}]}]`
+			to = `[{"state":"operational","links":[{"rel":"self","href":"/api/instances/3"}]},
+			       {"state":"operational","links":[{"rel":"self","href":"/api/instances/1"}]}]`
+		})
+
+		It("matches elements using their self href", func() {
+			Ω(changes).Should(HaveLen(3))
+			Ω(changes[0].Op).Should(Equal(ChangeUpdate))
+			Ω(changes[0].Path).Should(Equal("[/api/instances/1].state"))
+			Ω(changes[1].Op).Should(Equal(ChangeRemove))
+			Ω(changes[1].Path).Should(Equal("[/api/instances/2]"))
+			Ω(changes[2].Op).Should(Equal(ChangeAdd))
+			Ω(changes[2].Path).Should(Equal("[/api/instances/3]"))
+		})
+
+		It("uses array indices in JSON pointers", func() {
+			Ω(changes[0].Pointer).Should(Equal("/0/state"))
+			Ω(changes[1].Pointer).Should(Equal("/1"))
+			Ω(changes[2].Pointer).Should(Equal("/-"))
+		})
+	})
+
+	Context("with arrays of values", func() {
+		BeforeEach(func() {
+			from = `["a","b"]`
+			to = `["a","c","d"]`
+		})
+
+		It("compares elements by index", func() {
+			Ω(changes).Should(HaveLen(2))
+			Ω(changes[0]).Should(Equal(&Change{Op: ChangeUpdate, Path: "[1]", Pointer: "/1", Old: "b", New: "c"}))
+			Ω(changes[1]).Should(Equal(&Change{Op: ChangeAdd, Path: "[2]", Pointer: "/2", New: "d"}))
+		})
+	})
+})
+
+var _ = Describe("diff command", func() {
+	var (
+		fromFile, toFile *os.File
+		cmdLine          *cmd.CommandLine
+		output           string
+		err              error
+	)
+
+	BeforeEach(func() {
+		fromFile, _ = ioutil.TempFile("", "rsc_diff")
+		toFile, _ = ioutil.TempFile("", "rsc_diff")
+		fromFile.WriteString(`{"name":"st","revision":1,"updated_at":"2015/09/01 10:00:00 +0000"}`)
+		toFile.WriteString(`{"name":"st","revision":2,"updated_at":"2015/09/02 10:00:00 +0000"}`)
+		cmdLine = &cmd.CommandLine{
+			Command:    "diff",
+			DiffFrom:   fromFile.Name(),
+			DiffTo:     toFile.Name(),
+			DiffAPI:    Cm15Command,
+			DiffIgnore: []string{"updated_at"},
+			DiffFormat: "text",
+		}
+	})
+
+	AfterEach(func() {
+		os.Remove(fromFile.Name())
+		os.Remove(toFile.Name())
+	})
+
+	JustBeforeEach(func() {
+		var resp, e = runDiff(cmdLine)
+		err = e
+		if e == nil {
+			var displayer, _ = NewDisplayer(resp)
+			output = displayer.Output()
+		}
+	})
+
+	It("compares files", func() {
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(output).Should(Equal("~ .revision: 1 => 2\n"))
+	})
+
+	Context("with STDIN for both values", func() {
+		BeforeEach(func() {
+			cmdLine.DiffFrom = "-"
+			cmdLine.DiffTo = "-"
+		})
+
+		It("fails", func() {
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("STDIN"))
+		})
+	})
+
+	Context("with a missing relative file", func() {
+		BeforeEach(func() {
+			cmdLine.DiffTo = "missing.json"
+		})
+
+		It("fails to read the file", func() {
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("Failed to read missing.json"))
+		})
+	})
+
+	Context("with the JSON format", func() {
+		BeforeEach(func() {
+			cmdLine.DiffFormat = "json"
+		})
+
+		It("returns a JSON patch", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(output).Should(MatchJSON(`[{"op":"replace","path":"/revision","value":2}]`))
+		})
+	})
+})
@@ -0,0 +1,194 @@
+// Package dynamic implements API clients whose metadata is loaded at runtime from the API docs
+// rather than generated. This makes it possible to use new APIs or new versions of existing APIs
+// without regenerating and rebuilding rsc.
+//
+// The docs are analyzed with the same analyzers as the code generators: a directory containing
+// api_data.json and attributes.json is analyzed like API 1.5 by api15gen and a directory containing
+// index.json is analyzed like a praxis application by praxisgen. The resulting clients rely on the
+// generic rsapi command parsing and request building.
+package dynamic
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/rightscale/rsc/cmd"
+	"github.com/rightscale/rsc/gen"
+	"github.com/rightscale/rsc/gen/api15"
+	"github.com/rightscale/rsc/gen/praxis"
+	"github.com/rightscale/rsc/rsapi"
+)
+
+// Definition describes an API whose metadata was loaded at runtime.
+type Definition struct {
+	Name       string            // API command name, e.g. "policy"
+	Title      string            // API description used in command line help
+	Version    string            // API version sent in the X-API-Version header, e.g. "1.0"
+	HrefPrefix string            // Prefix of all API paths if any, e.g. "/api"
+	Metadata   rsapi.APIMetadata // API resources and actions
+
+	commandValues rsapi.ActionCommands // Parsed command line values
+}
+
+// API is the client for an API loaded at runtime.
+type API struct {
+	*rsapi.API
+	Definition *Definition // Loaded API definition
+}
+
+// LoadDir loads the docs of all the APIs found in the sub-directories of the given directory.
+// The name of each sub-directory is used as the API command name.
+func LoadDir(dir string) ([]*Definition, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read API metadata directory: %s", err)
+	}
+	var defs []*Definition
+	for _, f := range files {
+		if strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+		apiDir := path.Join(dir, f.Name())
+		if fi, err := os.Stat(apiDir); err != nil || !fi.IsDir() { // Follow symlinks
+			continue
+		}
+		def, err := Load(f.Name(), apiDir)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// Load loads and analyzes the API docs found in the given directory. The directory must either
+// contain the API 1.5 style api_data.json and attributes.json files or the praxis index.json file
+// and corresponding resources and types directories. Praxis docs must describe a single version.
+func Load(name, dir string) (*Definition, error) {
+	var d *gen.APIDescriptor
+	if exists(path.Join(dir, "index.json")) {
+		descriptors, err := praxis.LoadDescriptors([]string{dir}, "API", "")
+		if err != nil {
+			return nil, fmt.Errorf("Failed to load %s API: %s", name, err)
+		}
+		var versions []string
+		for v := range descriptors {
+			versions = append(versions, v)
+		}
+		if len(versions) != 1 {
+			sort.Strings(versions)
+			return nil, fmt.Errorf("Failed to load %s API: docs in %s must describe exactly one version, found %d (%s)",
+				name, dir, len(versions), strings.Join(versions, ", "))
+		}
+		d = descriptors[versions[0]]
+	} else if exists(path.Join(dir, "api_data.json")) {
+		var err error
+		if d, err = api15.LoadDescriptor(dir); err != nil {
+			return nil, fmt.Errorf("Failed to load %s API: %s", name, err)
+		}
+	} else {
+		return nil, fmt.Errorf("Failed to load %s API: no index.json or api_data.json file in %s", name, dir)
+	}
+	md, err := d.Metadata()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load %s API: %s", name, err)
+	}
+	version := d.Version
+	if version == "unversioned" {
+		version = ""
+	}
+	title := fmt.Sprintf("%s API", name)
+	if version != "" {
+		title += " " + version
+	}
+	return &Definition{
+		Name:       name,
+		Title:      title,
+		Version:    version,
+		HrefPrefix: hrefPrefix(md),
+		Metadata:   md,
+	}, nil
+}
+
+// RegisterCommands registers the API resource actions commands with the given registrar.
+func (d *Definition) RegisterCommands(registrar rsapi.APICommandRegistrar) {
+	d.commandValues = rsapi.ActionCommands{}
+	registrar.RegisterActionCommands(d.Title, d.Metadata, d.commandValues)
+}
+
+// New returns a client for the API that uses the given authenticator.
+func (d *Definition) New(host string, auth rsapi.Authenticator) *API {
+	return d.fromAPI(rsapi.New(host, auth))
+}
+
+// FromCommandLine builds a client for the API from the command line.
+func (d *Definition) FromCommandLine(cmdLine *cmd.CommandLine) (*API, error) {
+	raw, err := rsapi.FromCommandLine(cmdLine)
+	if err != nil {
+		return nil, err
+	}
+	return d.fromAPI(raw), nil
+}
+
+// Wrap generic client into API client
+func (d *Definition) fromAPI(api *rsapi.API) *API {
+	api.Metadata = d.Metadata
+	return &API{API: api, Definition: d}
+}
+
+// RunCommand parses and runs a command given its name.
+func (a *API) RunCommand(cmd string) (*http.Response, error) {
+	c, err := a.ParseCommand(cmd, a.Definition.HrefPrefix, a.Definition.commandValues)
+	if err != nil {
+		return nil, err
+	}
+	req, err := a.BuildHTTPRequest(c.HTTPMethod, c.URI, a.Definition.Version, c.QueryParams, c.PayloadParams)
+	if err != nil {
+		return nil, err
+	}
+	return a.PerformRequest(req)
+}
+
+// ShowCommandHelp displays a command help given its name.
+func (a *API) ShowCommandHelp(cmd string) error {
+	return a.ShowHelp(cmd, a.Definition.HrefPrefix, a.Definition.commandValues)
+}
+
+// ShowAPIActions displays the command hrefs.
+func (a *API) ShowAPIActions(cmd string) error {
+	return a.ShowActions(cmd, a.Definition.HrefPrefix, a.Definition.commandValues)
+}
+
+// hrefPrefix returns the first path segment shared by all the action paths, e.g. "/api", so
+// that hrefs given on the command line may omit it. It returns an empty string if there is none.
+func hrefPrefix(md rsapi.APIMetadata) string {
+	var prefix string
+	for _, r := range md {
+		for _, a := range r.Actions {
+			for _, p := range a.PathPatterns {
+				elems := strings.SplitN(strings.TrimPrefix(p.Pattern, "/"), "/", 2)
+				if len(elems) < 2 || elems[0] == "%s" {
+					return ""
+				}
+				seg := "/" + elems[0]
+				if prefix == "" {
+					prefix = seg
+				} else if prefix != seg {
+					return ""
+				}
+			}
+		}
+	}
+	return prefix
+}
+
+// exists returns true if there is a file at the given path.
+func exists(p string) bool {
+	_, err := os.Stat(p)
+	return err == nil
+}
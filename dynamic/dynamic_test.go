@@ -0,0 +1,158 @@
+package dynamic_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rightscale/rsc/cm15"
+	"github.com/rightscale/rsc/dynamic"
+	"github.com/rightscale/rsc/httpclient"
+	"github.com/rightscale/rsc/rl10"
+	"github.com/rightscale/rsc/rsapi"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+var _ = Describe("Load", func() {
+	var name, dir string
+
+	var def *dynamic.Definition
+	var loadErr error
+
+	JustBeforeEach(func() {
+		def, loadErr = dynamic.Load(name, dir)
+	})
+
+	Context("with API 1.5 docs", func() {
+		BeforeEach(func() {
+			name = "cm15"
+			dir = "../cm15"
+		})
+
+		It("builds the same metadata as the generated client", func() {
+			Ω(loadErr).ShouldNot(HaveOccurred())
+			Ω(def.Version).Should(Equal("1.5"))
+			Ω(def.HrefPrefix).Should(Equal("/api"))
+			expectSameMetadata(def.Metadata, cm15.GenMetadata)
+		})
+	})
+
+	Context("with praxis docs", func() {
+		BeforeEach(func() {
+			name = "rl10"
+			dir = "../rl10/docs/api"
+		})
+
+		It("builds the same metadata as the generated client", func() {
+			Ω(loadErr).ShouldNot(HaveOccurred())
+			Ω(def.Version).Should(BeEmpty())
+			expectSameMetadata(def.Metadata, rl10.GenMetadata)
+		})
+	})
+
+	Context("with a directory that contains no docs", func() {
+		BeforeEach(func() {
+			name = "foo"
+			dir = "."
+		})
+
+		It("fails", func() {
+			Ω(loadErr).Should(HaveOccurred())
+			Ω(loadErr.Error()).Should(ContainSubstring("no index.json or api_data.json"))
+		})
+	})
+})
+
+var _ = Describe("LoadDir", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "rsc-dynamic")
+		Ω(err).ShouldNot(HaveOccurred())
+		apiDir := path.Join(dir, "cm")
+		Ω(os.Mkdir(apiDir, 0755)).Should(Succeed())
+		for _, f := range []string{"api_data.json", "attributes.json"} {
+			content, err := ioutil.ReadFile(path.Join("../cm15", f))
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(ioutil.WriteFile(path.Join(apiDir, f), content, 0644)).Should(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("loads one API per sub-directory", func() {
+		defs, err := dynamic.LoadDir(dir)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(defs).Should(HaveLen(1))
+		Ω(defs[0].Name).Should(Equal("cm"))
+		Ω(defs[0].Metadata).Should(HaveKey("Cloud"))
+	})
+})
+
+var _ = Describe("RunCommand", func() {
+	var server *httptest.Server
+	var req *http.Request
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req = r
+			w.Write([]byte("[]"))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		httpclient.Insecure = false
+	})
+
+	It("sends the request described by the loaded metadata", func() {
+		def, err := dynamic.Load("cm", "../cm15")
+		Ω(err).ShouldNot(HaveOccurred())
+		app := kingpin.New("rsc", "rsc")
+		def.RegisterCommands(&rsapi.Registrar{APICmd: app.Command("cm", def.Title)})
+		cmd, err := app.Parse([]string{"cm", "index", "/api/clouds", "filter[]=name==foo"})
+		Ω(err).ShouldNot(HaveOccurred())
+
+		httpclient.Insecure = true
+		api := def.New(server.URL, nil)
+		resp, err := api.RunCommand(cmd)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(resp.StatusCode).Should(Equal(200))
+		Ω(req.Method).Should(Equal("GET"))
+		Ω(req.URL.Path).Should(Equal("/api/clouds"))
+		Ω(req.URL.Query()["filter[]"]).Should(Equal([]string{"name==foo"}))
+		Ω(req.Header.Get("X-Api-Version")).Should(Equal("1.5"))
+	})
+})
+
+// expectSameMetadata checks that the loaded metadata matches the generated metadata. The order of
+// the command flags is not significant.
+func expectSameMetadata(loaded, generated rsapi.APIMetadata) {
+	Ω(loaded).Should(HaveLen(len(generated)))
+	for name, res := range generated {
+		Ω(loaded).Should(HaveKey(name))
+		l := loaded[name]
+		Ω(l.Description).Should(Equal(res.Description))
+		Ω(l.Actions).Should(HaveLen(len(res.Actions)))
+		for i, a := range res.Actions {
+			la := l.Actions[i]
+			Ω(la.Name).Should(Equal(a.Name))
+			Ω(la.Description).Should(Equal(a.Description))
+			Ω(la.PathPatterns).Should(Equal(a.PathPatterns))
+			Ω(la.APIParams).Should(Equal(a.APIParams))
+			Ω(la.Payload).Should(Equal(a.Payload))
+			expected := make([]interface{}, len(a.CommandFlags))
+			for j, f := range a.CommandFlags {
+				expected[j] = f
+			}
+			Ω(la.CommandFlags).Should(ConsistOf(expected...))
+		}
+	}
+}
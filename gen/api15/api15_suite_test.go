@@ -1,4 +1,4 @@
-package main
+package api15
 
 import (
 	. "github.com/onsi/ginkgo"
@@ -7,7 +7,7 @@ import (
 	"testing"
 )
 
-func TestAPI15gen(t *testing.T) {
+func TestAPI15(t *testing.T) {
 	RegisterFailHandler(Fail)
-	RunSpecs(t, "API15gen Suite")
+	RunSpecs(t, "API15 Suite")
 }
@@ -1,4 +1,4 @@
-package main
+package api15
 
 import (
 	"fmt"
@@ -36,6 +36,7 @@ func NewAPIAnalyzer(resources map[string]interface{}, attributeTypes map[string]
 // the APIAnalyzer struct accordingly.
 func (a *APIAnalyzer) Analyze() *gen.APIDescriptor {
 	var descriptor = &gen.APIDescriptor{
+		Version:   "1.5",
 		Resources: make(map[string]*gen.Resource),
 		Types:     make(map[string]*gen.ObjectDataType),
 	}
@@ -50,6 +51,9 @@ func (a *APIAnalyzer) Analyze() *gen.APIDescriptor {
 		var resource = a.rawResources[name]
 		a.AnalyzeResource(name, resource, descriptor)
 	}
+	for _, name := range rawResourceNames {
+		a.AnalyzeLinks(name, a.rawResources[name], descriptor)
+	}
 	descriptor.FinalizeTypeNames(a.rawTypes)
 	return descriptor
 }
@@ -287,6 +291,67 @@ func LocatorFunc(attributes []*gen.Attribute, name string) string {
 		return nil`
 }
 
+// linkResources maps the names of links whose target cannot be inferred from the name to the
+// name of the linked resource.
+var linkResources = map[string]string{
+	"lineage":  "PublicationLineage",
+	"settings": "MultiCloudImageSetting",
+}
+
+// AnalyzeLinks initializes the links of the given resource. It must be called once all resources
+// have been analyzed so that link targets can be resolved.
+func (a *APIAnalyzer) AnalyzeLinks(name string, resource interface{}, descriptor *gen.APIDescriptor) {
+	res, ok := descriptor.Resources[inflect.Singularize(name)]
+	if !ok || res.LocatorFunc == "" {
+		return // No links attribute
+	}
+	m, ok := resource.(map[string]interface{})["media_type"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	links, ok := m["links"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, n := range sortedKeys(links) {
+		if n == "self" {
+			continue
+		}
+		target := linkResource(n, descriptor)
+		if target == "" {
+			continue
+		}
+		res.Links = append(res.Links, &gen.Link{
+			Name:         n,
+			MethodName:   inflect.Camelize(n) + "Locator",
+			ResourceName: target,
+			LocatorFunc: `for _, l := range r.Links {
+			if l["rel"] == "` + n + `" {
+				return api.` + target + `Locator(l["href"])
+			}
+		}
+		return nil`,
+		})
+	}
+}
+
+// linkResource returns the name of the resource pointed to by the link with the given name, empty
+// string if it cannot be inferred. Links are named after the linked resource, possibly with a
+// qualifier prefix (e.g. "current_instance" or "default_multi_cloud_image").
+func linkResource(link string, descriptor *gen.APIDescriptor) string {
+	if r, ok := linkResources[link]; ok {
+		return r
+	}
+	elems := strings.Split(link, "_")
+	for i := range elems {
+		n := inflect.Camelize(inflect.Singularize(strings.Join(elems[i:], "_")))
+		if r, ok := descriptor.Resources[n]; ok && len(r.Actions) > 0 {
+			return n
+		}
+	}
+	return ""
+}
+
 // ParseRoute parses a API 1.5 route and returns corresponding path patterns.
 func ParseRoute(moniker string, route string) (pathPatterns []*gen.PathPattern) {
 	// :(((( some routes are empty
@@ -1,4 +1,4 @@
-package main
+package api15
 
 import (
 	. "github.com/onsi/ginkgo"
@@ -99,3 +99,45 @@ var _ = Describe("APIAnalyzer ParseRoute", func() {
 		})
 	})
 })
+
+var _ = Describe("APIAnalyzer AnalyzeLinks", func() {
+	var (
+		descriptor *gen.APIDescriptor
+		links      []*gen.Link
+	)
+
+	BeforeEach(func() {
+		descriptor = &gen.APIDescriptor{Resources: map[string]*gen.Resource{
+			"Server":       {Name: "Server", LocatorFunc: "return nil", Actions: []*gen.Action{{}}},
+			"Instance":     {Name: "Instance", Actions: []*gen.Action{{}}},
+			"AlertSpec":    {Name: "AlertSpec", Actions: []*gen.Action{{}}},
+			"Notification": {Name: "Notification"},
+		}}
+		raw := map[string]interface{}{
+			"media_type": map[string]interface{}{
+				"links": map[string]interface{}{
+					"self":             "Href of itself",
+					"current_instance": "Associated current instance",
+					"alert_specs":      "Associated AlertSpecs",
+					"notifications":    "Resource without actions",
+					"auditee":          "Unknown resource",
+				},
+			},
+		}
+		a := NewAPIAnalyzer(map[string]interface{}{"Servers": raw}, nil)
+		a.AnalyzeLinks("Servers", raw, descriptor)
+		links = descriptor.Resources["Server"].Links
+	})
+
+	It("resolves the linked resources", func() {
+		Ω(links).Should(HaveLen(2))
+		Ω(links[0].Name).Should(Equal("alert_specs"))
+		Ω(links[0].MethodName).Should(Equal("AlertSpecsLocator"))
+		Ω(links[0].ResourceName).Should(Equal("AlertSpec"))
+		Ω(links[1].Name).Should(Equal("current_instance"))
+		Ω(links[1].MethodName).Should(Equal("CurrentInstanceLocator"))
+		Ω(links[1].ResourceName).Should(Equal("Instance"))
+		Ω(links[1].LocatorFunc).Should(ContainSubstring(`l["rel"] == "current_instance"`))
+		Ω(links[1].LocatorFunc).Should(ContainSubstring(`api.InstanceLocator(l["href"])`))
+	})
+})
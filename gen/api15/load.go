@@ -0,0 +1,56 @@
+package api15
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+// LoadDescriptor loads the API 1.5 metadata files (api_data.json and attributes.json) found in the
+// given directory and analyzes them.
+func LoadDescriptor(dir string) (d *gen.APIDescriptor, err error) {
+	apiDataFile := path.Join(dir, "api_data.json")
+	var apiData map[string]interface{}
+	apiDataText, err := loadFile(apiDataFile)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(apiDataText, &apiData); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal JSON read from '%s': %s", apiDataFile, err)
+	}
+
+	attributesFile := path.Join(dir, "attributes.json")
+	var attributes map[string]string
+	attributesText, err := loadFile(attributesFile)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(attributesText, &attributes); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal JSON read from '%s': %s", attributesFile, err)
+	}
+
+	// The analyzer panics on unexpected metadata
+	defer func() {
+		if r := recover(); r != nil {
+			d = nil
+			err = fmt.Errorf("Failed to analyze metadata in '%s': %v", dir, r)
+		}
+	}()
+	return NewAPIAnalyzer(apiData, attributes).Analyze(), nil
+}
+
+// Helper function that reads content from given file
+func loadFile(file string) ([]byte, error) {
+	if _, err := os.Stat(file); err != nil {
+		return nil, fmt.Errorf("Cannot find '%s'", file)
+	}
+	js, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot read '%s': %s", file, err)
+	}
+	return js, nil
+}
@@ -1,4 +1,4 @@
-package main
+package api15
 
 import (
 	"fmt"
@@ -25,6 +25,9 @@ var (
 	partsRegexp = regexp.MustCompile("[^[:alnum:]]+")
 )
 
+// Regular expression used by API 1.5 to validate date parameters, e.g. "2011/06/25 00:00:00 +0000"
+const rubyTimeRegex = `/^(\d{4})\/(\d{2})\/(\d{2}) (\d{2}):(\d{2}):(\d{2}) ([+-]\d{4})$/`
+
 // ParamAnalyzer exposes the "Analyze" method which initializes all the fields but 'rawParams'
 // which is initialized by the factory method.
 // The analyzer takes a map describing the parameters of a method as found in the API JSON and
@@ -63,7 +66,6 @@ func (p *ParamAnalyzer) Analyze() {
 		paths[i] = n
 		i++
 	}
-	sort.Strings(paths)
 	sort.Sort(ByReverseLength(paths))
 	rawLeafParams := []string{}
 	for _, p := range paths {
@@ -184,6 +186,8 @@ func (p *ParamAnalyzer) Analyze() {
 }
 
 // ByReverseLength makes it possible to sort an array of strings by length.
+// Strings of the same length are sorted alphabetically so that the order does not depend on the
+// sort algorithm.
 type ByReverseLength []string
 
 func (s ByReverseLength) Len() int {
@@ -193,7 +197,10 @@ func (s ByReverseLength) Swap(i, j int) {
 	s[i], s[j] = s[j], s[i]
 }
 func (s ByReverseLength) Less(i, j int) bool {
-	return len(s[i]) > len(s[j])
+	if len(s[i]) != len(s[j]) {
+		return len(s[i]) > len(s[j])
+	}
+	return s[i] < s[j]
 }
 
 // Recursively record all type declarations
@@ -231,6 +238,9 @@ func (p *ParamAnalyzer) parseDataType(path string, child *gen.ActionParam) gen.D
 		res = &i
 	case "String":
 		s := gen.BasicDataType("string")
+		if param["regex"] == rubyTimeRegex {
+			s = gen.BasicDataType(gen.TimeDataType)
+		}
 		res = &s
 	case "Array":
 		if child != nil {
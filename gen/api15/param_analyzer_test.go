@@ -1,4 +1,4 @@
-package main
+package api15
 
 import (
 	. "github.com/onsi/ginkgo"
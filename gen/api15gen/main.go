@@ -1,15 +1,14 @@
 package main
 
 import (
-	"encoding/json"
 	"flag"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"os"
-	"os/exec"
 	"path"
 
 	"github.com/rightscale/rsc/gen"
+	"github.com/rightscale/rsc/gen/api15"
 	"github.com/rightscale/rsc/gen/writers"
 	"gopkg.in/alecthomas/kingpin.v2"
 )
@@ -22,6 +21,9 @@ func main() {
 		"Path to directory containig metadata files (api_data.json and attributes.json)")
 	destDirVal := flag.String("output", curDir,
 		"Path to output file")
+	tool := flag.String("tool", "rsc", "Tool or library for which to generate code, supported values are 'rsc', 'openapi', 'typescript', 'jsonschema' or 'docs'")
+	importPath := flag.String("import", "", "Import path of the generated client package used by the fakes, e.g. \"github.com/rightscale/rsc/cm15\", derived from GOPATH or computed with 'go list' if blank")
+	check := flag.Bool("check", false, "Do not write any file, exit with a non-zero status if the generated files differ from the files on disk")
 	flag.Parse()
 
 	metadataDir := *metadataDirVal
@@ -34,97 +36,107 @@ func main() {
 		kingpin.Fatalf("%s is not a valid directory", destDir)
 	}
 
-	apiDataFile := path.Join(metadataDir, "api_data.json")
-	var apiData map[string]interface{}
-	apiDataText, err := loadFile(apiDataFile)
+	// 2. Analyze
+	descriptor, err := api15.LoadDescriptor(metadataDir)
 	kingpin.FatalIfError(err, "")
-	err = json.Unmarshal(apiDataText, &apiData)
-	if err != nil {
-		kingpin.Fatalf("Cannot unmarshal JSON read from '%s': %s", apiDataFile, err)
-	}
 
-	attributesFile := path.Join(metadataDir, "attributes.json")
-	var attributes map[string]string
-	attributesText, err := loadFile(attributesFile)
-	kingpin.FatalIfError(err, "")
-	err = json.Unmarshal(attributesText, &attributes)
-	if err != nil {
-		kingpin.Fatalf("Cannot unmarshal JSON read from '%s': %s", attributesFile, err)
-	}
+	// 3. Write code
+	out := writers.NewOutput(*check)
+	var generated []string
+	switch *tool {
+	case "rsc":
+		// 3.a Write codegen_client.go
+		var clientPath = path.Join(destDir, "codegen_client.go")
+		kingpin.FatalIfError(generateClient(out, descriptor, clientPath), "")
 
-	// 2. Analyze
-	analyzer := NewAPIAnalyzer(apiData, attributes)
-	descriptor := analyzer.Analyze()
+		// 3.b Write codegen_metadata.go
+		var metadataPath = path.Join(destDir, "codegen_metadata.go")
+		kingpin.FatalIfError(generateMetadata(out, descriptor, metadataPath), "")
 
-	// 3. Write codegen_client.go
-	var clientPath = path.Join(destDir, "codegen_client.go")
-	kingpin.FatalIfError(generateClient(descriptor, clientPath), "")
-
-	// 4. Write codegen_metadata.go
-	var metadataPath = path.Join(destDir, "codegen_metadata.go")
-	kingpin.FatalIfError(generateMetadata(descriptor, metadataPath), "")
+		// 3.c Write cm15fake/codegen_fake.go
+		var fakePath = path.Join(destDir, "cm15fake", "codegen_fake.go")
+		kingpin.FatalIfError(out.WriteFakes(descriptor, fakePath, *importPath, destDir, "cm15"), "")
+		generated = append(generated, clientPath, metadataPath, fakePath)
+	case "openapi":
+		var openAPIPath = path.Join(destDir, "openapi.json")
+		kingpin.FatalIfError(generateOpenAPI(out, descriptor, openAPIPath), "")
+		generated = append(generated, openAPIPath)
+	case "typescript":
+		var tsPath = path.Join(destDir, "client.ts")
+		kingpin.FatalIfError(out.WriteTypeScript(descriptor, tsPath, "RightScale Cloud Management API 1.5", "1.5"), "")
+		generated = append(generated, tsPath)
+	case "jsonschema":
+		files, err := out.WriteJSONSchemas(descriptor, path.Join(destDir, "schemas"),
+			"RightScale Cloud Management API 1.5")
+		kingpin.FatalIfError(err, "")
+		generated = append(generated, files...)
+	case "docs":
+		files, err := out.WriteDocs(descriptor, path.Join(destDir, "docs"),
+			"RightScale Cloud Management API 1.5", "cm15", "cm15")
+		kingpin.FatalIfError(err, "")
+		generated = append(generated, files...)
+	default:
+		kingpin.Fatalf("Invalid tool '%s', supported tools are 'rsc', 'openapi', 'typescript', 'jsonschema' and 'docs'", *tool)
+	}
 
-	// 5. Say something...
-	fmt.Printf("%s\n%s\n", clientPath, metadataPath)
+	// 4. Say something...
+	if *check {
+		kingpin.FatalIfError(out.StaleError(), "")
+		return
+	}
+	for _, g := range generated {
+		fmt.Printf("%s\n", g)
+	}
 }
 
 // Generate API client code, drives the code writer.
-func generateClient(descriptor *gen.APIDescriptor, codegen string) error {
-	f, err := os.Create(codegen)
-	if err != nil {
-		return err
-	}
+func generateClient(out *writers.Output, descriptor *gen.APIDescriptor, codegen string) error {
 	c, err := writers.NewClientWriter()
 	if err != nil {
 		return err
 	}
-	kingpin.FatalIfError(c.WriteHeader("cm15", "1.5", false /*needTime*/, true /*needJSON*/, f), "")
-	for _, name := range descriptor.ResourceNames {
-		resource := descriptor.Resources[name]
-		c.WriteResourceHeader(name, f)
-		kingpin.FatalIfError(c.WriteResource(resource, f), "")
-	}
-	c.WriteTypeSectionHeader(f)
-	for _, name := range descriptor.TypeNames {
-		t := descriptor.Types[name]
-		c.WriteType(t, f)
-	}
-	f.Close()
-	o, err := exec.Command("go", "fmt", codegen).CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("Failed to format generated client code:\n%s", o)
-	}
-	return nil
+	return out.Write(codegen, func(w io.Writer) error {
+		err := c.WriteHeader("cm15", "1.5", true /*needJSON*/, descriptor.NeedIO(), descriptor.NeedIOUtil(), w)
+		if err != nil {
+			return err
+		}
+		for _, name := range descriptor.ResourceNames {
+			resource := descriptor.Resources[name]
+			c.WriteResourceHeader(name, w)
+			if err := c.WriteResource(resource, w); err != nil {
+				return err
+			}
+		}
+		c.WriteTypeSectionHeader(w)
+		for _, name := range descriptor.TypeNames {
+			t := descriptor.Types[name]
+			c.WriteType(t, w)
+		}
+		return nil
+	})
 }
 
 // Generate API metadata, drives the metadata writer.
-func generateMetadata(descriptor *gen.APIDescriptor, codegen string) error {
-	f, err := os.Create(codegen)
-	if err != nil {
-		return err
-	}
+func generateMetadata(out *writers.Output, descriptor *gen.APIDescriptor, codegen string) error {
 	c, err := writers.NewMetadataWriter()
 	if err != nil {
 		return err
 	}
-	kingpin.FatalIfError(c.WriteHeader("cm15", f), "")
-	kingpin.FatalIfError(c.WriteMetadata(descriptor, f), "")
-	f.Close()
-	o, err := exec.Command("go", "fmt", codegen).CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("Failed to format generated metadata code:\n%s", o)
-	}
-	return nil
+	return out.Write(codegen, func(w io.Writer) error {
+		if err := c.WriteHeader("cm15", w); err != nil {
+			return err
+		}
+		return c.WriteMetadata(descriptor, w)
+	})
 }
 
-// Helper function that reads content from given file
-func loadFile(file string) ([]byte, error) {
-	if _, err := os.Stat(file); err != nil {
-		return nil, fmt.Errorf("Cannot find '%s'", file)
-	}
-	js, err := ioutil.ReadFile(file)
+// Generate OpenAPI document, drives the OpenAPI writer.
+func generateOpenAPI(out *writers.Output, descriptor *gen.APIDescriptor, codegen string) error {
+	c, err := writers.NewOpenAPIWriter("RightScale Cloud Management API 1.5")
 	if err != nil {
-		return nil, fmt.Errorf("Cannot read '%s': %s", file, err)
+		return err
 	}
-	return js, nil
+	return out.Write(codegen, func(w io.Writer) error {
+		return c.WriteOpenAPI(descriptor, w)
+	})
 }
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/rightscale/rsc/gen"
+	"github.com/rightscale/rsc/gen/api15"
+	"github.com/rightscale/rsc/gen/diff"
+	"github.com/rightscale/rsc/gen/openapi"
+	"github.com/rightscale/rsc/gen/praxis"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func main() {
+	// 1. Parse command line arguments
+	oldDirVal := flag.String("old", "",
+		"Path to directory containing the old API metadata (api_data.json, index.json or openapi.json)")
+	newDirVal := flag.String("new", "",
+		"Path to directory containing the new API metadata, defaults to -old")
+	fromRev := flag.String("from", "",
+		"Git revision of the old API metadata, the metadata is read from the working tree if blank")
+	toRev := flag.String("to", "",
+		"Git revision of the new API metadata, the metadata is read from the working tree if blank")
+	targetVersion := flag.String("target", "",
+		"Version of praxis API to compare, required if the metadata describes multiple versions")
+	format := flag.String("format", "text", "Report format, supported values are 'text' or 'json'")
+	output := flag.String("output", "", "Path to report file, the report is written to stdout if blank")
+	failOnBreaking := flag.Bool("fail-on-breaking", false, "Exit with status 1 if there are breaking changes")
+	flag.Parse()
+
+	if *oldDirVal == "" {
+		kingpin.Fatalf("-old option is required.")
+	}
+	oldDir, newDir := *oldDirVal, *newDirVal
+	if newDir == "" {
+		newDir = oldDir
+	}
+	if oldDir == newDir && *fromRev == *toRev {
+		kingpin.Fatalf("nothing to compare, specify a different -new directory or git revisions with -from and -to.")
+	}
+	if *format != "text" && *format != "json" {
+		kingpin.Fatalf("invalid format '%s', supported values are 'text' or 'json'.", *format)
+	}
+
+	// 2. Analyze
+	oldDesc, err := load(oldDir, *fromRev, *targetVersion)
+	kingpin.FatalIfError(err, "")
+	newDesc, err := load(newDir, *toRev, *targetVersion)
+	kingpin.FatalIfError(err, "")
+
+	// 3. Compare and write report
+	report := diff.Compare(oldDesc, newDesc)
+	w := os.Stdout
+	if *output != "" {
+		w, err = os.Create(*output)
+		kingpin.FatalIfError(err, "")
+	}
+	if *format == "json" {
+		err = report.WriteJSON(w)
+	} else {
+		err = report.WriteText(w)
+	}
+	kingpin.FatalIfError(err, "")
+	if w != os.Stdout {
+		w.Close()
+	}
+	if *failOnBreaking && len(report.Breaking()) > 0 {
+		os.Exit(1)
+	}
+}
+
+// load analyzes the API metadata found in the given directory. The content of the directory is
+// read from the given git revision if not blank.
+func load(dir, rev, targetVersion string) (*gen.APIDescriptor, error) {
+	if rev != "" {
+		tmp, err := ioutil.TempDir("", "apidiff")
+		if err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(tmp)
+		if err := checkout(dir, rev, tmp); err != nil {
+			return nil, err
+		}
+		dir = tmp
+	}
+	if stat, err := os.Stat(dir); err != nil || !stat.IsDir() {
+		return nil, fmt.Errorf("%s is not a valid directory", dir)
+	}
+	switch {
+	case exists(path.Join(dir, "api_data.json")):
+		return api15.LoadDescriptor(dir)
+	case exists(path.Join(dir, "index.json")):
+		descriptors, err := praxis.LoadDescriptors([]string{dir}, "API", targetVersion)
+		if err != nil {
+			return nil, err
+		}
+		if len(descriptors) != 1 {
+			var versions []string
+			for v := range descriptors {
+				versions = append(versions, v)
+			}
+			return nil, fmt.Errorf("%s describes %d API versions (%s), use -target to select one",
+				dir, len(descriptors), strings.Join(versions, ", "))
+		}
+		for _, d := range descriptors {
+			return d, nil
+		}
+	case exists(path.Join(dir, "openapi.json")):
+		return openapi.LoadDescriptor(path.Join(dir, "openapi.json"), "API")
+	}
+	return nil, fmt.Errorf("no api_data.json, index.json or openapi.json file in %s", dir)
+}
+
+// checkout extracts the content of the given directory at the given git revision into dest.
+func checkout(dir, rev, dest string) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(wd, dir)
+	}
+	top, err := exec.Command("git", "-C", dir, "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return fmt.Errorf("Failed to find git repository containing %s: %s", dir, err)
+	}
+	root, err := filepath.EvalSymlinks(strings.TrimSpace(string(top)))
+	if err != nil {
+		return err
+	}
+	if dir, err = filepath.EvalSymlinks(dir); err != nil {
+		// Directory may not exist in working tree anymore
+		dir = filepath.Clean(dir)
+	}
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return err
+	}
+	tree := rev + ":" + filepath.ToSlash(rel)
+	if rel == "." {
+		tree = rev + ":"
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", "-C", root, "archive", "--format=tar", tree)
+	cmd.Stderr = &stderr
+	archive, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("Failed to read %s at revision %s: %s", rel, rev, strings.TrimSpace(stderr.String()))
+	}
+	tr := tar.NewReader(bytes.NewReader(archive))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("Failed to extract %s at revision %s: %s", rel, rev, err)
+		}
+		target := filepath.Join(dest, filepath.FromSlash(hdr.Name))
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg, tar.TypeRegA:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			content, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(target, content, 0644); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// exists returns true if the file with the given path exists.
+func exists(p string) bool {
+	_, err := os.Stat(p)
+	return err == nil
+}
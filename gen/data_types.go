@@ -15,8 +15,8 @@ type APIDescriptor struct {
 	Types         map[string]*ObjectDataType // Types used by resource actions indexed by name
 	ResourceNames []string                   // Resource names ordered alphabetically
 	TypeNames     []string                   // Type names ordered alphabetically
-	NeedTime      bool                       // Whether generated code uses the time.Time package
 	NeedJSON      bool                       // Whether generated code uses encoding/json package
+	HeaderVersion string                     // Value of the X-Api-Version header required by the API if any
 }
 
 // Merge two descriptors together, make sure there are no duplicate resource names and that
@@ -81,13 +81,14 @@ func (d *APIDescriptor) FinalizeTypeNames(rawTypes map[string][]*ObjectDataType)
 				for _, ty := range types {
 					ty.TypeName = tn
 				}
-				rawTypes[tn] = types
+				rawTypes[tn] = append(rawTypes[tn], types...)
 				delete(rawTypes, oldTn)
 			}
 		}
 	}
 
 	// 2. Make data type names unique
+	rawTypeNames = make([]string, len(rawTypes))
 	idx = 0
 	for n := range rawTypes {
 		rawTypeNames[idx] = n
@@ -164,6 +165,32 @@ func (d *APIDescriptor) uniqueTypeName(prefix string) string {
 	return u
 }
 
+// NeedIO returns true if the generated code uses the io package, that is if any resource action
+// response may be streamed.
+func (d *APIDescriptor) NeedIO() bool {
+	for _, r := range d.Resources {
+		for _, a := range r.Actions {
+			if a.IsStreamable() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NeedIOUtil returns true if the generated code uses the ioutil package, that is if any resource
+// action returns the response body as a string.
+func (d *APIDescriptor) NeedIOUtil() bool {
+	for _, r := range d.Resources {
+		for _, a := range r.Actions {
+			if a.Return == "string" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Resource is the data structure used to describe API resources.
 type Resource struct {
 	Name        string       // Resource name, e.g. "ServerArray"
@@ -172,6 +199,16 @@ type Resource struct {
 	Attributes  []*Attribute // Resource attributes
 	Actions     []*Action    // Resource actions, e.g. "index", "show", "update" ...
 	LocatorFunc string       // Source code for Locator factory method if any
+	Links       []*Link      // Links to other resources, sorted by name
+}
+
+// Link describes a link from a resource to another resource. The client generators create a
+// method on the resource struct for each link that returns a locator for the linked resource.
+type Link struct {
+	Name         string // Link name, e.g. "current_instance"
+	MethodName   string // Name of generated method, e.g. "CurrentInstanceLocator"
+	ResourceName string // Name of linked resource, e.g. "Instance"
+	LocatorFunc  string // Source code for the method body
 }
 
 // Attribute is the resource attributes used to generate resource type definition.
@@ -213,6 +250,20 @@ func (a *Action) MandatoryParams() []*ActionParam {
 	return m[:i]
 }
 
+// IsStreamable returns true if the action response body is raw content (e.g. text or a file
+// download) rather than JSON. The client exposes an additional method returning the response body
+// as a reader for these actions.
+func (a *Action) IsStreamable() bool {
+	return a.Return == "string" || a.Return == "" && a.Name == "download"
+}
+
+// IsIterable returns true if the action is an index action that returns a collection. The client
+// exposes an additional method that decodes the collection elements one at a time and calls a
+// callback with each element for these actions.
+func (a *Action) IsIterable() bool {
+	return a.Name == "index" && strings.HasPrefix(a.Return, "[]")
+}
+
 // HasOptionalParams returns true if the action takes optional parameters, false otherwise.
 func (a *Action) HasOptionalParams() bool {
 	for _, param := range a.Params {
@@ -287,6 +338,10 @@ type DataType interface {
 // BasicDataType is a basic data type.
 type BasicDataType string
 
+// TimeDataType is the basic data type of date and time attributes and parameters.
+// It is a pointer so that null values can be represented.
+const TimeDataType = "*rsapi.Time"
+
 // IsEquivalent returns true if other represents the same data type as the target.
 func (b *BasicDataType) IsEquivalent(other DataType) bool {
 	t, ok := other.(*BasicDataType)
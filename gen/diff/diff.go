@@ -0,0 +1,340 @@
+// Package diff compares two versions of an API and reports the changes that affect clients.
+// Each change is classified as breaking or non-breaking: a change is breaking if code or command
+// lines written against the old version of the API may fail against the new version.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+// Change kinds
+const (
+	ResourceAdded     = "resource_added"
+	ResourceRemoved   = "resource_removed"
+	ActionAdded       = "action_added"
+	ActionRemoved     = "action_removed"
+	PathAdded         = "path_added"
+	PathRemoved       = "path_removed"
+	ParamAdded        = "param_added"
+	ParamRemoved      = "param_removed"
+	ParamMandatory    = "param_mandatory"
+	ParamOptional     = "param_optional"
+	ParamTypeChanged  = "param_type_changed"
+	EnumValuesAdded   = "enum_values_added"
+	EnumValuesRemoved = "enum_values_removed"
+	ReturnChanged     = "return_changed"
+	AttributeAdded    = "attribute_added"
+	AttributeRemoved  = "attribute_removed"
+	AttributeChanged  = "attribute_type_changed"
+)
+
+// Change describes a single difference between two versions of an API.
+type Change struct {
+	Kind        string `json:"kind"`             // Change kind, e.g. "action_removed"
+	Breaking    bool   `json:"breaking"`         // Whether change may break existing clients
+	Resource    string `json:"resource"`         // Name of resource affected by the change
+	Action      string `json:"action,omitempty"` // Name of action affected by the change if any
+	Param       string `json:"param,omitempty"`  // Name of parameter or attribute affected by the change if any
+	Description string `json:"description"`      // Human readable description of the change
+	Old         string `json:"old,omitempty"`    // Old value, e.g. old parameter type
+	New         string `json:"new,omitempty"`    // New value, e.g. new parameter type
+}
+
+// Report lists the changes between two versions of an API.
+type Report struct {
+	OldVersion string    `json:"old_version"` // Version of old API
+	NewVersion string    `json:"new_version"` // Version of new API
+	Changes    []*Change `json:"changes"`     // Changes ordered by resource, action and parameter
+}
+
+// Compare computes the changes between the old and new API descriptors.
+func Compare(old, new *gen.APIDescriptor) *Report {
+	r := Report{OldVersion: old.Version, NewVersion: new.Version, Changes: []*Change{}}
+	for _, name := range unionNames(old.ResourceNames, new.ResourceNames) {
+		o, okOld := old.Resources[name]
+		n, okNew := new.Resources[name]
+		switch {
+		case !okNew:
+			r.add(&Change{Kind: ResourceRemoved, Breaking: true, Resource: name,
+				Description: fmt.Sprintf("Remove resource %s", name)})
+		case !okOld:
+			r.add(&Change{Kind: ResourceAdded, Resource: name,
+				Description: fmt.Sprintf("Add resource %s", name)})
+		default:
+			r.compareResources(o, n)
+		}
+	}
+	return &r
+}
+
+// Breaking returns the breaking changes.
+func (r *Report) Breaking() []*Change {
+	var changes []*Change
+	for _, c := range r.Changes {
+		if c.Breaking {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// WriteText writes the report using the CHANGELOG.md bullet list format, breaking changes first.
+func (r *Report) WriteText(w io.Writer) error {
+	if len(r.Changes) == 0 {
+		_, err := fmt.Fprintln(w, "No change")
+		return err
+	}
+	for _, breaking := range []bool{true, false} {
+		for _, c := range r.Changes {
+			if c.Breaking != breaking {
+				continue
+			}
+			prefix := "* "
+			if breaking {
+				prefix += "[break] "
+			}
+			if _, err := fmt.Fprintln(w, prefix+c.Description); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the JSON representation of the report.
+func (r *Report) WriteJSON(w io.Writer) error {
+	js, err := json.MarshalIndent(r, "", "    ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", js)
+	return err
+}
+
+// add appends the given change to the report.
+func (r *Report) add(c *Change) {
+	r.Changes = append(r.Changes, c)
+}
+
+// compareResources records the changes between two versions of the same resource.
+func (r *Report) compareResources(old, new *gen.Resource) {
+	name := old.Name
+	oldActions := make(map[string]*gen.Action, len(old.Actions))
+	oldNames := make([]string, len(old.Actions))
+	for i, a := range old.Actions {
+		oldActions[a.Name] = a
+		oldNames[i] = a.Name
+	}
+	newActions := make(map[string]*gen.Action, len(new.Actions))
+	newNames := make([]string, len(new.Actions))
+	for i, a := range new.Actions {
+		newActions[a.Name] = a
+		newNames[i] = a.Name
+	}
+	for _, an := range unionNames(oldNames, newNames) {
+		o, okOld := oldActions[an]
+		n, okNew := newActions[an]
+		switch {
+		case !okNew:
+			r.add(&Change{Kind: ActionRemoved, Breaking: true, Resource: name, Action: an,
+				Description: fmt.Sprintf("Remove action %s.%s", name, an)})
+		case !okOld:
+			r.add(&Change{Kind: ActionAdded, Resource: name, Action: an,
+				Description: fmt.Sprintf("Add action %s.%s", name, an)})
+		default:
+			r.compareActions(name, o, n)
+		}
+	}
+
+	oldAttrs := make(map[string]*gen.Attribute, len(old.Attributes))
+	oldNames = make([]string, len(old.Attributes))
+	for i, a := range old.Attributes {
+		oldAttrs[a.Name] = a
+		oldNames[i] = a.Name
+	}
+	newAttrs := make(map[string]*gen.Attribute, len(new.Attributes))
+	newNames = make([]string, len(new.Attributes))
+	for i, a := range new.Attributes {
+		newAttrs[a.Name] = a
+		newNames[i] = a.Name
+	}
+	for _, an := range unionNames(oldNames, newNames) {
+		o, okOld := oldAttrs[an]
+		n, okNew := newAttrs[an]
+		switch {
+		case !okNew:
+			r.add(&Change{Kind: AttributeRemoved, Breaking: true, Resource: name, Param: an,
+				Description: fmt.Sprintf("Remove attribute %s of %s", an, name)})
+		case !okOld:
+			r.add(&Change{Kind: AttributeAdded, Resource: name, Param: an,
+				Description: fmt.Sprintf("Add attribute %s to %s", an, name)})
+		case o.FieldType != n.FieldType:
+			r.add(&Change{Kind: AttributeChanged, Breaking: true, Resource: name, Param: an,
+				Old: o.FieldType, New: n.FieldType,
+				Description: fmt.Sprintf("Change type of attribute %s of %s from %s to %s",
+					an, name, o.FieldType, n.FieldType)})
+		}
+	}
+}
+
+// compareActions records the changes between two versions of the same action.
+func (r *Report) compareActions(resource string, old, new *gen.Action) {
+	name := resource + "." + old.Name
+
+	// Paths
+	oldPaths := make([]string, len(old.PathPatterns))
+	for i, p := range old.PathPatterns {
+		oldPaths[i] = pathSignature(p)
+	}
+	newPaths := make([]string, len(new.PathPatterns))
+	for i, p := range new.PathPatterns {
+		newPaths[i] = pathSignature(p)
+	}
+	for _, p := range difference(oldPaths, newPaths) {
+		r.add(&Change{Kind: PathRemoved, Breaking: true, Resource: resource, Action: old.Name,
+			Old: p, Description: fmt.Sprintf("Remove path %s from %s", p, name)})
+	}
+	for _, p := range difference(newPaths, oldPaths) {
+		r.add(&Change{Kind: PathAdded, Resource: resource, Action: old.Name,
+			New: p, Description: fmt.Sprintf("Add path %s to %s", p, name)})
+	}
+
+	// Params
+	oldParams := make(map[string]*gen.ActionParam, len(old.LeafParams))
+	oldNames := make([]string, len(old.LeafParams))
+	for i, p := range old.LeafParams {
+		oldParams[p.QueryName] = p
+		oldNames[i] = p.QueryName
+	}
+	newParams := make(map[string]*gen.ActionParam, len(new.LeafParams))
+	newNames := make([]string, len(new.LeafParams))
+	for i, p := range new.LeafParams {
+		newParams[p.QueryName] = p
+		newNames[i] = p.QueryName
+	}
+	for _, pn := range unionNames(oldNames, newNames) {
+		o, okOld := oldParams[pn]
+		n, okNew := newParams[pn]
+		switch {
+		case !okNew:
+			r.add(&Change{Kind: ParamRemoved, Breaking: true, Resource: resource, Action: old.Name,
+				Param: pn, Description: fmt.Sprintf("Remove parameter %s of %s", pn, name)})
+		case !okOld:
+			desc := "optional"
+			if n.Mandatory {
+				desc = "mandatory"
+			}
+			r.add(&Change{Kind: ParamAdded, Breaking: n.Mandatory, Resource: resource,
+				Action: old.Name, Param: pn,
+				Description: fmt.Sprintf("Add %s parameter %s to %s", desc, pn, name)})
+		default:
+			r.compareParams(resource, old.Name, o, n)
+		}
+	}
+
+	// Return type
+	if old.Return != new.Return || old.ReturnLocation != new.ReturnLocation {
+		o, n := returnSignature(old), returnSignature(new)
+		r.add(&Change{Kind: ReturnChanged, Breaking: true, Resource: resource, Action: old.Name,
+			Old: o, New: n,
+			Description: fmt.Sprintf("Change return type of %s from %s to %s", name, o, n)})
+	}
+}
+
+// compareParams records the changes between two versions of the same action parameter.
+func (r *Report) compareParams(resource, action string, old, new *gen.ActionParam) {
+	name := fmt.Sprintf("parameter %s of %s.%s", old.QueryName, resource, action)
+	if !old.Mandatory && new.Mandatory {
+		r.add(&Change{Kind: ParamMandatory, Breaking: true, Resource: resource, Action: action,
+			Param: old.QueryName, Description: fmt.Sprintf("Make %s mandatory", name)})
+	} else if old.Mandatory && !new.Mandatory {
+		r.add(&Change{Kind: ParamOptional, Resource: resource, Action: action,
+			Param: old.QueryName, Description: fmt.Sprintf("Make %s optional", name)})
+	}
+	if o, n := old.Signature(), new.Signature(); o != n {
+		r.add(&Change{Kind: ParamTypeChanged, Breaking: true, Resource: resource, Action: action,
+			Param: old.QueryName, Old: o, New: n,
+			Description: fmt.Sprintf("Change type of %s from %s to %s", name, o, n)})
+	}
+	if len(new.ValidValues) == 0 {
+		// No more restriction on values, nothing removed
+		return
+	}
+	oldValues := make([]string, len(old.ValidValues))
+	for i, v := range old.ValidValues {
+		oldValues[i] = fmt.Sprintf("%q", fmt.Sprint(v))
+	}
+	newValues := make([]string, len(new.ValidValues))
+	for i, v := range new.ValidValues {
+		newValues[i] = fmt.Sprintf("%q", fmt.Sprint(v))
+	}
+	if len(old.ValidValues) == 0 {
+		// Parameter values are now restricted, any other value got removed
+		r.add(&Change{Kind: EnumValuesRemoved, Breaking: true, Resource: resource, Action: action,
+			Param: old.QueryName, New: strings.Join(newValues, ", "),
+			Description: fmt.Sprintf("Restrict values of %s to %s", name, strings.Join(newValues, ", "))})
+		return
+	}
+	if removed := difference(oldValues, newValues); len(removed) > 0 {
+		r.add(&Change{Kind: EnumValuesRemoved, Breaking: true, Resource: resource, Action: action,
+			Param: old.QueryName, Old: strings.Join(removed, ", "),
+			Description: fmt.Sprintf("Remove values %s from %s", strings.Join(removed, ", "), name)})
+	}
+	if added := difference(newValues, oldValues); len(added) > 0 {
+		r.add(&Change{Kind: EnumValuesAdded, Resource: resource, Action: action,
+			Param: old.QueryName, New: strings.Join(added, ", "),
+			Description: fmt.Sprintf("Add values %s to %s", strings.Join(added, ", "), name)})
+	}
+}
+
+// pathSignature returns the string representation of a path pattern that ignores the names of
+// the path variables, e.g. "GET /api/clouds/%s/instances".
+func pathSignature(p *gen.PathPattern) string {
+	return p.HTTPMethod + " " + p.Pattern
+}
+
+// returnSignature returns the string representation of an action return type.
+func returnSignature(a *gen.Action) string {
+	if a.Return == "" {
+		return "nothing"
+	}
+	if a.ReturnLocation {
+		return a.Return + " (location)"
+	}
+	return a.Return
+}
+
+// unionNames returns the sorted union of the given names.
+func unionNames(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	var res []string
+	for _, n := range append(append([]string{}, a...), b...) {
+		if !seen[n] {
+			seen[n] = true
+			res = append(res, n)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// difference returns the elements of a that are not in b in order.
+func difference(a, b []string) []string {
+	inB := make(map[string]bool, len(b))
+	for _, n := range b {
+		inB[n] = true
+	}
+	var res []string
+	for _, n := range a {
+		if !inB[n] {
+			res = append(res, n)
+		}
+	}
+	return res
+}
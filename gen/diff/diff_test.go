@@ -0,0 +1,231 @@
+package diff_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/rightscale/rsc/gen"
+	"github.com/rightscale/rsc/gen/diff"
+)
+
+var _ = Describe("Compare", func() {
+	var (
+		old, new *gen.APIDescriptor
+
+		report *diff.Report
+	)
+
+	BeforeEach(func() {
+		old = descriptor()
+		new = descriptor()
+	})
+
+	JustBeforeEach(func() {
+		report = diff.Compare(old, new)
+	})
+
+	// action returns the action with the given name of the Server resource.
+	action := func(d *gen.APIDescriptor, name string) *gen.Action {
+		for _, a := range d.Resources["Server"].Actions {
+			if a.Name == name {
+				return a
+			}
+		}
+		return nil
+	}
+
+	Context("with identical descriptors", func() {
+		It("reports no change", func() {
+			Ω(report.Changes).Should(BeEmpty())
+			var buf bytes.Buffer
+			Ω(report.WriteText(&buf)).Should(Succeed())
+			Ω(buf.String()).Should(Equal("No change\n"))
+		})
+	})
+
+	Context("with added and removed resources", func() {
+		BeforeEach(func() {
+			delete(old.Resources, "Cloud")
+			old.ResourceNames = []string{"Server"}
+			new.Resources["Volume"] = &gen.Resource{Name: "Volume"}
+			new.ResourceNames = append(new.ResourceNames, "Volume")
+			delete(new.Resources, "Server")
+			new.ResourceNames = []string{"Cloud", "Volume"}
+		})
+
+		It("classifies the changes", func() {
+			Ω(report.Changes).Should(HaveLen(3))
+			Ω(*report.Changes[0]).Should(Equal(diff.Change{Kind: diff.ResourceAdded,
+				Resource: "Cloud", Description: "Add resource Cloud"}))
+			Ω(*report.Changes[1]).Should(Equal(diff.Change{Kind: diff.ResourceRemoved, Breaking: true,
+				Resource: "Server", Description: "Remove resource Server"}))
+			Ω(report.Changes[2].Kind).Should(Equal(diff.ResourceAdded))
+			Ω(report.Breaking()).Should(HaveLen(1))
+		})
+
+		It("lists breaking changes first", func() {
+			var buf bytes.Buffer
+			Ω(report.WriteText(&buf)).Should(Succeed())
+			Ω(buf.String()).Should(Equal("* [break] Remove resource Server\n" +
+				"* Add resource Cloud\n* Add resource Volume\n"))
+		})
+	})
+
+	Context("with changed actions", func() {
+		BeforeEach(func() {
+			srv := new.Resources["Server"]
+			srv.Actions = []*gen.Action{action(new, "show"), {Name: "launch"}}
+			show := action(new, "show")
+			show.PathPatterns = append(show.PathPatterns,
+				&gen.PathPattern{HTTPMethod: "GET", Pattern: "/api/deployments/%s/servers/%s"})
+			show.Return = "*ServerLocator"
+			show.ReturnLocation = true
+		})
+
+		It("reports the action and path changes", func() {
+			Ω(report.Changes).Should(HaveLen(4))
+			Ω(report.Changes[0].Kind).Should(Equal(diff.ActionRemoved))
+			Ω(report.Changes[0].Action).Should(Equal("index"))
+			Ω(report.Changes[0].Breaking).Should(BeTrue())
+			Ω(report.Changes[1].Kind).Should(Equal(diff.ActionAdded))
+			Ω(report.Changes[1].Action).Should(Equal("launch"))
+			Ω(report.Changes[2].Kind).Should(Equal(diff.PathAdded))
+			Ω(report.Changes[2].New).Should(Equal("GET /api/deployments/%s/servers/%s"))
+			Ω(report.Changes[2].Breaking).Should(BeFalse())
+			Ω(*report.Changes[3]).Should(Equal(diff.Change{Kind: diff.ReturnChanged, Breaking: true,
+				Resource: "Server", Action: "show", Old: "*Server", New: "*ServerLocator (location)",
+				Description: "Change return type of Server.show from *Server to *ServerLocator (location)"}))
+		})
+
+		Context("and removed paths", func() {
+			BeforeEach(func() {
+				action(new, "show").PathPatterns = action(new, "show").PathPatterns[1:]
+			})
+
+			It("reports a breaking change", func() {
+				Ω(report.Changes[2].Kind).Should(Equal(diff.PathRemoved))
+				Ω(report.Changes[2].Old).Should(Equal("GET /api/servers/%s"))
+				Ω(report.Changes[2].Breaking).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("with changed parameters", func() {
+		BeforeEach(func() {
+			index := action(new, "index")
+			index.LeafParams[0].Mandatory = true                               // filter[]
+			index.LeafParams[1].ValidValues = []interface{}{"default", "tiny"} // view
+			index.LeafParams = append(index.LeafParams[:2], &gen.ActionParam{
+				Name: "limit", QueryName: "limit", Type: basic("int"), Mandatory: true})
+			show := action(new, "show")
+			show.LeafParams[0].Type = basic("int") // view
+		})
+
+		It("classifies the changes", func() {
+			changes := report.Changes
+			Ω(changes).Should(HaveLen(6))
+			Ω(changes[0].Kind).Should(Equal(diff.ParamMandatory))
+			Ω(changes[0].Param).Should(Equal("filter[]"))
+			Ω(changes[0].Breaking).Should(BeTrue())
+			Ω(changes[1].Kind).Should(Equal(diff.ParamAdded))
+			Ω(changes[1].Description).Should(Equal("Add mandatory parameter limit to Server.index"))
+			Ω(changes[1].Breaking).Should(BeTrue())
+			Ω(changes[2].Kind).Should(Equal(diff.ParamRemoved))
+			Ω(changes[2].Param).Should(Equal("secret"))
+			Ω(changes[3].Kind).Should(Equal(diff.EnumValuesRemoved))
+			Ω(changes[3].Description).Should(Equal(`Remove values "extended" from parameter view of Server.index`))
+			Ω(changes[3].Breaking).Should(BeTrue())
+			Ω(changes[4].Kind).Should(Equal(diff.EnumValuesAdded))
+			Ω(changes[4].New).Should(Equal(`"tiny"`))
+			Ω(changes[4].Breaking).Should(BeFalse())
+			Ω(*changes[5]).Should(Equal(diff.Change{Kind: diff.ParamTypeChanged, Breaking: true,
+				Resource: "Server", Action: "show", Param: "view", Old: "string", New: "int",
+				Description: "Change type of parameter view of Server.show from string to int"}))
+		})
+	})
+
+	Context("with changed attributes", func() {
+		BeforeEach(func() {
+			new.Resources["Cloud"].Attributes = []*gen.Attribute{
+				{Name: "description", FieldName: "Description", FieldType: "string"},
+				{Name: "name", FieldName: "Name", FieldType: "int"},
+			}
+		})
+
+		It("classifies the changes", func() {
+			Ω(report.Changes).Should(HaveLen(3))
+			Ω(report.Changes[0].Kind).Should(Equal(diff.AttributeAdded))
+			Ω(report.Changes[1].Kind).Should(Equal(diff.AttributeRemoved))
+			Ω(report.Changes[1].Param).Should(Equal("href"))
+			Ω(report.Changes[2].Kind).Should(Equal(diff.AttributeChanged))
+			Ω(report.Breaking()).Should(HaveLen(2))
+		})
+
+		It("writes JSON", func() {
+			var buf bytes.Buffer
+			Ω(report.WriteJSON(&buf)).Should(Succeed())
+			var res map[string]interface{}
+			Ω(json.Unmarshal(buf.Bytes(), &res)).Should(Succeed())
+			Ω(res["old_version"]).Should(Equal("1.5"))
+			changes := res["changes"].([]interface{})
+			Ω(changes).Should(HaveLen(3))
+			Ω(changes[2]).Should(Equal(map[string]interface{}{
+				"kind":        "attribute_type_changed",
+				"breaking":    true,
+				"resource":    "Cloud",
+				"param":       "name",
+				"description": "Change type of attribute name of Cloud from string to int",
+				"old":         "string",
+				"new":         "int",
+			}))
+		})
+	})
+})
+
+// basic returns a basic data type.
+func basic(name string) gen.DataType {
+	b := gen.BasicDataType(name)
+	return &b
+}
+
+// descriptor builds a new descriptor each time it's called so that tests can modify the result.
+func descriptor() *gen.APIDescriptor {
+	cloud := &gen.Resource{
+		Name: "Cloud",
+		Attributes: []*gen.Attribute{
+			{Name: "href", FieldName: "Href", FieldType: "string"},
+			{Name: "name", FieldName: "Name", FieldType: "string"},
+		},
+	}
+	server := &gen.Resource{
+		Name: "Server",
+		Actions: []*gen.Action{
+			{
+				Name:         "index",
+				PathPatterns: []*gen.PathPattern{{HTTPMethod: "GET", Pattern: "/api/servers"}},
+				LeafParams: []*gen.ActionParam{
+					{Name: "filter", QueryName: "filter[]",
+						Type: &gen.ArrayDataType{ElemType: &gen.ActionParam{Name: "item", Type: basic("string")}}},
+					{Name: "view", QueryName: "view", Type: basic("string"),
+						ValidValues: []interface{}{"default", "extended"}},
+					{Name: "secret", QueryName: "secret", Type: basic("string")},
+				},
+				Return: "[]*Server",
+			},
+			{
+				Name:         "show",
+				PathPatterns: []*gen.PathPattern{{HTTPMethod: "GET", Pattern: "/api/servers/%s"}},
+				LeafParams:   []*gen.ActionParam{{Name: "view", QueryName: "view", Type: basic("string")}},
+				Return:       "*Server",
+			},
+		},
+	}
+	return &gen.APIDescriptor{
+		Version:       "1.5",
+		Resources:     map[string]*gen.Resource{"Cloud": cloud, "Server": server},
+		ResourceNames: []string{"Cloud", "Server"},
+	}
+}
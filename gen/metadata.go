@@ -0,0 +1,130 @@
+package gen
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/rightscale/rsc/metadata"
+)
+
+// Metadata builds the API metadata used by rsc to parse command lines and build requests from the
+// descriptor. The result is identical to the GenMetadata variable produced by the metadata writer
+// which makes it possible to use APIs whose metadata is only available at runtime.
+func (d *APIDescriptor) Metadata() (map[string]*metadata.Resource, error) {
+	res := make(map[string]*metadata.Resource, len(d.ResourceNames))
+	for _, n := range d.ResourceNames {
+		r := d.Resources[n]
+		actions := make([]*metadata.Action, len(r.Actions))
+		for i, a := range r.Actions {
+			action, err := a.metadata()
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %s", r.Name, a.Name, err)
+			}
+			actions[i] = action
+		}
+		res[n] = &metadata.Resource{
+			Name:        r.Name,
+			Description: r.Description,
+			Actions:     actions,
+		}
+	}
+	return res, nil
+}
+
+// metadata builds the action metadata.
+func (a *Action) metadata() (*metadata.Action, error) {
+	patterns := make([]*metadata.PathPattern, len(a.PathPatterns))
+	for i, p := range a.PathPatterns {
+		rx, err := regexp.Compile(p.Regexp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path regexp %s: %s", p.Regexp, err)
+		}
+		vars := p.Variables
+		if vars == nil {
+			vars = []string{}
+		}
+		patterns[i] = &metadata.PathPattern{
+			HTTPMethod: p.HTTPMethod,
+			Pattern:    p.Pattern,
+			Variables:  vars,
+			Regexp:     rx,
+		}
+	}
+	flags := make([]*metadata.ActionParam, len(a.LeafParams))
+	for i, p := range a.LeafParams {
+		flagType, err := p.FlagType()
+		if err != nil {
+			return nil, err
+		}
+		flag, err := p.metadata(flagType)
+		if err != nil {
+			return nil, err
+		}
+		flags[i] = flag
+	}
+	params := make([]*metadata.ActionParam, len(a.Params))
+	for i, p := range a.Params {
+		param, err := p.metadata(p.Signature())
+		if err != nil {
+			return nil, err
+		}
+		params[i] = param
+	}
+	action := &metadata.Action{
+		Name:         a.Name,
+		Description:  a.Description,
+		PathPatterns: patterns,
+		CommandFlags: flags,
+		APIParams:    params,
+	}
+	if a.Payload != nil {
+		action.Payload = a.Payload.Name()
+	}
+	return action, nil
+}
+
+// FlagType returns the type of the command line flag corresponding to the parameter, one of
+// "string", "[]string", "int", "map" or "file" etc. The parameter must be a leaf parameter, an
+// error is returned if it is an object.
+func (p *ActionParam) FlagType() (string, error) {
+	switch t := p.Type.(type) {
+	case *ArrayDataType:
+		return "[]string", nil
+	case *EnumerableDataType:
+		return "map", nil
+	case *UploadDataType:
+		return "file", nil
+	case *BasicDataType:
+		if *t == TimeDataType {
+			return "time", nil
+		}
+		return string(*t), nil
+	}
+	return "", fmt.Errorf("invalid type for leaf parameter %s: %s", p.QueryName, p.Signature())
+}
+
+// metadata builds the parameter metadata using the given type.
+func (p *ActionParam) metadata(typ string) (*metadata.ActionParam, error) {
+	param := &metadata.ActionParam{
+		Name:        p.QueryName,
+		Description: p.Description,
+		Type:        typ,
+		Location:    metadata.Location(p.Location),
+		Mandatory:   p.Mandatory,
+		NonBlank:    p.NonBlank,
+	}
+	if p.Regexp != "" {
+		rx, err := regexp.Compile(p.Regexp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regexp for %s: %s", p.QueryName, err)
+		}
+		param.Regexp = rx
+	}
+	if len(p.ValidValues) > 0 {
+		param.ValidValues = make([]string, len(p.ValidValues))
+		for i, v := range p.ValidValues {
+			param.ValidValues[i] = fmt.Sprintf("%v", v)
+		}
+	}
+	return param, nil
+}
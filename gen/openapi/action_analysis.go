@@ -0,0 +1,505 @@
+package openapi
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"bitbucket.org/pkg/inflect"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+// Regular expression that captures variables in a path
+var pathVariablesRegexp = regexp.MustCompile(`\{([^/}]+)\}`)
+
+// analyzeActions creates the actions of the given resource from its operations. Operations that
+// map to the same action name and take the same parameters are merged into a single action with
+// multiple path patterns (e.g. "GET /pets" and "GET /owners/{id}/pets").
+func (a *APIAnalyzer) analyzeActions(resourceName string, ops []*operation) ([]*gen.Action, error) {
+	var actions []*gen.Action
+	byName := make(map[string]*gen.Action)
+	for _, op := range ops {
+		name := crudActionName(resourceName, op)
+		if name == "" {
+			name = pathActionName(op)
+		}
+		action, err := a.analyzeAction(resourceName, name, op)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %s", op.verb, op.path, err)
+		}
+		if existing, ok := byName[name]; ok {
+			if sameSignature(existing, action) {
+				existing.PathPatterns = append(existing.PathPatterns, action.PathPatterns...)
+				continue
+			}
+			alt := operationActionName(op)
+			if _, ok := byName[alt]; ok || alt == name {
+				return nil, fmt.Errorf("%s %s: %s action %s is already defined with different parameters",
+					op.verb, op.path, resourceName, name)
+			}
+			action.Name = alt
+			action.MethodName = inflect.Camelize(alt)
+		}
+		byName[action.Name] = action
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+// analyzeAction creates the action with the given name from the given operation.
+func (a *APIAnalyzer) analyzeAction(resourceName, name string, op *operation) (*gen.Action, error) {
+	description := fmt.Sprintf("No description provided for %s.", name)
+	summary, _ := op.raw["summary"].(string)
+	desc, _ := op.raw["description"].(string)
+	if summary != "" && desc != "" && !strings.HasPrefix(desc, summary) {
+		description = summary + "\n" + desc
+	} else if desc != "" {
+		description = desc
+	} else if summary != "" {
+		description = summary
+	}
+	pattern := toPattern(op.verb, a.basePath+op.path)
+
+	params := []*gen.ActionParam{} // Query and payload params
+	leafParams := []*gen.ActionParam{}
+	pathParamNames := []string{}
+	queryParamNames := []string{}
+	payloadParamNames := []string{}
+
+	// Path and query params analysis, operation parameters override path item parameters
+	raws, err := a.parameters(op)
+	if err != nil {
+		return nil, err
+	}
+	var body map[string]interface{} // Swagger body parameter
+	for _, p := range raws {
+		pname, _ := p["name"].(string)
+		switch p["in"] {
+		case "path":
+			pathParamNames = append(pathParamNames, pname)
+		case "query":
+			param, err := a.analyzeParam(p)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to compute type of param %s: %s", pname, err)
+			}
+			param.Location = gen.QueryParam
+			queryParamNames = append(queryParamNames, pname)
+			params = append(params, param)
+			leafParams = append(leafParams, param)
+		case "formData":
+			param, err := a.analyzeParam(p)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to compute type of param %s: %s", pname, err)
+			}
+			if p["type"] == "file" {
+				param.Type = &gen.UploadDataType{TypeName: "FileUpload"}
+			}
+			param.Location = gen.PayloadParam
+			payloadParamNames = append(payloadParamNames, pname)
+			params = append(params, param)
+			leafParams = append(leafParams, param)
+		case "body":
+			body = p
+		case "header":
+			if strings.EqualFold(pname, "X-Api-Version") {
+				// The API version header is sent by the generated client (see -version).
+				if v := a.headerVersion(p); v != "" && a.descriptor.HeaderVersion == "" {
+					a.descriptor.HeaderVersion = v
+				}
+				continue
+			}
+			fallthrough
+		default:
+			// Header and cookie parameters are not supported by rsc, the client could not make
+			// valid requests if they are required.
+			if req, _ := p["required"].(bool); req {
+				return nil, fmt.Errorf("required %v parameter %s is not supported", p["in"], pname)
+			}
+		}
+	}
+
+	// Payload params analysis
+	var schema map[string]interface{}
+	var required bool
+	if body != nil {
+		schema, _ = body["schema"].(map[string]interface{})
+		required, _ = body["required"].(bool)
+	} else if rb, ok := op.raw["requestBody"].(map[string]interface{}); ok {
+		if rb, err = a.deref(rb); err != nil {
+			return nil, err
+		}
+		schema, _ = jsonContent(rb)
+		required, _ = rb["required"].(bool)
+	}
+	var payload gen.DataType
+	if schema != nil {
+		resolved, err := a.deref(schema)
+		if err != nil {
+			return nil, err
+		}
+		if isObject(resolved) {
+			// Flatten the object attributes as top level params
+			props, req, err := a.properties(resolved)
+			if err != nil {
+				return nil, err
+			}
+			for _, pn := range sortedKeys(props) {
+				prop, _ := props[pn].(map[string]interface{})
+				att, err := a.analyzeAttribute(pn, pn, prop)
+				if err != nil {
+					return nil, fmt.Errorf("Failed to compute type of payload attribute %s: %s", pn, err)
+				}
+				att.Mandatory = req[pn]
+				att.Location = gen.PayloadParam
+				payloadParamNames = append(payloadParamNames, pn)
+				params = append(params, att)
+				extracted := extractLeafParams(att, att.Name, make(map[*gen.ObjectDataType]bool))
+				for _, e := range extracted {
+					e.Location = gen.PayloadParam
+				}
+				leafParams = append(leafParams, extracted...)
+			}
+		} else {
+			// Raw payload (no attributes)
+			pd, err := a.analyzeType("payload", "payload", schema)
+			if err != nil {
+				return nil, err
+			}
+			payload = pd
+			param := &gen.ActionParam{
+				Name:      "payload",
+				QueryName: "payload",
+				VarName:   "payload",
+				Type:      pd,
+				Location:  gen.PayloadParam,
+				Mandatory: required,
+			}
+			params = append(params, param)
+			leafParams = append(leafParams, param)
+		}
+	}
+
+	// Response analysis
+	status, resp := successResponse(op.raw)
+	hasLocation := false
+	var returnTypeName string
+	if resp != nil {
+		if headers, ok := resp["headers"].(map[string]interface{}); ok {
+			for h := range headers {
+				if strings.EqualFold(h, "Location") {
+					hasLocation = true
+				}
+			}
+		}
+		if status == 201 && name == "create" {
+			hasLocation = true
+		}
+		if !hasLocation {
+			if schema, isJSON := a.responseSchema(op.raw, resp); schema != nil {
+				if isJSON {
+					if returnTypeName, err = a.returnType(schema); err != nil {
+						return nil, err
+					}
+					a.descriptor.NeedJSON = true
+				} else {
+					returnTypeName = "string"
+				}
+			}
+		}
+	}
+	if hasLocation {
+		returnTypeName = fmt.Sprintf("*%sLocator", resourceName)
+	}
+
+	return &gen.Action{
+		Name:              name,
+		MethodName:        inflect.Camelize(name),
+		Description:       removeBlankLines(description),
+		ResourceName:      resourceName,
+		PathPatterns:      []*gen.PathPattern{pattern},
+		Payload:           payload,
+		Params:            params,
+		LeafParams:        leafParams,
+		Return:            returnTypeName,
+		ReturnLocation:    hasLocation,
+		QueryParamNames:   queryParamNames,
+		PayloadParamNames: payloadParamNames,
+		PathParamNames:    pathParamNames,
+	}, nil
+}
+
+// parameters returns the resolved parameters of the given operation, parameters defined on the
+// operation override the parameters with the same name and location defined on the path item.
+func (a *APIAnalyzer) parameters(op *operation) ([]map[string]interface{}, error) {
+	var params []map[string]interface{}
+	index := make(map[string]int)
+	for _, raws := range [][]interface{}{op.params, rawParams(op.raw)} {
+		for _, raw := range raws {
+			m, ok := raw.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("Invalid parameter %s", prettify(raw))
+			}
+			p, err := a.deref(m)
+			if err != nil {
+				return nil, err
+			}
+			key := fmt.Sprintf("%v:%v", p["in"], p["name"])
+			if i, ok := index[key]; ok {
+				params[i] = p
+			} else {
+				index[key] = len(params)
+				params = append(params, p)
+			}
+		}
+	}
+	return params, nil
+}
+
+// headerVersion returns the value of an X-Api-Version header parameter, i.e. the single value of
+// its enum, or the empty string if the parameter does not define a single value.
+func (a *APIAnalyzer) headerVersion(p map[string]interface{}) string {
+	schema := p
+	if s, ok := p["schema"].(map[string]interface{}); ok {
+		if resolved, err := a.deref(s); err == nil {
+			schema = resolved
+		}
+	}
+	enum, _ := schema["enum"].([]interface{})
+	if len(enum) != 1 {
+		return ""
+	}
+	v, _ := enum[0].(string)
+	return v
+}
+
+// returnType returns the go type of the action result given the response schema.
+func (a *APIAnalyzer) returnType(schema map[string]interface{}) (string, error) {
+	if ref, ok := schema["$ref"].(string); ok {
+		if res, ok := a.resourceSchemas[refName(ref)]; ok {
+			return "*" + res, nil
+		}
+	}
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		if ref, ok := items["$ref"].(string); ok {
+			if res, ok := a.resourceSchemas[refName(ref)]; ok {
+				return "[]*" + res, nil
+			}
+		}
+	}
+	dt, err := a.analyzeType("return", "return", schema)
+	if err != nil {
+		return "", err
+	}
+	param := gen.ActionParam{Type: dt}
+	return param.Signature(), nil
+}
+
+// responseSchema returns the schema of the given response and whether the response is JSON.
+func (a *APIAnalyzer) responseSchema(op, resp map[string]interface{}) (map[string]interface{}, bool) {
+	if resp == nil {
+		return nil, false
+	}
+	resp, err := a.deref(resp)
+	if err != nil {
+		return nil, false
+	}
+	if !a.swagger {
+		return jsonContent(resp)
+	}
+	schema, _ := resp["schema"].(map[string]interface{})
+	produces, ok := op["produces"].([]interface{})
+	if !ok {
+		produces, _ = a.Doc["produces"].([]interface{})
+	}
+	if len(produces) == 0 {
+		return schema, true
+	}
+	for _, p := range produces {
+		if s, _ := p.(string); isJSONMediaType(s) {
+			return schema, true
+		}
+	}
+	return schema, false
+}
+
+// jsonContent returns the schema of the JSON content of an OpenAPI 3 request body or response
+// and true. It returns the schema of the first content and false if the content is not JSON.
+func jsonContent(m map[string]interface{}) (map[string]interface{}, bool) {
+	content, _ := m["content"].(map[string]interface{})
+	if len(content) == 0 {
+		return nil, false
+	}
+	for _, mt := range sortedKeys(content) {
+		if isJSONMediaType(mt) {
+			c, _ := content[mt].(map[string]interface{})
+			s, _ := c["schema"].(map[string]interface{})
+			return s, true
+		}
+	}
+	c, _ := content[sortedKeys(content)[0]].(map[string]interface{})
+	s, _ := c["schema"].(map[string]interface{})
+	if s == nil {
+		s = map[string]interface{}{"type": "string"}
+	}
+	return s, false
+}
+
+// successResponse returns the first success response of the given operation and its status code.
+func successResponse(op map[string]interface{}) (int, map[string]interface{}) {
+	responses, _ := op["responses"].(map[string]interface{})
+	for _, code := range sortedKeys(responses) {
+		s, err := strconv.Atoi(code)
+		if err != nil || s < 200 || s > 299 {
+			continue // Skip error and default responses
+		}
+		resp, _ := responses[code].(map[string]interface{})
+		return s, resp
+	}
+	return 0, nil
+}
+
+// resourceName returns the name of the resource the given operation belongs to. This is the
+// singular form of the first tag of the operation if any. Otherwise it is inferred from the path:
+// it is the segment preceding the last variable if the path ends with a variable or the last
+// segment otherwise.
+func resourceName(op *operation) string {
+	if tags, ok := op.raw["tags"].([]interface{}); ok && len(tags) > 0 {
+		if t, ok := tags[0].(string); ok && t != "" {
+			return toResourceName(t)
+		}
+	}
+	coll, item := collection(op.path)
+	if !item && !isPlural(coll) {
+		// Singular segment following an item, e.g. "/pets/{id}/photo", assume custom action
+		segs := strings.Split(strings.Trim(op.path, "/"), "/")
+		if n := len(segs); n > 2 && pathVariablesRegexp.MatchString(segs[n-2]) {
+			if parent, _ := collection(strings.Join(segs[:n-1], "/")); parent != "" {
+				coll = parent
+			}
+		}
+	}
+	return toResourceName(coll)
+}
+
+// crudActionName returns the name of the standard action corresponding to the operation if the
+// operation applies to the resource collection or resource items, blank otherwise.
+func crudActionName(resourceName string, op *operation) string {
+	coll, item := collection(op.path)
+	if coll == "" || toResourceName(coll) != resourceName {
+		return ""
+	}
+	switch {
+	case !item && op.verb == "GET":
+		return "index"
+	case !item && op.verb == "POST":
+		return "create"
+	case item && op.verb == "GET":
+		return "show"
+	case item && (op.verb == "PUT" || op.verb == "PATCH"):
+		return "update"
+	case item && op.verb == "DELETE":
+		return "destroy"
+	}
+	return ""
+}
+
+// pathActionName returns the action name derived from the last path segment, e.g. "disable" for
+// "/alerts/{id}/disable", or from the operation if the last path segment is a variable.
+func pathActionName(op *operation) string {
+	segs := strings.Split(strings.Trim(op.path, "/"), "/")
+	last := segs[len(segs)-1]
+	if last == "" || pathVariablesRegexp.MatchString(last) {
+		return operationActionName(op)
+	}
+	return toActionName(last)
+}
+
+// operationActionName returns the action name derived from the operation id, or the lower case
+// HTTP method if the operation has no id.
+func operationActionName(op *operation) string {
+	if id, ok := op.raw["operationId"].(string); ok && id != "" {
+		return toActionName(id)
+	}
+	return strings.ToLower(op.verb)
+}
+
+// collection returns the last non variable segment of the path and whether the path ends with
+// a variable (that is whether it identifies a collection item).
+func collection(path string) (string, bool) {
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	last := len(segs) - 1
+	if last < 0 || segs[last] == "" {
+		return "", false
+	}
+	if !pathVariablesRegexp.MatchString(segs[last]) {
+		return segs[last], false
+	}
+	if last == 0 || pathVariablesRegexp.MatchString(segs[last-1]) {
+		return "", true
+	}
+	return segs[last-1], true
+}
+
+// sameSignature returns true if the two actions take the same parameters and return the same type.
+func sameSignature(a1, a2 *gen.Action) bool {
+	if a1.Return != a2.Return || len(a1.Params) != len(a2.Params) {
+		return false
+	}
+	for i, p := range a1.Params {
+		if p.Location != a2.Params[i].Location || !p.IsEquivalent(a2.Params[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Create path pattern from HTTP verb and request path
+func toPattern(verb, path string) *gen.PathPattern {
+	pattern := gen.PathPattern{
+		HTTPMethod: verb,
+		Path:       path,
+		Pattern:    pathVariablesRegexp.ReplaceAllLiteralString(path, "%s"),
+	}
+	var rx []string
+	last := 0
+	for _, m := range pathVariablesRegexp.FindAllStringSubmatchIndex(path, -1) {
+		rx = append(rx, regexp.QuoteMeta(path[last:m[0]]), `([^/]+)`)
+		pattern.Variables = append(pattern.Variables, path[m[2]:m[3]])
+		last = m[1]
+	}
+	rx = append(rx, regexp.QuoteMeta(path[last:]))
+	pattern.Regexp = strings.Join(rx, "")
+	return &pattern
+}
+
+// Extract leaf parameters from given action param
+func extractLeafParams(a *gen.ActionParam, root string, visiting map[*gen.ObjectDataType]bool) []*gen.ActionParam {
+	switch t := a.Type.(type) {
+	case *gen.BasicDataType, *gen.EnumerableDataType, *gen.UploadDataType:
+		dup := *a
+		dup.QueryName = root
+		return []*gen.ActionParam{&dup}
+	case *gen.ArrayDataType:
+		return extractLeafParams(t.ElemType, root+"[]", visiting)
+	case *gen.ObjectDataType:
+		if visiting[t] {
+			return nil // Recursive type
+		}
+		visiting[t] = true
+		params := []*gen.ActionParam{}
+		for _, f := range t.Fields {
+			params = append(params, extractLeafParams(f, fmt.Sprintf("%s[%s]", root, f.Name), visiting)...)
+		}
+		delete(visiting, t)
+		return params
+	}
+	return nil
+}
+
+// rawParams returns the raw parameters of the given operation.
+func rawParams(op map[string]interface{}) []interface{} {
+	params, _ := op["parameters"].([]interface{})
+	return params
+}
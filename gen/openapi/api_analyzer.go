@@ -0,0 +1,258 @@
+package openapi
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+// HTTP methods of operations that are analyzed in the order they are analyzed.
+var httpMethods = []string{"get", "post", "put", "patch", "delete"}
+
+// APIAnalyzer holds the analysis results.
+type APIAnalyzer struct {
+	// Raw OpenAPI 2 (Swagger) or 3 document
+	Doc map[string]interface{}
+	// Name of golang struct to generate for API client
+	ClientName string
+
+	// Temporary data structures used by analysis
+
+	// Descriptor being built
+	descriptor *gen.APIDescriptor
+	// Whether the document is a Swagger (OpenAPI 2) document
+	swagger bool
+	// Prefix of all paths ("basePath" or path of first server URL)
+	basePath string
+	// Named types indexed by go name
+	namedTypes map[string]*gen.ObjectDataType
+	// Inline types indexed by go name, names are made unique once the analysis completes
+	inlineTypes map[string][]*gen.ObjectDataType
+	// Name of resources indexed by name of schema describing them
+	resourceSchemas map[string]string
+}
+
+// operation is an OpenAPI operation together with its path and HTTP method.
+type operation struct {
+	verb   string                 // HTTP method in upper case, e.g. "GET"
+	path   string                 // Path relative to the base path, e.g. "/pets/{id}"
+	raw    map[string]interface{} // Raw operation
+	params []interface{}          // Raw path item parameters shared by all the path operations
+}
+
+// NewAPIAnalyzer is the factory method for APIAnalyzer.
+func NewAPIAnalyzer(doc map[string]interface{}, clientName string) *APIAnalyzer {
+	return &APIAnalyzer{
+		Doc:             doc,
+		ClientName:      clientName,
+		namedTypes:      make(map[string]*gen.ObjectDataType),
+		inlineTypes:     make(map[string][]*gen.ObjectDataType),
+		resourceSchemas: make(map[string]string),
+	}
+}
+
+// Analyze creates an API descriptor from the OpenAPI document.
+// Operations are grouped into resources using their first tag or their path if they have no tag.
+// Operations on the resource collection and item paths are mapped to the usual "index", "show",
+// "create", "update" and "destroy" actions, other operations are named after the last segment of
+// their path or their operationId.
+func (a *APIAnalyzer) Analyze() (*gen.APIDescriptor, error) {
+	if v, ok := a.Doc["swagger"].(string); ok && strings.HasPrefix(v, "2.") {
+		a.swagger = true
+	} else if v, ok := a.Doc["openapi"].(string); !ok || !strings.HasPrefix(v, "3.") {
+		return nil, fmt.Errorf("Unsupported document, only Swagger 2.0 and OpenAPI 3 documents are supported")
+	}
+	descriptor := gen.APIDescriptor{
+		Resources: make(map[string]*gen.Resource),
+		Types:     make(map[string]*gen.ObjectDataType),
+	}
+	if info, ok := a.Doc["info"].(map[string]interface{}); ok {
+		descriptor.Version, _ = info["version"].(string)
+	}
+	a.descriptor = &descriptor
+	a.basePath = a.docBasePath()
+
+	// 1. Group operations by resource
+	paths, ok := a.Doc["paths"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Missing \"paths\" in document")
+	}
+	ops := make(map[string][]*operation)
+	for _, p := range sortedKeys(paths) {
+		item, ok := paths[p].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Invalid path item for %s", p)
+		}
+		params, _ := item["parameters"].([]interface{})
+		for _, m := range httpMethods {
+			raw, ok := item[m].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			op := &operation{verb: strings.ToUpper(m), path: p, raw: raw, params: params}
+			name := resourceName(op)
+			if name == "" {
+				return nil, fmt.Errorf("%s %s: cannot infer resource name", op.verb, p)
+			}
+			ops[name] = append(ops[name], op)
+		}
+	}
+	resNames := make([]string, 0, len(ops))
+	for n := range ops {
+		resNames = append(resNames, n)
+	}
+	sort.Strings(resNames)
+
+	// 2. Identify the schemas that describe resources so that actions may return resources
+	for _, name := range resNames {
+		if s := a.resourceSchema(name, ops[name]); s != "" {
+			if _, ok := a.resourceSchemas[s]; !ok {
+				a.resourceSchemas[s] = name
+			}
+		}
+	}
+
+	// 3. Analyze each resource
+	for _, name := range resNames {
+		if err := a.analyzeResource(name, ops[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	// We're done
+	a.finalizeTypeNames()
+	return &descriptor, nil
+}
+
+// analyzeResource creates the resource with the given name from its operations.
+func (a *APIAnalyzer) analyzeResource(name string, ops []*operation) error {
+	resource := gen.Resource{
+		Name:        name,
+		ClientName:  a.ClientName,
+		Description: a.tagDescription(ops[0]),
+		Attributes:  []*gen.Attribute{},
+	}
+
+	// Attributes, iterate over sorted schema names so the attributes are always in the same order
+	schemaNames := make([]string, 0, len(a.resourceSchemas))
+	for s := range a.resourceSchemas {
+		schemaNames = append(schemaNames, s)
+	}
+	sort.Strings(schemaNames)
+	for _, s := range schemaNames {
+		if a.resourceSchemas[s] != name {
+			continue
+		}
+		schema, err := a.resolveRef(schemaRef(s, a.swagger))
+		if err != nil {
+			return err
+		}
+		props, _, err := a.properties(schema)
+		if err != nil {
+			return err
+		}
+		hasHref := false
+		for _, pn := range sortedKeys(props) {
+			prop, _ := props[pn].(map[string]interface{})
+			param, err := a.analyzeAttribute(pn, pn, prop)
+			if err != nil {
+				return fmt.Errorf("Failed to compute type of attribute %s of %s: %s", pn, name, err)
+			}
+			if pn == "href" {
+				hasHref = true
+			}
+			resource.Attributes = append(resource.Attributes,
+				&gen.Attribute{Name: pn, FieldName: toFieldName(pn), FieldType: param.Signature()})
+		}
+		if hasHref {
+			resource.LocatorFunc = "return api." + name + "Locator(r.Href)"
+		}
+	}
+
+	// Actions
+	actions, err := a.analyzeActions(name, ops)
+	if err != nil {
+		return err
+	}
+	resource.Actions = actions
+
+	a.descriptor.Resources[name] = &resource
+	a.descriptor.ResourceNames = append(a.descriptor.ResourceNames, name)
+	return nil
+}
+
+// resourceSchema returns the name of the schema returned by the "show" action of the resource or
+// of the items returned by its "index" action, blank if none.
+func (a *APIAnalyzer) resourceSchema(name string, ops []*operation) string {
+	var index string
+	for _, op := range ops {
+		action := crudActionName(name, op)
+		if action != "show" && action != "index" {
+			continue
+		}
+		_, resp := successResponse(op.raw)
+		schema, isJSON := a.responseSchema(op.raw, resp)
+		if schema == nil || !isJSON {
+			continue
+		}
+		if action == "show" {
+			if ref, ok := schema["$ref"].(string); ok {
+				return refName(ref)
+			}
+		} else if index == "" {
+			if items, ok := schema["items"].(map[string]interface{}); ok {
+				if ref, ok := items["$ref"].(string); ok {
+					index = refName(ref)
+				}
+			}
+		}
+	}
+	return index
+}
+
+// tagDescription returns the description of the first tag of the given operation if any.
+func (a *APIAnalyzer) tagDescription(op *operation) string {
+	tags, _ := op.raw["tags"].([]interface{})
+	if len(tags) == 0 {
+		return ""
+	}
+	docTags, _ := a.Doc["tags"].([]interface{})
+	for _, t := range docTags {
+		tag, _ := t.(map[string]interface{})
+		if tag["name"] == tags[0] {
+			d, _ := tag["description"].(string)
+			return removeBlankLines(d)
+		}
+	}
+	return ""
+}
+
+// docBasePath returns the prefix of all the API paths: the "basePath" of Swagger documents or the
+// path of the first server URL of OpenAPI 3 documents.
+func (a *APIAnalyzer) docBasePath() string {
+	var base string
+	if a.swagger {
+		base, _ = a.Doc["basePath"].(string)
+	} else if servers, ok := a.Doc["servers"].([]interface{}); ok && len(servers) > 0 {
+		if server, ok := servers[0].(map[string]interface{}); ok {
+			if u, ok := server["url"].(string); ok {
+				if parsed, err := url.Parse(u); err == nil {
+					base = parsed.Path
+				}
+			}
+		}
+	}
+	return strings.TrimSuffix(base, "/")
+}
+
+// finalizeTypeNames makes sure type names are unique, it should be called after analysis
+// has completed.
+func (a *APIAnalyzer) finalizeTypeNames() {
+	for n, named := range a.namedTypes {
+		a.inlineTypes[n] = append(a.inlineTypes[n], named)
+	}
+	a.descriptor.FinalizeTypeNames(a.inlineTypes)
+}
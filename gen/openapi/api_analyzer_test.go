@@ -0,0 +1,374 @@
+package openapi
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+var _ = Describe("APIAnalyzer", func() {
+	var (
+		doc string
+
+		descriptor *gen.APIDescriptor
+		err        error
+	)
+
+	JustBeforeEach(func() {
+		var raw map[string]interface{}
+		Ω(json.Unmarshal([]byte(doc), &raw)).Should(Succeed())
+		descriptor, err = NewAPIAnalyzer(raw, "API").Analyze()
+	})
+
+	// findAction returns the action with the given name of the resource with the given name.
+	findAction := func(resource, name string) *gen.Action {
+		r, ok := descriptor.Resources[resource]
+		Ω(ok).Should(BeTrue(), "resource "+resource+" not found")
+		for _, a := range r.Actions {
+			if a.Name == name {
+				return a
+			}
+		}
+		Fail("action " + resource + "." + name + " not found")
+		return nil
+	}
+
+	Context("given a Swagger 2.0 document", func() {
+		BeforeEach(func() {
+			doc = swaggerPetstore
+		})
+
+		It("creates one resource per tag", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(descriptor.Version).Should(Equal("1.0.0"))
+			Ω(descriptor.ResourceNames).Should(Equal([]string{"Pet", "Store"}))
+			Ω(descriptor.Resources["Pet"].Description).Should(Equal("Everything about your pets"))
+		})
+
+		It("maps the collection and item operations to the standard actions", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			index := findAction("Pet", "index")
+			Ω(index.PathPatterns).Should(HaveLen(2))
+			Ω(index.PathPatterns[0].Pattern).Should(Equal("/v1/owners/%s/pets"))
+			Ω(index.PathPatterns[0].Variables).Should(Equal([]string{"ownerId"}))
+			Ω(index.PathPatterns[1].Pattern).Should(Equal("/v1/pets"))
+			Ω(index.Return).Should(Equal("[]*Pet"))
+			show := findAction("Pet", "show")
+			Ω(show.PathPatterns[0].HTTPMethod).Should(Equal("GET"))
+			Ω(show.PathPatterns[0].Pattern).Should(Equal("/v1/pets/%s"))
+			Ω(show.PathPatterns[0].Regexp).Should(Equal(`/v1/pets/([^/]+)`))
+			Ω(show.PathParamNames).Should(Equal([]string{"petId"}))
+			Ω(show.Return).Should(Equal("*Pet"))
+			Ω(findAction("Pet", "destroy").Return).Should(BeEmpty())
+		})
+
+		It("names other actions after their path", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			vaccinate := findAction("Pet", "vaccinate")
+			Ω(vaccinate.PathPatterns[0].HTTPMethod).Should(Equal("POST"))
+			Ω(vaccinate.Description).Should(Equal("Vaccinate a pet"))
+			inventory := findAction("Store", "inventory")
+			Ω(inventory.Return).Should(Equal("map[string]interface{}"))
+		})
+
+		It("analyzes query parameters", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			index := findAction("Pet", "index")
+			Ω(index.QueryParamNames).Should(Equal([]string{"limit", "tags"}))
+			limit := index.Params[0]
+			Ω(limit.Location).Should(Equal(gen.QueryParam))
+			Ω(limit.Signature()).Should(Equal("int"))
+			Ω(limit.Max).Should(Equal(100))
+			tags := index.Params[1]
+			Ω(tags.QueryName).Should(Equal("tags"))
+			Ω(tags.Signature()).Should(Equal("[]string"))
+		})
+
+		It("flattens the payload", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			create := findAction("Pet", "create")
+			Ω(create.PayloadParamNames).Should(Equal([]string{"name", "owner", "tag"}))
+			Ω(create.MandatoryParams()).Should(HaveLen(1))
+			Ω(create.MandatoryParams()[0].Name).Should(Equal("name"))
+			var names []string
+			for _, p := range create.LeafParams {
+				names = append(names, p.QueryName)
+			}
+			Ω(names).Should(Equal([]string{"name", "owner[email]", "owner[name]", "tag"}))
+			Ω(create.ReturnLocation).Should(BeTrue())
+			Ω(create.Return).Should(Equal("*PetLocator"))
+		})
+
+		It("maps form parameters to payload parameters", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			feed := findAction("Pet", "feed")
+			Ω(feed.PayloadParamNames).Should(Equal([]string{"food", "menu"}))
+			Ω(feed.MandatoryParams()).Should(HaveLen(1))
+			Ω(feed.MandatoryParams()[0].Name).Should(Equal("food"))
+			Ω(feed.Params[0].Location).Should(Equal(gen.PayloadParam))
+			Ω(feed.Params[1].Signature()).Should(Equal("*rsapi.FileUpload"))
+		})
+
+		Context("with a required header parameter", func() {
+			BeforeEach(func() {
+				doc = `{"swagger": "2.0", "info": {"title": "Petstore", "version": "1.0.0"},
+				  "paths": {"/pets": {"get": {
+				    "parameters": [{"name": "X-Token", "in": "header", "required": true, "type": "string"}],
+				    "responses": {"200": {"description": "pets"}}}}}}`
+			})
+
+			It("fails", func() {
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("required header parameter X-Token is not supported"))
+			})
+		})
+
+		It("creates types for the referenced schemas", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(descriptor.TypeNames).Should(ContainElement("Owner"))
+			owner := descriptor.Types["Owner"]
+			Ω(owner.Fields).Should(HaveLen(2))
+			Ω(owner.Fields[0].Name).Should(Equal("email"))
+			Ω(owner.Fields[0].Regexp).Should(Equal(".+@.+"))
+		})
+
+		It("initializes the resource attributes", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			pet := descriptor.Resources["Pet"]
+			Ω(pet.Attributes).Should(HaveLen(4))
+			Ω(pet.Attributes[0].Name).Should(Equal("born_at"))
+			Ω(pet.Attributes[0].FieldName).Should(Equal("BornAt"))
+			Ω(pet.Attributes[0].FieldType).Should(Equal("*rsapi.Time"))
+			Ω(pet.LocatorFunc).Should(Equal("return api.PetLocator(r.Href)"))
+		})
+	})
+
+	Context("given an OpenAPI 3 document", func() {
+		BeforeEach(func() {
+			doc = openAPIPetstore
+		})
+
+		It("uses the server URL path as base path", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(findAction("Pet", "show").PathPatterns[0].Pattern).Should(Equal("/api/pets/%s"))
+		})
+
+		It("groups operations by path when they have no tags", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(descriptor.ResourceNames).Should(Equal([]string{"Pet"}))
+		})
+
+		It("analyzes the request body", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			update := findAction("Pet", "update")
+			Ω(update.PathPatterns[0].HTTPMethod).Should(Equal("PATCH"))
+			Ω(update.PayloadParamNames).Should(Equal([]string{"name", "tags"}))
+			var names []string
+			for _, p := range update.LeafParams {
+				names = append(names, p.QueryName)
+			}
+			Ω(names).Should(Equal([]string{"name", "tags[]"}))
+		})
+
+		It("returns text responses as strings", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			photo := findAction("Pet", "photo")
+			Ω(photo.Return).Should(Equal("string"))
+			Ω(photo.IsStreamable()).Should(BeTrue())
+		})
+
+		It("resolves parameter references", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			index := findAction("Pet", "index")
+			Ω(index.QueryParamNames).Should(Equal([]string{"filter", "limit"}))
+			Ω(index.Params[0].Signature()).Should(Equal("map[string]interface{}"))
+			Ω(index.Params[1].Mandatory).Should(BeTrue())
+		})
+
+		Context("with a required X-Api-Version header parameter", func() {
+			BeforeEach(func() {
+				doc = `{"openapi": "3.0.0", "info": {"title": "CM", "version": "1.5"},
+				  "paths": {"/api/clouds": {"get": {
+				    "parameters": [{"name": "X-Api-Version", "in": "header", "required": true,
+				      "schema": {"type": "string", "enum": ["1.5"]}}],
+				    "responses": {"200": {"description": "clouds"}}}}}}`
+			})
+
+			It("records the API version", func() {
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(descriptor.HeaderVersion).Should(Equal("1.5"))
+				Ω(findAction("Cloud", "index").Params).Should(BeEmpty())
+			})
+		})
+	})
+
+	Context("given an unsupported document", func() {
+		BeforeEach(func() {
+			doc = `{"swagger": "1.2", "paths": {}}`
+		})
+
+		It("fails", func() {
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+})
+
+const swaggerPetstore = `{
+  "swagger": "2.0",
+  "info": {"title": "Petstore", "version": "1.0.0"},
+  "basePath": "/v1",
+  "tags": [{"name": "pets", "description": "Everything about your pets"}],
+  "paths": {
+    "/pets": {
+      "get": {
+        "tags": ["pets"],
+        "operationId": "listPets",
+        "parameters": [
+          {"name": "limit", "in": "query", "type": "integer", "maximum": 100},
+          {"name": "tags", "in": "query", "type": "array", "items": {"type": "string"}}
+        ],
+        "responses": {"200": {"description": "pets", "schema": {"type": "array", "items": {"$ref": "#/definitions/Pet"}}}}
+      },
+      "post": {
+        "tags": ["pets"],
+        "operationId": "createPet",
+        "parameters": [{"name": "pet", "in": "body", "schema": {"$ref": "#/definitions/NewPet"}}],
+        "responses": {"201": {"description": "created"}}
+      }
+    },
+    "/owners/{ownerId}/pets": {
+      "get": {
+        "tags": ["pets"],
+        "operationId": "listOwnerPets",
+        "parameters": [
+          {"name": "ownerId", "in": "path", "required": true, "type": "string"},
+          {"name": "limit", "in": "query", "type": "integer", "maximum": 100},
+          {"name": "tags", "in": "query", "type": "array", "items": {"type": "string"}}
+        ],
+        "responses": {"200": {"description": "pets", "schema": {"type": "array", "items": {"$ref": "#/definitions/Pet"}}}}
+      }
+    },
+    "/pets/{petId}": {
+      "parameters": [{"name": "petId", "in": "path", "required": true, "type": "string"}],
+      "get": {
+        "tags": ["pets"],
+        "operationId": "showPetById",
+        "responses": {"200": {"description": "pet", "schema": {"$ref": "#/definitions/Pet"}}, "404": {"description": "not found"}}
+      },
+      "delete": {
+        "tags": ["pets"],
+        "responses": {"204": {"description": "deleted"}}
+      }
+    },
+    "/pets/{petId}/vaccinate": {
+      "post": {
+        "tags": ["pets"],
+        "summary": "Vaccinate a pet",
+        "parameters": [{"name": "petId", "in": "path", "required": true, "type": "string"}],
+        "responses": {"204": {"description": "vaccinated"}}
+      }
+    },
+    "/pets/{petId}/feed": {
+      "post": {
+        "tags": ["pets"],
+        "summary": "Feed a pet",
+        "consumes": ["multipart/form-data"],
+        "parameters": [
+          {"name": "petId", "in": "path", "required": true, "type": "string"},
+          {"name": "food", "in": "formData", "required": true, "type": "string"},
+          {"name": "menu", "in": "formData", "type": "file"},
+          {"name": "X-Request-Id", "in": "header", "type": "string"}
+        ],
+        "responses": {"204": {"description": "fed"}}
+      }
+    },
+    "/store/inventory": {
+      "get": {
+        "tags": ["store"],
+        "operationId": "getInventory",
+        "responses": {"200": {"description": "inventory", "schema": {"type": "object", "additionalProperties": {"type": "integer"}}}}
+      }
+    }
+  },
+  "definitions": {
+    "Pet": {
+      "type": "object",
+      "properties": {
+        "born_at": {"type": "string", "format": "date-time"},
+        "href": {"type": "string"},
+        "name": {"type": "string"},
+        "owner": {"$ref": "#/definitions/Owner"}
+      }
+    },
+    "NewPet": {
+      "type": "object",
+      "required": ["name"],
+      "properties": {
+        "name": {"type": "string", "minLength": 1},
+        "owner": {"$ref": "#/definitions/Owner"},
+        "tag": {"type": "string", "enum": ["cat", "dog"]}
+      }
+    },
+    "Owner": {
+      "type": "object",
+      "properties": {
+        "email": {"type": "string", "pattern": ".+@.+"},
+        "name": {"type": "string"}
+      }
+    }
+  }
+}`
+
+const openAPIPetstore = `{
+  "openapi": "3.0.0",
+  "info": {"title": "Petstore", "version": "2.0"},
+  "servers": [{"url": "https://petstore.example.com/api"}],
+  "paths": {
+    "/pets": {
+      "get": {
+        "parameters": [
+          {"name": "filter", "in": "query", "style": "deepObject", "schema": {"type": "object", "properties": {"name": {"type": "string"}}}},
+          {"$ref": "#/components/parameters/limit"}
+        ],
+        "responses": {"200": {"description": "pets", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Pet"}}}}}}
+      }
+    },
+    "/pets/{id}": {
+      "get": {
+        "parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}],
+        "responses": {"200": {"description": "pet", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}}}
+      },
+      "patch": {
+        "parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}],
+        "requestBody": {"$ref": "#/components/requestBodies/PetUpdate"},
+        "responses": {"204": {"description": "updated"}}
+      }
+    },
+    "/pets/{id}/photo": {
+      "get": {
+        "parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}],
+        "responses": {"200": {"description": "photo", "content": {"image/png": {"schema": {"type": "string", "format": "binary"}}}}}
+      }
+    }
+  },
+  "components": {
+    "parameters": {
+      "limit": {"name": "limit", "in": "query", "required": true, "schema": {"type": "integer"}}
+    },
+    "requestBodies": {
+      "PetUpdate": {
+        "content": {"application/json": {"schema": {"allOf": [
+          {"$ref": "#/components/schemas/PetName"},
+          {"type": "object", "properties": {"tags": {"type": "array", "items": {"type": "string"}}}}
+        ]}}}
+      }
+    },
+    "schemas": {
+      "Pet": {"type": "object", "properties": {"id": {"type": "string"}, "name": {"type": "string"}}},
+      "PetName": {"type": "object", "properties": {"name": {"type": "string"}}}
+    }
+  }
+}`
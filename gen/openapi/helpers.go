@@ -0,0 +1,116 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"bitbucket.org/pkg/inflect"
+)
+
+var (
+	// Capture all alphanumerical parts to build go identifier from raw names
+	partsRegexp = regexp.MustCompile("[^[:alnum:]]+")
+
+	// Check whether string only contains blank characters
+	blankRegexp = regexp.MustCompile(`^\s*$`)
+
+	// Go keywords that cannot be used as variable names
+	goKeywords = map[string]bool{"break": true, "case": true, "chan": true, "const": true,
+		"continue": true, "default": true, "defer": true, "else": true, "fallthrough": true,
+		"for": true, "func": true, "go": true, "goto": true, "if": true, "import": true,
+		"interface": true, "map": true, "package": true, "range": true, "return": true,
+		"select": true, "struct": true, "switch": true, "type": true, "var": true}
+)
+
+// Produce go type name from given schema name
+func toGoTypeName(name string) string {
+	return inflect.Camelize(strings.Trim(partsRegexp.ReplaceAllString(name, "_"), "_"))
+}
+
+// Produce go resource name from given tag or path segment, e.g. "pet_stores" => "PetStore"
+func toResourceName(name string) string {
+	name = strings.Trim(partsRegexp.ReplaceAllString(name, "_"), "_")
+	if name == "" {
+		return ""
+	}
+	name = inflect.Underscore(name)
+	if s := inflect.Singularize(name); inflect.Pluralize(s) == name {
+		name = s // Only singularize plural names, inflect mangles some singular names
+	}
+	return inflect.Camelize(name)
+}
+
+// isPlural returns true if the given path segment is a plural noun, e.g. "pet_stores".
+func isPlural(name string) bool {
+	name = inflect.Underscore(strings.Trim(partsRegexp.ReplaceAllString(name, "_"), "_"))
+	s := inflect.Singularize(name)
+	return s != name && inflect.Pluralize(s) == name
+}
+
+// Produce action name from given operation id or path segment, e.g. "listPets" => "list_pets"
+func toActionName(name string) string {
+	name = strings.Trim(partsRegexp.ReplaceAllString(name, "_"), "_")
+	return strings.ToLower(inflect.Underscore(name))
+}
+
+// Produce go struct field name from given attribute name
+func toFieldName(name string) string {
+	return inflect.Camelize(strings.Trim(partsRegexp.ReplaceAllString(name, "_"), "_"))
+}
+
+// Parse native names into go parameter names
+func toVarName(name string) string {
+	if name == "options" {
+		return "options_"
+	}
+	p := partsRegexp.ReplaceAllString(name, "_")
+	v := inflect.CamelizeDownFirst(p)
+	if goKeywords[v] {
+		v += "_"
+	}
+	return v
+}
+
+// isJSONMediaType returns true if the given media type is JSON, e.g. "application/vnd.api+json".
+func isJSONMediaType(mt string) bool {
+	mt = strings.ToLower(strings.TrimSpace(strings.Split(mt, ";")[0]))
+	return mt == "application/json" || strings.HasSuffix(mt, "+json") || mt == "*/*"
+}
+
+// Return dumpable representation of given object
+func prettify(o interface{}) string {
+	s, err := json.MarshalIndent(o, "", "    ")
+	if err != nil {
+		return fmt.Sprintf("%+v", o)
+	}
+	return string(s)
+}
+
+// Helper method that removes blank lines from strings
+func removeBlankLines(doc string) string {
+	lines := strings.Split(doc, "\n")
+	fullLines := make([]string, len(lines))
+	i := 0
+	for _, line := range lines {
+		if len(line) > 0 && !blankRegexp.MatchString(line) {
+			fullLines[i] = line
+			i++
+		}
+	}
+	return strings.Join(fullLines[:i], "\n")
+}
+
+// Return keys of given maps sorted
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, len(m))
+	idx := 0
+	for k := range m {
+		keys[idx] = k
+		idx++
+	}
+	sort.Strings(keys)
+	return keys
+}
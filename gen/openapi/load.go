@@ -0,0 +1,31 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+// LoadDescriptor loads the OpenAPI 2 (Swagger) or 3 JSON document in the given file and analyzes
+// it.
+func LoadDescriptor(file, clientName string) (*gen.APIDescriptor, error) {
+	if _, err := os.Stat(file); err != nil {
+		return nil, fmt.Errorf("Cannot find '%s'", file)
+	}
+	js, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot read '%s': %s", file, err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(js, &doc); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal JSON read from '%s': %s", file, err)
+	}
+	d, err := NewAPIAnalyzer(doc, clientName).Analyze()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to analyze '%s': %s", file, err)
+	}
+	return d, nil
+}
@@ -0,0 +1,299 @@
+package openapi
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"bitbucket.org/pkg/inflect"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+// Regular expression used to capture brackets in query name
+var bracketRegexp = regexp.MustCompile(`(\[|\])+`)
+
+// analyzeParam analyzes a query parameter. Swagger parameters describe their type inline while
+// OpenAPI 3 parameters use a schema. Array parameters are sent by repeating the parameter in the
+// query string and object parameters are sent using the "deepObject" style, e.g. "tags[env]=prod".
+func (a *APIAnalyzer) analyzeParam(p map[string]interface{}) (*gen.ActionParam, error) {
+	name, _ := p["name"].(string)
+	schema := p
+	if s, ok := p["schema"].(map[string]interface{}); ok {
+		schema = s
+	}
+	resolved, err := a.deref(schema)
+	if err != nil {
+		return nil, err
+	}
+	var param *gen.ActionParam
+	if isObject(resolved) {
+		param = &gen.ActionParam{Name: name, QueryName: name, VarName: toVarName(name),
+			Type: new(gen.EnumerableDataType)}
+	} else {
+		if param, err = a.analyzeAttribute(name, name, schema); err != nil {
+			return nil, err
+		}
+		param.QueryName = name // No brackets for arrays
+	}
+	if d, ok := p["description"].(string); ok {
+		param.Description = removeBlankLines(d)
+	}
+	param.Mandatory, _ = p["required"].(bool)
+	return param, nil
+}
+
+// analyzeAttribute analyzes an attribute creating a corresponding ActionParam.
+func (a *APIAnalyzer) analyzeAttribute(name, query string, schema map[string]interface{}) (*gen.ActionParam, error) {
+	param := gen.ActionParam{Name: name, QueryName: query, VarName: toVarName(name)}
+	resolved, err := a.deref(schema)
+	if err != nil {
+		return nil, err
+	}
+	if d, ok := schema["description"].(string); ok {
+		param.Description = removeBlankLines(d)
+	} else if d, ok := resolved["description"].(string); ok {
+		param.Description = removeBlankLines(d)
+	}
+	if r, ok := resolved["pattern"].(string); ok {
+		param.Regexp = r
+	}
+	if m, ok := resolved["minimum"].(float64); ok {
+		param.Min = int(m)
+	}
+	if m, ok := resolved["maximum"].(float64); ok {
+		param.Max = int(m)
+	}
+	if m, ok := resolved["minLength"].(float64); ok && m > 0 {
+		param.NonBlank = true
+	}
+	if values, ok := resolved["enum"].([]interface{}); ok {
+		param.ValidValues = values
+	}
+	dataType, err := a.analyzeType(name, query, schema)
+	if err != nil {
+		return nil, err
+	}
+	param.Type = dataType
+	if _, ok := dataType.(*gen.ArrayDataType); ok {
+		param.QueryName += "[]"
+	}
+	return &param, nil
+}
+
+// analyzeType analyzes a type given its schema.
+func (a *APIAnalyzer) analyzeType(name, query string, schema map[string]interface{}) (gen.DataType, error) {
+	if ref, ok := schema["$ref"].(string); ok {
+		return a.analyzeRef(ref, query)
+	}
+	if _, ok := schema["oneOf"]; ok {
+		return basicType("interface{}"), nil
+	}
+	if _, ok := schema["anyOf"]; ok {
+		return basicType("interface{}"), nil
+	}
+	t, _ := schema["type"].(string)
+	if t == "" {
+		if _, ok := schema["items"]; ok {
+			t = "array"
+		} else if isObject(schema) {
+			t = "object"
+		}
+	}
+	switch t {
+	case "integer":
+		return basicType("int"), nil
+	case "number":
+		return basicType("float64"), nil
+	case "boolean":
+		return basicType("bool"), nil
+	case "string", "file":
+		if schema["format"] == "date-time" {
+			return basicType(gen.TimeDataType), nil
+		}
+		return basicType("string"), nil
+	case "array":
+		items, ok := schema["items"].(map[string]interface{})
+		if !ok {
+			items = map[string]interface{}{}
+		}
+		elemType, err := a.analyzeAttribute(name+"Item", query+"[]", items)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to compute type of array items: %s", err)
+		}
+		return &gen.ArrayDataType{ElemType: elemType}, nil
+	case "object":
+		if !isObject(schema) {
+			return new(gen.EnumerableDataType), nil // Free form hash
+		}
+		obj := a.createInlineType(inflect.Camelize(bracketRegexp.ReplaceAllLiteralString(query, "_") + "_struct"))
+		if err := a.analyzeFields(obj, query, schema); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	}
+	return basicType("interface{}"), nil
+}
+
+// analyzeRef analyzes the type defined by the schema with the given reference. Object schemas
+// produce named types, other schemas are analyzed inline.
+func (a *APIAnalyzer) analyzeRef(ref, query string) (gen.DataType, error) {
+	goName := toGoTypeName(refName(ref))
+	if t, ok := a.namedTypes[goName]; ok {
+		return t, nil
+	}
+	schema, err := a.resolveRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	if !isObject(schema) {
+		return a.analyzeType(refName(ref), query, schema)
+	}
+	obj := &gen.ObjectDataType{TypeName: goName}
+	a.namedTypes[goName] = obj // Register first to support recursive types
+	if err := a.analyzeFields(obj, query, schema); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// analyzeFields initializes the fields of the given object type from the object schema.
+func (a *APIAnalyzer) analyzeFields(obj *gen.ObjectDataType, query string, schema map[string]interface{}) error {
+	props, required, err := a.properties(schema)
+	if err != nil {
+		return err
+	}
+	obj.Fields = make([]*gen.ActionParam, len(props))
+	for idx, pn := range sortedKeys(props) {
+		prop, _ := props[pn].(map[string]interface{})
+		field, err := a.analyzeAttribute(pn, fmt.Sprintf("%s[%s]", query, pn), prop)
+		if err != nil {
+			return fmt.Errorf("Failed to compute type of attribute %s: %s", pn, err)
+		}
+		field.Mandatory = required[pn]
+		obj.Fields[idx] = field
+	}
+	return nil
+}
+
+// properties returns the properties and required properties of the given object schema
+// including the properties of the "allOf" schemas.
+func (a *APIAnalyzer) properties(schema map[string]interface{}) (map[string]interface{}, map[string]bool, error) {
+	props := make(map[string]interface{})
+	required := make(map[string]bool)
+	schema, err := a.deref(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	if all, ok := schema["allOf"].([]interface{}); ok {
+		for _, s := range all {
+			sub, ok := s.(map[string]interface{})
+			if !ok {
+				return nil, nil, fmt.Errorf("Invalid allOf schema %s", prettify(s))
+			}
+			p, r, err := a.properties(sub)
+			if err != nil {
+				return nil, nil, err
+			}
+			for n, v := range p {
+				props[n] = v
+			}
+			for n := range r {
+				required[n] = true
+			}
+		}
+	}
+	if p, ok := schema["properties"].(map[string]interface{}); ok {
+		for n, v := range p {
+			props[n] = v
+		}
+	}
+	if r, ok := schema["required"].([]interface{}); ok {
+		for _, n := range r {
+			if s, ok := n.(string); ok {
+				required[s] = true
+			}
+		}
+	}
+	return props, required, nil
+}
+
+// createInlineType creates a new inline type, names of inline types are made unique once the
+// analysis completes.
+func (a *APIAnalyzer) createInlineType(name string) *gen.ObjectDataType {
+	obj := &gen.ObjectDataType{TypeName: name}
+	a.inlineTypes[name] = append(a.inlineTypes[name], obj)
+	return obj
+}
+
+// deref returns the object the given object refers to if it is a reference ("$ref"), the object
+// itself otherwise.
+func (a *APIAnalyzer) deref(m map[string]interface{}) (map[string]interface{}, error) {
+	for i := 0; i < 32; i++ {
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return m, nil
+		}
+		var err error
+		if m, err = a.resolveRef(ref); err != nil {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("Too many levels of references")
+}
+
+// resolveRef returns the object with the given local reference, e.g. "#/definitions/Pet".
+func (a *APIAnalyzer) resolveRef(ref string) (map[string]interface{}, error) {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("Unsupported reference %s, only local references are supported", ref)
+	}
+	var cur interface{} = a.Doc
+	for _, elem := range strings.Split(ref[2:], "/") {
+		elem = strings.Replace(strings.Replace(elem, "~1", "/", -1), "~0", "~", -1)
+		if e, err := url.QueryUnescape(elem); err == nil {
+			elem = e
+		}
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Invalid reference %s", ref)
+		}
+		if cur, ok = m[elem]; !ok {
+			return nil, fmt.Errorf("Unknown reference %s", ref)
+		}
+	}
+	m, ok := cur.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Invalid reference %s", ref)
+	}
+	return m, nil
+}
+
+// isObject returns true if the given schema describes an object with properties.
+func isObject(schema map[string]interface{}) bool {
+	if _, ok := schema["allOf"]; ok {
+		return true
+	}
+	props, _ := schema["properties"].(map[string]interface{})
+	return len(props) > 0
+}
+
+// refName returns the name of the schema with the given reference, e.g. "Pet" for
+// "#/definitions/Pet".
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// schemaRef returns the reference to the schema with the given name.
+func schemaRef(name string, swagger bool) string {
+	if swagger {
+		return "#/definitions/" + name
+	}
+	return "#/components/schemas/" + name
+}
+
+// basicType returns the basic data type with the given go type.
+func basicType(t string) *gen.BasicDataType {
+	b := gen.BasicDataType(t)
+	return &b
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/rightscale/rsc/gen"
+	"github.com/rightscale/rsc/gen/openapi"
+	"github.com/rightscale/rsc/gen/writers"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func main() {
+	// 1. Parse command line arguments
+	curDir, err := os.Getwd()
+	kingpin.FatalIfError(err, "")
+	metadataVal := flag.String("metadata", path.Join(curDir, "openapi.json"),
+		"Path to OpenAPI 2 (Swagger) or 3 JSON document")
+	destDirVal := flag.String("output", curDir,
+		"Path to output file")
+	pkgName := flag.String("pkg", "", "Name of generated package, e.g. \"policy\"")
+	clientName := flag.String("client", "", "Name of API client go struct, e.g. \"API\".")
+	version := flag.String("version", "", "Value of X-API-Version header sent by the generated client, defaults to the value of the X-Api-Version header parameter defined in the document if any, the header is not sent if blank")
+	importPath := flag.String("import", "", "Import path of the generated client package used by the fakes, e.g. \"github.com/rightscale/rsc/cm15\", derived from GOPATH or computed with 'go list' if blank")
+	check := flag.Bool("check", false, "Do not write any file, exit with a non-zero status if the generated files differ from the files on disk")
+	flag.Parse()
+
+	metadata := *metadataVal
+	if stat, err := os.Stat(metadata); err != nil || stat.IsDir() {
+		kingpin.Fatalf("%s is not a valid file.", metadata)
+	}
+
+	destDir := *destDirVal
+	if stat, _ := os.Stat(destDir); stat != nil && !stat.IsDir() {
+		kingpin.Fatalf("%s is not a valid directory.", destDir)
+	}
+
+	if *pkgName == "" {
+		kingpin.Fatalf("-pkg option is required.")
+	}
+
+	if *clientName == "" {
+		kingpin.Fatalf("-client option is required.")
+	}
+
+	// 2. Analyze
+	descriptor, err := openapi.LoadDescriptor(metadata, *clientName)
+	kingpin.FatalIfError(err, "")
+
+	if *version == "" {
+		*version = descriptor.HeaderVersion
+	}
+
+	// 3. Write code
+	out := writers.NewOutput(*check)
+	clientPath := path.Join(destDir, "codegen_client.go")
+	kingpin.FatalIfError(generateClient(out, *version, descriptor, clientPath, *pkgName), "")
+	metadataPath := path.Join(destDir, "codegen_metadata.go")
+	kingpin.FatalIfError(generateMetadata(out, descriptor, metadataPath, *pkgName), "")
+	fakePath := path.Join(destDir, *pkgName+"fake", "codegen_fake.go")
+	kingpin.FatalIfError(out.WriteFakes(descriptor, fakePath, *importPath, destDir, *pkgName), "")
+
+	// 4. Say something...
+	if *check {
+		kingpin.FatalIfError(out.StaleError(), "")
+		return
+	}
+	for _, g := range []string{clientPath, metadataPath, fakePath} {
+		fmt.Printf("%s\n", g)
+	}
+}
+
+// Generate API client code, drives the code writer.
+func generateClient(out *writers.Output, version string, descriptor *gen.APIDescriptor, codegen, pkg string) error {
+	c, err := writers.NewClientWriter()
+	if err != nil {
+		return err
+	}
+	return out.Write(codegen, func(w io.Writer) error {
+		err := c.WriteHeader(pkg, version, descriptor.NeedJSON, descriptor.NeedIO(),
+			descriptor.NeedIOUtil(), w)
+		if err != nil {
+			return err
+		}
+		for _, name := range descriptor.ResourceNames {
+			resource := descriptor.Resources[name]
+			c.WriteResourceHeader(name, w)
+			if err := c.WriteResource(resource, w); err != nil {
+				return err
+			}
+		}
+		c.WriteTypeSectionHeader(w)
+		for _, name := range descriptor.TypeNames {
+			t := descriptor.Types[name]
+			c.WriteType(t, w)
+		}
+		return nil
+	})
+}
+
+// Generate API metadata, drives the metadata writer.
+func generateMetadata(out *writers.Output, descriptor *gen.APIDescriptor, codegen, pkg string) error {
+	c, err := writers.NewMetadataWriter()
+	if err != nil {
+		return err
+	}
+	return out.Write(codegen, func(w io.Writer) error {
+		if err := c.WriteHeader(pkg, w); err != nil {
+			return err
+		}
+		return c.WriteMetadata(descriptor, w)
+	})
+}
@@ -1,4 +1,4 @@
-package main
+package praxis
 
 import (
 	"fmt"
@@ -179,8 +179,8 @@ func (a *APIAnalyzer) AnalyzeActions(resourceName string, resource map[string]in
 						}
 					} else if mime, ok := resp["mime_type"]; ok {
 						// Resticle compat
-						for n, r := range a.RawResources {
-							if mt, ok := r["mime_type"]; ok {
+						for _, n := range a.rawResourceNames() {
+							if mt, ok := a.RawResources[n]["mime_type"]; ok {
 								if mt == mime {
 									if actionName == "index" {
 										returnTypeName = "[]*" + n
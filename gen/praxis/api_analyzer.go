@@ -1,4 +1,4 @@
-package main
+package praxis
 
 import (
 	"sort"
@@ -45,17 +45,8 @@ func (a *APIAnalyzer) Analyze() (*gen.APIDescriptor, error) {
 	}
 	a.descriptor = &descriptor
 
-	// Sort resource names so iterations are always done in the same order
-	rawResourceNames := make([]string, len(a.RawResources))
-	idx := 0
-	for name := range a.RawResources {
-		rawResourceNames[idx] = name
-		idx++
-	}
-	sort.Strings(rawResourceNames)
-
 	// Analyze each resource
-	for _, name := range rawResourceNames {
+	for _, name := range a.rawResourceNames() {
 		err := a.AnalyzeResource(name, a.RawResources[name], &descriptor)
 		if err != nil {
 			return nil, err
@@ -67,6 +58,19 @@ func (a *APIAnalyzer) Analyze() (*gen.APIDescriptor, error) {
 	return &descriptor, nil
 }
 
+// rawResourceNames returns the names of the raw resources sorted alphabetically so iterations are
+// always done in the same order.
+func (a *APIAnalyzer) rawResourceNames() []string {
+	names := make([]string, len(a.RawResources))
+	idx := 0
+	for name := range a.RawResources {
+		names[idx] = name
+		idx++
+	}
+	sort.Strings(names)
+	return names
+}
+
 // TypeRegistry keeps track of all created types.
 // There are types that have a one to one mapping with types defined in the metadata (named types)
 // and types that are created from inline structs and hashes (inline types). We need to
@@ -1,4 +1,4 @@
-package main
+package praxis
 
 import (
 	"encoding/json"
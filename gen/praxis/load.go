@@ -0,0 +1,110 @@
+package praxis
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+// Index is the data structure used to load content of index.json files.
+type Index map[string]map[string]map[string]interface{}
+
+// LoadDescriptors loads the praxis API docs (index.json, resources and types) found in the given
+// directories and analyzes them. The descriptors of the different directories are merged and
+// indexed by API version. Only the given version is loaded if targetVersion is not blank.
+func LoadDescriptors(dirs []string, clientName, targetVersion string) (map[string]*gen.APIDescriptor, error) {
+	indeces := make(map[string]Index, len(dirs)) // Index content mapped by directory path
+	for _, dir := range dirs {
+		indexFile := path.Join(dir, "index.json")
+		indexContent, err := loadFile(indexFile)
+		if err != nil {
+			return nil, err
+		}
+		var index Index
+		if err := json.Unmarshal(indexContent, &index); err != nil {
+			return nil, fmt.Errorf("Cannot unmarshal JSON read from '%s': %s", indexFile, err)
+		}
+		indeces[dir] = index
+	}
+
+	descriptors := make(map[string]*gen.APIDescriptor) // descriptors indexed by version
+	for _, dir := range dirs {
+		for version, resources := range indeces[dir] {
+			if targetVersion != "" && version != targetVersion {
+				continue
+			}
+			apiResources := make(map[string]map[string]interface{}) // Resource properties indexed by name indexed by resource name
+			for name, resource := range resources {
+				// Skip built-in resources (?)
+				if strings.HasSuffix(name, " (*)") {
+					continue
+				}
+				fileName := strings.Replace(fmt.Sprintf("%s.json", resource["controller"]), "::", "-", -1)
+				resourcePath := path.Join(dir, version, "resources", fileName)
+				var resourceData map[string]interface{}
+				if err := unmarshal(resourcePath, &resourceData); err != nil {
+					return nil, fmt.Errorf("Failed to unmarshal content of file %s: %s", resourcePath, err)
+				}
+				apiResources[name] = resourceData
+			}
+
+			apiTypes := make(map[string]map[string]interface{}) // Type properties indexed by name indexed by type name
+			typesDir := path.Join(dir, version, "types")
+			files, _ := ioutil.ReadDir(typesDir)
+			for _, file := range files {
+				var typeData map[string]interface{}
+				if err := unmarshal(path.Join(typesDir, file.Name()), &typeData); err != nil {
+					return nil, fmt.Errorf("Failed to unmarshal content of file %s: %s", path.Join(typesDir, file.Name()), err)
+				}
+				typeName, ok := typeData["name"]
+				if !ok {
+					return nil, fmt.Errorf("Missing \"name\" key for type defined in %s", path.Join(typesDir, file.Name()))
+				}
+				apiTypes[typeName.(string)] = typeData
+			}
+			analyzer := NewAPIAnalyzer(version, clientName, apiResources, apiTypes)
+			d, err := analyzer.Analyze()
+			if err != nil {
+				return nil, err
+			}
+			if existing, ok := descriptors[version]; ok {
+				if err := existing.Merge(d); err != nil {
+					return nil, fmt.Errorf("Conflict between multiple metadata directory: %s", err)
+				}
+			} else {
+				descriptors[version] = d
+			}
+		}
+	}
+	return descriptors, nil
+}
+
+// Helper method that loads file file content and (JSON) unmarshals it into target
+func unmarshal(path string, target *map[string]interface{}) error {
+	content, err := loadFile(path)
+	if err != nil {
+		return fmt.Errorf("Failed to load media type JSON from '%s': %s", path, err)
+	}
+	err = json.Unmarshal(content, target)
+	if err != nil {
+		return fmt.Errorf("Cannot unmarshal JSON read from '%s': %s", path, err)
+	}
+	return nil
+}
+
+// Helper function that reads content from given file
+func loadFile(file string) ([]byte, error) {
+	if _, err := os.Stat(file); err != nil {
+		return nil, fmt.Errorf("Cannot find '%s'", file)
+	}
+	js, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot read '%s': %s", file, err)
+	}
+	return js, nil
+}
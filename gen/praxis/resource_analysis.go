@@ -0,0 +1,161 @@
+package praxis
+
+import (
+	"strings"
+
+	"bitbucket.org/pkg/inflect"
+	"github.com/rightscale/rsc/gen"
+)
+
+// AnalyzeResource creates an API descriptor from raw resources and types.
+func (a *APIAnalyzer) AnalyzeResource(name string, res map[string]interface{}, desc *gen.APIDescriptor) error {
+	name = inflect.Singularize(name)
+	resource := gen.Resource{Name: name, ClientName: a.ClientName}
+
+	// Description
+	if d, ok := res["description"]; ok {
+		resource.Description = removeBlankLines(d.(string))
+	}
+
+	// Attributes
+	hasHref := false
+	var links []*gen.Link
+	attributes := []*gen.Attribute{}
+	m, ok := res["media_type"].(string)
+	if ok {
+		t, ok := a.RawTypes[m]
+		if ok {
+			attrs, ok := t["attributes"].(map[string]interface{})
+			if ok {
+				attributes = make([]*gen.Attribute, len(attrs))
+				for idx, n := range sortedKeys(attrs) {
+					if n == "href" {
+						hasHref = true
+					}
+					param, err := a.AnalyzeAttribute(n, n, attrs[n].(map[string]interface{}))
+					if err != nil {
+						return err
+					}
+					attributes[idx] = &gen.Attribute{n, inflect.Camelize(n), param.Signature()}
+					if n == "links" {
+						links = a.AnalyzeLinks(param, attrs[n].(map[string]interface{}))
+					}
+				}
+			}
+		}
+	}
+	resource.Attributes = attributes
+	if hasHref {
+		resource.LocatorFunc = locatorFunc(name)
+	}
+	resource.Links = links
+
+	// Actions
+	actions, err := a.AnalyzeActions(name, res)
+	if err != nil {
+		return err
+	}
+	resource.Actions = actions
+
+	// Name and done
+	resName := toGoTypeName(name, false)
+	desc.Resources[resName] = &resource
+	desc.ResourceNames = append(desc.ResourceNames, resName)
+
+	return nil
+}
+
+// locatorFunc returns the source for the function returning the resource locator built from its
+// href field.
+func locatorFunc(resource string) string {
+	return "return api." + resource + "Locator(r.Href)"
+}
+
+// AnalyzeLinks returns the links to other resources of the API given the analyzed and raw "links"
+// attribute of a resource media type. Only links whose type has a href field and whose media type
+// is the media type of a resource are returned.
+func (a *APIAnalyzer) AnalyzeLinks(param *gen.ActionParam, raw map[string]interface{}) []*gen.Link {
+	obj, ok := param.Type.(*gen.ObjectDataType)
+	if !ok {
+		return nil
+	}
+	var rawLinks map[string]interface{}
+	if t, ok := raw["type"].(map[string]interface{}); ok {
+		rawLinks, _ = t["attributes"].(map[string]interface{})
+	}
+	var links []*gen.Link
+	for _, f := range obj.Fields {
+		lt, ok := f.Type.(*gen.ObjectDataType)
+		if !ok || !hasHrefField(lt) {
+			continue
+		}
+		rawLink, _ := rawLinks[f.Name].(map[string]interface{})
+		target := a.linkedResource(rawLink)
+		if target == "" {
+			continue
+		}
+		field := "r.Links." + strings.Title(f.VarName)
+		links = append(links, &gen.Link{
+			Name:         f.Name,
+			MethodName:   inflect.Camelize(f.Name) + "Locator",
+			ResourceName: target,
+			LocatorFunc: `if r.Links == nil || ` + field + ` == nil || ` + field + `.Href == "" {
+			return nil
+		}
+		return api.` + target + `Locator(` + field + `.Href)`,
+		})
+	}
+	return links
+}
+
+// linkedResource returns the name of the resource whose media type is the type of the given raw
+// link, empty string if there is none. Resources that have no action are skipped since they have
+// no locator.
+func (a *APIAnalyzer) linkedResource(link map[string]interface{}) string {
+	if link == nil {
+		return ""
+	}
+	// Depending on the version of praxis the media type is in "link_to", "reference",
+	// "options.reference" or is the type name.
+	mediaType, ok := link["link_to"].(string)
+	if !ok {
+		mediaType, ok = link["reference"].(string)
+	}
+	if !ok {
+		if o, found := link["options"].(map[string]interface{}); found {
+			mediaType, ok = o["reference"].(string)
+		}
+	}
+	if !ok {
+		if t, found := link["type"].(map[string]interface{}); found {
+			mediaType, ok = t["name"].(string)
+		}
+	}
+	if !ok {
+		return ""
+	}
+	// Resources may refer to their media type using its name or id (e.g. "V1::MediaTypes::Cloud"
+	// or "V1-MediaTypes-Cloud").
+	mediaType = strings.Replace(strings.TrimSuffix(mediaType, "::Collection"), "::", "-", -1)
+	for _, n := range a.rawResourceNames() {
+		r := a.RawResources[n]
+		if m, _ := r["media_type"].(string); strings.Replace(m, "::", "-", -1) != mediaType {
+			continue
+		}
+		if actions, _ := r["actions"].([]interface{}); len(actions) == 0 {
+			continue // No locator to return
+		}
+		return inflect.Singularize(n)
+	}
+	return ""
+}
+
+// hasHrefField returns true if the given object data type has a "href" field.
+func hasHrefField(o *gen.ObjectDataType) bool {
+	for _, f := range o.Fields {
+		if f.Name == "href" {
+			return true
+		}
+	}
+	return false
+}
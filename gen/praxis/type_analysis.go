@@ -1,4 +1,4 @@
-package main
+package praxis
 
 import (
 	"fmt"
@@ -110,8 +110,7 @@ func (a *APIAnalyzer) AnalyzeType(typeDef map[string]interface{}, query string)
 		o := gen.BasicDataType("interface{}")
 		dataType = &o
 	case "DateTime":
-		t := gen.BasicDataType("*time.Time") // Need pointer for case where value is null
-		a.descriptor.NeedTime = true
+		t := gen.BasicDataType(gen.TimeDataType)
 		dataType = &t
 	case "Collection", "Ids":
 		member, ok := typeDef["member_attribute"].(map[string]interface{})
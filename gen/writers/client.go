@@ -24,6 +24,12 @@ func NewClientWriter() (*ClientWriter, error) {
 		"paramsInitializer": paramsInitializer,
 		"blankCondition":    blankCondition,
 		"stripStar":         stripStar,
+		"optionsTypeName":   optionsTypeName,
+		"optionalParams":    optionalParams,
+		"optionFieldName":   optionFieldName,
+		"setCondition":      setCondition,
+		"eachParameters":    eachParameters,
+		"elemType":          elemType,
 	}
 	headerT, err := template.New("header-client").Funcs(funcMap).Parse(headerTmpl)
 	if err != nil {
@@ -40,12 +46,13 @@ func NewClientWriter() (*ClientWriter, error) {
 }
 
 // WriteHeader writes the header text.
-func (c *ClientWriter) WriteHeader(pkg, version string, needTime, needJSON bool, w io.Writer) error {
+func (c *ClientWriter) WriteHeader(pkg, version string, needJSON, needIO, needIOUtil bool, w io.Writer) error {
 	ctx := map[string]interface{}{
 		"Pkg":        pkg,
 		"APIVersion": version,
-		"NeedTime":   needTime,
 		"NeedJSON":   needJSON,
+		"NeedIO":     needIO,
+		"NeedIOUtil": needIOUtil,
 	}
 	return c.headerTmpl.Execute(w, ctx)
 }
@@ -94,8 +101,8 @@ package {{.Pkg}}
 import (
 	{{if .NeedJSON}}"encoding/json"
 	{{end}}"fmt"
-	"io/ioutil"
-	{{if .NeedTime}}"time"
+	{{if .NeedIO}}"io"
+	{{end}}{{if .NeedIOUtil}}"io/ioutil"
 	{{end}}
 	"github.com/rightscale/rsc/metadata"
 	"github.com/rightscale/rsc/rsapi"
@@ -138,7 +145,7 @@ func (r *Href) ActionPath(rName, aName string) (*metadata.ActionPath, error) {
 }
 `
 
-const resourceTmpl = `{{$resource := .}}{{define "ActionBody"}}` + actionBodyTmpl + `{{end}}
+const resourceTmpl = `{{$resource := .}}{{define "ActionBody"}}` + actionBodyTmpl + `{{end}}{{define "ReaderBody"}}` + readerBodyTmpl + `{{end}}{{define "EachBody"}}` + eachBodyTmpl + `{{end}}
 {{comment .Description}}
 type {{.Name}} struct { {{range .Attributes}}
 {{.FieldName}} {{.FieldType}} ` + "`" + `json:"{{.Name}},omitempty"` + "`" + `{{end}}
@@ -148,6 +155,12 @@ type {{.Name}} struct { {{range .Attributes}}
 func (r *{{.Name}}) Locator(api *API) *{{.Name}}Locator {
 	{{.LocatorFunc}}
 }
+{{end}}{{range .Links}}
+// {{.MethodName}} returns a locator for the resource pointed to by the "{{.Name}}" link.
+// It returns nil if the link is absent.
+func (r *{{$resource.Name}}) {{.MethodName}}(api *API) *{{.ResourceName}}Locator {
+	{{.LocatorFunc}}
+}
 {{end}}
 {{if .Actions}}
 //===== Locator
@@ -163,23 +176,82 @@ func (api *{{.ClientName}}) {{.Name}}Locator(href string) *{{.Name}}Locator {
 	return &{{.Name}}Locator{Href(href), api}
 }
 
+// {{.Name}}LocatorInterface lists the {{.Name}}Locator methods, code that depends on the interface
+// rather than on {{.Name}}Locator can be unit tested using the generated fake locators.
+type {{.Name}}LocatorInterface interface { {{range .Actions}}
+	{{.MethodName}}({{parameters .}}){{if .Return}} ({{.Return}},{{end}} error{{if .Return}}){{end}}{{if .IsStreamable}}
+	{{.MethodName}}Reader({{parameters .}}) (io.ReadCloser, error){{end}}{{if .IsIterable}}
+	{{.MethodName}}Each({{eachParameters .}}) error{{end}}{{end}}
+}
+
 //===== Actions
-{{end}}{{range .Actions}}{{range .PathPatterns}}
+{{end}}{{range .Actions}}{{if .HasOptionalParams}}` + optionsTmpl + `{{end}}{{range .PathPatterns}}
 // {{.HTTPMethod}} {{.Path}}{{end}}
 //
 {{comment .Description}}
 func (loc *{{$resource.Name}}Locator) {{.MethodName}}({{parameters .}}){{if .Return}} ({{.Return}},{{end}} error{{if .Return}}){{end}} {
 	{{template "ActionBody" . }}
 }
-{{end}}
+{{if .IsStreamable}}
+// {{.MethodName}}Reader is identical to {{.MethodName}} except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *{{$resource.Name}}Locator) {{.MethodName}}Reader({{parameters .}}) (io.ReadCloser, error) {
+	{{template "ReaderBody" . }}
+}
+{{end}}{{if .IsIterable}}
+// {{.MethodName}}Each is identical to {{.MethodName}} except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *{{$resource.Name}}Locator) {{.MethodName}}Each({{eachParameters .}}) error {
+	{{template "EachBody" . }}
+}
+{{end}}{{end}}
+`
+
+const optionsTmpl = `
+// {{optionsTypeName .}} contains the optional parameters of {{.ResourceName}}Locator.{{.MethodName}}.
+type {{optionsTypeName .}} struct { {{range optionalParams .}}{{if .Description}}
+{{comment .Description}}{{end}}
+{{optionFieldName .}} {{.Signature}}{{end}}
+// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+// values (e.g. false). Values of fields above take precedence.
+Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *{{optionsTypeName .}}) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case {{range $i, $o := optionalParams .}}{{if $i}}, {{end}}"{{$o.Name}}"{{end}}:
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of {{.ResourceName}}Locator.{{.MethodName}}", n)
+		}
+	}{{range optionalParams .}}
+	if {{setCondition (printf "o.%s" (optionFieldName .)) .Type}} {
+		p["{{.Name}}"] = o.{{optionFieldName .}}
+	}{{end}}
+	return p, nil
+}
 `
 
 const actionBodyTmpl = `{{$action := .}}{{if .Return}}var res {{.Return}}
 	{{end}}{{range .Params}}{{if and .Mandatory (blankCondition .VarName .Type)}}{{blankCondition .VarName .Type}}
 		return {{if $action.Return}}res, {{end}}fmt.Errorf("{{.VarName}} is required")
 	}
-	{{end}}{{end}}{{/* end range .Params */}}var params rsapi.APIParams{{paramsInitializer . 1 "params"}}
+	{{end}}{{end}}{{/* end range .Params */}}{{if .HasOptionalParams}}opts, err := options.params()
+	if err != nil {
+		return {{if $action.Return}}res, {{end}}err
+	}
+	{{end}}var params rsapi.APIParams{{paramsInitializer . 1 "params"}}
 	var p rsapi.APIParams{{paramsInitializer . 2 "p"}}
+	if err := loc.api.ValidateParams("{{$action.ResourceName}}", "{{$action.Name}}", params, p); err != nil {
+		return {{if $action.Return}}res, {{end}}err
+	}
 	uri, err := loc.ActionPath("{{$action.ResourceName}}", "{{$action.Name}}")
 	if err != nil {
 		return {{if $action.Return}}res, {{end}}err
@@ -192,25 +264,84 @@ const actionBodyTmpl = `{{$action := .}}{{if .Return}}var res {{.Return}}
 	if err != nil {
 		return {{if $action.Return}}res, {{end}}err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return {{if $action.Return}}res, {{end}}fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return {{if $action.Return}}res, {{end}}rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	{{if .ReturnLocation}}location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
 	} else {
 		return &{{stripStar .Return}}{Href(location), loc.api}, nil
-	}{{else if .Return}}defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	}{{else if eq .Return "string"}}respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return res, err
 	}
-	{{if eq .Return "string"}}res = string(respBody)
-	{{else}}err = json.Unmarshal(respBody, &res)
-	{{end}}return res, err{{else}}return nil{{end}}`
+	res = string(respBody)
+	return res, err{{else if .Return}}err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err{{else}}return nil{{end}}`
+
+const readerBodyTmpl = `{{range .Params}}{{if and .Mandatory (blankCondition .VarName .Type)}}{{blankCondition .VarName .Type}}
+		return nil, fmt.Errorf("{{.VarName}} is required")
+	}
+	{{end}}{{end}}{{/* end range .Params */}}{{if .HasOptionalParams}}opts, err := options.params()
+	if err != nil {
+		return nil, err
+	}
+	{{end}}var params rsapi.APIParams{{paramsInitializer . 1 "params"}}
+	var p rsapi.APIParams{{paramsInitializer . 2 "p"}}
+	if err := loc.api.ValidateParams("{{.ResourceName}}", "{{.Name}}", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("{{.ResourceName}}", "{{.Name}}")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil`
+
+const eachBodyTmpl = `{{range .Params}}{{if and .Mandatory (blankCondition .VarName .Type)}}{{blankCondition .VarName .Type}}
+		return fmt.Errorf("{{.VarName}} is required")
+	}
+	{{end}}{{end}}{{/* end range .Params */}}{{if .HasOptionalParams}}opts, err := options.params()
+	if err != nil {
+		return err
+	}
+	{{end}}var params rsapi.APIParams{{paramsInitializer . 1 "params"}}
+	var p rsapi.APIParams{{paramsInitializer . 2 "p"}}
+	if err := loc.api.ValidateParams("{{.ResourceName}}", "{{.Name}}", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("{{.ResourceName}}", "{{.Name}}")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e {{elemType .}}
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})`
@@ -0,0 +1,350 @@
+package writers
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+// DocsWriter struct exposes methods to generate the Markdown reference documentation of an API.
+// The documentation consists of an index page listing the resources and of one page per resource.
+type DocsWriter struct {
+	Title   string // Documentation title, e.g. "RightScale Cloud Management API 1.5"
+	Command string // rsc sub-command used in command line examples, e.g. "cm15"
+	Package string // Name of client go package used in code examples, e.g. "cm15"
+
+	indexTmpl    *template.Template
+	resourceTmpl *template.Template
+}
+
+// NewDocsWriter creates a new writer that generates Markdown documentation.
+func NewDocsWriter(title, command, pkg string) (*DocsWriter, error) {
+	w := &DocsWriter{Title: title, Command: command, Package: pkg}
+	funcMap := template.FuncMap{
+		"cell":       cell,
+		"summary":    summary,
+		"path":       docPath,
+		"flagType":   flagType,
+		"validation": validation,
+		"commandLine": func(r *gen.Resource, a *gen.Action) string {
+			return w.commandLineExample(r, a)
+		},
+		"goCode": func(r *gen.Resource, a *gen.Action) string {
+			return w.goExample(r, a)
+		},
+	}
+	indexT, err := template.New("docs-index").Funcs(funcMap).Parse(docsIndexTmpl)
+	if err != nil {
+		return nil, err
+	}
+	resourceT, err := template.New("docs-resource").Funcs(funcMap).Parse(docsResourceTmpl)
+	if err != nil {
+		return nil, err
+	}
+	w.indexTmpl = indexT
+	w.resourceTmpl = resourceT
+	return w, nil
+}
+
+// WriteIndex writes the index page listing all the API resources.
+func (w *DocsWriter) WriteIndex(d *gen.APIDescriptor, out io.Writer) error {
+	resources := make([]*gen.Resource, len(d.ResourceNames))
+	for i, n := range d.ResourceNames {
+		resources[i] = d.Resources[n]
+	}
+	return w.indexTmpl.Execute(out, map[string]interface{}{
+		"Title":     w.Title,
+		"Command":   w.Command,
+		"Package":   w.Package,
+		"Resources": resources,
+	})
+}
+
+// WriteResource writes the page describing the given resource, its actions and attributes.
+func (w *DocsWriter) WriteResource(d *gen.APIDescriptor, r *gen.Resource, out io.Writer) error {
+	return w.resourceTmpl.Execute(out, map[string]interface{}{
+		"Title":    w.Title,
+		"Resource": r,
+		"Returns": func(a *gen.Action) string {
+			return returnDoc(d, a)
+		},
+	})
+}
+
+// commandLineExample returns the rsc command line that runs the given action.
+// Path variables and mandatory parameter values are read from environment variables.
+func (w *DocsWriter) commandLineExample(r *gen.Resource, a *gen.Action) string {
+	p := hrefPattern(r, a.PathPatterns[0])
+	vars := make([]interface{}, len(p.Variables))
+	for i, v := range p.Variables {
+		vars[i] = "$" + envVarName(v)
+	}
+	elems := []string{"rsc", w.Command, a.Name, fmt.Sprintf(p.Pattern, vars...)}
+	for _, param := range a.LeafParams {
+		if !param.Mandatory {
+			continue
+		}
+		value := "$" + envVarName(param.QueryName)
+		if t, _ := param.FlagType(); t == "map" {
+			value = "KEY=" + value
+		}
+		elems = append(elems, fmt.Sprintf(`"%s=%s"`, param.QueryName, value))
+	}
+	return strings.Join(elems, " ")
+}
+
+// goExample returns the go code that runs the given action using the generated client, the
+// code assumes that the client is stored in the "api" variable.
+func (w *DocsWriter) goExample(r *gen.Resource, a *gen.Action) string {
+	var decls, args []string
+	p := hrefPattern(r, a.PathPatterns[0])
+	href := fmt.Sprintf("%q", p.Pattern)
+	if len(p.Variables) > 0 {
+		vars := make([]string, len(p.Variables))
+		for i, v := range p.Variables {
+			vars[i] = goVarName(v)
+			decls = append(decls, fmt.Sprintf("%s string", vars[i]))
+		}
+		href = fmt.Sprintf("fmt.Sprintf(%s, %s)", href, strings.Join(vars, ", "))
+	}
+	for _, param := range a.MandatoryParams() {
+		decls = append(decls, fmt.Sprintf("%s %s", param.VarName, qualifiedTypeRegexp.ReplaceAllString(
+			param.Signature(), "${1}"+w.Package+".${2}")))
+		args = append(args, param.VarName)
+	}
+	if a.HasOptionalParams() {
+		args = append(args, fmt.Sprintf("&%s.%s{}", w.Package, optionsTypeName(a)))
+	}
+	var lines []string
+	switch len(decls) {
+	case 0:
+	case 1:
+		lines = append(lines, "var "+decls[0])
+	default:
+		lines = append(lines, "var (")
+		for _, d := range decls {
+			lines = append(lines, "\t"+d)
+		}
+		lines = append(lines, ")")
+	}
+	lines = append(lines, fmt.Sprintf("loc := api.%sLocator(%s)", a.ResourceName, href))
+	res := "err"
+	if a.Return != "" {
+		res = "res, err"
+	}
+	lines = append(lines, fmt.Sprintf("%s := loc.%s(%s)", res, a.MethodName, strings.Join(args, ", ")))
+	return strings.Join(lines, "\n")
+}
+
+// hrefPattern returns the pattern of the resource hrefs accepted by the given action path pattern:
+// the shortest path pattern of the resource actions that is a prefix of the action path and that
+// has the same variables, e.g. "/api/servers/%s" for "/api/servers/%s/clone".
+func hrefPattern(r *gen.Resource, p *gen.PathPattern) *gen.PathPattern {
+	res := p
+	for _, a := range r.Actions {
+		for _, other := range a.PathPatterns {
+			if len(other.Pattern) >= len(res.Pattern) || !strings.HasPrefix(p.Pattern, other.Pattern+"/") {
+				continue
+			}
+			if strings.Join(other.Variables, ",") == strings.Join(p.Variables, ",") {
+				res = other
+			}
+		}
+	}
+	return res
+}
+
+// Matches the names of the generated types in go type signatures, e.g. "Server" in "[]*Server"
+var qualifiedTypeRegexp = regexp.MustCompile(`(^|[^.\w])([A-Z]\w*)`)
+
+// Matches sequences of characters that may not appear in environment variable names
+var envVarRegexp = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// Name of environment variable used in command line examples, e.g. "server[name]" => "SERVER_NAME"
+func envVarName(name string) string {
+	return strings.ToUpper(strings.Trim(envVarRegexp.ReplaceAllString(name, "_"), "_"))
+}
+
+// Name of go variable used in code examples, e.g. "cloud_id" => "cloudID"
+func goVarName(name string) string {
+	parts := strings.Split(envVarRegexp.ReplaceAllString(name, "_"), "_")
+	res := strings.ToLower(parts[0])
+	for _, p := range parts[1:] {
+		if p == "id" || p == "href" {
+			res += strings.ToUpper(p)
+		} else if p != "" {
+			res += strings.ToUpper(p[:1]) + strings.ToLower(p[1:])
+		}
+	}
+	return res
+}
+
+// Path pattern with variables written as ":name", e.g. "/api/clouds/:cloud_id/instances"
+func docPath(p *gen.PathPattern) string {
+	vars := make([]interface{}, len(p.Variables))
+	for i, v := range p.Variables {
+		vars[i] = ":" + v
+	}
+	return fmt.Sprintf(p.Pattern, vars...)
+}
+
+// Escape text so that it can be used in a Markdown table cell
+func cell(text string) string {
+	text = strings.Replace(strings.TrimSpace(text), "|", `\|`, -1)
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// First sentence of given description
+func summary(desc string) string {
+	desc = cell(desc)
+	for _, loc := range sentenceEndRegexp.FindAllStringIndex(desc, -1) {
+		if end := desc[:loc[0]]; !strings.HasSuffix(end, "e.g") && !strings.HasSuffix(end, "i.e") {
+			return desc[:loc[0]+1]
+		}
+	}
+	return desc
+}
+
+// Matches the end of a sentence followed by another sentence
+var sentenceEndRegexp = regexp.MustCompile(`\. [A-Z]`)
+
+// Human readable description of the validation rules of the given parameter
+func validation(p *gen.ActionParam) string {
+	var rules []string
+	if p.NonBlank {
+		rules = append(rules, "non blank")
+	}
+	if p.Regexp != "" {
+		rules = append(rules, fmt.Sprintf("must match `%s`", p.Regexp))
+	}
+	if len(p.ValidValues) > 0 {
+		values := toStringArray(p.ValidValues)
+		label := "one of"
+		if p.QueryName == "filter[]" {
+			// Valid values of filters are the names of the fields that can be filtered on
+			label = "filters on"
+		}
+		rules = append(rules, fmt.Sprintf("%s `%s`", label, strings.Join(values, "`, `")))
+	}
+	if p.Min != 0 {
+		rules = append(rules, fmt.Sprintf("min %d", p.Min))
+	}
+	if p.Max != 0 {
+		rules = append(rules, fmt.Sprintf("max %d", p.Max))
+	}
+	return cell(strings.Join(rules, ", "))
+}
+
+// Description of the value returned by the given action, links to the resource page if the action
+// returns resources.
+func returnDoc(d *gen.APIDescriptor, a *gen.Action) string {
+	if a.Return == "" {
+		return "No content."
+	}
+	if a.ReturnLocation {
+		return fmt.Sprintf("The href of the created resource in the `Location` header, the client returns a `%s`.",
+			a.Return)
+	}
+	name := strings.TrimLeft(a.Return, "[]*")
+	if _, ok := d.Resources[name]; ok {
+		if strings.HasPrefix(a.Return, "[]") {
+			return fmt.Sprintf("A list of [%s](%s.md#attributes) resources.", name, name)
+		}
+		return fmt.Sprintf("A [%s](%s.md#attributes) resource.", name, name)
+	}
+	if a.IsStreamable() {
+		return "The raw response body."
+	}
+	return fmt.Sprintf("A `%s`.", a.Return)
+}
+
+// Inline templates
+
+const docsIndexTmpl = `# {{.Title}}
+
+Use the ` + "`" + `rsc {{.Command}}` + "`" + ` command to send requests to the API from the command line. The go
+examples assume that ` + "`" + `api` + "`" + ` is the ` + "`" + `*{{.Package}}.API` + "`" + ` client created with ` + "`" + `{{.Package}}.New` + "`" + `.
+
+| Resource | Description |
+|----------|-------------|
+{{range .Resources}}| [{{.Name}}]({{.Name}}.md) | {{summary .Description}} |
+{{end}}`
+
+const docsResourceTmpl = `{{$r := .Resource}}{{$returns := .Returns}}# {{$r.Name}}
+
+[{{.Title}}](README.md)
+{{if $r.Description}}
+{{$r.Description}}
+{{end}}{{if $r.Actions}}
+## Actions
+{{range $r.Actions}}
+* [{{.Name}}](#{{.Name}}){{end}}
+{{range $r.Actions}}
+### {{.Name}}
+{{if .Description}}
+{{.Description}}
+{{end}}
+| Verb | Path |
+|------|------|
+{{range .PathPatterns}}| ` + "`" + `{{.HTTPMethod}}` + "`" + ` | ` + "`" + `{{path .}}` + "`" + ` |
+{{end}}{{if .LeafParams}}
+#### Parameters
+
+| Name | Type | Required | Validation | Description |
+|------|------|----------|------------|-------------|
+{{range .LeafParams}}| ` + "`" + `{{.QueryName}}` + "`" + ` | {{flagType .}} | {{if .Mandatory}}yes{{else}}no{{end}} | {{validation .}} | {{cell .Description}} |
+{{end}}{{end}}
+#### Response
+
+{{call $returns .}}
+
+#### Examples
+
+` + "```" + `sh
+{{commandLine $r .}}
+` + "```" + `
+
+` + "```" + `go
+{{goCode $r .}}
+` + "```" + `
+{{end}}{{end}}{{if $r.Attributes}}
+## Attributes
+
+| Name | Type |
+|------|------|
+{{range $r.Attributes}}| ` + "`" + `{{.Name}}` + "`" + ` | ` + "`" + `{{.FieldType}}` + "`" + ` |
+{{end}}{{end}}`
+
+// WriteDocs writes the documentation index page README.md and one page per resource to dir and
+// returns the paths of the pages.
+func (o *Output) WriteDocs(d *gen.APIDescriptor, dir, title, command, pkg string) ([]string, error) {
+	w, err := NewDocsWriter(title, command, pkg)
+	if err != nil {
+		return nil, err
+	}
+	indexPath := filepath.Join(dir, "README.md")
+	err = o.Write(indexPath, func(out io.Writer) error {
+		return w.WriteIndex(d, out)
+	})
+	if err != nil {
+		return nil, err
+	}
+	files := []string{indexPath}
+	for _, name := range d.ResourceNames {
+		res := d.Resources[name]
+		resPath := filepath.Join(dir, name+".md")
+		err := o.Write(resPath, func(out io.Writer) error {
+			return w.WriteResource(d, res, out)
+		})
+		if err != nil {
+			return files, err
+		}
+		files = append(files, resPath)
+	}
+	return files, nil
+}
@@ -0,0 +1,292 @@
+package writers
+
+import (
+	"bytes"
+	"fmt"
+	"go/build"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+// FakeWriter struct exposes methods to generate the go code of the fake locators.
+// The fake locators implement the locator interfaces of the API client package and live in their
+// own package so that code that uses the client can be unit tested without making HTTP requests.
+type FakeWriter struct {
+	headerTmpl   *template.Template
+	resourceTmpl *template.Template
+}
+
+// NewFakeWriter is the fake writer factory.
+func NewFakeWriter() (*FakeWriter, error) {
+	funcMap := template.FuncMap{
+		"comment":     comment,
+		"commandLine": commandLine,
+	}
+	headerT, err := template.New("header-fake").Funcs(funcMap).Parse(fakeHeaderTmpl)
+	if err != nil {
+		return nil, err
+	}
+	resourceT, err := template.New("resource-fake").Funcs(funcMap).Parse(fakeResourceTmpl)
+	if err != nil {
+		return nil, err
+	}
+	return &FakeWriter{
+		headerTmpl:   headerT,
+		resourceTmpl: resourceT,
+	}, nil
+}
+
+// WriteFakes writes the fake locators for all the resources of the given descriptor.
+// pkg is the name of the fake package, clientPkg the import path of the client package and
+// clientName its name.
+func (c *FakeWriter) WriteFakes(d *gen.APIDescriptor, pkg, clientPkg, clientName string, w io.Writer) error {
+	var body bytes.Buffer
+	for _, name := range d.ResourceNames {
+		r := d.Resources[name]
+		if len(r.Actions) == 0 {
+			continue
+		}
+		if err := c.resourceTmpl.Execute(&body, newFakeResource(r, clientName)); err != nil {
+			return err
+		}
+	}
+	b := body.String()
+	ctx := map[string]interface{}{
+		"Pkg":        pkg,
+		"ClientPkg":  clientPkg,
+		"ClientName": clientName,
+		"NeedIO":     strings.Contains(b, "io.ReadCloser"),
+		"NeedRsapi":  strings.Contains(b, "rsapi."),
+	}
+	if err := c.headerTmpl.Execute(w, ctx); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, b)
+	return err
+}
+
+// ImportPath returns the import path of the go package in the given directory. The import path is
+// derived from GOPATH if the directory is under one of its "src" directories, otherwise it is
+// computed with "go list" which requires the directory to be importable.
+func ImportPath(dir string) (string, error) {
+	if abs, err := filepath.Abs(dir); err == nil {
+		for _, root := range filepath.SplitList(build.Default.GOPATH) {
+			src := filepath.Join(root, "src") + string(filepath.Separator)
+			if root != "" && strings.HasPrefix(abs, src) {
+				return filepath.ToSlash(abs[len(src):]), nil
+			}
+		}
+	}
+	if !filepath.IsAbs(dir) && !strings.HasPrefix(dir, ".") {
+		dir = "." + string(filepath.Separator) + dir
+	}
+	o, err := exec.Command("go", "list", "-f", "{{.ImportPath}}", dir).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("Failed to compute import path of %s:\n%s", dir, o)
+	}
+	return strings.TrimSpace(string(o)), nil
+}
+
+// fakeResource contains the data needed to render the fake locator of a resource.
+type fakeResource struct {
+	Name       string        // Resource name, e.g. "Server"
+	ClientName string        // Name of client package, e.g. "cm15"
+	Actions    []*fakeMethod // Locator methods
+}
+
+// fakeMethod contains the data needed to render a fake locator method.
+type fakeMethod struct {
+	Name        string // Method name, e.g. "Index" or "DetailReader"
+	CallName    string // Name of struct recording calls, e.g. "ServerIndexCall"
+	Params      string // Method parameters, e.g. "options *cm15.ServerIndexOptions"
+	Types       string // Method parameter types, e.g. "*cm15.ServerIndexOptions"
+	Args        string // Method arguments, e.g. "options"
+	Fields      string // Call struct fields declaration
+	Inits       string // Call struct fields initialization
+	Return      string // Qualified return type if any, e.g. "[]*cm15.Server"
+	Results     string // Method results, e.g. "([]*cm15.Server, error)"
+	DeclareCall bool   // Whether the call struct should be declared with this method
+	Each        string // Name of method whose stub results are iterated over if any, e.g. "Index"
+	EachArgs    string // Arguments of the iterated method stub, e.g. "options"
+}
+
+// newFakeResource computes the data needed to render the fake locator of the given resource.
+func newFakeResource(r *gen.Resource, clientName string) *fakeResource {
+	res := fakeResource{Name: r.Name, ClientName: clientName}
+	for _, a := range r.Actions {
+		var params, types, args, fields, inits []string
+		for _, p := range a.MandatoryParams() {
+			sig := qualifyType(p.Signature(), clientName)
+			params = append(params, fmt.Sprintf("%s %s", p.VarName, sig))
+			types = append(types, sig)
+			args = append(args, p.VarName)
+			fields = append(fields, fmt.Sprintf("%s %s", strings.Title(p.VarName), sig))
+			inits = append(inits, fmt.Sprintf("%s: %s", strings.Title(p.VarName), p.VarName))
+		}
+		if a.HasOptionalParams() {
+			sig := fmt.Sprintf("*%s.%s", clientName, optionsTypeName(a))
+			params = append(params, "options "+sig)
+			types = append(types, sig)
+			args = append(args, "options")
+			fields = append(fields, "Options "+sig)
+			inits = append(inits, "Options: options")
+		}
+		m := fakeMethod{
+			Name:        a.MethodName,
+			CallName:    r.Name + a.MethodName + "Call",
+			Params:      strings.Join(params, ", "),
+			Types:       strings.Join(types, ", "),
+			Args:        strings.Join(args, ", "),
+			Fields:      strings.Join(fields, "\n\t"),
+			Inits:       strings.Join(inits, ", "),
+			DeclareCall: true,
+		}
+		if a.Return != "" {
+			m.Return = qualifyType(a.Return, clientName)
+			m.Results = fmt.Sprintf("(%s, error)", m.Return)
+		} else {
+			m.Results = "error"
+		}
+		res.Actions = append(res.Actions, &m)
+		if a.IsStreamable() {
+			rm := m
+			rm.Name = a.MethodName + "Reader"
+			rm.Return = "io.ReadCloser"
+			rm.Results = "(io.ReadCloser, error)"
+			rm.DeclareCall = false
+			res.Actions = append(res.Actions, &rm)
+		}
+		if a.IsIterable() {
+			fn := fmt.Sprintf("func(%s) error", qualifyType(elemType(a), clientName))
+			em := m
+			em.Name = a.MethodName + "Each"
+			em.CallName = r.Name + a.MethodName + "EachCall"
+			em.Params = strings.Join(append(params, "fn "+fn), ", ")
+			em.Types = strings.Join(append(types, fn), ", ")
+			em.Args = strings.Join(append(args, "fn"), ", ")
+			em.Return = ""
+			em.Results = "error"
+			em.Each = a.MethodName
+			em.EachArgs = m.Args
+			res.Actions = append(res.Actions, &em)
+		}
+	}
+	return &res
+}
+
+// typeNameRegexp captures type names that are not qualified with a package name.
+var typeNameRegexp = regexp.MustCompile(`(^|[^\w.])([A-Z]\w*)`)
+
+// qualifyType qualifies the names of the types defined in the client package that appear in the
+// given type signature, e.g. "[]*Server" becomes "[]*cm15.Server".
+func qualifyType(sig, pkg string) string {
+	return typeNameRegexp.ReplaceAllString(sig, "${1}"+pkg+".${2}")
+}
+
+// Inline templates
+
+const fakeHeaderTmpl = `
+//************************************************************************//
+//                     RightScale API client fakes
+//
+// Generated with:
+{{comment commandLine}}
+//
+// The content of this file is auto-generated, DO NOT MODIFY
+//************************************************************************//
+
+// Package {{.Pkg}} provides fake implementations of the {{.ClientName}} locator interfaces.
+// Each fake records the calls made to its methods and returns the values returned by the
+// corresponding stub functions if set, zero values otherwise.
+package {{.Pkg}}
+
+import (
+	{{if .NeedIO}}"io"
+	{{end}}"sync"
+
+	"{{.ClientPkg}}"{{if .NeedRsapi}}
+	"github.com/rightscale/rsc/rsapi"{{end}}
+)
+`
+
+const fakeResourceTmpl = `{{$resource := .}}
+/******  {{.Name}} ******/
+
+// {{.Name}}Locator is a fake implementation of {{.ClientName}}.{{.Name}}LocatorInterface.
+type {{.Name}}Locator struct {
+	mu sync.Mutex
+{{range .Actions}}
+	// {{.Name}}Stub is called by {{.Name}} if not nil.
+	{{.Name}}Stub func({{.Types}}) {{.Results}}
+	// {{.Name}}Calls records the arguments of the calls made to {{.Name}}.
+	{{.Name}}Calls []*{{.CallName}}
+{{end}}}
+
+var _ {{.ClientName}}.{{.Name}}LocatorInterface = (*{{.Name}}Locator)(nil)
+{{range .Actions}}{{if .DeclareCall}}
+// {{.CallName}} records the arguments of a call to {{$resource.Name}}Locator.{{.Name}}.
+type {{.CallName}} struct{{if .Fields}} {
+	{{.Fields}}
+}{{else}}{}{{end}}
+{{end}}
+// {{.Name}} records the call and returns the results of {{.Name}}Stub if set.{{if .Each}}
+// Otherwise it calls fn with each element returned by {{.Each}}Stub if set.{{end}}
+func (fake *{{$resource.Name}}Locator) {{.Name}}({{.Params}}) {{.Results}} {
+	fake.mu.Lock()
+	fake.{{.Name}}Calls = append(fake.{{.Name}}Calls, &{{.CallName}}{ {{.Inits}} })
+	stub := fake.{{.Name}}Stub{{if .Each}}
+	each := fake.{{.Each}}Stub{{end}}
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub({{.Args}})
+	}
+	{{if .Each}}if each == nil {
+		return nil
+	}
+	res, err := each({{.EachArgs}})
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil{{else if .Return}}var res {{.Return}}
+	return res, nil{{else}}return nil{{end}}
+}
+
+// {{.Name}}Returns sets {{.Name}}Stub to a function that returns the given values.
+func (fake *{{$resource.Name}}Locator) {{.Name}}Returns({{if .Return}}res {{.Return}}, {{end}}err error) {
+	fake.mu.Lock()
+	fake.{{.Name}}Stub = func({{.Types}}) {{.Results}} {
+		return {{if .Return}}res, {{end}}err
+	}
+	fake.mu.Unlock()
+}
+{{end}}`
+
+// WriteFakes writes the fake locators of the client package named clientName to the file at path.
+// clientPkg is the import path of the client package, it is computed from the client package
+// directory clientDir if blank.
+func (o *Output) WriteFakes(d *gen.APIDescriptor, path, clientPkg, clientDir, clientName string) error {
+	if clientPkg == "" {
+		var err error
+		if clientPkg, err = ImportPath(clientDir); err != nil {
+			return err
+		}
+	}
+	w, err := NewFakeWriter()
+	if err != nil {
+		return err
+	}
+	return o.Write(path, func(out io.Writer) error {
+		return w.WriteFakes(d, clientName+"fake", clientPkg, clientName, out)
+	})
+}
@@ -3,6 +3,7 @@ package writers
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/rightscale/rsc/gen"
@@ -37,12 +38,27 @@ func parameters(a *gen.Action) string {
 		params[i] = fmt.Sprintf("%s %s", param.VarName, param.Signature())
 	}
 	if hasOptional {
-		params[countParams-1] = "options rsapi.APIParams"
+		params[countParams-1] = fmt.Sprintf("options *%s", optionsTypeName(a))
 	}
 
 	return strings.Join(params, ", ")
 }
 
+// Produces the list of parameters of the method that iterates over the collection returned by the
+// given action: the action parameters followed by the callback.
+func eachParameters(a *gen.Action) string {
+	fn := fmt.Sprintf("fn func(%s) error", elemType(a))
+	if params := parameters(a); params != "" {
+		return params + ", " + fn
+	}
+	return fn
+}
+
+// Type of the elements of the collection returned by the given action, e.g. "*Server"
+func elemType(a *gen.Action) string {
+	return strings.TrimPrefix(a.Return, "[]")
+}
+
 // Produces code that initializes a APIParams struct with the values of parameters for the given
 // action and location.
 func paramsInitializer(action *gen.Action, location int, varName string) string {
@@ -75,26 +91,73 @@ func paramsInitializer(action *gen.Action, location int, varName string) string
 		if location == 1 { // QueryParam
 			name = opt.QueryName
 		}
-		inits[i] = fmt.Sprintf("\tvar %sOpt = options[\"%s\"]\n\tif %sOpt != nil {\n\t\t%s[\"%s\"] = %sOpt\n\t}",
+		inits[i] = fmt.Sprintf("\tvar %sOpt = opts[\"%s\"]\n\tif %sOpt != nil {\n\t\t%s[\"%s\"] = %sOpt\n\t}",
 			opt.VarName, opt.Name, opt.VarName, varName, name, opt.VarName)
 	}
 	var paramsInits = strings.Join(inits, "\n\t")
 	return fmt.Sprintf("\n%s = %s\n%s", varName, paramsDecl, paramsInits)
 }
 
-// Command line used to run tool
+// Name of struct holding the optional parameters of the given action, e.g. "CloudIndexOptions"
+func optionsTypeName(a *gen.Action) string {
+	return a.ResourceName + a.MethodName + "Options"
+}
+
+// Optional parameters of given action
+func optionalParams(a *gen.Action) []*gen.ActionParam {
+	var opts []*gen.ActionParam
+	for _, p := range a.Params {
+		if !p.Mandatory {
+			opts = append(opts, p)
+		}
+	}
+	return opts
+}
+
+// Name of options struct field for given optional parameter
+func optionFieldName(p *gen.ActionParam) string {
+	return strings.Title(p.VarName)
+}
+
+// Code that checks whether the options struct field with given name and type is set, that is
+// contains a non zero value.
+func setCondition(name string, t gen.DataType) string {
+	if b, ok := t.(*gen.BasicDataType); ok {
+		switch *b {
+		case "string":
+			return fmt.Sprintf("%s != \"\"", name)
+		case "int", "float64":
+			return fmt.Sprintf("%s != 0", name)
+		case "bool":
+			return name
+		}
+	}
+	return fmt.Sprintf("%s != nil", name)
+}
+
+// Command line used to run tool, the -check flag and the path to the tool are omitted so that the
+// generated code does not depend on how the tool was invoked.
 func commandLine() string {
-	return fmt.Sprintf("$ %s %s", os.Args[0], strings.Join(os.Args[1:], " "))
+	var args []string
+	for _, a := range os.Args[1:] {
+		if a == "-check" || a == "--check" || strings.HasPrefix(a, "-check=") || strings.HasPrefix(a, "--check=") {
+			continue
+		}
+		args = append(args, a)
+	}
+	return fmt.Sprintf("$ %s %s", filepath.Base(os.Args[0]), strings.Join(args, " "))
 }
 
 // Code that checks whether variable with given name and type contains a blank value (empty string,
-// empty array or empy map).
+// nil pointer, empty array or empy map).
 // Return empty string if type of variable cannot produce blank values
 func blankCondition(name string, t gen.DataType) (blank string) {
 	switch actual := t.(type) {
 	case *gen.BasicDataType:
 		if *actual == "string" {
 			blank = fmt.Sprintf("if %s == \"\" {", name)
+		} else if strings.HasPrefix(string(*actual), "*") {
+			blank = fmt.Sprintf("if %s == nil {", name)
 		}
 	case *gen.ArrayDataType:
 		blank = fmt.Sprintf("if len(%s) == 0 {", name)
@@ -139,18 +202,6 @@ func escapeBackticks(d string) string {
 }
 
 // Type of flag, one of "string", "[]string", "int", "map" or "file"
-func flagType(param *gen.ActionParam) string {
-	path := param.QueryName
-	if _, ok := param.Type.(*gen.ArrayDataType); ok {
-		return "[]string"
-	} else if _, ok := param.Type.(*gen.EnumerableDataType); ok {
-		return "map"
-	} else if _, ok := param.Type.(*gen.UploadDataType); ok {
-		return "file"
-	}
-	b, ok := param.Type.(*gen.BasicDataType)
-	if !ok {
-		panic("Wooaat? a object leaf??? - " + path)
-	}
-	return string(*b)
+func flagType(param *gen.ActionParam) (string, error) {
+	return param.FlagType()
 }
@@ -0,0 +1,214 @@
+package writers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+// JSONSchemaWriter struct exposes methods to generate JSON Schema (draft 7) documents describing the
+// API resource media types, payload types and action payloads. Each document is self-contained:
+// the named types it references are described under "definitions".
+type JSONSchemaWriter struct {
+	Title string // Title prefix of generated documents, e.g. "RightScale Cloud Management API 1.5"
+}
+
+// NewJSONSchemaWriter creates a new writer that generates JSON Schema documents.
+func NewJSONSchemaWriter(title string) (*JSONSchemaWriter, error) {
+	return &JSONSchemaWriter{Title: title}, nil
+}
+
+// WriteResource writes the JSON schema of the given resource media type.
+func (w *JSONSchemaWriter) WriteResource(d *gen.APIDescriptor, r *gen.Resource, out io.Writer) error {
+	return w.write(d, r.Name, resourceSchema(d, r), out)
+}
+
+// WriteType writes the JSON schema of the payload type with the given name.
+func (w *JSONSchemaWriter) WriteType(d *gen.APIDescriptor, name string, out io.Writer) error {
+	t, ok := d.Types[name]
+	if !ok {
+		return fmt.Errorf("Unknown type %s", name)
+	}
+	return w.write(d, name, objectSchema(d, t), out)
+}
+
+// WritePayload writes the JSON schema of the request body of the given action, i.e. the object
+// whose properties are the action payload parameters.
+func (w *JSONSchemaWriter) WritePayload(d *gen.APIDescriptor, a *gen.Action, out io.Writer) error {
+	return w.write(d, a.ResourceName+" "+a.Name, payloadSchema(d, a), out)
+}
+
+// HasJSONPayload returns true if the given action accepts a JSON request body for which a schema
+// can be generated with WritePayload. Actions that upload files send multipart bodies instead.
+func HasJSONPayload(a *gen.Action) bool {
+	if a.Payload != nil {
+		return true
+	}
+	found := false
+	for _, p := range a.Params {
+		if p.Location != gen.PayloadParam {
+			continue
+		}
+		if _, ok := p.Type.(*gen.UploadDataType); ok {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// jsonSchemaDoc is a top level JSON schema document.
+type jsonSchemaDoc struct {
+	Schema string `json:"$schema"`
+	Title  string `json:"title"`
+	schema
+	Definitions map[string]*schema `json:"definitions,omitempty"`
+}
+
+// Prefix of references built by the OpenAPI schema builders
+const componentsPrefix = "#/components/schemas/"
+
+// write serializes the JSON schema document for the given root schema, the references to named
+// types are rewritten to point to the document definitions.
+func (w *JSONSchemaWriter) write(d *gen.APIDescriptor, name string, root *schema, out io.Writer) error {
+	defs := make(map[string]*schema)
+	if err := resolveRefs(d, root, defs); err != nil {
+		return err
+	}
+	if strings.HasPrefix(root.Ref, "#/definitions/") {
+		// Siblings of $ref are ignored, inline the referenced schema so definitions apply
+		root = defs[root.Ref[len("#/definitions/"):]]
+	}
+	title := name
+	if w.Title != "" {
+		title = w.Title + " " + name
+	}
+	doc := jsonSchemaDoc{
+		Schema: "http://json-schema.org/draft-07/schema#",
+		Title:  title,
+		schema: *root,
+	}
+	if len(defs) > 0 {
+		doc.Definitions = defs
+	}
+	b, err := json.MarshalIndent(&doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Failed to serialize JSON schema of %s: %s", name, err)
+	}
+	_, err = out.Write(append(b, '\n'))
+	return err
+}
+
+// payloadSchema builds the schema of the request body of the given action.
+func payloadSchema(d *gen.APIDescriptor, a *gen.Action) *schema {
+	if a.Payload != nil {
+		return dataTypeSchema(d, a.Payload)
+	}
+	s := &schema{Type: "object", Properties: make(map[string]*schema)}
+	for _, p := range a.Params {
+		if p.Location != gen.PayloadParam {
+			continue
+		}
+		s.Properties[p.Name] = paramSchema(d, p)
+		if p.Mandatory {
+			s.Required = append(s.Required, p.Name)
+		}
+	}
+	sort.Strings(s.Required)
+	s.Description = strings.TrimSpace(a.Description)
+	return s
+}
+
+// resolveRefs walks the given schema and rewrites the references to named resources and types so
+// they point to the document definitions. The schemas of referenced names are added to defs,
+// recursively.
+func resolveRefs(d *gen.APIDescriptor, s *schema, defs map[string]*schema) error {
+	if s == nil {
+		return nil
+	}
+	if strings.HasPrefix(s.Ref, componentsPrefix) {
+		name := s.Ref[len(componentsPrefix):]
+		s.Ref = "#/definitions/" + name
+		if _, ok := defs[name]; !ok {
+			var def *schema
+			if r, ok := d.Resources[name]; ok {
+				def = resourceSchema(d, r)
+			} else if t, ok := d.Types[name]; ok {
+				def = objectSchema(d, t)
+			} else {
+				return fmt.Errorf("Unknown type %s", name)
+			}
+			defs[name] = def
+			if err := resolveRefs(d, def, defs); err != nil {
+				return err
+			}
+		}
+	}
+	if err := resolveRefs(d, s.Items, defs); err != nil {
+		return err
+	}
+	if err := resolveRefs(d, s.AdditionalProperties, defs); err != nil {
+		return err
+	}
+	for _, p := range s.Properties {
+		if err := resolveRefs(d, p, defs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSONSchemas writes the JSON schemas of the resource media types, of the payload types and of
+// the action payloads to the "resources", "types" and "payloads" sub-directories of dir and
+// returns the paths of the schema files.
+func (o *Output) WriteJSONSchemas(d *gen.APIDescriptor, dir, title string) ([]string, error) {
+	w, err := NewJSONSchemaWriter(title)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	write := func(sub, name string, fn func(io.Writer) error) error {
+		p := filepath.Join(dir, sub, name+".json")
+		if err := o.Write(p, fn); err != nil {
+			return err
+		}
+		files = append(files, p)
+		return nil
+	}
+	for _, name := range d.ResourceNames {
+		res := d.Resources[name]
+		err := write("resources", name, func(out io.Writer) error {
+			return w.WriteResource(d, res, out)
+		})
+		if err != nil {
+			return files, err
+		}
+		for _, a := range res.Actions {
+			if !HasJSONPayload(a) {
+				continue
+			}
+			action := a
+			err := write("payloads", name+"_"+a.Name, func(out io.Writer) error {
+				return w.WritePayload(d, action, out)
+			})
+			if err != nil {
+				return files, err
+			}
+		}
+	}
+	for _, name := range d.TypeNames {
+		typeName := name
+		err := write("types", name, func(out io.Writer) error {
+			return w.WriteType(d, typeName, out)
+		})
+		if err != nil {
+			return files, err
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,407 @@
+package writers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+// OpenAPIWriter struct exposes methods to generate OpenAPI 3 documents describing an API.
+type OpenAPIWriter struct {
+	Title string // Document title, e.g. "RightScale CM API 1.5"
+}
+
+// NewOpenAPIWriter creates a new writer that generates OpenAPI 3 documents with the given title.
+func NewOpenAPIWriter(title string) (*OpenAPIWriter, error) {
+	return &OpenAPIWriter{Title: title}, nil
+}
+
+// WriteOpenAPI writes the OpenAPI 3 document describing the resources and types of the API.
+// Each resource action path pattern produces one operation whose id is the resource name followed
+// by the action name. Resource media types and payload types are described under
+// components/schemas.
+// Actions may use different variable names for the same path pattern (e.g. "/api/servers/:id" and
+// "/api/servers/:server_id"), the path templates use the variable names of the first action so
+// that they are unique. Operations built from the first path pattern of actions are written first,
+// an operation built from another path pattern is skipped if the same path and method is already
+// described. WriteOpenAPI returns an error if the first path patterns of two actions collide.
+func (o *OpenAPIWriter) WriteOpenAPI(d *gen.APIDescriptor, w io.Writer) error {
+	doc := openAPIDoc{
+		OpenAPI: "3.0.0",
+		Info:    openAPIInfo{Title: o.Title, Version: d.Version},
+		Paths:   make(map[string]map[string]*openAPIOperation),
+		Components: openAPIComponents{
+			Schemas: make(map[string]*schema),
+		},
+	}
+	templates := make(map[string][]string) // Path template variable names indexed by pattern
+	for _, primary := range []bool{true, false} {
+		for _, name := range d.ResourceNames {
+			res := d.Resources[name]
+			if primary {
+				doc.Components.Schemas[name] = resourceSchema(d, res)
+			}
+			for _, a := range res.Actions {
+				for i, p := range a.PathPatterns {
+					if (i == 0) != primary {
+						continue
+					}
+					vars, ok := templates[p.Pattern]
+					if !ok {
+						vars = p.Variables
+						templates[p.Pattern] = vars
+					}
+					path := openAPIPath(p, vars)
+					ops, ok := doc.Paths[path]
+					if !ok {
+						ops = make(map[string]*openAPIOperation)
+						doc.Paths[path] = ops
+					}
+					op := openAPIActionOperation(d, a, vars)
+					if i > 0 {
+						op.OperationID = fmt.Sprintf("%s%d", op.OperationID, i+1)
+					}
+					method := strings.ToLower(p.HTTPMethod)
+					if existing, ok := ops[method]; ok {
+						if primary {
+							return fmt.Errorf("Operations %s and %s have the same path and method %s %s",
+								existing.OperationID, op.OperationID, p.HTTPMethod, path)
+						}
+						continue
+					}
+					ops[method] = op
+				}
+			}
+		}
+	}
+	for _, name := range d.TypeNames {
+		if _, ok := doc.Components.Schemas[name]; ok {
+			return fmt.Errorf("Type %s has the same name as a resource", name)
+		}
+		doc.Components.Schemas[name] = objectSchema(d, d.Types[name])
+	}
+	b, err := json.MarshalIndent(&doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Failed to serialize OpenAPI document: %s", err)
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+type (
+	// openAPIDoc is the top level OpenAPI 3 document.
+	openAPIDoc struct {
+		OpenAPI    string                                  `json:"openapi"`
+		Info       openAPIInfo                             `json:"info"`
+		Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+		Components openAPIComponents                       `json:"components"`
+	}
+
+	// openAPIInfo contains the API title and version.
+	openAPIInfo struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	}
+
+	// openAPIComponents contains the reusable schemas.
+	openAPIComponents struct {
+		Schemas map[string]*schema `json:"schemas"`
+	}
+
+	// openAPIOperation describes a single API operation, i.e. a resource action path pattern.
+	openAPIOperation struct {
+		OperationID string                      `json:"operationId"`
+		Summary     string                      `json:"summary,omitempty"`
+		Description string                      `json:"description,omitempty"`
+		Tags        []string                    `json:"tags"`
+		Parameters  []*openAPIParameter         `json:"parameters,omitempty"`
+		RequestBody *openAPIRequestBody         `json:"requestBody,omitempty"`
+		Responses   map[string]*openAPIResponse `json:"responses"`
+	}
+
+	// openAPIParameter describes a path, query string or header parameter.
+	openAPIParameter struct {
+		Name        string  `json:"name"`
+		In          string  `json:"in"`
+		Description string  `json:"description,omitempty"`
+		Required    bool    `json:"required,omitempty"`
+		Schema      *schema `json:"schema"`
+	}
+
+	// openAPIRequestBody describes a request payload.
+	openAPIRequestBody struct {
+		Required bool                         `json:"required,omitempty"`
+		Content  map[string]*openAPIMediaType `json:"content"`
+	}
+
+	// openAPIResponse describes a response.
+	openAPIResponse struct {
+		Description string                       `json:"description"`
+		Headers     map[string]*openAPIHeader    `json:"headers,omitempty"`
+		Content     map[string]*openAPIMediaType `json:"content,omitempty"`
+	}
+
+	// openAPIHeader describes a response header.
+	openAPIHeader struct {
+		Description string  `json:"description,omitempty"`
+		Schema      *schema `json:"schema"`
+	}
+
+	// openAPIMediaType associates a schema with a content type.
+	openAPIMediaType struct {
+		Schema *schema `json:"schema"`
+	}
+
+	// schema is the subset of JSON schema used to describe parameters, payloads and media types.
+	schema struct {
+		Ref                  string             `json:"$ref,omitempty"`
+		Type                 string             `json:"type,omitempty"`
+		Format               string             `json:"format,omitempty"`
+		Description          string             `json:"description,omitempty"`
+		Pattern              string             `json:"pattern,omitempty"`
+		Enum                 []interface{}      `json:"enum,omitempty"`
+		MinLength            *int               `json:"minLength,omitempty"`
+		Minimum              *int               `json:"minimum,omitempty"`
+		Maximum              *int               `json:"maximum,omitempty"`
+		Items                *schema            `json:"items,omitempty"`
+		Properties           map[string]*schema `json:"properties,omitempty"`
+		Required             []string           `json:"required,omitempty"`
+		AdditionalProperties *schema            `json:"additionalProperties,omitempty"`
+	}
+)
+
+// openAPIPath converts a path pattern to an OpenAPI path template using the given variable names,
+// e.g. "/api/servers/%s" with variable "id" becomes "/api/servers/{id}".
+func openAPIPath(p *gen.PathPattern, variables []string) string {
+	vars := make([]interface{}, len(variables))
+	for i, v := range variables {
+		vars[i] = "{" + v + "}"
+	}
+	return fmt.Sprintf(p.Pattern, vars...)
+}
+
+// openAPIActionOperation builds the operation corresponding to an action path pattern given the
+// names of the path template variables.
+func openAPIActionOperation(d *gen.APIDescriptor, a *gen.Action, variables []string) *openAPIOperation {
+	desc := strings.TrimSpace(a.Description)
+	op := &openAPIOperation{
+		OperationID: a.ResourceName + "_" + a.Name,
+		Summary:     strings.SplitN(desc, "\n", 2)[0],
+		Description: desc,
+		Tags:        []string{a.ResourceName},
+		Responses:   openAPIResponses(d, a),
+	}
+	for _, v := range variables {
+		op.Parameters = append(op.Parameters, &openAPIParameter{
+			Name:     v,
+			In:       "path",
+			Required: true,
+			Schema:   &schema{Type: "string"},
+		})
+	}
+	var payload []*gen.ActionParam
+	for _, param := range a.Params {
+		switch param.Location {
+		case gen.QueryParam:
+			op.Parameters = append(op.Parameters, &openAPIParameter{
+				Name:        param.QueryName,
+				In:          "query",
+				Description: param.Description,
+				Required:    param.Mandatory,
+				Schema:      paramSchema(d, param),
+			})
+		case gen.PayloadParam:
+			payload = append(payload, param)
+		}
+	}
+	if d.Version != "" {
+		op.Parameters = append(op.Parameters, &openAPIParameter{
+			Name:     "X-Api-Version",
+			In:       "header",
+			Required: true,
+			Schema:   &schema{Type: "string", Enum: []interface{}{d.Version}},
+		})
+	}
+	if a.Payload != nil {
+		op.RequestBody = &openAPIRequestBody{
+			Required: true,
+			Content:  map[string]*openAPIMediaType{"application/json": {Schema: dataTypeSchema(d, a.Payload)}},
+		}
+	} else if len(payload) > 0 {
+		body := &schema{Type: "object", Properties: make(map[string]*schema)}
+		contentType := "application/json"
+		for _, param := range payload {
+			body.Properties[param.Name] = paramSchema(d, param)
+			if param.Mandatory {
+				body.Required = append(body.Required, param.Name)
+			}
+			if _, ok := param.Type.(*gen.UploadDataType); ok {
+				contentType = "multipart/form-data"
+			}
+		}
+		sort.Strings(body.Required)
+		op.RequestBody = &openAPIRequestBody{
+			Required: len(body.Required) > 0,
+			Content:  map[string]*openAPIMediaType{contentType: {Schema: body}},
+		}
+	}
+	return op
+}
+
+// openAPIResponses builds the responses of the given action from its return type.
+func openAPIResponses(d *gen.APIDescriptor, a *gen.Action) map[string]*openAPIResponse {
+	switch {
+	case a.ReturnLocation || strings.HasSuffix(a.Return, "Locator"):
+		return map[string]*openAPIResponse{"201": {
+			Description: "Created",
+			Headers: map[string]*openAPIHeader{"Location": {
+				Description: "Href of created resource",
+				Schema:      &schema{Type: "string"},
+			}},
+		}}
+	case a.Return == "":
+		return map[string]*openAPIResponse{"204": {Description: "No Content"}}
+	case a.IsStreamable():
+		return map[string]*openAPIResponse{"200": {
+			Description: "OK",
+			Content:     map[string]*openAPIMediaType{"text/plain": {Schema: &schema{Type: "string"}}},
+		}}
+	default:
+		return map[string]*openAPIResponse{"200": {
+			Description: "OK",
+			Content:     map[string]*openAPIMediaType{"application/json": {Schema: goTypeSchema(d, a.Return)}},
+		}}
+	}
+}
+
+// resourceSchema builds the schema of the given resource media type from its attributes.
+func resourceSchema(d *gen.APIDescriptor, res *gen.Resource) *schema {
+	s := &schema{Type: "object", Description: strings.TrimSpace(res.Description)}
+	if len(res.Attributes) > 0 {
+		s.Properties = make(map[string]*schema, len(res.Attributes))
+		for _, a := range res.Attributes {
+			s.Properties[a.Name] = goTypeSchema(d, a.FieldType)
+		}
+	}
+	return s
+}
+
+// objectSchema builds the schema of the given object data type.
+func objectSchema(d *gen.APIDescriptor, o *gen.ObjectDataType) *schema {
+	s := &schema{Type: "object", Properties: make(map[string]*schema, len(o.Fields))}
+	for _, f := range o.Fields {
+		s.Properties[f.Name] = paramSchema(d, f)
+		if f.Mandatory {
+			s.Required = append(s.Required, f.Name)
+		}
+	}
+	sort.Strings(s.Required)
+	return s
+}
+
+// paramSchema builds the schema of the given parameter including its validation rules.
+func paramSchema(d *gen.APIDescriptor, p *gen.ActionParam) *schema {
+	s := dataTypeSchema(d, p.Type)
+	if s.Ref != "" {
+		return s // Siblings of $ref are ignored
+	}
+	s.Description = p.Description
+	v := s
+	if s.Type == "array" && s.Items.Ref == "" {
+		v = s.Items // Validations apply to array elements
+	}
+	if p.QueryName == "filter[]" && len(p.ValidValues) > 0 {
+		// Valid values of filters are the names of the fields that can be filtered on
+		names := make([]string, len(p.ValidValues))
+		for i, n := range p.ValidValues {
+			names[i] = regexp.QuoteMeta(fmt.Sprint(n))
+		}
+		v.Pattern = fmt.Sprintf("^(%s)(==|<>)", strings.Join(names, "|"))
+	} else {
+		if p.Regexp != "" {
+			v.Pattern = p.Regexp
+		}
+		if len(p.ValidValues) > 0 {
+			v.Enum = p.ValidValues
+		}
+	}
+	if p.NonBlank && v.Type == "string" {
+		one := 1
+		v.MinLength = &one
+	}
+	if s.Type == "integer" {
+		if p.Min != 0 {
+			min := p.Min
+			s.Minimum = &min
+		}
+		if p.Max != 0 {
+			max := p.Max
+			s.Maximum = &max
+		}
+	}
+	return s
+}
+
+// dataTypeSchema builds the schema of the given data type, named object types are referenced.
+func dataTypeSchema(d *gen.APIDescriptor, t gen.DataType) *schema {
+	switch dt := t.(type) {
+	case *gen.BasicDataType:
+		return goTypeSchema(d, string(*dt))
+	case *gen.ArrayDataType:
+		return &schema{Type: "array", Items: paramSchema(d, dt.ElemType)}
+	case *gen.ObjectDataType:
+		if _, ok := d.Types[dt.TypeName]; ok {
+			return &schema{Ref: schemaRef(dt.TypeName)}
+		}
+		return objectSchema(d, dt)
+	case *gen.EnumerableDataType:
+		return &schema{Type: "object", AdditionalProperties: &schema{}}
+	case *gen.UploadDataType:
+		return &schema{Type: "string", Format: "binary"}
+	}
+	return &schema{}
+}
+
+// goTypeSchema builds the schema corresponding to the given generated Go type, e.g.
+// "[]*Server" or "map[string]string". Types whose names are API resources or types are
+// referenced.
+func goTypeSchema(d *gen.APIDescriptor, t string) *schema {
+	t = strings.TrimPrefix(t, "*")
+	switch {
+	case t == "string":
+		return &schema{Type: "string"}
+	case t == "int":
+		return &schema{Type: "integer"}
+	case t == "float64":
+		return &schema{Type: "number"}
+	case t == "bool":
+		return &schema{Type: "boolean"}
+	case t == "rsapi.Time":
+		return &schema{Type: "string", Format: "date-time"}
+	case t == "rsapi.FileUpload":
+		return &schema{Type: "string", Format: "binary"}
+	case strings.HasPrefix(t, "[]"):
+		return &schema{Type: "array", Items: goTypeSchema(d, t[2:])}
+	case strings.HasPrefix(t, "map[string]"):
+		return &schema{Type: "object", AdditionalProperties: goTypeSchema(d, t[11:])}
+	case t == "interface{}" || t == "":
+		return &schema{}
+	}
+	if _, ok := d.Resources[t]; ok {
+		return &schema{Ref: schemaRef(t)}
+	}
+	if _, ok := d.Types[t]; ok {
+		return &schema{Ref: schemaRef(t)}
+	}
+	return &schema{Type: "object"}
+}
+
+// schemaRef returns the reference to the schema with the given name.
+func schemaRef(name string) string {
+	return "#/components/schemas/" + name
+}
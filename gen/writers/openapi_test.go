@@ -0,0 +1,97 @@
+package writers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/rightscale/rsc/gen"
+	"github.com/rightscale/rsc/gen/api15"
+	"github.com/rightscale/rsc/gen/writers"
+)
+
+var _ = Describe("OpenAPIWriter", func() {
+	var (
+		descriptor *gen.APIDescriptor
+		doc        map[string]map[string]map[string]interface{}
+		err        error
+	)
+
+	JustBeforeEach(func() {
+		var w *writers.OpenAPIWriter
+		w, err = writers.NewOpenAPIWriter("Test")
+		Ω(err).ShouldNot(HaveOccurred())
+		var buf bytes.Buffer
+		if err = w.WriteOpenAPI(descriptor, &buf); err != nil {
+			return
+		}
+		var raw struct {
+			Paths map[string]map[string]map[string]interface{} `json:"paths"`
+		}
+		Ω(json.Unmarshal(buf.Bytes(), &raw)).Should(Succeed())
+		doc = raw.Paths
+	})
+
+	Context("with the CM API 1.5 descriptor", func() {
+		BeforeEach(func() {
+			descriptor, err = api15.LoadDescriptor("../../cm15")
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+
+		It("produces unique path templates", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			varRegexp := regexp.MustCompile(`\{[^}]+\}`)
+			templates := make(map[string]string)
+			for path, ops := range doc {
+				normalized := varRegexp.ReplaceAllString(path, "{}")
+				Ω(templates).ShouldNot(HaveKey(normalized), path+" and "+templates[normalized])
+				templates[normalized] = path
+				for _, op := range ops {
+					var names []string
+					params, _ := op["parameters"].([]interface{})
+					for _, p := range params {
+						if p.(map[string]interface{})["in"] == "path" {
+							names = append(names, p.(map[string]interface{})["name"].(string))
+						}
+					}
+					var expected []string
+					for _, v := range varRegexp.FindAllString(path, -1) {
+						expected = append(expected, v[1:len(v)-1])
+					}
+					Ω(names).Should(Equal(expected), path)
+				}
+			}
+		})
+
+		It("uses the path template of the primary action", func() {
+			Ω(doc).Should(HaveKey("/api/servers/{id}/launch"))
+			Ω(doc["/api/servers/{id}/launch"]["post"]["operationId"]).Should(Equal("Server_launch"))
+		})
+	})
+
+	Context("with actions that have the same path and method", func() {
+		BeforeEach(func() {
+			action := func(resource string) *gen.Action {
+				return &gen.Action{Name: "show", ResourceName: resource, PathPatterns: []*gen.PathPattern{
+					{HTTPMethod: "GET", Pattern: "/api/things/%s", Variables: []string{"id"}},
+				}}
+			}
+			descriptor = &gen.APIDescriptor{
+				Resources: map[string]*gen.Resource{
+					"Foo": {Name: "Foo", Actions: []*gen.Action{action("Foo")}},
+					"Bar": {Name: "Bar", Actions: []*gen.Action{action("Bar")}},
+				},
+				ResourceNames: []string{"Bar", "Foo"},
+				Types:         map[string]*gen.ObjectDataType{},
+			}
+		})
+
+		It("fails", func() {
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("Bar_show and Foo_show"))
+		})
+	})
+})
@@ -0,0 +1,75 @@
+package writers
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Output writes the files produced by the generators.
+// Go source files are formatted in-process so that the generated code only depends on the
+// generator inputs. Files whose content did not change are left untouched so that their
+// modification time is preserved. In check mode no file is written, instead the paths of the
+// files that are missing or whose content differs are recorded in Stale. Only the files produced
+// by the generator are checked: leftover files that are no longer generated, e.g. after an API
+// version was removed, are not reported and must be deleted manually.
+type Output struct {
+	Check bool     // Whether to only check that the files on disk are up-to-date
+	Stale []string // Paths of the files that are out-of-date, only recorded in check mode
+}
+
+// NewOutput is the output factory.
+func NewOutput(check bool) *Output {
+	return &Output{Check: check}
+}
+
+// Write renders the content of the file at the given path using fn and writes it to disk if it
+// changed. The content of files with the ".go" extension is formatted with go/format.
+func (o *Output) Write(path string, fn func(io.Writer) error) error {
+	var buf bytes.Buffer
+	if err := fn(&buf); err != nil {
+		return err
+	}
+	content := buf.Bytes()
+	if strings.HasSuffix(path, ".go") {
+		formatted, err := format.Source(content)
+		if err != nil {
+			return fmt.Errorf("Failed to format generated code of %s: %s", path, err)
+		}
+		content = formatted
+	}
+	return o.WriteFile(path, content)
+}
+
+// WriteFile writes content to the file at the given path unless the file already has that
+// content. Missing parent directories are created.
+func (o *Output) WriteFile(path string, content []byte) error {
+	if existing, err := ioutil.ReadFile(path); err == nil && bytes.Equal(existing, content) {
+		return nil
+	}
+	if o.Check {
+		o.Stale = append(o.Stale, path)
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("Failed to write %s: %s", path, err)
+	}
+	return nil
+}
+
+// StaleError returns an error listing the paths of the out-of-date files if there are any, nil
+// otherwise.
+func (o *Output) StaleError() error {
+	if len(o.Stale) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Generated files are out-of-date:\n%s", strings.Join(o.Stale, "\n"))
+}
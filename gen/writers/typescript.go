@@ -0,0 +1,491 @@
+package writers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/rightscale/rsc/gen"
+)
+
+// TypeScriptWriter struct exposes methods to generate a TypeScript API client.
+// The client consists of a single file containing interfaces for the resources and payload types,
+// a fetch based transport and one locator class per resource exposing the resource actions.
+type TypeScriptWriter struct {
+	Title   string // API title used in header comment, e.g. "RightScale Cloud Management API 1.5"
+	Version string // Value of X-API-Version header sent with each request, not sent if blank
+}
+
+// NewTypeScriptWriter creates a new writer that generates TypeScript clients.
+func NewTypeScriptWriter(title, version string) (*TypeScriptWriter, error) {
+	if version == "unversioned" {
+		version = ""
+	}
+	return &TypeScriptWriter{Title: title, Version: version}, nil
+}
+
+// WriteClient writes the TypeScript client for the given API.
+func (w *TypeScriptWriter) WriteClient(d *gen.APIDescriptor, out io.Writer) error {
+	funcMap := template.FuncMap{
+		"comment":     comment,
+		"commandLine": commandLine,
+		"jsDoc":       jsDoc,
+		"jsString":    jsString,
+		"lowerFirst":  lowerFirst,
+		"fieldName":   tsFieldName,
+		"varName":     tsVarName,
+		"optionsType": optionsTypeName,
+		"optionals":   optionalParams,
+		"stripStar":   stripStar,
+		"tsType": func(t string) string {
+			return goTypeToTS(d, t)
+		},
+		"paramType": func(p *gen.ActionParam) string {
+			return dataTypeToTS(d, p.Type)
+		},
+		"paramKey": func(p *gen.ActionParam) string {
+			if p.Location == gen.QueryParam {
+				return p.QueryName
+			}
+			return p.Name
+		},
+		"location": func(p *gen.ActionParam) string {
+			if p.Location == gen.QueryParam {
+				return "query"
+			}
+			return "payload"
+		},
+		"signature": func(a *gen.Action) string {
+			return tsParameters(d, a)
+		},
+		"returnType": func(a *gen.Action) string {
+			return tsReturnType(d, a)
+		},
+		"paths": func(a *gen.Action) string {
+			paths := make([]string, len(a.PathPatterns))
+			for i, p := range a.PathPatterns {
+				paths[i] = p.HTTPMethod + " " + p.Path
+			}
+			return strings.Join(paths, "\n")
+		},
+	}
+	t, err := template.New("typescript-client").Funcs(funcMap).Parse(typeScriptTmpl)
+	if err != nil {
+		return err
+	}
+	resources := make([]*gen.Resource, len(d.ResourceNames))
+	for i, n := range d.ResourceNames {
+		resources[i] = d.Resources[n]
+	}
+	types := make([]*gen.ObjectDataType, len(d.TypeNames))
+	for i, n := range d.TypeNames {
+		types[i] = d.Types[n]
+	}
+	return t.Execute(out, map[string]interface{}{
+		"Title":     w.Title,
+		"Version":   w.Version,
+		"Resources": resources,
+		"Types":     types,
+	})
+}
+
+// TypeScript reserved words and names of local variables of the generated methods that cannot be
+// used as parameter names
+var tsReservedWords = map[string]bool{"break": true, "case": true, "catch": true, "class": true,
+	"const": true, "continue": true, "debugger": true, "default": true, "delete": true, "do": true,
+	"else": true, "enum": true, "export": true, "extends": true, "false": true, "finally": true,
+	"for": true, "function": true, "if": true, "import": true, "in": true, "instanceof": true,
+	"new": true, "null": true, "return": true, "super": true, "switch": true, "this": true,
+	"throw": true, "true": true, "try": true, "typeof": true, "var": true, "void": true,
+	"while": true, "with": true, "let": true, "static": true, "yield": true, "await": true,
+	"implements": true, "interface": true, "package": true, "private": true, "protected": true,
+	"public": true, "options": true, "p": true, "query": true, "payload": true, "resp": true,
+	"location": true}
+
+// Matches valid TypeScript identifiers
+var tsIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// Name of TypeScript parameter corresponding to given action parameter
+func tsVarName(p *gen.ActionParam) string {
+	if tsReservedWords[p.VarName] {
+		return p.VarName + "_"
+	}
+	return p.VarName
+}
+
+// Name of TypeScript interface field, quoted if not a valid identifier
+func tsFieldName(name string) string {
+	if tsIdentifierRegexp.MatchString(name) {
+		return name
+	}
+	return jsString(name)
+}
+
+// JavaScript string literal
+func jsString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+// "MultiTerminate" => "multiTerminate"
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// JSDoc comment indented with given prefix
+func jsDoc(indent string, elems ...string) string {
+	var lines []string
+	for _, e := range elems {
+		e = strings.Replace(strings.TrimSpace(e), "*/", "*\\/", -1)
+		if e == "" {
+			continue
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		for _, l := range strings.Split(e, "\n") {
+			lines = append(lines, strings.TrimSpace(l))
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	if len(lines) == 1 {
+		return indent + "/** " + lines[0] + " */\n"
+	}
+	res := indent + "/**\n"
+	for _, l := range lines {
+		if l == "" {
+			res += indent + " *\n"
+		} else {
+			res += indent + " * " + l + "\n"
+		}
+	}
+	return res + indent + " */\n"
+}
+
+// Signature of the TypeScript method corresponding to the given action: mandatory parameters
+// followed by an optional options object.
+func tsParameters(d *gen.APIDescriptor, a *gen.Action) string {
+	var params []string
+	for _, p := range a.MandatoryParams() {
+		params = append(params, fmt.Sprintf("%s: %s", tsVarName(p), dataTypeToTS(d, p.Type)))
+	}
+	if a.HasOptionalParams() {
+		params = append(params, fmt.Sprintf("options?: %s", optionsTypeName(a)))
+	}
+	return strings.Join(params, ", ")
+}
+
+// Type of value returned by the TypeScript method corresponding to the given action.
+func tsReturnType(d *gen.APIDescriptor, a *gen.Action) string {
+	switch {
+	case a.Return == "":
+		return "void"
+	case a.ReturnLocation:
+		return stripStar(a.Return)
+	case a.IsStreamable():
+		return "string"
+	}
+	return goTypeToTS(d, a.Return)
+}
+
+// TypeScript type corresponding to the given data type, inline object types are written inline.
+func dataTypeToTS(d *gen.APIDescriptor, t gen.DataType) string {
+	switch dt := t.(type) {
+	case *gen.BasicDataType:
+		return goTypeToTS(d, string(*dt))
+	case *gen.ArrayDataType:
+		return arrayOf(dataTypeToTS(d, dt.ElemType.Type))
+	case *gen.ObjectDataType:
+		if _, ok := d.Types[dt.TypeName]; ok {
+			return dt.TypeName
+		}
+		fields := make([]string, len(dt.Fields))
+		for i, f := range dt.Fields {
+			opt := "?"
+			if f.Mandatory {
+				opt = ""
+			}
+			fields[i] = fmt.Sprintf("%s%s: %s;", tsFieldName(f.Name), opt, dataTypeToTS(d, f.Type))
+		}
+		return "{ " + strings.Join(fields, " ") + " }"
+	case *gen.EnumerableDataType:
+		return "{ [key: string]: any }"
+	case *gen.UploadDataType:
+		return "Blob"
+	}
+	return "any"
+}
+
+// TypeScript type corresponding to the given generated Go type, e.g. "[]*Server" => "Server[]".
+func goTypeToTS(d *gen.APIDescriptor, t string) string {
+	t = strings.TrimPrefix(t, "*")
+	switch {
+	case t == "string" || t == "rsapi.Time":
+		return "string"
+	case t == "int" || t == "float64":
+		return "number"
+	case t == "bool":
+		return "boolean"
+	case t == "rsapi.FileUpload":
+		return "Blob"
+	case strings.HasPrefix(t, "[]"):
+		return arrayOf(goTypeToTS(d, t[2:]))
+	case strings.HasPrefix(t, "map[string]"):
+		return fmt.Sprintf("{ [key: string]: %s }", goTypeToTS(d, t[11:]))
+	}
+	if _, ok := d.Resources[t]; ok {
+		return t
+	}
+	if _, ok := d.Types[t]; ok {
+		return t
+	}
+	return "any"
+}
+
+// Array of given TypeScript type
+func arrayOf(t string) string {
+	if strings.HasPrefix(t, "{") {
+		return "Array<" + t + ">"
+	}
+	return t + "[]"
+}
+
+// Inline templates
+
+const typeScriptTmpl = `//************************************************************************//
+//                {{.Title}} TypeScript client
+//
+// Generated with:
+{{comment commandLine}}
+//
+// The content of this file is auto-generated, DO NOT MODIFY
+//************************************************************************//
+
+/** Authenticator signs API requests, e.g. by setting the Authorization header. */
+export interface Authenticator {
+  sign(headers: Headers): Promise<void>;
+}
+
+/** BearerAuthenticator signs requests with an OAuth access token. */
+export class BearerAuthenticator implements Authenticator {
+  constructor(private token: string) {}
+
+  async sign(headers: Headers): Promise<void> {
+    headers.set("Authorization", "Bearer " + this.token);
+  }
+}
+
+/** APIError is the error returned when the API response status code is not 2xx. */
+export class APIError extends Error {
+  constructor(public status: number, public body: string) {
+    super("invalid response " + status + ": " + body);
+  }
+}
+
+/** Params contains query string or payload parameters indexed by name. */
+export type Params = { [name: string]: any };
+
+/** PathPattern describes one of the paths of an action. */
+interface PathPattern {
+  method: string;
+  pattern: string;
+  variables: string[];
+  regexp: RegExp;
+}
+
+/** Client sends requests to the API, use the locator factory methods to access resources. */
+export class Client {
+  /**
+   * @param host API host name, e.g. "us-3.rightscale.com", or URL
+   * @param auth request authenticator, requests are not signed if undefined
+   * @param fetchFn fetch implementation, defaults to the global fetch function
+   */
+  constructor(public host: string, public auth?: Authenticator, public fetchFn: typeof fetch = fetch) {}
+{{range .Resources}}{{if .Actions}}
+  /** {{.Name}}Locator builds a locator from the given href. */
+  {{lowerFirst .Name}}Locator(href: string): {{.Name}}Locator {
+    return new {{.Name}}Locator(this, href);
+  }
+{{end}}{{end}}
+  /** request sends a request to the API, it fails with an APIError if the response status code is not 2xx. */
+  async request(method: string, path: string, query: Params, payload: Params): Promise<Response> {
+    let url = /^https?:\/\//.test(this.host) ? this.host : "https://" + this.host;
+    url += path;
+    const qs = encodeParams(query);
+    if (qs !== "") {
+      url += "?" + qs;
+    }
+    const headers = new Headers();{{if .Version}}
+    headers.set("X-API-Version", {{jsString .Version}});{{end}}
+    let body: BodyInit | undefined;
+    if (Object.keys(payload).length > 0) {
+      if (hasUpload(payload)) {
+        body = formData(payload);
+      } else {
+        headers.set("Content-Type", "application/json");
+        body = JSON.stringify(payload);
+      }
+    }
+    if (this.auth) {
+      await this.auth.sign(headers);
+    }
+    const resp = await this.fetchFn(url, { method: method, headers: headers, body: body });
+    if (resp.status < 200 || resp.status > 299) {
+      throw new APIError(resp.status, await resp.text());
+    }
+    return resp;
+  }
+}
+
+/**
+ * actionPath computes the method and path of the request made by an action: the values of the
+ * path variables are extracted from the href using the resource path patterns then substituted
+ * in the action path pattern that uses the most variables.
+ */
+function actionPath(href: string, resourcePaths: { [action: string]: PathPattern[] }, action: string): { method: string; path: string } {
+  const matches: PathPattern[] = [];
+  for (const a of Object.keys(resourcePaths)) {
+    for (const p of resourcePaths[a]) {
+      if (p.regexp.test(href) || p.regexp.test(href + "/")) {
+        matches.push(p);
+      }
+    }
+  }
+  if (matches.length === 0) {
+    throw new Error("href '" + href + "' does not match any path of the resource");
+  }
+  matches.sort((a, b) => b.pattern.length - a.pattern.length);
+  const vars: { [name: string]: string } = {};
+  const m = matches[0].regexp.exec(href) || matches[0].regexp.exec(href + "/");
+  for (let i = 0; m && i < matches[0].variables.length; i++) {
+    vars[matches[0].variables[i]] = m[i + 1];
+  }
+  let best: { method: string; path: string } | undefined;
+  let weight = -1;
+  for (const p of resourcePaths[action]) {
+    if (p.variables.length <= weight || p.variables.some(v => vars[v] === undefined)) {
+      continue;
+    }
+    let i = 0;
+    best = { method: p.method, path: p.pattern.replace(/%s/g, () => vars[p.variables[i++]]) };
+    weight = p.variables.length;
+  }
+  if (!best) {
+    throw new Error("missing variables in href '" + href + "' to build the path of action " + action);
+  }
+  return best;
+}
+
+/** encodeParams encodes the given parameters into a query string using the "a[b][]=c" notation. */
+function encodeParams(params: Params): string {
+  return flattenParams(params, "").map(([k, v]) => encodeURIComponent(k) + "=" + encodeURIComponent(String(v))).join("&");
+}
+
+/** flattenParams flattens nested parameters into a list of name and value pairs. */
+function flattenParams(params: Params, prefix: string): Array<[string, any]> {
+  const res: Array<[string, any]> = [];
+  for (const k of Object.keys(params)) {
+    const v = params[k];
+    const name = prefix === "" ? k : prefix + "[" + k + "]";
+    if (v === undefined || v === null) {
+      continue;
+    } else if (Array.isArray(v)) {
+      const n = name.slice(-2) === "[]" ? name : name + "[]";
+      for (const e of v) {
+        if (typeof e === "object" && !(e instanceof Blob)) {
+          res.push(...flattenParams(e, n));
+        } else {
+          res.push([n, e]);
+        }
+      }
+    } else if (typeof v === "object" && !(v instanceof Blob)) {
+      res.push(...flattenParams(v, name));
+    } else {
+      res.push([name, v]);
+    }
+  }
+  return res;
+}
+
+/** hasUpload returns true if the payload contains a file. */
+function hasUpload(payload: Params): boolean {
+  return flattenParams(payload, "").some(([, v]) => v instanceof Blob);
+}
+
+/** formData builds the multipart form corresponding to the given payload. */
+function formData(payload: Params): FormData {
+  const data = new FormData();
+  for (const [k, v] of flattenParams(payload, "")) {
+    data.append(k, v instanceof Blob ? v : String(v));
+  }
+  return data;
+}
+
+//===== Resources
+{{range .Resources}}{{$resource := .}}
+{{jsDoc "" .Description}}export interface {{.Name}} {
+{{range .Attributes}}  {{fieldName .Name}}?: {{tsType .FieldType}};
+{{end}}}
+{{if .Actions}}
+/** Path patterns of the {{.Name}} actions indexed by action name */
+const {{lowerFirst .Name}}Paths: { [action: string]: PathPattern[] } = {
+{{range .Actions}}  {{jsString .Name}}: [
+{{range .PathPatterns}}    { method: {{jsString .HTTPMethod}}, pattern: {{jsString .Pattern}}, variables: [{{range $i, $v := .Variables}}{{if $i}}, {{end}}{{jsString $v}}{{end}}], regexp: new RegExp({{jsString .Regexp}}) },
+{{end}}  ],
+{{end}}};
+{{range .Actions}}{{if .HasOptionalParams}}
+/** {{optionsType .}} contains the optional parameters of the {{$resource.Name}} {{.Name}} action. */
+export interface {{optionsType .}} {
+{{range optionals .}}{{jsDoc "  " .Description}}  {{fieldName .Name}}?: {{paramType .}};
+{{end}}}
+{{end}}{{end}}
+/** {{.Name}}Locator exposes the {{.Name}} resource actions. */
+export class {{.Name}}Locator {
+  constructor(public client: Client, public href: string) {}
+{{range .Actions}}{{$action := .}}
+{{jsDoc "  " (paths .) .Description}}  async {{lowerFirst .MethodName}}({{signature .}}): Promise<{{returnType .}}> {
+    const p = actionPath(this.href, {{lowerFirst $resource.Name}}Paths, {{jsString .Name}});
+    const query: Params = {};
+    const payload: Params = {};{{range .Params}}{{if .Mandatory}}
+    {{location .}}[{{jsString (paramKey .)}}] = {{varName .}};{{end}}{{end}}{{if .HasOptionalParams}}
+    if (options) {{"{"}}{{range optionals .}}
+      if (options[{{jsString .Name}}] !== undefined) {
+        {{location .}}[{{jsString (paramKey .)}}] = options[{{jsString .Name}}];
+      }{{end}}
+    }{{end}}
+    {{if eq .Return ""}}await this.client.request(p.method, p.path, query, payload);{{else}}const resp = await this.client.request(p.method, p.path, query, payload);{{if .ReturnLocation}}
+    const location = resp.headers.get("Location");
+    if (!location) {
+      throw new Error("missing Location header in response");
+    }
+    return new {{returnType .}}(this.client, location);{{else if .IsStreamable}}
+    return await resp.text();{{else}}
+    return (await resp.json()) as {{returnType .}};{{end}}{{end}}
+  }
+{{end}}}
+{{end}}{{end}}{{if .Types}}
+//===== Data Types
+{{range .Types}}
+export interface {{.TypeName}} {
+{{range .Fields}}{{jsDoc "  " .Description}}  {{fieldName .Name}}{{if not .Mandatory}}?{{end}}: {{paramType .}};
+{{end}}}
+{{end}}{{end}}`
+
+// WriteTypeScript writes the TypeScript client of the given API to the file at path.
+func (o *Output) WriteTypeScript(d *gen.APIDescriptor, path, title, version string) error {
+	w, err := NewTypeScriptWriter(title, version)
+	if err != nil {
+		return err
+	}
+	return o.Write(path, func(out io.Writer) error {
+		return w.WriteClient(d, out)
+	})
+}
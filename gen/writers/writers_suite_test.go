@@ -0,0 +1,13 @@
+package writers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestWriters(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Writers Suite")
+}
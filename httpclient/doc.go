@@ -25,5 +25,16 @@ Status: 200 OK
 Strict-Transport-Security: max-age=31536000; includeSubdomains;
 X-Request-Uuid: 3b036a56a5b04b35a94dbaf8240f1016
 
+The Recorder and Replayer types make it possible to record all the requests made by a program
+into a cassette file and to replay them later, for example in tests. Setting Middleware causes
+all the clients created by the package to be wrapped, including the ones used for authentication:
+
+	rec := httpclient.NewRecorder("fixtures/servers.json")
+	httpclient.Middleware = rec.Wrap
+	// ... make requests ...
+	err := rec.Save()
+
+	rep, err := httpclient.NewReplayer("fixtures/servers.json", httpclient.ReplayInOrder)
+	httpclient.Middleware = rep.Wrap
 */
 package httpclient
@@ -92,13 +92,15 @@ func init() {
 }
 
 // New returns an HTTP client using the settings specified by this package variables.
+// The client is wrapped with Middleware if set.
 func New() HTTPClient {
-	return &dumpClient{Client: newRawClient(false)}
+	return wrap(&dumpClient{Client: newRawClient(false)})
 }
 
 // NewNoRedirect returns an HTTP client that does not follow redirects.
+// The client is wrapped with Middleware if set.
 func NewNoRedirect() HTTPClient {
-	return &dumpClient{Client: newRawClient(true)}
+	return wrap(&dumpClient{Client: newRawClient(true)})
 }
 
 // ShortToken creates a 6 bytes unique string.
@@ -182,7 +184,7 @@ func (d *dumpClient) doImp(req *http.Request, hidden bool) (*http.Response, erro
 		return nil, err
 	}
 	if !hide {
-		dumpResponse(resp, req, reqBody)
+		dumpResponse(resp, req, reqBody, hidden)
 	}
 	log.Info("completed", "id", id, "status", resp.Status, "time", time.Since(startedAt).String())
 
@@ -216,9 +218,9 @@ func dumpRequest(req *http.Request) []byte {
 }
 
 // dumpResponse dumps the response and optionally the request (in case of JSON format) according to
-// DumpFormat.
+// DumpFormat. hidden indicates whether the request was made for authentication.
 // It also checks whether the special recorder pipe is opened and if so writes the dump to it.
-func dumpResponse(resp *http.Response, req *http.Request, reqBody []byte) {
+func dumpResponse(resp *http.Response, req *http.Request, reqBody []byte, hidden bool) {
 	if DumpFormat == NoDump {
 		return
 	}
@@ -250,6 +252,7 @@ func dumpResponse(resp *http.Response, req *http.Request, reqBody []byte) {
 			Status:     resp.StatusCode,
 			RespHeader: respHeaders,
 			RespBody:   string(respBody),
+			Hidden:     hidden,
 		}
 		b, err := json.MarshalIndent(dumped, "", "    ")
 		if err != nil {
@@ -0,0 +1,381 @@
+package httpclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/rightscale/rsc/recording"
+)
+
+const (
+	// ReplayInOrder causes a Replayer to serve the recorded interactions in the order they were
+	// recorded. Requests must match the recorded method, path and query string.
+	ReplayInOrder ReplayMode = iota
+
+	// ReplayMatching causes a Replayer to serve the first unused recorded interaction whose method,
+	// path and query string match the request regardless of order.
+	ReplayMatching
+)
+
+// ScrubbedValue is the value that replaces secrets in cassettes.
+const ScrubbedValue = "[SCRUBBED]"
+
+var (
+	// Middleware if not nil wraps all the clients returned by New and NewNoRedirect, including the
+	// clients used by the authenticators to create sessions. Set it to the Wrap method of a
+	// Recorder or Replayer to record or replay all the requests made by a program.
+	Middleware func(HTTPClient) HTTPClient
+)
+
+type (
+	// Cassette is a named sequence of recorded HTTP interactions.
+	Cassette struct {
+		Name         string                       // Cassette name, defaults to the file name
+		Interactions []*recording.RequestResponse // Recorded requests and responses in order
+	}
+
+	// Recorder is an HTTP client middleware that records all the requests and responses into a
+	// cassette file, scrubbing secrets and volatile headers. Call Save to write the file once done.
+	Recorder struct {
+		Path         string                              // Path to cassette file
+		ScrubHeaders map[string]bool                     // Headers whose values get scrubbed
+		DropHeaders  map[string]bool                     // Headers that are not recorded
+		ScrubFields  map[string]bool                     // JSON body fields whose values get scrubbed
+		Scrub        func(rr *recording.RequestResponse) // Custom scrubbing applied last, optional
+
+		mu       sync.Mutex
+		cassette Cassette
+	}
+
+	// Replayer is an HTTP client that serves the responses recorded in a cassette instead of
+	// making requests.
+	Replayer struct {
+		Mode ReplayMode // How recorded interactions get selected
+
+		mu       sync.Mutex
+		cassette *Cassette
+		used     []bool // Whether each interaction has been served
+		next     int    // Index of next interaction in ReplayInOrder mode
+	}
+
+	// ReplayMode dictates how a Replayer selects the recorded interaction matching a request.
+	ReplayMode int
+
+	// recordingClient records the requests made with the underlying client.
+	recordingClient struct {
+		recorder *Recorder
+		client   HTTPClient
+	}
+)
+
+// wrap applies Middleware to the given client if set.
+func wrap(c HTTPClient) HTTPClient {
+	if Middleware == nil {
+		return c
+	}
+	return Middleware(c)
+}
+
+// LoadCassette reads the cassette file at the given path.
+func LoadCassette(path string) (*Cassette, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read cassette: %s", err)
+	}
+	var c Cassette
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("Failed to load cassette %s: %s", path, err)
+	}
+	return &c, nil
+}
+
+// NewRecorder returns a recorder that writes to the cassette file at the given path. The recorder
+// scrubs the authentication headers and the JSON body fields holding credentials and tokens and
+// drops the Date, User-Agent and X-Request-Uuid headers by default.
+func NewRecorder(path string) *Recorder {
+	name := filepath.Base(path)
+	return &Recorder{
+		Path: path,
+		ScrubHeaders: map[string]bool{
+			"Authorization": true,
+			"Cookie":        true,
+			"Set-Cookie":    true,
+			"X-Rll-Secret":  true,
+		},
+		DropHeaders: map[string]bool{
+			"Date":           true,
+			"User-Agent":     true,
+			"X-Request-Uuid": true,
+		},
+		ScrubFields: map[string]bool{
+			"access_token":   true,
+			"instance_token": true,
+			"password":       true,
+			"refresh_token":  true,
+		},
+		cassette: Cassette{Name: strings.TrimSuffix(name, filepath.Ext(name))},
+	}
+}
+
+// Wrap returns a client that makes requests using the given client and records them.
+func (r *Recorder) Wrap(c HTTPClient) HTTPClient {
+	return &recordingClient{recorder: r, client: c}
+}
+
+// Interactions returns the interactions recorded so far.
+func (r *Recorder) Interactions() []*recording.RequestResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*recording.RequestResponse(nil), r.cassette.Interactions...)
+}
+
+// Save writes the cassette file, creating its directory if needed.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, err := json.MarshalIndent(&r.cassette, "", "    ")
+	if err != nil {
+		return fmt.Errorf("Failed to serialize cassette: %s", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0755); err != nil {
+		return fmt.Errorf("Failed to create cassette directory: %s", err)
+	}
+	if err := ioutil.WriteFile(r.Path, b, 0644); err != nil {
+		return fmt.Errorf("Failed to write cassette: %s", err)
+	}
+	return nil
+}
+
+// record scrubs and appends an interaction to the cassette.
+func (r *Recorder) record(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte) {
+	rr := recording.RequestResponse{
+		Verb:       req.Method,
+		URI:        req.URL.String(),
+		ReqHeader:  r.scrubHeaders(req.Header),
+		ReqBody:    r.ScrubBody(reqBody),
+		Status:     resp.StatusCode,
+		RespHeader: r.scrubHeaders(resp.Header),
+		RespBody:   r.ScrubBody(respBody),
+	}
+	if r.Scrub != nil {
+		r.Scrub(&rr)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &rr)
+}
+
+// scrubHeaders returns a copy of the given headers without the dropped headers and with the
+// values of the scrubbed headers replaced.
+func (r *Recorder) scrubHeaders(h http.Header) http.Header {
+	res := make(http.Header, len(h))
+	for k, v := range h {
+		if r.DropHeaders[k] {
+			continue
+		}
+		if r.ScrubHeaders[k] {
+			v = []string{ScrubbedValue}
+		}
+		res[k] = append([]string(nil), v...)
+	}
+	return res
+}
+
+// ScrubBody replaces the values of the scrubbed fields found at any depth if the body is a JSON
+// object or array and returns the resulting body.
+func (r *Recorder) ScrubBody(body []byte) string {
+	var val interface{}
+	if len(r.ScrubFields) == 0 || json.Unmarshal(body, &val) != nil {
+		return string(body)
+	}
+	if !r.scrubValue(val) {
+		return string(body)
+	}
+	b, err := json.Marshal(val)
+	if err != nil {
+		return string(body)
+	}
+	return string(b)
+}
+
+// scrubValue replaces the values of the scrubbed fields found in the given JSON value
+// recursively. It returns true if any value was replaced.
+func (r *Recorder) scrubValue(val interface{}) bool {
+	scrubbed := false
+	switch v := val.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if r.ScrubFields[k] {
+				v[k] = ScrubbedValue
+				scrubbed = true
+			} else if r.scrubValue(e) {
+				scrubbed = true
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if r.scrubValue(e) {
+				scrubbed = true
+			}
+		}
+	}
+	return scrubbed
+}
+
+// Do makes the request and records it.
+func (c *recordingClient) Do(req *http.Request) (*http.Response, error) {
+	return c.doImp(req, false)
+}
+
+// DoHidden makes the request and records it, hidden requests are recorded too so that replays
+// include authentication handshakes.
+func (c *recordingClient) DoHidden(req *http.Request) (*http.Response, error) {
+	return c.doImp(req, true)
+}
+
+// doImp makes the request using the underlying client and records the interaction.
+func (c *recordingClient) doImp(req *http.Request, hidden bool) (*http.Response, error) {
+	reqBody, err := dumpReqBody(req)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read request body: %s", err)
+	}
+	var resp *http.Response
+	if hidden {
+		resp, err = c.client.DoHidden(req)
+	} else {
+		resp, err = c.client.Do(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := dumpRespBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read response body: %s", err)
+	}
+	c.recorder.record(req, reqBody, resp, respBody)
+	return resp, nil
+}
+
+// NewReplayer returns a replayer that serves the interactions recorded in the cassette file at
+// the given path.
+func NewReplayer(path string, mode ReplayMode) (*Replayer, error) {
+	c, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewCassetteReplayer(c, mode), nil
+}
+
+// NewCassetteReplayer returns a replayer that serves the interactions of the given cassette.
+func NewCassetteReplayer(c *Cassette, mode ReplayMode) *Replayer {
+	return &Replayer{Mode: mode, cassette: c, used: make([]bool, len(c.Interactions))}
+}
+
+// Wrap returns the replayer, the given client is never used.
+func (r *Replayer) Wrap(HTTPClient) HTTPClient {
+	return r
+}
+
+// Remaining returns the number of recorded interactions that have not been served yet.
+func (r *Replayer) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, u := range r.used {
+		if !u {
+			n++
+		}
+	}
+	return n
+}
+
+// Do returns the recorded response matching the request.
+func (r *Replayer) Do(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	var found *recording.RequestResponse
+	switch r.Mode {
+	case ReplayInOrder:
+		if r.next >= len(r.cassette.Interactions) {
+			return nil, fmt.Errorf("cassette %s: no more interactions to replay for %s %s",
+				r.cassette.Name, req.Method, req.URL.RequestURI())
+		}
+		rr := r.cassette.Interactions[r.next]
+		if !matches(rr, req) {
+			return nil, fmt.Errorf("cassette %s: interaction %d is %s %s but request is %s %s",
+				r.cassette.Name, r.next+1, rr.Verb, requestURI(rr.URI), req.Method, req.URL.RequestURI())
+		}
+		r.used[r.next] = true
+		r.next++
+		found = rr
+	default:
+		for i, rr := range r.cassette.Interactions {
+			if !r.used[i] && matches(rr, req) {
+				r.used[i] = true
+				found = rr
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("cassette %s: no recorded interaction matches %s %s",
+				r.cassette.Name, req.Method, req.URL.RequestURI())
+		}
+	}
+	return newResponse(found, req), nil
+}
+
+// DoHidden is equivalent to Do.
+func (r *Replayer) DoHidden(req *http.Request) (*http.Response, error) {
+	return r.Do(req)
+}
+
+// matches returns true if the recorded interaction has the same method, path and query string as
+// the request. The scheme and host are ignored so that cassettes can be replayed against any host.
+func matches(rr *recording.RequestResponse, req *http.Request) bool {
+	if rr.Verb != req.Method {
+		return false
+	}
+	u, err := url.Parse(rr.URI)
+	if err != nil {
+		return false
+	}
+	return u.Path == req.URL.Path && u.Query().Encode() == req.URL.Query().Encode()
+}
+
+// requestURI returns the path and query string of the given URI.
+func requestURI(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	return u.RequestURI()
+}
+
+// newResponse builds the response to the given request from a recorded interaction.
+func newResponse(rr *recording.RequestResponse, req *http.Request) *http.Response {
+	header := make(http.Header, len(rr.RespHeader))
+	for k, v := range rr.RespHeader {
+		header[k] = append([]string(nil), v...)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rr.Status, http.StatusText(rr.Status)),
+		StatusCode:    rr.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(rr.RespBody)),
+		ContentLength: int64(len(rr.RespBody)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,183 @@
+package httpclient_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/rightscale/rsc/cm15"
+	"github.com/rightscale/rsc/httpclient"
+	"github.com/rightscale/rsc/recording"
+	"github.com/rightscale/rsc/rsapi"
+	"github.com/rightscale/rsc/rsctest"
+)
+
+var _ = Describe("VCR", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "rsc_vcr_test")
+		Ω(err).ShouldNot(HaveOccurred())
+		path = filepath.Join(dir, "cassettes", "servers.json")
+	})
+
+	AfterEach(func() {
+		httpclient.Middleware = nil
+		os.RemoveAll(dir)
+	})
+
+	Context("recording", func() {
+		var server *ghttp.Server
+
+		BeforeEach(func() {
+			httpclient.NoCertCheck = true
+			server = ghttp.NewTLSServer()
+			server.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/api/oauth2"),
+				ghttp.RespondWith(200, `{"access_token":"secret","expires_in":7200}`, http.Header{
+					"Set-Cookie":     {"rs_gbl=secret"},
+					"X-Request-Uuid": {"abc"},
+				}),
+			))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("records scrubbed interactions", func() {
+			rec := httpclient.NewRecorder(path)
+			client := rec.Wrap(httpclient.New())
+			req, err := http.NewRequest("POST", server.URL()+"/api/oauth2",
+				strings.NewReader(`{"grant_type":"refresh_token","refresh_token":"secret"}`))
+			Ω(err).ShouldNot(HaveOccurred())
+			req.Header.Set("Authorization", "Bearer secret")
+			resp, err := client.DoHidden(req)
+			Ω(err).ShouldNot(HaveOccurred())
+			body, err := ioutil.ReadAll(resp.Body)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(body)).Should(ContainSubstring(`"access_token":"secret"`))
+
+			Ω(rec.Save()).Should(Succeed())
+			c, err := httpclient.LoadCassette(path)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(c.Name).Should(Equal("servers"))
+			Ω(c.Interactions).Should(HaveLen(1))
+			rr := c.Interactions[0]
+			Ω(rr.Verb).Should(Equal("POST"))
+			Ω(rr.Status).Should(Equal(200))
+			Ω(rr.ReqHeader.Get("Authorization")).Should(Equal(httpclient.ScrubbedValue))
+			Ω(rr.ReqHeader.Get("User-Agent")).Should(BeEmpty())
+			Ω(rr.ReqBody).ShouldNot(ContainSubstring("secret"))
+			Ω(rr.RespHeader.Get("Set-Cookie")).Should(Equal(httpclient.ScrubbedValue))
+			Ω(rr.RespHeader.Get("X-Request-Uuid")).Should(BeEmpty())
+			Ω(rr.RespBody).ShouldNot(ContainSubstring("secret"))
+		})
+
+		It("scrubs nested fields", func() {
+			rec := httpclient.NewRecorder(path)
+			body := rec.ScrubBody([]byte(`{"user":{"password":"secret"},"tokens":[{"access_token":"secret"}]}`))
+			Ω(body).ShouldNot(ContainSubstring("secret"))
+			Ω(body).Should(ContainSubstring(httpclient.ScrubbedValue))
+			Ω(rec.ScrubBody([]byte("not json"))).Should(Equal("not json"))
+		})
+	})
+
+	Context("replaying", func() {
+		var cassette *httpclient.Cassette
+
+		BeforeEach(func() {
+			cassette = &httpclient.Cassette{
+				Name: "test",
+				Interactions: []*recording.RequestResponse{
+					{Verb: "GET", URI: "https://us-3.rightscale.com/api/clouds?view=default",
+						Status: 200, RespBody: "clouds"},
+					{Verb: "GET", URI: "https://us-3.rightscale.com/api/servers",
+						Status: 404, RespBody: "servers"},
+				},
+			}
+		})
+
+		get := func(client httpclient.HTTPClient, uri string) (string, error) {
+			req, err := http.NewRequest("GET", "http://localhost"+uri, nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			resp, err := client.Do(req)
+			if err != nil {
+				return "", err
+			}
+			b, err := ioutil.ReadAll(resp.Body)
+			Ω(err).ShouldNot(HaveOccurred())
+			return string(b), nil
+		}
+
+		It("serves interactions in order", func() {
+			r := httpclient.NewCassetteReplayer(cassette, httpclient.ReplayInOrder)
+			_, err := get(r, "/api/servers")
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("interaction 1 is GET /api/clouds?view=default"))
+			Ω(get(r, "/api/clouds?view=default")).Should(Equal("clouds"))
+			Ω(get(r, "/api/servers")).Should(Equal("servers"))
+			_, err = get(r, "/api/servers")
+			Ω(err).Should(HaveOccurred())
+			Ω(r.Remaining()).Should(Equal(0))
+		})
+
+		It("serves matching interactions", func() {
+			r := httpclient.NewCassetteReplayer(cassette, httpclient.ReplayMatching)
+			Ω(get(r, "/api/servers")).Should(Equal("servers"))
+			_, err := get(r, "/api/clouds")
+			Ω(err).Should(HaveOccurred())
+			Ω(get(r, "/api/clouds?view=default")).Should(Equal("clouds"))
+		})
+	})
+
+	Context("with a client and authenticator", func() {
+		var fake *rsctest.Server
+
+		BeforeEach(func() {
+			httpclient.Insecure = true
+			fake = rsctest.NewServer()
+			fake.AddServer("web", "")
+		})
+
+		AfterEach(func() {
+			httpclient.Insecure = false
+			fake.Close()
+		})
+
+		listServers := func() ([]*cm15.Server, error) {
+			auth := rsapi.NewOAuthAuthenticator(fake.RefreshToken, 1)
+			client := cm15.New(fake.Host(), auth)
+			return client.ServerLocator("/api/servers").Index(nil)
+		}
+
+		It("records and replays authentication handshakes", func() {
+			rec := httpclient.NewRecorder(path)
+			httpclient.Middleware = rec.Wrap
+			servers, err := listServers()
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(servers).Should(HaveLen(1))
+			Ω(rec.Interactions()).Should(HaveLen(2))
+			Ω(rec.Save()).Should(Succeed())
+
+			fake.Close()
+			r, err := httpclient.NewReplayer(path, httpclient.ReplayInOrder)
+			Ω(err).ShouldNot(HaveOccurred())
+			httpclient.Middleware = r.Wrap
+			servers, err = listServers()
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(servers).Should(HaveLen(1))
+			Ω(servers[0].Name).Should(Equal("web"))
+			Ω(r.Remaining()).Should(Equal(0))
+		})
+	})
+})
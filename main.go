@@ -36,6 +36,12 @@ func main() {
 	switch topCommand {
 	case "setup":
 		err = CreateConfig(cmdLine.ConfigPath)
+	case "diff":
+		resp, err = runDiff(cmdLine)
+	case "plugin":
+		err = runPlugin(cmdLine)
+	case "alias":
+		err = runAlias(cmdLine)
 	case "json":
 		var b []byte
 		b, err = ioutil.ReadAll(os.Stdin)
@@ -49,7 +55,11 @@ func main() {
 		var client cmd.CommandClient
 		client, err = APIClient(topCommand, cmdLine)
 		if err == nil {
-			resp, err = runCommand(client, cmdLine)
+			if cmdLine.Watch > 0 {
+				err = watch(client, cmdLine)
+			} else {
+				resp, err = runCommand(client, cmdLine)
+			}
 		}
 	}
 
@@ -60,6 +70,14 @@ func main() {
 	if resp == nil {
 		return // No results, just exit (e.g. setup, printed help...)
 	}
+	if cmdLine.Output != "" && resp.StatusCode > 199 && resp.StatusCode < 300 {
+		path, err := saveOutput(resp, cmdLine.Output)
+		if err != nil {
+			PrintFatal(err.Error())
+		}
+		fmt.Fprintf(errOut, "%s written to %s\n", resp.Header.Get("Content-Type"), path)
+		return
+	}
 
 	var notExactlyOneError bool
 	displayer, err := NewDisplayer(resp)
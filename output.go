@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Preferred file extensions for content types returned by the RightScale APIs, other content
+// types use the first extension returned by mime.ExtensionsByType.
+var contentTypeExtensions = map[string]string{
+	"application/json": ".json",
+	"text/plain":       ".txt",
+	"text/csv":         ".csv",
+	"text/html":        ".html",
+	"text/xml":         ".xml",
+	"application/xml":  ".xml",
+}
+
+// saveOutput streams the response body to the file with the given path without loading it in
+// memory. If the path has no extension then the extension corresponding to the response content
+// type is appended to it. saveOutput returns the path of the file that was written.
+func saveOutput(resp *http.Response, path string) (string, error) {
+	defer resp.Body.Close()
+	if filepath.Ext(path) == "" {
+		path += contentTypeExtension(resp.Header.Get("Content-Type"))
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("Failed to create output file: %s", err)
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		return "", fmt.Errorf("Failed to write output file: %s", err)
+	}
+	return path, nil
+}
+
+// contentTypeExtension returns the file extension (including the leading dot) used for the given
+// content type, the empty string if there is none.
+func contentTypeExtension(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	if ext, ok := contentTypeExtensions[mediaType]; ok {
+		return ext
+	}
+	if strings.HasSuffix(mediaType, "+json") {
+		return ".json" // e.g. application/vnd.rightscale.audit_entry+json
+	}
+	if exts, _ := mime.ExtensionsByType(mediaType); len(exts) > 0 {
+		return exts[0]
+	}
+	return ""
+}
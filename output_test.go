@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Output", func() {
+	var (
+		dir, path   string
+		contentType string
+		written     string
+		err         error
+	)
+
+	BeforeEach(func() {
+		dir, _ = ioutil.TempDir("", "rsc_output")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	JustBeforeEach(func() {
+		resp := &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{"Content-Type": []string{contentType}},
+			Body:       ioutil.NopCloser(bytes.NewBufferString("audit entry detail")),
+		}
+		written, err = saveOutput(resp, path)
+	})
+
+	Context("with a path without extension", func() {
+		BeforeEach(func() {
+			path = filepath.Join(dir, "detail")
+			contentType = "text/plain; charset=utf-8"
+		})
+
+		It("appends the extension matching the content type", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(written).Should(Equal(path + ".txt"))
+			Ω(ioutil.ReadFile(written)).Should(Equal([]byte("audit entry detail")))
+		})
+	})
+
+	Context("with a path with an extension", func() {
+		BeforeEach(func() {
+			path = filepath.Join(dir, "detail.log")
+			contentType = "application/vnd.rightscale.audit_entry+json"
+		})
+
+		It("writes the body to the file", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(written).Should(Equal(path))
+			Ω(ioutil.ReadFile(written)).Should(Equal([]byte("audit entry detail")))
+		})
+	})
+
+	Context("with a path to a missing directory", func() {
+		BeforeEach(func() {
+			path = filepath.Join(dir, "missing", "detail")
+			contentType = "text/plain"
+		})
+
+		It("fails", func() {
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+
+	"github.com/rightscale/rsc/cmd"
+	"github.com/rightscale/rsc/rsapi"
+)
+
+// runPlugin runs the plugin executable rsc-NAME found in PATH. The plugin inherits the standard
+// input, output and error streams and receives the resolved host, account, credentials and dump
+// settings via environment variables (see rsapi.FromEnv).
+// runPlugin exits the process with the plugin exit code if it is not 0.
+func runPlugin(cmdLine *cmd.CommandLine) error {
+	name := PluginPrefix + cmdLine.PluginName
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return fmt.Errorf("Unknown command '%s' and no %s executable found in PATH", cmdLine.PluginName, name)
+	}
+	env, err := rsapi.PluginEnv(cmdLine)
+	if err != nil {
+		return fmt.Errorf("Failed to authenticate for plugin %s: %s", name, err)
+	}
+	plugin := exec.Command(path, cmdLine.PluginArgs...)
+	plugin.Env = append(os.Environ(), env...)
+	plugin.Stdin = in
+	plugin.Stdout = out
+	plugin.Stderr = errOut
+	err = plugin.Run()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+			osExit(status.ExitStatus())
+			return nil
+		}
+	}
+	return err
+}
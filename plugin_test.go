@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rightscale/rsc/cmd"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+var _ = Describe("Plugins", func() {
+	var (
+		dir     string
+		oldPath string
+	)
+
+	BeforeEach(func() {
+		dir, _ = ioutil.TempDir("", "rsc_plugin")
+		script := "#!/bin/sh\necho \"$RSC_HOST $RSC_ACCOUNT $RSC_DUMP $@\"\nexit 3\n"
+		ioutil.WriteFile(filepath.Join(dir, "rsc-hello"), []byte(script), 0755)
+		oldPath = os.Getenv("PATH")
+		os.Setenv("PATH", dir+string(os.PathListSeparator)+oldPath)
+	})
+
+	AfterEach(func() {
+		os.Setenv("PATH", oldPath)
+		os.RemoveAll(dir)
+	})
+
+	Context("parsing a command line", func() {
+		var (
+			args    []string
+			cmdLine *cmd.CommandLine
+			err     error
+		)
+
+		JustBeforeEach(func() {
+			os.Args = append([]string{"rsc"}, args...)
+			cmdLine, err = ParseCommandLine(kingpin.New("test", "test"))
+		})
+
+		Context("with a plugin command", func() {
+			BeforeEach(func() {
+				args = []string{"--noAuth", "--host", "h", "--dump=debug", "hello", "--name", "x", "--help"}
+			})
+
+			It("delegates to the plugin", func() {
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(cmdLine.Command).Should(Equal("plugin"))
+				Ω(cmdLine.PluginName).Should(Equal("hello"))
+				Ω(cmdLine.PluginArgs).Should(Equal([]string{"--name", "x", "--help"}))
+				Ω(cmdLine.Host).Should(Equal("h"))
+				Ω(cmdLine.ShowHelp).Should(BeFalse())
+			})
+		})
+
+		Context("with an unknown command", func() {
+			BeforeEach(func() {
+				args = []string{"--noAuth", "--host", "h", "goodbye"}
+			})
+
+			It("fails", func() {
+				Ω(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("running a plugin", func() {
+		var (
+			stdout   bytes.Buffer
+			exitCode int
+			err      error
+		)
+
+		BeforeEach(func() {
+			stdout.Reset()
+			SetOutput(&stdout)
+			exitCode = 0
+			osExit = func(code int) { exitCode = code }
+			cmdLine := cmd.CommandLine{
+				Command:    "plugin",
+				Host:       "us-3.rightscale.com",
+				Account:    42,
+				Dump:       "json",
+				NoAuth:     true,
+				PluginName: "hello",
+				PluginArgs: []string{"a", "b"},
+			}
+			err = runPlugin(&cmdLine)
+		})
+
+		It("passes the settings and arguments to the plugin", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(stdout.String()).Should(Equal("us-3.rightscale.com 42 json a b\n"))
+		})
+
+		It("exits with the plugin exit code", func() {
+			Ω(exitCode).Should(Equal(3))
+		})
+	})
+})
@@ -1,3 +1,12 @@
+// The recorder tool runs "rsc" with the given arguments and appends a recording of the invocation
+// to recording_new.json. The recording includes all the requests made by rsc in order. Use the
+// --record-auth flag to also record the authentication requests, they are flagged as hidden and
+// skipped on replay.
+//
+// "recorder verify [FILE]" checks the requests of all the recordings in FILE (recording.json by
+// default) against the API metadata of the generated clients and lists the requests that match no
+// known action or that use unknown or invalid parameters or miss mandatory ones. Run it after
+// regenerating the clients to find the recorded tests that became invalid.
 package main
 
 import (
@@ -13,18 +22,49 @@ import (
 	"strings"
 	"syscall"
 
+	"github.com/rightscale/rsc/ca"
+	"github.com/rightscale/rsc/cm15"
+	"github.com/rightscale/rsc/cm16"
+	"github.com/rightscale/rsc/httpclient"
 	"github.com/rightscale/rsc/recording"
+	"github.com/rightscale/rsc/rl10"
+	"github.com/rightscale/rsc/rsapi"
+	"github.com/rightscale/rsc/ss"
 )
 
 const output = "recording_new.json"
 
+// apiMetadata lists the metadata used to verify recordings indexed by API command name.
+var apiMetadata = map[string]rsapi.APIMetadata{
+	"ca":   ca.GenMetadata,
+	"cm15": cm15.GenMetadata,
+	"cm16": cm16.GenMetadata,
+	"rl10": rl10.GenMetadata,
+	"ss":   ss.GenMetadata,
+}
+
 var exitRegexp = regexp.MustCompile(`exit status (\d+)`)
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "verify" {
+		path := "recording.json"
+		if len(os.Args) > 2 {
+			path = os.Args[2]
+		}
+		os.Exit(verify(path))
+	}
+
 	// Massage the command line args
 	args := []string{"--dump=record"}
-	args = append(args, os.Args[1:]...)
+	var recordAuth bool
+	recordAuth, args = extractFlag("--record-auth", append(args, os.Args[1:]...))
+	if recordAuth {
+		// Hidden authentication requests only get dumped in verbose mode
+		args = append([]string{"--verbose"}, args...)
+	}
 	cmd := exec.Command("../rsc", args...)
+	_, args = extractFlag("--verbose", args)
+	_, args = extractFlag("--noAuth", args)
 	_, args = extractArg("--dump", args)
 	_, args = extractArg("--host", args)
 	_, args = extractArg("--key", args)
@@ -70,25 +110,41 @@ func main() {
 	}
 	fmt.Fprintf(os.Stderr, "Exit code: %#v\n", exitCode)
 
-	// Parse recording output
-	var rr recording.RequestResponse
-	err = json.Unmarshal(*bufPtr, &rr)
-	if err != nil {
-		fail("load rsc dump: %s - dump was:\n%s\noutput was:\n%s\n",
-			err, string(*bufPtr), out.String())
+	// Parse recording output, there is one dump per request
+	// Secrets are scrubbed the same way as in cassettes recorded with httpclient.Recorder
+	scrubber := httpclient.NewRecorder(output)
+	var rrs []recording.RequestResponse
+	decoder := json.NewDecoder(bytes.NewReader(*bufPtr))
+	for {
+		var rr recording.RequestResponse
+		err = decoder.Decode(&rr)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fail("load rsc dump: %s - dump was:\n%s\noutput was:\n%s\n",
+				err, string(*bufPtr), out.String())
+		}
+		rr.ReqHeader.Del("Authorization")
+		rr.ReqHeader.Del("Cookie")
+		rr.ReqHeader.Del("User-Agent")
+		rr.RespHeader.Del("Cache-Control")
+		rr.RespHeader.Del("Connection")
+		rr.RespHeader.Del("Set-Cookie")
+		rr.RespHeader.Del("Strict-Transport-Security")
+		rr.RespHeader.Del("X-Request-Uuid")
+		rr.ReqBody = scrubber.ScrubBody([]byte(rr.ReqBody))
+		rr.RespBody = scrubber.ScrubBody([]byte(rr.RespBody))
+		rrs = append(rrs, rr)
+	}
+	if len(rrs) == 0 {
+		fail("rsc did not dump any request - output was:\n%s\n", out.String())
 	}
-	rr.ReqHeader.Del("Authorization")
-	rr.ReqHeader.Del("User-Agent")
-	rr.RespHeader.Del("Cache-Control")
-	rr.RespHeader.Del("Connection")
-	rr.RespHeader.Del("Set-Cookie")
-	rr.RespHeader.Del("Strict-Transport-Security")
-	rr.RespHeader.Del("X-Request-Uuid")
 	record := recording.Recording{
 		CmdArgs:  args,
 		ExitCode: exitCode,
 		Stdout:   out.String(),
-		RR:       rr,
+		RRs:      rrs,
 	}
 	js, err := json.MarshalIndent(record, "", "    ")
 	if err != nil {
@@ -100,6 +156,57 @@ func main() {
 	os.Exit(exitCode)
 }
 
+// Verify the requests of all the recordings in the given file against the API metadata, print the
+// violations and return the process exit code
+func verify(path string) int {
+	f, err := os.Open(path)
+	if err != nil {
+		fail("failed to open recordings: %s", err)
+	}
+	defer f.Close()
+	decoder := json.NewDecoder(f)
+	var count, invalid int
+	for {
+		var rec recording.Recording
+		err := decoder.Decode(&rec)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fail("failed to load recording %d: %s", count+1, err)
+		}
+		count++
+		var md rsapi.APIMetadata
+		for _, a := range rec.CmdArgs {
+			if m, ok := apiMetadata[a]; ok {
+				md = m
+				break
+			}
+		}
+		if md == nil {
+			fmt.Printf("recording %d: %s\n  - could not determine API\n", count,
+				strings.Join(rec.CmdArgs, " "))
+			invalid++
+			continue
+		}
+		api := rsapi.API{Metadata: md}
+		errs := api.VerifyRecording(&rec)
+		if len(errs) == 0 {
+			continue
+		}
+		invalid++
+		fmt.Printf("recording %d: %s\n", count, strings.Join(rec.CmdArgs, " "))
+		for _, err := range errs {
+			fmt.Printf("  %s\n", strings.Replace(err.Error(), "\n", "\n  ", -1))
+		}
+	}
+	fmt.Printf("%d recordings verified, %d invalid\n", count, invalid)
+	if invalid > 0 {
+		return 1
+	}
+	return 0
+}
+
 // Read file asynchronously
 func readAllAsync(f io.ReadCloser) (*[]byte, chan struct{}) {
 	done := make(chan struct{}, 1) // signal that the read is done
@@ -143,6 +250,21 @@ func extractArg(name string, args []string) (string, []string) {
 	return val, newArgs
 }
 
+// Extract boolean command line flag with given name and return whether it was present and the
+// remaining arguments
+func extractFlag(name string, args []string) (bool, []string) {
+	var found bool
+	var newArgs []string
+	for _, a := range args {
+		if a == name {
+			found = true
+		} else {
+			newArgs = append(newArgs, a)
+		}
+	}
+	return found, newArgs
+}
+
 // Helper function that appends a string to output file
 func write(b []byte) {
 	f, err := os.OpenFile(output, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0644)
@@ -6,10 +6,11 @@ import "net/http"
 
 // Recording represents a single "rsc" invokation recording.
 type Recording struct {
-	CmdArgs  []string        // command line arguments
-	ExitCode int             // Exit code
-	Stdout   string          // Exit print
-	RR       RequestResponse // back-end request/response
+	CmdArgs  []string          // command line arguments
+	ExitCode int               // Exit code
+	Stdout   string            // Exit print
+	RRs      []RequestResponse `json:",omitempty"` // back-end requests/responses in order
+	RR       *RequestResponse  `json:",omitempty"` // single back-end request/response (old format)
 }
 
 // RequestResponse contains an HTTP Request and the corresponding response details.
@@ -20,4 +21,15 @@ type RequestResponse struct {
 	Status     int         // numerical response status
 	RespHeader http.Header // full response headers
 	RespBody   string      // not []byte so that json.Marshal doesn't produce base64
+	Hidden     bool        `json:",omitempty"` // whether request was made for authentication
+}
+
+// Exchanges returns the recorded requests/responses in order. It supports both recordings that
+// list all the exchanges in RRs and recordings made with the old format that only record one
+// exchange in RR.
+func (r *Recording) Exchanges() []RequestResponse {
+	if len(r.RRs) > 0 || r.RR == nil {
+		return r.RRs
+	}
+	return []RequestResponse{*r.RR}
 }
@@ -18,7 +18,13 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
+	"github.com/rightscale/rsc/ca"
+	"github.com/rightscale/rsc/cm15"
+	"github.com/rightscale/rsc/cm16"
 	"github.com/rightscale/rsc/recording"
+	"github.com/rightscale/rsc/rl10"
+	"github.com/rightscale/rsc/rsapi"
+	"github.com/rightscale/rsc/ss"
 )
 
 // Iterate through all recorded test cases and play them back
@@ -46,29 +52,15 @@ var _ = Describe("Recorded request", func() {
 			server := ghttp.NewServer()
 			defer server.Close()
 
-			// construct list of verifiers
-			url := regexp.MustCompile(`https?://[^/]+(/[^?]+)\??(.*)`).
-				FindStringSubmatch(testCase.RR.URI)
-			//fmt.Fprintf(os.Stderr, "URL: %#v\n", url)
-			handlers := []http.HandlerFunc{
-				ghttp.VerifyRequest(testCase.RR.Verb, url[1], url[2]),
+			// construct list of verifiers, authentication requests are not made on replay
+			var expected int
+			for _, rr := range testCase.Exchanges() {
+				if rr.Hidden {
+					continue
+				}
+				server.AppendHandlers(exchangeHandler(rr))
+				expected++
 			}
-			if len(testCase.RR.ReqBody) > 0 {
-				handlers = append(handlers,
-					ghttp.VerifyJSON(testCase.RR.ReqBody))
-			}
-			for k := range testCase.RR.ReqHeader {
-				handlers = append(handlers,
-					ghttp.VerifyHeaderKV(k, testCase.RR.ReqHeader.Get(k)))
-			}
-			respHeader := make(http.Header)
-			for k, v := range testCase.RR.RespHeader {
-				respHeader[k] = v
-			}
-			handlers = append(handlers,
-				ghttp.RespondWith(testCase.RR.Status, testCase.RR.RespBody,
-					respHeader))
-			server.AppendHandlers(ghttp.CombineHandlers(handlers...))
 
 			os.Args = append([]string{
 				"rsc", "--noAuth", "--dump", "debug",
@@ -91,7 +83,56 @@ var _ = Describe("Recorded request", func() {
 			//stdoutBuf.String(), testCase.Stdout)
 			Ω(exitCode).Should(Equal(testCase.ExitCode), "Exit code doesn't match")
 			Ω(stdoutBuf.String()).Should(Equal(testCase.Stdout), "Stdout doesn't match")
+			Ω(server.ReceivedRequests()).Should(HaveLen(expected), "Number of requests doesn't match")
+		})
+
+		// Check that the recorded requests are still valid for the current API metadata
+		It(strings.Join(testCase.CmdArgs, " ")+" conforms to API metadata", func() {
+			verifyRecording(&testCase)
 		})
 	}
 
 })
+
+// exchangeHandler returns a handler that verifies that the request matches the recorded one and
+// responds with the recorded response.
+func exchangeHandler(rr recording.RequestResponse) http.HandlerFunc {
+	url := regexp.MustCompile(`https?://[^/]+(/[^?]+)\??(.*)`).FindStringSubmatch(rr.URI)
+	handlers := []http.HandlerFunc{
+		ghttp.VerifyRequest(rr.Verb, url[1], url[2]),
+	}
+	if len(rr.ReqBody) > 0 {
+		handlers = append(handlers, ghttp.VerifyJSON(rr.ReqBody))
+	}
+	for k := range rr.ReqHeader {
+		handlers = append(handlers, ghttp.VerifyHeaderKV(k, rr.ReqHeader.Get(k)))
+	}
+	respHeader := make(http.Header)
+	for k, v := range rr.RespHeader {
+		respHeader[k] = v
+	}
+	handlers = append(handlers, ghttp.RespondWith(rr.Status, rr.RespBody, respHeader))
+	return ghttp.CombineHandlers(handlers...)
+}
+
+// verifyRecording checks that the requests of the given recording conform to the metadata of the
+// API targeted by the recorded command line.
+func verifyRecording(rec *recording.Recording) {
+	apis := map[string]rsapi.APIMetadata{
+		CaCommand:   ca.GenMetadata,
+		Cm15Command: cm15.GenMetadata,
+		Cm16Command: cm16.GenMetadata,
+		Rl10Command: rl10.GenMetadata,
+		SsCommand:   ss.GenMetadata,
+	}
+	var md rsapi.APIMetadata
+	for _, a := range rec.CmdArgs {
+		if m, ok := apis[a]; ok {
+			md = m
+			break
+		}
+	}
+	Ω(md).ShouldNot(BeNil(), "Recording does not target a known API")
+	api := rsapi.API{Metadata: md}
+	Ω(api.VerifyRecording(rec)).Should(BeEmpty())
+}
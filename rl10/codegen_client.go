@@ -11,6 +11,7 @@ package rl10
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	"github.com/rightscale/rsc/metadata"
@@ -72,6 +73,16 @@ func (api *API) DebugCookbookPathLocator(href string) *DebugCookbookPathLocator
 	return &DebugCookbookPathLocator{Href(href), api}
 }
 
+// DebugCookbookPathLocatorInterface lists the DebugCookbookPathLocator methods, code that depends on the interface
+// rather than on DebugCookbookPathLocator can be unit tested using the generated fake locators.
+type DebugCookbookPathLocatorInterface interface {
+	Show() (string, error)
+	ShowReader() (io.ReadCloser, error)
+	Update(path string) (string, error)
+	UpdateReader(path string) (io.ReadCloser, error)
+	Delete() error
+}
+
 //===== Actions
 
 // GET /rll/debug/cookbook
@@ -81,6 +92,9 @@ func (loc *DebugCookbookPathLocator) Show() (string, error) {
 	var res string
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("DebugCookbookPath", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("DebugCookbookPath", "show")
 	if err != nil {
 		return res, err
@@ -93,14 +107,8 @@ func (loc *DebugCookbookPathLocator) Show() (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -111,6 +119,32 @@ func (loc *DebugCookbookPathLocator) Show() (string, error) {
 	return res, err
 }
 
+// ShowReader is identical to Show except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *DebugCookbookPathLocator) ShowReader() (io.ReadCloser, error) {
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("DebugCookbookPath", "show", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("DebugCookbookPath", "show")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 // PUT /rll/debug/cookbook
 //
 // Set debug cookbook directory location
@@ -124,6 +158,9 @@ func (loc *DebugCookbookPathLocator) Update(path string) (string, error) {
 		"path": path,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("DebugCookbookPath", "update", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("DebugCookbookPath", "update")
 	if err != nil {
 		return res, err
@@ -136,14 +173,8 @@ func (loc *DebugCookbookPathLocator) Update(path string) (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -154,12 +185,47 @@ func (loc *DebugCookbookPathLocator) Update(path string) (string, error) {
 	return res, err
 }
 
+// UpdateReader is identical to Update except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *DebugCookbookPathLocator) UpdateReader(path string) (io.ReadCloser, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path is required")
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{
+		"path": path,
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("DebugCookbookPath", "update", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("DebugCookbookPath", "update")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 // DELETE /rll/debug/cookbook
 //
 // Remove debug cookbook directory location
 func (loc *DebugCookbookPathLocator) Delete() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("DebugCookbookPath", "delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("DebugCookbookPath", "delete")
 	if err != nil {
 		return err
@@ -172,15 +238,10 @@ func (loc *DebugCookbookPathLocator) Delete() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -203,6 +264,18 @@ func (api *API) EnvLocator(href string) *EnvLocator {
 	return &EnvLocator{Href(href), api}
 }
 
+// EnvLocatorInterface lists the EnvLocator methods, code that depends on the interface
+// rather than on EnvLocator can be unit tested using the generated fake locators.
+type EnvLocatorInterface interface {
+	Index() (string, error)
+	IndexReader() (io.ReadCloser, error)
+	Show() (string, error)
+	ShowReader() (io.ReadCloser, error)
+	Update(payload string) (string, error)
+	UpdateReader(payload string) (io.ReadCloser, error)
+	Delete() error
+}
+
 //===== Actions
 
 // GET /rll/env
@@ -212,6 +285,9 @@ func (loc *EnvLocator) Index() (string, error) {
 	var res string
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Env", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Env", "index")
 	if err != nil {
 		return res, err
@@ -224,14 +300,8 @@ func (loc *EnvLocator) Index() (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -242,6 +312,32 @@ func (loc *EnvLocator) Index() (string, error) {
 	return res, err
 }
 
+// IndexReader is identical to Index except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *EnvLocator) IndexReader() (io.ReadCloser, error) {
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Env", "index", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Env", "index")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 // GET /rll/env/:name
 //
 // Retrieve environment variable value
@@ -249,6 +345,9 @@ func (loc *EnvLocator) Show() (string, error) {
 	var res string
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Env", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Env", "show")
 	if err != nil {
 		return res, err
@@ -261,14 +360,8 @@ func (loc *EnvLocator) Show() (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -279,6 +372,32 @@ func (loc *EnvLocator) Show() (string, error) {
 	return res, err
 }
 
+// ShowReader is identical to Show except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *EnvLocator) ShowReader() (io.ReadCloser, error) {
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Env", "show", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Env", "show")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 // PUT /rll/env/:name
 //
 // Set environment variable value
@@ -292,6 +411,9 @@ func (loc *EnvLocator) Update(payload string) (string, error) {
 	p = rsapi.APIParams{
 		"payload": payload,
 	}
+	if err := loc.api.ValidateParams("Env", "update", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Env", "update")
 	if err != nil {
 		return res, err
@@ -304,14 +426,8 @@ func (loc *EnvLocator) Update(payload string) (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -322,12 +438,47 @@ func (loc *EnvLocator) Update(payload string) (string, error) {
 	return res, err
 }
 
+// UpdateReader is identical to Update except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *EnvLocator) UpdateReader(payload string) (io.ReadCloser, error) {
+	if payload == "" {
+		return nil, fmt.Errorf("payload is required")
+	}
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	p = rsapi.APIParams{
+		"payload": payload,
+	}
+	if err := loc.api.ValidateParams("Env", "update", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Env", "update")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 // DELETE /rll/env/:name
 //
 // Delete environment variable
 func (loc *EnvLocator) Delete() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Env", "delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Env", "delete")
 	if err != nil {
 		return err
@@ -340,15 +491,10 @@ func (loc *EnvLocator) Delete() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -371,6 +517,15 @@ func (api *API) ProcLocator(href string) *ProcLocator {
 	return &ProcLocator{Href(href), api}
 }
 
+// ProcLocatorInterface lists the ProcLocator methods, code that depends on the interface
+// rather than on ProcLocator can be unit tested using the generated fake locators.
+type ProcLocatorInterface interface {
+	Index() (string, error)
+	IndexReader() (io.ReadCloser, error)
+	Show() (string, error)
+	ShowReader() (io.ReadCloser, error)
+}
+
 //===== Actions
 
 // GET /rll/proc
@@ -380,6 +535,9 @@ func (loc *ProcLocator) Index() (string, error) {
 	var res string
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Proc", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Proc", "index")
 	if err != nil {
 		return res, err
@@ -392,14 +550,8 @@ func (loc *ProcLocator) Index() (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -410,6 +562,32 @@ func (loc *ProcLocator) Index() (string, error) {
 	return res, err
 }
 
+// IndexReader is identical to Index except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *ProcLocator) IndexReader() (io.ReadCloser, error) {
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Proc", "index", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Proc", "index")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 // GET /rll/proc/:name
 //
 // Retrieve process variable value
@@ -417,6 +595,9 @@ func (loc *ProcLocator) Show() (string, error) {
 	var res string
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Proc", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Proc", "show")
 	if err != nil {
 		return res, err
@@ -429,14 +610,8 @@ func (loc *ProcLocator) Show() (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -447,6 +622,32 @@ func (loc *ProcLocator) Show() (string, error) {
 	return res, err
 }
 
+// ShowReader is identical to Show except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *ProcLocator) ShowReader() (io.ReadCloser, error) {
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Proc", "show", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Proc", "show")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 /******  Rl10 ******/
 
 // Miscellaneous RightLink 10 local requests
@@ -466,6 +667,17 @@ func (api *API) Rl10Locator(href string) *Rl10Locator {
 	return &Rl10Locator{Href(href), api}
 }
 
+// Rl10LocatorInterface lists the Rl10Locator methods, code that depends on the interface
+// rather than on Rl10Locator can be unit tested using the generated fake locators.
+type Rl10LocatorInterface interface {
+	Upgrade(exec string) (string, error)
+	UpgradeReader(exec string) (io.ReadCloser, error)
+	RunRecipe(recipe string, options *Rl10RunRecipeOptions) (string, error)
+	RunRecipeReader(recipe string, options *Rl10RunRecipeOptions) (io.ReadCloser, error)
+	RunRightScript(options *Rl10RunRightScriptOptions) (string, error)
+	RunRightScriptReader(options *Rl10RunRightScriptOptions) (io.ReadCloser, error)
+}
+
 //===== Actions
 
 // POST /rll/upgrade
@@ -481,6 +693,9 @@ func (loc *Rl10Locator) Upgrade(exec string) (string, error) {
 		"exec": exec,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Rl10", "upgrade", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Rl10", "upgrade")
 	if err != nil {
 		return res, err
@@ -493,14 +708,8 @@ func (loc *Rl10Locator) Upgrade(exec string) (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -511,27 +720,100 @@ func (loc *Rl10Locator) Upgrade(exec string) (string, error) {
 	return res, err
 }
 
+// UpgradeReader is identical to Upgrade except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *Rl10Locator) UpgradeReader(exec string) (io.ReadCloser, error) {
+	if exec == "" {
+		return nil, fmt.Errorf("exec is required")
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{
+		"exec": exec,
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Rl10", "upgrade", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Rl10", "upgrade")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
+// Rl10RunRecipeOptions contains the optional parameters of Rl10Locator.RunRecipe.
+type Rl10RunRecipeOptions struct {
+	// Script argument values
+	Arguments map[string]interface{}
+	// JSON hash of "name": "value" pairs
+	Json string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *Rl10RunRecipeOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "arguments", "json":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of Rl10Locator.RunRecipe", n)
+		}
+	}
+	if o.Arguments != nil {
+		p["arguments"] = o.Arguments
+	}
+	if o.Json != "" {
+		p["json"] = o.Json
+	}
+	return p, nil
+}
+
 // POST /rll/run/recipe
 //
 // Run git-based scripts (as recipes) synchronously
-func (loc *Rl10Locator) RunRecipe(recipe string, options rsapi.APIParams) (string, error) {
+func (loc *Rl10Locator) RunRecipe(recipe string, options *Rl10RunRecipeOptions) (string, error) {
 	var res string
 	if recipe == "" {
 		return res, fmt.Errorf("recipe is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"recipe": recipe,
 	}
-	var argumentsOpt = options["arguments"]
+	var argumentsOpt = opts["arguments"]
 	if argumentsOpt != nil {
 		params["arguments"] = argumentsOpt
 	}
-	var jsonOpt = options["json"]
+	var jsonOpt = opts["json"]
 	if jsonOpt != nil {
 		params["json"] = jsonOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Rl10", "run_recipe", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Rl10", "run_recipe")
 	if err != nil {
 		return res, err
@@ -544,14 +826,8 @@ func (loc *Rl10Locator) RunRecipe(recipe string, options rsapi.APIParams) (strin
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -562,26 +838,116 @@ func (loc *Rl10Locator) RunRecipe(recipe string, options rsapi.APIParams) (strin
 	return res, err
 }
 
+// RunRecipeReader is identical to RunRecipe except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *Rl10Locator) RunRecipeReader(recipe string, options *Rl10RunRecipeOptions) (io.ReadCloser, error) {
+	if recipe == "" {
+		return nil, fmt.Errorf("recipe is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return nil, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{
+		"recipe": recipe,
+	}
+	var argumentsOpt = opts["arguments"]
+	if argumentsOpt != nil {
+		params["arguments"] = argumentsOpt
+	}
+	var jsonOpt = opts["json"]
+	if jsonOpt != nil {
+		params["json"] = jsonOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Rl10", "run_recipe", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Rl10", "run_recipe")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
+// Rl10RunRightScriptOptions contains the optional parameters of Rl10Locator.RunRightScript.
+type Rl10RunRightScriptOptions struct {
+	// Script argument values
+	Arguments map[string]interface{}
+	// Name of script
+	RightScript string
+	// Id of script
+	RightScriptId int
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *Rl10RunRightScriptOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "arguments", "right_script", "right_script_id":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of Rl10Locator.RunRightScript", n)
+		}
+	}
+	if o.Arguments != nil {
+		p["arguments"] = o.Arguments
+	}
+	if o.RightScript != "" {
+		p["right_script"] = o.RightScript
+	}
+	if o.RightScriptId != 0 {
+		p["right_script_id"] = o.RightScriptId
+	}
+	return p, nil
+}
+
 // POST /rll/run/right_script
 //
 // Run RightScripts synchronously
-func (loc *Rl10Locator) RunRightScript(options rsapi.APIParams) (string, error) {
+func (loc *Rl10Locator) RunRightScript(options *Rl10RunRightScriptOptions) (string, error) {
 	var res string
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var argumentsOpt = options["arguments"]
+	var argumentsOpt = opts["arguments"]
 	if argumentsOpt != nil {
 		params["arguments"] = argumentsOpt
 	}
-	var rightScriptOpt = options["right_script"]
+	var rightScriptOpt = opts["right_script"]
 	if rightScriptOpt != nil {
 		params["right_script"] = rightScriptOpt
 	}
-	var rightScriptIdOpt = options["right_script_id"]
+	var rightScriptIdOpt = opts["right_script_id"]
 	if rightScriptIdOpt != nil {
 		params["right_script_id"] = rightScriptIdOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Rl10", "run_right_script", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Rl10", "run_right_script")
 	if err != nil {
 		return res, err
@@ -594,14 +960,8 @@ func (loc *Rl10Locator) RunRightScript(options rsapi.APIParams) (string, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -612,6 +972,49 @@ func (loc *Rl10Locator) RunRightScript(options rsapi.APIParams) (string, error)
 	return res, err
 }
 
+// RunRightScriptReader is identical to RunRightScript except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *Rl10Locator) RunRightScriptReader(options *Rl10RunRightScriptOptions) (io.ReadCloser, error) {
+	opts, err := options.params()
+	if err != nil {
+		return nil, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var argumentsOpt = opts["arguments"]
+	if argumentsOpt != nil {
+		params["arguments"] = argumentsOpt
+	}
+	var rightScriptOpt = opts["right_script"]
+	if rightScriptOpt != nil {
+		params["right_script"] = rightScriptOpt
+	}
+	var rightScriptIdOpt = opts["right_script_id"]
+	if rightScriptIdOpt != nil {
+		params["right_script_id"] = rightScriptIdOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Rl10", "run_right_script", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Rl10", "run_right_script")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 /******  TSS ******/
 
 // Manipulate the TSS proxy
@@ -631,29 +1034,79 @@ func (api *API) TSSLocator(href string) *TSSLocator {
 	return &TSSLocator{Href(href), api}
 }
 
+// TSSLocatorInterface lists the TSSLocator methods, code that depends on the interface
+// rather than on TSSLocator can be unit tested using the generated fake locators.
+type TSSLocatorInterface interface {
+	PutControl(options *TSSPutControlOptions) (string, error)
+	PutControlReader(options *TSSPutControlOptions) (io.ReadCloser, error)
+	GetHostname() (string, error)
+	GetHostnameReader() (io.ReadCloser, error)
+	PutHostname(hostname string) (string, error)
+	PutHostnameReader(hostname string) (io.ReadCloser, error)
+}
+
 //===== Actions
 
+// TSSPutControlOptions contains the optional parameters of TSSLocator.PutControl.
+type TSSPutControlOptions struct {
+	EnableMonitoring bool
+	TssId            string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *TSSPutControlOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "enable_monitoring", "tss_id":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of TSSLocator.PutControl", n)
+		}
+	}
+	if o.EnableMonitoring {
+		p["enable_monitoring"] = o.EnableMonitoring
+	}
+	if o.TssId != "" {
+		p["tss_id"] = o.TssId
+	}
+	return p, nil
+}
+
 // PUT /rll/tss/control
 //
 // Control the TSS monitoring
-func (loc *TSSLocator) PutControl(options rsapi.ApiParams) (string, error) {
+func (loc *TSSLocator) PutControl(options *TSSPutControlOptions) (string, error) {
 	var res string
-	var params rsapi.ApiParams
-	params = rsapi.ApiParams{}
-	var enableMonitoringOpt = options["enable_monitoring"]
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var enableMonitoringOpt = opts["enable_monitoring"]
 	if enableMonitoringOpt != nil {
 		params["enable_monitoring"] = enableMonitoringOpt
 	}
-	var tssIdOpt = options["tss_id"]
+	var tssIdOpt = opts["tss_id"]
 	if tssIdOpt != nil {
 		params["tss_id"] = tssIdOpt
 	}
-	var p rsapi.ApiParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("TSS", "put_control", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("TSS", "put_control")
 	if err != nil {
 		return res, err
 	}
-	req, err := loc.api.BuildHTTPRequest(uri.HttpMethod, uri.Path, APIVersion, params, p)
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
 	if err != nil {
 		return res, err
 	}
@@ -661,14 +1114,8 @@ func (loc *TSSLocator) PutControl(options rsapi.ApiParams) (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -679,6 +1126,45 @@ func (loc *TSSLocator) PutControl(options rsapi.ApiParams) (string, error) {
 	return res, err
 }
 
+// PutControlReader is identical to PutControl except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *TSSLocator) PutControlReader(options *TSSPutControlOptions) (io.ReadCloser, error) {
+	opts, err := options.params()
+	if err != nil {
+		return nil, err
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var enableMonitoringOpt = opts["enable_monitoring"]
+	if enableMonitoringOpt != nil {
+		params["enable_monitoring"] = enableMonitoringOpt
+	}
+	var tssIdOpt = opts["tss_id"]
+	if tssIdOpt != nil {
+		params["tss_id"] = tssIdOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("TSS", "put_control", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("TSS", "put_control")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 // GET /rll/tss/hostname
 //
 // Get the TSS hostname to proxy
@@ -686,6 +1172,9 @@ func (loc *TSSLocator) GetHostname() (string, error) {
 	var res string
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("TSS", "get_hostname", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("TSS", "get_hostname")
 	if err != nil {
 		return res, err
@@ -698,14 +1187,8 @@ func (loc *TSSLocator) GetHostname() (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -716,6 +1199,32 @@ func (loc *TSSLocator) GetHostname() (string, error) {
 	return res, err
 }
 
+// GetHostnameReader is identical to GetHostname except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *TSSLocator) GetHostnameReader() (io.ReadCloser, error) {
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("TSS", "get_hostname", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("TSS", "get_hostname")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 // PUT /rll/tss/hostname
 //
 // Set the TSS hostname to proxy
@@ -729,6 +1238,9 @@ func (loc *TSSLocator) PutHostname(hostname string) (string, error) {
 		"hostname": hostname,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("TSS", "put_hostname", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("TSS", "put_hostname")
 	if err != nil {
 		return res, err
@@ -741,14 +1253,8 @@ func (loc *TSSLocator) PutHostname(hostname string) (string, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
@@ -759,4 +1265,36 @@ func (loc *TSSLocator) PutHostname(hostname string) (string, error) {
 	return res, err
 }
 
+// PutHostnameReader is identical to PutHostname except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *TSSLocator) PutHostnameReader(hostname string) (io.ReadCloser, error) {
+	if hostname == "" {
+		return nil, fmt.Errorf("hostname is required")
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{
+		"hostname": hostname,
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("TSS", "put_hostname", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("TSS", "put_hostname")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 /****** Parameter Data Types ******/
@@ -0,0 +1,879 @@
+//************************************************************************//
+//                     RightScale API client fakes
+//
+// Generated with:
+// $ praxisgen -metadata=rl10/docs/api -output=rl10 -pkg=rl10 -target=unversioned -client=API
+//
+// The content of this file is auto-generated, DO NOT MODIFY
+//************************************************************************//
+
+// Package rl10fake provides fake implementations of the rl10 locator interfaces.
+// Each fake records the calls made to its methods and returns the values returned by the
+// corresponding stub functions if set, zero values otherwise.
+package rl10fake
+
+import (
+	"io"
+	"sync"
+
+	"github.com/rightscale/rsc/rl10"
+)
+
+/******  DebugCookbookPath ******/
+
+// DebugCookbookPathLocator is a fake implementation of rl10.DebugCookbookPathLocatorInterface.
+type DebugCookbookPathLocator struct {
+	mu sync.Mutex
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (string, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*DebugCookbookPathShowCall
+
+	// ShowReaderStub is called by ShowReader if not nil.
+	ShowReaderStub func() (io.ReadCloser, error)
+	// ShowReaderCalls records the arguments of the calls made to ShowReader.
+	ShowReaderCalls []*DebugCookbookPathShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(string) (string, error)
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*DebugCookbookPathUpdateCall
+
+	// UpdateReaderStub is called by UpdateReader if not nil.
+	UpdateReaderStub func(string) (io.ReadCloser, error)
+	// UpdateReaderCalls records the arguments of the calls made to UpdateReader.
+	UpdateReaderCalls []*DebugCookbookPathUpdateCall
+
+	// DeleteStub is called by Delete if not nil.
+	DeleteStub func() error
+	// DeleteCalls records the arguments of the calls made to Delete.
+	DeleteCalls []*DebugCookbookPathDeleteCall
+}
+
+var _ rl10.DebugCookbookPathLocatorInterface = (*DebugCookbookPathLocator)(nil)
+
+// DebugCookbookPathShowCall records the arguments of a call to DebugCookbookPathLocator.Show.
+type DebugCookbookPathShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *DebugCookbookPathLocator) Show() (string, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &DebugCookbookPathShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res string
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *DebugCookbookPathLocator) ShowReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ShowReader records the call and returns the results of ShowReaderStub if set.
+func (fake *DebugCookbookPathLocator) ShowReader() (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.ShowReaderCalls = append(fake.ShowReaderCalls, &DebugCookbookPathShowCall{})
+	stub := fake.ShowReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// ShowReaderReturns sets ShowReaderStub to a function that returns the given values.
+func (fake *DebugCookbookPathLocator) ShowReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.ShowReaderStub = func() (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// DebugCookbookPathUpdateCall records the arguments of a call to DebugCookbookPathLocator.Update.
+type DebugCookbookPathUpdateCall struct {
+	Path string
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *DebugCookbookPathLocator) Update(path string) (string, error) {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &DebugCookbookPathUpdateCall{Path: path})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(path)
+	}
+	var res string
+	return res, nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *DebugCookbookPathLocator) UpdateReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(string) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UpdateReader records the call and returns the results of UpdateReaderStub if set.
+func (fake *DebugCookbookPathLocator) UpdateReader(path string) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.UpdateReaderCalls = append(fake.UpdateReaderCalls, &DebugCookbookPathUpdateCall{Path: path})
+	stub := fake.UpdateReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(path)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// UpdateReaderReturns sets UpdateReaderStub to a function that returns the given values.
+func (fake *DebugCookbookPathLocator) UpdateReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.UpdateReaderStub = func(string) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// DebugCookbookPathDeleteCall records the arguments of a call to DebugCookbookPathLocator.Delete.
+type DebugCookbookPathDeleteCall struct{}
+
+// Delete records the call and returns the results of DeleteStub if set.
+func (fake *DebugCookbookPathLocator) Delete() error {
+	fake.mu.Lock()
+	fake.DeleteCalls = append(fake.DeleteCalls, &DebugCookbookPathDeleteCall{})
+	stub := fake.DeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DeleteReturns sets DeleteStub to a function that returns the given values.
+func (fake *DebugCookbookPathLocator) DeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.DeleteStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Env ******/
+
+// EnvLocator is a fake implementation of rl10.EnvLocatorInterface.
+type EnvLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func() (string, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*EnvIndexCall
+
+	// IndexReaderStub is called by IndexReader if not nil.
+	IndexReaderStub func() (io.ReadCloser, error)
+	// IndexReaderCalls records the arguments of the calls made to IndexReader.
+	IndexReaderCalls []*EnvIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (string, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*EnvShowCall
+
+	// ShowReaderStub is called by ShowReader if not nil.
+	ShowReaderStub func() (io.ReadCloser, error)
+	// ShowReaderCalls records the arguments of the calls made to ShowReader.
+	ShowReaderCalls []*EnvShowCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(string) (string, error)
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*EnvUpdateCall
+
+	// UpdateReaderStub is called by UpdateReader if not nil.
+	UpdateReaderStub func(string) (io.ReadCloser, error)
+	// UpdateReaderCalls records the arguments of the calls made to UpdateReader.
+	UpdateReaderCalls []*EnvUpdateCall
+
+	// DeleteStub is called by Delete if not nil.
+	DeleteStub func() error
+	// DeleteCalls records the arguments of the calls made to Delete.
+	DeleteCalls []*EnvDeleteCall
+}
+
+var _ rl10.EnvLocatorInterface = (*EnvLocator)(nil)
+
+// EnvIndexCall records the arguments of a call to EnvLocator.Index.
+type EnvIndexCall struct{}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *EnvLocator) Index() (string, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &EnvIndexCall{})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res string
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *EnvLocator) IndexReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func() (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// IndexReader records the call and returns the results of IndexReaderStub if set.
+func (fake *EnvLocator) IndexReader() (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.IndexReaderCalls = append(fake.IndexReaderCalls, &EnvIndexCall{})
+	stub := fake.IndexReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// IndexReaderReturns sets IndexReaderStub to a function that returns the given values.
+func (fake *EnvLocator) IndexReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.IndexReaderStub = func() (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// EnvShowCall records the arguments of a call to EnvLocator.Show.
+type EnvShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *EnvLocator) Show() (string, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &EnvShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res string
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *EnvLocator) ShowReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ShowReader records the call and returns the results of ShowReaderStub if set.
+func (fake *EnvLocator) ShowReader() (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.ShowReaderCalls = append(fake.ShowReaderCalls, &EnvShowCall{})
+	stub := fake.ShowReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// ShowReaderReturns sets ShowReaderStub to a function that returns the given values.
+func (fake *EnvLocator) ShowReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.ShowReaderStub = func() (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// EnvUpdateCall records the arguments of a call to EnvLocator.Update.
+type EnvUpdateCall struct {
+	Payload string
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *EnvLocator) Update(payload string) (string, error) {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &EnvUpdateCall{Payload: payload})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(payload)
+	}
+	var res string
+	return res, nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *EnvLocator) UpdateReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(string) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UpdateReader records the call and returns the results of UpdateReaderStub if set.
+func (fake *EnvLocator) UpdateReader(payload string) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.UpdateReaderCalls = append(fake.UpdateReaderCalls, &EnvUpdateCall{Payload: payload})
+	stub := fake.UpdateReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(payload)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// UpdateReaderReturns sets UpdateReaderStub to a function that returns the given values.
+func (fake *EnvLocator) UpdateReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.UpdateReaderStub = func(string) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// EnvDeleteCall records the arguments of a call to EnvLocator.Delete.
+type EnvDeleteCall struct{}
+
+// Delete records the call and returns the results of DeleteStub if set.
+func (fake *EnvLocator) Delete() error {
+	fake.mu.Lock()
+	fake.DeleteCalls = append(fake.DeleteCalls, &EnvDeleteCall{})
+	stub := fake.DeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DeleteReturns sets DeleteStub to a function that returns the given values.
+func (fake *EnvLocator) DeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.DeleteStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Proc ******/
+
+// ProcLocator is a fake implementation of rl10.ProcLocatorInterface.
+type ProcLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func() (string, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ProcIndexCall
+
+	// IndexReaderStub is called by IndexReader if not nil.
+	IndexReaderStub func() (io.ReadCloser, error)
+	// IndexReaderCalls records the arguments of the calls made to IndexReader.
+	IndexReaderCalls []*ProcIndexCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (string, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ProcShowCall
+
+	// ShowReaderStub is called by ShowReader if not nil.
+	ShowReaderStub func() (io.ReadCloser, error)
+	// ShowReaderCalls records the arguments of the calls made to ShowReader.
+	ShowReaderCalls []*ProcShowCall
+}
+
+var _ rl10.ProcLocatorInterface = (*ProcLocator)(nil)
+
+// ProcIndexCall records the arguments of a call to ProcLocator.Index.
+type ProcIndexCall struct{}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ProcLocator) Index() (string, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ProcIndexCall{})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res string
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ProcLocator) IndexReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func() (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// IndexReader records the call and returns the results of IndexReaderStub if set.
+func (fake *ProcLocator) IndexReader() (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.IndexReaderCalls = append(fake.IndexReaderCalls, &ProcIndexCall{})
+	stub := fake.IndexReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// IndexReaderReturns sets IndexReaderStub to a function that returns the given values.
+func (fake *ProcLocator) IndexReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.IndexReaderStub = func() (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ProcShowCall records the arguments of a call to ProcLocator.Show.
+type ProcShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ProcLocator) Show() (string, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ProcShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res string
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ProcLocator) ShowReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ShowReader records the call and returns the results of ShowReaderStub if set.
+func (fake *ProcLocator) ShowReader() (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.ShowReaderCalls = append(fake.ShowReaderCalls, &ProcShowCall{})
+	stub := fake.ShowReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// ShowReaderReturns sets ShowReaderStub to a function that returns the given values.
+func (fake *ProcLocator) ShowReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.ShowReaderStub = func() (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Rl10 ******/
+
+// Rl10Locator is a fake implementation of rl10.Rl10LocatorInterface.
+type Rl10Locator struct {
+	mu sync.Mutex
+
+	// UpgradeStub is called by Upgrade if not nil.
+	UpgradeStub func(string) (string, error)
+	// UpgradeCalls records the arguments of the calls made to Upgrade.
+	UpgradeCalls []*Rl10UpgradeCall
+
+	// UpgradeReaderStub is called by UpgradeReader if not nil.
+	UpgradeReaderStub func(string) (io.ReadCloser, error)
+	// UpgradeReaderCalls records the arguments of the calls made to UpgradeReader.
+	UpgradeReaderCalls []*Rl10UpgradeCall
+
+	// RunRecipeStub is called by RunRecipe if not nil.
+	RunRecipeStub func(string, *rl10.Rl10RunRecipeOptions) (string, error)
+	// RunRecipeCalls records the arguments of the calls made to RunRecipe.
+	RunRecipeCalls []*Rl10RunRecipeCall
+
+	// RunRecipeReaderStub is called by RunRecipeReader if not nil.
+	RunRecipeReaderStub func(string, *rl10.Rl10RunRecipeOptions) (io.ReadCloser, error)
+	// RunRecipeReaderCalls records the arguments of the calls made to RunRecipeReader.
+	RunRecipeReaderCalls []*Rl10RunRecipeCall
+
+	// RunRightScriptStub is called by RunRightScript if not nil.
+	RunRightScriptStub func(*rl10.Rl10RunRightScriptOptions) (string, error)
+	// RunRightScriptCalls records the arguments of the calls made to RunRightScript.
+	RunRightScriptCalls []*Rl10RunRightScriptCall
+
+	// RunRightScriptReaderStub is called by RunRightScriptReader if not nil.
+	RunRightScriptReaderStub func(*rl10.Rl10RunRightScriptOptions) (io.ReadCloser, error)
+	// RunRightScriptReaderCalls records the arguments of the calls made to RunRightScriptReader.
+	RunRightScriptReaderCalls []*Rl10RunRightScriptCall
+}
+
+var _ rl10.Rl10LocatorInterface = (*Rl10Locator)(nil)
+
+// Rl10UpgradeCall records the arguments of a call to Rl10Locator.Upgrade.
+type Rl10UpgradeCall struct {
+	Exec string
+}
+
+// Upgrade records the call and returns the results of UpgradeStub if set.
+func (fake *Rl10Locator) Upgrade(exec string) (string, error) {
+	fake.mu.Lock()
+	fake.UpgradeCalls = append(fake.UpgradeCalls, &Rl10UpgradeCall{Exec: exec})
+	stub := fake.UpgradeStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(exec)
+	}
+	var res string
+	return res, nil
+}
+
+// UpgradeReturns sets UpgradeStub to a function that returns the given values.
+func (fake *Rl10Locator) UpgradeReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.UpgradeStub = func(string) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UpgradeReader records the call and returns the results of UpgradeReaderStub if set.
+func (fake *Rl10Locator) UpgradeReader(exec string) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.UpgradeReaderCalls = append(fake.UpgradeReaderCalls, &Rl10UpgradeCall{Exec: exec})
+	stub := fake.UpgradeReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(exec)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// UpgradeReaderReturns sets UpgradeReaderStub to a function that returns the given values.
+func (fake *Rl10Locator) UpgradeReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.UpgradeReaderStub = func(string) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// Rl10RunRecipeCall records the arguments of a call to Rl10Locator.RunRecipe.
+type Rl10RunRecipeCall struct {
+	Recipe  string
+	Options *rl10.Rl10RunRecipeOptions
+}
+
+// RunRecipe records the call and returns the results of RunRecipeStub if set.
+func (fake *Rl10Locator) RunRecipe(recipe string, options *rl10.Rl10RunRecipeOptions) (string, error) {
+	fake.mu.Lock()
+	fake.RunRecipeCalls = append(fake.RunRecipeCalls, &Rl10RunRecipeCall{Recipe: recipe, Options: options})
+	stub := fake.RunRecipeStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(recipe, options)
+	}
+	var res string
+	return res, nil
+}
+
+// RunRecipeReturns sets RunRecipeStub to a function that returns the given values.
+func (fake *Rl10Locator) RunRecipeReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.RunRecipeStub = func(string, *rl10.Rl10RunRecipeOptions) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RunRecipeReader records the call and returns the results of RunRecipeReaderStub if set.
+func (fake *Rl10Locator) RunRecipeReader(recipe string, options *rl10.Rl10RunRecipeOptions) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.RunRecipeReaderCalls = append(fake.RunRecipeReaderCalls, &Rl10RunRecipeCall{Recipe: recipe, Options: options})
+	stub := fake.RunRecipeReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(recipe, options)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// RunRecipeReaderReturns sets RunRecipeReaderStub to a function that returns the given values.
+func (fake *Rl10Locator) RunRecipeReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.RunRecipeReaderStub = func(string, *rl10.Rl10RunRecipeOptions) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// Rl10RunRightScriptCall records the arguments of a call to Rl10Locator.RunRightScript.
+type Rl10RunRightScriptCall struct {
+	Options *rl10.Rl10RunRightScriptOptions
+}
+
+// RunRightScript records the call and returns the results of RunRightScriptStub if set.
+func (fake *Rl10Locator) RunRightScript(options *rl10.Rl10RunRightScriptOptions) (string, error) {
+	fake.mu.Lock()
+	fake.RunRightScriptCalls = append(fake.RunRightScriptCalls, &Rl10RunRightScriptCall{Options: options})
+	stub := fake.RunRightScriptStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res string
+	return res, nil
+}
+
+// RunRightScriptReturns sets RunRightScriptStub to a function that returns the given values.
+func (fake *Rl10Locator) RunRightScriptReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.RunRightScriptStub = func(*rl10.Rl10RunRightScriptOptions) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// RunRightScriptReader records the call and returns the results of RunRightScriptReaderStub if set.
+func (fake *Rl10Locator) RunRightScriptReader(options *rl10.Rl10RunRightScriptOptions) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.RunRightScriptReaderCalls = append(fake.RunRightScriptReaderCalls, &Rl10RunRightScriptCall{Options: options})
+	stub := fake.RunRightScriptReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// RunRightScriptReaderReturns sets RunRightScriptReaderStub to a function that returns the given values.
+func (fake *Rl10Locator) RunRightScriptReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.RunRightScriptReaderStub = func(*rl10.Rl10RunRightScriptOptions) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  TSS ******/
+
+// TSSLocator is a fake implementation of rl10.TSSLocatorInterface.
+type TSSLocator struct {
+	mu sync.Mutex
+
+	// PutControlStub is called by PutControl if not nil.
+	PutControlStub func(*rl10.TSSPutControlOptions) (string, error)
+	// PutControlCalls records the arguments of the calls made to PutControl.
+	PutControlCalls []*TSSPutControlCall
+
+	// PutControlReaderStub is called by PutControlReader if not nil.
+	PutControlReaderStub func(*rl10.TSSPutControlOptions) (io.ReadCloser, error)
+	// PutControlReaderCalls records the arguments of the calls made to PutControlReader.
+	PutControlReaderCalls []*TSSPutControlCall
+
+	// GetHostnameStub is called by GetHostname if not nil.
+	GetHostnameStub func() (string, error)
+	// GetHostnameCalls records the arguments of the calls made to GetHostname.
+	GetHostnameCalls []*TSSGetHostnameCall
+
+	// GetHostnameReaderStub is called by GetHostnameReader if not nil.
+	GetHostnameReaderStub func() (io.ReadCloser, error)
+	// GetHostnameReaderCalls records the arguments of the calls made to GetHostnameReader.
+	GetHostnameReaderCalls []*TSSGetHostnameCall
+
+	// PutHostnameStub is called by PutHostname if not nil.
+	PutHostnameStub func(string) (string, error)
+	// PutHostnameCalls records the arguments of the calls made to PutHostname.
+	PutHostnameCalls []*TSSPutHostnameCall
+
+	// PutHostnameReaderStub is called by PutHostnameReader if not nil.
+	PutHostnameReaderStub func(string) (io.ReadCloser, error)
+	// PutHostnameReaderCalls records the arguments of the calls made to PutHostnameReader.
+	PutHostnameReaderCalls []*TSSPutHostnameCall
+}
+
+var _ rl10.TSSLocatorInterface = (*TSSLocator)(nil)
+
+// TSSPutControlCall records the arguments of a call to TSSLocator.PutControl.
+type TSSPutControlCall struct {
+	Options *rl10.TSSPutControlOptions
+}
+
+// PutControl records the call and returns the results of PutControlStub if set.
+func (fake *TSSLocator) PutControl(options *rl10.TSSPutControlOptions) (string, error) {
+	fake.mu.Lock()
+	fake.PutControlCalls = append(fake.PutControlCalls, &TSSPutControlCall{Options: options})
+	stub := fake.PutControlStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res string
+	return res, nil
+}
+
+// PutControlReturns sets PutControlStub to a function that returns the given values.
+func (fake *TSSLocator) PutControlReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.PutControlStub = func(*rl10.TSSPutControlOptions) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PutControlReader records the call and returns the results of PutControlReaderStub if set.
+func (fake *TSSLocator) PutControlReader(options *rl10.TSSPutControlOptions) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.PutControlReaderCalls = append(fake.PutControlReaderCalls, &TSSPutControlCall{Options: options})
+	stub := fake.PutControlReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// PutControlReaderReturns sets PutControlReaderStub to a function that returns the given values.
+func (fake *TSSLocator) PutControlReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.PutControlReaderStub = func(*rl10.TSSPutControlOptions) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// TSSGetHostnameCall records the arguments of a call to TSSLocator.GetHostname.
+type TSSGetHostnameCall struct{}
+
+// GetHostname records the call and returns the results of GetHostnameStub if set.
+func (fake *TSSLocator) GetHostname() (string, error) {
+	fake.mu.Lock()
+	fake.GetHostnameCalls = append(fake.GetHostnameCalls, &TSSGetHostnameCall{})
+	stub := fake.GetHostnameStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res string
+	return res, nil
+}
+
+// GetHostnameReturns sets GetHostnameStub to a function that returns the given values.
+func (fake *TSSLocator) GetHostnameReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.GetHostnameStub = func() (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// GetHostnameReader records the call and returns the results of GetHostnameReaderStub if set.
+func (fake *TSSLocator) GetHostnameReader() (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.GetHostnameReaderCalls = append(fake.GetHostnameReaderCalls, &TSSGetHostnameCall{})
+	stub := fake.GetHostnameReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// GetHostnameReaderReturns sets GetHostnameReaderStub to a function that returns the given values.
+func (fake *TSSLocator) GetHostnameReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.GetHostnameReaderStub = func() (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// TSSPutHostnameCall records the arguments of a call to TSSLocator.PutHostname.
+type TSSPutHostnameCall struct {
+	Hostname string
+}
+
+// PutHostname records the call and returns the results of PutHostnameStub if set.
+func (fake *TSSLocator) PutHostname(hostname string) (string, error) {
+	fake.mu.Lock()
+	fake.PutHostnameCalls = append(fake.PutHostnameCalls, &TSSPutHostnameCall{Hostname: hostname})
+	stub := fake.PutHostnameStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(hostname)
+	}
+	var res string
+	return res, nil
+}
+
+// PutHostnameReturns sets PutHostnameStub to a function that returns the given values.
+func (fake *TSSLocator) PutHostnameReturns(res string, err error) {
+	fake.mu.Lock()
+	fake.PutHostnameStub = func(string) (string, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// PutHostnameReader records the call and returns the results of PutHostnameReaderStub if set.
+func (fake *TSSLocator) PutHostnameReader(hostname string) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.PutHostnameReaderCalls = append(fake.PutHostnameReaderCalls, &TSSPutHostnameCall{Hostname: hostname})
+	stub := fake.PutHostnameReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(hostname)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// PutHostnameReaderReturns sets PutHostnameReaderStub to a function that returns the given values.
+func (fake *TSSLocator) PutHostnameReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.PutHostnameReaderStub = func(string) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
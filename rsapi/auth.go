@@ -65,6 +65,14 @@ func NewTokenAuthenticator(token string) Authenticator {
 	return &tokenAuthenticator{token: token}
 }
 
+// NewSessionAuthenticator returns an authenticator that uses an existing RightScale session to do
+// authentication. cookies is the value of the "Cookie" header returned when the session was
+// created. This is useful if the session has already been created, by rsc when running plugins
+// for example.
+func NewSessionAuthenticator(cookies string, accountID int) Authenticator {
+	return &sessionAuthenticator{cookies: cookies, accountID: accountID}
+}
+
 // NewSSAuthenticator returns an authenticator that wraps another one and adds the logic needed to
 // create sessions in Self-Service.
 func NewSSAuthenticator(auther Authenticator, accountID int) Authenticator {
@@ -271,6 +279,31 @@ func (t *tokenAuthenticator) CanAuthenticate(host string) error {
 	return testAuth(t, client, host, false)
 }
 
+// Existing session authenticator
+type sessionAuthenticator struct {
+	cookies   string
+	accountID int
+	host      string // Only used by CanAuthenticate
+}
+
+// Sign sets the session cookie and account headers
+func (s *sessionAuthenticator) Sign(r *http.Request) error {
+	r.Header.Set("Cookie", s.cookies)
+	r.Header.Set("X-Account", strconv.Itoa(s.accountID))
+	return nil
+}
+
+// SetHost is not used by the session authenticator as it does not actually create sessions.
+func (s *sessionAuthenticator) SetHost(h string) {
+	s.host = h
+}
+
+// CanAuthenticate makes a test request to CM 1.5 and returns nil if it is successful.
+func (s *sessionAuthenticator) CanAuthenticate(host string) error {
+	client := httpclient.New()
+	return testAuth(s, client, host, false)
+}
+
 // RightLink 10 authenticator
 type rl10Authenticator struct {
 	secret string
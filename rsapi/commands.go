@@ -104,6 +104,13 @@ func (a *API) ParseCommand(cmd, hrefPrefix string, values ActionCommands) (*Pars
 					name, value)
 			}
 			*coerced = append(*coerced, APIParams{name: val})
+		case "time":
+			t, err := ParseTime(value)
+			if err != nil {
+				return nil, fmt.Errorf("Value for '%s' must be a date and time, value provided was '%s'",
+					name, value)
+			}
+			*coerced = append(*coerced, APIParams{name: a.FormatTime(t.Time)})
 		case "map":
 			velems := strings.SplitN(value, "=", 2)
 			if len(velems) != 2 {
@@ -391,4 +391,51 @@ var _ = Describe("ParseCommand with cm15", func() {
 		})
 	})
 
+	Describe("with date flags", func() {
+		// dateFlags returns the audit entries index command using the given start date
+		dateFlags := func(startDate string) rsapi.ActionCommands {
+			return rsapi.ActionCommands{"index": &rsapi.ActionCommand{
+				Href: "/api/audit_entries",
+				Params: []string{
+					"start_date=" + startDate,
+					"end_date=2015/04/09 00:00:00 +0000",
+					"limit=10",
+				},
+			}}
+		}
+
+		BeforeEach(func() {
+			cmd = "index"
+			values = dateFlags("2015/04/08 00:00:00 +0000")
+		})
+
+		It("passes the values through", func() {
+			Ω(parseErr).ShouldNot(HaveOccurred())
+			Ω(parsed.PayloadParams["start_date"]).Should(Equal("2015/04/08 00:00:00 +0000"))
+			Ω(parsed.PayloadParams["end_date"]).Should(Equal("2015/04/09 00:00:00 +0000"))
+		})
+
+		Context("with a RFC 3339 date", func() {
+			BeforeEach(func() {
+				values = dateFlags("2015-04-08T00:00:00Z")
+			})
+
+			It("formats the value using the API layout", func() {
+				Ω(parseErr).ShouldNot(HaveOccurred())
+				Ω(parsed.PayloadParams["start_date"]).Should(Equal("2015/04/08 00:00:00 +0000"))
+			})
+		})
+
+		Context("with an invalid date", func() {
+			BeforeEach(func() {
+				values = dateFlags("yesterday")
+			})
+
+			It("fails", func() {
+				Ω(parseErr).Should(HaveOccurred())
+				Ω(parseErr.Error()).Should(ContainSubstring("must be a date and time"))
+			})
+		})
+	})
+
 })
@@ -0,0 +1,213 @@
+package rsapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/rightscale/rsc/metadata"
+	"github.com/rightscale/rsc/recording"
+)
+
+// ContractError is the error returned by VerifyRequest when a recorded request does not conform
+// to the API metadata. Resource and Action are blank if the request does not match any action.
+type ContractError struct {
+	Verb       string   // Request HTTP verb
+	Path       string   // Request path
+	Resource   string   // Name of matched resource, e.g. "Server"
+	Action     string   // Name of matched action, e.g. "index"
+	Violations []string // Description of each violation
+}
+
+// Error lists all the violations.
+func (e *ContractError) Error() string {
+	target := "no action"
+	if e.Action != "" {
+		target = e.Resource + "." + e.Action
+	}
+	return fmt.Sprintf("%s %s (%s):\n  - %s", e.Verb, e.Path, target,
+		strings.Join(e.Violations, "\n  - "))
+}
+
+// VerifyRecording checks all the requests of the given recording against the API metadata, see
+// VerifyRequest. Hidden (authentication) requests are skipped. It returns the errors of all the
+// requests that do not conform in order.
+func (a *API) VerifyRecording(rec *recording.Recording) []error {
+	var errs []error
+	for _, rr := range rec.Exchanges() {
+		if rr.Hidden {
+			continue
+		}
+		if err := a.VerifyRequest(&rr); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// VerifyRequest checks that the given recorded request corresponds to an action described in the
+// API metadata: the path and verb must resolve to a resource action, the query string and payload
+// parameters must all be known to the action and their values must satisfy the metadata
+// constraints (valid values, regular expressions etc.), and all mandatory parameters must be
+// present. It returns a *ContractError listing all the violations if any.
+// This makes it possible to detect recorded tests that became invalid after regenerating the
+// clients from new API metadata.
+func (a *API) VerifyRequest(rr *recording.RequestResponse) error {
+	u, err := url.Parse(rr.URI)
+	if err != nil {
+		return &ContractError{Verb: rr.Verb, Path: rr.URI,
+			Violations: []string{fmt.Sprintf("Invalid request URI: %s", err)}}
+	}
+	var query []string
+	for n, vs := range u.Query() {
+		for _, v := range vs {
+			query = append(query, n+"="+v)
+		}
+	}
+	sort.Strings(query)
+	var payload []string
+	var rawPayload bool // Whether body is not a JSON object
+	if strings.TrimSpace(rr.ReqBody) != "" {
+		var body map[string]interface{}
+		if err := json.Unmarshal([]byte(rr.ReqBody), &body); err != nil {
+			rawPayload = true
+		}
+		for n, v := range body {
+			payload = flattenParam(n, v, payload)
+		}
+		sort.Strings(payload)
+	}
+
+	var first *ContractError
+	for _, match := range a.matchActions(rr.Verb, u.Path) {
+		cerr := &ContractError{
+			Verb:     rr.Verb,
+			Path:     u.Path,
+			Resource: match.resource.Name,
+			Action:   match.action.Name,
+		}
+		if rawPayload && match.action.Payload == "" {
+			cerr.Violations = append(cerr.Violations, "Request body is not a JSON object")
+		}
+		seen := make(map[string]bool)
+		cerr.Violations = append(cerr.Violations,
+			a.verifyFlags(match.action, query, metadata.QueryParam, seen)...)
+		cerr.Violations = append(cerr.Violations,
+			a.verifyFlags(match.action, payload, metadata.PayloadParam, seen)...)
+		cerr.Violations = append(cerr.Violations, missingParams(match.action, seen)...)
+		if len(cerr.Violations) == 0 {
+			return nil
+		}
+		if first == nil {
+			first = cerr
+		}
+	}
+	if first == nil {
+		return &ContractError{Verb: rr.Verb, Path: u.Path,
+			Violations: []string{"Request does not match any known action"}}
+	}
+	return first
+}
+
+// actionMatch is a resource action whose path and verb match a request.
+type actionMatch struct {
+	resource *metadata.Resource
+	action   *metadata.Action
+}
+
+// matchActions returns the resource actions whose URL is the given path for the given verb.
+// The resources are traversed in alphabetical order so that results are consistent.
+func (a *API) matchActions(verb, path string) []*actionMatch {
+	names := make([]string, 0, len(a.Metadata))
+	for n := range a.Metadata {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	var matches []*actionMatch
+	for _, n := range names {
+		res := a.Metadata[n]
+		vars, err := res.ExtractVariables(path)
+		if err != nil {
+			continue
+		}
+		for _, action := range res.Actions {
+			p, err := action.URL(vars)
+			if err != nil || p.HTTPMethod != verb || p.Path != path {
+				continue
+			}
+			matches = append(matches, &actionMatch{resource: res, action: action})
+		}
+	}
+	return matches
+}
+
+// verifyFlags checks the given "NAME=VALUE" request parameters found in the given location
+// against the action metadata and records the names of the parameters that were found in seen.
+func (a *API) verifyFlags(action *metadata.Action, flags []string, loc metadata.Location, seen map[string]bool) []string {
+	var violations []string
+	for _, f := range flags {
+		param, value, err := a.findParamAndValue(action, f)
+		if err != nil {
+			violations = append(violations, err.Error())
+			continue
+		}
+		name := strings.SplitN(f, "=", 2)[0]
+		if param == nil {
+			// Parameter may be described by API params only, e.g. free form hashes
+			param = findAPIParam(action, name)
+			if param == nil {
+				violations = append(violations, fmt.Sprintf("Unknown parameter '%s'", name))
+				continue
+			}
+			seen[param.Name] = true
+			if param.Location != loc {
+				violations = append(violations, locationViolation(param))
+			}
+			continue
+		}
+		seen[param.Name] = true
+		if param.Location != loc {
+			violations = append(violations, locationViolation(param))
+			continue
+		}
+		if err := validateFlagValue(value, param); err != nil {
+			violations = append(violations, err.Error())
+			continue
+		}
+		if param.Name == "filter[]" {
+			if err := validateFilter(value, param); err != nil {
+				violations = append(violations, err.Error())
+			}
+		}
+	}
+	return violations
+}
+
+// findAPIParam returns the action API parameter the given flag name belongs to, nil if none.
+// The API parameter is the one whose name is the flag name prefix up to the first bracket.
+func findAPIParam(action *metadata.Action, name string) *metadata.ActionParam {
+	top := name
+	if idx := strings.Index(name, "["); idx > 0 {
+		top = name[:idx]
+	}
+	for _, p := range action.APIParams {
+		if p.Name == top || p.Name == top+"[]" || p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// locationViolation returns the violation message for a parameter sent in the wrong location.
+func locationViolation(param *metadata.ActionParam) string {
+	where := "query string"
+	switch param.Location {
+	case metadata.PayloadParam:
+		where = "payload"
+	case metadata.PathParam:
+		where = "path"
+	}
+	return fmt.Sprintf("Parameter '%s' must be given in the %s", param.Name, where)
+}
@@ -0,0 +1,181 @@
+package rsapi_test
+
+import (
+	"regexp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rightscale/rsc/metadata"
+	"github.com/rightscale/rsc/recording"
+	"github.com/rightscale/rsc/rsapi"
+)
+
+var _ = Describe("VerifyRequest", func() {
+	var (
+		api *rsapi.API
+		rr  *recording.RequestResponse
+		err error
+	)
+
+	BeforeEach(func() {
+		api = rsapi.New("test.rightscale.com", nil)
+		api.Metadata = rsapi.APIMetadata{"Server": &metadata.Resource{
+			Name: "Server",
+			Actions: []*metadata.Action{
+				{
+					Name: "index",
+					PathPatterns: []*metadata.PathPattern{
+						{HTTPMethod: "GET", Pattern: "/api/servers", Regexp: regexp.MustCompile(`^/api/servers$`)},
+					},
+					CommandFlags: []*metadata.ActionParam{
+						{Name: "filter[]", Type: "[]string", Location: metadata.QueryParam,
+							ValidValues: []string{"name", "state"}},
+						{Name: "view", Type: "string", Location: metadata.QueryParam,
+							ValidValues: []string{"default", "instance_detail"}},
+					},
+					APIParams: []*metadata.ActionParam{
+						{Name: "filter[]", Type: "[]string", Location: metadata.QueryParam},
+						{Name: "view", Type: "string", Location: metadata.QueryParam},
+					},
+				},
+				{
+					Name: "create",
+					PathPatterns: []*metadata.PathPattern{
+						{HTTPMethod: "POST", Pattern: "/api/servers", Regexp: regexp.MustCompile(`^/api/servers$`)},
+					},
+					CommandFlags: []*metadata.ActionParam{
+						{Name: "server[name]", Type: "string", Location: metadata.PayloadParam,
+							Mandatory: true, NonBlank: true},
+						{Name: "server[description]", Type: "string", Location: metadata.PayloadParam},
+					},
+					APIParams: []*metadata.ActionParam{
+						{Name: "server", Type: "*ServerParam", Location: metadata.PayloadParam,
+							Mandatory: true},
+					},
+				},
+				{
+					Name: "show",
+					PathPatterns: []*metadata.PathPattern{
+						{HTTPMethod: "GET", Pattern: "/api/servers/%s", Variables: []string{"id"},
+							Regexp: regexp.MustCompile(`^/api/servers/([^/]+)$`)},
+					},
+				},
+			},
+		}}
+	})
+
+	JustBeforeEach(func() {
+		err = api.VerifyRequest(rr)
+	})
+
+	violations := func() []string {
+		Ω(err).Should(HaveOccurred())
+		cerr, ok := err.(*rsapi.ContractError)
+		Ω(ok).Should(BeTrue())
+		return cerr.Violations
+	}
+
+	Context("with a valid query string", func() {
+		BeforeEach(func() {
+			rr = &recording.RequestResponse{Verb: "GET",
+				URI: "https://test.rightscale.com/api/servers?filter[]=name==web&view=default"}
+		})
+
+		It("succeeds", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("with a valid payload", func() {
+		BeforeEach(func() {
+			rr = &recording.RequestResponse{Verb: "POST", URI: "https://test.rightscale.com/api/servers",
+				ReqBody: `{"server":{"name":"web","description":"front"}}`}
+		})
+
+		It("succeeds", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("with a path variable", func() {
+		BeforeEach(func() {
+			rr = &recording.RequestResponse{Verb: "GET", URI: "https://test.rightscale.com/api/servers/42"}
+		})
+
+		It("succeeds", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("with an unknown path", func() {
+		BeforeEach(func() {
+			rr = &recording.RequestResponse{Verb: "GET", URI: "https://test.rightscale.com/api/clouds"}
+		})
+
+		It("reports the request", func() {
+			Ω(violations()).Should(ConsistOf("Request does not match any known action"))
+			Ω(err.Error()).Should(ContainSubstring("GET /api/clouds (no action)"))
+		})
+	})
+
+	Context("with an unknown verb", func() {
+		BeforeEach(func() {
+			rr = &recording.RequestResponse{Verb: "DELETE", URI: "https://test.rightscale.com/api/servers/42"}
+		})
+
+		It("reports the request", func() {
+			Ω(violations()).Should(ConsistOf("Request does not match any known action"))
+		})
+	})
+
+	Context("with unknown and invalid query parameters", func() {
+		BeforeEach(func() {
+			rr = &recording.RequestResponse{Verb: "GET",
+				URI: "https://test.rightscale.com/api/servers?view=full&filter[]=size==1&limit=2"}
+		})
+
+		It("lists the violations", func() {
+			Ω(violations()).Should(ConsistOf(
+				"Invalid filter 'size' for 'filter[]', filter must be one of name, state",
+				"Unknown parameter 'limit'",
+				"Invalid value for 'view', value must be one of default, instance_detail, value provided was 'full'",
+			))
+			Ω(err.Error()).Should(ContainSubstring("Server.index"))
+		})
+	})
+
+	Context("with a missing mandatory parameter", func() {
+		BeforeEach(func() {
+			rr = &recording.RequestResponse{Verb: "POST", URI: "https://test.rightscale.com/api/servers",
+				ReqBody: `{"server":{"description":"front"}}`}
+		})
+
+		It("lists the violations", func() {
+			Ω(violations()).Should(ConsistOf("Missing required parameter 'server[name]'"))
+		})
+	})
+
+	Context("with a payload parameter given in the query string", func() {
+		BeforeEach(func() {
+			rr = &recording.RequestResponse{Verb: "POST",
+				URI: "https://test.rightscale.com/api/servers?server[name]=web"}
+		})
+
+		It("lists the violations", func() {
+			Ω(violations()).Should(ConsistOf("Parameter 'server[name]' must be given in the payload"))
+		})
+	})
+
+	Context("with a recording", func() {
+		It("skips hidden requests", func() {
+			rec := &recording.Recording{RRs: []recording.RequestResponse{
+				{Verb: "POST", URI: "https://test.rightscale.com/api/sessions", Hidden: true},
+				{Verb: "GET", URI: "https://test.rightscale.com/api/servers"},
+				{Verb: "GET", URI: "https://test.rightscale.com/api/clouds"},
+			}}
+			errs := api.VerifyRecording(rec)
+			Ω(errs).Should(HaveLen(1))
+			Ω(errs[0].Error()).Should(ContainSubstring("/api/clouds"))
+		})
+	})
+})
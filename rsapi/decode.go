@@ -0,0 +1,163 @@
+package rsapi
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// DecodeArray reads the JSON array from r one element at a time and calls fn with the raw JSON of
+// each element. Only one element is held in memory at a time so that very large collections can
+// be processed with constant memory. A JSON null is treated as an empty array. DecodeArray stops
+// and returns the error returned by fn if any.
+func DecodeArray(r io.Reader, fn func(elem []byte) error) error {
+	br := bufio.NewReader(r)
+	c, err := skipSpaces(br)
+	if err != nil {
+		return fmt.Errorf("Failed to decode response: %s", unexpectedEOF(err))
+	}
+	if c == 'n' {
+		if err := expect(br, "ull"); err != nil {
+			return err
+		}
+		return expectEnd(br)
+	}
+	if c != '[' {
+		return fmt.Errorf("Failed to decode response: expected array, got %q", c)
+	}
+	first := true
+	for {
+		c, err := skipSpaces(br)
+		if err != nil {
+			return fmt.Errorf("Failed to decode response: %s", unexpectedEOF(err))
+		}
+		if c == ']' {
+			return expectEnd(br)
+		}
+		if !first {
+			if c != ',' {
+				return fmt.Errorf("Failed to decode response: expected ',' or ']', got %q", c)
+			}
+		} else {
+			br.UnreadByte()
+			first = false
+		}
+		elem, err := readValue(br)
+		if err != nil {
+			return fmt.Errorf("Failed to decode response: %s", unexpectedEOF(err))
+		}
+		if len(elem) == 0 {
+			return fmt.Errorf("Failed to decode response: expected array element")
+		}
+		if err := fn(elem); err != nil {
+			return err
+		}
+	}
+}
+
+// readValue reads the raw bytes of the next JSON value, leading white spaces are skipped. The
+// value content is not validated beyond what is needed to find where it ends.
+func readValue(br *bufio.Reader) ([]byte, error) {
+	var (
+		buf      []byte
+		depth    int
+		inString bool
+		escaped  bool
+	)
+	if _, err := skipSpaces(br); err != nil {
+		return nil, err
+	}
+	br.UnreadByte()
+	for {
+		c, err := br.ReadByte()
+		if err == io.EOF && len(buf) > 0 && depth == 0 && !inString {
+			return buf, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if inString {
+			buf = append(buf, c)
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+				if depth == 0 {
+					return buf, nil
+				}
+			}
+			continue
+		}
+		switch c {
+		case '"':
+			inString = true
+		case '{', '[':
+			depth++
+		case '}', ']':
+			if depth == 0 {
+				br.UnreadByte()
+				return buf, nil
+			}
+			depth--
+			if depth == 0 {
+				return append(buf, c), nil
+			}
+		case ',', ' ', '\t', '\r', '\n':
+			if depth == 0 {
+				br.UnreadByte()
+				return buf, nil
+			}
+		}
+		buf = append(buf, c)
+	}
+}
+
+// skipSpaces reads and returns the next byte that is not a JSON white space.
+func skipSpaces(br *bufio.Reader) (byte, error) {
+	for {
+		c, err := br.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if c != ' ' && c != '\t' && c != '\r' && c != '\n' {
+			return c, nil
+		}
+	}
+}
+
+// expect reads the given literal.
+func expect(br *bufio.Reader, lit string) error {
+	for i := 0; i < len(lit); i++ {
+		c, err := br.ReadByte()
+		if err != nil {
+			return fmt.Errorf("Failed to decode response: %s", unexpectedEOF(err))
+		}
+		if c != lit[i] {
+			return fmt.Errorf("Failed to decode response: invalid character %q", c)
+		}
+	}
+	return nil
+}
+
+// expectEnd checks that only white spaces are left to read.
+func expectEnd(br *bufio.Reader) error {
+	c, err := skipSpaces(br)
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to decode response: %s", err)
+	}
+	return fmt.Errorf("Failed to decode response: invalid character %q after array", c)
+}
+
+// unexpectedEOF converts io.EOF to io.ErrUnexpectedEOF.
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
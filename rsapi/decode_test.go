@@ -0,0 +1,122 @@
+package rsapi_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rightscale/rsc/rsapi"
+)
+
+var _ = Describe("DecodeArray", func() {
+	var (
+		body  string
+		elems []string
+		err   error
+	)
+
+	JustBeforeEach(func() {
+		elems = nil
+		err = rsapi.DecodeArray(strings.NewReader(body), func(elem []byte) error {
+			elems = append(elems, string(elem))
+			return nil
+		})
+	})
+
+	Context("with an array of objects", func() {
+		BeforeEach(func() {
+			body = ` [ {"name": "a,]}\"b", "links": [{"rel": "self"}]},
+				{"name": "c"} ]
+			`
+		})
+
+		It("calls the callback with each element", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(elems).Should(HaveLen(2))
+			var first map[string]interface{}
+			Ω(json.Unmarshal([]byte(elems[0]), &first)).Should(Succeed())
+			Ω(first["name"]).Should(Equal(`a,]}"b`))
+			Ω(elems[1]).Should(Equal(`{"name": "c"}`))
+		})
+	})
+
+	Context("with an array of scalars", func() {
+		BeforeEach(func() {
+			body = `["a", 1,true ,null]`
+		})
+
+		It("calls the callback with each element", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(elems).Should(Equal([]string{`"a"`, "1", "true", "null"}))
+		})
+	})
+
+	Context("with an empty array", func() {
+		BeforeEach(func() {
+			body = "[]"
+		})
+
+		It("does not call the callback", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(elems).Should(BeEmpty())
+		})
+	})
+
+	Context("with null", func() {
+		BeforeEach(func() {
+			body = "null\n"
+		})
+
+		It("does not call the callback", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(elems).Should(BeEmpty())
+		})
+	})
+
+	Context("with a truncated array", func() {
+		BeforeEach(func() {
+			body = `[{"name": "a"}, {"name": `
+		})
+
+		It("returns an error after the complete elements", func() {
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("unexpected EOF"))
+			Ω(elems).Should(Equal([]string{`{"name": "a"}`}))
+		})
+	})
+
+	Context("with an object", func() {
+		BeforeEach(func() {
+			body = `{"name": "a"}`
+		})
+
+		It("returns an error", func() {
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("expected array"))
+		})
+	})
+
+	Context("with a missing element", func() {
+		BeforeEach(func() {
+			body = `[1,]`
+		})
+
+		It("returns an error", func() {
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("when the callback fails", func() {
+		It("stops and returns the error", func() {
+			var count int
+			err := rsapi.DecodeArray(strings.NewReader("[1, 2, 3]"), func(elem []byte) error {
+				count++
+				return fmt.Errorf("stop")
+			})
+			Ω(err).Should(MatchError("stop"))
+			Ω(count).Should(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,114 @@
+package rsapi
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/rightscale/rsc/cmd"
+	"github.com/rightscale/rsc/httpclient"
+)
+
+// Names of the environment variables used by rsc to hand over the resolved command line settings
+// and credentials to plugins (rsc-NAME executables found in PATH).
+const (
+	// EnvHost contains the API host, e.g. "us-3.rightscale.com".
+	EnvHost = "RSC_HOST"
+	// EnvAccount contains the RightScale account ID.
+	EnvAccount = "RSC_ACCOUNT"
+	// EnvAccessToken contains an OAuth access token if rsc was given OAuth credentials.
+	EnvAccessToken = "RSC_ACCESS_TOKEN"
+	// EnvSession contains the session cookies if rsc was given a login and password or an
+	// instance API token.
+	EnvSession = "RSC_SESSION"
+	// EnvRL10 is set to "true" if requests should be proxied through RightLink 10.
+	EnvRL10 = "RSC_RL10"
+	// EnvDump contains the value of the --dump flag.
+	EnvDump = "RSC_DUMP"
+	// EnvVerbose is set to "true" if the --verbose flag was set.
+	EnvVerbose = "RSC_VERBOSE"
+	// EnvFetch is set to "true" if the --fetch flag was set.
+	EnvFetch = "RSC_FETCH"
+	// EnvNoAuth is set to "true" if the --noAuth flag was set.
+	EnvNoAuth = "RSC_NOAUTH"
+)
+
+// PluginEnv returns the environment variables given to plugins so that they can create API
+// clients with FromEnv. It authenticates using the credentials given on the command line so that
+// plugins receive a fresh OAuth access token or session.
+func PluginEnv(cmdLine *cmd.CommandLine) ([]string, error) {
+	env := []string{
+		EnvHost + "=" + cmdLine.Host,
+		EnvDump + "=" + cmdLine.Dump,
+		EnvVerbose + "=" + strconv.FormatBool(cmdLine.Verbose),
+		EnvFetch + "=" + strconv.FormatBool(cmdLine.FetchResource),
+		EnvRL10 + "=" + strconv.FormatBool(cmdLine.RL10),
+		EnvNoAuth + "=" + strconv.FormatBool(cmdLine.NoAuth),
+	}
+	if cmdLine.Account != 0 {
+		env = append(env, EnvAccount+"="+strconv.Itoa(cmdLine.Account))
+	}
+	if cmdLine.NoAuth || cmdLine.RL10 {
+		return env, nil
+	}
+	if cmdLine.OAuthToken == "" && cmdLine.OAuthAccessToken == "" && cmdLine.APIToken == "" && cmdLine.Username == "" {
+		return env, nil // No credentials, let plugin deal with it
+	}
+	client, err := FromCommandLine(cmdLine)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", buildURL(client.Host, "api/sessions"), nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Auth.Sign(req); err != nil {
+		return nil, err
+	}
+	if req.URL.Host != client.Host {
+		// Login redirected to a different host
+		env[0] = EnvHost + "=" + req.URL.Host
+	}
+	if auth := req.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		env = append(env, EnvAccessToken+"="+strings.TrimPrefix(auth, "Bearer "))
+	} else if cookies := req.Header.Get("Cookie"); cookies != "" {
+		env = append(env, EnvSession+"="+cookies)
+	}
+	return env, nil
+}
+
+// FromEnv builds an API client from the environment variables set by rsc when running plugins.
+// The client uses the same host, credentials and dump settings as the rsc invocation that ran the
+// plugin. FromEnv returns an error if there are no credentials unless rsc was run with --noAuth.
+func FromEnv() (*API, error) {
+	var account int
+	if a := os.Getenv(EnvAccount); a != "" {
+		var err error
+		if account, err = strconv.Atoi(a); err != nil {
+			return nil, fmt.Errorf("Invalid %s value '%s'", EnvAccount, a)
+		}
+	}
+	host := os.Getenv(EnvHost)
+	var client *API
+	if os.Getenv(EnvRL10) == "true" {
+		var err error
+		if client, err = NewRL10(); err != nil {
+			return nil, err
+		}
+	} else if token := os.Getenv(EnvAccessToken); token != "" {
+		client = New(host, NewTokenAuthenticator(token))
+	} else if cookies := os.Getenv(EnvSession); cookies != "" {
+		client = New(host, NewSessionAuthenticator(cookies, account))
+	} else if os.Getenv(EnvNoAuth) == "true" {
+		// No auth, used by tests
+		client = New(host, nil)
+		httpclient.Insecure = true
+	} else {
+		return nil, fmt.Errorf("Missing authentication information, use '--email EMAIL --password PWD', '--token TOKEN' or 'setup'")
+	}
+	setDumpFormat(os.Getenv(EnvDump), os.Getenv(EnvVerbose) == "true")
+	client.FetchLocationResource = os.Getenv(EnvFetch) == "true"
+	return client, nil
+}
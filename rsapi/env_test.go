@@ -0,0 +1,83 @@
+package rsapi_test
+
+import (
+	"net/http"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rightscale/rsc/httpclient"
+	"github.com/rightscale/rsc/rsapi"
+)
+
+var _ = Describe("FromEnv", func() {
+	var (
+		client *rsapi.API
+		err    error
+	)
+
+	BeforeEach(func() {
+		os.Setenv(rsapi.EnvHost, "us-4.rightscale.com")
+		os.Setenv(rsapi.EnvAccount, "42")
+	})
+
+	AfterEach(func() {
+		os.Unsetenv(rsapi.EnvHost)
+		os.Unsetenv(rsapi.EnvAccount)
+		os.Unsetenv(rsapi.EnvAccessToken)
+		os.Unsetenv(rsapi.EnvSession)
+		os.Unsetenv(rsapi.EnvNoAuth)
+		httpclient.Insecure = false
+	})
+
+	JustBeforeEach(func() {
+		client, err = rsapi.FromEnv()
+	})
+
+	Context("with an access token", func() {
+		BeforeEach(func() {
+			os.Setenv(rsapi.EnvAccessToken, "token")
+		})
+
+		It("signs requests with the token", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(client.Host).Should(Equal("us-4.rightscale.com"))
+			req, _ := http.NewRequest("GET", "https://us-4.rightscale.com/api/clouds", nil)
+			Ω(client.Auth.Sign(req)).ShouldNot(HaveOccurred())
+			Ω(req.Header.Get("Authorization")).Should(Equal("Bearer token"))
+		})
+	})
+
+	Context("with a session", func() {
+		BeforeEach(func() {
+			os.Setenv(rsapi.EnvSession, "rs_gbl=abc")
+		})
+
+		It("signs requests with the session cookie", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			req, _ := http.NewRequest("GET", "https://us-4.rightscale.com/api/clouds", nil)
+			Ω(client.Auth.Sign(req)).ShouldNot(HaveOccurred())
+			Ω(req.Header.Get("Cookie")).Should(Equal("rs_gbl=abc"))
+			Ω(req.Header.Get("X-Account")).Should(Equal("42"))
+		})
+	})
+
+	Context("without credentials", func() {
+		It("fails", func() {
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("Missing authentication information"))
+			Ω(httpclient.Insecure).Should(BeFalse())
+		})
+
+		Context("with --noAuth", func() {
+			BeforeEach(func() {
+				os.Setenv(rsapi.EnvNoAuth, "true")
+			})
+
+			It("creates an unauthenticated client", func() {
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(client.Auth).Should(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,102 @@
+package rsapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// APIError is the error returned by the client methods when the API responds with a status code
+// outside of the 2xx range.
+type APIError struct {
+	StatusCode  int         // Response status code, e.g. 404
+	Status      string      // Response status, e.g. "404 Not Found"
+	Header      http.Header // Response headers
+	Body        []byte      // Raw response body
+	Message     string      // Error message extracted from response body
+	RequestUUID string      // Value of X-Request-Uuid response header, useful when contacting support
+	Method      string      // Request HTTP method
+	Href        string      // Request path
+}
+
+// NewAPIError builds an APIError from the given response. It reads and closes the response body.
+func NewAPIError(resp *http.Response) *APIError {
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	e := APIError{
+		StatusCode:  resp.StatusCode,
+		Status:      resp.Status,
+		Header:      resp.Header,
+		Body:        body,
+		Message:     errorMessage(body),
+		RequestUUID: resp.Header.Get("X-Request-Uuid"),
+	}
+	if req := resp.Request; req != nil {
+		e.Method = req.Method
+		if req.URL != nil {
+			e.Href = req.URL.Path
+		}
+	}
+	return &e
+}
+
+// Error returns the response status followed by the response body if any.
+func (e *APIError) Error() string {
+	if len(e.Body) == 0 {
+		return fmt.Sprintf("invalid response %s", e.Status)
+	}
+	return fmt.Sprintf("invalid response %s: %s", e.Status, e.Body)
+}
+
+// IsNotFound returns true if err is an APIError with status code 404.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict returns true if err is an APIError with status code 409.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsRateLimited returns true if err is an APIError with status code 429.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, 429)
+}
+
+// IsUnauthorized returns true if err is an APIError with status code 401.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden returns true if err is an APIError with status code 403.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsUnprocessableEntity returns true if err is an APIError with status code 422, the RightScale
+// APIs use this status code for validation errors.
+func IsUnprocessableEntity(err error) bool {
+	return hasStatus(err, 422)
+}
+
+// hasStatus returns true if err is an APIError with the given status code.
+func hasStatus(err error, code int) bool {
+	e, ok := err.(*APIError)
+	return ok && e.StatusCode == code
+}
+
+// errorMessage extracts the error message from a response body. Praxis and Self-Service APIs
+// respond with JSON objects containing a "message" field, CM API 1.5 responds with plain text.
+func errorMessage(body []byte) string {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(body, &obj); err == nil {
+		for _, k := range []string{"message", "error", "summary"} {
+			if msg, ok := obj[k].(string); ok && msg != "" {
+				return msg
+			}
+		}
+	}
+	return strings.TrimSpace(string(body))
+}
@@ -0,0 +1,79 @@
+package rsapi_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rightscale/rsc/rsapi"
+)
+
+var _ = Describe("APIError", func() {
+	var (
+		status int
+		body   string
+		apiErr *rsapi.APIError
+	)
+
+	JustBeforeEach(func() {
+		u, _ := url.Parse("https://us-3.rightscale.com/api/clouds/42")
+		resp := http.Response{
+			StatusCode: status,
+			Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+			Header:     http.Header{"X-Request-Uuid": []string{"abc123"}},
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Request:    &http.Request{Method: "GET", URL: u},
+		}
+		apiErr = rsapi.NewAPIError(&resp)
+	})
+
+	Context("with a plain text response", func() {
+		BeforeEach(func() {
+			status = 404
+			body = "ResourceNotFound: Couldn't find Cloud with ID=42 \n"
+		})
+
+		It("records the response and request details", func() {
+			Ω(apiErr.StatusCode).Should(Equal(404))
+			Ω(apiErr.Message).Should(Equal("ResourceNotFound: Couldn't find Cloud with ID=42"))
+			Ω(apiErr.RequestUUID).Should(Equal("abc123"))
+			Ω(apiErr.Method).Should(Equal("GET"))
+			Ω(apiErr.Href).Should(Equal("/api/clouds/42"))
+			Ω(apiErr.Error()).Should(Equal("invalid response 404 Not Found: " + body))
+		})
+
+		It("is recognized by the helpers", func() {
+			Ω(rsapi.IsNotFound(apiErr)).Should(BeTrue())
+			Ω(rsapi.IsConflict(apiErr)).Should(BeFalse())
+			Ω(rsapi.IsNotFound(fmt.Errorf("404"))).Should(BeFalse())
+		})
+	})
+
+	Context("with a JSON response", func() {
+		BeforeEach(func() {
+			status = 429
+			body = `{"name":"rate_limited","message":"Too many requests"}`
+		})
+
+		It("extracts the message", func() {
+			Ω(apiErr.Message).Should(Equal("Too many requests"))
+			Ω(rsapi.IsRateLimited(apiErr)).Should(BeTrue())
+		})
+	})
+
+	Context("with an empty response", func() {
+		BeforeEach(func() {
+			status = 409
+			body = ""
+		})
+
+		It("only reports the status", func() {
+			Ω(apiErr.Error()).Should(Equal("invalid response 409 Conflict"))
+			Ω(rsapi.IsConflict(apiErr)).Should(BeTrue())
+		})
+	})
+})
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -16,17 +15,21 @@ import (
 
 // FileUpload represents payload fields that correspond to multipart file uploads.
 type FileUpload struct {
-	Name     string    // Multipart part name
-	Filename string    // Uploaded filename
-	Reader   io.Reader // Backing reader
+	Name        string    // Multipart part name
+	Filename    string    // Uploaded filename
+	Reader      io.Reader // Backing reader, the upload can be retried if it also implements io.Seeker
+	ContentType string    // Part content type, defaults to type matching filename extension
 }
 
 // BuildHTTPRequest creates a http.Request given all its parts.
-// If any member of the Payload field is of type io.Reader then the resulting request has a
-// multipart body where each member of type io.Reader is mapped to a single part and all other
-// members make up the first part.
+// If any member of the Payload field is of type *FileUpload then the resulting request has a
+// multipart body where each member of type *FileUpload is mapped to a single part and all other
+// members make up the first part. The file uploads are streamed when the request is sent, use
+// RewindRequest to send the request again. Top level parameters of type Time are formatted using
+// the API TimeLayout.
 func (a *API) BuildHTTPRequest(verb, path, version string, params, payload APIParams) (*http.Request, error) {
 	u := url.URL{Host: a.Host, Path: path}
+	params, payload = a.formatTimes(params), a.formatTimes(payload)
 	if params != nil {
 		var values = u.Query()
 		for n, p := range params {
@@ -65,10 +68,8 @@ func (a *API) BuildHTTPRequest(verb, path, version string, params, payload APIPa
 	}
 	var jsonBytes []byte
 	var body io.Reader
-	var isMultipart bool
-	var boundary string
+	var upload *uploadBody
 	if payload != nil {
-		var fields io.Reader
 		var uploads []*FileUpload
 		for k, v := range payload {
 			if mpart, ok := v.(*FileUpload); ok {
@@ -81,39 +82,11 @@ func (a *API) BuildHTTPRequest(verb, path, version string, params, payload APIPa
 			if jsonBytes, err = json.Marshal(payload); err != nil {
 				return nil, fmt.Errorf("failed to serialize request body: %s", err.Error())
 			}
-			fields = bytes.NewBuffer(jsonBytes)
+			body = bytes.NewBuffer(jsonBytes)
 		}
 		if len(uploads) > 0 {
-			isMultipart = true
-			var buffer bytes.Buffer
-			w := multipart.NewWriter(&buffer)
-			if len(payload) > 0 {
-				p, err := w.CreateFormField("payload")
-				if err != nil {
-					return nil, fmt.Errorf("failed to create multipart payload: %s", err.Error())
-				}
-				_, err = io.Copy(p, fields)
-				if err != nil {
-					return nil, fmt.Errorf("failed to copy multipart payload: %s", err.Error())
-				}
-			}
-			for _, u := range uploads {
-				p, err := w.CreateFormFile(u.Name, u.Filename)
-				if err != nil {
-					return nil, fmt.Errorf("failed to create multipart file: %s", err.Error())
-				}
-				_, err = io.Copy(p, u.Reader)
-				if err != nil {
-					return nil, fmt.Errorf("failed to copy multipart file: %s", err.Error())
-				}
-			}
-			if err := w.Close(); err != nil {
-				return nil, fmt.Errorf("failed to close multipart body: %s", err.Error())
-			}
-			boundary = w.Boundary()
-			body = &buffer
-		} else {
-			body = fields
+			upload = newUploadBody(jsonBytes, uploads, a.UploadProgress)
+			body = upload
 		}
 	}
 	var req, err = http.NewRequest(verb, u.String(), body)
@@ -123,8 +96,9 @@ func (a *API) BuildHTTPRequest(verb, path, version string, params, payload APIPa
 	if version != "" {
 		req.Header.Set("X-API-Version", version)
 	}
-	if isMultipart {
-		req.Header.Set("Content-Type", fmt.Sprintf("multipart/form-data; boundary=%s", boundary))
+	if upload != nil {
+		req.Header.Set("Content-Type", upload.contentType())
+		req.ContentLength = upload.contentLength()
 	} else {
 		req.Header.Set("Content-Type", "application/json")
 	}
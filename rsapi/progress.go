@@ -0,0 +1,71 @@
+package rsapi
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// Width of progress bar in characters
+const progressBarWidth = 30
+
+// NewProgressBar returns a ProgressFunc that renders a progress bar for each file upload on the
+// given writer, e.g.:
+//
+//	cat.zip [===============>              ]  52% 5.2 MB/10.0 MB
+//
+// The bar is redrawn at most 10 times per second.
+func NewProgressBar(w io.Writer) ProgressFunc {
+	var last time.Time
+	return func(name string, sent, total int64) {
+		done := sent == total
+		if !done && time.Since(last) < 100*time.Millisecond {
+			return
+		}
+		last = time.Now()
+		var line string
+		if total < 0 {
+			line = fmt.Sprintf("%s %s", name, formatBytes(sent))
+		} else {
+			pct := 100
+			if total > 0 {
+				pct = int(sent * 100 / total)
+			}
+			filled := pct * progressBarWidth / 100
+			bar := strings.Repeat("=", filled)
+			if filled < progressBarWidth {
+				bar += ">" + strings.Repeat(" ", progressBarWidth-filled-1)
+			}
+			line = fmt.Sprintf("%s [%s] %3d%% %s/%s", name, bar, pct, formatBytes(sent),
+				formatBytes(total))
+		}
+		if done {
+			fmt.Fprintf(w, "\r%s\n", line)
+		} else {
+			fmt.Fprintf(w, "\r%s", line)
+		}
+	}
+}
+
+// isTerminal returns true if the given file is a character device (e.g. a terminal), progress
+// bars are only rendered on terminals.
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// formatBytes returns a human readable representation of the given number of bytes.
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<30:
+		return fmt.Sprintf("%.1f GB", float64(n)/(1<<30))
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
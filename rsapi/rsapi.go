@@ -21,6 +21,9 @@ type (
 		Client                httpclient.HTTPClient // Underlying http client (not used for authentication requests as these necessitate special redirect handling)
 		FetchLocationResource bool                  // Whether to fetch resource pointed by Location header
 		Metadata              APIMetadata           // Generated API metadata
+		UploadProgress        ProgressFunc          // Called while file uploads are being sent, optional
+		NoParamValidation     bool                  // Whether generated clients should skip validating parameters against the metadata
+		TimeLayout            string                // Layout used to format Time parameters, RFC 3339 if blank
 
 		insecure bool // Whether HTTP should be used instead of HTTPS (used by RL10 proxied requests)
 		// Use Insecure method to set to true.
@@ -139,23 +142,32 @@ func FromCommandLine(cmdLine *cmd.CommandLine) (*API, error) {
 		if cmdLine.OAuthToken == "" && cmdLine.OAuthAccessToken == "" && cmdLine.APIToken == "" && cmdLine.Username == "" && !cmdLine.RL10 {
 			return nil, fmt.Errorf("Missing authentication information, use '--email EMAIL --password PWD', '--token TOKEN' or 'setup'")
 		}
-		if cmdLine.Verbose || cmdLine.Dump == "debug" {
-			httpclient.DumpFormat = httpclient.Debug
-		}
-		if cmdLine.Dump == "json" {
-			httpclient.DumpFormat = httpclient.JSON
-		}
-		if cmdLine.Dump == "record" {
-			httpclient.DumpFormat = httpclient.JSON | httpclient.Record
-		}
-		if cmdLine.Verbose {
-			httpclient.DumpFormat |= httpclient.Verbose
-		}
+		setDumpFormat(cmdLine.Dump, cmdLine.Verbose)
 		client.FetchLocationResource = cmdLine.FetchResource
 	}
+	if httpclient.DumpFormat == httpclient.NoDump && isTerminal(os.Stderr) {
+		client.UploadProgress = NewProgressBar(os.Stderr)
+	}
 	return client, nil
 }
 
+// setDumpFormat initializes httpclient.DumpFormat from the values of the --dump and --verbose
+// flags.
+func setDumpFormat(dump string, verbose bool) {
+	if verbose || dump == "debug" {
+		httpclient.DumpFormat = httpclient.Debug
+	}
+	if dump == "json" {
+		httpclient.DumpFormat = httpclient.JSON
+	}
+	if dump == "record" {
+		httpclient.DumpFormat = httpclient.JSON | httpclient.Record
+	}
+	if verbose {
+		httpclient.DumpFormat |= httpclient.Verbose
+	}
+}
+
 // CanAuthenticate makes a test authenticated request to the RightScale API and returns an error
 // if it fails.
 func (a *API) CanAuthenticate() error {
@@ -0,0 +1,109 @@
+package rsapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RubyTimeLayout is the layout of the date and time values returned by the RightScale CM API 1.5,
+// e.g. "2011/06/25 00:00:00 +0000". It is also the format the API expects for date parameters.
+const RubyTimeLayout = "2006/01/02 15:04:05 -0700"
+
+// timeLayouts lists the layouts of the date and time values returned by the RightScale APIs.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	RubyTimeLayout,
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// Time is the type of the date and time attributes and parameters of the generated API clients.
+// It wraps time.Time and adds the ability to unmarshal all the date and time formats used by the
+// RightScale APIs. Time values are marshaled using RFC 3339 so that they round-trip through JSON.
+// Top level parameters are formatted using the API TimeLayout when building requests.
+type Time struct {
+	time.Time
+}
+
+// NewTime returns a pointer to the Time wrapping t, convenient to initialize parameters.
+func NewTime(t time.Time) *Time {
+	return &Time{t}
+}
+
+// ParseTime parses a date and time value using any of the formats used by the RightScale APIs.
+func ParseTime(value string) (*Time, error) {
+	value = strings.TrimSpace(value)
+	for _, l := range timeLayouts {
+		if t, err := time.Parse(l, value); err == nil {
+			return &Time{t}, nil
+		}
+	}
+	return nil, fmt.Errorf("Invalid date and time value '%s', value must use RFC 3339 or the YYYY/MM/DD HH:MM:SS [+/-]ZZZZ format", value)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t Time) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Time.Format(time.RFC3339Nano))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, null leaves the value unchanged.
+func (t *Time) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("Invalid date and time value %s, value must be a string", b)
+	}
+	parsed, err := ParseTime(s)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed.Time
+	return nil
+}
+
+// FormatTime formats the given time using the API TimeLayout, RFC 3339 if blank.
+func (a *API) FormatTime(t time.Time) string {
+	if a.TimeLayout == "" {
+		return t.Format(time.RFC3339)
+	}
+	return t.Format(a.TimeLayout)
+}
+
+// formatTimes returns a copy of params where the Time values are replaced with their string
+// representation formatted using the API TimeLayout and nil Time pointers are removed. It returns
+// params if there is no Time value.
+func (a *API) formatTimes(params APIParams) APIParams {
+	res := params
+	copied := false
+	for n, v := range params {
+		var t *Time
+		switch tv := v.(type) {
+		case *Time:
+			t = tv
+		case Time:
+			t = &tv
+		default:
+			continue
+		}
+		if !copied {
+			res = make(APIParams, len(params))
+			for k, e := range params {
+				res[k] = e
+			}
+			copied = true
+		}
+		if t == nil {
+			delete(res, n)
+		} else {
+			res[n] = a.FormatTime(t.Time)
+		}
+	}
+	return res
+}
@@ -0,0 +1,93 @@
+package rsapi_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rightscale/rsc/rsapi"
+)
+
+var _ = Describe("Time", func() {
+	expected := time.Date(2015, 4, 8, 22, 32, 21, 0, time.UTC)
+
+	Describe("ParseTime", func() {
+		for _, value := range []string{
+			"2015/04/08 22:32:21 +0000",
+			"2015-04-08T22:32:21Z",
+			"2015-04-08T22:32:21.000Z",
+			"2015-04-08T15:32:21-07:00",
+			"2015-04-08 22:32:21 +0000",
+			"2015-04-08 22:32:21 UTC",
+		} {
+			v := value
+			It("parses "+v, func() {
+				t, err := rsapi.ParseTime(v)
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(t.Equal(expected)).Should(BeTrue())
+			})
+		}
+
+		It("rejects invalid values", func() {
+			_, err := rsapi.ParseTime("yesterday")
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+
+	Describe("JSON", func() {
+		type resource struct {
+			UpdatedAt *rsapi.Time `json:"updated_at,omitempty"`
+		}
+
+		It("unmarshals API values", func() {
+			var r resource
+			err := json.Unmarshal([]byte(`{"updated_at":"2015/04/08 22:32:21 +0000"}`), &r)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(r.UpdatedAt.Equal(expected)).Should(BeTrue())
+		})
+
+		It("unmarshals null", func() {
+			var r resource
+			err := json.Unmarshal([]byte(`{"updated_at":null}`), &r)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(r.UpdatedAt).Should(BeNil())
+		})
+
+		It("round-trips", func() {
+			r := resource{UpdatedAt: rsapi.NewTime(expected)}
+			b, err := json.Marshal(&r)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(b)).Should(Equal(`{"updated_at":"2015-04-08T22:32:21Z"}`))
+			var decoded resource
+			Ω(json.Unmarshal(b, &decoded)).Should(Succeed())
+			Ω(decoded.UpdatedAt.Equal(expected)).Should(BeTrue())
+		})
+	})
+
+	Describe("BuildHTTPRequest", func() {
+		var api *rsapi.API
+
+		BeforeEach(func() {
+			api = rsapi.New("test.com", nil)
+		})
+
+		It("formats query parameters using RFC 3339 by default", func() {
+			params := rsapi.APIParams{"since": rsapi.NewTime(expected)}
+			req, err := api.BuildHTTPRequest("GET", "/items", "1.0", params, nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(req.URL.Query().Get("since")).Should(Equal("2015-04-08T22:32:21Z"))
+		})
+
+		It("formats parameters using the API time layout", func() {
+			api.TimeLayout = rsapi.RubyTimeLayout
+			payload := rsapi.APIParams{"start_date": rsapi.NewTime(expected)}
+			req, err := api.BuildHTTPRequest("GET", "/api/audit_entries", "1.5", nil, payload)
+			Ω(err).ShouldNot(HaveOccurred())
+			body, err := ioutil.ReadAll(req.Body)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(body)).Should(Equal(`{"start_date":"2015/04/08 22:32:21 +0000"}`))
+		})
+	})
+})
@@ -0,0 +1,249 @@
+package rsapi
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ProgressFunc is the signature of the callback invoked while file uploads are being sent.
+// name is the name of the uploaded file, sent the number of bytes sent so far and total the size
+// of the file. total is -1 if the size of the file is not known in advance in which case the last
+// call is made with total equal to sent once the file has been sent completely.
+type ProgressFunc func(name string, sent, total int64)
+
+// uploadBody is a multipart request body that streams the file uploads through a pipe rather than
+// loading them in memory. The body can be rewound (see RewindRequest) if all the upload readers
+// implement io.Seeker.
+type uploadBody struct {
+	payload  []byte        // JSON encoded payload fields, nil if none
+	uploads  []*FileUpload // File uploads, one part each
+	offsets  []int64       // Initial offsets of upload readers, -1 if reader is not seekable
+	sizes    []int64       // Size of uploads, -1 if unknown
+	progress ProgressFunc  // Progress callback, may be nil
+
+	once   sync.Once         // Used to start writing to the pipe on first read
+	done   chan struct{}     // Closed when writing to the pipe completes
+	reader *io.PipeReader    // Reader side of pipe
+	writer *io.PipeWriter    // Writer side of pipe
+	mw     *multipart.Writer // Multipart writer writing to pipe
+}
+
+// newUploadBody creates a multipart body for the given payload fields and file uploads.
+func newUploadBody(payload []byte, uploads []*FileUpload, progress ProgressFunc) *uploadBody {
+	b := uploadBody{
+		payload:  payload,
+		uploads:  uploads,
+		offsets:  make([]int64, len(uploads)),
+		sizes:    make([]int64, len(uploads)),
+		progress: progress,
+	}
+	for i, u := range uploads {
+		b.offsets[i], b.sizes[i] = -1, -1
+		s, ok := u.Reader.(io.Seeker)
+		if !ok {
+			continue
+		}
+		cur, err := s.Seek(0, 1)
+		if err != nil {
+			continue
+		}
+		end, err := s.Seek(0, 2)
+		if err != nil {
+			continue
+		}
+		if _, err := s.Seek(cur, 0); err != nil {
+			continue
+		}
+		b.offsets[i], b.sizes[i] = cur, end-cur
+	}
+	b.reset()
+	return &b
+}
+
+// RewindRequest prepares a request built with BuildHTTPRequest so that it can be sent again, for
+// example after a network error. Requests with file uploads can only be rewound if all the upload
+// readers implement io.Seeker, RewindRequest returns an error otherwise.
+func RewindRequest(req *http.Request) error {
+	b, ok := req.Body.(*uploadBody)
+	if !ok {
+		return fmt.Errorf("request body cannot be rewound")
+	}
+	if err := b.rewind(); err != nil {
+		return err
+	}
+	// The multipart boundary changes but always has the same length so the content length stays
+	// the same.
+	req.Header.Set("Content-Type", b.contentType())
+	return nil
+}
+
+// Read reads from the pipe, it starts the goroutine writing to the pipe on the first call.
+func (b *uploadBody) Read(p []byte) (int, error) {
+	b.once.Do(func() { go b.write(b.mw, b.writer, b.done) })
+	return b.reader.Read(p)
+}
+
+// Close closes the pipe causing the goroutine writing to it if any to stop.
+func (b *uploadBody) Close() error {
+	return b.reader.Close()
+}
+
+// contentType returns the value of the Content-Type header for requests using the body.
+func (b *uploadBody) contentType() string {
+	return fmt.Sprintf("multipart/form-data; boundary=%s", b.mw.Boundary())
+}
+
+// contentLength returns the size of the body or -1 if the size of one of the uploads is unknown.
+func (b *uploadBody) contentLength() int64 {
+	var total int64
+	for _, s := range b.sizes {
+		if s < 0 {
+			return -1
+		}
+		total += s
+	}
+	// Measure the multipart envelope by writing it with empty files, boundaries always have the
+	// same length.
+	var counter countWriter
+	w := multipart.NewWriter(&counter)
+	if err := b.writeParts(w, func(io.Writer, int) error { return nil }); err != nil {
+		return -1
+	}
+	return total + counter.count
+}
+
+// rewind seeks the upload readers back to their initial offsets and resets the pipe so that the
+// body can be sent again.
+func (b *uploadBody) rewind() error {
+	for i, u := range b.uploads {
+		if b.offsets[i] < 0 {
+			return fmt.Errorf("cannot rewind upload of %s, reader is not seekable", u.Filename)
+		}
+	}
+	b.reader.Close()
+	started := true
+	b.once.Do(func() { started = false })
+	if started {
+		<-b.done // Wait for writer to stop reading uploads
+	}
+	for i, u := range b.uploads {
+		if _, err := u.Reader.(io.Seeker).Seek(b.offsets[i], 0); err != nil {
+			return fmt.Errorf("failed to rewind upload of %s: %s", u.Filename, err)
+		}
+	}
+	b.reset()
+	return nil
+}
+
+// reset creates a new pipe and multipart writer.
+func (b *uploadBody) reset() {
+	b.reader, b.writer = io.Pipe()
+	b.mw = multipart.NewWriter(b.writer)
+	b.once = sync.Once{}
+	b.done = make(chan struct{})
+}
+
+// write writes the multipart body to the pipe, it runs in its own goroutine and closes done when
+// it returns.
+func (b *uploadBody) write(mw *multipart.Writer, pw *io.PipeWriter, done chan struct{}) {
+	defer close(done)
+	err := b.writeParts(mw, func(w io.Writer, i int) error {
+		u := b.uploads[i]
+		var r io.Reader = u.Reader
+		if b.progress != nil {
+			r = &progressReader{Reader: u.Reader, name: u.Filename, total: b.sizes[i], progress: b.progress}
+		}
+		if _, err := io.Copy(w, r); err != nil {
+			return fmt.Errorf("failed to copy multipart file: %s", err)
+		}
+		if b.progress != nil && b.sizes[i] < 0 {
+			sent := r.(*progressReader).sent
+			b.progress(u.Filename, sent, sent)
+		}
+		return nil
+	})
+	pw.CloseWithError(err)
+}
+
+// writeParts writes the payload and file parts to the given multipart writer. It calls copyFile
+// to write the content of each file.
+func (b *uploadBody) writeParts(w *multipart.Writer, copyFile func(io.Writer, int) error) error {
+	if b.payload != nil {
+		p, err := w.CreateFormField("payload")
+		if err != nil {
+			return fmt.Errorf("failed to create multipart payload: %s", err)
+		}
+		if _, err := p.Write(b.payload); err != nil {
+			return fmt.Errorf("failed to copy multipart payload: %s", err)
+		}
+	}
+	for i, u := range b.uploads {
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			quoteEscaper.Replace(u.Name), quoteEscaper.Replace(u.Filename)))
+		h.Set("Content-Type", u.contentType())
+		p, err := w.CreatePart(h)
+		if err != nil {
+			return fmt.Errorf("failed to create multipart file: %s", err)
+		}
+		if err := copyFile(p, i); err != nil {
+			return err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to close multipart body: %s", err)
+	}
+	return nil
+}
+
+// Escapes quotes in multipart header values, same as mime/multipart.
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// contentType returns the content type of the upload, defaulting to the type matching the file
+// extension or "application/octet-stream".
+func (u *FileUpload) contentType() string {
+	if u.ContentType != "" {
+		return u.ContentType
+	}
+	if t := mime.TypeByExtension(filepath.Ext(u.Filename)); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+// progressReader is a reader that reports progress as it gets read.
+type progressReader struct {
+	io.Reader
+	name     string
+	sent     int64
+	total    int64
+	progress ProgressFunc
+}
+
+// Read reads from the underlying reader and reports progress.
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if n > 0 {
+		r.sent += int64(n)
+		r.progress(r.name, r.sent, r.total)
+	}
+	return n, err
+}
+
+// countWriter counts the bytes written to it.
+type countWriter struct {
+	count int64
+}
+
+// Write counts the bytes.
+func (c *countWriter) Write(p []byte) (int, error) {
+	c.count += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,120 @@
+package rsapi_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rightscale/rsc/rsapi"
+)
+
+var _ = Describe("File uploads", func() {
+	var (
+		reader   io.Reader
+		progress [][]int64
+		req      *http.Request
+		err      error
+	)
+
+	JustBeforeEach(func() {
+		progress = nil
+		api := rsapi.New("test.com", nil)
+		api.UploadProgress = func(name string, sent, total int64) {
+			Ω(name).Should(Equal("cat.rb"))
+			progress = append(progress, []int64{sent, total})
+		}
+		payload := rsapi.APIParams{
+			"name": "test",
+			"source": &rsapi.FileUpload{Name: "source", Filename: "cat.rb", Reader: reader,
+				ContentType: "text/x-ruby"},
+		}
+		req, err = api.BuildHTTPRequest("POST", "/templates", "1.0", nil, payload)
+	})
+
+	// readParts reads the request body and returns the parts content indexed by name.
+	readParts := func() map[string]string {
+		body, err := ioutil.ReadAll(req.Body)
+		Ω(err).ShouldNot(HaveOccurred())
+		if req.ContentLength >= 0 {
+			Ω(int64(len(body))).Should(Equal(req.ContentLength))
+		}
+		mediaType, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(mediaType).Should(Equal("multipart/form-data"))
+		parts := make(map[string]string)
+		r := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		for {
+			p, err := r.NextPart()
+			if err == io.EOF {
+				break
+			}
+			Ω(err).ShouldNot(HaveOccurred())
+			content, err := ioutil.ReadAll(p)
+			Ω(err).ShouldNot(HaveOccurred())
+			parts[p.FormName()] = string(content)
+			if p.FormName() == "source" {
+				Ω(p.FileName()).Should(Equal("cat.rb"))
+				Ω(p.Header.Get("Content-Type")).Should(Equal("text/x-ruby"))
+			}
+		}
+		return parts
+	}
+
+	Context("with a seekable reader", func() {
+		BeforeEach(func() {
+			reader = strings.NewReader("name 'test'")
+		})
+
+		It("streams the multipart body", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(req.ContentLength).Should(BeNumerically(">", 0))
+			parts := readParts()
+			Ω(parts["payload"]).Should(MatchJSON(`{"name":"test"}`))
+			Ω(parts["source"]).Should(Equal("name 'test'"))
+			Ω(progress).Should(Equal([][]int64{{11, 11}}))
+		})
+
+		It("can be rewound", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			readParts()
+			Ω(rsapi.RewindRequest(req)).Should(Succeed())
+			parts := readParts()
+			Ω(parts["source"]).Should(Equal("name 'test'"))
+		})
+
+		It("can be rewound before being fully read", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			b := make([]byte, 10)
+			_, err := req.Body.Read(b)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(rsapi.RewindRequest(req)).Should(Succeed())
+			parts := readParts()
+			Ω(parts["source"]).Should(Equal("name 'test'"))
+		})
+	})
+
+	Context("with a reader that is not seekable", func() {
+		BeforeEach(func() {
+			reader = bytes.NewBufferString("name 'test'")
+		})
+
+		It("streams the multipart body with an unknown length", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(req.ContentLength).Should(BeNumerically("<", 0))
+			parts := readParts()
+			Ω(parts["source"]).Should(Equal("name 'test'"))
+			Ω(progress).Should(Equal([][]int64{{11, -1}, {11, 11}}))
+		})
+
+		It("cannot be rewound", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(rsapi.RewindRequest(req)).ShouldNot(Succeed())
+		})
+	})
+})
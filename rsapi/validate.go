@@ -0,0 +1,171 @@
+package rsapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rightscale/rsc/metadata"
+)
+
+// ValidationError is the error returned by ValidateParams when the parameters given to an action
+// do not satisfy the constraints described in the API metadata. It lists all the violations.
+type ValidationError struct {
+	Resource   string   // Name of resource, e.g. "Server"
+	Action     string   // Name of action, e.g. "index"
+	Violations []string // Description of each violation
+}
+
+// Error lists all the violations.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("Invalid parameters for %s.%s:\n  - %s", e.Resource, e.Action,
+		strings.Join(e.Violations, "\n  - "))
+}
+
+// filterRegexp captures the field name of filter values, e.g. "name==foo" or "name<>foo".
+var filterRegexp = regexp.MustCompile(`^([^=<>]+)(==|<>)`)
+
+// ValidateParams checks the query string and payload parameters of the given resource action
+// against the constraints defined in the API metadata (mandatory, non blank, regular expression
+// and valid values). It is called by the generated clients prior to sending requests and returns
+// a *ValidationError listing all the violations if any.
+// Validation is skipped if NoParamValidation is true or if there is no metadata for the action.
+func (a *API) ValidateParams(resource, action string, params, payload APIParams) error {
+	if a.NoParamValidation || a.Metadata == nil {
+		return nil
+	}
+	res, ok := a.Metadata[resource]
+	if !ok {
+		return nil
+	}
+	var act *metadata.Action
+	for _, ac := range res.Actions {
+		if ac.Name == action {
+			act = ac
+			break
+		}
+	}
+	if act == nil || len(act.CommandFlags) == 0 {
+		return nil
+	}
+
+	var flags []string
+	seen := make(map[string]bool)
+	for _, ps := range []APIParams{a.formatTimes(params), a.formatTimes(payload)} {
+		values := make(map[string]interface{}, len(ps))
+		for n, v := range ps {
+			switch v.(type) {
+			case *FileUpload, FileUpload:
+				seen[n] = true
+				continue
+			}
+			values[n] = v
+		}
+		// Use the JSON representation so that parameter structs get validated using the same
+		// names as the API.
+		b, err := json.Marshal(values)
+		if err != nil {
+			return nil // Request won't build anyway, let BuildHTTPRequest report the error
+		}
+		var raw map[string]interface{}
+		if err := json.Unmarshal(b, &raw); err != nil {
+			return nil
+		}
+		for n, v := range raw {
+			flags = flattenParam(n, v, flags)
+		}
+	}
+	sort.Strings(flags)
+
+	var violations []string
+	for _, f := range flags {
+		param, value, err := a.findParamAndValue(act, f)
+		if err != nil {
+			violations = append(violations, err.Error())
+			continue
+		}
+		if param == nil {
+			continue // Unknown parameter, API will decide
+		}
+		seen[param.Name] = true
+		if err := validateFlagValue(value, param); err != nil {
+			violations = append(violations, err.Error())
+			continue
+		}
+		if param.Name == "filter[]" {
+			if err := validateFilter(value, param); err != nil {
+				violations = append(violations, err.Error())
+			}
+		}
+	}
+	violations = append(violations, missingParams(act, seen)...)
+	if len(violations) > 0 {
+		return &ValidationError{Resource: resource, Action: action, Violations: violations}
+	}
+	return nil
+}
+
+// missingParams returns the violations corresponding to the mandatory parameters of the given
+// action that are not in seen.
+func missingParams(act *metadata.Action, seen map[string]bool) []string {
+	var violations []string
+	for _, p := range act.CommandFlags {
+		if !p.Mandatory || seen[p.Name] || p.Type == "bool" {
+			continue
+		}
+		if strings.Contains(p.Name, "[]") {
+			continue // Only mandatory in array elements which may not exist
+		}
+		violations = append(violations, fmt.Sprintf("Missing required parameter '%s'", p.Name))
+	}
+	return violations
+}
+
+// flattenParam appends the "NAME=VALUE" strings representing the given parameter value to flags
+// using the same names as the command line, e.g. "server[name]=foo" or "filter[]=name==foo".
+func flattenParam(name string, value interface{}, flags []string) []string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			flags = flattenParam(name+"["+k+"]", e, flags)
+		}
+	case []interface{}:
+		if !strings.HasSuffix(name, "[]") {
+			name += "[]"
+		}
+		for _, e := range v {
+			flags = flattenParam(name, e, flags)
+		}
+	case nil:
+	case string:
+		flags = append(flags, name+"="+v)
+	case float64:
+		flags = append(flags, name+"="+strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		flags = append(flags, name+"="+strconv.FormatBool(v))
+	}
+	return flags
+}
+
+// validateFilter checks that a filter value is of the form "NAME==VALUE" or "NAME<>VALUE" and
+// that NAME is one of the filters supported by the action.
+func validateFilter(value string, param *metadata.ActionParam) error {
+	m := filterRegexp.FindStringSubmatch(value)
+	if m == nil {
+		return fmt.Errorf("Invalid value '%s' for '%s', value must be of the form NAME==VALUE or NAME<>VALUE",
+			value, param.Name)
+	}
+	if len(param.ValidValues) == 0 {
+		return nil
+	}
+	for _, v := range param.ValidValues {
+		if v == m[1] {
+			return nil
+		}
+	}
+	return fmt.Errorf("Invalid filter '%s' for '%s', filter must be one of %s",
+		m[1], param.Name, strings.Join(param.ValidValues, ", "))
+}
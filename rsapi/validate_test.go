@@ -0,0 +1,156 @@
+package rsapi_test
+
+import (
+	"regexp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rightscale/rsc/metadata"
+	"github.com/rightscale/rsc/rsapi"
+)
+
+var _ = Describe("ValidateParams", func() {
+	type serverParam struct {
+		Name     string `json:"name,omitempty"`
+		CloudID  int    `json:"cloud_id,omitempty"`
+		Optimize bool   `json:"optimize,omitempty"`
+	}
+
+	var (
+		api        *rsapi.API
+		actionName string
+		params     rsapi.APIParams
+		payload    rsapi.APIParams
+		err        error
+	)
+
+	BeforeEach(func() {
+		api = rsapi.New("test.rightscale.com", nil)
+		api.Metadata = rsapi.APIMetadata{"Server": &metadata.Resource{
+			Name: "Server",
+			Actions: []*metadata.Action{
+				{
+					Name: "index",
+					CommandFlags: []*metadata.ActionParam{
+						{Name: "filter[]", Type: "[]string", Location: metadata.QueryParam, NonBlank: true,
+							ValidValues: []string{"name", "state"}},
+						{Name: "view", Type: "string", Location: metadata.QueryParam,
+							ValidValues: []string{"default", "instance_detail"}},
+					},
+				},
+				{
+					Name: "create",
+					CommandFlags: []*metadata.ActionParam{
+						{Name: "server[name]", Type: "string", Location: metadata.PayloadParam,
+							Mandatory: true, NonBlank: true},
+						{Name: "server[cloud_id]", Type: "int", Location: metadata.PayloadParam,
+							Regexp: regexp.MustCompile(`^[0-9]{1,3}$`)},
+						{Name: "server[optimize]", Type: "bool", Location: metadata.PayloadParam},
+						{Name: "server[inputs]", Type: "map", Location: metadata.PayloadParam,
+							Regexp: regexp.MustCompile(`^[A-Z_]+=text:`)},
+					},
+				},
+			},
+		}}
+		params = nil
+		payload = nil
+	})
+
+	JustBeforeEach(func() {
+		err = api.ValidateParams("Server", actionName, params, payload)
+	})
+
+	Context("with valid query parameters", func() {
+		BeforeEach(func() {
+			actionName = "index"
+			params = rsapi.APIParams{
+				"filter[]": []string{"name==foo", "state<>stopped"},
+				"view":     "instance_detail",
+			}
+		})
+
+		It("succeeds", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("with invalid query parameters", func() {
+		BeforeEach(func() {
+			actionName = "index"
+			params = rsapi.APIParams{
+				"filter[]": []string{"name=foo", "href==/api/servers/1"},
+				"view":     "full",
+			}
+		})
+
+		It("lists all the violations", func() {
+			Ω(err).Should(HaveOccurred())
+			verr, ok := err.(*rsapi.ValidationError)
+			Ω(ok).Should(BeTrue())
+			Ω(verr.Resource).Should(Equal("Server"))
+			Ω(verr.Action).Should(Equal("index"))
+			Ω(verr.Violations).Should(HaveLen(3))
+			Ω(err.Error()).Should(ContainSubstring("'name=foo'"))
+			Ω(err.Error()).Should(ContainSubstring("Invalid filter 'href'"))
+			Ω(err.Error()).Should(ContainSubstring("provided was 'full'"))
+		})
+	})
+
+	Context("with a valid payload struct", func() {
+		BeforeEach(func() {
+			actionName = "create"
+			payload = rsapi.APIParams{
+				"server": &serverParam{Name: "foo", CloudID: 42, Optimize: true},
+			}
+		})
+
+		It("succeeds", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("with an invalid payload", func() {
+		BeforeEach(func() {
+			actionName = "create"
+			payload = rsapi.APIParams{
+				"server": map[string]interface{}{
+					"cloud_id": 1234,
+					"inputs":   map[string]string{"FOO": "text:bar", "BAR": "bar"},
+				},
+			}
+		})
+
+		It("lists all the violations", func() {
+			Ω(err).Should(HaveOccurred())
+			verr, ok := err.(*rsapi.ValidationError)
+			Ω(ok).Should(BeTrue())
+			Ω(verr.Violations).Should(HaveLen(3))
+			Ω(err.Error()).Should(ContainSubstring("'1234'"))
+			Ω(err.Error()).Should(ContainSubstring("'BAR=bar'"))
+			Ω(err.Error()).Should(ContainSubstring("Missing required parameter 'server[name]'"))
+		})
+	})
+
+	Context("with validation disabled", func() {
+		BeforeEach(func() {
+			actionName = "index"
+			params = rsapi.APIParams{"view": "full"}
+			api.NoParamValidation = true
+		})
+
+		It("succeeds", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("with no metadata for the action", func() {
+		BeforeEach(func() {
+			actionName = "destroy"
+			params = rsapi.APIParams{"view": "full"}
+		})
+
+		It("succeeds", func() {
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+	})
+})
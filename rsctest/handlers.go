@@ -0,0 +1,739 @@
+package rsctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sessionCookie is the name of the cookie that holds the session created by basic and instance
+// authentication.
+const sessionCookie = "rs_gbl"
+
+// defaultCloud is the href of the cloud instances get launched in when none is specified.
+const defaultCloud = "/api/clouds/1"
+
+// handler is the signature of route handlers.
+type handler func(http.ResponseWriter, *request)
+
+// buildRoutes returns the routes handled by the server.
+func (s *Server) buildRoutes() []*route {
+	routes := []struct {
+		method, path string
+		handler      handler
+	}{
+		{"POST", `^/api/sessions$`, s.createSession},
+		{"GET", `^/api/sessions$`, s.showSession},
+		{"POST", `^/api/session/instance$`, s.createInstanceSession},
+		{"GET", `^/api/user_data$`, s.showUserData},
+		{"POST", `^/api/oauth2$`, s.createAccessToken},
+
+		{"GET", `^/api/deployments$`, s.index("deployment", nil)},
+		{"POST", `^/api/deployments$`, s.create("deployment", s.createDeployment)},
+		{"GET", `^/api/deployments/[^/]+$`, s.show("deployment")},
+		{"PUT", `^/api/deployments/[^/]+$`, s.update("deployment")},
+		{"DELETE", `^/api/deployments/[^/]+$`, s.destroy("deployment", s.checkDeploymentEmpty)},
+		{"GET", `^(/api/deployments/[^/]+)/servers$`, s.index("server", s.inDeployment)},
+		{"GET", `^(/api/deployments/[^/]+)/server_arrays$`, s.index("server_array", s.inDeployment)},
+
+		{"GET", `^/api/servers$`, s.index("server", nil)},
+		{"POST", `^/api/servers$`, s.create("server", s.createServer)},
+		{"GET", `^/api/servers/[^/]+$`, s.show("server")},
+		{"PUT", `^/api/servers/[^/]+$`, s.update("server")},
+		{"DELETE", `^/api/servers/[^/]+$`, s.destroy("server", s.checkNotRunning)},
+		{"POST", `^(/api/servers/[^/]+)/launch$`, s.action("server", s.launchServerAction)},
+		// The CM 1.5 API metadata (and thus the generated client) uses "teminate".
+		{"POST", `^(/api/servers/[^/]+)/te(?:r)?minate$`, s.action("server", s.terminateServerAction)},
+
+		{"GET", `^/api/server_arrays$`, s.index("server_array", nil)},
+		{"POST", `^/api/server_arrays$`, s.create("server_array", s.createServerArray)},
+		{"GET", `^/api/server_arrays/[^/]+$`, s.show("server_array")},
+		{"PUT", `^/api/server_arrays/[^/]+$`, s.update("server_array")},
+		{"DELETE", `^/api/server_arrays/[^/]+$`, s.destroy("server_array", s.checkNotRunning)},
+		{"POST", `^(/api/server_arrays/[^/]+)/launch$`, s.action("server_array", s.launchServerArrayAction)},
+		{"POST", `^(/api/server_arrays/[^/]+)/multi_terminate$`, s.action("server_array", s.multiTerminateAction)},
+		{"GET", `^(/api/server_arrays/[^/]+)/current_instances$`, s.index("instance", s.currentInstances)},
+
+		{"GET", `^/api/clouds/([^/]+)/instances$`, s.index("instance", s.inCloud)},
+		{"GET", `^/api/clouds/[^/]+/instances/[^/]+$`, s.show("instance")},
+		{"POST", `^(/api/clouds/[^/]+/instances/[^/]+)/terminate$`, s.action("instance", s.terminateInstanceAction)},
+
+		{"POST", `^/api/tags/multi_add$`, s.multiAddTags},
+		{"POST", `^/api/tags/multi_delete$`, s.multiDeleteTags},
+		{"POST", `^/api/tags/by_resource$`, s.tagsByResource},
+		{"POST", `^/api/tags/by_tag$`, s.tagsByTag},
+
+		{"GET", `^/api/audit_entries$`, s.indexAuditEntries},
+		{"POST", `^/api/audit_entries$`, s.createAuditEntryHandler},
+		{"GET", `^/api/audit_entries/[^/]+$`, s.show("audit_entry")},
+		{"PUT", `^/api/audit_entries/[^/]+$`, s.action("audit_entry", s.updateAuditEntryAction)},
+		{"POST", `^(/api/audit_entries/[^/]+)/append$`, s.action("audit_entry", s.appendAuditEntryAction)},
+		{"GET", `^(/api/audit_entries/[^/]+)/detail$`, s.showAuditEntryDetail},
+	}
+	res := make([]*route, len(routes))
+	for i, r := range routes {
+		res[i] = &route{method: r.method, regexp: regexp.MustCompile(r.path), handler: r.handler}
+	}
+	return res
+}
+
+/****** Generic handlers ******/
+
+// index returns a handler that lists the resources of the given kind. scope computes the function
+// used to select the resources from the request, it may be nil in which case all resources are
+// listed. The handler applies the "filter[]" and "view" parameters.
+func (s *Server) index(kind string, scope func(*request) (func(*resource) bool, error)) handler {
+	return func(w http.ResponseWriter, r *request) {
+		var sc func(*resource) bool
+		if scope != nil {
+			var err error
+			if sc, err = scope(r); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+		res, err := filter(s.list(kind, sc), arrayParam(r.params, "filter"))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, kind, true, s.renderList(res, stringParam(r.params, "view")))
+	}
+}
+
+// create returns a handler that creates a resource using the given function. The function is
+// given the resource payload, e.g. the value of the "server" parameter for servers.
+func (s *Server) create(kind string, fn func(map[string]interface{}) (*resource, error)) handler {
+	return func(w http.ResponseWriter, r *request) {
+		res, err := fn(mapParam(r.params, kind))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeCreated(w, res.href)
+	}
+}
+
+// show returns a handler that renders the resource of the given kind identified by the request
+// path.
+func (s *Server) show(kind string) handler {
+	return func(w http.ResponseWriter, r *request) {
+		res, err := s.lookup(kind, r.URL.Path)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, kind, false, s.render(res, stringParam(r.params, "view")))
+	}
+}
+
+// update returns a handler that updates the attributes of the resource of the given kind
+// identified by the request path. Payload fields ending with "_href" update the corresponding
+// links.
+func (s *Server) update(kind string) handler {
+	return func(w http.ResponseWriter, r *request) {
+		res, err := s.lookup(kind, r.URL.Path)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		for k, v := range mapParam(r.params, kind) {
+			switch val := v.(type) {
+			case map[string]interface{}, []interface{}:
+				continue
+			case string:
+				if strings.HasSuffix(k, "_href") {
+					if _, ok := s.resources[val]; !ok {
+						writeError(w, unprocessable("Resource %s does not exist", val))
+						return
+					}
+					res.links[strings.TrimSuffix(k, "_href")] = val
+					continue
+				}
+			}
+			res.attrs[k] = v
+		}
+		res.updatedAt = time.Now().UTC()
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// destroy returns a handler that deletes the resource of the given kind identified by the request
+// path. check is called prior to deleting the resource and may prevent the deletion by returning
+// an error.
+func (s *Server) destroy(kind string, check func(*resource) error) handler {
+	return func(w http.ResponseWriter, r *request) {
+		res, err := s.lookup(kind, r.URL.Path)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if err := check(res); err != nil {
+			writeError(w, err)
+			return
+		}
+		s.remove(res.href)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// action returns a handler that runs the given function on the resource of the given kind whose
+// href is the first submatch of the route regexp or the request path if none. The handler
+// responds with 201 and a Location header if the function returns a non empty href, 204
+// otherwise.
+func (s *Server) action(kind string, fn func(*resource, *request) (string, error)) handler {
+	return func(w http.ResponseWriter, r *request) {
+		href := r.vars[0]
+		if len(r.vars) > 1 {
+			href = r.vars[1]
+		}
+		res, err := s.lookup(kind, href)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		loc, err := fn(res, r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if loc != "" {
+			writeCreated(w, loc)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+/****** Sessions ******/
+
+// createSession creates a session if the email and password match the server credentials.
+func (s *Server) createSession(w http.ResponseWriter, r *request) {
+	email := stringParam(r.params, "email")
+	if email != s.Email || stringParam(r.params, "password") != s.Password {
+		writeError(w, &httpError{http.StatusUnauthorized, "Unauthorized: invalid email or password"})
+		return
+	}
+	s.login(w, email)
+}
+
+// createInstanceSession creates a session using an instance API token, any non empty token is
+// accepted.
+func (s *Server) createInstanceSession(w http.ResponseWriter, r *request) {
+	if stringParam(r.params, "instance_token") == "" {
+		writeError(w, &httpError{http.StatusUnauthorized, "Unauthorized: missing instance token"})
+		return
+	}
+	s.login(w, s.Email)
+}
+
+// login sets the session cookie.
+func (s *Server) login(w http.ResponseWriter, email string) {
+	http.SetCookie(w, &http.Cookie{Name: sessionCookie, Value: s.newSession("session", email), Path: "/"})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// showSession is used by clients to check that they can authenticate.
+func (s *Server) showSession(w http.ResponseWriter, r *request) {
+	writeJSON(w, "session", false, map[string]interface{}{
+		"message": "You have successfully logged into the RightScale API.",
+	})
+}
+
+// showUserData is used by clients authenticated with an instance token to check that they can
+// authenticate.
+func (s *Server) showUserData(w http.ResponseWriter, r *request) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+}
+
+// createAccessToken creates an OAuth access token if the refresh token matches the server refresh
+// token.
+func (s *Server) createAccessToken(w http.ResponseWriter, r *request) {
+	w.Header().Set("Content-Type", "application/json")
+	if stringParam(r.params, "grant_type") != "refresh_token" ||
+		stringParam(r.params, "refresh_token") != s.RefreshToken {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+	b, _ := json.Marshal(map[string]interface{}{
+		"access_token": s.newSession("access-token", s.Email),
+		"expires_in":   7200,
+		"token_type":   "bearer",
+	})
+	w.WriteHeader(http.StatusOK)
+	w.Write(b)
+}
+
+/****** Deployments ******/
+
+// createDeployment creates a deployment from the given payload.
+func (s *Server) createDeployment(p map[string]interface{}) (*resource, error) {
+	name := stringParam(p, "name")
+	if name == "" {
+		return nil, unprocessable("Deployment name cannot be blank")
+	}
+	scope := stringParam(p, "server_tag_scope")
+	if scope == "" {
+		scope = "deployment"
+	}
+	href := "/api/deployments/" + s.nextID()
+	r := s.add("deployment", href, map[string]interface{}{
+		"name":             name,
+		"description":      stringParam(p, "description"),
+		"server_tag_scope": scope,
+	})
+	r.links["servers"] = href + "/servers"
+	r.links["server_arrays"] = href + "/server_arrays"
+	return r, nil
+}
+
+// inDeployment selects the resources that belong to the deployment identified by the request.
+func (s *Server) inDeployment(r *request) (func(*resource) bool, error) {
+	if _, err := s.lookup("deployment", r.vars[1]); err != nil {
+		return nil, err
+	}
+	return linkedTo("deployment", r.vars[1]), nil
+}
+
+// checkDeploymentEmpty prevents the deletion of deployments that contain servers or server
+// arrays.
+func (s *Server) checkDeploymentEmpty(r *resource) error {
+	n := len(s.list("server", linkedTo("deployment", r.href))) +
+		len(s.list("server_array", linkedTo("deployment", r.href)))
+	if n > 0 {
+		return unprocessable("Deployment %s cannot be destroyed, it contains %d servers or server arrays",
+			r.href, n)
+	}
+	return nil
+}
+
+/****** Servers and server arrays ******/
+
+// createServer creates a server from the given payload.
+func (s *Server) createServer(p map[string]interface{}) (*resource, error) {
+	attrs := map[string]interface{}{"state": "inactive"}
+	return s.createLaunchable("server", "/api/servers/", attrs, p)
+}
+
+// createServerArray creates a server array from the given payload.
+func (s *Server) createServerArray(p map[string]interface{}) (*resource, error) {
+	state := stringParam(p, "state")
+	if state == "" {
+		state = "enabled"
+	}
+	arrayType := stringParam(p, "array_type")
+	if arrayType == "" {
+		arrayType = "alert"
+	}
+	attrs := map[string]interface{}{"state": state, "array_type": arrayType, "instances_count": 0}
+	r, err := s.createLaunchable("server_array", "/api/server_arrays/", attrs, p)
+	if err != nil {
+		return nil, err
+	}
+	r.links["current_instances"] = r.href + "/current_instances"
+	return r, nil
+}
+
+// createLaunchable creates a server or server array from the given payload.
+func (s *Server) createLaunchable(kind, prefix string, attrs, p map[string]interface{}) (*resource, error) {
+	name := stringParam(p, "name")
+	if name == "" {
+		return nil, unprocessable("%s name cannot be blank", kindNames[kind])
+	}
+	deployment := stringParam(p, "deployment_href")
+	if deployment != "" {
+		if _, err := s.lookup("deployment", deployment); err != nil {
+			return nil, unprocessable("Deployment %s does not exist", deployment)
+		}
+	}
+	attrs["name"] = name
+	attrs["description"] = stringParam(p, "description")
+	r := s.add(kind, prefix+s.nextID(), attrs)
+	if deployment != "" {
+		r.links["deployment"] = deployment
+	}
+	instance := mapParam(p, "instance")
+	if t := stringParam(instance, "server_template_href"); t != "" {
+		r.links["server_template"] = t
+	}
+	r.cloud = stringParam(instance, "cloud_href")
+	if r.cloud == "" {
+		r.cloud = defaultCloud
+	}
+	return r, nil
+}
+
+// launchServerAction launches the server and returns the href of the new instance.
+func (s *Server) launchServerAction(r *resource, _ *request) (string, error) {
+	i, err := s.launchServer(r)
+	if err != nil {
+		return "", err
+	}
+	return i.href, nil
+}
+
+// launchServer creates the current instance of the given server.
+func (s *Server) launchServer(r *resource) (*resource, error) {
+	if len(s.list("instance", isCurrentInstanceOf(r.href))) > 0 {
+		return nil, unprocessable("Server %s is already launched", r.href)
+	}
+	i := s.launchInstance(r, fmt.Sprintf("%v", r.attrs["name"]))
+	r.links["current_instance"] = i.href
+	r.attrs["state"] = "operational"
+	r.updatedAt = i.createdAt
+	return i, nil
+}
+
+// terminateServerAction terminates the current instance of the server.
+func (s *Server) terminateServerAction(r *resource, _ *request) (string, error) {
+	i, ok := s.resources[r.links["current_instance"]]
+	if !ok {
+		return "", unprocessable("Server %s is not running", r.href)
+	}
+	s.terminateInstance(i)
+	return "", nil
+}
+
+// launchServerArrayAction launches a new instance in the server array and returns its href.
+func (s *Server) launchServerArrayAction(r *resource, _ *request) (string, error) {
+	i, err := s.launchServerArray(r)
+	if err != nil {
+		return "", err
+	}
+	return i.href, nil
+}
+
+// launchServerArray launches a new instance in the given server array, instances are named after
+// the array followed by their number, e.g. "App #2".
+func (s *Server) launchServerArray(r *resource) (*resource, error) {
+	if r.attrs["state"] == "disabled" {
+		return nil, unprocessable("Server array %s is disabled", r.href)
+	}
+	r.launched++
+	i := s.launchInstance(r, fmt.Sprintf("%v #%d", r.attrs["name"], r.launched))
+	r.attrs["instances_count"] = len(s.list("instance", isCurrentInstanceOf(r.href)))
+	r.updatedAt = i.createdAt
+	return i, nil
+}
+
+// multiTerminateAction terminates all the current instances of the server array.
+func (s *Server) multiTerminateAction(r *resource, _ *request) (string, error) {
+	for _, i := range s.list("instance", isCurrentInstanceOf(r.href)) {
+		s.terminateInstance(i)
+	}
+	return "", nil
+}
+
+// currentInstances selects the current instances of the server array identified by the request.
+func (s *Server) currentInstances(r *request) (func(*resource) bool, error) {
+	if _, err := s.lookup("server_array", r.vars[1]); err != nil {
+		return nil, err
+	}
+	return isCurrentInstanceOf(r.vars[1]), nil
+}
+
+// checkNotRunning prevents the deletion of servers and server arrays that have running instances.
+func (s *Server) checkNotRunning(r *resource) error {
+	if len(s.list("instance", isCurrentInstanceOf(r.href))) > 0 {
+		return unprocessable("%s %s has running instances, terminate them first",
+			kindNames[r.kind], r.href)
+	}
+	return nil
+}
+
+/****** Instances ******/
+
+// launchInstance creates an operational instance launched by the given server or server array.
+func (s *Server) launchInstance(parent *resource, name string) *resource {
+	id := s.nextID()
+	n := len(s.list("instance", nil)) + 1
+	i := s.add("instance", fmt.Sprintf("%s/instances/%s", parent.cloud, id), map[string]interface{}{
+		"name":                 name,
+		"state":                "operational",
+		"resource_uid":         "i-rsctest" + id,
+		"public_ip_addresses":  []string{fmt.Sprintf("203.0.113.%d", (n-1)%254+1)},
+		"private_ip_addresses": []string{fmt.Sprintf("10.0.0.%d", (n-1)%254+1)},
+	})
+	i.links["cloud"] = parent.cloud
+	i.links["parent"] = parent.href
+	for _, rel := range []string{"deployment", "server_template"} {
+		if h, ok := parent.links[rel]; ok {
+			i.links[rel] = h
+		}
+	}
+	return i
+}
+
+// inCloud selects the instances of the cloud identified by the request.
+func (s *Server) inCloud(r *request) (func(*resource) bool, error) {
+	return linkedTo("cloud", "/api/clouds/"+r.vars[1]), nil
+}
+
+// terminateInstanceAction terminates the instance.
+func (s *Server) terminateInstanceAction(r *resource, _ *request) (string, error) {
+	if r.attrs["state"] == "terminated" {
+		return "", unprocessable("Instance %s is already terminated", r.href)
+	}
+	s.terminateInstance(r)
+	return "", nil
+}
+
+// terminateInstance terminates the given instance and updates the server or server array that
+// launched it.
+func (s *Server) terminateInstance(i *resource) {
+	now := time.Now().UTC()
+	i.attrs["state"] = "terminated"
+	i.updatedAt = now
+	parent, ok := s.resources[i.links["parent"]]
+	if !ok {
+		return
+	}
+	switch parent.kind {
+	case "server":
+		if parent.links["current_instance"] == i.href {
+			delete(parent.links, "current_instance")
+			parent.attrs["state"] = "inactive"
+		}
+	case "server_array":
+		parent.attrs["instances_count"] = len(s.list("instance", isCurrentInstanceOf(parent.href)))
+	}
+	parent.updatedAt = now
+}
+
+/****** Tags ******/
+
+// tagResourceTypes lists the kinds of resources that can be looked up by tag indexed by the
+// values of the by_tag "resource_type" parameter.
+var tagResourceTypes = map[string]string{
+	"deployments":   "deployment",
+	"instances":     "instance",
+	"servers":       "server",
+	"server_arrays": "server_array",
+}
+
+// taggedResources returns the resources identified by the "resource_hrefs[]" parameter.
+func (s *Server) taggedResources(r *request) ([]*resource, error) {
+	hrefs := arrayParam(r.params, "resource_hrefs")
+	if len(hrefs) == 0 {
+		return nil, unprocessable("resource_hrefs cannot be blank")
+	}
+	res := make([]*resource, len(hrefs))
+	for i, h := range hrefs {
+		rs, ok := s.resources[h]
+		if !ok {
+			return nil, unprocessable("Resource %s does not exist", h)
+		}
+		res[i] = rs
+	}
+	return res, nil
+}
+
+// multiAddTags adds the "tags[]" to the resources identified by "resource_hrefs[]".
+func (s *Server) multiAddTags(w http.ResponseWriter, r *request) {
+	resources, err := s.taggedResources(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	for _, rs := range resources {
+		for _, t := range arrayParam(r.params, "tags") {
+			if !hasTag(rs, t) {
+				rs.tags = append(rs.tags, t)
+			}
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// multiDeleteTags removes the "tags[]" from the resources identified by "resource_hrefs[]".
+func (s *Server) multiDeleteTags(w http.ResponseWriter, r *request) {
+	resources, err := s.taggedResources(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	del := arrayParam(r.params, "tags")
+	for _, rs := range resources {
+		var tags []string
+		for _, t := range rs.tags {
+			if !contains(del, t) {
+				tags = append(tags, t)
+			}
+		}
+		rs.tags = tags
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// tagsByResource lists the tags of the resources identified by "resource_hrefs[]".
+func (s *Server) tagsByResource(w http.ResponseWriter, r *request) {
+	resources, err := s.taggedResources(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, "resource_tag", true, renderTags(resources))
+}
+
+// tagsByTag lists the resources of type "resource_type" that have any of the "tags[]" or all of
+// them if "match_all" is true.
+func (s *Server) tagsByTag(w http.ResponseWriter, r *request) {
+	kind, ok := tagResourceTypes[stringParam(r.params, "resource_type")]
+	if !ok {
+		writeError(w, unprocessable("Invalid resource_type '%s'", stringParam(r.params, "resource_type")))
+		return
+	}
+	tags := arrayParam(r.params, "tags")
+	if len(tags) == 0 {
+		writeError(w, unprocessable("tags cannot be blank"))
+		return
+	}
+	matchAll := stringParam(r.params, "match_all") == "true"
+	resources := s.list(kind, func(rs *resource) bool {
+		for _, t := range tags {
+			if hasTag(rs, t) != matchAll {
+				return !matchAll
+			}
+		}
+		return matchAll
+	})
+	writeJSON(w, "resource_tag", true, renderTags(resources))
+}
+
+// renderTags returns the JSON representation of the tags of the given resources.
+func renderTags(resources []*resource) []map[string]interface{} {
+	res := make([]map[string]interface{}, len(resources))
+	for i, r := range resources {
+		tags := make([]map[string]string, len(r.tags))
+		for j, t := range r.tags {
+			tags[j] = map[string]string{"name": t}
+		}
+		res[i] = map[string]interface{}{
+			"actions": []interface{}{},
+			"links":   []map[string]string{{"rel": "resource", "href": r.href}},
+			"tags":    tags,
+		}
+	}
+	return res
+}
+
+// hasTag returns true if the resource has the given tag.
+func hasTag(r *resource, tag string) bool {
+	return contains(r.tags, tag)
+}
+
+// contains returns true if the given slice contains the given string.
+func contains(values []string, val string) bool {
+	for _, v := range values {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
+
+/****** Audit entries ******/
+
+// createAuditEntryHandler creates an audit entry on behalf of the user that made the request.
+func (s *Server) createAuditEntryHandler(w http.ResponseWriter, r *request) {
+	e, err := s.createAuditEntry(mapParam(r.params, "audit_entry"), r.email)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeCreated(w, e.href)
+}
+
+// createAuditEntry creates an audit entry from the given payload.
+func (s *Server) createAuditEntry(p map[string]interface{}, userEmail string) (*resource, error) {
+	auditee := stringParam(p, "auditee_href")
+	if _, ok := s.resources[auditee]; !ok {
+		return nil, unprocessable("Auditee %s does not exist", auditee)
+	}
+	summary := stringParam(p, "summary")
+	if summary == "" {
+		return nil, unprocessable("Audit entry summary cannot be blank")
+	}
+	detail := stringParam(p, "detail")
+	r := s.add("audit_entry", "/api/audit_entries/"+s.nextID(), map[string]interface{}{
+		"summary":     summary,
+		"user_email":  userEmail,
+		"detail_size": len(detail),
+	})
+	r.detail = detail
+	r.links["auditee"] = auditee
+	r.links["detail"] = r.href + "/detail"
+	return r, nil
+}
+
+// indexAuditEntries lists the audit entries updated between "start_date" and "end_date", up to
+// "limit" entries.
+func (s *Server) indexAuditEntries(w http.ResponseWriter, r *request) {
+	var dates [2]time.Time
+	for i, n := range []string{"start_date", "end_date"} {
+		d, err := time.Parse(rubyTimeFormat, stringParam(r.params, n))
+		if err != nil {
+			writeError(w, unprocessable("Invalid %s '%s', format must be %s", n,
+				stringParam(r.params, n), rubyTimeFormat))
+			return
+		}
+		dates[i] = d
+	}
+	limit, err := strconv.Atoi(stringParam(r.params, "limit"))
+	if err != nil || limit < 1 || limit > 1000 {
+		writeError(w, unprocessable("Invalid limit '%s', limit must be between 1 and 1000",
+			stringParam(r.params, "limit")))
+		return
+	}
+	entries := s.list("audit_entry", func(e *resource) bool {
+		return !e.updatedAt.Before(dates[0]) && !e.updatedAt.After(dates[1])
+	})
+	if entries, err = filter(entries, arrayParam(r.params, "filter")); err != nil {
+		writeError(w, err)
+		return
+	}
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	writeJSON(w, "audit_entry", true, s.renderList(entries, stringParam(r.params, "view")))
+}
+
+// updateAuditEntryAction updates the summary of the audit entry and appends to its detail.
+func (s *Server) updateAuditEntryAction(r *resource, req *request) (string, error) {
+	updateAuditEntry(r, mapParam(req.params, "audit_entry"))
+	return "", nil
+}
+
+// appendAuditEntryAction appends to the detail of the audit entry and updates its summary.
+func (s *Server) appendAuditEntryAction(r *resource, req *request) (string, error) {
+	updateAuditEntry(r, req.params)
+	return "", nil
+}
+
+// updateAuditEntry updates the audit entry summary and detail from the given parameters.
+func updateAuditEntry(r *resource, p map[string]interface{}) {
+	if summary := stringParam(p, "summary"); summary != "" {
+		r.attrs["summary"] = summary
+	}
+	r.detail += stringParam(p, "detail")
+	r.attrs["detail_size"] = len(r.detail)
+	r.updatedAt = time.Now().UTC()
+}
+
+// showAuditEntryDetail writes the detail of the audit entry identified by the request.
+func (s *Server) showAuditEntryDetail(w http.ResponseWriter, r *request) {
+	e, err := s.lookup("audit_entry", r.vars[1])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(e.detail))
+}
@@ -0,0 +1,285 @@
+package rsctest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// rubyTimeFormat is the format used by the API to serialize timestamps.
+const rubyTimeFormat = "2006/01/02 15:04:05 -0700"
+
+// kindNames lists the resource names indexed by media type name.
+var kindNames = map[string]string{
+	"audit_entry":  "AuditEntry",
+	"deployment":   "Deployment",
+	"instance":     "Instance",
+	"server":       "Server",
+	"server_array": "ServerArray",
+}
+
+// resource is a resource managed by the server.
+type resource struct {
+	kind      string                 // Media type name, e.g. "server_array"
+	href      string                 // Resource href
+	attrs     map[string]interface{} // Attributes, excluding links and timestamps
+	links     map[string]string      // Hrefs of related resources indexed by rel, excluding self
+	tags      []string               // Resource tags
+	createdAt time.Time              // Creation timestamp
+	updatedAt time.Time              // Last update timestamp
+	detail    string                 // Audit entry detail
+	cloud     string                 // Href of cloud instances get launched in
+	launched  int                    // Number of instances launched by server array
+}
+
+// Attributes returns the JSON representation of the resource with the given href as returned by
+// the show action with the default view, nil if there is no such resource.
+func (s *Server) Attributes(href string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.resources[href]
+	if !ok {
+		return nil
+	}
+	return s.render(r, "default")
+}
+
+// Tags returns the tags of the resource with the given href.
+func (s *Server) Tags(href string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r, ok := s.resources[href]; ok {
+		return append([]string(nil), r.tags...)
+	}
+	return nil
+}
+
+// AddDeployment creates a deployment with the given name and returns its href.
+func (s *Server) AddDeployment(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mustCreate(s.createDeployment(map[string]interface{}{"name": name}))
+}
+
+// AddServer creates a server with the given name in the deployment with the given href (may be
+// empty) and returns the server href.
+func (s *Server) AddServer(name, deploymentHref string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mustCreate(s.createServer(map[string]interface{}{"name": name,
+		"deployment_href": deploymentHref}))
+}
+
+// AddServerArray creates a server array with the given name in the deployment with the given href
+// (may be empty) and returns the server array href.
+func (s *Server) AddServerArray(name, deploymentHref string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mustCreate(s.createServerArray(map[string]interface{}{"name": name,
+		"deployment_href": deploymentHref}))
+}
+
+// AddAuditEntry creates an audit entry for the resource with the given href and returns the audit
+// entry href. userEmail defaults to the server Email if empty.
+func (s *Server) AddAuditEntry(auditeeHref, summary, userEmail string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if userEmail == "" {
+		userEmail = s.Email
+	}
+	return s.mustCreate(s.createAuditEntry(map[string]interface{}{"auditee_href": auditeeHref,
+		"summary": summary}, userEmail))
+}
+
+// Launch launches the server or server array with the given href and returns the href of the
+// launched instance.
+func (s *Server) Launch(href string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.resources[href]
+	if !ok {
+		return "", fmt.Errorf("No resource with href %s", href)
+	}
+	var i *resource
+	var err error
+	switch r.kind {
+	case "server":
+		i, err = s.launchServer(r)
+	case "server_array":
+		i, err = s.launchServerArray(r)
+	default:
+		return "", fmt.Errorf("Cannot launch %s", href)
+	}
+	if err != nil {
+		return "", err
+	}
+	return i.href, nil
+}
+
+// mustCreate returns the href of the created resource, it panics if creation failed. Used by the
+// seed helpers where failures denote programming errors.
+func (s *Server) mustCreate(r *resource, err error) string {
+	if err != nil {
+		panic(err)
+	}
+	return r.href
+}
+
+// add creates and stores a new resource of the given kind.
+func (s *Server) add(kind, href string, attrs map[string]interface{}) *resource {
+	now := time.Now().UTC()
+	r := resource{
+		kind:      kind,
+		href:      href,
+		attrs:     attrs,
+		links:     make(map[string]string),
+		createdAt: now,
+		updatedAt: now,
+	}
+	s.resources[href] = &r
+	s.hrefs = append(s.hrefs, href)
+	return &r
+}
+
+// remove deletes the resource with the given href.
+func (s *Server) remove(href string) {
+	delete(s.resources, href)
+	for i, h := range s.hrefs {
+		if h == href {
+			s.hrefs = append(s.hrefs[:i], s.hrefs[i+1:]...)
+			break
+		}
+	}
+}
+
+// lookup returns the resource of the given kind with the given href.
+func (s *Server) lookup(kind, href string) (*resource, error) {
+	r, ok := s.resources[href]
+	if !ok || r.kind != kind {
+		return nil, notFound(kind, href)
+	}
+	return r, nil
+}
+
+// list returns the resources of the given kind for which scope returns true in order of creation.
+// scope may be nil.
+func (s *Server) list(kind string, scope func(*resource) bool) []*resource {
+	var res []*resource
+	for _, h := range s.hrefs {
+		r := s.resources[h]
+		if r.kind == kind && (scope == nil || scope(r)) {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// linkedTo returns a scope function that selects resources whose link with the given rel points
+// to href.
+func linkedTo(rel, href string) func(*resource) bool {
+	return func(r *resource) bool { return r.links[rel] == href }
+}
+
+// render returns the JSON representation of the resource using the given view.
+func (s *Server) render(r *resource, view string) map[string]interface{} {
+	res := make(map[string]interface{}, len(r.attrs)+4)
+	for k, v := range r.attrs {
+		res[k] = v
+	}
+	if r.kind != "audit_entry" {
+		res["created_at"] = r.createdAt.Format(rubyTimeFormat)
+	}
+	res["updated_at"] = r.updatedAt.Format(rubyTimeFormat)
+	links := []map[string]string{{"rel": "self", "href": r.href}}
+	rels := make([]string, 0, len(r.links))
+	for rel := range r.links {
+		rels = append(rels, rel)
+	}
+	sort.Strings(rels)
+	for _, rel := range rels {
+		links = append(links, map[string]string{"rel": rel, "href": r.links[rel]})
+	}
+	res["links"] = links
+	if view != "instance_detail" {
+		return res
+	}
+	switch r.kind {
+	case "server":
+		if i, ok := s.resources[r.links["current_instance"]]; ok {
+			res["current_instance"] = s.render(i, "default")
+		}
+	case "server_array":
+		var instances []map[string]interface{}
+		for _, i := range s.list("instance", isCurrentInstanceOf(r.href)) {
+			instances = append(instances, s.render(i, "default"))
+		}
+		res["current_instances"] = instances
+	}
+	return res
+}
+
+// renderList returns the JSON representation of the given resources.
+func (s *Server) renderList(resources []*resource, view string) []map[string]interface{} {
+	res := make([]map[string]interface{}, len(resources))
+	for i, r := range resources {
+		res[i] = s.render(r, view)
+	}
+	return res
+}
+
+// filter returns the resources that match all the given filters. Filters have the form
+// "NAME==VALUE" or "NAME<>VALUE". Filters on fields ending with "_href" compare VALUE with the
+// href of the corresponding link, other filters check whether the attribute contains VALUE.
+func filter(resources []*resource, filters []string) ([]*resource, error) {
+	if len(filters) == 0 {
+		return resources, nil
+	}
+	var res []*resource
+	for _, r := range resources {
+		ok := true
+		for _, f := range filters {
+			match, err := matchFilter(r, f)
+			if err != nil {
+				return nil, err
+			}
+			if !match {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			res = append(res, r)
+		}
+	}
+	return res, nil
+}
+
+// matchFilter returns true if the resource matches the given filter.
+func matchFilter(r *resource, f string) (bool, error) {
+	negate := false
+	i := strings.Index(f, "==")
+	if j := strings.Index(f, "<>"); i < 0 || (j >= 0 && j < i) {
+		i, negate = j, true
+	}
+	if i <= 0 {
+		return false, unprocessable("Invalid filter '%s'", f)
+	}
+	name, value := f[:i], f[i+2:]
+	var match bool
+	if strings.HasSuffix(name, "_href") {
+		match = r.links[strings.TrimSuffix(name, "_href")] == value
+	} else {
+		v, ok := r.attrs[name]
+		match = ok && strings.Contains(fmt.Sprintf("%v", v), value)
+	}
+	return match != negate, nil
+}
+
+// isCurrentInstanceOf returns a scope function that selects the instances launched by the server
+// or server array with the given href that have not been terminated.
+func isCurrentInstanceOf(href string) func(*resource) bool {
+	return func(r *resource) bool {
+		return r.links["parent"] == href && r.attrs["state"] != "terminated"
+	}
+}
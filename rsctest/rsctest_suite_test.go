@@ -0,0 +1,13 @@
+package rsctest_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestRsctest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rsctest Suite")
+}
@@ -0,0 +1,354 @@
+// Package rsctest provides a fake RightScale CM 1.5 API server for integration tests.
+//
+// The server keeps the state of the resources it manages in memory so that multi-step workflows
+// (create a deployment, create a server in it, launch the server, list its instances etc.) behave
+// like they do against the real API without requiring network access or credentials. It
+// implements a subset of the API: sessions and OAuth, deployments, servers, server arrays,
+// instances, tags and audit entries. Instances boot instantly and get assigned public and private
+// IP addresses when launched.
+//
+// Typical usage:
+//
+//	s := rsctest.NewServer()
+//	defer s.Close()
+//	httpclient.Insecure = true
+//	client := cm15.New(s.Host(), rsapi.NewBasicAuthenticator(s.Email, s.Password, 1))
+//	s.AddServer("web", "")
+//
+// Failure injection makes it possible to test error handling, see Server.Fail.
+package rsctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Server is a fake CM 1.5 API server. Use NewServer to create one and Close to stop it.
+type Server struct {
+	*httptest.Server
+
+	Email        string // Email accepted to create sessions, defaults to "rsctest@rightscale.com"
+	Password     string // Password accepted to create sessions, defaults to "rsctest"
+	RefreshToken string // Refresh token accepted to create OAuth access tokens
+	NoAuth       bool   // Accept requests without session cookie or access token if true
+
+	mu        sync.Mutex
+	routes    []*route
+	resources map[string]*resource // Resources indexed by href
+	hrefs     []string             // Hrefs of resources in order of creation
+	sessions  map[string]string    // Emails indexed by session cookie value or access token
+	failures  []*failure
+	lastID    int
+}
+
+// Failure describes an error response returned by the server in place of handling matching
+// requests.
+type Failure struct {
+	Method string // HTTP method of requests to fail, all methods if empty
+	Path   string // Regular expression matched against request paths, all paths if empty
+	Status int    // HTTP status code of response, defaults to 500
+	Body   string // Response body
+	Times  int    // Number of requests to fail, all matching requests if 0
+}
+
+// failure is a registered failure with compiled path regexp and number of remaining requests.
+type failure struct {
+	Failure
+	regexp *regexp.Regexp
+	left   int
+}
+
+// route associates a handler with the requests it handles.
+type route struct {
+	method  string
+	regexp  *regexp.Regexp
+	handler func(http.ResponseWriter, *request)
+}
+
+// request is a request being handled.
+type request struct {
+	*http.Request
+	vars   []string               // Route regexp submatches, vars[0] is the request path
+	params map[string]interface{} // Query string and payload parameters
+	email  string                 // Email of authenticated user
+}
+
+// NewServer starts and returns a new fake CM 1.5 API server with no resources.
+func NewServer() *Server {
+	s := &Server{
+		Email:        "rsctest@rightscale.com",
+		Password:     "rsctest",
+		RefreshToken: "rsctest-refresh-token",
+		resources:    make(map[string]*resource),
+		sessions:     make(map[string]string),
+	}
+	s.routes = s.buildRoutes()
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Host returns the host and port the server listens on, suitable for creating clients. The server
+// only speaks HTTP so clients must set httpclient.Insecure to true.
+func (s *Server) Host() string {
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+// NewAccessToken returns an access token that can be used to authenticate requests with
+// rsapi.NewTokenAuthenticator.
+func (s *Server) NewAccessToken() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.newSession("access-token", s.Email)
+}
+
+// Fail registers a failure: the server responds to requests matching the failure method and path
+// with the failure status and body instead of handling them. Failures are checked in the order
+// they were registered, before authentication. Fail panics if the failure path is not a valid
+// regular expression.
+func (s *Server) Fail(f Failure) {
+	if f.Status == 0 {
+		f.Status = http.StatusInternalServerError
+	}
+	fl := failure{Failure: f, regexp: regexp.MustCompile(f.Path), left: f.Times}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, &fl)
+}
+
+// ClearFailures removes all the registered failures.
+func (s *Server) ClearFailures() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = nil
+}
+
+// ServeHTTP handles the API requests.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if f := s.failure(req); f != nil {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(f.Status)
+		w.Write([]byte(f.Body))
+		return
+	}
+	var rt *route
+	var vars []string
+	for _, r := range s.routes {
+		if r.method != req.Method {
+			continue
+		}
+		if vars = r.regexp.FindStringSubmatch(req.URL.Path); vars != nil {
+			rt = r
+			break
+		}
+	}
+	if rt == nil {
+		writeError(w, &httpError{http.StatusNotFound,
+			fmt.Sprintf("ResourceNotFound: No route matches %s %s", req.Method, req.URL.Path)})
+		return
+	}
+	params, err := readParams(req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	r := request{Request: req, vars: vars, params: params}
+	if req.Method != "POST" || !isAuthRoute(req.URL.Path) {
+		email, ok := s.authenticate(req)
+		if !ok {
+			writeError(w, &httpError{http.StatusForbidden,
+				"Session cookie is expired or invalid"})
+			return
+		}
+		r.email = email
+	}
+	rt.handler(w, &r)
+}
+
+// failure returns the first registered failure matching the given request if any and updates
+// its count of remaining requests.
+func (s *Server) failure(req *http.Request) *failure {
+	for i, f := range s.failures {
+		if f.Method != "" && f.Method != req.Method {
+			continue
+		}
+		if !f.regexp.MatchString(req.URL.Path) {
+			continue
+		}
+		if f.Times > 0 {
+			f.left--
+			if f.left == 0 {
+				s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+// isAuthRoute returns true if the given path is used to create sessions and thus does not
+// require authentication.
+func isAuthRoute(path string) bool {
+	return path == "/api/sessions" || path == "/api/session/instance" || path == "/api/oauth2"
+}
+
+// authenticate returns the email of the user that made the request, false if the request is not
+// authenticated.
+func (s *Server) authenticate(req *http.Request) (string, bool) {
+	if c, err := req.Cookie(sessionCookie); err == nil {
+		if email, ok := s.sessions[c.Value]; ok {
+			return email, true
+		}
+	}
+	if auth := req.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		if email, ok := s.sessions[strings.TrimPrefix(auth, "Bearer ")]; ok {
+			return email, true
+		}
+	}
+	return s.Email, s.NoAuth
+}
+
+// newSession records and returns a new session cookie value or access token for the given email.
+func (s *Server) newSession(prefix, email string) string {
+	token := fmt.Sprintf("rsctest-%s-%d", prefix, len(s.sessions)+1)
+	s.sessions[token] = email
+	return token
+}
+
+// nextID returns a new unique resource ID.
+func (s *Server) nextID() string {
+	s.lastID++
+	return fmt.Sprintf("%d", s.lastID)
+}
+
+// readParams merges the query string and JSON payload parameters of the given request. Query
+// string array parameters (e.g. "filter[]") are stored without the brackets.
+func readParams(req *http.Request) (map[string]interface{}, error) {
+	params := make(map[string]interface{})
+	for n, vs := range req.URL.Query() {
+		if strings.HasSuffix(n, "[]") {
+			a := make([]interface{}, len(vs))
+			for i, v := range vs {
+				a[i] = v
+			}
+			params[strings.TrimSuffix(n, "[]")] = a
+		} else if len(vs) > 0 {
+			params[n] = vs[0]
+		}
+	}
+	if req.Body == nil {
+		return params, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, &httpError{http.StatusBadRequest, fmt.Sprintf("Failed to read body: %s", err)}
+	}
+	if len(body) == 0 {
+		return params, nil
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, &httpError{http.StatusBadRequest, fmt.Sprintf("Invalid JSON payload: %s", err)}
+	}
+	for n, v := range payload {
+		params[n] = v
+	}
+	return params, nil
+}
+
+// httpError is an error that results in a text response with the given status code.
+type httpError struct {
+	status int
+	msg    string
+}
+
+// Error returns the response body.
+func (e *httpError) Error() string {
+	return e.msg
+}
+
+// unprocessable returns a 422 error with the given message.
+func unprocessable(format string, v ...interface{}) error {
+	return &httpError{422, "UnprocessableEntity: " + fmt.Sprintf(format, v...)}
+}
+
+// notFound returns a 404 error for the resource with the given kind and href.
+func notFound(kind, href string) error {
+	id := href[strings.LastIndex(href, "/")+1:]
+	return &httpError{http.StatusNotFound,
+		fmt.Sprintf("ResourceNotFound: Couldn't find %s with ID=%s", kindNames[kind], id)}
+}
+
+// writeError writes the response corresponding to the given error.
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if he, ok := err.(*httpError); ok {
+		status = he.status
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(status)
+	w.Write([]byte(err.Error()))
+}
+
+// writeJSON writes a JSON response using the RightScale media type with the given name.
+func writeJSON(w http.ResponseWriter, mediaType string, collection bool, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	ct := "application/vnd.rightscale." + mediaType + "+json"
+	if collection {
+		ct += ";type=collection"
+	}
+	w.Header().Set("Content-Type", ct)
+	w.WriteHeader(http.StatusOK)
+	w.Write(b)
+}
+
+// writeCreated writes the response to a successful create action.
+func writeCreated(w http.ResponseWriter, href string) {
+	w.Header().Set("Location", href)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// stringParam returns the value of the given string parameter, "" if missing.
+func stringParam(params map[string]interface{}, name string) string {
+	switch v := params[name].(type) {
+	case string:
+		return v
+	case float64, bool:
+		return fmt.Sprintf("%v", v)
+	}
+	return ""
+}
+
+// arrayParam returns the string values of the given array parameter.
+func arrayParam(params map[string]interface{}, name string) []string {
+	var res []string
+	switch v := params[name].(type) {
+	case []interface{}:
+		for _, e := range v {
+			res = append(res, fmt.Sprintf("%v", e))
+		}
+	case string:
+		res = []string{v}
+	}
+	return res
+}
+
+// mapParam returns the value of the given hash parameter, an empty map if missing.
+func mapParam(params map[string]interface{}, name string) map[string]interface{} {
+	if m, ok := params[name].(map[string]interface{}); ok {
+		return m
+	}
+	return make(map[string]interface{})
+}
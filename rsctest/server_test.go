@@ -0,0 +1,181 @@
+package rsctest_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rightscale/rsc/cm15"
+	"github.com/rightscale/rsc/httpclient"
+	"github.com/rightscale/rsc/rsapi"
+	"github.com/rightscale/rsc/rsctest"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		server *rsctest.Server
+		client *cm15.API
+	)
+
+	BeforeEach(func() {
+		httpclient.Insecure = true
+		server = rsctest.NewServer()
+		client = cm15.New(server.Host(), rsapi.NewBasicAuthenticator(server.Email, server.Password, 1))
+	})
+
+	AfterEach(func() {
+		httpclient.Insecure = false
+		server.Close()
+	})
+
+	Context("authentication", func() {
+		It("accepts valid credentials", func() {
+			Ω(client.CanAuthenticate()).Should(Succeed())
+		})
+
+		It("rejects invalid credentials", func() {
+			auth := rsapi.NewBasicAuthenticator(server.Email, "wrong", 1)
+			client = cm15.New(server.Host(), auth)
+			Ω(client.CanAuthenticate()).ShouldNot(Succeed())
+		})
+
+		It("supports OAuth", func() {
+			auth := rsapi.NewOAuthAuthenticator(server.RefreshToken, 1)
+			client = cm15.New(server.Host(), auth)
+			Ω(client.CanAuthenticate()).Should(Succeed())
+			_, err := client.DeploymentLocator("/api/deployments").Index(nil)
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+
+		It("rejects unauthenticated requests", func() {
+			resp, err := http.Get(server.URL + "/api/deployments")
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(resp.StatusCode).Should(Equal(403))
+		})
+	})
+
+	Context("server workflow", func() {
+		It("creates, launches and terminates a server", func() {
+			dloc, err := client.DeploymentLocator("/api/deployments").Create(
+				&cm15.DeploymentParam{Name: "staging"})
+			Ω(err).ShouldNot(HaveOccurred())
+			deploymentHref := string(dloc.Href)
+
+			sloc, err := client.ServerLocator("/api/servers").Create(&cm15.ServerParam{
+				Name:           "web",
+				DeploymentHref: deploymentHref,
+				Instance: &cm15.InstanceParam4{
+					CloudHref:          "/api/clouds/6",
+					ServerTemplateHref: "/api/server_templates/42",
+				},
+			})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(sloc.Launch()).Should(Succeed())
+
+			servers, err := client.ServerLocator(deploymentHref + "/servers").Index(nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(servers).Should(HaveLen(1))
+			srv, err := sloc.Show(&cm15.ServerShowOptions{View: "instance_detail"})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(srv.Name).Should(Equal("web"))
+			Ω(srv.State).Should(Equal("operational"))
+			Ω(srv.CurrentInstance).ShouldNot(BeNil())
+			Ω(srv.CurrentInstance.State).Should(Equal("operational"))
+			Ω(srv.CurrentInstance.PublicIpAddresses).Should(HaveLen(1))
+			instance := srv.CurrentInstanceLocator(client)
+			Ω(instance).ShouldNot(BeNil())
+			Ω(string(instance.Href)).Should(HavePrefix("/api/clouds/6/instances/"))
+
+			Ω(sloc.Launch()).ShouldNot(Succeed())
+			Ω(sloc.Terminate()).Should(Succeed())
+			srv, err = sloc.Show(nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(srv.State).Should(Equal("inactive"))
+			i, err := instance.Show(nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(i.State).Should(Equal("terminated"))
+		})
+
+		It("filters servers", func() {
+			d := server.AddDeployment("prod")
+			server.AddServer("web 1", d)
+			server.AddServer("web 2", "")
+			server.AddServer("db", d)
+			servers, err := client.ServerLocator("/api/servers").Index(&cm15.ServerIndexOptions{
+				Filter: []string{"name==web", "deployment_href==" + d},
+			})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(servers).Should(HaveLen(1))
+			Ω(servers[0].Name).Should(Equal("web 1"))
+		})
+
+		It("returns 404 for unknown resources", func() {
+			_, err := client.ServerLocator("/api/servers/42").Show(nil)
+			Ω(rsapi.IsNotFound(err)).Should(BeTrue())
+		})
+	})
+
+	Context("server arrays", func() {
+		It("launches numbered instances", func() {
+			href := server.AddServerArray("App", "")
+			loc := client.ServerArrayLocator(href)
+			Ω(loc.Launch()).Should(Succeed())
+			Ω(loc.Launch()).Should(Succeed())
+			instances, err := loc.CurrentInstances()
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(instances).Should(HaveLen(2))
+			Ω(instances[0].Name).Should(Equal("App #1"))
+			Ω(instances[1].Name).Should(Equal("App #2"))
+
+			Ω(loc.MultiTerminate()).Should(Succeed())
+			instances, err = loc.CurrentInstances()
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(instances).Should(BeEmpty())
+		})
+	})
+
+	Context("tags", func() {
+		It("adds, lists and deletes tags", func() {
+			href := server.AddServer("web", "")
+			Ω(client.TagLocator("/api/tags/multi_add").MultiAdd([]string{href}, []string{"app:role=web", "app:env=prod"})).Should(Succeed())
+			res, err := client.TagLocator("/api/tags/by_tag").ByTag("servers", []string{"app:role=web"}, nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(res).Should(HaveLen(1))
+			Ω(client.TagLocator("/api/tags/multi_delete").MultiDelete([]string{href}, []string{"app:role=web"})).Should(Succeed())
+			Ω(server.Tags(href)).Should(Equal([]string{"app:env=prod"}))
+		})
+	})
+
+	Context("audit entries", func() {
+		It("creates and appends to audit entries", func() {
+			href := server.AddServer("web", "")
+			loc, err := client.AuditEntryLocator("/api/audit_entries").Create(
+				&cm15.AuditEntryParam{AuditeeHref: href, Summary: "deploying", Detail: "step 1\n"}, nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(loc.Append(&cm15.AuditEntryAppendOptions{Detail: "step 2\n"})).Should(Succeed())
+			detail, err := loc.Detail()
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(detail).Should(Equal("step 1\nstep 2\n"))
+			entry, err := loc.Show(nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(entry.Summary).Should(Equal("deploying"))
+			Ω(entry.UserEmail).Should(Equal(server.Email))
+		})
+	})
+
+	Context("failure injection", func() {
+		It("fails matching requests", func() {
+			server.Fail(rsctest.Failure{Method: "GET", Path: "^/api/deployments$", Status: 503,
+				Body: "maintenance", Times: 1})
+			_, err := client.DeploymentLocator("/api/deployments").Index(nil)
+			Ω(err).Should(HaveOccurred())
+			apiErr, ok := err.(*rsapi.APIError)
+			Ω(ok).Should(BeTrue())
+			Ω(apiErr.StatusCode).Should(Equal(503))
+			Ω(apiErr.Message).Should(Equal("maintenance"))
+
+			_, err = client.DeploymentLocator("/api/deployments").Index(nil)
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+	})
+})
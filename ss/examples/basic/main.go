@@ -55,7 +55,7 @@ func main() {
 
 	// 3. Make execution index call using expanded view
 	l := client.ExecutionLocator(fmt.Sprintf("/projects/%d/executions", *account))
-	execs, err := l.Index(rsapi.APIParams{})
+	execs, err := l.Index(nil)
 	if err != nil {
 		fail("failed to list executions: %s", err)
 	}
@@ -1,7 +1,9 @@
 package ss
 
 import (
+	"os"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/rightscale/rsc/cmd"
@@ -34,6 +36,22 @@ func FromCommandLine(cmdLine *cmd.CommandLine) (*API, error) {
 	return &API{api}, nil
 }
 
+// FromEnv builds a client from the environment variables set by rsc when running plugins.
+func FromEnv() (*API, error) {
+	api, err := rsapi.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if api.Auth != nil && os.Getenv(rsapi.EnvRL10) != "true" {
+		account, _ := strconv.Atoi(os.Getenv(rsapi.EnvAccount))
+		api.Auth = rsapi.NewSSAuthenticator(api.Auth, account)
+		api.Auth.SetHost(api.Host)
+	}
+	setupMetadata()
+	api.Metadata = GenMetadata
+	return &API{api}, nil
+}
+
 // New returns a Self-Service API client.
 func New(h string, a rsapi.Authenticator) *API {
 	api := rsapi.New(h, a)
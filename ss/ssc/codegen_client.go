@@ -12,8 +12,7 @@ package ssc
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"time"
+	"io"
 
 	"github.com/rightscale/rsc/metadata"
 	"github.com/rightscale/rsc/rsapi"
@@ -88,24 +87,75 @@ func (api *API) AccountPreferenceLocator(href string) *AccountPreferenceLocator
 	return &AccountPreferenceLocator{Href(href), api}
 }
 
+// AccountPreferenceLocatorInterface lists the AccountPreferenceLocator methods, code that depends on the interface
+// rather than on AccountPreferenceLocator can be unit tested using the generated fake locators.
+type AccountPreferenceLocatorInterface interface {
+	Index(options *AccountPreferenceIndexOptions) ([]*AccountPreference, error)
+	IndexEach(options *AccountPreferenceIndexOptions, fn func(*AccountPreference) error) error
+	Show() (*AccountPreference, error)
+	Create(groupName string, name string, value string) (*AccountPreferenceLocator, error)
+	Delete() error
+}
+
 //===== Actions
 
+// AccountPreferenceIndexOptions contains the optional parameters of AccountPreferenceLocator.Index.
+type AccountPreferenceIndexOptions struct {
+	// Filter by group, so that only AccountPreferences belonging to that group are returned
+	Filter []string
+	// The group that this setting belongs to, simply for organizational purposes
+	Group string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *AccountPreferenceIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "group":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of AccountPreferenceLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.Group != "" {
+		p["group"] = o.Group
+	}
+	return p, nil
+}
+
 // GET /accounts/:account_id/account_preferences
 //
 // List the AccountPreferences for this account.
-func (loc *AccountPreferenceLocator) Index(options rsapi.APIParams) ([]*AccountPreference, error) {
+func (loc *AccountPreferenceLocator) Index(options *AccountPreferenceIndexOptions) ([]*AccountPreference, error) {
 	var res []*AccountPreference
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var groupOpt = options["group"]
+	var groupOpt = opts["group"]
 	if groupOpt != nil {
 		params["group"] = groupOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AccountPreference", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AccountPreference", "index")
 	if err != nil {
 		return res, err
@@ -118,22 +168,59 @@ func (loc *AccountPreferenceLocator) Index(options rsapi.APIParams) ([]*AccountP
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *AccountPreferenceLocator) IndexEach(options *AccountPreferenceIndexOptions, fn func(*AccountPreference) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var groupOpt = opts["group"]
+	if groupOpt != nil {
+		params["group"] = groupOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AccountPreference", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("AccountPreference", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *AccountPreference
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /accounts/:account_id/account_preferences/:name
@@ -143,6 +230,9 @@ func (loc *AccountPreferenceLocator) Show() (*AccountPreference, error) {
 	var res *AccountPreference
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AccountPreference", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AccountPreference", "show")
 	if err != nil {
 		return res, err
@@ -155,21 +245,11 @@ func (loc *AccountPreferenceLocator) Show() (*AccountPreference, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -194,6 +274,9 @@ func (loc *AccountPreferenceLocator) Create(groupName string, name string, value
 		"name":       name,
 		"value":      value,
 	}
+	if err := loc.api.ValidateParams("AccountPreference", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("AccountPreference", "create")
 	if err != nil {
 		return res, err
@@ -206,15 +289,10 @@ func (loc *AccountPreferenceLocator) Create(groupName string, name string, value
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -229,6 +307,9 @@ func (loc *AccountPreferenceLocator) Create(groupName string, name string, value
 func (loc *AccountPreferenceLocator) Delete() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("AccountPreference", "delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("AccountPreference", "delete")
 	if err != nil {
 		return err
@@ -241,15 +322,10 @@ func (loc *AccountPreferenceLocator) Delete() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -296,20 +372,72 @@ func (api *API) ApplicationLocator(href string) *ApplicationLocator {
 	return &ApplicationLocator{Href(href), api}
 }
 
+// ApplicationLocatorInterface lists the ApplicationLocator methods, code that depends on the interface
+// rather than on ApplicationLocator can be unit tested using the generated fake locators.
+type ApplicationLocatorInterface interface {
+	Index(options *ApplicationIndexOptions) ([]*Application, error)
+	IndexEach(options *ApplicationIndexOptions, fn func(*Application) error) error
+	Show(options *ApplicationShowOptions) (*Application, error)
+	Create(compiledCat *CompiledCAT, name string, shortDescription string, options *ApplicationCreateOptions) (*ApplicationLocator, error)
+	Update(options *ApplicationUpdateOptions) error
+	MultiUpdate(id string, options *ApplicationMultiUpdateOptions) error
+	Delete() error
+	MultiDelete(ids []string) error
+	Download(apiVersion string) error
+	DownloadReader(apiVersion string) (io.ReadCloser, error)
+	Launch(options *ApplicationLaunchOptions) error
+}
+
 //===== Actions
 
+// ApplicationIndexOptions contains the optional parameters of ApplicationLocator.Index.
+type ApplicationIndexOptions struct {
+	// An optional list of Application IDs to retrieve. If not specified, all are returned.
+	Ids []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ApplicationIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "ids":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ApplicationLocator.Index", n)
+		}
+	}
+	if o.Ids != nil {
+		p["ids"] = o.Ids
+	}
+	return p, nil
+}
+
 // GET /catalogs/:catalog_id/applications
 //
 // List the Applications available in the specified Catalog.
-func (loc *ApplicationLocator) Index(options rsapi.APIParams) ([]*Application, error) {
+func (loc *ApplicationLocator) Index(options *ApplicationIndexOptions) ([]*Application, error) {
 	var res []*Application
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var idsOpt = options["ids"]
+	var idsOpt = opts["ids"]
 	if idsOpt != nil {
 		params["ids[]"] = idsOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Application", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Application", "index")
 	if err != nil {
 		return res, err
@@ -322,36 +450,105 @@ func (loc *ApplicationLocator) Index(options rsapi.APIParams) ([]*Application, e
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *ApplicationLocator) IndexEach(options *ApplicationIndexOptions, fn func(*Application) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var idsOpt = opts["ids"]
+	if idsOpt != nil {
+		params["ids[]"] = idsOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Application", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Application", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Application
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// ApplicationShowOptions contains the optional parameters of ApplicationLocator.Show.
+type ApplicationShowOptions struct {
+	// Optional view to return
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ApplicationShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ApplicationLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /catalogs/:catalog_id/applications/:id
 //
 // Show detailed information about a given Application.
-func (loc *ApplicationLocator) Show(options rsapi.APIParams) (*Application, error) {
+func (loc *ApplicationLocator) Show(options *ApplicationShowOptions) (*Application, error) {
 	var res *Application
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Application", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Application", "show")
 	if err != nil {
 		return res, err
@@ -364,28 +561,62 @@ func (loc *ApplicationLocator) Show(options rsapi.APIParams) (*Application, erro
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ApplicationCreateOptions contains the optional parameters of ApplicationLocator.Create.
+type ApplicationCreateOptions struct {
+	// Long description of application
+	LongDescription string
+	// Whether the CloudApp requires a schedule to be provided at launch time. If set to false, allows user to pick from '24/7' schedule when launching in the UI
+	ScheduleRequired bool
+	// Schedules available to users when launching the application
+	Schedules []*Schedule
+	// If created from a Template, the template href can be provided to maintain the relationship between the resources.
+	TemplateHref string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ApplicationCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "long_description", "schedule_required", "schedules", "template_href":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ApplicationLocator.Create", n)
+		}
+	}
+	if o.LongDescription != "" {
+		p["long_description"] = o.LongDescription
+	}
+	if o.ScheduleRequired {
+		p["schedule_required"] = o.ScheduleRequired
+	}
+	if o.Schedules != nil {
+		p["schedules"] = o.Schedules
+	}
+	if o.TemplateHref != "" {
+		p["template_href"] = o.TemplateHref
+	}
+	return p, nil
+}
+
 // POST /catalogs/:catalog_id/applications
 //
 // Create a new Application in the Catalog.
-func (loc *ApplicationLocator) Create(compiledCat *CompiledCAT, name string, shortDescription string, options rsapi.APIParams) (*ApplicationLocator, error) {
+func (loc *ApplicationLocator) Create(compiledCat *CompiledCAT, name string, shortDescription string, options *ApplicationCreateOptions) (*ApplicationLocator, error) {
 	var res *ApplicationLocator
 	if compiledCat == nil {
 		return res, fmt.Errorf("compiledCat is required")
@@ -396,6 +627,10 @@ func (loc *ApplicationLocator) Create(compiledCat *CompiledCAT, name string, sho
 	if shortDescription == "" {
 		return res, fmt.Errorf("shortDescription is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
@@ -403,22 +638,25 @@ func (loc *ApplicationLocator) Create(compiledCat *CompiledCAT, name string, sho
 		"name":              name,
 		"short_description": shortDescription,
 	}
-	var longDescriptionOpt = options["long_description"]
+	var longDescriptionOpt = opts["long_description"]
 	if longDescriptionOpt != nil {
 		p["long_description"] = longDescriptionOpt
 	}
-	var scheduleRequiredOpt = options["schedule_required"]
+	var scheduleRequiredOpt = opts["schedule_required"]
 	if scheduleRequiredOpt != nil {
 		p["schedule_required"] = scheduleRequiredOpt
 	}
-	var schedulesOpt = options["schedules"]
+	var schedulesOpt = opts["schedules"]
 	if schedulesOpt != nil {
 		p["schedules"] = schedulesOpt
 	}
-	var templateHrefOpt = options["template_href"]
+	var templateHrefOpt = opts["template_href"]
 	if templateHrefOpt != nil {
 		p["template_href"] = templateHrefOpt
 	}
+	if err := loc.api.ValidateParams("Application", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Application", "create")
 	if err != nil {
 		return res, err
@@ -431,15 +669,10 @@ func (loc *ApplicationLocator) Create(compiledCat *CompiledCAT, name string, sho
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -448,41 +681,107 @@ func (loc *ApplicationLocator) Create(compiledCat *CompiledCAT, name string, sho
 	}
 }
 
+// ApplicationUpdateOptions contains the optional parameters of ApplicationLocator.Update.
+type ApplicationUpdateOptions struct {
+	// The compiled source of the CAT file. This can be obtained by calling Template.compile or Template.show in the Designer application.
+	CompiledCat *CompiledCAT
+	// Long description of application
+	LongDescription string
+	// Name of application
+	Name string
+	// Whether the CloudApp requires a schedule to be provided at launch time. If set to false, allows user to pick from '24/7' schedule when launching in the UI
+	ScheduleRequired bool
+	// Schedules available to users when launching the application
+	Schedules []*Schedule
+	// Short description of application
+	ShortDescription string
+	// A template href can be provided to maintain the relationship between the resources.
+	TemplateHref string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ApplicationUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "compiled_cat", "long_description", "name", "schedule_required", "schedules", "short_description", "template_href":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ApplicationLocator.Update", n)
+		}
+	}
+	if o.CompiledCat != nil {
+		p["compiled_cat"] = o.CompiledCat
+	}
+	if o.LongDescription != "" {
+		p["long_description"] = o.LongDescription
+	}
+	if o.Name != "" {
+		p["name"] = o.Name
+	}
+	if o.ScheduleRequired {
+		p["schedule_required"] = o.ScheduleRequired
+	}
+	if o.Schedules != nil {
+		p["schedules"] = o.Schedules
+	}
+	if o.ShortDescription != "" {
+		p["short_description"] = o.ShortDescription
+	}
+	if o.TemplateHref != "" {
+		p["template_href"] = o.TemplateHref
+	}
+	return p, nil
+}
+
 // PUT /catalogs/:catalog_id/applications/:id
 //
 // Update the content of an existing Application.
-func (loc *ApplicationLocator) Update(options rsapi.APIParams) error {
+func (loc *ApplicationLocator) Update(options *ApplicationUpdateOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var compiledCatOpt = options["compiled_cat"]
+	var compiledCatOpt = opts["compiled_cat"]
 	if compiledCatOpt != nil {
 		p["compiled_cat"] = compiledCatOpt
 	}
-	var longDescriptionOpt = options["long_description"]
+	var longDescriptionOpt = opts["long_description"]
 	if longDescriptionOpt != nil {
 		p["long_description"] = longDescriptionOpt
 	}
-	var nameOpt = options["name"]
+	var nameOpt = opts["name"]
 	if nameOpt != nil {
 		p["name"] = nameOpt
 	}
-	var scheduleRequiredOpt = options["schedule_required"]
+	var scheduleRequiredOpt = opts["schedule_required"]
 	if scheduleRequiredOpt != nil {
 		p["schedule_required"] = scheduleRequiredOpt
 	}
-	var schedulesOpt = options["schedules"]
+	var schedulesOpt = opts["schedules"]
 	if schedulesOpt != nil {
 		p["schedules"] = schedulesOpt
 	}
-	var shortDescriptionOpt = options["short_description"]
+	var shortDescriptionOpt = opts["short_description"]
 	if shortDescriptionOpt != nil {
 		p["short_description"] = shortDescriptionOpt
 	}
-	var templateHrefOpt = options["template_href"]
+	var templateHrefOpt = opts["template_href"]
 	if templateHrefOpt != nil {
 		p["template_href"] = templateHrefOpt
 	}
+	if err := loc.api.ValidateParams("Application", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Application", "update")
 	if err != nil {
 		return err
@@ -495,58 +794,119 @@ func (loc *ApplicationLocator) Update(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ApplicationMultiUpdateOptions contains the optional parameters of ApplicationLocator.MultiUpdate.
+type ApplicationMultiUpdateOptions struct {
+	// The compiled source of the CAT file. This can be obtained by calling Template.compile or Template.show in the Designer application.
+	CompiledCat *CompiledCAT
+	// Long description of application
+	LongDescription string
+	// Name of application
+	Name string
+	// Whether the CloudApp requires a schedule to be provided at launch time. If set to false, allows user to pick from '24/7' schedule when launching in the UI
+	ScheduleRequired bool
+	// Schedules available to users when launching the application
+	Schedules []*Schedule
+	// Short description of application
+	ShortDescription string
+	// A template href can be provided to maintain the relationship between the resources.
+	TemplateHref string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ApplicationMultiUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "compiled_cat", "long_description", "name", "schedule_required", "schedules", "short_description", "template_href":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ApplicationLocator.MultiUpdate", n)
+		}
+	}
+	if o.CompiledCat != nil {
+		p["compiled_cat"] = o.CompiledCat
+	}
+	if o.LongDescription != "" {
+		p["long_description"] = o.LongDescription
+	}
+	if o.Name != "" {
+		p["name"] = o.Name
+	}
+	if o.ScheduleRequired {
+		p["schedule_required"] = o.ScheduleRequired
+	}
+	if o.Schedules != nil {
+		p["schedules"] = o.Schedules
+	}
+	if o.ShortDescription != "" {
+		p["short_description"] = o.ShortDescription
+	}
+	if o.TemplateHref != "" {
+		p["template_href"] = o.TemplateHref
+	}
+	return p, nil
+}
+
 // PUT /catalogs/:catalog_id/applications
 //
 // Update the content of multiple Applications.
-func (loc *ApplicationLocator) MultiUpdate(id string, options rsapi.APIParams) error {
+func (loc *ApplicationLocator) MultiUpdate(id string, options *ApplicationMultiUpdateOptions) error {
 	if id == "" {
 		return fmt.Errorf("id is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"id": id,
 	}
-	var compiledCatOpt = options["compiled_cat"]
+	var compiledCatOpt = opts["compiled_cat"]
 	if compiledCatOpt != nil {
 		p["compiled_cat"] = compiledCatOpt
 	}
-	var longDescriptionOpt = options["long_description"]
+	var longDescriptionOpt = opts["long_description"]
 	if longDescriptionOpt != nil {
 		p["long_description"] = longDescriptionOpt
 	}
-	var nameOpt = options["name"]
+	var nameOpt = opts["name"]
 	if nameOpt != nil {
 		p["name"] = nameOpt
 	}
-	var scheduleRequiredOpt = options["schedule_required"]
+	var scheduleRequiredOpt = opts["schedule_required"]
 	if scheduleRequiredOpt != nil {
 		p["schedule_required"] = scheduleRequiredOpt
 	}
-	var schedulesOpt = options["schedules"]
+	var schedulesOpt = opts["schedules"]
 	if schedulesOpt != nil {
 		p["schedules"] = schedulesOpt
 	}
-	var shortDescriptionOpt = options["short_description"]
+	var shortDescriptionOpt = opts["short_description"]
 	if shortDescriptionOpt != nil {
 		p["short_description"] = shortDescriptionOpt
 	}
-	var templateHrefOpt = options["template_href"]
+	var templateHrefOpt = opts["template_href"]
 	if templateHrefOpt != nil {
 		p["template_href"] = templateHrefOpt
 	}
+	if err := loc.api.ValidateParams("Application", "multi_update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Application", "multi_update")
 	if err != nil {
 		return err
@@ -559,15 +919,10 @@ func (loc *ApplicationLocator) MultiUpdate(id string, options rsapi.APIParams) e
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -577,6 +932,9 @@ func (loc *ApplicationLocator) MultiUpdate(id string, options rsapi.APIParams) e
 func (loc *ApplicationLocator) Delete() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Application", "delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Application", "delete")
 	if err != nil {
 		return err
@@ -589,15 +947,10 @@ func (loc *ApplicationLocator) Delete() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -613,6 +966,9 @@ func (loc *ApplicationLocator) MultiDelete(ids []string) error {
 		"ids[]": ids,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Application", "multi_delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Application", "multi_delete")
 	if err != nil {
 		return err
@@ -625,15 +981,10 @@ func (loc *ApplicationLocator) MultiDelete(ids []string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -649,6 +1000,9 @@ func (loc *ApplicationLocator) Download(apiVersion string) error {
 		"api_version": apiVersion,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Application", "download", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Application", "download")
 	if err != nil {
 		return err
@@ -661,45 +1015,128 @@ func (loc *ApplicationLocator) Download(apiVersion string) error {
 	if err != nil {
 		return err
 	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
 	defer resp.Body.Close()
+	return nil
+}
+
+// DownloadReader is identical to Download except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *ApplicationLocator) DownloadReader(apiVersion string) (io.ReadCloser, error) {
+	if apiVersion == "" {
+		return nil, fmt.Errorf("apiVersion is required")
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{
+		"api_version": apiVersion,
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Application", "download", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Application", "download")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
+// ApplicationLaunchOptions contains the optional parameters of ApplicationLocator.Launch.
+type ApplicationLaunchOptions struct {
+	// The description for the execution. The description of the Application will be used if none is provided.
+	Description string
+	// When the CloudApp should be automatically terminated.
+	EndDate *rsapi.Time
+	// The name for the Execution. The Application name will be used if none is provided. This will be used as the name of the deployment (appended with a unique ID).
+	Name string
+	// The configuration options of the Execution. These are the values provided for the CloudApp parameters.
+	Options_ []*ConfigurationOption
+	// Name of the Schedule to use when launching. It must match one of the schedules attached to the Application
+	ScheduleName string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ApplicationLaunchOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "description", "end_date", "name", "options", "schedule_name":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ApplicationLocator.Launch", n)
 		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
 	}
-	return nil
+	if o.Description != "" {
+		p["description"] = o.Description
+	}
+	if o.EndDate != nil {
+		p["end_date"] = o.EndDate
+	}
+	if o.Name != "" {
+		p["name"] = o.Name
+	}
+	if o.Options_ != nil {
+		p["options"] = o.Options_
+	}
+	if o.ScheduleName != "" {
+		p["schedule_name"] = o.ScheduleName
+	}
+	return p, nil
 }
 
 // POST /catalogs/:catalog_id/applications/:id/actions/launch
 //
 // Launches an Application by creating an Execution with ScheduledActions as needed to match the optional Schedule provided.
-func (loc *ApplicationLocator) Launch(options rsapi.APIParams) error {
+func (loc *ApplicationLocator) Launch(options *ApplicationLaunchOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var descriptionOpt = options["description"]
+	var descriptionOpt = opts["description"]
 	if descriptionOpt != nil {
 		p["description"] = descriptionOpt
 	}
-	var endDateOpt = options["end_date"]
+	var endDateOpt = opts["end_date"]
 	if endDateOpt != nil {
 		p["end_date"] = endDateOpt
 	}
-	var nameOpt = options["name"]
+	var nameOpt = opts["name"]
 	if nameOpt != nil {
 		p["name"] = nameOpt
 	}
-	var options_Opt = options["options"]
+	var options_Opt = opts["options"]
 	if options_Opt != nil {
 		p["options"] = options_Opt
 	}
-	var scheduleNameOpt = options["schedule_name"]
+	var scheduleNameOpt = opts["schedule_name"]
 	if scheduleNameOpt != nil {
 		p["schedule_name"] = scheduleNameOpt
 	}
+	if err := loc.api.ValidateParams("Application", "launch", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Application", "launch")
 	if err != nil {
 		return err
@@ -712,15 +1149,10 @@ func (loc *ApplicationLocator) Launch(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -763,25 +1195,73 @@ func (api *API) NotificationRuleLocator(href string) *NotificationRuleLocator {
 	return &NotificationRuleLocator{Href(href), api}
 }
 
+// NotificationRuleLocatorInterface lists the NotificationRuleLocator methods, code that depends on the interface
+// rather than on NotificationRuleLocator can be unit tested using the generated fake locators.
+type NotificationRuleLocatorInterface interface {
+	Index(source string, options *NotificationRuleIndexOptions) ([]*NotificationRule, error)
+	IndexEach(source string, options *NotificationRuleIndexOptions, fn func(*NotificationRule) error) error
+	Create(minSeverity string, source string, target string, options *NotificationRuleCreateOptions) (*NotificationRuleLocator, error)
+	Patch(minSeverity string) error
+	Show() (*NotificationRule, error)
+	Delete() error
+	MultiDelete(options *NotificationRuleMultiDeleteOptions) error
+}
+
 //===== Actions
 
+// NotificationRuleIndexOptions contains the optional parameters of NotificationRuleLocator.Index.
+type NotificationRuleIndexOptions struct {
+	// Comma separated list of target ids. Note, currently only "me" is allowed.
+	Targets string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NotificationRuleIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "targets":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NotificationRuleLocator.Index", n)
+		}
+	}
+	if o.Targets != "" {
+		p["targets"] = o.Targets
+	}
+	return p, nil
+}
+
 // GET /accounts/:account_id/notification_rules
 //
 // List all notification rules, potentially filtering by a collection of resources.
-func (loc *NotificationRuleLocator) Index(source string, options rsapi.APIParams) ([]*NotificationRule, error) {
+func (loc *NotificationRuleLocator) Index(source string, options *NotificationRuleIndexOptions) ([]*NotificationRule, error) {
 	var res []*NotificationRule
 	if source == "" {
 		return res, fmt.Errorf("source is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"source": source,
 	}
-	var targetsOpt = options["targets"]
+	var targetsOpt = opts["targets"]
 	if targetsOpt != nil {
 		params["targets"] = targetsOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NotificationRule", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("NotificationRule", "index")
 	if err != nil {
 		return res, err
@@ -794,29 +1274,95 @@ func (loc *NotificationRuleLocator) Index(source string, options rsapi.APIParams
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *NotificationRuleLocator) IndexEach(source string, options *NotificationRuleIndexOptions, fn func(*NotificationRule) error) error {
+	if source == "" {
+		return fmt.Errorf("source is required")
+	}
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{
+		"source": source,
+	}
+	var targetsOpt = opts["targets"]
+	if targetsOpt != nil {
+		params["targets"] = targetsOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NotificationRule", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("NotificationRule", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *NotificationRule
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// NotificationRuleCreateOptions contains the optional parameters of NotificationRuleLocator.Create.
+type NotificationRuleCreateOptions struct {
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NotificationRuleCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NotificationRuleLocator.Create", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
 }
 
 // POST /accounts/:account_id/notification_rules
 //
 // Create one notification rule for a specific target and source.
 // The source must be unique in the scope of target and account.
-func (loc *NotificationRuleLocator) Create(minSeverity string, source string, target string, options rsapi.APIParams) (*NotificationRuleLocator, error) {
+func (loc *NotificationRuleLocator) Create(minSeverity string, source string, target string, options *NotificationRuleCreateOptions) (*NotificationRuleLocator, error) {
 	var res *NotificationRuleLocator
 	if minSeverity == "" {
 		return res, fmt.Errorf("minSeverity is required")
@@ -827,9 +1373,13 @@ func (loc *NotificationRuleLocator) Create(minSeverity string, source string, ta
 	if target == "" {
 		return res, fmt.Errorf("target is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
@@ -839,6 +1389,9 @@ func (loc *NotificationRuleLocator) Create(minSeverity string, source string, ta
 		"source":       source,
 		"target":       target,
 	}
+	if err := loc.api.ValidateParams("NotificationRule", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("NotificationRule", "create")
 	if err != nil {
 		return res, err
@@ -851,15 +1404,10 @@ func (loc *NotificationRuleLocator) Create(minSeverity string, source string, ta
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -880,6 +1428,9 @@ func (loc *NotificationRuleLocator) Patch(minSeverity string) error {
 	p = rsapi.APIParams{
 		"min_severity": minSeverity,
 	}
+	if err := loc.api.ValidateParams("NotificationRule", "patch", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NotificationRule", "patch")
 	if err != nil {
 		return err
@@ -892,15 +1443,10 @@ func (loc *NotificationRuleLocator) Patch(minSeverity string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -911,6 +1457,9 @@ func (loc *NotificationRuleLocator) Show() (*NotificationRule, error) {
 	var res *NotificationRule
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NotificationRule", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("NotificationRule", "show")
 	if err != nil {
 		return res, err
@@ -923,21 +1472,11 @@ func (loc *NotificationRuleLocator) Show() (*NotificationRule, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -947,6 +1486,9 @@ func (loc *NotificationRuleLocator) Show() (*NotificationRule, error) {
 func (loc *NotificationRuleLocator) Delete() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("NotificationRule", "delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NotificationRule", "delete")
 	if err != nil {
 		return err
@@ -959,37 +1501,79 @@ func (loc *NotificationRuleLocator) Delete() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// NotificationRuleMultiDeleteOptions contains the optional parameters of NotificationRuleLocator.MultiDelete.
+type NotificationRuleMultiDeleteOptions struct {
+	// Notification rule id
+	Id string
+	// The exact source of the rule to be deleted
+	Source string
+	// The notification target (user) that the rule applies to.
+	// Note that at this time, "me" is the only supported target.
+	Target string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NotificationRuleMultiDeleteOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "id", "source", "target":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NotificationRuleLocator.MultiDelete", n)
+		}
+	}
+	if o.Id != "" {
+		p["id"] = o.Id
+	}
+	if o.Source != "" {
+		p["source"] = o.Source
+	}
+	if o.Target != "" {
+		p["target"] = o.Target
+	}
+	return p, nil
+}
+
 // DELETE /accounts/:account_id/notification_rules
 //
 // Delete one or more notification rules by id or source and target.
-func (loc *NotificationRuleLocator) MultiDelete(options rsapi.APIParams) error {
+func (loc *NotificationRuleLocator) MultiDelete(options *NotificationRuleMultiDeleteOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var idOpt = options["id"]
+	var idOpt = opts["id"]
 	if idOpt != nil {
 		p["id"] = idOpt
 	}
-	var sourceOpt = options["source"]
+	var sourceOpt = opts["source"]
 	if sourceOpt != nil {
 		p["source"] = sourceOpt
 	}
-	var targetOpt = options["target"]
+	var targetOpt = opts["target"]
 	if targetOpt != nil {
 		p["target"] = targetOpt
 	}
+	if err := loc.api.ValidateParams("NotificationRule", "multi_delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("NotificationRule", "multi_delete")
 	if err != nil {
 		return err
@@ -1002,15 +1586,10 @@ func (loc *NotificationRuleLocator) MultiDelete(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1047,26 +1626,78 @@ func (api *API) UserPreferenceLocator(href string) *UserPreferenceLocator {
 	return &UserPreferenceLocator{Href(href), api}
 }
 
+// UserPreferenceLocatorInterface lists the UserPreferenceLocator methods, code that depends on the interface
+// rather than on UserPreferenceLocator can be unit tested using the generated fake locators.
+type UserPreferenceLocatorInterface interface {
+	Index(options *UserPreferenceIndexOptions) ([]*UserPreference, error)
+	IndexEach(options *UserPreferenceIndexOptions, fn func(*UserPreference) error) error
+	Show(options *UserPreferenceShowOptions) (*UserPreference, error)
+	Create(userId string, userPreferenceInfoId string, value interface{}) (*UserPreferenceLocator, error)
+	Update(value interface{}, options *UserPreferenceUpdateOptions) error
+	Delete() error
+}
+
 //===== Actions
 
+// UserPreferenceIndexOptions contains the optional parameters of UserPreferenceLocator.Index.
+type UserPreferenceIndexOptions struct {
+	// Filter by user, so that only UserPreference belonging to that user are returned. Use "me" as a shortcut for the current user ID.
+	Filter []string
+	// Optional view to return
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *UserPreferenceIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of UserPreferenceLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /accounts/:account_id/user_preferences
 //
 // List the UserPreference for users in this account.
 // Only administrators and infrastructure users may request the preferences of other users.
 // Users who are not members of the admin role need to specify a filter with their ID in order to retrieve their preferences.
-func (loc *UserPreferenceLocator) Index(options rsapi.APIParams) ([]*UserPreference, error) {
+func (loc *UserPreferenceLocator) Index(options *UserPreferenceIndexOptions) ([]*UserPreference, error) {
 	var res []*UserPreference
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("UserPreference", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("UserPreference", "index")
 	if err != nil {
 		return res, err
@@ -1079,36 +1710,109 @@ func (loc *UserPreferenceLocator) Index(options rsapi.APIParams) ([]*UserPrefere
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *UserPreferenceLocator) IndexEach(options *UserPreferenceIndexOptions, fn func(*UserPreference) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("UserPreference", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("UserPreference", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *UserPreference
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// UserPreferenceShowOptions contains the optional parameters of UserPreferenceLocator.Show.
+type UserPreferenceShowOptions struct {
+	// Optional view to return
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *UserPreferenceShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of UserPreferenceLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /accounts/:account_id/user_preferences/:id
 //
 // Get details for a particular UserPreference
-func (loc *UserPreferenceLocator) Show(options rsapi.APIParams) (*UserPreference, error) {
+func (loc *UserPreferenceLocator) Show(options *UserPreferenceShowOptions) (*UserPreference, error) {
 	var res *UserPreference
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("UserPreference", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("UserPreference", "show")
 	if err != nil {
 		return res, err
@@ -1121,21 +1825,11 @@ func (loc *UserPreferenceLocator) Show(options rsapi.APIParams) (*UserPreference
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -1159,6 +1853,9 @@ func (loc *UserPreferenceLocator) Create(userId string, userPreferenceInfoId str
 		"user_preference_info_id": userPreferenceInfoId,
 		"value":                   value,
 	}
+	if err := loc.api.ValidateParams("UserPreference", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("UserPreference", "create")
 	if err != nil {
 		return res, err
@@ -1171,15 +1868,10 @@ func (loc *UserPreferenceLocator) Create(userId string, userPreferenceInfoId str
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -1188,21 +1880,57 @@ func (loc *UserPreferenceLocator) Create(userId string, userPreferenceInfoId str
 	}
 }
 
+// UserPreferenceUpdateOptions contains the optional parameters of UserPreferenceLocator.Update.
+type UserPreferenceUpdateOptions struct {
+	// In a multipart request, the ID of the UserPreference to update
+	Id string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *UserPreferenceUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "id":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of UserPreferenceLocator.Update", n)
+		}
+	}
+	if o.Id != "" {
+		p["id"] = o.Id
+	}
+	return p, nil
+}
+
 // PATCH /accounts/:account_id/user_preferences/:id
 //
 // Update the value of a UserPreference.
 // Multiple values may be updated using a multipart request.
 // Values are validated with the corresponding UserPreferenceInfo.
-func (loc *UserPreferenceLocator) Update(value interface{}, options rsapi.APIParams) error {
+func (loc *UserPreferenceLocator) Update(value interface{}, options *UserPreferenceUpdateOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"value": value,
 	}
-	var idOpt = options["id"]
+	var idOpt = opts["id"]
 	if idOpt != nil {
 		p["id"] = idOpt
 	}
+	if err := loc.api.ValidateParams("UserPreference", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("UserPreference", "update")
 	if err != nil {
 		return err
@@ -1215,15 +1943,10 @@ func (loc *UserPreferenceLocator) Update(value interface{}, options rsapi.APIPar
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1233,6 +1956,9 @@ func (loc *UserPreferenceLocator) Update(value interface{}, options rsapi.APIPar
 func (loc *UserPreferenceLocator) Delete() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("UserPreference", "delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("UserPreference", "delete")
 	if err != nil {
 		return err
@@ -1245,15 +1971,10 @@ func (loc *UserPreferenceLocator) Delete() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1293,20 +2014,64 @@ func (api *API) UserPreferenceInfoLocator(href string) *UserPreferenceInfoLocato
 	return &UserPreferenceInfoLocator{Href(href), api}
 }
 
+// UserPreferenceInfoLocatorInterface lists the UserPreferenceInfoLocator methods, code that depends on the interface
+// rather than on UserPreferenceInfoLocator can be unit tested using the generated fake locators.
+type UserPreferenceInfoLocatorInterface interface {
+	Index(options *UserPreferenceInfoIndexOptions) ([]*UserPreferenceInfo, error)
+	IndexEach(options *UserPreferenceInfoIndexOptions, fn func(*UserPreferenceInfo) error) error
+	Show() (*UserPreferenceInfo, error)
+}
+
 //===== Actions
 
+// UserPreferenceInfoIndexOptions contains the optional parameters of UserPreferenceInfoLocator.Index.
+type UserPreferenceInfoIndexOptions struct {
+	// Filter by category and/or name
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *UserPreferenceInfoIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of UserPreferenceInfoLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /accounts/:account_id/user_preference_infos
 //
 // List the UserPreferenceInfo.
-func (loc *UserPreferenceInfoLocator) Index(options rsapi.APIParams) ([]*UserPreferenceInfo, error) {
+func (loc *UserPreferenceInfoLocator) Index(options *UserPreferenceInfoIndexOptions) ([]*UserPreferenceInfo, error) {
 	var res []*UserPreferenceInfo
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("UserPreferenceInfo", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("UserPreferenceInfo", "index")
 	if err != nil {
 		return res, err
@@ -1319,22 +2084,55 @@ func (loc *UserPreferenceInfoLocator) Index(options rsapi.APIParams) ([]*UserPre
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *UserPreferenceInfoLocator) IndexEach(options *UserPreferenceInfoIndexOptions, fn func(*UserPreferenceInfo) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("UserPreferenceInfo", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("UserPreferenceInfo", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *UserPreferenceInfo
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /accounts/:account_id/user_preference_infos/:id
@@ -1344,6 +2142,9 @@ func (loc *UserPreferenceInfoLocator) Show() (*UserPreferenceInfo, error) {
 	var res *UserPreferenceInfo
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("UserPreferenceInfo", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("UserPreferenceInfo", "show")
 	if err != nil {
 		return res, err
@@ -1356,21 +2157,11 @@ func (loc *UserPreferenceInfoLocator) Show() (*UserPreferenceInfo, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -1467,8 +2258,8 @@ type TemplateInfo struct {
 }
 
 type TimestampsStruct struct {
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *rsapi.Time `json:"created_at,omitempty"`
+	UpdatedAt *rsapi.Time `json:"updated_at,omitempty"`
 }
 
 type User struct {
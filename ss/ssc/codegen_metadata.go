@@ -1448,7 +1448,7 @@ and can launch them to create Executions in the Manager application.`,
 					&metadata.ActionParam{
 						Name:        "end_date",
 						Description: `When the CloudApp should be automatically terminated.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -1507,7 +1507,7 @@ and can launch them to create Executions in the Manager application.`,
 					&metadata.ActionParam{
 						Name:        "end_date",
 						Description: `When the CloudApp should be automatically terminated.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
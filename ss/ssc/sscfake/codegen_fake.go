@@ -0,0 +1,1137 @@
+//************************************************************************//
+//                     RightScale API client fakes
+//
+// Generated with:
+// $ praxisgen -metadata=ss/ssc/restful_doc -output=ss/ssc -pkg=ssc -target=1.0 -client=API
+//
+// The content of this file is auto-generated, DO NOT MODIFY
+//************************************************************************//
+
+// Package sscfake provides fake implementations of the ssc locator interfaces.
+// Each fake records the calls made to its methods and returns the values returned by the
+// corresponding stub functions if set, zero values otherwise.
+package sscfake
+
+import (
+	"io"
+	"sync"
+
+	"github.com/rightscale/rsc/ss/ssc"
+)
+
+/******  AccountPreference ******/
+
+// AccountPreferenceLocator is a fake implementation of ssc.AccountPreferenceLocatorInterface.
+type AccountPreferenceLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*ssc.AccountPreferenceIndexOptions) ([]*ssc.AccountPreference, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*AccountPreferenceIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*ssc.AccountPreferenceIndexOptions, func(*ssc.AccountPreference) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*AccountPreferenceIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*ssc.AccountPreference, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*AccountPreferenceShowCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(string, string, string) (*ssc.AccountPreferenceLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*AccountPreferenceCreateCall
+
+	// DeleteStub is called by Delete if not nil.
+	DeleteStub func() error
+	// DeleteCalls records the arguments of the calls made to Delete.
+	DeleteCalls []*AccountPreferenceDeleteCall
+}
+
+var _ ssc.AccountPreferenceLocatorInterface = (*AccountPreferenceLocator)(nil)
+
+// AccountPreferenceIndexCall records the arguments of a call to AccountPreferenceLocator.Index.
+type AccountPreferenceIndexCall struct {
+	Options *ssc.AccountPreferenceIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *AccountPreferenceLocator) Index(options *ssc.AccountPreferenceIndexOptions) ([]*ssc.AccountPreference, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &AccountPreferenceIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*ssc.AccountPreference
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *AccountPreferenceLocator) IndexReturns(res []*ssc.AccountPreference, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*ssc.AccountPreferenceIndexOptions) ([]*ssc.AccountPreference, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AccountPreferenceIndexEachCall records the arguments of a call to AccountPreferenceLocator.IndexEach.
+type AccountPreferenceIndexEachCall struct {
+	Options *ssc.AccountPreferenceIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *AccountPreferenceLocator) IndexEach(options *ssc.AccountPreferenceIndexOptions, fn func(*ssc.AccountPreference) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &AccountPreferenceIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *AccountPreferenceLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*ssc.AccountPreferenceIndexOptions, func(*ssc.AccountPreference) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// AccountPreferenceShowCall records the arguments of a call to AccountPreferenceLocator.Show.
+type AccountPreferenceShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *AccountPreferenceLocator) Show() (*ssc.AccountPreference, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &AccountPreferenceShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *ssc.AccountPreference
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *AccountPreferenceLocator) ShowReturns(res *ssc.AccountPreference, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*ssc.AccountPreference, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AccountPreferenceCreateCall records the arguments of a call to AccountPreferenceLocator.Create.
+type AccountPreferenceCreateCall struct {
+	GroupName string
+	Name      string
+	Value     string
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *AccountPreferenceLocator) Create(groupName string, name string, value string) (*ssc.AccountPreferenceLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &AccountPreferenceCreateCall{GroupName: groupName, Name: name, Value: value})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(groupName, name, value)
+	}
+	var res *ssc.AccountPreferenceLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *AccountPreferenceLocator) CreateReturns(res *ssc.AccountPreferenceLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(string, string, string) (*ssc.AccountPreferenceLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// AccountPreferenceDeleteCall records the arguments of a call to AccountPreferenceLocator.Delete.
+type AccountPreferenceDeleteCall struct{}
+
+// Delete records the call and returns the results of DeleteStub if set.
+func (fake *AccountPreferenceLocator) Delete() error {
+	fake.mu.Lock()
+	fake.DeleteCalls = append(fake.DeleteCalls, &AccountPreferenceDeleteCall{})
+	stub := fake.DeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DeleteReturns sets DeleteStub to a function that returns the given values.
+func (fake *AccountPreferenceLocator) DeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.DeleteStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Application ******/
+
+// ApplicationLocator is a fake implementation of ssc.ApplicationLocatorInterface.
+type ApplicationLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*ssc.ApplicationIndexOptions) ([]*ssc.Application, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ApplicationIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*ssc.ApplicationIndexOptions, func(*ssc.Application) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*ApplicationIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*ssc.ApplicationShowOptions) (*ssc.Application, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ApplicationShowCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*ssc.CompiledCAT, string, string, *ssc.ApplicationCreateOptions) (*ssc.ApplicationLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*ApplicationCreateCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*ssc.ApplicationUpdateOptions) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*ApplicationUpdateCall
+
+	// MultiUpdateStub is called by MultiUpdate if not nil.
+	MultiUpdateStub func(string, *ssc.ApplicationMultiUpdateOptions) error
+	// MultiUpdateCalls records the arguments of the calls made to MultiUpdate.
+	MultiUpdateCalls []*ApplicationMultiUpdateCall
+
+	// DeleteStub is called by Delete if not nil.
+	DeleteStub func() error
+	// DeleteCalls records the arguments of the calls made to Delete.
+	DeleteCalls []*ApplicationDeleteCall
+
+	// MultiDeleteStub is called by MultiDelete if not nil.
+	MultiDeleteStub func([]string) error
+	// MultiDeleteCalls records the arguments of the calls made to MultiDelete.
+	MultiDeleteCalls []*ApplicationMultiDeleteCall
+
+	// DownloadStub is called by Download if not nil.
+	DownloadStub func(string) error
+	// DownloadCalls records the arguments of the calls made to Download.
+	DownloadCalls []*ApplicationDownloadCall
+
+	// DownloadReaderStub is called by DownloadReader if not nil.
+	DownloadReaderStub func(string) (io.ReadCloser, error)
+	// DownloadReaderCalls records the arguments of the calls made to DownloadReader.
+	DownloadReaderCalls []*ApplicationDownloadCall
+
+	// LaunchStub is called by Launch if not nil.
+	LaunchStub func(*ssc.ApplicationLaunchOptions) error
+	// LaunchCalls records the arguments of the calls made to Launch.
+	LaunchCalls []*ApplicationLaunchCall
+}
+
+var _ ssc.ApplicationLocatorInterface = (*ApplicationLocator)(nil)
+
+// ApplicationIndexCall records the arguments of a call to ApplicationLocator.Index.
+type ApplicationIndexCall struct {
+	Options *ssc.ApplicationIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ApplicationLocator) Index(options *ssc.ApplicationIndexOptions) ([]*ssc.Application, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ApplicationIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*ssc.Application
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ApplicationLocator) IndexReturns(res []*ssc.Application, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*ssc.ApplicationIndexOptions) ([]*ssc.Application, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ApplicationIndexEachCall records the arguments of a call to ApplicationLocator.IndexEach.
+type ApplicationIndexEachCall struct {
+	Options *ssc.ApplicationIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *ApplicationLocator) IndexEach(options *ssc.ApplicationIndexOptions, fn func(*ssc.Application) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &ApplicationIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *ApplicationLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*ssc.ApplicationIndexOptions, func(*ssc.Application) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ApplicationShowCall records the arguments of a call to ApplicationLocator.Show.
+type ApplicationShowCall struct {
+	Options *ssc.ApplicationShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ApplicationLocator) Show(options *ssc.ApplicationShowOptions) (*ssc.Application, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ApplicationShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *ssc.Application
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ApplicationLocator) ShowReturns(res *ssc.Application, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*ssc.ApplicationShowOptions) (*ssc.Application, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ApplicationCreateCall records the arguments of a call to ApplicationLocator.Create.
+type ApplicationCreateCall struct {
+	CompiledCat      *ssc.CompiledCAT
+	Name             string
+	ShortDescription string
+	Options          *ssc.ApplicationCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *ApplicationLocator) Create(compiledCat *ssc.CompiledCAT, name string, shortDescription string, options *ssc.ApplicationCreateOptions) (*ssc.ApplicationLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &ApplicationCreateCall{CompiledCat: compiledCat, Name: name, ShortDescription: shortDescription, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(compiledCat, name, shortDescription, options)
+	}
+	var res *ssc.ApplicationLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *ApplicationLocator) CreateReturns(res *ssc.ApplicationLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*ssc.CompiledCAT, string, string, *ssc.ApplicationCreateOptions) (*ssc.ApplicationLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ApplicationUpdateCall records the arguments of a call to ApplicationLocator.Update.
+type ApplicationUpdateCall struct {
+	Options *ssc.ApplicationUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *ApplicationLocator) Update(options *ssc.ApplicationUpdateOptions) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &ApplicationUpdateCall{Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *ApplicationLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*ssc.ApplicationUpdateOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ApplicationMultiUpdateCall records the arguments of a call to ApplicationLocator.MultiUpdate.
+type ApplicationMultiUpdateCall struct {
+	Id      string
+	Options *ssc.ApplicationMultiUpdateOptions
+}
+
+// MultiUpdate records the call and returns the results of MultiUpdateStub if set.
+func (fake *ApplicationLocator) MultiUpdate(id string, options *ssc.ApplicationMultiUpdateOptions) error {
+	fake.mu.Lock()
+	fake.MultiUpdateCalls = append(fake.MultiUpdateCalls, &ApplicationMultiUpdateCall{Id: id, Options: options})
+	stub := fake.MultiUpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(id, options)
+	}
+	return nil
+}
+
+// MultiUpdateReturns sets MultiUpdateStub to a function that returns the given values.
+func (fake *ApplicationLocator) MultiUpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiUpdateStub = func(string, *ssc.ApplicationMultiUpdateOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ApplicationDeleteCall records the arguments of a call to ApplicationLocator.Delete.
+type ApplicationDeleteCall struct{}
+
+// Delete records the call and returns the results of DeleteStub if set.
+func (fake *ApplicationLocator) Delete() error {
+	fake.mu.Lock()
+	fake.DeleteCalls = append(fake.DeleteCalls, &ApplicationDeleteCall{})
+	stub := fake.DeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DeleteReturns sets DeleteStub to a function that returns the given values.
+func (fake *ApplicationLocator) DeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.DeleteStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ApplicationMultiDeleteCall records the arguments of a call to ApplicationLocator.MultiDelete.
+type ApplicationMultiDeleteCall struct {
+	Ids []string
+}
+
+// MultiDelete records the call and returns the results of MultiDeleteStub if set.
+func (fake *ApplicationLocator) MultiDelete(ids []string) error {
+	fake.mu.Lock()
+	fake.MultiDeleteCalls = append(fake.MultiDeleteCalls, &ApplicationMultiDeleteCall{Ids: ids})
+	stub := fake.MultiDeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(ids)
+	}
+	return nil
+}
+
+// MultiDeleteReturns sets MultiDeleteStub to a function that returns the given values.
+func (fake *ApplicationLocator) MultiDeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiDeleteStub = func([]string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ApplicationDownloadCall records the arguments of a call to ApplicationLocator.Download.
+type ApplicationDownloadCall struct {
+	ApiVersion string
+}
+
+// Download records the call and returns the results of DownloadStub if set.
+func (fake *ApplicationLocator) Download(apiVersion string) error {
+	fake.mu.Lock()
+	fake.DownloadCalls = append(fake.DownloadCalls, &ApplicationDownloadCall{ApiVersion: apiVersion})
+	stub := fake.DownloadStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(apiVersion)
+	}
+	return nil
+}
+
+// DownloadReturns sets DownloadStub to a function that returns the given values.
+func (fake *ApplicationLocator) DownloadReturns(err error) {
+	fake.mu.Lock()
+	fake.DownloadStub = func(string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// DownloadReader records the call and returns the results of DownloadReaderStub if set.
+func (fake *ApplicationLocator) DownloadReader(apiVersion string) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.DownloadReaderCalls = append(fake.DownloadReaderCalls, &ApplicationDownloadCall{ApiVersion: apiVersion})
+	stub := fake.DownloadReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(apiVersion)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// DownloadReaderReturns sets DownloadReaderStub to a function that returns the given values.
+func (fake *ApplicationLocator) DownloadReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.DownloadReaderStub = func(string) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ApplicationLaunchCall records the arguments of a call to ApplicationLocator.Launch.
+type ApplicationLaunchCall struct {
+	Options *ssc.ApplicationLaunchOptions
+}
+
+// Launch records the call and returns the results of LaunchStub if set.
+func (fake *ApplicationLocator) Launch(options *ssc.ApplicationLaunchOptions) error {
+	fake.mu.Lock()
+	fake.LaunchCalls = append(fake.LaunchCalls, &ApplicationLaunchCall{Options: options})
+	stub := fake.LaunchStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// LaunchReturns sets LaunchStub to a function that returns the given values.
+func (fake *ApplicationLocator) LaunchReturns(err error) {
+	fake.mu.Lock()
+	fake.LaunchStub = func(*ssc.ApplicationLaunchOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  NotificationRule ******/
+
+// NotificationRuleLocator is a fake implementation of ssc.NotificationRuleLocatorInterface.
+type NotificationRuleLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(string, *ssc.NotificationRuleIndexOptions) ([]*ssc.NotificationRule, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*NotificationRuleIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(string, *ssc.NotificationRuleIndexOptions, func(*ssc.NotificationRule) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*NotificationRuleIndexEachCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(string, string, string, *ssc.NotificationRuleCreateOptions) (*ssc.NotificationRuleLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*NotificationRuleCreateCall
+
+	// PatchStub is called by Patch if not nil.
+	PatchStub func(string) error
+	// PatchCalls records the arguments of the calls made to Patch.
+	PatchCalls []*NotificationRulePatchCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*ssc.NotificationRule, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*NotificationRuleShowCall
+
+	// DeleteStub is called by Delete if not nil.
+	DeleteStub func() error
+	// DeleteCalls records the arguments of the calls made to Delete.
+	DeleteCalls []*NotificationRuleDeleteCall
+
+	// MultiDeleteStub is called by MultiDelete if not nil.
+	MultiDeleteStub func(*ssc.NotificationRuleMultiDeleteOptions) error
+	// MultiDeleteCalls records the arguments of the calls made to MultiDelete.
+	MultiDeleteCalls []*NotificationRuleMultiDeleteCall
+}
+
+var _ ssc.NotificationRuleLocatorInterface = (*NotificationRuleLocator)(nil)
+
+// NotificationRuleIndexCall records the arguments of a call to NotificationRuleLocator.Index.
+type NotificationRuleIndexCall struct {
+	Source  string
+	Options *ssc.NotificationRuleIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *NotificationRuleLocator) Index(source string, options *ssc.NotificationRuleIndexOptions) ([]*ssc.NotificationRule, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &NotificationRuleIndexCall{Source: source, Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(source, options)
+	}
+	var res []*ssc.NotificationRule
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *NotificationRuleLocator) IndexReturns(res []*ssc.NotificationRule, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(string, *ssc.NotificationRuleIndexOptions) ([]*ssc.NotificationRule, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NotificationRuleIndexEachCall records the arguments of a call to NotificationRuleLocator.IndexEach.
+type NotificationRuleIndexEachCall struct {
+	Source  string
+	Options *ssc.NotificationRuleIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *NotificationRuleLocator) IndexEach(source string, options *ssc.NotificationRuleIndexOptions, fn func(*ssc.NotificationRule) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &NotificationRuleIndexEachCall{Source: source, Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(source, options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(source, options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *NotificationRuleLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(string, *ssc.NotificationRuleIndexOptions, func(*ssc.NotificationRule) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NotificationRuleCreateCall records the arguments of a call to NotificationRuleLocator.Create.
+type NotificationRuleCreateCall struct {
+	MinSeverity string
+	Source      string
+	Target      string
+	Options     *ssc.NotificationRuleCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *NotificationRuleLocator) Create(minSeverity string, source string, target string, options *ssc.NotificationRuleCreateOptions) (*ssc.NotificationRuleLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &NotificationRuleCreateCall{MinSeverity: minSeverity, Source: source, Target: target, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(minSeverity, source, target, options)
+	}
+	var res *ssc.NotificationRuleLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *NotificationRuleLocator) CreateReturns(res *ssc.NotificationRuleLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(string, string, string, *ssc.NotificationRuleCreateOptions) (*ssc.NotificationRuleLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NotificationRulePatchCall records the arguments of a call to NotificationRuleLocator.Patch.
+type NotificationRulePatchCall struct {
+	MinSeverity string
+}
+
+// Patch records the call and returns the results of PatchStub if set.
+func (fake *NotificationRuleLocator) Patch(minSeverity string) error {
+	fake.mu.Lock()
+	fake.PatchCalls = append(fake.PatchCalls, &NotificationRulePatchCall{MinSeverity: minSeverity})
+	stub := fake.PatchStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(minSeverity)
+	}
+	return nil
+}
+
+// PatchReturns sets PatchStub to a function that returns the given values.
+func (fake *NotificationRuleLocator) PatchReturns(err error) {
+	fake.mu.Lock()
+	fake.PatchStub = func(string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NotificationRuleShowCall records the arguments of a call to NotificationRuleLocator.Show.
+type NotificationRuleShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *NotificationRuleLocator) Show() (*ssc.NotificationRule, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &NotificationRuleShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *ssc.NotificationRule
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *NotificationRuleLocator) ShowReturns(res *ssc.NotificationRule, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*ssc.NotificationRule, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NotificationRuleDeleteCall records the arguments of a call to NotificationRuleLocator.Delete.
+type NotificationRuleDeleteCall struct{}
+
+// Delete records the call and returns the results of DeleteStub if set.
+func (fake *NotificationRuleLocator) Delete() error {
+	fake.mu.Lock()
+	fake.DeleteCalls = append(fake.DeleteCalls, &NotificationRuleDeleteCall{})
+	stub := fake.DeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DeleteReturns sets DeleteStub to a function that returns the given values.
+func (fake *NotificationRuleLocator) DeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.DeleteStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NotificationRuleMultiDeleteCall records the arguments of a call to NotificationRuleLocator.MultiDelete.
+type NotificationRuleMultiDeleteCall struct {
+	Options *ssc.NotificationRuleMultiDeleteOptions
+}
+
+// MultiDelete records the call and returns the results of MultiDeleteStub if set.
+func (fake *NotificationRuleLocator) MultiDelete(options *ssc.NotificationRuleMultiDeleteOptions) error {
+	fake.mu.Lock()
+	fake.MultiDeleteCalls = append(fake.MultiDeleteCalls, &NotificationRuleMultiDeleteCall{Options: options})
+	stub := fake.MultiDeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// MultiDeleteReturns sets MultiDeleteStub to a function that returns the given values.
+func (fake *NotificationRuleLocator) MultiDeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiDeleteStub = func(*ssc.NotificationRuleMultiDeleteOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  UserPreference ******/
+
+// UserPreferenceLocator is a fake implementation of ssc.UserPreferenceLocatorInterface.
+type UserPreferenceLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*ssc.UserPreferenceIndexOptions) ([]*ssc.UserPreference, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*UserPreferenceIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*ssc.UserPreferenceIndexOptions, func(*ssc.UserPreference) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*UserPreferenceIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*ssc.UserPreferenceShowOptions) (*ssc.UserPreference, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*UserPreferenceShowCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(string, string, interface{}) (*ssc.UserPreferenceLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*UserPreferenceCreateCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(interface{}, *ssc.UserPreferenceUpdateOptions) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*UserPreferenceUpdateCall
+
+	// DeleteStub is called by Delete if not nil.
+	DeleteStub func() error
+	// DeleteCalls records the arguments of the calls made to Delete.
+	DeleteCalls []*UserPreferenceDeleteCall
+}
+
+var _ ssc.UserPreferenceLocatorInterface = (*UserPreferenceLocator)(nil)
+
+// UserPreferenceIndexCall records the arguments of a call to UserPreferenceLocator.Index.
+type UserPreferenceIndexCall struct {
+	Options *ssc.UserPreferenceIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *UserPreferenceLocator) Index(options *ssc.UserPreferenceIndexOptions) ([]*ssc.UserPreference, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &UserPreferenceIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*ssc.UserPreference
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *UserPreferenceLocator) IndexReturns(res []*ssc.UserPreference, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*ssc.UserPreferenceIndexOptions) ([]*ssc.UserPreference, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UserPreferenceIndexEachCall records the arguments of a call to UserPreferenceLocator.IndexEach.
+type UserPreferenceIndexEachCall struct {
+	Options *ssc.UserPreferenceIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *UserPreferenceLocator) IndexEach(options *ssc.UserPreferenceIndexOptions, fn func(*ssc.UserPreference) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &UserPreferenceIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *UserPreferenceLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*ssc.UserPreferenceIndexOptions, func(*ssc.UserPreference) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// UserPreferenceShowCall records the arguments of a call to UserPreferenceLocator.Show.
+type UserPreferenceShowCall struct {
+	Options *ssc.UserPreferenceShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *UserPreferenceLocator) Show(options *ssc.UserPreferenceShowOptions) (*ssc.UserPreference, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &UserPreferenceShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *ssc.UserPreference
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *UserPreferenceLocator) ShowReturns(res *ssc.UserPreference, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*ssc.UserPreferenceShowOptions) (*ssc.UserPreference, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UserPreferenceCreateCall records the arguments of a call to UserPreferenceLocator.Create.
+type UserPreferenceCreateCall struct {
+	UserId               string
+	UserPreferenceInfoId string
+	Value                interface{}
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *UserPreferenceLocator) Create(userId string, userPreferenceInfoId string, value interface{}) (*ssc.UserPreferenceLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &UserPreferenceCreateCall{UserId: userId, UserPreferenceInfoId: userPreferenceInfoId, Value: value})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(userId, userPreferenceInfoId, value)
+	}
+	var res *ssc.UserPreferenceLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *UserPreferenceLocator) CreateReturns(res *ssc.UserPreferenceLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(string, string, interface{}) (*ssc.UserPreferenceLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UserPreferenceUpdateCall records the arguments of a call to UserPreferenceLocator.Update.
+type UserPreferenceUpdateCall struct {
+	Value   interface{}
+	Options *ssc.UserPreferenceUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *UserPreferenceLocator) Update(value interface{}, options *ssc.UserPreferenceUpdateOptions) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &UserPreferenceUpdateCall{Value: value, Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(value, options)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *UserPreferenceLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(interface{}, *ssc.UserPreferenceUpdateOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// UserPreferenceDeleteCall records the arguments of a call to UserPreferenceLocator.Delete.
+type UserPreferenceDeleteCall struct{}
+
+// Delete records the call and returns the results of DeleteStub if set.
+func (fake *UserPreferenceLocator) Delete() error {
+	fake.mu.Lock()
+	fake.DeleteCalls = append(fake.DeleteCalls, &UserPreferenceDeleteCall{})
+	stub := fake.DeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DeleteReturns sets DeleteStub to a function that returns the given values.
+func (fake *UserPreferenceLocator) DeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.DeleteStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  UserPreferenceInfo ******/
+
+// UserPreferenceInfoLocator is a fake implementation of ssc.UserPreferenceInfoLocatorInterface.
+type UserPreferenceInfoLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*ssc.UserPreferenceInfoIndexOptions) ([]*ssc.UserPreferenceInfo, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*UserPreferenceInfoIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*ssc.UserPreferenceInfoIndexOptions, func(*ssc.UserPreferenceInfo) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*UserPreferenceInfoIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*ssc.UserPreferenceInfo, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*UserPreferenceInfoShowCall
+}
+
+var _ ssc.UserPreferenceInfoLocatorInterface = (*UserPreferenceInfoLocator)(nil)
+
+// UserPreferenceInfoIndexCall records the arguments of a call to UserPreferenceInfoLocator.Index.
+type UserPreferenceInfoIndexCall struct {
+	Options *ssc.UserPreferenceInfoIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *UserPreferenceInfoLocator) Index(options *ssc.UserPreferenceInfoIndexOptions) ([]*ssc.UserPreferenceInfo, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &UserPreferenceInfoIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*ssc.UserPreferenceInfo
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *UserPreferenceInfoLocator) IndexReturns(res []*ssc.UserPreferenceInfo, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*ssc.UserPreferenceInfoIndexOptions) ([]*ssc.UserPreferenceInfo, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// UserPreferenceInfoIndexEachCall records the arguments of a call to UserPreferenceInfoLocator.IndexEach.
+type UserPreferenceInfoIndexEachCall struct {
+	Options *ssc.UserPreferenceInfoIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *UserPreferenceInfoLocator) IndexEach(options *ssc.UserPreferenceInfoIndexOptions, fn func(*ssc.UserPreferenceInfo) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &UserPreferenceInfoIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *UserPreferenceInfoLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*ssc.UserPreferenceInfoIndexOptions, func(*ssc.UserPreferenceInfo) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// UserPreferenceInfoShowCall records the arguments of a call to UserPreferenceInfoLocator.Show.
+type UserPreferenceInfoShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *UserPreferenceInfoLocator) Show() (*ssc.UserPreferenceInfo, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &UserPreferenceInfoShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *ssc.UserPreferenceInfo
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *UserPreferenceInfoLocator) ShowReturns(res *ssc.UserPreferenceInfo, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*ssc.UserPreferenceInfo, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
@@ -12,8 +12,7 @@ package ssd
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"time"
+	"io"
 
 	"github.com/rightscale/rsc/metadata"
 	"github.com/rightscale/rsc/rsapi"
@@ -89,6 +88,18 @@ func (api *API) ScheduleLocator(href string) *ScheduleLocator {
 	return &ScheduleLocator{Href(href), api}
 }
 
+// ScheduleLocatorInterface lists the ScheduleLocator methods, code that depends on the interface
+// rather than on ScheduleLocator can be unit tested using the generated fake locators.
+type ScheduleLocatorInterface interface {
+	Index() ([]*Schedule, error)
+	IndexEach(fn func(*Schedule) error) error
+	Show() (*Schedule, error)
+	Create(name string, startRecurrence *Recurrence, stopRecurrence *Recurrence, options *ScheduleCreateOptions) (*ScheduleLocator, error)
+	Update(options *ScheduleUpdateOptions) error
+	Delete() error
+	MultiDelete(ids []string) error
+}
+
 //===== Actions
 
 // GET /collections/:collection_id/schedules
@@ -98,6 +109,9 @@ func (loc *ScheduleLocator) Index() ([]*Schedule, error) {
 	var res []*Schedule
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Schedule", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Schedule", "index")
 	if err != nil {
 		return res, err
@@ -110,22 +124,46 @@ func (loc *ScheduleLocator) Index() ([]*Schedule, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *ScheduleLocator) IndexEach(fn func(*Schedule) error) error {
+	var params rsapi.APIParams
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Schedule", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Schedule", "index")
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Schedule
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /collections/:collection_id/schedules/:id
@@ -135,6 +173,9 @@ func (loc *ScheduleLocator) Show() (*Schedule, error) {
 	var res *Schedule
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Schedule", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Schedule", "show")
 	if err != nil {
 		return res, err
@@ -147,28 +188,47 @@ func (loc *ScheduleLocator) Show() (*Schedule, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ScheduleCreateOptions contains the optional parameters of ScheduleLocator.Create.
+type ScheduleCreateOptions struct {
+	// An optional description that will help users understand the purpose of the Schedule
+	Description string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScheduleCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "description":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScheduleLocator.Create", n)
+		}
+	}
+	if o.Description != "" {
+		p["description"] = o.Description
+	}
+	return p, nil
+}
+
 // POST /collections/:collection_id/schedules
 //
 // Create a new Schedule.
-func (loc *ScheduleLocator) Create(name string, startRecurrence *Recurrence, stopRecurrence *Recurrence, options rsapi.APIParams) (*ScheduleLocator, error) {
+func (loc *ScheduleLocator) Create(name string, startRecurrence *Recurrence, stopRecurrence *Recurrence, options *ScheduleCreateOptions) (*ScheduleLocator, error) {
 	var res *ScheduleLocator
 	if name == "" {
 		return res, fmt.Errorf("name is required")
@@ -179,6 +239,10 @@ func (loc *ScheduleLocator) Create(name string, startRecurrence *Recurrence, sto
 	if stopRecurrence == nil {
 		return res, fmt.Errorf("stopRecurrence is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
@@ -186,10 +250,13 @@ func (loc *ScheduleLocator) Create(name string, startRecurrence *Recurrence, sto
 		"start_recurrence": startRecurrence,
 		"stop_recurrence":  stopRecurrence,
 	}
-	var descriptionOpt = options["description"]
+	var descriptionOpt = opts["description"]
 	if descriptionOpt != nil {
 		p["description"] = descriptionOpt
 	}
+	if err := loc.api.ValidateParams("Schedule", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Schedule", "create")
 	if err != nil {
 		return res, err
@@ -202,15 +269,10 @@ func (loc *ScheduleLocator) Create(name string, startRecurrence *Recurrence, sto
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -219,30 +281,81 @@ func (loc *ScheduleLocator) Create(name string, startRecurrence *Recurrence, sto
 	}
 }
 
+// ScheduleUpdateOptions contains the optional parameters of ScheduleLocator.Update.
+type ScheduleUpdateOptions struct {
+	// An optional description that will help users understand the purpose of the Schedule
+	Description string
+	// The unique name of the Schedule
+	Name string
+	// When to start a CloudApp
+	StartRecurrence *Recurrence
+	// When to stop a CloudApp
+	StopRecurrence *Recurrence
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScheduleUpdateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "description", "name", "start_recurrence", "stop_recurrence":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScheduleLocator.Update", n)
+		}
+	}
+	if o.Description != "" {
+		p["description"] = o.Description
+	}
+	if o.Name != "" {
+		p["name"] = o.Name
+	}
+	if o.StartRecurrence != nil {
+		p["start_recurrence"] = o.StartRecurrence
+	}
+	if o.StopRecurrence != nil {
+		p["stop_recurrence"] = o.StopRecurrence
+	}
+	return p, nil
+}
+
 // PATCH /collections/:collection_id/schedules/:id
 //
 // Update one or more attributes of an existing Schedule.
 // Note: updating a Schedule in Designer doesn't update it in the applications that were published with it to the Catalog or affect running CloudApps with that Schedule.
-func (loc *ScheduleLocator) Update(options rsapi.APIParams) error {
+func (loc *ScheduleLocator) Update(options *ScheduleUpdateOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var descriptionOpt = options["description"]
+	var descriptionOpt = opts["description"]
 	if descriptionOpt != nil {
 		p["description"] = descriptionOpt
 	}
-	var nameOpt = options["name"]
+	var nameOpt = opts["name"]
 	if nameOpt != nil {
 		p["name"] = nameOpt
 	}
-	var startRecurrenceOpt = options["start_recurrence"]
+	var startRecurrenceOpt = opts["start_recurrence"]
 	if startRecurrenceOpt != nil {
 		p["start_recurrence"] = startRecurrenceOpt
 	}
-	var stopRecurrenceOpt = options["stop_recurrence"]
+	var stopRecurrenceOpt = opts["stop_recurrence"]
 	if stopRecurrenceOpt != nil {
 		p["stop_recurrence"] = stopRecurrenceOpt
 	}
+	if err := loc.api.ValidateParams("Schedule", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Schedule", "update")
 	if err != nil {
 		return err
@@ -255,15 +368,10 @@ func (loc *ScheduleLocator) Update(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -274,6 +382,9 @@ func (loc *ScheduleLocator) Update(options rsapi.APIParams) error {
 func (loc *ScheduleLocator) Delete() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Schedule", "delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Schedule", "delete")
 	if err != nil {
 		return err
@@ -286,15 +397,10 @@ func (loc *ScheduleLocator) Delete() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -311,6 +417,9 @@ func (loc *ScheduleLocator) MultiDelete(ids []string) error {
 		"ids[]": ids,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Schedule", "multi_delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Schedule", "multi_delete")
 	if err != nil {
 		return err
@@ -323,15 +432,10 @@ func (loc *ScheduleLocator) MultiDelete(ids []string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -382,20 +486,73 @@ func (api *API) TemplateLocator(href string) *TemplateLocator {
 	return &TemplateLocator{Href(href), api}
 }
 
+// TemplateLocatorInterface lists the TemplateLocator methods, code that depends on the interface
+// rather than on TemplateLocator can be unit tested using the generated fake locators.
+type TemplateLocatorInterface interface {
+	Index(options *TemplateIndexOptions) ([]*Template, error)
+	IndexEach(options *TemplateIndexOptions, fn func(*Template) error) error
+	Show(options *TemplateShowOptions) (*Template, error)
+	Create(source *rsapi.FileUpload) (*TemplateLocator, error)
+	Update(source *rsapi.FileUpload) error
+	Delete() error
+	MultiDelete(ids []string) error
+	Download(apiVersion string) error
+	DownloadReader(apiVersion string) (io.ReadCloser, error)
+	Compile(source string) error
+	Publish(id string, options *TemplatePublishOptions) error
+	Unpublish(id string) error
+}
+
 //===== Actions
 
+// TemplateIndexOptions contains the optional parameters of TemplateLocator.Index.
+type TemplateIndexOptions struct {
+	// An optional list of template IDs to retrieve
+	Ids []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *TemplateIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "ids":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of TemplateLocator.Index", n)
+		}
+	}
+	if o.Ids != nil {
+		p["ids"] = o.Ids
+	}
+	return p, nil
+}
+
 // GET /collections/:collection_id/templates
 //
 // List the templates available in Designer along with some general details.
-func (loc *TemplateLocator) Index(options rsapi.APIParams) ([]*Template, error) {
+func (loc *TemplateLocator) Index(options *TemplateIndexOptions) ([]*Template, error) {
 	var res []*Template
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var idsOpt = options["ids"]
+	var idsOpt = opts["ids"]
 	if idsOpt != nil {
 		params["ids[]"] = idsOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Template", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Template", "index")
 	if err != nil {
 		return res, err
@@ -408,36 +565,105 @@ func (loc *TemplateLocator) Index(options rsapi.APIParams) ([]*Template, error)
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *TemplateLocator) IndexEach(options *TemplateIndexOptions, fn func(*Template) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var idsOpt = opts["ids"]
+	if idsOpt != nil {
+		params["ids[]"] = idsOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Template", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Template", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Template
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// TemplateShowOptions contains the optional parameters of TemplateLocator.Show.
+type TemplateShowOptions struct {
+	// Optional view to return
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *TemplateShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of TemplateLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /collections/:collection_id/templates/:id
 //
 // Show detailed information about a given Template. Use the views specified below for more information.
-func (loc *TemplateLocator) Show(options rsapi.APIParams) (*Template, error) {
+func (loc *TemplateLocator) Show(options *TemplateShowOptions) (*Template, error) {
 	var res *Template
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Template", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Template", "show")
 	if err != nil {
 		return res, err
@@ -450,21 +676,11 @@ func (loc *TemplateLocator) Show(options rsapi.APIParams) (*Template, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -478,6 +694,9 @@ func (loc *TemplateLocator) Create(source *rsapi.FileUpload) (*TemplateLocator,
 	p = rsapi.APIParams{
 		"source": source,
 	}
+	if err := loc.api.ValidateParams("Template", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Template", "create")
 	if err != nil {
 		return res, err
@@ -490,15 +709,10 @@ func (loc *TemplateLocator) Create(source *rsapi.FileUpload) (*TemplateLocator,
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -516,6 +730,9 @@ func (loc *TemplateLocator) Update(source *rsapi.FileUpload) error {
 	p = rsapi.APIParams{
 		"source": source,
 	}
+	if err := loc.api.ValidateParams("Template", "update", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Template", "update")
 	if err != nil {
 		return err
@@ -528,15 +745,10 @@ func (loc *TemplateLocator) Update(source *rsapi.FileUpload) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -546,6 +758,9 @@ func (loc *TemplateLocator) Update(source *rsapi.FileUpload) error {
 func (loc *TemplateLocator) Delete() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Template", "delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Template", "delete")
 	if err != nil {
 		return err
@@ -558,15 +773,10 @@ func (loc *TemplateLocator) Delete() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -582,6 +792,9 @@ func (loc *TemplateLocator) MultiDelete(ids []string) error {
 		"ids[]": ids,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Template", "multi_delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Template", "multi_delete")
 	if err != nil {
 		return err
@@ -594,15 +807,10 @@ func (loc *TemplateLocator) MultiDelete(ids []string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -618,6 +826,9 @@ func (loc *TemplateLocator) Download(apiVersion string) error {
 		"api_version": apiVersion,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Template", "download", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Template", "download")
 	if err != nil {
 		return err
@@ -630,18 +841,45 @@ func (loc *TemplateLocator) Download(apiVersion string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// DownloadReader is identical to Download except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *TemplateLocator) DownloadReader(apiVersion string) (io.ReadCloser, error) {
+	if apiVersion == "" {
+		return nil, fmt.Errorf("apiVersion is required")
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{
+		"api_version": apiVersion,
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Template", "download", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Template", "download")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 // POST /collections/:collection_id/templates/actions/compile
 //
 // Compile the Template, but don't save it to Designer. Useful for debugging a CAT file while you are still authoring it.
@@ -654,6 +892,9 @@ func (loc *TemplateLocator) Compile(source string) error {
 	p = rsapi.APIParams{
 		"source": source,
 	}
+	if err := loc.api.ValidateParams("Template", "compile", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Template", "compile")
 	if err != nil {
 		return err
@@ -666,50 +907,101 @@ func (loc *TemplateLocator) Compile(source string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// TemplatePublishOptions contains the optional parameters of TemplateLocator.Publish.
+type TemplatePublishOptions struct {
+	// Optionally override the Template name for display in the Catalog
+	Name string
+	// If re-publishing, you must specify the href of the Application in the Catalog that is being overridden
+	OverriddenApplicationHref string
+	// Whether the CloudApp requires a schedule to be provided at launch time. If set to false, allows user to pick from '24/7' schedule when launching in the UI
+	ScheduleRequired bool
+	// Schedules available to users when launching the application
+	Schedules []string
+	// Optionally override the Template short description for display in the Catalog
+	ShortDescription string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *TemplatePublishOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "name", "overridden_application_href", "schedule_required", "schedules", "short_description":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of TemplateLocator.Publish", n)
+		}
+	}
+	if o.Name != "" {
+		p["name"] = o.Name
+	}
+	if o.OverriddenApplicationHref != "" {
+		p["overridden_application_href"] = o.OverriddenApplicationHref
+	}
+	if o.ScheduleRequired {
+		p["schedule_required"] = o.ScheduleRequired
+	}
+	if o.Schedules != nil {
+		p["schedules"] = o.Schedules
+	}
+	if o.ShortDescription != "" {
+		p["short_description"] = o.ShortDescription
+	}
+	return p, nil
+}
+
 // POST /collections/:collection_id/templates/actions/publish
 //
 // Publish the given Template to the Catalog so that users can launch it.
-func (loc *TemplateLocator) Publish(id string, options rsapi.APIParams) error {
+func (loc *TemplateLocator) Publish(id string, options *TemplatePublishOptions) error {
 	if id == "" {
 		return fmt.Errorf("id is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"id": id,
 	}
-	var nameOpt = options["name"]
+	var nameOpt = opts["name"]
 	if nameOpt != nil {
 		p["name"] = nameOpt
 	}
-	var overriddenApplicationHrefOpt = options["overridden_application_href"]
+	var overriddenApplicationHrefOpt = opts["overridden_application_href"]
 	if overriddenApplicationHrefOpt != nil {
 		p["overridden_application_href"] = overriddenApplicationHrefOpt
 	}
-	var scheduleRequiredOpt = options["schedule_required"]
+	var scheduleRequiredOpt = opts["schedule_required"]
 	if scheduleRequiredOpt != nil {
 		p["schedule_required"] = scheduleRequiredOpt
 	}
-	var schedulesOpt = options["schedules"]
+	var schedulesOpt = opts["schedules"]
 	if schedulesOpt != nil {
 		p["schedules"] = schedulesOpt
 	}
-	var shortDescriptionOpt = options["short_description"]
+	var shortDescriptionOpt = opts["short_description"]
 	if shortDescriptionOpt != nil {
 		p["short_description"] = shortDescriptionOpt
 	}
+	if err := loc.api.ValidateParams("Template", "publish", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Template", "publish")
 	if err != nil {
 		return err
@@ -722,15 +1014,10 @@ func (loc *TemplateLocator) Publish(id string, options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -746,6 +1033,9 @@ func (loc *TemplateLocator) Unpublish(id string) error {
 	p = rsapi.APIParams{
 		"id": id,
 	}
+	if err := loc.api.ValidateParams("Template", "unpublish", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Template", "unpublish")
 	if err != nil {
 		return err
@@ -758,15 +1048,10 @@ func (loc *TemplateLocator) Unpublish(id string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -811,14 +1096,14 @@ type Recurrence struct {
 }
 
 type TimestampsStruct struct {
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *rsapi.Time `json:"created_at,omitempty"`
+	UpdatedAt *rsapi.Time `json:"updated_at,omitempty"`
 }
 
 type TimestampsStruct2 struct {
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	PublishedAt *time.Time `json:"published_at,omitempty"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	CreatedAt   *rsapi.Time `json:"created_at,omitempty"`
+	PublishedAt *rsapi.Time `json:"published_at,omitempty"`
+	UpdatedAt   *rsapi.Time `json:"updated_at,omitempty"`
 }
 
 type User struct {
@@ -0,0 +1,652 @@
+//************************************************************************//
+//                     RightScale API client fakes
+//
+// Generated with:
+// $ praxisgen -metadata=ss/ssd/restful_doc -output=ss/ssd -pkg=ssd -target=1.0 -client=API
+//
+// The content of this file is auto-generated, DO NOT MODIFY
+//************************************************************************//
+
+// Package ssdfake provides fake implementations of the ssd locator interfaces.
+// Each fake records the calls made to its methods and returns the values returned by the
+// corresponding stub functions if set, zero values otherwise.
+package ssdfake
+
+import (
+	"io"
+	"sync"
+
+	"github.com/rightscale/rsc/rsapi"
+	"github.com/rightscale/rsc/ss/ssd"
+)
+
+/******  Schedule ******/
+
+// ScheduleLocator is a fake implementation of ssd.ScheduleLocatorInterface.
+type ScheduleLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func() ([]*ssd.Schedule, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ScheduleIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(func(*ssd.Schedule) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*ScheduleIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*ssd.Schedule, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ScheduleShowCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(string, *ssd.Recurrence, *ssd.Recurrence, *ssd.ScheduleCreateOptions) (*ssd.ScheduleLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*ScheduleCreateCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*ssd.ScheduleUpdateOptions) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*ScheduleUpdateCall
+
+	// DeleteStub is called by Delete if not nil.
+	DeleteStub func() error
+	// DeleteCalls records the arguments of the calls made to Delete.
+	DeleteCalls []*ScheduleDeleteCall
+
+	// MultiDeleteStub is called by MultiDelete if not nil.
+	MultiDeleteStub func([]string) error
+	// MultiDeleteCalls records the arguments of the calls made to MultiDelete.
+	MultiDeleteCalls []*ScheduleMultiDeleteCall
+}
+
+var _ ssd.ScheduleLocatorInterface = (*ScheduleLocator)(nil)
+
+// ScheduleIndexCall records the arguments of a call to ScheduleLocator.Index.
+type ScheduleIndexCall struct{}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ScheduleLocator) Index() ([]*ssd.Schedule, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ScheduleIndexCall{})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res []*ssd.Schedule
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ScheduleLocator) IndexReturns(res []*ssd.Schedule, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func() ([]*ssd.Schedule, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduleIndexEachCall records the arguments of a call to ScheduleLocator.IndexEach.
+type ScheduleIndexEachCall struct{}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *ScheduleLocator) IndexEach(fn func(*ssd.Schedule) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &ScheduleIndexEachCall{})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each()
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *ScheduleLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(func(*ssd.Schedule) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduleShowCall records the arguments of a call to ScheduleLocator.Show.
+type ScheduleShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ScheduleLocator) Show() (*ssd.Schedule, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ScheduleShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *ssd.Schedule
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ScheduleLocator) ShowReturns(res *ssd.Schedule, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*ssd.Schedule, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduleCreateCall records the arguments of a call to ScheduleLocator.Create.
+type ScheduleCreateCall struct {
+	Name            string
+	StartRecurrence *ssd.Recurrence
+	StopRecurrence  *ssd.Recurrence
+	Options         *ssd.ScheduleCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *ScheduleLocator) Create(name string, startRecurrence *ssd.Recurrence, stopRecurrence *ssd.Recurrence, options *ssd.ScheduleCreateOptions) (*ssd.ScheduleLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &ScheduleCreateCall{Name: name, StartRecurrence: startRecurrence, StopRecurrence: stopRecurrence, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(name, startRecurrence, stopRecurrence, options)
+	}
+	var res *ssd.ScheduleLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *ScheduleLocator) CreateReturns(res *ssd.ScheduleLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(string, *ssd.Recurrence, *ssd.Recurrence, *ssd.ScheduleCreateOptions) (*ssd.ScheduleLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduleUpdateCall records the arguments of a call to ScheduleLocator.Update.
+type ScheduleUpdateCall struct {
+	Options *ssd.ScheduleUpdateOptions
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *ScheduleLocator) Update(options *ssd.ScheduleUpdateOptions) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &ScheduleUpdateCall{Options: options})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *ScheduleLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*ssd.ScheduleUpdateOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduleDeleteCall records the arguments of a call to ScheduleLocator.Delete.
+type ScheduleDeleteCall struct{}
+
+// Delete records the call and returns the results of DeleteStub if set.
+func (fake *ScheduleLocator) Delete() error {
+	fake.mu.Lock()
+	fake.DeleteCalls = append(fake.DeleteCalls, &ScheduleDeleteCall{})
+	stub := fake.DeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DeleteReturns sets DeleteStub to a function that returns the given values.
+func (fake *ScheduleLocator) DeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.DeleteStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduleMultiDeleteCall records the arguments of a call to ScheduleLocator.MultiDelete.
+type ScheduleMultiDeleteCall struct {
+	Ids []string
+}
+
+// MultiDelete records the call and returns the results of MultiDeleteStub if set.
+func (fake *ScheduleLocator) MultiDelete(ids []string) error {
+	fake.mu.Lock()
+	fake.MultiDeleteCalls = append(fake.MultiDeleteCalls, &ScheduleMultiDeleteCall{Ids: ids})
+	stub := fake.MultiDeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(ids)
+	}
+	return nil
+}
+
+// MultiDeleteReturns sets MultiDeleteStub to a function that returns the given values.
+func (fake *ScheduleLocator) MultiDeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiDeleteStub = func([]string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Template ******/
+
+// TemplateLocator is a fake implementation of ssd.TemplateLocatorInterface.
+type TemplateLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*ssd.TemplateIndexOptions) ([]*ssd.Template, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*TemplateIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*ssd.TemplateIndexOptions, func(*ssd.Template) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*TemplateIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*ssd.TemplateShowOptions) (*ssd.Template, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*TemplateShowCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*rsapi.FileUpload) (*ssd.TemplateLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*TemplateCreateCall
+
+	// UpdateStub is called by Update if not nil.
+	UpdateStub func(*rsapi.FileUpload) error
+	// UpdateCalls records the arguments of the calls made to Update.
+	UpdateCalls []*TemplateUpdateCall
+
+	// DeleteStub is called by Delete if not nil.
+	DeleteStub func() error
+	// DeleteCalls records the arguments of the calls made to Delete.
+	DeleteCalls []*TemplateDeleteCall
+
+	// MultiDeleteStub is called by MultiDelete if not nil.
+	MultiDeleteStub func([]string) error
+	// MultiDeleteCalls records the arguments of the calls made to MultiDelete.
+	MultiDeleteCalls []*TemplateMultiDeleteCall
+
+	// DownloadStub is called by Download if not nil.
+	DownloadStub func(string) error
+	// DownloadCalls records the arguments of the calls made to Download.
+	DownloadCalls []*TemplateDownloadCall
+
+	// DownloadReaderStub is called by DownloadReader if not nil.
+	DownloadReaderStub func(string) (io.ReadCloser, error)
+	// DownloadReaderCalls records the arguments of the calls made to DownloadReader.
+	DownloadReaderCalls []*TemplateDownloadCall
+
+	// CompileStub is called by Compile if not nil.
+	CompileStub func(string) error
+	// CompileCalls records the arguments of the calls made to Compile.
+	CompileCalls []*TemplateCompileCall
+
+	// PublishStub is called by Publish if not nil.
+	PublishStub func(string, *ssd.TemplatePublishOptions) error
+	// PublishCalls records the arguments of the calls made to Publish.
+	PublishCalls []*TemplatePublishCall
+
+	// UnpublishStub is called by Unpublish if not nil.
+	UnpublishStub func(string) error
+	// UnpublishCalls records the arguments of the calls made to Unpublish.
+	UnpublishCalls []*TemplateUnpublishCall
+}
+
+var _ ssd.TemplateLocatorInterface = (*TemplateLocator)(nil)
+
+// TemplateIndexCall records the arguments of a call to TemplateLocator.Index.
+type TemplateIndexCall struct {
+	Options *ssd.TemplateIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *TemplateLocator) Index(options *ssd.TemplateIndexOptions) ([]*ssd.Template, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &TemplateIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*ssd.Template
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *TemplateLocator) IndexReturns(res []*ssd.Template, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*ssd.TemplateIndexOptions) ([]*ssd.Template, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// TemplateIndexEachCall records the arguments of a call to TemplateLocator.IndexEach.
+type TemplateIndexEachCall struct {
+	Options *ssd.TemplateIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *TemplateLocator) IndexEach(options *ssd.TemplateIndexOptions, fn func(*ssd.Template) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &TemplateIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *TemplateLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*ssd.TemplateIndexOptions, func(*ssd.Template) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// TemplateShowCall records the arguments of a call to TemplateLocator.Show.
+type TemplateShowCall struct {
+	Options *ssd.TemplateShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *TemplateLocator) Show(options *ssd.TemplateShowOptions) (*ssd.Template, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &TemplateShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *ssd.Template
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *TemplateLocator) ShowReturns(res *ssd.Template, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*ssd.TemplateShowOptions) (*ssd.Template, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// TemplateCreateCall records the arguments of a call to TemplateLocator.Create.
+type TemplateCreateCall struct {
+	Source *rsapi.FileUpload
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *TemplateLocator) Create(source *rsapi.FileUpload) (*ssd.TemplateLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &TemplateCreateCall{Source: source})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(source)
+	}
+	var res *ssd.TemplateLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *TemplateLocator) CreateReturns(res *ssd.TemplateLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*rsapi.FileUpload) (*ssd.TemplateLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// TemplateUpdateCall records the arguments of a call to TemplateLocator.Update.
+type TemplateUpdateCall struct {
+	Source *rsapi.FileUpload
+}
+
+// Update records the call and returns the results of UpdateStub if set.
+func (fake *TemplateLocator) Update(source *rsapi.FileUpload) error {
+	fake.mu.Lock()
+	fake.UpdateCalls = append(fake.UpdateCalls, &TemplateUpdateCall{Source: source})
+	stub := fake.UpdateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(source)
+	}
+	return nil
+}
+
+// UpdateReturns sets UpdateStub to a function that returns the given values.
+func (fake *TemplateLocator) UpdateReturns(err error) {
+	fake.mu.Lock()
+	fake.UpdateStub = func(*rsapi.FileUpload) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// TemplateDeleteCall records the arguments of a call to TemplateLocator.Delete.
+type TemplateDeleteCall struct{}
+
+// Delete records the call and returns the results of DeleteStub if set.
+func (fake *TemplateLocator) Delete() error {
+	fake.mu.Lock()
+	fake.DeleteCalls = append(fake.DeleteCalls, &TemplateDeleteCall{})
+	stub := fake.DeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DeleteReturns sets DeleteStub to a function that returns the given values.
+func (fake *TemplateLocator) DeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.DeleteStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// TemplateMultiDeleteCall records the arguments of a call to TemplateLocator.MultiDelete.
+type TemplateMultiDeleteCall struct {
+	Ids []string
+}
+
+// MultiDelete records the call and returns the results of MultiDeleteStub if set.
+func (fake *TemplateLocator) MultiDelete(ids []string) error {
+	fake.mu.Lock()
+	fake.MultiDeleteCalls = append(fake.MultiDeleteCalls, &TemplateMultiDeleteCall{Ids: ids})
+	stub := fake.MultiDeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(ids)
+	}
+	return nil
+}
+
+// MultiDeleteReturns sets MultiDeleteStub to a function that returns the given values.
+func (fake *TemplateLocator) MultiDeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiDeleteStub = func([]string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// TemplateDownloadCall records the arguments of a call to TemplateLocator.Download.
+type TemplateDownloadCall struct {
+	ApiVersion string
+}
+
+// Download records the call and returns the results of DownloadStub if set.
+func (fake *TemplateLocator) Download(apiVersion string) error {
+	fake.mu.Lock()
+	fake.DownloadCalls = append(fake.DownloadCalls, &TemplateDownloadCall{ApiVersion: apiVersion})
+	stub := fake.DownloadStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(apiVersion)
+	}
+	return nil
+}
+
+// DownloadReturns sets DownloadStub to a function that returns the given values.
+func (fake *TemplateLocator) DownloadReturns(err error) {
+	fake.mu.Lock()
+	fake.DownloadStub = func(string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// DownloadReader records the call and returns the results of DownloadReaderStub if set.
+func (fake *TemplateLocator) DownloadReader(apiVersion string) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.DownloadReaderCalls = append(fake.DownloadReaderCalls, &TemplateDownloadCall{ApiVersion: apiVersion})
+	stub := fake.DownloadReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(apiVersion)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// DownloadReaderReturns sets DownloadReaderStub to a function that returns the given values.
+func (fake *TemplateLocator) DownloadReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.DownloadReaderStub = func(string) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// TemplateCompileCall records the arguments of a call to TemplateLocator.Compile.
+type TemplateCompileCall struct {
+	Source string
+}
+
+// Compile records the call and returns the results of CompileStub if set.
+func (fake *TemplateLocator) Compile(source string) error {
+	fake.mu.Lock()
+	fake.CompileCalls = append(fake.CompileCalls, &TemplateCompileCall{Source: source})
+	stub := fake.CompileStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(source)
+	}
+	return nil
+}
+
+// CompileReturns sets CompileStub to a function that returns the given values.
+func (fake *TemplateLocator) CompileReturns(err error) {
+	fake.mu.Lock()
+	fake.CompileStub = func(string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// TemplatePublishCall records the arguments of a call to TemplateLocator.Publish.
+type TemplatePublishCall struct {
+	Id      string
+	Options *ssd.TemplatePublishOptions
+}
+
+// Publish records the call and returns the results of PublishStub if set.
+func (fake *TemplateLocator) Publish(id string, options *ssd.TemplatePublishOptions) error {
+	fake.mu.Lock()
+	fake.PublishCalls = append(fake.PublishCalls, &TemplatePublishCall{Id: id, Options: options})
+	stub := fake.PublishStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(id, options)
+	}
+	return nil
+}
+
+// PublishReturns sets PublishStub to a function that returns the given values.
+func (fake *TemplateLocator) PublishReturns(err error) {
+	fake.mu.Lock()
+	fake.PublishStub = func(string, *ssd.TemplatePublishOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// TemplateUnpublishCall records the arguments of a call to TemplateLocator.Unpublish.
+type TemplateUnpublishCall struct {
+	Id string
+}
+
+// Unpublish records the call and returns the results of UnpublishStub if set.
+func (fake *TemplateLocator) Unpublish(id string) error {
+	fake.mu.Lock()
+	fake.UnpublishCalls = append(fake.UnpublishCalls, &TemplateUnpublishCall{Id: id})
+	stub := fake.UnpublishStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(id)
+	}
+	return nil
+}
+
+// UnpublishReturns sets UnpublishStub to a function that returns the given values.
+func (fake *TemplateLocator) UnpublishReturns(err error) {
+	fake.mu.Lock()
+	fake.UnpublishStub = func(string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
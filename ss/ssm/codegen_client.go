@@ -12,8 +12,7 @@ package ssm
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"time"
+	"io"
 
 	"github.com/rightscale/rsc/metadata"
 	"github.com/rightscale/rsc/rsapi"
@@ -79,7 +78,7 @@ type Execution struct {
 	Deployment           string                 `json:"deployment,omitempty"`
 	DeploymentUrl        string                 `json:"deployment_url,omitempty"`
 	Description          string                 `json:"description,omitempty"`
-	EndsAt               *time.Time             `json:"ends_at,omitempty"`
+	EndsAt               *rsapi.Time            `json:"ends_at,omitempty"`
 	Href                 string                 `json:"href,omitempty"`
 	Id                   string                 `json:"id,omitempty"`
 	Kind                 string                 `json:"kind,omitempty"`
@@ -104,6 +103,24 @@ func (r *Execution) Locator(api *API) *ExecutionLocator {
 	return api.ExecutionLocator(r.Href)
 }
 
+// LatestNotificationsLocator returns a locator for the resource pointed to by the "latest_notifications" link.
+// It returns nil if the link is absent.
+func (r *Execution) LatestNotificationsLocator(api *API) *NotificationLocator {
+	if r.Links == nil || r.Links.LatestNotifications == nil || r.Links.LatestNotifications.Href == "" {
+		return nil
+	}
+	return api.NotificationLocator(r.Links.LatestNotifications.Href)
+}
+
+// RunningOperationsLocator returns a locator for the resource pointed to by the "running_operations" link.
+// It returns nil if the link is absent.
+func (r *Execution) RunningOperationsLocator(api *API) *OperationLocator {
+	if r.Links == nil || r.Links.RunningOperations == nil || r.Links.RunningOperations.Href == "" {
+		return nil
+	}
+	return api.OperationLocator(r.Links.RunningOperations.Href)
+}
+
 //===== Locator
 
 // ExecutionLocator exposes the Execution resource actions.
@@ -117,28 +134,98 @@ func (api *API) ExecutionLocator(href string) *ExecutionLocator {
 	return &ExecutionLocator{Href(href), api}
 }
 
+// ExecutionLocatorInterface lists the ExecutionLocator methods, code that depends on the interface
+// rather than on ExecutionLocator can be unit tested using the generated fake locators.
+type ExecutionLocatorInterface interface {
+	Index(options *ExecutionIndexOptions) ([]*Execution, error)
+	IndexEach(options *ExecutionIndexOptions, fn func(*Execution) error) error
+	Show(options *ExecutionShowOptions) (*Execution, error)
+	Create(options *ExecutionCreateOptions) (*ExecutionLocator, error)
+	Patch(options *ExecutionPatchOptions) error
+	Delete(options *ExecutionDeleteOptions) error
+	MultiDelete(ids []string, options *ExecutionMultiDeleteOptions) error
+	Download(apiVersion string) error
+	DownloadReader(apiVersion string) (io.ReadCloser, error)
+	Launch() error
+	Start() error
+	Stop() error
+	Terminate() error
+	MultiLaunch(ids []string) error
+	MultiStart(ids []string) error
+	MultiStop(ids []string) error
+	MultiTerminate(ids []string) error
+	Run(name string, options *ExecutionRunOptions) error
+	MultiRun(ids []string, name string, options *ExecutionMultiRunOptions) error
+}
+
 //===== Actions
 
+// ExecutionIndexOptions contains the optional parameters of ExecutionLocator.Index.
+type ExecutionIndexOptions struct {
+	// Filter by status, syntax is ["status==running"]
+	Filter []string
+	// An optional list of execution IDs to retrieve
+	Ids []string
+	// Optional view to return
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ExecutionIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "ids", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ExecutionLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.Ids != nil {
+		p["ids"] = o.Ids
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /projects/:project_id/executions
 //
 // List information about the Executions, or use a filter to only return certain Executions. A view can be used for various levels of detail.
-func (loc *ExecutionLocator) Index(options rsapi.APIParams) ([]*Execution, error) {
+func (loc *ExecutionLocator) Index(options *ExecutionIndexOptions) ([]*Execution, error) {
 	var res []*Execution
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var idsOpt = options["ids"]
+	var idsOpt = opts["ids"]
 	if idsOpt != nil {
 		params["ids[]"] = idsOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Execution", "index")
 	if err != nil {
 		return res, err
@@ -151,36 +238,113 @@ func (loc *ExecutionLocator) Index(options rsapi.APIParams) ([]*Execution, error
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *ExecutionLocator) IndexEach(options *ExecutionIndexOptions, fn func(*Execution) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var idsOpt = opts["ids"]
+	if idsOpt != nil {
+		params["ids[]"] = idsOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Execution", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Execution
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// ExecutionShowOptions contains the optional parameters of ExecutionLocator.Show.
+type ExecutionShowOptions struct {
+	// Optional view to return
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ExecutionShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ExecutionLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /projects/:project_id/executions/:id
 //
 // Show details for a given Execution. A view can be used for various levels of detail.
-func (loc *ExecutionLocator) Show(options rsapi.APIParams) (*Execution, error) {
+func (loc *ExecutionLocator) Show(options *ExecutionShowOptions) (*Execution, error) {
 	var res *Execution
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Execution", "show")
 	if err != nil {
 		return res, err
@@ -193,80 +357,161 @@ func (loc *ExecutionLocator) Show(options rsapi.APIParams) (*Execution, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ExecutionCreateOptions contains the optional parameters of ExecutionLocator.Create.
+type ExecutionCreateOptions struct {
+	// The href of the Application in Catalog from which to create the Execution. This attribute is mutually exclusive with: source, compiled_cat, and template_href.
+	ApplicationHref string
+	// The compiled CAT source from which to create the Execution. This attribute is mutually exclusive with: source, template_href and application_href.
+	CompiledCat *CompiledCAT
+	// The currently selected schedule name, or nil for CloudApps using the '24/7' schedule
+	CurrentSchedule string
+	// The description for the execution. The short_description of the Template will be used if none is provided.
+	Description string
+	// The day on which the CloudApp should be automatically terminated
+	EndsAt *rsapi.Time
+	// The name for the Execution. The Template name will be used if none is provided. This will be used as the name of the deployment (appended with a unique ID).
+	Name string
+	// The configuration options of the Execution. These are the values provided for the CloudApp parameters.
+	Options_ []*ConfigurationOption
+	// Whether the CloudApp requires a schedule. If set to false, allows user to pick from '24/7' schedule in the UI
+	ScheduleRequired bool
+	// The inital ScheduledActions to apply to the Execution.
+	ScheduledActions []*ScheduledActionParam
+	// The schedules available to the CloudApp
+	Schedules []*Schedule
+	// The raw CAT source from which to create the Execution. The CAT will be compiled first and then launched if successful. This attribute is mutually exclusive with: compiled_cat, template_href and application_href.
+	Source string
+	// The href of the Template in Designer from which to create the Execution. This attribute is mutually exclusive with: source, compiled_cat, and application_href.
+	TemplateHref string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ExecutionCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "application_href", "compiled_cat", "current_schedule", "description", "ends_at", "name", "options", "schedule_required", "scheduled_actions", "schedules", "source", "template_href":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ExecutionLocator.Create", n)
+		}
+	}
+	if o.ApplicationHref != "" {
+		p["application_href"] = o.ApplicationHref
+	}
+	if o.CompiledCat != nil {
+		p["compiled_cat"] = o.CompiledCat
+	}
+	if o.CurrentSchedule != "" {
+		p["current_schedule"] = o.CurrentSchedule
+	}
+	if o.Description != "" {
+		p["description"] = o.Description
+	}
+	if o.EndsAt != nil {
+		p["ends_at"] = o.EndsAt
+	}
+	if o.Name != "" {
+		p["name"] = o.Name
+	}
+	if o.Options_ != nil {
+		p["options"] = o.Options_
+	}
+	if o.ScheduleRequired {
+		p["schedule_required"] = o.ScheduleRequired
+	}
+	if o.ScheduledActions != nil {
+		p["scheduled_actions"] = o.ScheduledActions
+	}
+	if o.Schedules != nil {
+		p["schedules"] = o.Schedules
+	}
+	if o.Source != "" {
+		p["source"] = o.Source
+	}
+	if o.TemplateHref != "" {
+		p["template_href"] = o.TemplateHref
+	}
+	return p, nil
+}
+
 // POST /projects/:project_id/executions
 //
 // Create a new execution from a CAT, a compiled CAT, an Application in the Catalog, or a Template in Designer
-func (loc *ExecutionLocator) Create(options rsapi.APIParams) (*ExecutionLocator, error) {
+func (loc *ExecutionLocator) Create(options *ExecutionCreateOptions) (*ExecutionLocator, error) {
 	var res *ExecutionLocator
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var applicationHrefOpt = options["application_href"]
+	var applicationHrefOpt = opts["application_href"]
 	if applicationHrefOpt != nil {
 		p["application_href"] = applicationHrefOpt
 	}
-	var compiledCatOpt = options["compiled_cat"]
+	var compiledCatOpt = opts["compiled_cat"]
 	if compiledCatOpt != nil {
 		p["compiled_cat"] = compiledCatOpt
 	}
-	var currentScheduleOpt = options["current_schedule"]
+	var currentScheduleOpt = opts["current_schedule"]
 	if currentScheduleOpt != nil {
 		p["current_schedule"] = currentScheduleOpt
 	}
-	var descriptionOpt = options["description"]
+	var descriptionOpt = opts["description"]
 	if descriptionOpt != nil {
 		p["description"] = descriptionOpt
 	}
-	var endsAtOpt = options["ends_at"]
+	var endsAtOpt = opts["ends_at"]
 	if endsAtOpt != nil {
 		p["ends_at"] = endsAtOpt
 	}
-	var nameOpt = options["name"]
+	var nameOpt = opts["name"]
 	if nameOpt != nil {
 		p["name"] = nameOpt
 	}
-	var options_Opt = options["options"]
+	var options_Opt = opts["options"]
 	if options_Opt != nil {
 		p["options"] = options_Opt
 	}
-	var scheduleRequiredOpt = options["schedule_required"]
+	var scheduleRequiredOpt = opts["schedule_required"]
 	if scheduleRequiredOpt != nil {
 		p["schedule_required"] = scheduleRequiredOpt
 	}
-	var scheduledActionsOpt = options["scheduled_actions"]
+	var scheduledActionsOpt = opts["scheduled_actions"]
 	if scheduledActionsOpt != nil {
 		p["scheduled_actions"] = scheduledActionsOpt
 	}
-	var schedulesOpt = options["schedules"]
+	var schedulesOpt = opts["schedules"]
 	if schedulesOpt != nil {
 		p["schedules"] = schedulesOpt
 	}
-	var sourceOpt = options["source"]
+	var sourceOpt = opts["source"]
 	if sourceOpt != nil {
 		p["source"] = sourceOpt
 	}
-	var templateHrefOpt = options["template_href"]
+	var templateHrefOpt = opts["template_href"]
 	if templateHrefOpt != nil {
 		p["template_href"] = templateHrefOpt
 	}
+	if err := loc.api.ValidateParams("Execution", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Execution", "create")
 	if err != nil {
 		return res, err
@@ -279,15 +524,10 @@ func (loc *ExecutionLocator) Create(options rsapi.APIParams) (*ExecutionLocator,
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -296,21 +536,62 @@ func (loc *ExecutionLocator) Create(options rsapi.APIParams) (*ExecutionLocator,
 	}
 }
 
+// ExecutionPatchOptions contains the optional parameters of ExecutionLocator.Patch.
+type ExecutionPatchOptions struct {
+	// The name of the schedule to select, or nil to use the '24/7' schedule
+	CurrentSchedule string
+	// The day on which the CloudApp should be automatically terminated
+	EndsAt *rsapi.Time
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ExecutionPatchOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "current_schedule", "ends_at":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ExecutionLocator.Patch", n)
+		}
+	}
+	if o.CurrentSchedule != "" {
+		p["current_schedule"] = o.CurrentSchedule
+	}
+	if o.EndsAt != nil {
+		p["ends_at"] = o.EndsAt
+	}
+	return p, nil
+}
+
 // PATCH /projects/:project_id/executions/:id
 //
 // Updates an execution end date or selected schedule.
-func (loc *ExecutionLocator) Patch(options rsapi.APIParams) error {
+func (loc *ExecutionLocator) Patch(options *ExecutionPatchOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var currentScheduleOpt = options["current_schedule"]
+	var currentScheduleOpt = opts["current_schedule"]
 	if currentScheduleOpt != nil {
 		p["current_schedule"] = currentScheduleOpt
 	}
-	var endsAtOpt = options["ends_at"]
+	var endsAtOpt = opts["ends_at"]
 	if endsAtOpt != nil {
 		p["ends_at"] = endsAtOpt
 	}
+	if err := loc.api.ValidateParams("Execution", "patch", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "patch")
 	if err != nil {
 		return err
@@ -323,29 +604,61 @@ func (loc *ExecutionLocator) Patch(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ExecutionDeleteOptions contains the optional parameters of ExecutionLocator.Delete.
+type ExecutionDeleteOptions struct {
+	// Force delete execution, bypass state validation so that non term can deleted.
+	// Note: using this option may leave cloud resources running that must manually be destroyed.
+	Force bool
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ExecutionDeleteOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "force":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ExecutionLocator.Delete", n)
+		}
+	}
+	if o.Force {
+		p["force"] = o.Force
+	}
+	return p, nil
+}
+
 // DELETE /projects/:project_id/executions/:id
 //
 // No description provided for delete.
-func (loc *ExecutionLocator) Delete(options rsapi.APIParams) error {
+func (loc *ExecutionLocator) Delete(options *ExecutionDeleteOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var forceOpt = options["force"]
+	var forceOpt = opts["force"]
 	if forceOpt != nil {
 		params["force"] = forceOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "delete")
 	if err != nil {
 		return err
@@ -358,34 +671,66 @@ func (loc *ExecutionLocator) Delete(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ExecutionMultiDeleteOptions contains the optional parameters of ExecutionLocator.MultiDelete.
+type ExecutionMultiDeleteOptions struct {
+	// Force delete execution, bypass state validation so that non term can deleted.
+	// Note:       using this option may leave cloud resources running that must manually be destroyed.
+	Force bool
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ExecutionMultiDeleteOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "force":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ExecutionLocator.MultiDelete", n)
+		}
+	}
+	if o.Force {
+		p["force"] = o.Force
+	}
+	return p, nil
+}
+
 // DELETE /projects/:project_id/executions
 //
 // Delete several executions from the database. Note: if an execution has not successfully been terminated, there may still be associated cloud resources running.
-func (loc *ExecutionLocator) MultiDelete(ids []string, options rsapi.APIParams) error {
+func (loc *ExecutionLocator) MultiDelete(ids []string, options *ExecutionMultiDeleteOptions) error {
 	if len(ids) == 0 {
 		return fmt.Errorf("ids is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"ids[]": ids,
 	}
-	var forceOpt = options["force"]
+	var forceOpt = opts["force"]
 	if forceOpt != nil {
 		params["force"] = forceOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "multi_delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "multi_delete")
 	if err != nil {
 		return err
@@ -398,15 +743,10 @@ func (loc *ExecutionLocator) MultiDelete(ids []string, options rsapi.APIParams)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -422,6 +762,9 @@ func (loc *ExecutionLocator) Download(apiVersion string) error {
 		"api_version": apiVersion,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "download", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "download")
 	if err != nil {
 		return err
@@ -434,24 +777,54 @@ func (loc *ExecutionLocator) Download(apiVersion string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// DownloadReader is identical to Download except that it returns the response body
+// without reading it. The caller must close the returned reader.
+func (loc *ExecutionLocator) DownloadReader(apiVersion string) (io.ReadCloser, error) {
+	if apiVersion == "" {
+		return nil, fmt.Errorf("apiVersion is required")
+	}
+	var params rsapi.APIParams
+	params = rsapi.APIParams{
+		"api_version": apiVersion,
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "download", params, p); err != nil {
+		return nil, err
+	}
+	uri, err := loc.ActionPath("Execution", "download")
+	if err != nil {
+		return nil, err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, rsapi.NewAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
 // POST /projects/:project_id/executions/:id/actions/launch
 //
 // Launch an Execution.
 func (loc *ExecutionLocator) Launch() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "launch", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "launch")
 	if err != nil {
 		return err
@@ -464,15 +837,10 @@ func (loc *ExecutionLocator) Launch() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -482,6 +850,9 @@ func (loc *ExecutionLocator) Launch() error {
 func (loc *ExecutionLocator) Start() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "start", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "start")
 	if err != nil {
 		return err
@@ -494,15 +865,10 @@ func (loc *ExecutionLocator) Start() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -512,6 +878,9 @@ func (loc *ExecutionLocator) Start() error {
 func (loc *ExecutionLocator) Stop() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "stop", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "stop")
 	if err != nil {
 		return err
@@ -524,15 +893,10 @@ func (loc *ExecutionLocator) Stop() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -542,6 +906,9 @@ func (loc *ExecutionLocator) Stop() error {
 func (loc *ExecutionLocator) Terminate() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "terminate", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "terminate")
 	if err != nil {
 		return err
@@ -554,15 +921,10 @@ func (loc *ExecutionLocator) Terminate() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -578,6 +940,9 @@ func (loc *ExecutionLocator) MultiLaunch(ids []string) error {
 		"ids[]": ids,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "multi_launch", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "multi_launch")
 	if err != nil {
 		return err
@@ -590,15 +955,10 @@ func (loc *ExecutionLocator) MultiLaunch(ids []string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -614,6 +974,9 @@ func (loc *ExecutionLocator) MultiStart(ids []string) error {
 		"ids[]": ids,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "multi_start", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "multi_start")
 	if err != nil {
 		return err
@@ -626,15 +989,10 @@ func (loc *ExecutionLocator) MultiStart(ids []string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -650,6 +1008,9 @@ func (loc *ExecutionLocator) MultiStop(ids []string) error {
 		"ids[]": ids,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "multi_stop", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "multi_stop")
 	if err != nil {
 		return err
@@ -662,15 +1023,10 @@ func (loc *ExecutionLocator) MultiStop(ids []string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -686,6 +1042,9 @@ func (loc *ExecutionLocator) MultiTerminate(ids []string) error {
 		"ids[]": ids,
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Execution", "multi_terminate", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "multi_terminate")
 	if err != nil {
 		return err
@@ -698,34 +1057,65 @@ func (loc *ExecutionLocator) MultiTerminate(ids []string) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ExecutionRunOptions contains the optional parameters of ExecutionLocator.Run.
+type ExecutionRunOptions struct {
+	// The configuration options of the operation. These are the values provided for the CloudApp parameters that this operation depends on.
+	ConfigurationOptions []*ConfigurationOption
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ExecutionRunOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "configuration_options":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ExecutionLocator.Run", n)
+		}
+	}
+	if o.ConfigurationOptions != nil {
+		p["configuration_options"] = o.ConfigurationOptions
+	}
+	return p, nil
+}
+
 // POST /projects/:project_id/executions/:id/actions/run
 //
 // Runs an Operation on an Execution.
-func (loc *ExecutionLocator) Run(name string, options rsapi.APIParams) error {
+func (loc *ExecutionLocator) Run(name string, options *ExecutionRunOptions) error {
 	if name == "" {
 		return fmt.Errorf("name is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"name": name,
 	}
-	var configurationOptionsOpt = options["configuration_options"]
+	var configurationOptionsOpt = opts["configuration_options"]
 	if configurationOptionsOpt != nil {
 		p["configuration_options"] = configurationOptionsOpt
 	}
+	if err := loc.api.ValidateParams("Execution", "run", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "run")
 	if err != nil {
 		return err
@@ -738,28 +1128,56 @@ func (loc *ExecutionLocator) Run(name string, options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ExecutionMultiRunOptions contains the optional parameters of ExecutionLocator.MultiRun.
+type ExecutionMultiRunOptions struct {
+	// The configuration options of the operation. These are the values provided for the CloudApp parameters that this operation depends on.
+	ConfigurationOptions []*ConfigurationOption
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ExecutionMultiRunOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "configuration_options":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ExecutionLocator.MultiRun", n)
+		}
+	}
+	if o.ConfigurationOptions != nil {
+		p["configuration_options"] = o.ConfigurationOptions
+	}
+	return p, nil
+}
+
 // POST /projects/:project_id/executions/actions/run
 //
 // Runs an Operation on several Executions.
-func (loc *ExecutionLocator) MultiRun(ids []string, name string, options rsapi.APIParams) error {
+func (loc *ExecutionLocator) MultiRun(ids []string, name string, options *ExecutionMultiRunOptions) error {
 	if len(ids) == 0 {
 		return fmt.Errorf("ids is required")
 	}
 	if name == "" {
 		return fmt.Errorf("name is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{
 		"ids[]": ids,
@@ -768,10 +1186,13 @@ func (loc *ExecutionLocator) MultiRun(ids []string, name string, options rsapi.A
 	p = rsapi.APIParams{
 		"name": name,
 	}
-	var configurationOptionsOpt = options["configuration_options"]
+	var configurationOptionsOpt = opts["configuration_options"]
 	if configurationOptionsOpt != nil {
 		p["configuration_options"] = configurationOptionsOpt
 	}
+	if err := loc.api.ValidateParams("Execution", "multi_run", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("Execution", "multi_run")
 	if err != nil {
 		return err
@@ -784,15 +1205,10 @@ func (loc *ExecutionLocator) MultiRun(ids []string, name string, options rsapi.A
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -818,6 +1234,15 @@ func (r *Notification) Locator(api *API) *NotificationLocator {
 	return api.NotificationLocator(r.Href)
 }
 
+// ExecutionLocator returns a locator for the resource pointed to by the "execution" link.
+// It returns nil if the link is absent.
+func (r *Notification) ExecutionLocator(api *API) *ExecutionLocator {
+	if r.Links == nil || r.Links.Execution == nil || r.Links.Execution.Href == "" {
+		return nil
+	}
+	return api.ExecutionLocator(r.Links.Execution.Href)
+}
+
 //===== Locator
 
 // NotificationLocator exposes the Notification resource actions.
@@ -831,24 +1256,73 @@ func (api *API) NotificationLocator(href string) *NotificationLocator {
 	return &NotificationLocator{Href(href), api}
 }
 
+// NotificationLocatorInterface lists the NotificationLocator methods, code that depends on the interface
+// rather than on NotificationLocator can be unit tested using the generated fake locators.
+type NotificationLocatorInterface interface {
+	Index(options *NotificationIndexOptions) ([]*Notification, error)
+	IndexEach(options *NotificationIndexOptions, fn func(*Notification) error) error
+	Show() (*Notification, error)
+}
+
 //===== Actions
 
+// NotificationIndexOptions contains the optional parameters of NotificationLocator.Index.
+type NotificationIndexOptions struct {
+	// Filter by Execution
+	Filter []string
+	// The Notification IDs to return
+	Ids []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *NotificationIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "ids":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of NotificationLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.Ids != nil {
+		p["ids"] = o.Ids
+	}
+	return p, nil
+}
+
 // GET /projects/:project_id/notifications
 //
 // List the most recent 50 Notifications. Use the filter parameter to specify specify Executions.
-func (loc *NotificationLocator) Index(options rsapi.APIParams) ([]*Notification, error) {
+func (loc *NotificationLocator) Index(options *NotificationIndexOptions) ([]*Notification, error) {
 	var res []*Notification
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var idsOpt = options["ids"]
+	var idsOpt = opts["ids"]
 	if idsOpt != nil {
 		params["ids[]"] = idsOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Notification", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Notification", "index")
 	if err != nil {
 		return res, err
@@ -861,22 +1335,59 @@ func (loc *NotificationLocator) Index(options rsapi.APIParams) ([]*Notification,
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *NotificationLocator) IndexEach(options *NotificationIndexOptions, fn func(*Notification) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var idsOpt = opts["ids"]
+	if idsOpt != nil {
+		params["ids[]"] = idsOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Notification", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Notification", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Notification
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /projects/:project_id/notifications/:id
@@ -886,6 +1397,9 @@ func (loc *NotificationLocator) Show() (*Notification, error) {
 	var res *Notification
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Notification", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Notification", "show")
 	if err != nil {
 		return res, err
@@ -898,21 +1412,11 @@ func (loc *NotificationLocator) Show() (*Notification, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
@@ -940,6 +1444,15 @@ func (r *Operation) Locator(api *API) *OperationLocator {
 	return api.OperationLocator(r.Href)
 }
 
+// ExecutionLocator returns a locator for the resource pointed to by the "execution" link.
+// It returns nil if the link is absent.
+func (r *Operation) ExecutionLocator(api *API) *ExecutionLocator {
+	if r.Links == nil || r.Links.Execution == nil || r.Links.Execution.Href == "" {
+		return nil
+	}
+	return api.ExecutionLocator(r.Links.Execution.Href)
+}
+
 //===== Locator
 
 // OperationLocator exposes the Operation resource actions.
@@ -953,32 +1466,92 @@ func (api *API) OperationLocator(href string) *OperationLocator {
 	return &OperationLocator{Href(href), api}
 }
 
+// OperationLocatorInterface lists the OperationLocator methods, code that depends on the interface
+// rather than on OperationLocator can be unit tested using the generated fake locators.
+type OperationLocatorInterface interface {
+	Index(options *OperationIndexOptions) ([]*Operation, error)
+	IndexEach(options *OperationIndexOptions, fn func(*Operation) error) error
+	Show(options *OperationShowOptions) (*Operation, error)
+	Create(executionId string, name string, options *OperationCreateOptions) (*OperationLocator, error)
+}
+
 //===== Actions
 
+// OperationIndexOptions contains the optional parameters of OperationLocator.Index.
+type OperationIndexOptions struct {
+	// Filter by Execution ID or status
+	Filter []string
+	// IDs of operations to filter on
+	Ids []string
+	// The maximum number of operations to retrieve. The maximum (and default) limit is 50.If a limit of more than 50 is specified, only 50 operations will be returned
+	Limit int
+	// Optional view to return
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *OperationIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter", "ids", "limit", "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of OperationLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	if o.Ids != nil {
+		p["ids"] = o.Ids
+	}
+	if o.Limit != 0 {
+		p["limit"] = o.Limit
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
+}
+
 // GET /projects/:project_id/operations
 //
 // Get the list of 50 most recent Operations (usually filtered by Execution).
-func (loc *OperationLocator) Index(options rsapi.APIParams) ([]*Operation, error) {
+func (loc *OperationLocator) Index(options *OperationIndexOptions) ([]*Operation, error) {
 	var res []*Operation
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
-	var idsOpt = options["ids"]
+	var idsOpt = opts["ids"]
 	if idsOpt != nil {
 		params["ids[]"] = idsOpt
 	}
-	var limitOpt = options["limit"]
+	var limitOpt = opts["limit"]
 	if limitOpt != nil {
 		params["limit"] = limitOpt
 	}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Operation", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Operation", "index")
 	if err != nil {
 		return res, err
@@ -991,36 +1564,117 @@ func (loc *OperationLocator) Index(options rsapi.APIParams) ([]*Operation, error
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *OperationLocator) IndexEach(options *OperationIndexOptions, fn func(*Operation) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var idsOpt = opts["ids"]
+	if idsOpt != nil {
+		params["ids[]"] = idsOpt
+	}
+	var limitOpt = opts["limit"]
+	if limitOpt != nil {
+		params["limit"] = limitOpt
+	}
+	var viewOpt = opts["view"]
+	if viewOpt != nil {
+		params["view"] = viewOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Operation", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("Operation", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *Operation
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// OperationShowOptions contains the optional parameters of OperationLocator.Show.
+type OperationShowOptions struct {
+	// Optional view to return
+	View string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *OperationShowOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "view":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of OperationLocator.Show", n)
+		}
+	}
+	if o.View != "" {
+		p["view"] = o.View
+	}
+	return p, nil
 }
 
 // GET /projects/:project_id/operations/:id
 //
 // Get the details for a specific Operation
-func (loc *OperationLocator) Show(options rsapi.APIParams) (*Operation, error) {
+func (loc *OperationLocator) Show(options *OperationShowOptions) (*Operation, error) {
 	var res *Operation
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var viewOpt = options["view"]
+	var viewOpt = opts["view"]
 	if viewOpt != nil {
 		params["view"] = viewOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("Operation", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Operation", "show")
 	if err != nil {
 		return res, err
@@ -1033,28 +1687,47 @@ func (loc *OperationLocator) Show(options rsapi.APIParams) (*Operation, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// OperationCreateOptions contains the optional parameters of OperationLocator.Create.
+type OperationCreateOptions struct {
+	// The configuration options of the operation. These are the values provided for the CloudAPP parameters that this operation depends on.
+	Options_ []*ConfigurationOption
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *OperationCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "options":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of OperationLocator.Create", n)
+		}
+	}
+	if o.Options_ != nil {
+		p["options"] = o.Options_
+	}
+	return p, nil
+}
+
 // POST /projects/:project_id/operations
 //
 // Trigger an Operation to run by specifying the Execution ID and the name of the Operation.
-func (loc *OperationLocator) Create(executionId string, name string, options rsapi.APIParams) (*OperationLocator, error) {
+func (loc *OperationLocator) Create(executionId string, name string, options *OperationCreateOptions) (*OperationLocator, error) {
 	var res *OperationLocator
 	if executionId == "" {
 		return res, fmt.Errorf("executionId is required")
@@ -1062,16 +1735,23 @@ func (loc *OperationLocator) Create(executionId string, name string, options rsa
 	if name == "" {
 		return res, fmt.Errorf("name is required")
 	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
 		"execution_id": executionId,
 		"name":         name,
 	}
-	var options_Opt = options["options"]
+	var options_Opt = opts["options"]
 	if options_Opt != nil {
 		p["options"] = options_Opt
 	}
+	if err := loc.api.ValidateParams("Operation", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("Operation", "create")
 	if err != nil {
 		return res, err
@@ -1084,15 +1764,10 @@ func (loc *OperationLocator) Create(executionId string, name string, options rsa
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -1111,13 +1786,13 @@ type ScheduledAction struct {
 	CreatedBy             *User                 `json:"created_by,omitempty"`
 	Execution             *Execution            `json:"execution,omitempty"`
 	ExecutionSchedule     bool                  `json:"execution_schedule,omitempty"`
-	FirstOccurrence       *time.Time            `json:"first_occurrence,omitempty"`
+	FirstOccurrence       *rsapi.Time           `json:"first_occurrence,omitempty"`
 	Href                  string                `json:"href,omitempty"`
 	Id                    string                `json:"id,omitempty"`
 	Kind                  string                `json:"kind,omitempty"`
 	Links                 *ScheduledActionLinks `json:"links,omitempty"`
 	Name                  string                `json:"name,omitempty"`
-	NextOccurrence        *time.Time            `json:"next_occurrence,omitempty"`
+	NextOccurrence        *rsapi.Time           `json:"next_occurrence,omitempty"`
 	Operation             *OperationStruct      `json:"operation,omitempty"`
 	Recurrence            string                `json:"recurrence,omitempty"`
 	RecurrenceDescription string                `json:"recurrence_description,omitempty"`
@@ -1130,6 +1805,15 @@ func (r *ScheduledAction) Locator(api *API) *ScheduledActionLocator {
 	return api.ScheduledActionLocator(r.Href)
 }
 
+// ExecutionLocator returns a locator for the resource pointed to by the "execution" link.
+// It returns nil if the link is absent.
+func (r *ScheduledAction) ExecutionLocator(api *API) *ExecutionLocator {
+	if r.Links == nil || r.Links.Execution == nil || r.Links.Execution.Href == "" {
+		return nil
+	}
+	return api.ExecutionLocator(r.Links.Execution.Href)
+}
+
 //===== Locator
 
 // ScheduledActionLocator exposes the ScheduledAction resource actions.
@@ -1143,20 +1827,68 @@ func (api *API) ScheduledActionLocator(href string) *ScheduledActionLocator {
 	return &ScheduledActionLocator{Href(href), api}
 }
 
+// ScheduledActionLocatorInterface lists the ScheduledActionLocator methods, code that depends on the interface
+// rather than on ScheduledActionLocator can be unit tested using the generated fake locators.
+type ScheduledActionLocatorInterface interface {
+	Index(options *ScheduledActionIndexOptions) ([]*ScheduledAction, error)
+	IndexEach(options *ScheduledActionIndexOptions, fn func(*ScheduledAction) error) error
+	Show() (*ScheduledAction, error)
+	Create(action string, executionId string, firstOccurrence *rsapi.Time, options *ScheduledActionCreateOptions) (*ScheduledActionLocator, error)
+	Patch(options *ScheduledActionPatchOptions) error
+	Delete() error
+	Skip(options *ScheduledActionSkipOptions) error
+}
+
 //===== Actions
 
+// ScheduledActionIndexOptions contains the optional parameters of ScheduledActionLocator.Index.
+type ScheduledActionIndexOptions struct {
+	// Filter by execution id or execution creator (user) id.
+	Filter []string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScheduledActionIndexOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "filter":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScheduledActionLocator.Index", n)
+		}
+	}
+	if o.Filter != nil {
+		p["filter"] = o.Filter
+	}
+	return p, nil
+}
+
 // GET /projects/:project_id/scheduled_actions
 //
 // List ScheduledAction resources in the project. The list can be filtered to a given execution.
-func (loc *ScheduledActionLocator) Index(options rsapi.APIParams) ([]*ScheduledAction, error) {
+func (loc *ScheduledActionLocator) Index(options *ScheduledActionIndexOptions) ([]*ScheduledAction, error) {
 	var res []*ScheduledAction
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	params = rsapi.APIParams{}
-	var filterOpt = options["filter"]
+	var filterOpt = opts["filter"]
 	if filterOpt != nil {
 		params["filter[]"] = filterOpt
 	}
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ScheduledAction", "index", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ScheduledAction", "index")
 	if err != nil {
 		return res, err
@@ -1169,22 +1901,55 @@ func (loc *ScheduledActionLocator) Index(options rsapi.APIParams) ([]*ScheduledA
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	return res, err
+}
+
+// IndexEach is identical to Index except that it decodes the response one element
+// at a time and calls fn with each element rather than loading the entire collection in memory.
+// It stops and returns the error returned by fn if any.
+func (loc *ScheduledActionLocator) IndexEach(options *ScheduledActionIndexOptions, fn func(*ScheduledAction) error) error {
+	opts, err := options.params()
 	if err != nil {
-		return res, err
+		return err
 	}
-	err = json.Unmarshal(respBody, &res)
-	return res, err
+	var params rsapi.APIParams
+	params = rsapi.APIParams{}
+	var filterOpt = opts["filter"]
+	if filterOpt != nil {
+		params["filter[]"] = filterOpt
+	}
+	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ScheduledAction", "index", params, p); err != nil {
+		return err
+	}
+	uri, err := loc.ActionPath("ScheduledAction", "index")
+	if err != nil {
+		return err
+	}
+	req, err := loc.api.BuildHTTPRequest(uri.HTTPMethod, uri.Path, APIVersion, params, p)
+	if err != nil {
+		return err
+	}
+	resp, err := loc.api.PerformRequest(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return rsapi.NewAPIError(resp)
+	}
+	defer resp.Body.Close()
+	return rsapi.DecodeArray(resp.Body, func(elem []byte) error {
+		var e *ScheduledAction
+		if err := json.Unmarshal(elem, &e); err != nil {
+			return err
+		}
+		return fn(e)
+	})
 }
 
 // GET /projects/:project_id/scheduled_actions/:id
@@ -1194,6 +1959,9 @@ func (loc *ScheduledActionLocator) Show() (*ScheduledAction, error) {
 	var res *ScheduledAction
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ScheduledAction", "show", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ScheduledAction", "show")
 	if err != nil {
 		return res, err
@@ -1206,28 +1974,62 @@ func (loc *ScheduledActionLocator) Show() (*ScheduledAction, error) {
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
 	defer resp.Body.Close()
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return res, err
-	}
-	err = json.Unmarshal(respBody, &res)
+	err = json.NewDecoder(resp.Body).Decode(&res)
 	return res, err
 }
 
+// ScheduledActionCreateOptions contains the optional parameters of ScheduledActionLocator.Create.
+type ScheduledActionCreateOptions struct {
+	// The human-readable name for the ScheduledAction.
+	Name string
+	// When scheduling a "run" action, contains details on the operation to run
+	Operation *OperationStruct
+	// iCal recurrence rule (RRULE) as described by RFC 5545. Expresses the days on which the action will be run. Optionally a "last occurrence" date can be set by passing the iCal "UNTIL" parameter in the rule (date-time must be passed in ISO-8601 format). If omitted, the action will only be run once, on the "first_occurrence".
+	Recurrence string
+	// The timezone in which the "first_occurrence" and "next_occurrence" times will be interpreted. Used to determine when Daylight Savings Time changes occur. Supports standardized "tzinfo" names [found here](http://www.iana.org/time-zones).
+	Timezone string
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScheduledActionCreateOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "name", "operation", "recurrence", "timezone":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScheduledActionLocator.Create", n)
+		}
+	}
+	if o.Name != "" {
+		p["name"] = o.Name
+	}
+	if o.Operation != nil {
+		p["operation"] = o.Operation
+	}
+	if o.Recurrence != "" {
+		p["recurrence"] = o.Recurrence
+	}
+	if o.Timezone != "" {
+		p["timezone"] = o.Timezone
+	}
+	return p, nil
+}
+
 // POST /projects/:project_id/scheduled_actions
 //
 // Create a new ScheduledAction resource.
-func (loc *ScheduledActionLocator) Create(action string, executionId string, firstOccurrence *time.Time, options rsapi.APIParams) (*ScheduledActionLocator, error) {
+func (loc *ScheduledActionLocator) Create(action string, executionId string, firstOccurrence *rsapi.Time, options *ScheduledActionCreateOptions) (*ScheduledActionLocator, error) {
 	var res *ScheduledActionLocator
 	if action == "" {
 		return res, fmt.Errorf("action is required")
@@ -1235,6 +2037,13 @@ func (loc *ScheduledActionLocator) Create(action string, executionId string, fir
 	if executionId == "" {
 		return res, fmt.Errorf("executionId is required")
 	}
+	if firstOccurrence == nil {
+		return res, fmt.Errorf("firstOccurrence is required")
+	}
+	opts, err := options.params()
+	if err != nil {
+		return res, err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{
@@ -1242,22 +2051,25 @@ func (loc *ScheduledActionLocator) Create(action string, executionId string, fir
 		"execution_id":     executionId,
 		"first_occurrence": firstOccurrence,
 	}
-	var nameOpt = options["name"]
+	var nameOpt = opts["name"]
 	if nameOpt != nil {
 		p["name"] = nameOpt
 	}
-	var operationOpt = options["operation"]
+	var operationOpt = opts["operation"]
 	if operationOpt != nil {
 		p["operation"] = operationOpt
 	}
-	var recurrenceOpt = options["recurrence"]
+	var recurrenceOpt = opts["recurrence"]
 	if recurrenceOpt != nil {
 		p["recurrence"] = recurrenceOpt
 	}
-	var timezoneOpt = options["timezone"]
+	var timezoneOpt = opts["timezone"]
 	if timezoneOpt != nil {
 		p["timezone"] = timezoneOpt
 	}
+	if err := loc.api.ValidateParams("ScheduledAction", "create", params, p); err != nil {
+		return res, err
+	}
 	uri, err := loc.ActionPath("ScheduledAction", "create")
 	if err != nil {
 		return res, err
@@ -1270,15 +2082,10 @@ func (loc *ScheduledActionLocator) Create(action string, executionId string, fir
 	if err != nil {
 		return res, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return res, fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return res, rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	location := resp.Header.Get("Location")
 	if len(location) == 0 {
 		return res, fmt.Errorf("Missing location header in response")
@@ -1287,17 +2094,53 @@ func (loc *ScheduledActionLocator) Create(action string, executionId string, fir
 	}
 }
 
+// ScheduledActionPatchOptions contains the optional parameters of ScheduledActionLocator.Patch.
+type ScheduledActionPatchOptions struct {
+	// The Date/Time for the next occurrence, useful for delaying a single occurrence. DateTimes should be passed as ISO-8601 formatted time strings.
+	NextOccurrence *rsapi.Time
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScheduledActionPatchOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "next_occurrence":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScheduledActionLocator.Patch", n)
+		}
+	}
+	if o.NextOccurrence != nil {
+		p["next_occurrence"] = o.NextOccurrence
+	}
+	return p, nil
+}
+
 // PATCH /projects/:project_id/scheduled_actions/:id
 //
 // Updates the 'next_occurrence' property of a ScheduledAction.
-func (loc *ScheduledActionLocator) Patch(options rsapi.APIParams) error {
+func (loc *ScheduledActionLocator) Patch(options *ScheduledActionPatchOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var nextOccurrenceOpt = options["next_occurrence"]
+	var nextOccurrenceOpt = opts["next_occurrence"]
 	if nextOccurrenceOpt != nil {
 		p["next_occurrence"] = nextOccurrenceOpt
 	}
+	if err := loc.api.ValidateParams("ScheduledAction", "patch", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ScheduledAction", "patch")
 	if err != nil {
 		return err
@@ -1310,15 +2153,10 @@ func (loc *ScheduledActionLocator) Patch(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1328,6 +2166,9 @@ func (loc *ScheduledActionLocator) Patch(options rsapi.APIParams) error {
 func (loc *ScheduledActionLocator) Delete() error {
 	var params rsapi.APIParams
 	var p rsapi.APIParams
+	if err := loc.api.ValidateParams("ScheduledAction", "delete", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ScheduledAction", "delete")
 	if err != nil {
 		return err
@@ -1340,29 +2181,60 @@ func (loc *ScheduledActionLocator) Delete() error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
+// ScheduledActionSkipOptions contains the optional parameters of ScheduledActionLocator.Skip.
+type ScheduledActionSkipOptions struct {
+	// The number of scheduled occurrences to skip. If not provided, the default count is 1.
+	Count int
+	// Params contains raw parameters indexed by name, it makes it possible to set parameters to zero
+	// values (e.g. false). Values of fields above take precedence.
+	Params rsapi.APIParams
+}
+
+// params returns the parameters indexed by name.
+func (o *ScheduledActionSkipOptions) params() (rsapi.APIParams, error) {
+	if o == nil {
+		return nil, nil
+	}
+	p := rsapi.APIParams{}
+	for n, v := range o.Params {
+		switch n {
+		case "count":
+			p[n] = v
+		default:
+			return nil, fmt.Errorf("%s is not a parameter of ScheduledActionLocator.Skip", n)
+		}
+	}
+	if o.Count != 0 {
+		p["count"] = o.Count
+	}
+	return p, nil
+}
+
 // POST /projects/:project_id/scheduled_actions/:id/actions/skip
 //
 // Skips the requested number of ScheduledAction occurrences. If no count is provided, one occurrence is skipped. On success, the next_occurrence view of the updated ScheduledAction is returned.
-func (loc *ScheduledActionLocator) Skip(options rsapi.APIParams) error {
+func (loc *ScheduledActionLocator) Skip(options *ScheduledActionSkipOptions) error {
+	opts, err := options.params()
+	if err != nil {
+		return err
+	}
 	var params rsapi.APIParams
 	var p rsapi.APIParams
 	p = rsapi.APIParams{}
-	var countOpt = options["count"]
+	var countOpt = opts["count"]
 	if countOpt != nil {
 		p["count"] = countOpt
 	}
+	if err := loc.api.ValidateParams("ScheduledAction", "skip", params, p); err != nil {
+		return err
+	}
 	uri, err := loc.ActionPath("ScheduledAction", "skip")
 	if err != nil {
 		return err
@@ -1375,15 +2247,10 @@ func (loc *ScheduledActionLocator) Skip(options rsapi.APIParams) error {
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := ioutil.ReadAll(resp.Body)
-		sr := string(respBody)
-		if sr != "" {
-			sr = ": " + sr
-		}
-		return fmt.Errorf("invalid response %s%s", resp.Status, sr)
+		return rsapi.NewAPIError(resp)
 	}
+	defer resp.Body.Close()
 	return nil
 }
 
@@ -1436,9 +2303,9 @@ type ConfigurationOption struct {
 }
 
 type CostStruct struct {
-	Unit      string     `json:"unit,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
-	Value     string     `json:"value,omitempty"`
+	Unit      string      `json:"unit,omitempty"`
+	UpdatedAt *rsapi.Time `json:"updated_at,omitempty"`
+	Value     string      `json:"value,omitempty"`
 }
 
 type ExecutionLink struct {
@@ -1463,7 +2330,7 @@ type ExecutionParam struct {
 	Deployment           string                                        `json:"deployment,omitempty"`
 	DeploymentUrl        string                                        `json:"deployment_url,omitempty"`
 	Description          string                                        `json:"description,omitempty"`
-	EndsAt               *time.Time                                    `json:"ends_at,omitempty"`
+	EndsAt               *rsapi.Time                                   `json:"ends_at,omitempty"`
 	Href                 string                                        `json:"href,omitempty"`
 	Id                   string                                        `json:"id,omitempty"`
 	Kind                 string                                        `json:"kind,omitempty"`
@@ -1484,9 +2351,9 @@ type ExecutionParam struct {
 }
 
 type LatestNotificationsExecutionCostStruct struct {
-	Unit      string     `json:"unit,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
-	Value     string     `json:"value,omitempty"`
+	Unit      string      `json:"unit,omitempty"`
+	UpdatedAt *rsapi.Time `json:"updated_at,omitempty"`
+	Value     string      `json:"value,omitempty"`
 }
 
 type LatestNotificationsExecutionNextActionOperationStruct struct {
@@ -1495,8 +2362,8 @@ type LatestNotificationsExecutionNextActionOperationStruct struct {
 }
 
 type LatestNotificationsExecutionNextActionTimestampsStruct struct {
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *rsapi.Time `json:"created_at,omitempty"`
+	UpdatedAt *rsapi.Time `json:"updated_at,omitempty"`
 }
 
 type LatestNotificationsExecutionRunningOperationsStatusStruct struct {
@@ -1511,18 +2378,18 @@ type LatestNotificationsExecutionRunningOperationsStatusTasksStatusStruct struct
 }
 
 type LatestNotificationsExecutionRunningOperationsTimestampsStruct struct {
-	CreatedAt  *time.Time `json:"created_at,omitempty"`
-	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	CreatedAt  *rsapi.Time `json:"created_at,omitempty"`
+	FinishedAt *rsapi.Time `json:"finished_at,omitempty"`
 }
 
 type LatestNotificationsExecutionTimestampsStruct struct {
-	CreatedAt    *time.Time `json:"created_at,omitempty"`
-	LaunchedAt   *time.Time `json:"launched_at,omitempty"`
-	TerminatedAt *time.Time `json:"terminated_at,omitempty"`
+	CreatedAt    *rsapi.Time `json:"created_at,omitempty"`
+	LaunchedAt   *rsapi.Time `json:"launched_at,omitempty"`
+	TerminatedAt *rsapi.Time `json:"terminated_at,omitempty"`
 }
 
 type LatestNotificationsTimestampsStruct struct {
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+	CreatedAt *rsapi.Time `json:"created_at,omitempty"`
 }
 
 type LaunchedFrom struct {
@@ -1651,13 +2518,13 @@ type ScheduledActionParam struct {
 	CreatedBy             *User                                                   `json:"created_by,omitempty"`
 	Execution             *ExecutionParam                                         `json:"execution,omitempty"`
 	ExecutionSchedule     bool                                                    `json:"execution_schedule,omitempty"`
-	FirstOccurrence       *time.Time                                              `json:"first_occurrence,omitempty"`
+	FirstOccurrence       *rsapi.Time                                             `json:"first_occurrence,omitempty"`
 	Href                  string                                                  `json:"href,omitempty"`
 	Id                    string                                                  `json:"id,omitempty"`
 	Kind                  string                                                  `json:"kind,omitempty"`
 	Links                 *ScheduledActionLinks                                   `json:"links,omitempty"`
 	Name                  string                                                  `json:"name,omitempty"`
-	NextOccurrence        *time.Time                                              `json:"next_occurrence,omitempty"`
+	NextOccurrence        *rsapi.Time                                             `json:"next_occurrence,omitempty"`
 	Operation             *LatestNotificationsExecutionNextActionOperationStruct  `json:"operation,omitempty"`
 	Recurrence            string                                                  `json:"recurrence,omitempty"`
 	RecurrenceDescription string                                                  `json:"recurrence_description,omitempty"`
@@ -1678,23 +2545,23 @@ type Task struct {
 }
 
 type TimestampsStruct struct {
-	CreatedAt    *time.Time `json:"created_at,omitempty"`
-	LaunchedAt   *time.Time `json:"launched_at,omitempty"`
-	TerminatedAt *time.Time `json:"terminated_at,omitempty"`
+	CreatedAt    *rsapi.Time `json:"created_at,omitempty"`
+	LaunchedAt   *rsapi.Time `json:"launched_at,omitempty"`
+	TerminatedAt *rsapi.Time `json:"terminated_at,omitempty"`
 }
 
 type TimestampsStruct2 struct {
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+	CreatedAt *rsapi.Time `json:"created_at,omitempty"`
 }
 
 type TimestampsStruct3 struct {
-	CreatedAt  *time.Time `json:"created_at,omitempty"`
-	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	CreatedAt  *rsapi.Time `json:"created_at,omitempty"`
+	FinishedAt *rsapi.Time `json:"finished_at,omitempty"`
 }
 
 type TimestampsStruct4 struct {
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *rsapi.Time `json:"created_at,omitempty"`
+	UpdatedAt *rsapi.Time `json:"updated_at,omitempty"`
 }
 
 type User struct {
@@ -356,7 +356,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "ends_at",
 						Description: `The day on which the CloudApp should be automatically terminated`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -446,7 +446,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "scheduled_actions[][execution][cost][updated_at]",
 						Description: `Timestamp of last cost refresh`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -518,7 +518,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "scheduled_actions[][execution][ends_at]",
 						Description: `The time of the next 'terminate' ScheduledAction (if any).`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -631,7 +631,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "scheduled_actions[][execution][next_action][first_occurrence]",
 						Description: `The time and day of the first occurrence when the action will be ran, similar to the "DTSTART" property specified by iCal. Used (in conjunction with timezone) to determine the time of day for the "next_occurrence". Can be set to the future or past. DateTimes should be passed as ISO-8601 formatted time strings.  All DateTimes are converted to UTC when returned.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -679,7 +679,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "scheduled_actions[][execution][next_action][next_occurrence]",
 						Description: `The Date/Time for the next occurrence. Since "DateTime implies a timezone offset (but no DST preference), the "timezone" parameter will be used to determine the DST preference. All DateTimes are converted to UTC when returned.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -711,7 +711,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "scheduled_actions[][execution][next_action][timestamps][created_at]",
 						Description: `Creation timestamp`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -719,7 +719,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "scheduled_actions[][execution][cost][updated_at]",
 						Description: `Timestamp of last cost refresh`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -776,7 +776,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "scheduled_actions[][execution][next_action][timestamps][created_at]",
 						Description: `Creation timestamp`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -784,7 +784,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "scheduled_actions[][execution][cost][updated_at]",
 						Description: `Timestamp of last cost refresh`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -800,7 +800,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "scheduled_actions[][execution][next_action][first_occurrence]",
 						Description: `The time and day of the first occurrence when the action will be ran, similar to the "DTSTART" property specified by iCal. Used (in conjunction with timezone) to determine the time of day for the "next_occurrence". Can be set to the future or past. DateTimes should be passed as ISO-8601 formatted time strings.  All DateTimes are converted to UTC when returned.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -848,7 +848,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "scheduled_actions[][execution][next_action][next_occurrence]",
 						Description: `The Date/Time for the next occurrence. Since "DateTime implies a timezone offset (but no DST preference), the "timezone" parameter will be used to determine the DST preference. All DateTimes are converted to UTC when returned.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -880,7 +880,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "scheduled_actions[][execution][next_action][timestamps][created_at]",
 						Description: `Creation timestamp`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -888,7 +888,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "scheduled_actions[][execution][cost][updated_at]",
 						Description: `Timestamp of last cost refresh`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -1026,7 +1026,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "ends_at",
 						Description: `The day on which the CloudApp should be automatically terminated`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -1113,7 +1113,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "ends_at",
 						Description: `The day on which the CloudApp should be automatically terminated`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -1131,7 +1131,7 @@ Self-Service.`,
 					&metadata.ActionParam{
 						Name:        "ends_at",
 						Description: `The day on which the CloudApp should be automatically terminated`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -1964,7 +1964,7 @@ All DateTimes must be passed in [ISO-8601 format](https://en.wikipedia.org/wiki/
 					&metadata.ActionParam{
 						Name:        "first_occurrence",
 						Description: `The time and day of the first occurrence when the action will be ran, similar to the "DTSTART" property specified by iCal. Used (in conjunction with timezone) to determine the time of day for the "next_occurrence". Can be set to the future or past. DateTimes should be passed as ISO-8601 formatted time strings.  All DateTimes are converted to UTC when returned.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -2048,7 +2048,7 @@ All DateTimes must be passed in [ISO-8601 format](https://en.wikipedia.org/wiki/
 					&metadata.ActionParam{
 						Name:        "first_occurrence",
 						Description: `The time and day of the first occurrence when the action will be ran, similar to the "DTSTART" property specified by iCal. Used (in conjunction with timezone) to determine the time of day for the "next_occurrence". Can be set to the future or past. DateTimes should be passed as ISO-8601 formatted time strings.  All DateTimes are converted to UTC when returned.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   true,
 						NonBlank:    false,
@@ -2103,7 +2103,7 @@ All DateTimes must be passed in [ISO-8601 format](https://en.wikipedia.org/wiki/
 					&metadata.ActionParam{
 						Name:        "next_occurrence",
 						Description: `The Date/Time for the next occurrence, useful for delaying a single occurrence. DateTimes should be passed as ISO-8601 formatted time strings.`,
-						Type:        "*time.Time",
+						Type:        "time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
@@ -2113,7 +2113,7 @@ All DateTimes must be passed in [ISO-8601 format](https://en.wikipedia.org/wiki/
 					&metadata.ActionParam{
 						Name:        "next_occurrence",
 						Description: `The Date/Time for the next occurrence, useful for delaying a single occurrence. DateTimes should be passed as ISO-8601 formatted time strings.`,
-						Type:        "*time.Time",
+						Type:        "*rsapi.Time",
 						Location:    metadata.PayloadParam,
 						Mandatory:   false,
 						NonBlank:    false,
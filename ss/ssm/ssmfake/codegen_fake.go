@@ -0,0 +1,1138 @@
+//************************************************************************//
+//                     RightScale API client fakes
+//
+// Generated with:
+// $ praxisgen -metadata=ss/ssm/restful_doc -output=ss/ssm -pkg=ssm -target=1.0 -client=API
+//
+// The content of this file is auto-generated, DO NOT MODIFY
+//************************************************************************//
+
+// Package ssmfake provides fake implementations of the ssm locator interfaces.
+// Each fake records the calls made to its methods and returns the values returned by the
+// corresponding stub functions if set, zero values otherwise.
+package ssmfake
+
+import (
+	"io"
+	"sync"
+
+	"github.com/rightscale/rsc/rsapi"
+	"github.com/rightscale/rsc/ss/ssm"
+)
+
+/******  Execution ******/
+
+// ExecutionLocator is a fake implementation of ssm.ExecutionLocatorInterface.
+type ExecutionLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*ssm.ExecutionIndexOptions) ([]*ssm.Execution, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ExecutionIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*ssm.ExecutionIndexOptions, func(*ssm.Execution) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*ExecutionIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*ssm.ExecutionShowOptions) (*ssm.Execution, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ExecutionShowCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(*ssm.ExecutionCreateOptions) (*ssm.ExecutionLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*ExecutionCreateCall
+
+	// PatchStub is called by Patch if not nil.
+	PatchStub func(*ssm.ExecutionPatchOptions) error
+	// PatchCalls records the arguments of the calls made to Patch.
+	PatchCalls []*ExecutionPatchCall
+
+	// DeleteStub is called by Delete if not nil.
+	DeleteStub func(*ssm.ExecutionDeleteOptions) error
+	// DeleteCalls records the arguments of the calls made to Delete.
+	DeleteCalls []*ExecutionDeleteCall
+
+	// MultiDeleteStub is called by MultiDelete if not nil.
+	MultiDeleteStub func([]string, *ssm.ExecutionMultiDeleteOptions) error
+	// MultiDeleteCalls records the arguments of the calls made to MultiDelete.
+	MultiDeleteCalls []*ExecutionMultiDeleteCall
+
+	// DownloadStub is called by Download if not nil.
+	DownloadStub func(string) error
+	// DownloadCalls records the arguments of the calls made to Download.
+	DownloadCalls []*ExecutionDownloadCall
+
+	// DownloadReaderStub is called by DownloadReader if not nil.
+	DownloadReaderStub func(string) (io.ReadCloser, error)
+	// DownloadReaderCalls records the arguments of the calls made to DownloadReader.
+	DownloadReaderCalls []*ExecutionDownloadCall
+
+	// LaunchStub is called by Launch if not nil.
+	LaunchStub func() error
+	// LaunchCalls records the arguments of the calls made to Launch.
+	LaunchCalls []*ExecutionLaunchCall
+
+	// StartStub is called by Start if not nil.
+	StartStub func() error
+	// StartCalls records the arguments of the calls made to Start.
+	StartCalls []*ExecutionStartCall
+
+	// StopStub is called by Stop if not nil.
+	StopStub func() error
+	// StopCalls records the arguments of the calls made to Stop.
+	StopCalls []*ExecutionStopCall
+
+	// TerminateStub is called by Terminate if not nil.
+	TerminateStub func() error
+	// TerminateCalls records the arguments of the calls made to Terminate.
+	TerminateCalls []*ExecutionTerminateCall
+
+	// MultiLaunchStub is called by MultiLaunch if not nil.
+	MultiLaunchStub func([]string) error
+	// MultiLaunchCalls records the arguments of the calls made to MultiLaunch.
+	MultiLaunchCalls []*ExecutionMultiLaunchCall
+
+	// MultiStartStub is called by MultiStart if not nil.
+	MultiStartStub func([]string) error
+	// MultiStartCalls records the arguments of the calls made to MultiStart.
+	MultiStartCalls []*ExecutionMultiStartCall
+
+	// MultiStopStub is called by MultiStop if not nil.
+	MultiStopStub func([]string) error
+	// MultiStopCalls records the arguments of the calls made to MultiStop.
+	MultiStopCalls []*ExecutionMultiStopCall
+
+	// MultiTerminateStub is called by MultiTerminate if not nil.
+	MultiTerminateStub func([]string) error
+	// MultiTerminateCalls records the arguments of the calls made to MultiTerminate.
+	MultiTerminateCalls []*ExecutionMultiTerminateCall
+
+	// RunStub is called by Run if not nil.
+	RunStub func(string, *ssm.ExecutionRunOptions) error
+	// RunCalls records the arguments of the calls made to Run.
+	RunCalls []*ExecutionRunCall
+
+	// MultiRunStub is called by MultiRun if not nil.
+	MultiRunStub func([]string, string, *ssm.ExecutionMultiRunOptions) error
+	// MultiRunCalls records the arguments of the calls made to MultiRun.
+	MultiRunCalls []*ExecutionMultiRunCall
+}
+
+var _ ssm.ExecutionLocatorInterface = (*ExecutionLocator)(nil)
+
+// ExecutionIndexCall records the arguments of a call to ExecutionLocator.Index.
+type ExecutionIndexCall struct {
+	Options *ssm.ExecutionIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ExecutionLocator) Index(options *ssm.ExecutionIndexOptions) ([]*ssm.Execution, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ExecutionIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*ssm.Execution
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ExecutionLocator) IndexReturns(res []*ssm.Execution, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*ssm.ExecutionIndexOptions) ([]*ssm.Execution, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionIndexEachCall records the arguments of a call to ExecutionLocator.IndexEach.
+type ExecutionIndexEachCall struct {
+	Options *ssm.ExecutionIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *ExecutionLocator) IndexEach(options *ssm.ExecutionIndexOptions, fn func(*ssm.Execution) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &ExecutionIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *ExecutionLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*ssm.ExecutionIndexOptions, func(*ssm.Execution) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionShowCall records the arguments of a call to ExecutionLocator.Show.
+type ExecutionShowCall struct {
+	Options *ssm.ExecutionShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ExecutionLocator) Show(options *ssm.ExecutionShowOptions) (*ssm.Execution, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ExecutionShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *ssm.Execution
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ExecutionLocator) ShowReturns(res *ssm.Execution, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*ssm.ExecutionShowOptions) (*ssm.Execution, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionCreateCall records the arguments of a call to ExecutionLocator.Create.
+type ExecutionCreateCall struct {
+	Options *ssm.ExecutionCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *ExecutionLocator) Create(options *ssm.ExecutionCreateOptions) (*ssm.ExecutionLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &ExecutionCreateCall{Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *ssm.ExecutionLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *ExecutionLocator) CreateReturns(res *ssm.ExecutionLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(*ssm.ExecutionCreateOptions) (*ssm.ExecutionLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionPatchCall records the arguments of a call to ExecutionLocator.Patch.
+type ExecutionPatchCall struct {
+	Options *ssm.ExecutionPatchOptions
+}
+
+// Patch records the call and returns the results of PatchStub if set.
+func (fake *ExecutionLocator) Patch(options *ssm.ExecutionPatchOptions) error {
+	fake.mu.Lock()
+	fake.PatchCalls = append(fake.PatchCalls, &ExecutionPatchCall{Options: options})
+	stub := fake.PatchStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// PatchReturns sets PatchStub to a function that returns the given values.
+func (fake *ExecutionLocator) PatchReturns(err error) {
+	fake.mu.Lock()
+	fake.PatchStub = func(*ssm.ExecutionPatchOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionDeleteCall records the arguments of a call to ExecutionLocator.Delete.
+type ExecutionDeleteCall struct {
+	Options *ssm.ExecutionDeleteOptions
+}
+
+// Delete records the call and returns the results of DeleteStub if set.
+func (fake *ExecutionLocator) Delete(options *ssm.ExecutionDeleteOptions) error {
+	fake.mu.Lock()
+	fake.DeleteCalls = append(fake.DeleteCalls, &ExecutionDeleteCall{Options: options})
+	stub := fake.DeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// DeleteReturns sets DeleteStub to a function that returns the given values.
+func (fake *ExecutionLocator) DeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.DeleteStub = func(*ssm.ExecutionDeleteOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionMultiDeleteCall records the arguments of a call to ExecutionLocator.MultiDelete.
+type ExecutionMultiDeleteCall struct {
+	Ids     []string
+	Options *ssm.ExecutionMultiDeleteOptions
+}
+
+// MultiDelete records the call and returns the results of MultiDeleteStub if set.
+func (fake *ExecutionLocator) MultiDelete(ids []string, options *ssm.ExecutionMultiDeleteOptions) error {
+	fake.mu.Lock()
+	fake.MultiDeleteCalls = append(fake.MultiDeleteCalls, &ExecutionMultiDeleteCall{Ids: ids, Options: options})
+	stub := fake.MultiDeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(ids, options)
+	}
+	return nil
+}
+
+// MultiDeleteReturns sets MultiDeleteStub to a function that returns the given values.
+func (fake *ExecutionLocator) MultiDeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiDeleteStub = func([]string, *ssm.ExecutionMultiDeleteOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionDownloadCall records the arguments of a call to ExecutionLocator.Download.
+type ExecutionDownloadCall struct {
+	ApiVersion string
+}
+
+// Download records the call and returns the results of DownloadStub if set.
+func (fake *ExecutionLocator) Download(apiVersion string) error {
+	fake.mu.Lock()
+	fake.DownloadCalls = append(fake.DownloadCalls, &ExecutionDownloadCall{ApiVersion: apiVersion})
+	stub := fake.DownloadStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(apiVersion)
+	}
+	return nil
+}
+
+// DownloadReturns sets DownloadStub to a function that returns the given values.
+func (fake *ExecutionLocator) DownloadReturns(err error) {
+	fake.mu.Lock()
+	fake.DownloadStub = func(string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// DownloadReader records the call and returns the results of DownloadReaderStub if set.
+func (fake *ExecutionLocator) DownloadReader(apiVersion string) (io.ReadCloser, error) {
+	fake.mu.Lock()
+	fake.DownloadReaderCalls = append(fake.DownloadReaderCalls, &ExecutionDownloadCall{ApiVersion: apiVersion})
+	stub := fake.DownloadReaderStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(apiVersion)
+	}
+	var res io.ReadCloser
+	return res, nil
+}
+
+// DownloadReaderReturns sets DownloadReaderStub to a function that returns the given values.
+func (fake *ExecutionLocator) DownloadReaderReturns(res io.ReadCloser, err error) {
+	fake.mu.Lock()
+	fake.DownloadReaderStub = func(string) (io.ReadCloser, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionLaunchCall records the arguments of a call to ExecutionLocator.Launch.
+type ExecutionLaunchCall struct{}
+
+// Launch records the call and returns the results of LaunchStub if set.
+func (fake *ExecutionLocator) Launch() error {
+	fake.mu.Lock()
+	fake.LaunchCalls = append(fake.LaunchCalls, &ExecutionLaunchCall{})
+	stub := fake.LaunchStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// LaunchReturns sets LaunchStub to a function that returns the given values.
+func (fake *ExecutionLocator) LaunchReturns(err error) {
+	fake.mu.Lock()
+	fake.LaunchStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionStartCall records the arguments of a call to ExecutionLocator.Start.
+type ExecutionStartCall struct{}
+
+// Start records the call and returns the results of StartStub if set.
+func (fake *ExecutionLocator) Start() error {
+	fake.mu.Lock()
+	fake.StartCalls = append(fake.StartCalls, &ExecutionStartCall{})
+	stub := fake.StartStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// StartReturns sets StartStub to a function that returns the given values.
+func (fake *ExecutionLocator) StartReturns(err error) {
+	fake.mu.Lock()
+	fake.StartStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionStopCall records the arguments of a call to ExecutionLocator.Stop.
+type ExecutionStopCall struct{}
+
+// Stop records the call and returns the results of StopStub if set.
+func (fake *ExecutionLocator) Stop() error {
+	fake.mu.Lock()
+	fake.StopCalls = append(fake.StopCalls, &ExecutionStopCall{})
+	stub := fake.StopStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// StopReturns sets StopStub to a function that returns the given values.
+func (fake *ExecutionLocator) StopReturns(err error) {
+	fake.mu.Lock()
+	fake.StopStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionTerminateCall records the arguments of a call to ExecutionLocator.Terminate.
+type ExecutionTerminateCall struct{}
+
+// Terminate records the call and returns the results of TerminateStub if set.
+func (fake *ExecutionLocator) Terminate() error {
+	fake.mu.Lock()
+	fake.TerminateCalls = append(fake.TerminateCalls, &ExecutionTerminateCall{})
+	stub := fake.TerminateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// TerminateReturns sets TerminateStub to a function that returns the given values.
+func (fake *ExecutionLocator) TerminateReturns(err error) {
+	fake.mu.Lock()
+	fake.TerminateStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionMultiLaunchCall records the arguments of a call to ExecutionLocator.MultiLaunch.
+type ExecutionMultiLaunchCall struct {
+	Ids []string
+}
+
+// MultiLaunch records the call and returns the results of MultiLaunchStub if set.
+func (fake *ExecutionLocator) MultiLaunch(ids []string) error {
+	fake.mu.Lock()
+	fake.MultiLaunchCalls = append(fake.MultiLaunchCalls, &ExecutionMultiLaunchCall{Ids: ids})
+	stub := fake.MultiLaunchStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(ids)
+	}
+	return nil
+}
+
+// MultiLaunchReturns sets MultiLaunchStub to a function that returns the given values.
+func (fake *ExecutionLocator) MultiLaunchReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiLaunchStub = func([]string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionMultiStartCall records the arguments of a call to ExecutionLocator.MultiStart.
+type ExecutionMultiStartCall struct {
+	Ids []string
+}
+
+// MultiStart records the call and returns the results of MultiStartStub if set.
+func (fake *ExecutionLocator) MultiStart(ids []string) error {
+	fake.mu.Lock()
+	fake.MultiStartCalls = append(fake.MultiStartCalls, &ExecutionMultiStartCall{Ids: ids})
+	stub := fake.MultiStartStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(ids)
+	}
+	return nil
+}
+
+// MultiStartReturns sets MultiStartStub to a function that returns the given values.
+func (fake *ExecutionLocator) MultiStartReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiStartStub = func([]string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionMultiStopCall records the arguments of a call to ExecutionLocator.MultiStop.
+type ExecutionMultiStopCall struct {
+	Ids []string
+}
+
+// MultiStop records the call and returns the results of MultiStopStub if set.
+func (fake *ExecutionLocator) MultiStop(ids []string) error {
+	fake.mu.Lock()
+	fake.MultiStopCalls = append(fake.MultiStopCalls, &ExecutionMultiStopCall{Ids: ids})
+	stub := fake.MultiStopStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(ids)
+	}
+	return nil
+}
+
+// MultiStopReturns sets MultiStopStub to a function that returns the given values.
+func (fake *ExecutionLocator) MultiStopReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiStopStub = func([]string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionMultiTerminateCall records the arguments of a call to ExecutionLocator.MultiTerminate.
+type ExecutionMultiTerminateCall struct {
+	Ids []string
+}
+
+// MultiTerminate records the call and returns the results of MultiTerminateStub if set.
+func (fake *ExecutionLocator) MultiTerminate(ids []string) error {
+	fake.mu.Lock()
+	fake.MultiTerminateCalls = append(fake.MultiTerminateCalls, &ExecutionMultiTerminateCall{Ids: ids})
+	stub := fake.MultiTerminateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(ids)
+	}
+	return nil
+}
+
+// MultiTerminateReturns sets MultiTerminateStub to a function that returns the given values.
+func (fake *ExecutionLocator) MultiTerminateReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiTerminateStub = func([]string) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionRunCall records the arguments of a call to ExecutionLocator.Run.
+type ExecutionRunCall struct {
+	Name    string
+	Options *ssm.ExecutionRunOptions
+}
+
+// Run records the call and returns the results of RunStub if set.
+func (fake *ExecutionLocator) Run(name string, options *ssm.ExecutionRunOptions) error {
+	fake.mu.Lock()
+	fake.RunCalls = append(fake.RunCalls, &ExecutionRunCall{Name: name, Options: options})
+	stub := fake.RunStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(name, options)
+	}
+	return nil
+}
+
+// RunReturns sets RunStub to a function that returns the given values.
+func (fake *ExecutionLocator) RunReturns(err error) {
+	fake.mu.Lock()
+	fake.RunStub = func(string, *ssm.ExecutionRunOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ExecutionMultiRunCall records the arguments of a call to ExecutionLocator.MultiRun.
+type ExecutionMultiRunCall struct {
+	Ids     []string
+	Name    string
+	Options *ssm.ExecutionMultiRunOptions
+}
+
+// MultiRun records the call and returns the results of MultiRunStub if set.
+func (fake *ExecutionLocator) MultiRun(ids []string, name string, options *ssm.ExecutionMultiRunOptions) error {
+	fake.mu.Lock()
+	fake.MultiRunCalls = append(fake.MultiRunCalls, &ExecutionMultiRunCall{Ids: ids, Name: name, Options: options})
+	stub := fake.MultiRunStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(ids, name, options)
+	}
+	return nil
+}
+
+// MultiRunReturns sets MultiRunStub to a function that returns the given values.
+func (fake *ExecutionLocator) MultiRunReturns(err error) {
+	fake.mu.Lock()
+	fake.MultiRunStub = func([]string, string, *ssm.ExecutionMultiRunOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Notification ******/
+
+// NotificationLocator is a fake implementation of ssm.NotificationLocatorInterface.
+type NotificationLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*ssm.NotificationIndexOptions) ([]*ssm.Notification, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*NotificationIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*ssm.NotificationIndexOptions, func(*ssm.Notification) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*NotificationIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*ssm.Notification, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*NotificationShowCall
+}
+
+var _ ssm.NotificationLocatorInterface = (*NotificationLocator)(nil)
+
+// NotificationIndexCall records the arguments of a call to NotificationLocator.Index.
+type NotificationIndexCall struct {
+	Options *ssm.NotificationIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *NotificationLocator) Index(options *ssm.NotificationIndexOptions) ([]*ssm.Notification, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &NotificationIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*ssm.Notification
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *NotificationLocator) IndexReturns(res []*ssm.Notification, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*ssm.NotificationIndexOptions) ([]*ssm.Notification, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// NotificationIndexEachCall records the arguments of a call to NotificationLocator.IndexEach.
+type NotificationIndexEachCall struct {
+	Options *ssm.NotificationIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *NotificationLocator) IndexEach(options *ssm.NotificationIndexOptions, fn func(*ssm.Notification) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &NotificationIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *NotificationLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*ssm.NotificationIndexOptions, func(*ssm.Notification) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// NotificationShowCall records the arguments of a call to NotificationLocator.Show.
+type NotificationShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *NotificationLocator) Show() (*ssm.Notification, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &NotificationShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *ssm.Notification
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *NotificationLocator) ShowReturns(res *ssm.Notification, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*ssm.Notification, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  Operation ******/
+
+// OperationLocator is a fake implementation of ssm.OperationLocatorInterface.
+type OperationLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*ssm.OperationIndexOptions) ([]*ssm.Operation, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*OperationIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*ssm.OperationIndexOptions, func(*ssm.Operation) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*OperationIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func(*ssm.OperationShowOptions) (*ssm.Operation, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*OperationShowCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(string, string, *ssm.OperationCreateOptions) (*ssm.OperationLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*OperationCreateCall
+}
+
+var _ ssm.OperationLocatorInterface = (*OperationLocator)(nil)
+
+// OperationIndexCall records the arguments of a call to OperationLocator.Index.
+type OperationIndexCall struct {
+	Options *ssm.OperationIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *OperationLocator) Index(options *ssm.OperationIndexOptions) ([]*ssm.Operation, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &OperationIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*ssm.Operation
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *OperationLocator) IndexReturns(res []*ssm.Operation, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*ssm.OperationIndexOptions) ([]*ssm.Operation, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// OperationIndexEachCall records the arguments of a call to OperationLocator.IndexEach.
+type OperationIndexEachCall struct {
+	Options *ssm.OperationIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *OperationLocator) IndexEach(options *ssm.OperationIndexOptions, fn func(*ssm.Operation) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &OperationIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *OperationLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*ssm.OperationIndexOptions, func(*ssm.Operation) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// OperationShowCall records the arguments of a call to OperationLocator.Show.
+type OperationShowCall struct {
+	Options *ssm.OperationShowOptions
+}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *OperationLocator) Show(options *ssm.OperationShowOptions) (*ssm.Operation, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &OperationShowCall{Options: options})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res *ssm.Operation
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *OperationLocator) ShowReturns(res *ssm.Operation, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func(*ssm.OperationShowOptions) (*ssm.Operation, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// OperationCreateCall records the arguments of a call to OperationLocator.Create.
+type OperationCreateCall struct {
+	ExecutionId string
+	Name        string
+	Options     *ssm.OperationCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *OperationLocator) Create(executionId string, name string, options *ssm.OperationCreateOptions) (*ssm.OperationLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &OperationCreateCall{ExecutionId: executionId, Name: name, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(executionId, name, options)
+	}
+	var res *ssm.OperationLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *OperationLocator) CreateReturns(res *ssm.OperationLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(string, string, *ssm.OperationCreateOptions) (*ssm.OperationLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+/******  ScheduledAction ******/
+
+// ScheduledActionLocator is a fake implementation of ssm.ScheduledActionLocatorInterface.
+type ScheduledActionLocator struct {
+	mu sync.Mutex
+
+	// IndexStub is called by Index if not nil.
+	IndexStub func(*ssm.ScheduledActionIndexOptions) ([]*ssm.ScheduledAction, error)
+	// IndexCalls records the arguments of the calls made to Index.
+	IndexCalls []*ScheduledActionIndexCall
+
+	// IndexEachStub is called by IndexEach if not nil.
+	IndexEachStub func(*ssm.ScheduledActionIndexOptions, func(*ssm.ScheduledAction) error) error
+	// IndexEachCalls records the arguments of the calls made to IndexEach.
+	IndexEachCalls []*ScheduledActionIndexEachCall
+
+	// ShowStub is called by Show if not nil.
+	ShowStub func() (*ssm.ScheduledAction, error)
+	// ShowCalls records the arguments of the calls made to Show.
+	ShowCalls []*ScheduledActionShowCall
+
+	// CreateStub is called by Create if not nil.
+	CreateStub func(string, string, *rsapi.Time, *ssm.ScheduledActionCreateOptions) (*ssm.ScheduledActionLocator, error)
+	// CreateCalls records the arguments of the calls made to Create.
+	CreateCalls []*ScheduledActionCreateCall
+
+	// PatchStub is called by Patch if not nil.
+	PatchStub func(*ssm.ScheduledActionPatchOptions) error
+	// PatchCalls records the arguments of the calls made to Patch.
+	PatchCalls []*ScheduledActionPatchCall
+
+	// DeleteStub is called by Delete if not nil.
+	DeleteStub func() error
+	// DeleteCalls records the arguments of the calls made to Delete.
+	DeleteCalls []*ScheduledActionDeleteCall
+
+	// SkipStub is called by Skip if not nil.
+	SkipStub func(*ssm.ScheduledActionSkipOptions) error
+	// SkipCalls records the arguments of the calls made to Skip.
+	SkipCalls []*ScheduledActionSkipCall
+}
+
+var _ ssm.ScheduledActionLocatorInterface = (*ScheduledActionLocator)(nil)
+
+// ScheduledActionIndexCall records the arguments of a call to ScheduledActionLocator.Index.
+type ScheduledActionIndexCall struct {
+	Options *ssm.ScheduledActionIndexOptions
+}
+
+// Index records the call and returns the results of IndexStub if set.
+func (fake *ScheduledActionLocator) Index(options *ssm.ScheduledActionIndexOptions) ([]*ssm.ScheduledAction, error) {
+	fake.mu.Lock()
+	fake.IndexCalls = append(fake.IndexCalls, &ScheduledActionIndexCall{Options: options})
+	stub := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	var res []*ssm.ScheduledAction
+	return res, nil
+}
+
+// IndexReturns sets IndexStub to a function that returns the given values.
+func (fake *ScheduledActionLocator) IndexReturns(res []*ssm.ScheduledAction, err error) {
+	fake.mu.Lock()
+	fake.IndexStub = func(*ssm.ScheduledActionIndexOptions) ([]*ssm.ScheduledAction, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduledActionIndexEachCall records the arguments of a call to ScheduledActionLocator.IndexEach.
+type ScheduledActionIndexEachCall struct {
+	Options *ssm.ScheduledActionIndexOptions
+}
+
+// IndexEach records the call and returns the results of IndexEachStub if set.
+// Otherwise it calls fn with each element returned by IndexStub if set.
+func (fake *ScheduledActionLocator) IndexEach(options *ssm.ScheduledActionIndexOptions, fn func(*ssm.ScheduledAction) error) error {
+	fake.mu.Lock()
+	fake.IndexEachCalls = append(fake.IndexEachCalls, &ScheduledActionIndexEachCall{Options: options})
+	stub := fake.IndexEachStub
+	each := fake.IndexStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options, fn)
+	}
+	if each == nil {
+		return nil
+	}
+	res, err := each(options)
+	if err != nil {
+		return err
+	}
+	for _, e := range res {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexEachReturns sets IndexEachStub to a function that returns the given values.
+func (fake *ScheduledActionLocator) IndexEachReturns(err error) {
+	fake.mu.Lock()
+	fake.IndexEachStub = func(*ssm.ScheduledActionIndexOptions, func(*ssm.ScheduledAction) error) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduledActionShowCall records the arguments of a call to ScheduledActionLocator.Show.
+type ScheduledActionShowCall struct{}
+
+// Show records the call and returns the results of ShowStub if set.
+func (fake *ScheduledActionLocator) Show() (*ssm.ScheduledAction, error) {
+	fake.mu.Lock()
+	fake.ShowCalls = append(fake.ShowCalls, &ScheduledActionShowCall{})
+	stub := fake.ShowStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	var res *ssm.ScheduledAction
+	return res, nil
+}
+
+// ShowReturns sets ShowStub to a function that returns the given values.
+func (fake *ScheduledActionLocator) ShowReturns(res *ssm.ScheduledAction, err error) {
+	fake.mu.Lock()
+	fake.ShowStub = func() (*ssm.ScheduledAction, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduledActionCreateCall records the arguments of a call to ScheduledActionLocator.Create.
+type ScheduledActionCreateCall struct {
+	Action          string
+	ExecutionId     string
+	FirstOccurrence *rsapi.Time
+	Options         *ssm.ScheduledActionCreateOptions
+}
+
+// Create records the call and returns the results of CreateStub if set.
+func (fake *ScheduledActionLocator) Create(action string, executionId string, firstOccurrence *rsapi.Time, options *ssm.ScheduledActionCreateOptions) (*ssm.ScheduledActionLocator, error) {
+	fake.mu.Lock()
+	fake.CreateCalls = append(fake.CreateCalls, &ScheduledActionCreateCall{Action: action, ExecutionId: executionId, FirstOccurrence: firstOccurrence, Options: options})
+	stub := fake.CreateStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(action, executionId, firstOccurrence, options)
+	}
+	var res *ssm.ScheduledActionLocator
+	return res, nil
+}
+
+// CreateReturns sets CreateStub to a function that returns the given values.
+func (fake *ScheduledActionLocator) CreateReturns(res *ssm.ScheduledActionLocator, err error) {
+	fake.mu.Lock()
+	fake.CreateStub = func(string, string, *rsapi.Time, *ssm.ScheduledActionCreateOptions) (*ssm.ScheduledActionLocator, error) {
+		return res, err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduledActionPatchCall records the arguments of a call to ScheduledActionLocator.Patch.
+type ScheduledActionPatchCall struct {
+	Options *ssm.ScheduledActionPatchOptions
+}
+
+// Patch records the call and returns the results of PatchStub if set.
+func (fake *ScheduledActionLocator) Patch(options *ssm.ScheduledActionPatchOptions) error {
+	fake.mu.Lock()
+	fake.PatchCalls = append(fake.PatchCalls, &ScheduledActionPatchCall{Options: options})
+	stub := fake.PatchStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// PatchReturns sets PatchStub to a function that returns the given values.
+func (fake *ScheduledActionLocator) PatchReturns(err error) {
+	fake.mu.Lock()
+	fake.PatchStub = func(*ssm.ScheduledActionPatchOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduledActionDeleteCall records the arguments of a call to ScheduledActionLocator.Delete.
+type ScheduledActionDeleteCall struct{}
+
+// Delete records the call and returns the results of DeleteStub if set.
+func (fake *ScheduledActionLocator) Delete() error {
+	fake.mu.Lock()
+	fake.DeleteCalls = append(fake.DeleteCalls, &ScheduledActionDeleteCall{})
+	stub := fake.DeleteStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return nil
+}
+
+// DeleteReturns sets DeleteStub to a function that returns the given values.
+func (fake *ScheduledActionLocator) DeleteReturns(err error) {
+	fake.mu.Lock()
+	fake.DeleteStub = func() error {
+		return err
+	}
+	fake.mu.Unlock()
+}
+
+// ScheduledActionSkipCall records the arguments of a call to ScheduledActionLocator.Skip.
+type ScheduledActionSkipCall struct {
+	Options *ssm.ScheduledActionSkipOptions
+}
+
+// Skip records the call and returns the results of SkipStub if set.
+func (fake *ScheduledActionLocator) Skip(options *ssm.ScheduledActionSkipOptions) error {
+	fake.mu.Lock()
+	fake.SkipCalls = append(fake.SkipCalls, &ScheduledActionSkipCall{Options: options})
+	stub := fake.SkipStub
+	fake.mu.Unlock()
+	if stub != nil {
+		return stub(options)
+	}
+	return nil
+}
+
+// SkipReturns sets SkipStub to a function that returns the given values.
+func (fake *ScheduledActionLocator) SkipReturns(err error) {
+	fake.mu.Lock()
+	fake.SkipStub = func(*ssm.ScheduledActionSkipOptions) error {
+		return err
+	}
+	fake.mu.Unlock()
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/rightscale/rsc/cmd"
+)
+
+// watch runs the command repeatedly waiting for the --watch interval between runs. It displays
+// the result of the first run then only the differences between consecutive results. Values
+// extracted with --x1, --xm, --xj or --xh are compared instead of the response bodies if these
+// flags are present.
+// watch returns after --watch-count runs if set or when the process is interrupted (Ctrl-C).
+func watch(client cmd.CommandClient, cmdLine *cmd.CommandLine) error {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	var previous interface{}
+	var initialized bool
+	for i := 0; cmdLine.WatchCount <= 0 || i < cmdLine.WatchCount; i++ {
+		if i > 0 {
+			select {
+			case <-interrupt:
+				return nil
+			case <-time.After(cmdLine.Watch):
+			}
+		}
+		resp, err := runCommand(client, cmdLine)
+		if err != nil {
+			PrintError(err.Error())
+			continue
+		}
+		if resp == nil {
+			return nil // Help or actions, nothing to watch
+		}
+		displayer, err := watchedValue(resp, cmdLine)
+		if err != nil {
+			PrintError(err.Error())
+			continue
+		}
+		current := displayer.RawOutput
+		if !initialized {
+			initialized = true
+			if cmdLine.ExtractSelector != "" {
+				values, _ := current.([]interface{})
+				for _, v := range values {
+					fmt.Fprintln(out, compactJSON(v))
+				}
+			} else {
+				if cmdLine.Pretty {
+					displayer.Pretty()
+				}
+				output := displayer.Output()
+				if !strings.HasSuffix(output, "\n") {
+					output += "\n"
+				}
+				fmt.Fprint(out, output)
+			}
+		} else if changes := DiffJSON(previous, current); len(changes) > 0 {
+			PrintTitle(time.Now().Format(time.RFC3339))
+			for _, c := range changes {
+				fmt.Fprintln(out, c.String())
+			}
+		}
+		previous = current
+	}
+	return nil
+}
+
+// watchedValue loads the response and applies the extraction flags if any. The resulting value
+// is stored in the RawOutput field of the returned displayer. Values extracted with --xm are
+// kept as JSON values so that they can be compared.
+func watchedValue(resp *http.Response, cmdLine *cmd.CommandLine) (*Displayer, error) {
+	displayer, err := NewDisplayer(resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if len(displayer.body) > 0 {
+			return nil, fmt.Errorf("%s: %s", resp.Status, displayer.body)
+		}
+		return nil, fmt.Errorf("%s", resp.Status)
+	}
+	switch {
+	case cmdLine.ExtractOneSelect != "":
+		err = displayer.ApplySingleExtract(cmdLine.ExtractOneSelect)
+	case cmdLine.ExtractSelector != "":
+		err = displayer.ApplyExtract(cmdLine.ExtractSelector, true)
+	case cmdLine.ExtractSelectorJSON != "":
+		err = displayer.ApplyExtract(cmdLine.ExtractSelectorJSON, true)
+	case cmdLine.ExtractHeader != "":
+		err = displayer.ApplyHeaderExtract(cmdLine.ExtractHeader)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return displayer, nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Watch", func() {
+	var (
+		server    *ghttp.Server
+		stdoutBuf bytes.Buffer
+		exitCode  int
+		extraArgs []string
+	)
+
+	const (
+		first  = `[{"name":"i1","state":"booting","links":[{"rel":"self","href":"/api/instances/1"}]}]`
+		second = `[{"name":"i1","state":"operational","links":[{"rel":"self","href":"/api/instances/1"}]},` +
+			`{"name":"i2","state":"pending","links":[{"rel":"self","href":"/api/instances/2"}]}]`
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		path := "/api/clouds/1/instances"
+		server.AppendHandlers(
+			ghttp.CombineHandlers(ghttp.VerifyRequest("GET", path), ghttp.RespondWith(200, first)),
+			ghttp.CombineHandlers(ghttp.VerifyRequest("GET", path), ghttp.RespondWith(200, second)),
+			ghttp.CombineHandlers(ghttp.VerifyRequest("GET", path), ghttp.RespondWith(200, second)),
+		)
+		extraArgs = nil
+		stdoutBuf.Reset()
+		exitCode = 99
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		os.Args = append([]string{"rsc", "--noAuth",
+			"--host", strings.TrimPrefix(server.URL(), "http://"),
+			"--watch", "1ms", "--watch-count", "3"}, extraArgs...)
+		os.Args = append(os.Args, "cm15", "index", "/api/clouds/1/instances")
+		SetOutput(&stdoutBuf)
+		SetErrorOutput(ioutil.Discard)
+		osExit = func(code int) { exitCode = code }
+		main()
+	})
+
+	It("re-runs the command the given number of times", func() {
+		Ω(server.ReceivedRequests()).Should(HaveLen(3))
+		Ω(exitCode).Should(Equal(99), "watch should not exit the process")
+	})
+
+	It("prints the first response then the changes", func() {
+		lines := strings.Split(strings.TrimSpace(stdoutBuf.String()), "\n")
+		Ω(lines).Should(HaveLen(4))
+		Ω(lines[0]).Should(MatchJSON(first))
+		Ω(lines[1]).Should(HavePrefix("== "))
+		Ω(lines[2]).Should(Equal(`~ [/api/instances/1].state: "booting" => "operational"`))
+		Ω(lines[3]).Should(HavePrefix(`+ [/api/instances/2]: {`))
+	})
+
+	Context("when a request fails", func() {
+		BeforeEach(func() {
+			server.SetHandler(0, func(w http.ResponseWriter, r *http.Request) {
+				conn, _, err := w.(http.Hijacker).Hijack()
+				Ω(err).ShouldNot(HaveOccurred())
+				conn.Close()
+			})
+		})
+
+		It("keeps watching", func() {
+			Ω(server.ReceivedRequests()).Should(HaveLen(3))
+			Ω(exitCode).Should(Equal(99), "watch should not exit the process")
+			lines := strings.Split(strings.TrimSpace(stdoutBuf.String()), "\n")
+			Ω(lines[0]).Should(MatchJSON(second))
+		})
+	})
+
+	Context("with --xm", func() {
+		BeforeEach(func() {
+			extraArgs = []string{"--xm", ".state"}
+		})
+
+		It("compares the extracted values", func() {
+			Ω(server.ReceivedRequests()).Should(HaveLen(3))
+			Ω(stdoutBuf.String()).ShouldNot(BeEmpty())
+		})
+	})
+})